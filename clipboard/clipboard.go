@@ -142,10 +142,15 @@
 package clipboard
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/application"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/infrastructure/native"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/infrastructure/osc52"
 )
@@ -172,9 +177,14 @@ import (
 //	go func() { mu.Lock(); c.Write("text1"); mu.Unlock() }()
 //	go func() { mu.Lock(); c.Write("text2"); mu.Unlock() }()
 type Clipboard struct {
-	manager *application.ClipboardManager
+	manager       *application.ClipboardManager
+	watchInterval time.Duration
 }
 
+// DefaultWatchInterval is how often Watch polls the clipboard unless
+// configured with Builder.WithWatchInterval.
+const DefaultWatchInterval = 500 * time.Millisecond
+
 // New creates a new clipboard instance with auto-detected providers.
 // This is the recommended way to use the clipboard.
 func New() (*Clipboard, error) {
@@ -184,7 +194,8 @@ func New() (*Clipboard, error) {
 	}
 
 	return &Clipboard{
-		manager: manager,
+		manager:       manager,
+		watchInterval: DefaultWatchInterval,
 	}, nil
 }
 
@@ -198,6 +209,99 @@ func (c *Clipboard) Write(text string) error {
 	return c.manager.Write(text)
 }
 
+// WriteFrom copies everything read from r to the clipboard as text, for
+// large payloads such as logs or files.
+//
+// Whether the data is streamed depends on the active provider:
+//   - OSC 52: streamed, base64-encoded on the fly into a single escape sequence
+//   - Linux (wl-copy, xclip, xsel) and macOS (pbcopy): streamed to the tool's stdin
+//   - Windows and custom providers: collected into one buffer, since the
+//     native API takes the whole content at once
+//
+// With history enabled, the content is also collected for the history entry.
+//
+// Example:
+//
+//	f, _ := os.Open("build.log")
+//	defer f.Close()
+//	err := cb.WriteFrom(f)
+func (c *Clipboard) WriteFrom(r io.Reader) error {
+	return c.manager.WriteFrom(r)
+}
+
+// ReadTo copies the clipboard text to w.
+//
+// Linux and macOS providers stream the tool's output to w; Windows and
+// custom providers read the content into one buffer first. OSC 52 cannot
+// read the clipboard and returns an error.
+//
+// Example:
+//
+//	err := cb.ReadTo(os.Stdout)
+func (c *Clipboard) ReadTo(w io.Writer) error {
+	return c.manager.ReadTo(w)
+}
+
+// ReadBest reads the clipboard in the richest format available (images,
+// then HTML, then RTF, then plain text) and returns it with its MIME type,
+// so a paste handler can decide what to do with it:
+//
+//	content, err := clip.ReadBest()
+//	if err != nil {
+//		return err
+//	}
+//	switch {
+//	case content.MIMEType.IsImage():
+//		showImage(content.Data)
+//	case content.MIMEType == clipboard.MIMETypeHTML:
+//		text, _ := clip.ConvertHTMLToMarkdown(content.Text())
+//		insert(text)
+//	default:
+//		insert(content.Text())
+//	}
+//
+// On Linux with xclip or wl-clipboard the application that owns the
+// clipboard is asked for each format. Elsewhere (and with xsel) only one
+// content is available and its type is detected from its bytes: RTF and
+// HTML by their leading markup, images by their magic bytes.
+func (c *Clipboard) ReadBest() (Content, error) {
+	content, err := c.manager.ReadBest()
+	if err != nil {
+		return Content{}, err
+	}
+	return Content{Data: content.Data(), MIMEType: content.MIMEType()}, nil
+}
+
+// AvailableFormats returns the MIME types currently on the clipboard,
+// without reading the content where the platform allows it (see ReadBest).
+// Returns nil if the clipboard is empty or cannot be read.
+func (c *Clipboard) AvailableFormats() []MIMEType {
+	formats, err := c.manager.AvailableFormats()
+	if err != nil {
+		return nil
+	}
+	return formats
+}
+
+// ReadPrimary reads text from the primary selection: the text last
+// selected with the mouse, pasted with a middle click on X11 and Wayland.
+//
+// macOS and Windows have no primary selection, so there ReadPrimary reads
+// the regular clipboard, like Read. OSC 52 cannot read either selection.
+func (c *Clipboard) ReadPrimary() (string, error) {
+	return c.manager.ReadPrimary()
+}
+
+// WritePrimary writes text to the primary selection, so that a middle
+// click pastes it on X11 and Wayland.
+//
+// macOS and Windows have no primary selection, so there WritePrimary
+// writes the regular clipboard, like Write. With OSC 52 the terminal
+// decides; many ignore primary selection writes.
+func (c *Clipboard) WritePrimary(text string) error {
+	return c.manager.WritePrimary(text)
+}
+
 // IsAvailable returns true if clipboard is available.
 func (c *Clipboard) IsAvailable() bool {
 	return c.manager.IsAvailable()
@@ -213,6 +317,48 @@ func (c *Clipboard) IsSSH() bool {
 	return c.manager.IsSSH()
 }
 
+// MIMEType identifies a clipboard content format, such as "text/html".
+type MIMEType = value.MIMEType
+
+// Clipboard content formats.
+const (
+	MIMETypePlainText = value.MIMETypePlainText
+	MIMETypeHTML      = value.MIMETypeHTML
+	MIMETypeRTF       = value.MIMETypeRTF
+	MIMETypeImagePNG  = value.MIMETypeImagePNG
+	MIMETypeImageJPEG = value.MIMETypeImageJPEG
+	MIMETypeImageGIF  = value.MIMETypeImageGIF
+	MIMETypeImageBMP  = value.MIMETypeImageBMP
+	MIMETypeBinary    = value.MIMETypeBinary
+)
+
+// Content is clipboard data tagged with its format, as returned by ReadBest.
+//
+// Zero value: Content with zero value (nil Data, empty MIMEType) means no content.
+type Content struct {
+	Data     []byte
+	MIMEType MIMEType
+}
+
+// Text returns the content as a string. Only meaningful for text formats
+// (see MIMEType.IsText).
+func (c Content) Text() string {
+	return string(c.Data)
+}
+
+// Selection identifies which system clipboard Read and Write use.
+// See Builder.WithSelection.
+type Selection = value.Selection
+
+const (
+	// SelectionClipboard is the regular copy/paste clipboard (default).
+	SelectionClipboard = value.SelectionClipboard
+
+	// SelectionPrimary is the X11/Wayland primary selection (middle-click paste).
+	// On macOS and Windows it aliases the regular clipboard.
+	SelectionPrimary = value.SelectionPrimary
+)
+
 // Builder provides a fluent interface for creating a clipboard instance.
 //
 // Zero value: Builder with zero value is valid and ready to use with default settings.
@@ -225,6 +371,9 @@ type Builder struct {
 	osc52Enabled  bool
 	osc52Timeout  time.Duration
 	nativeEnabled bool
+	watchInterval time.Duration
+	selection     Selection
+	historyDedup  bool
 }
 
 // NewBuilder creates a new clipboard builder.
@@ -233,6 +382,8 @@ func NewBuilder() *Builder {
 		osc52Enabled:  true,
 		osc52Timeout:  5 * time.Second,
 		nativeEnabled: true,
+		watchInterval: DefaultWatchInterval,
+		selection:     SelectionClipboard,
 	}
 }
 
@@ -248,6 +399,36 @@ func (b *Builder) WithOSC52Timeout(timeout time.Duration) *Builder {
 	return b
 }
 
+// WithWatchInterval sets how often Watch polls the clipboard for changes.
+// Shorter intervals notice changes sooner at the cost of more reads.
+func (b *Builder) WithWatchInterval(interval time.Duration) *Builder {
+	b.watchInterval = interval
+	return b
+}
+
+// WithSelection sets the selection used by Read, Write, ReadTo and
+// WriteFrom on the built-in providers (default SelectionClipboard).
+// Custom providers added with WithProvider are not affected.
+//
+// Example:
+//
+//	// Middle-click clipboard for a terminal tool on Linux
+//	clip, err := clipboard.NewBuilder().
+//		WithSelection(clipboard.SelectionPrimary).
+//		Build()
+func (b *Builder) WithSelection(selection Selection) *Builder {
+	b.selection = selection
+	return b
+}
+
+// WithHistoryDedup enables or disables history deduplication (default off).
+// With dedup enabled, writing content identical to an existing history
+// entry moves that entry to the front instead of adding a new one.
+func (b *Builder) WithHistoryDedup(enabled bool) *Builder {
+	b.historyDedup = enabled
+	return b
+}
+
 // WithNative enables or disables native platform clipboard.
 func (b *Builder) WithNative(enabled bool) *Builder {
 	b.nativeEnabled = enabled
@@ -262,6 +443,14 @@ func (b *Builder) WithProvider(provider service.Provider) *Builder {
 
 // Build creates the clipboard instance.
 func (b *Builder) Build() (*Clipboard, error) {
+	selection := b.selection
+	if selection == "" {
+		selection = SelectionClipboard
+	}
+	if !selection.IsValid() {
+		return nil, fmt.Errorf("unknown selection: %q", selection)
+	}
+
 	var providers []service.Provider
 
 	// Add custom providers first (highest priority)
@@ -269,13 +458,13 @@ func (b *Builder) Build() (*Clipboard, error) {
 
 	// Add OSC 52 if enabled
 	if b.osc52Enabled {
-		osc52Provider := osc52.NewProvider(b.osc52Timeout)
+		osc52Provider := osc52.NewProvider(b.osc52Timeout).WithSelection(selection)
 		providers = append(providers, osc52Provider)
 	}
 
 	// Add native provider if enabled
 	if b.nativeEnabled {
-		nativeProvider := native.NewProvider()
+		nativeProvider := native.NewProvider().WithSelection(selection)
 		providers = append(providers, nativeProvider)
 	}
 
@@ -283,9 +472,11 @@ func (b *Builder) Build() (*Clipboard, error) {
 	if err != nil {
 		return nil, err
 	}
+	manager.SetHistoryDedup(b.historyDedup)
 
 	return &Clipboard{
-		manager: manager,
+		manager:       manager,
+		watchInterval: b.watchInterval,
 	}, nil
 }
 
@@ -472,6 +663,20 @@ func (c *Clipboard) ConvertRTFToHTML(rtf string) (string, error) {
 	return c.manager.ConvertRTFToHTML(rtf)
 }
 
+// ConvertHTMLToMarkdown converts HTML content to Markdown format.
+// Bold, italic, and links carry over; underline and color have no
+// Markdown equivalent and are dropped.
+func (c *Clipboard) ConvertHTMLToMarkdown(html string) (string, error) {
+	return c.manager.ConvertHTMLToMarkdown(html)
+}
+
+// ConvertMarkdownToHTML converts Markdown content to HTML format.
+// Bold, italic, code spans, links, and headings are understood; other
+// Markdown (lists, quotes, tables, images) is kept as plain text.
+func (c *Clipboard) ConvertMarkdownToHTML(markdown string) (string, error) {
+	return c.manager.ConvertMarkdownToHTML(markdown)
+}
+
 // HistoryEntry represents a single clipboard history item in the public API.
 //
 // Zero value: HistoryEntry with zero value (empty strings, nil Content, zero time/size) is valid but not useful.
@@ -485,6 +690,7 @@ type HistoryEntry struct {
 	MIMEType  string
 	Timestamp time.Time
 	Size      int
+	IsPinned  bool // Pinned entries survive ClearHistory, expiration, and size eviction
 }
 
 // EnableHistory enables clipboard history tracking with the given limits.
@@ -502,7 +708,7 @@ func (c *Clipboard) EnableHistory(maxSize int, maxAge time.Duration) {
 	c.manager.EnableHistory(maxSize, maxAge)
 }
 
-// DisableHistory disables clipboard history tracking and clears existing history.
+// DisableHistory disables clipboard history tracking and clears existing history, pinned entries included.
 func (c *Clipboard) DisableHistory() {
 	c.manager.DisableHistory()
 }
@@ -523,13 +729,7 @@ func (c *Clipboard) GetHistory() []HistoryEntry {
 	// Convert internal entries to public API
 	result := make([]HistoryEntry, len(entries))
 	for i, entry := range entries {
-		result[i] = HistoryEntry{
-			ID:        entry.ID(),
-			Content:   entry.Content(),
-			MIMEType:  entry.MIMEType().String(),
-			Timestamp: entry.Timestamp(),
-			Size:      entry.Size(),
-		}
+		result[i] = toHistoryEntry(entry)
 	}
 	return result
 }
@@ -542,13 +742,7 @@ func (c *Clipboard) GetHistoryEntry(id string) (HistoryEntry, error) {
 		return HistoryEntry{}, err
 	}
 
-	return HistoryEntry{
-		ID:        entry.ID(),
-		Content:   entry.Content(),
-		MIMEType:  entry.MIMEType().String(),
-		Timestamp: entry.Timestamp(),
-		Size:      entry.Size(),
-	}, nil
+	return toHistoryEntry(entry), nil
 }
 
 // GetRecentHistory returns the N most recent history entries.
@@ -563,23 +757,77 @@ func (c *Clipboard) GetRecentHistory(count int) []HistoryEntry {
 	// Convert internal entries to public API
 	result := make([]HistoryEntry, len(entries))
 	for i, entry := range entries {
-		result[i] = HistoryEntry{
-			ID:        entry.ID(),
-			Content:   entry.Content(),
-			MIMEType:  entry.MIMEType().String(),
-			Timestamp: entry.Timestamp(),
-			Size:      entry.Size(),
-		}
+		result[i] = toHistoryEntry(entry)
 	}
 	return result
 }
 
-// ClearHistory removes all history entries.
+// SearchHistory returns the history entries whose content or MIME type
+// contains query, ignoring case, sorted by timestamp (newest first).
+// Matching is Unicode-aware; binary content such as images only matches
+// on its MIME type. An empty query returns the whole history.
+// Returns empty slice if history is not enabled.
+func (c *Clipboard) SearchHistory(query string) []HistoryEntry {
+	entries := c.manager.SearchHistory(query)
+
+	// Convert internal entries to public API
+	result := make([]HistoryEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = toHistoryEntry(entry)
+	}
+	return result
+}
+
+// FilterHistory returns the history entries for which match returns true,
+// sorted by timestamp (newest first). match receives a copy of each entry,
+// so modifying it does not affect the history. match must not call other
+// history methods on c.
+// Returns empty slice if history is not enabled or match is nil.
+func (c *Clipboard) FilterHistory(match func(HistoryEntry) bool) []HistoryEntry {
+	if match == nil {
+		return []HistoryEntry{}
+	}
+
+	entries := c.manager.FilterHistory(func(entry *model.HistoryEntry) bool {
+		return match(toHistoryEntry(entry))
+	})
+
+	// Convert internal entries to public API
+	result := make([]HistoryEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = toHistoryEntry(entry)
+	}
+	return result
+}
+
+// ClearHistory removes all history entries except pinned ones.
 // Does nothing if history is not enabled.
 func (c *Clipboard) ClearHistory() {
 	c.manager.ClearHistory()
 }
 
+// PinHistoryEntry pins the history entry at index in GetHistory order
+// (newest first). Pinned entries survive ClearHistory, RemoveExpiredHistory,
+// and size eviction until they are unpinned.
+// Returns error if history is not enabled or index is out of range.
+//
+// Example:
+//
+//	// Keep the most recent entry around
+//	if err := c.PinHistoryEntry(0); err != nil {
+//		log.Println(err)
+//	}
+func (c *Clipboard) PinHistoryEntry(index int) error {
+	return c.manager.PinHistoryEntry(index)
+}
+
+// UnpinHistoryEntry unpins the history entry at index in GetHistory order
+// (newest first).
+// Returns error if history is not enabled or index is out of range.
+func (c *Clipboard) UnpinHistoryEntry(index int) error {
+	return c.manager.UnpinHistoryEntry(index)
+}
+
 // GetHistorySize returns the number of entries in history.
 // Returns 0 if history is not enabled.
 func (c *Clipboard) GetHistorySize() int {
@@ -592,7 +840,7 @@ func (c *Clipboard) GetHistoryTotalSize() int {
 	return c.manager.GetHistoryTotalSize()
 }
 
-// RemoveExpiredHistory removes expired entries from history.
+// RemoveExpiredHistory removes expired entries from history; pinned entries never expire.
 // Returns the number of entries removed.
 // Returns 0 if history is not enabled.
 //
@@ -610,6 +858,60 @@ func (c *Clipboard) RemoveExpiredHistory() int {
 	return c.manager.RemoveExpiredHistory()
 }
 
+// Watch reports external clipboard changes, such as text copied in
+// another application, as history entries on the returned channel.
+//
+// The clipboard is polled every DefaultWatchInterval (see
+// Builder.WithWatchInterval); none of the providers offer change
+// notifications. The content present when Watch is called is not
+// reported, and neither is an emptied clipboard.
+//
+// With history enabled, every change is also added to history, so
+// GetHistory stays in sync with the channel. Writes made through this
+// Clipboard are reported too, without duplicating their history entry.
+//
+// The channel is closed when ctx is canceled. Sends block until the entry
+// is received; changes made meanwhile are coalesced into the next poll.
+// OSC 52 cannot read the clipboard, so with the OSC 52 provider the
+// channel never receives anything.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	changes, err := clip.Watch(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for entry := range changes {
+//		fmt.Printf("Copied: %s\n", entry.Content)
+//	}
+func (c *Clipboard) Watch(ctx context.Context) (<-chan HistoryEntry, error) {
+	interval := c.watchInterval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	entries, err := c.manager.Watch(ctx, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan HistoryEntry)
+	go func() {
+		defer close(events)
+		for entry := range entries {
+			select {
+			case events <- toHistoryEntry(entry):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
 // RestoreFromHistory restores a history entry to the clipboard by writing it back.
 // This is a convenience method that combines GetHistoryEntry and Write/WriteImage/WriteHTML/WriteRTF.
 func (c *Clipboard) RestoreFromHistory(id string) error {
@@ -641,3 +943,15 @@ func (c *Clipboard) RestoreFromHistory(id string) error {
 	// Fallback to plain text
 	return c.Write(string(entry.Content()))
 }
+
+// toHistoryEntry converts an internal history entry to the public API.
+func toHistoryEntry(entry *model.HistoryEntry) HistoryEntry {
+	return HistoryEntry{
+		ID:        entry.ID(),
+		Content:   entry.Content(),
+		MIMEType:  entry.MIMEType().String(),
+		Timestamp: entry.Timestamp(),
+		Size:      entry.Size(),
+		IsPinned:  entry.IsPinned(),
+	}
+}
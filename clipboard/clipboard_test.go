@@ -1,7 +1,10 @@
 package clipboard
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1170,6 +1173,82 @@ func TestClipboard_ConvertRTFToHTML(t *testing.T) {
 	}
 }
 
+func TestClipboard_ConvertMarkdown(t *testing.T) {
+	clipboard, err := NewBuilder().
+		WithProvider(&MockProvider{name: "mock", available: true}).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	md, err := clipboard.ConvertHTMLToMarkdown(`<b>Bold</b> <a href="https://example.com">text</a>`)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if md != "[**Bold text**](https://example.com)" {
+		t.Errorf("ConvertHTMLToMarkdown() = %q", md)
+	}
+
+	html, err := clipboard.ConvertMarkdownToHTML(md)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html != `<a href="https://example.com"><strong>Bold text</strong></a>` {
+		t.Errorf("ConvertMarkdownToHTML() = %q", html)
+	}
+}
+
+func TestClipboard_ReadBest(t *testing.T) {
+	mockProvider := &MockProvider{
+		name:      "mock",
+		available: true,
+		readFunc: func() (*model.ClipboardContent, error) {
+			return model.NewTextContent("<p>Pasted <b>HTML</b></p>")
+		},
+	}
+	clipboard, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	formats := clipboard.AvailableFormats()
+	if len(formats) != 1 || formats[0] != MIMETypeHTML {
+		t.Errorf("AvailableFormats() = %v, want [text/html]", formats)
+	}
+
+	content, err := clipboard.ReadBest()
+	if err != nil {
+		t.Fatalf("ReadBest() error = %v", err)
+	}
+	if content.MIMEType != MIMETypeHTML || !content.MIMEType.IsText() {
+		t.Errorf("MIMEType = %v, want text/html", content.MIMEType)
+	}
+	if content.Text() != "<p>Pasted <b>HTML</b></p>" {
+		t.Errorf("Text() = %q", content.Text())
+	}
+}
+
+func TestClipboard_AvailableFormats_Empty(t *testing.T) {
+	mockProvider := &MockProvider{
+		name:      "mock",
+		available: true,
+		readFunc: func() (*model.ClipboardContent, error) {
+			return nil, fmt.Errorf("clipboard is empty")
+		},
+	}
+	clipboard, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if formats := clipboard.AvailableFormats(); formats != nil {
+		t.Errorf("AvailableFormats() = %v, want nil", formats)
+	}
+	if _, err := clipboard.ReadBest(); err == nil {
+		t.Error("expected error for empty clipboard")
+	}
+}
+
 // History methods tests
 
 func TestClipboard_EnableHistory(t *testing.T) {
@@ -2420,3 +2499,302 @@ func TestBuilder_Build_WithAllOptionsEnabled(t *testing.T) {
 		t.Errorf("expected 'first-priority', got %s", clipboard.GetProviderName())
 	}
 }
+
+func TestClipboard_WriteFrom_ReadTo(t *testing.T) {
+	var written string
+	mockProvider := &MockProvider{
+		name:      "mock",
+		available: true,
+		writeFunc: func(content *model.ClipboardContent) error {
+			written, _ = content.Text()
+			return nil
+		},
+	}
+
+	cb, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cb.EnableHistory(10, time.Hour)
+
+	var large strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&large, "Line %d\n", i+1)
+	}
+
+	if err := cb.WriteFrom(strings.NewReader(large.String())); err != nil {
+		t.Fatalf("WriteFrom() error = %v", err)
+	}
+	if written != large.String() {
+		t.Errorf("provider got %d bytes, want %d", len(written), large.Len())
+	}
+	if got := cb.GetHistoryTotalSize(); got != large.Len() {
+		t.Errorf("history size = %d, want %d", got, large.Len())
+	}
+
+	var out strings.Builder
+	if err := cb.ReadTo(&out); err != nil {
+		t.Fatalf("ReadTo() error = %v", err)
+	}
+	if out.String() != "mock data" {
+		t.Errorf("ReadTo() = %q, want %q", out.String(), "mock data")
+	}
+}
+
+func TestClipboard_Watch(t *testing.T) {
+	var mu sync.Mutex
+	current := "before"
+	mockProvider := &MockProvider{
+		name:      "mock",
+		available: true,
+		readFunc: func() (*model.ClipboardContent, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return model.NewTextContent(current)
+		},
+	}
+
+	cb, err := NewBuilder().
+		WithProvider(mockProvider).
+		WithOSC52(false).
+		WithNative(false).
+		WithWatchInterval(5 * time.Millisecond).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cb.EnableHistory(10, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := cb.Watch(ctx)
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	mu.Lock()
+	current = "copied elsewhere"
+	mu.Unlock()
+
+	select {
+	case entry := <-changes:
+		if string(entry.Content) != "copied elsewhere" {
+			t.Errorf("Content = %q, want %q", entry.Content, "copied elsewhere")
+		}
+		if entry.MIMEType != "text/plain" {
+			t.Errorf("MIMEType = %q, want text/plain", entry.MIMEType)
+		}
+		history := cb.GetHistory()
+		if len(history) != 1 || history[0].ID != entry.ID {
+			t.Errorf("history = %v, want the watched entry", history)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for clipboard change")
+	}
+
+	cancel()
+	for range changes {
+		// Drain until closed
+	}
+}
+
+// selectionProvider is a provider with separate CLIPBOARD and PRIMARY
+// selections, like the Linux native provider.
+type selectionProvider struct {
+	MockProvider
+	selections map[Selection]string
+}
+
+func (p *selectionProvider) ReadSelection(selection Selection) (*model.ClipboardContent, error) {
+	return model.NewTextContent(p.selections[selection])
+}
+
+func (p *selectionProvider) WriteSelection(selection Selection, content *model.ClipboardContent) error {
+	p.selections[selection], _ = content.Text()
+	return nil
+}
+
+func TestClipboard_WritePrimary_ReadPrimary(t *testing.T) {
+	t.Run("provider with primary selection", func(t *testing.T) {
+		provider := &selectionProvider{
+			MockProvider: MockProvider{name: "x11", available: true},
+			selections:   map[Selection]string{SelectionClipboard: "copied"},
+		}
+		cb, err := NewBuilder().WithProvider(provider).WithOSC52(false).WithNative(false).Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := cb.WritePrimary("selected"); err != nil {
+			t.Fatalf("WritePrimary() error = %v", err)
+		}
+		if got, _ := cb.ReadPrimary(); got != "selected" {
+			t.Errorf("ReadPrimary() = %q, want %q", got, "selected")
+		}
+		if got := provider.selections[SelectionClipboard]; got != "copied" {
+			t.Errorf("clipboard selection = %q, want it untouched", got)
+		}
+	})
+
+	t.Run("provider without primary selection aliases the clipboard", func(t *testing.T) {
+		var written string
+		provider := &MockProvider{
+			name:      "single",
+			available: true,
+			writeFunc: func(content *model.ClipboardContent) error {
+				written, _ = content.Text()
+				return nil
+			},
+		}
+		cb, err := NewBuilder().WithProvider(provider).WithOSC52(false).WithNative(false).Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := cb.WritePrimary("selected"); err != nil {
+			t.Fatalf("WritePrimary() error = %v", err)
+		}
+		if written != "selected" {
+			t.Errorf("provider got %q, want %q", written, "selected")
+		}
+	})
+}
+
+func TestBuilder_WithSelection(t *testing.T) {
+	for _, selection := range []Selection{SelectionClipboard, SelectionPrimary} {
+		if _, err := NewBuilder().WithSelection(selection).Build(); err != nil {
+			t.Errorf("Build() with %s selection error = %v", selection, err)
+		}
+	}
+
+	if _, err := NewBuilder().WithSelection("secondary").Build(); err == nil {
+		t.Error("expected error for unknown selection")
+	}
+}
+
+func TestClipboard_HistoryDedup(t *testing.T) {
+	mockProvider := &MockProvider{name: "mock", available: true}
+	cb, err := NewBuilder().
+		WithProvider(mockProvider).
+		WithOSC52(false).
+		WithNative(false).
+		WithHistoryDedup(true).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cb.EnableHistory(10, time.Hour)
+
+	for _, text := range []string{"alpha", "beta", "alpha"} {
+		if err := cb.Write(text); err != nil {
+			t.Fatalf("Write(%q) error = %v", text, err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	history := cb.GetHistory()
+	if len(history) != 2 {
+		t.Fatalf("history has %d entries, want 2", len(history))
+	}
+	if string(history[0].Content) != "alpha" || string(history[1].Content) != "beta" {
+		t.Errorf("history = [%s %s], want [alpha beta]", history[0].Content, history[1].Content)
+	}
+}
+
+func TestClipboard_PinHistoryEntry(t *testing.T) {
+	mockProvider := &MockProvider{name: "mock", available: true}
+	cb, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := cb.PinHistoryEntry(0); err == nil {
+		t.Error("expected error when history is not enabled")
+	}
+
+	cb.EnableHistory(10, time.Hour)
+	_ = cb.Write("keep me")
+	time.Sleep(2 * time.Millisecond)
+	_ = cb.Write("temporary")
+
+	// Index 1 is the older entry ("keep me")
+	if err := cb.PinHistoryEntry(1); err != nil {
+		t.Fatalf("PinHistoryEntry() error = %v", err)
+	}
+	if err := cb.PinHistoryEntry(5); err == nil {
+		t.Error("expected error for out-of-range index")
+	}
+
+	cb.ClearHistory()
+	history := cb.GetHistory()
+	if len(history) != 1 || string(history[0].Content) != "keep me" || !history[0].IsPinned {
+		t.Fatalf("history after clear = %+v, want the pinned entry", history)
+	}
+
+	if err := cb.UnpinHistoryEntry(0); err != nil {
+		t.Fatalf("UnpinHistoryEntry() error = %v", err)
+	}
+	cb.ClearHistory()
+	if size := cb.GetHistorySize(); size != 0 {
+		t.Errorf("history size = %d after unpin and clear, want 0", size)
+	}
+}
+
+func TestClipboard_SearchHistory(t *testing.T) {
+	mockProvider := &MockProvider{name: "mock", available: true}
+	cb, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if entries := cb.SearchHistory("x"); entries == nil || len(entries) != 0 {
+		t.Errorf("SearchHistory() = %v with history disabled, want empty slice", entries)
+	}
+
+	cb.EnableHistory(10, time.Hour)
+	for _, text := range []string{"Привет мир", "hello world", "goodbye"} {
+		if err := cb.Write(text); err != nil {
+			t.Fatalf("Write(%q) error = %v", text, err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	if entries := cb.SearchHistory("ПРИВЕТ"); len(entries) != 1 || string(entries[0].Content) != "Привет мир" {
+		t.Errorf("SearchHistory(\"ПРИВЕТ\") = %+v", entries)
+	}
+	if entries := cb.SearchHistory("WORLD"); len(entries) != 1 {
+		t.Errorf("SearchHistory(\"WORLD\") returned %d entries, want 1", len(entries))
+	}
+	if entries := cb.SearchHistory("text/plain"); len(entries) != 3 {
+		t.Errorf("SearchHistory(\"text/plain\") returned %d entries, want 3", len(entries))
+	}
+
+	// Results are copies
+	entries := cb.SearchHistory("goodbye")
+	entries[0].Content[0] = 'X'
+	if got := cb.SearchHistory("goodbye"); len(got) != 1 {
+		t.Error("modifying a search result changed the stored history")
+	}
+}
+
+func TestClipboard_FilterHistory(t *testing.T) {
+	mockProvider := &MockProvider{name: "mock", available: true}
+	cb, err := NewBuilder().WithProvider(mockProvider).WithOSC52(false).WithNative(false).Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cb.EnableHistory(10, time.Hour)
+
+	_ = cb.Write("keep")
+	time.Sleep(2 * time.Millisecond)
+	_ = cb.Write("drop")
+	_ = cb.PinHistoryEntry(1)
+
+	pinned := cb.FilterHistory(func(entry HistoryEntry) bool { return entry.IsPinned })
+	if len(pinned) != 1 || string(pinned[0].Content) != "keep" {
+		t.Errorf("FilterHistory(pinned) = %+v, want the pinned entry", pinned)
+	}
+
+	if entries := cb.FilterHistory(nil); entries == nil || len(entries) != 0 {
+		t.Errorf("FilterHistory(nil) = %v, want empty slice", entries)
+	}
+}
@@ -2,11 +2,14 @@
 //
 // This example shows how to:
 // - Enable/disable clipboard history
-// - View history entries
+// - Watch the system clipboard and update the list live
+// - Deduplicate repeated copies and pin entries that should stay
+// - Search history by content or MIME type
 // - Monitor memory usage
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -16,10 +19,20 @@ import (
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// clipboardChangedMsg is sent when the system clipboard content changes.
+type clipboardChangedMsg clipboard.HistoryEntry
+
+// watchStoppedMsg is sent when the watch channel is closed.
+type watchStoppedMsg struct{}
+
 type model struct {
 	clipboard      *clipboard.Clipboard
+	changes        <-chan clipboard.HistoryEntry
+	cancelWatch    context.CancelFunc
 	historyEnabled bool
 	entries        []clipboard.HistoryEntry
+	searching      bool   // Typing a search query
+	query          string // Current search query ("" = whole history)
 	selected       int
 	message        string
 	width          int
@@ -27,7 +40,8 @@ type model struct {
 }
 
 func initialModel() model {
-	clip, err := clipboard.New()
+	// Copying the same text again moves it to the top instead of duplicating it
+	clip, err := clipboard.NewBuilder().WithHistoryDedup(true).Build()
 	if err != nil {
 		panic(err)
 	}
@@ -35,8 +49,18 @@ func initialModel() model {
 	// Enable history: 100 entries, 24-hour retention
 	clip.EnableHistory(100, 24*time.Hour)
 
+	// Watch for changes made in other applications
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := clip.Watch(ctx)
+	if err != nil {
+		cancel()
+		panic(err)
+	}
+
 	return model{
 		clipboard:      clip,
+		changes:        changes,
+		cancelWatch:    cancel,
 		historyEnabled: true,
 		entries:        []clipboard.HistoryEntry{},
 		selected:       0,
@@ -46,31 +70,129 @@ func initialModel() model {
 	}
 }
 
+// waitForChange returns a command that waits for the next clipboard change.
+func waitForChange(changes <-chan clipboard.HistoryEntry) tea.Cmd {
+	return func() tea.Msg {
+		entry, ok := <-changes
+		if !ok {
+			return watchStoppedMsg{}
+		}
+		return clipboardChangedMsg(entry)
+	}
+}
+
+// refresh reloads the displayed entries, applying the search query.
+func (m *model) refresh() {
+	if m.query == "" {
+		m.entries = m.clipboard.GetHistory()
+	} else {
+		m.entries = m.clipboard.SearchHistory(m.query)
+	}
+	if m.selected >= len(m.entries) {
+		m.selected = max(len(m.entries)-1, 0)
+	}
+}
+
+// historyIndex returns the index of the entry with id in the full
+// history, which is what PinHistoryEntry expects, or -1.
+func (m model) historyIndex(id string) int {
+	for i, entry := range m.clipboard.GetHistory() {
+		if entry.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateSearch handles keys while a search query is being typed.
+func (m model) updateSearch(msg tea.KeyMsg) (model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.searching = false
+		m.message = fmt.Sprintf("%d entries match %q", len(m.entries), m.query)
+	case tea.KeyEsc:
+		m.searching = false
+		m.query = ""
+		m.message = "Search cleared"
+	case tea.KeyBackspace:
+		if runes := []rune(m.query); len(runes) > 0 {
+			m.query = string(runes[:len(runes)-1])
+		}
+	case tea.KeySpace:
+		m.query += " "
+	case tea.KeyRune:
+		if !msg.Ctrl && !msg.Alt {
+			m.query += string(msg.Rune)
+		}
+	}
+	m.refresh()
+	return m, nil
+}
+
 func (m model) Init() tea.Cmd {
-	return nil
+	return waitForChange(m.changes)
 }
 
 func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case clipboardChangedMsg:
+		m.refresh()
+		m.message = fmt.Sprintf("Clipboard changed (%d bytes)", msg.Size)
+		return m, waitForChange(m.changes)
+
+	case watchStoppedMsg:
+		m.message = "Stopped watching the clipboard"
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.searching && msg.Type != tea.KeyCtrlC {
+			return m.updateSearch(msg)
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
+			m.cancelWatch()
 			return m, tea.Quit()
 
 		case "?":
-			m.message = "Keys: [v]iew [c]lear [e]nable/disable [m]emory [q]uit"
+			m.message = "Keys: [/]search [v]iew [p]in [c]lear [e]nable/disable [m]emory [q]uit"
+			return m, nil
+
+		case "/":
+			m.searching = true
+			m.message = "Search: type to filter, Enter to keep, Esc to clear"
 			return m, nil
 
 		case "v":
-			m.entries = m.clipboard.GetHistory()
+			m.refresh()
 			m.message = fmt.Sprintf("Refreshed history: %d entries", len(m.entries))
 			return m, nil
 
+		case "p":
+			if m.selected >= len(m.entries) {
+				return m, nil
+			}
+			// Search results are a subset, so look up the index in full history
+			index := m.historyIndex(m.entries[m.selected].ID)
+			var err error
+			if m.entries[m.selected].IsPinned {
+				err = m.clipboard.UnpinHistoryEntry(index)
+				m.message = "Entry unpinned"
+			} else {
+				err = m.clipboard.PinHistoryEntry(index)
+				m.message = "Entry pinned (survives clear and expiration)"
+			}
+			if err != nil {
+				m.message = fmt.Sprintf("Pin failed: %v", err)
+			}
+			m.refresh()
+			return m, nil
+
 		case "c":
 			m.clipboard.ClearHistory()
-			m.entries = []clipboard.HistoryEntry{}
+			m.refresh()
 			m.selected = 0
-			m.message = "History cleared"
+			m.message = "History cleared (pinned entries kept)"
 			return m, nil
 
 		case "e":
@@ -90,7 +212,7 @@ func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
 			totalSize := m.clipboard.GetHistoryTotalSize()
 			removed := m.clipboard.RemoveExpiredHistory()
 			m.message = fmt.Sprintf("Entries: %d | Memory: %d bytes | Expired removed: %d", size, totalSize, removed)
-			m.entries = m.clipboard.GetHistory()
+			m.refresh()
 			return m, nil
 
 		case "up", "k":
@@ -132,12 +254,21 @@ func (m model) View() string {
 		m.clipboard.GetHistoryTotalSize()))
 
 	// History list
-	b.WriteString("History (newest first):\n")
+	switch {
+	case m.searching:
+		b.WriteString(fmt.Sprintf("Search: %s_\n", m.query))
+	case m.query != "":
+		b.WriteString(fmt.Sprintf("Matching %q (newest first, / then Esc to clear):\n", m.query))
+	default:
+		b.WriteString("History (newest first):\n")
+	}
 	b.WriteString(strings.Repeat("-", min(m.width, 80)) + "\n")
 
-	if len(m.entries) == 0 {
+	if len(m.entries) == 0 && m.query != "" {
+		b.WriteString("  No entries match the search\n")
+	} else if len(m.entries) == 0 {
 		b.WriteString("  No history entries yet\n")
-		b.WriteString("  Use clipboard normally, then press 'v' to view history\n")
+		b.WriteString("  Copy something in any application and it will appear here\n")
 	} else {
 		displayCount := min(len(m.entries), 10)
 		for i := 0; i < displayCount; i++ {
@@ -162,8 +293,13 @@ func (m model) View() string {
 			preview = strings.ReplaceAll(preview, "\n", " ")
 			preview = strings.ReplaceAll(preview, "\r", " ")
 
-			b.WriteString(fmt.Sprintf("[%s] %s (%s, %d bytes)\n",
-				ageStr, preview, entry.MIMEType, entry.Size))
+			pin := ""
+			if entry.IsPinned {
+				pin = " [pinned]"
+			}
+
+			b.WriteString(fmt.Sprintf("[%s] %s (%s, %d bytes)%s\n",
+				ageStr, preview, entry.MIMEType, entry.Size, pin))
 		}
 
 		if len(m.entries) > displayCount {
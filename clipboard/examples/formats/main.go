@@ -2,7 +2,9 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"log"
 
 	"github.com/phoenix-tui/phoenix/clipboard"
@@ -144,24 +146,31 @@ Line 3`
 	fmt.Println("7. Large Content")
 	fmt.Println("----------------")
 
-	largeText := ""
-	for i := 0; i < 1000; i++ {
-		largeText += fmt.Sprintf("Line %d\n", i+1)
-	}
-
-	err = cb.Write(largeText)
+	// Stream 1000 lines instead of building one large string in memory
+	pr, pw := io.Pipe()
+	written := 0
+	go func() {
+		for i := 0; i < 1000; i++ {
+			n, _ := fmt.Fprintf(pw, "Line %d\n", i+1)
+			written += n
+		}
+		pw.Close()
+	}()
+
+	err = cb.WriteFrom(pr)
 	if err != nil {
 		log.Fatalf("Failed to write large content: %v", err)
 	}
-	fmt.Printf("✓ Written large content: %d bytes\n", len(largeText))
+	fmt.Printf("✓ Streamed large content: %d bytes\n", written)
 
-	readLarge, err := cb.Read()
+	var readLarge bytes.Buffer
+	err = cb.ReadTo(&readLarge)
 	if err != nil {
 		log.Fatalf("Failed to read large content: %v", err)
 	}
-	fmt.Printf("✓ Read large content: %d bytes\n", len(readLarge))
+	fmt.Printf("✓ Read large content: %d bytes\n", readLarge.Len())
 
-	if len(readLarge) == len(largeText) {
+	if readLarge.Len() == written {
 		fmt.Println("✓ Large content preserved correctly")
 	}
 
@@ -10,8 +10,10 @@ require (
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
 	github.com/phoenix-tui/phoenix/terminal v0.2.4 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/unilibs/uniwidth v0.2.0 // indirect
 	golang.org/x/sys v0.40.0 // indirect
 	golang.org/x/term v0.39.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -22,3 +24,5 @@ replace github.com/phoenix-tui/phoenix/terminal => ../terminal
 replace github.com/phoenix-tui/phoenix/tea => ../tea
 
 replace github.com/phoenix-tui/phoenix/testing => ../testing
+
+replace github.com/phoenix-tui/phoenix/core => ../core
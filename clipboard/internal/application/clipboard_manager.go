@@ -2,7 +2,11 @@
 package application
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
@@ -21,6 +25,8 @@ type ClipboardManager struct {
 	richTextCodec  *service2.RichTextCodec
 	history        *service2.ClipboardHistory
 	historyEnabled bool
+	historyDedup   bool         // Applied to every history created by EnableHistory
+	historyMu      sync.RWMutex // Guards history swaps seen by Watch goroutines
 }
 
 // NewClipboardManager creates a new clipboard manager with auto-detected providers.
@@ -82,6 +88,66 @@ func (m *ClipboardManager) Write(text string) error {
 	return nil
 }
 
+// ReadBest reads the richest format on the clipboard, tagged with its MIME type.
+func (m *ClipboardManager) ReadBest() (*model.ClipboardContent, error) {
+	return m.service.ReadBest()
+}
+
+// AvailableFormats returns the MIME types currently on the clipboard.
+func (m *ClipboardManager) AvailableFormats() ([]value.MIMEType, error) {
+	return m.service.AvailableFormats()
+}
+
+// ReadPrimary reads text from the primary selection.
+// Providers without a primary selection read the regular clipboard.
+func (m *ClipboardManager) ReadPrimary() (string, error) {
+	content, err := m.service.ReadSelection(value.SelectionPrimary)
+	if err != nil {
+		return "", err
+	}
+	return content.Text()
+}
+
+// WritePrimary writes text to the primary selection.
+// Providers without a primary selection write the regular clipboard.
+// If history is enabled, the content is added to history.
+func (m *ClipboardManager) WritePrimary(text string) error {
+	content, err := model.NewTextContent(text)
+	if err != nil {
+		return err
+	}
+	if err := m.service.WriteSelection(value.SelectionPrimary, content); err != nil {
+		return err
+	}
+
+	if m.historyEnabled && m.history != nil {
+		_ = m.history.Add([]byte(text), value.MIMETypePlainText)
+	}
+
+	return nil
+}
+
+// WriteFrom streams text from r to the clipboard.
+// If history is enabled, the content is also collected for the history entry.
+func (m *ClipboardManager) WriteFrom(r io.Reader) error {
+	if !m.historyEnabled || m.history == nil || r == nil {
+		return m.service.WriteFrom(r)
+	}
+
+	var recorded bytes.Buffer
+	if err := m.service.WriteFrom(io.TeeReader(r, &recorded)); err != nil {
+		return err
+	}
+	_ = m.history.Add(recorded.Bytes(), value.MIMETypePlainText)
+
+	return nil
+}
+
+// ReadTo streams the clipboard text to w.
+func (m *ClipboardManager) ReadTo(w io.Writer) error {
+	return m.service.ReadTo(w)
+}
+
 // IsAvailable returns true if clipboard is available.
 func (m *ClipboardManager) IsAvailable() bool {
 	return m.service.IsAvailable()
@@ -241,16 +307,68 @@ func (m *ClipboardManager) ConvertRTFToHTML(rtf string) (string, error) {
 	return m.richTextCodec.RTFToHTML(rtf)
 }
 
+// ConvertHTMLToMarkdown converts HTML content to Markdown format using the codec.
+func (m *ClipboardManager) ConvertHTMLToMarkdown(html string) (string, error) {
+	return m.richTextCodec.HTMLToMarkdown(html)
+}
+
+// ConvertMarkdownToHTML converts Markdown content to HTML format using the codec.
+func (m *ClipboardManager) ConvertMarkdownToHTML(markdown string) (string, error) {
+	return m.richTextCodec.MarkdownToHTML(markdown)
+}
+
 // EnableHistory enables clipboard history tracking with the given limits.
 // maxSize: maximum number of entries (0 = unlimited)
 // maxAge: maximum age of entries (0 = no expiration)
 func (m *ClipboardManager) EnableHistory(maxSize int, maxAge time.Duration) {
+	m.historyMu.Lock()
+	defer m.historyMu.Unlock()
 	m.history = service2.NewClipboardHistory(maxSize, maxAge)
+	m.history.SetDedup(m.historyDedup)
 	m.historyEnabled = true
 }
 
+// SetHistoryDedup enables or disables history deduplication: writing
+// content identical to an existing entry moves that entry to the front
+// instead of adding a new one. The setting also applies to history
+// enabled later.
+func (m *ClipboardManager) SetHistoryDedup(dedup bool) {
+	m.historyDedup = dedup
+	if m.history != nil {
+		m.history.SetDedup(dedup)
+	}
+}
+
+// PinHistoryEntry pins the history entry at index (as returned by
+// GetHistory, newest first). Pinned entries survive ClearHistory,
+// expiration, and size eviction.
+func (m *ClipboardManager) PinHistoryEntry(index int) error {
+	return m.setHistoryEntryPinned(index, true)
+}
+
+// UnpinHistoryEntry unpins the history entry at index (as returned by
+// GetHistory, newest first).
+func (m *ClipboardManager) UnpinHistoryEntry(index int) error {
+	return m.setHistoryEntryPinned(index, false)
+}
+
+// setHistoryEntryPinned pins or unpins the history entry at index.
+func (m *ClipboardManager) setHistoryEntryPinned(index int, pinned bool) error {
+	if !m.historyEnabled || m.history == nil {
+		return fmt.Errorf("clipboard history is not enabled")
+	}
+
+	entries := m.history.GetAll()
+	if index < 0 || index >= len(entries) {
+		return fmt.Errorf("history index %d out of range [0, %d)", index, len(entries))
+	}
+	return m.history.SetPinned(entries[index].ID(), pinned)
+}
+
 // DisableHistory disables clipboard history tracking.
 func (m *ClipboardManager) DisableHistory() {
+	m.historyMu.Lock()
+	defer m.historyMu.Unlock()
 	m.historyEnabled = false
 	m.history = nil
 }
@@ -287,6 +405,26 @@ func (m *ClipboardManager) GetRecentHistory(count int) []*model.HistoryEntry {
 	return m.history.GetRecent(count)
 }
 
+// SearchHistory returns the history entries whose content or MIME type
+// contains query, ignoring case, newest first.
+// Returns nil if history is not enabled.
+func (m *ClipboardManager) SearchHistory(query string) []*model.HistoryEntry {
+	if !m.historyEnabled || m.history == nil {
+		return nil
+	}
+	return m.history.Search(query)
+}
+
+// FilterHistory returns the history entries for which match returns true,
+// newest first.
+// Returns nil if history is not enabled.
+func (m *ClipboardManager) FilterHistory(match func(*model.HistoryEntry) bool) []*model.HistoryEntry {
+	if !m.historyEnabled || m.history == nil {
+		return nil
+	}
+	return m.history.Filter(match)
+}
+
 // ClearHistory removes all history entries.
 // Does nothing if history is not enabled.
 func (m *ClipboardManager) ClearHistory() {
@@ -322,3 +460,114 @@ func (m *ClipboardManager) RemoveExpiredHistory() int {
 	}
 	return m.history.RemoveExpired()
 }
+
+// Watch polls the clipboard every interval and sends an entry on the
+// returned channel whenever its content changes. The content present when
+// Watch is called is the baseline and is not reported; an emptied
+// clipboard is not reported either.
+//
+// If history is enabled, each change is also added to history under the
+// same ID. Changes made through this manager are already in history, so
+// the watcher reports the existing entry instead of adding a duplicate.
+//
+// Sends block until the entry is received; changes made meanwhile are
+// coalesced into the next poll. The channel is closed when ctx is done.
+// Returns an error if ctx is nil, interval is not positive, or no
+// provider is available.
+func (m *ClipboardManager) Watch(ctx context.Context, interval time.Duration) (<-chan *model.HistoryEntry, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("watch interval must be positive, got %v", interval)
+	}
+	if !m.service.IsAvailable() {
+		return nil, fmt.Errorf("no clipboard provider available")
+	}
+
+	last := m.readSnapshot()
+	events := make(chan *model.HistoryEntry)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current := m.readSnapshot()
+			if sameContent(current, last) {
+				continue
+			}
+			last = current
+			if current == nil {
+				continue
+			}
+
+			entry, err := m.recordWatched(current)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case events <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// readSnapshot reads the clipboard for Watch.
+// Read errors (such as an empty clipboard) are treated as no content.
+func (m *ClipboardManager) readSnapshot() *model.ClipboardContent {
+	content, err := m.service.Read()
+	if err != nil || content == nil || content.IsEmpty() {
+		return nil
+	}
+	return content
+}
+
+// recordWatched turns a watched change into a history entry, adding it
+// to history when enabled. If the newest history entry already holds the
+// same content (it was written through this manager), that entry is
+// returned instead.
+func (m *ClipboardManager) recordWatched(content *model.ClipboardContent) (*model.HistoryEntry, error) {
+	m.historyMu.RLock()
+	history := m.history
+	enabled := m.historyEnabled
+	m.historyMu.RUnlock()
+
+	if enabled && history != nil {
+		if recent := history.GetRecent(1); len(recent) == 1 &&
+			recent[0].MIMEType() == content.MIMEType() &&
+			bytes.Equal(recent[0].Content(), content.Data()) {
+			return recent[0], nil
+		}
+	}
+
+	entry, err := model.NewHistoryEntry(content.Data(), content.MIMEType())
+	if err != nil {
+		return nil, err
+	}
+	if enabled && history != nil {
+		return history.AddEntry(entry)
+	}
+	return entry, nil
+}
+
+// sameContent reports whether two clipboard snapshots hold the same data.
+func sameContent(a, b *model.ClipboardContent) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.MIMEType() == b.MIMEType() && bytes.Equal(a.Data(), b.Data())
+}
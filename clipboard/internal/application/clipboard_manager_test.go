@@ -1,8 +1,12 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/service"
@@ -269,3 +273,200 @@ func TestNewClipboardManager_ErrorPropagation(t *testing.T) {
 		t.Error("expected non-nil manager when no error")
 	}
 }
+
+// changingProvider is a provider whose content can be changed from a test
+// while a watcher reads it.
+type changingProvider struct {
+	mu   sync.Mutex
+	text string
+}
+
+func (p *changingProvider) Read() (*model.ClipboardContent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.text == "" {
+		return nil, fmt.Errorf("clipboard is empty")
+	}
+	return model.NewTextContent(p.text)
+}
+
+func (p *changingProvider) Write(content *model.ClipboardContent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.text = string(content.Data())
+	return nil
+}
+
+func (p *changingProvider) set(text string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.text = text
+}
+
+func (p *changingProvider) IsAvailable() bool { return true }
+func (p *changingProvider) Name() string      { return "changing" }
+
+func receiveEntry(t *testing.T, events <-chan *model.HistoryEntry) *model.HistoryEntry {
+	t.Helper()
+	select {
+	case entry, ok := <-events:
+		if !ok {
+			t.Fatal("watch channel closed unexpectedly")
+		}
+		return entry
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for clipboard change")
+		return nil
+	}
+}
+
+func TestClipboardManager_Watch(t *testing.T) {
+	provider := &changingProvider{text: "initial"}
+	manager, err := NewClipboardManagerWithProviders([]service.Provider{provider})
+	if err != nil {
+		t.Fatalf("NewClipboardManagerWithProviders() error = %v", err)
+	}
+	manager.EnableHistory(0, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := manager.Watch(ctx, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	// External change is reported and recorded
+	provider.set("external")
+	entry := receiveEntry(t, events)
+	if string(entry.Content()) != "external" {
+		t.Errorf("entry content = %q, want %q", entry.Content(), "external")
+	}
+	if _, err := manager.GetHistoryEntry(entry.ID()); err != nil {
+		t.Errorf("watched entry not in history: %v", err)
+	}
+
+	// Clearing the clipboard is not reported; the next change is
+	provider.set("")
+	time.Sleep(20 * time.Millisecond)
+	provider.set("again")
+	entry = receiveEntry(t, events)
+	if string(entry.Content()) != "again" {
+		t.Errorf("entry content = %q, want %q", entry.Content(), "again")
+	}
+
+	// Own writes are reported without duplicating history
+	if err := manager.Write("own"); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	entry = receiveEntry(t, events)
+	if string(entry.Content()) != "own" {
+		t.Errorf("entry content = %q, want %q", entry.Content(), "own")
+	}
+	if got := manager.GetHistorySize(); got != 3 {
+		t.Errorf("history size = %d, want 3", got)
+	}
+
+	// Cancellation closes the channel
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected channel to be closed after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Error("channel not closed after cancel")
+	}
+}
+
+func TestClipboardManager_Watch_Errors(t *testing.T) {
+	manager, _ := NewClipboardManagerWithProviders([]service.Provider{&changingProvider{}})
+
+	//nolint:staticcheck // SA1012: nil context is the case under test
+	if _, err := manager.Watch(nil, time.Second); err == nil {
+		t.Error("expected error for nil context")
+	}
+	if _, err := manager.Watch(context.Background(), 0); err == nil {
+		t.Error("expected error for zero interval")
+	}
+
+	unavailable, _ := NewClipboardManagerWithProviders([]service.Provider{&MockProvider{available: false}})
+	if _, err := unavailable.Watch(context.Background(), time.Second); err == nil {
+		t.Error("expected error when no provider is available")
+	}
+}
+
+func TestClipboardManager_WritePrimary_ReadPrimary(t *testing.T) {
+	provider := &changingProvider{}
+	manager, err := NewClipboardManagerWithProviders([]service.Provider{provider})
+	if err != nil {
+		t.Fatalf("NewClipboardManagerWithProviders() error = %v", err)
+	}
+	manager.EnableHistory(0, 0)
+
+	// Single-selection providers alias the primary selection to the clipboard
+	if err := manager.WritePrimary("selected"); err != nil {
+		t.Fatalf("WritePrimary() error = %v", err)
+	}
+	got, err := manager.ReadPrimary()
+	if err != nil {
+		t.Fatalf("ReadPrimary() error = %v", err)
+	}
+	if got != "selected" {
+		t.Errorf("ReadPrimary() = %q, want %q", got, "selected")
+	}
+	if size := manager.GetHistorySize(); size != 1 {
+		t.Errorf("history size = %d, want 1", size)
+	}
+}
+
+func TestClipboardManager_HistoryDedupAndPinning(t *testing.T) {
+	manager, err := NewClipboardManagerWithProviders([]service.Provider{&changingProvider{}})
+	if err != nil {
+		t.Fatalf("NewClipboardManagerWithProviders() error = %v", err)
+	}
+
+	// Dedup set before history is enabled still applies
+	manager.SetHistoryDedup(true)
+	manager.EnableHistory(0, 0)
+	_ = manager.Write("same")
+	_ = manager.Write("same")
+	if size := manager.GetHistorySize(); size != 1 {
+		t.Errorf("history size = %d, want 1", size)
+	}
+
+	if err := manager.PinHistoryEntry(0); err != nil {
+		t.Fatalf("PinHistoryEntry() error = %v", err)
+	}
+	if !manager.GetHistory()[0].IsPinned() {
+		t.Error("expected entry to be pinned")
+	}
+	if err := manager.UnpinHistoryEntry(-1); err == nil {
+		t.Error("expected error for negative index")
+	}
+}
+
+func TestClipboardManager_SearchHistory(t *testing.T) {
+	manager, err := NewClipboardManagerWithProviders([]service.Provider{&changingProvider{}})
+	if err != nil {
+		t.Fatalf("NewClipboardManagerWithProviders() error = %v", err)
+	}
+
+	if entries := manager.SearchHistory("x"); entries != nil {
+		t.Errorf("SearchHistory() = %v with history disabled, want nil", entries)
+	}
+	if entries := manager.FilterHistory(func(*model.HistoryEntry) bool { return true }); entries != nil {
+		t.Errorf("FilterHistory() = %v with history disabled, want nil", entries)
+	}
+
+	manager.EnableHistory(0, 0)
+	_ = manager.Write("Needle in a haystack")
+	_ = manager.Write("just hay")
+
+	if entries := manager.SearchHistory("NEEDLE"); len(entries) != 1 {
+		t.Errorf("SearchHistory() returned %d entries, want 1", len(entries))
+	}
+	if entries := manager.FilterHistory(func(*model.HistoryEntry) bool { return true }); len(entries) != 2 {
+		t.Errorf("FilterHistory() returned %d entries, want 2", len(entries))
+	}
+}
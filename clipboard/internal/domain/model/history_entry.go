@@ -17,6 +17,7 @@ type HistoryEntry struct {
 	mimeType  value2.MIMEType
 	timestamp time.Time
 	size      int
+	pinned    bool
 }
 
 // NewHistoryEntry creates a new history entry with the given content and MIME type.
@@ -94,11 +95,32 @@ func (h *HistoryEntry) MIMEType() value2.MIMEType {
 	return h.mimeType
 }
 
-// Timestamp returns the time when this entry was created.
+// Timestamp returns the time when this entry was created, or last copied
+// again if the history moved a duplicate to the front.
 func (h *HistoryEntry) Timestamp() time.Time {
 	return h.timestamp
 }
 
+// IsPinned returns true if the entry is pinned.
+// Pinned entries survive clearing, expiration, and size eviction.
+func (h *HistoryEntry) IsPinned() bool {
+	return h.pinned
+}
+
+// WithPinned returns a copy of the entry, keeping its ID, with the pinned flag set.
+func (h *HistoryEntry) WithPinned(pinned bool) *HistoryEntry {
+	result := *h
+	result.pinned = pinned
+	return &result
+}
+
+// WithTimestamp returns a copy of the entry, keeping its ID, with a new timestamp.
+func (h *HistoryEntry) WithTimestamp(timestamp time.Time) *HistoryEntry {
+	result := *h
+	result.timestamp = timestamp
+	return &result
+}
+
 // Size returns the size of the content in bytes.
 func (h *HistoryEntry) Size() int {
 	return h.size
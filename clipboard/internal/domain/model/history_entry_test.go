@@ -406,3 +406,26 @@ func TestHistoryEntry_Immutability(t *testing.T) {
 		assert.Equal(t, byte('t'), content2[0])
 	})
 }
+
+func TestHistoryEntry_WithPinned(t *testing.T) {
+	entry, err := NewHistoryEntry([]byte("pin me"), value.MIMETypePlainText)
+	require.NoError(t, err)
+	assert.False(t, entry.IsPinned())
+
+	pinned := entry.WithPinned(true)
+	assert.True(t, pinned.IsPinned())
+	assert.True(t, pinned.Equals(entry), "pinned copy keeps the ID")
+	assert.False(t, entry.IsPinned(), "original is unchanged")
+	assert.False(t, pinned.WithPinned(false).IsPinned())
+}
+
+func TestHistoryEntry_WithTimestamp(t *testing.T) {
+	entry, err := NewHistoryEntry([]byte("touch me"), value.MIMETypePlainText)
+	require.NoError(t, err)
+	later := entry.Timestamp().Add(time.Hour)
+
+	touched := entry.WithTimestamp(later)
+	assert.Equal(t, later, touched.Timestamp())
+	assert.True(t, touched.Equals(entry), "touched copy keeps the ID")
+	assert.NotEqual(t, later, entry.Timestamp(), "original is unchanged")
+}
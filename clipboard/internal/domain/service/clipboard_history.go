@@ -2,10 +2,13 @@
 package service
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
@@ -13,10 +16,12 @@ import (
 
 // ClipboardHistory is a domain service that manages clipboard history.
 // It maintains a FIFO list of clipboard entries with configurable size and age limits.
+// Pinned entries are exempt from eviction, expiration, and Clear.
 type ClipboardHistory struct {
 	entries []*model.HistoryEntry
 	maxSize int
 	maxAge  time.Duration
+	dedup   bool // Move identical content to the front instead of adding it again
 	mu      sync.RWMutex
 }
 
@@ -39,7 +44,8 @@ func NewClipboardHistory(maxSize int, maxAge time.Duration) *ClipboardHistory {
 }
 
 // Add adds a new entry to the history.
-// If maxSize is exceeded, the oldest entry is removed (FIFO).
+// If maxSize is exceeded, the oldest unpinned entry is removed (FIFO).
+// With dedup enabled, identical content moves the existing entry to the front.
 // Returns the created entry or an error.
 func (h *ClipboardHistory) Add(content []byte, mimeType value.MIMEType) error {
 	h.mu.Lock()
@@ -51,16 +57,61 @@ func (h *ClipboardHistory) Add(content []byte, mimeType value.MIMEType) error {
 		return fmt.Errorf("failed to create history entry: %w", err)
 	}
 
-	// Add to history
-	h.entries = append(h.entries, entry)
+	h.insert(entry)
+	return nil
+}
 
-	// Enforce max size (FIFO eviction)
-	if h.maxSize > 0 && len(h.entries) > h.maxSize {
-		// Remove oldest entry
-		h.entries = h.entries[1:]
+// AddEntry adds an existing entry to the history, keeping its ID.
+// If maxSize is exceeded, the oldest unpinned entry is removed (FIFO).
+// Returns the stored entry: with dedup enabled and identical content
+// already present, that is the existing entry, moved to the front.
+func (h *ClipboardHistory) AddEntry(entry *model.HistoryEntry) (*model.HistoryEntry, error) {
+	if entry == nil {
+		return nil, fmt.Errorf("history entry cannot be nil")
 	}
 
-	return nil
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.insert(entry), nil
+}
+
+// insert adds entry, merging it into an identical entry when dedup is
+// enabled, and enforces maxSize. The caller must hold h.mu.
+func (h *ClipboardHistory) insert(entry *model.HistoryEntry) *model.HistoryEntry {
+	if h.dedup {
+		for i, existing := range h.entries {
+			if existing.MIMEType() == entry.MIMEType() && bytes.Equal(existing.Content(), entry.Content()) {
+				// Keep the existing ID and pin, take the new timestamp
+				entry = existing.WithTimestamp(entry.Timestamp())
+				h.entries = append(h.entries[:i], h.entries[i+1:]...)
+				break
+			}
+		}
+	}
+
+	h.entries = append(h.entries, entry)
+	h.evict()
+	return entry
+}
+
+// evict removes the oldest unpinned entries until maxSize is respected.
+// If every entry is pinned the history may exceed maxSize.
+// The caller must hold h.mu.
+func (h *ClipboardHistory) evict() {
+	for h.maxSize > 0 && len(h.entries) > h.maxSize {
+		oldest := -1
+		for i, entry := range h.entries {
+			if !entry.IsPinned() {
+				oldest = i
+				break
+			}
+		}
+		if oldest < 0 {
+			return
+		}
+		h.entries = append(h.entries[:oldest], h.entries[oldest+1:]...)
+	}
 }
 
 // Get returns the entry with the given ID.
@@ -144,15 +195,96 @@ func (h *ClipboardHistory) GetByMIMEType(mimeType value.MIMEType) []*model.Histo
 	return result
 }
 
-// Clear removes all entries from the history.
+// Search returns the entries whose MIME type or text content contains
+// query, ignoring case (Unicode simple case folding). Content that is not
+// valid UTF-8, such as images, only matches on its MIME type. An empty
+// query matches every entry.
+// Results are sorted by timestamp (newest first).
+func (h *ClipboardHistory) Search(query string) []*model.HistoryEntry {
+	return h.Filter(func(entry *model.HistoryEntry) bool {
+		if containsFold(entry.MIMEType().String(), query) {
+			return true
+		}
+		content := entry.Content()
+		return utf8.Valid(content) && containsFold(string(content), query)
+	})
+}
+
+// Filter returns the entries for which match returns true.
+// match must not modify the history; it is called with the lock held.
+// Results are sorted by timestamp (newest first).
+func (h *ClipboardHistory) Filter(match func(*model.HistoryEntry) bool) []*model.HistoryEntry {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var result []*model.HistoryEntry
+	if match == nil {
+		return result
+	}
+
+	for _, entry := range h.entries {
+		if match(entry) {
+			result = append(result, entry)
+		}
+	}
+
+	// Sort by timestamp (newest first)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp().After(result[j].Timestamp())
+	})
+
+	return result
+}
+
+// Clear removes all unpinned entries from the history.
 func (h *ClipboardHistory) Clear() {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	h.entries = make([]*model.HistoryEntry, 0, h.maxSize)
+	kept := make([]*model.HistoryEntry, 0, h.maxSize)
+	for _, entry := range h.entries {
+		if entry.IsPinned() {
+			kept = append(kept, entry)
+		}
+	}
+	h.entries = kept
+}
+
+// SetPinned pins or unpins the entry with the given ID.
+// Returns an error if no entry has that ID.
+func (h *ClipboardHistory) SetPinned(id string, pinned bool) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, entry := range h.entries {
+		if entry.ID() == id {
+			h.entries[i] = entry.WithPinned(pinned)
+			h.evict()
+			return nil
+		}
+	}
+	return fmt.Errorf("history entry not found: %s", id)
 }
 
-// RemoveExpired removes all entries older than maxAge.
+// SetDedup enables or disables deduplication. With dedup enabled, adding
+// content identical to an existing entry moves that entry to the front
+// instead of adding a new one. Existing duplicates are left in place.
+func (h *ClipboardHistory) SetDedup(dedup bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.dedup = dedup
+}
+
+// Dedup returns true if deduplication is enabled.
+func (h *ClipboardHistory) Dedup() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.dedup
+}
+
+// RemoveExpired removes all unpinned entries older than maxAge.
 // If maxAge is 0 or negative, no entries are removed.
 // Returns the number of entries removed.
 func (h *ClipboardHistory) RemoveExpired() int {
@@ -167,7 +299,7 @@ func (h *ClipboardHistory) RemoveExpired() int {
 	removed := 0
 
 	for _, entry := range h.entries {
-		if !entry.IsExpired(h.maxAge) {
+		if entry.IsPinned() || !entry.IsExpired(h.maxAge) {
 			kept = append(kept, entry)
 		} else {
 			removed++
@@ -217,7 +349,7 @@ func (h *ClipboardHistory) MaxAge() time.Duration {
 }
 
 // SetMaxSize updates the maximum number of entries.
-// If the new size is smaller than the current size, oldest entries are removed.
+// If the new size is smaller than the current size, the oldest unpinned entries are removed.
 func (h *ClipboardHistory) SetMaxSize(maxSize int) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -229,10 +361,7 @@ func (h *ClipboardHistory) SetMaxSize(maxSize int) {
 	h.maxSize = maxSize
 
 	// Enforce new size limit
-	if h.maxSize > 0 && len(h.entries) > h.maxSize {
-		// Remove oldest entries
-		h.entries = h.entries[len(h.entries)-h.maxSize:]
-	}
+	h.evict()
 }
 
 // SetMaxAge updates the maximum age of entries.
@@ -268,3 +397,28 @@ func (h *ClipboardHistory) Contains(id string) bool {
 	}
 	return false
 }
+
+// containsFold reports whether substr is within s, ignoring case.
+// Simple case folding maps rune to rune, so candidates are compared a
+// window of the same rune count at a time.
+func containsFold(s, substr string) bool {
+	if substr == "" {
+		return true
+	}
+
+	n := utf8.RuneCountInString(substr)
+	for i := range s {
+		end := i
+		for count := 0; count < n && end < len(s); count++ {
+			_, size := utf8.DecodeRuneInString(s[end:])
+			end += size
+		}
+		if strings.EqualFold(s[i:end], substr) {
+			return true
+		}
+		if end == len(s) {
+			break
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -120,6 +121,43 @@ func TestClipboardHistory_Add(t *testing.T) {
 	})
 }
 
+func TestClipboardHistory_AddEntry(t *testing.T) {
+	t.Run("keeps the entry ID", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		entry, err := model.NewHistoryEntry([]byte("watched"), value.MIMETypePlainText)
+		require.NoError(t, err)
+
+		stored, err := history.AddEntry(entry)
+		require.NoError(t, err)
+		assert.Equal(t, entry.ID(), stored.ID())
+
+		got, err := history.Get(entry.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []byte("watched"), got.Content())
+	})
+
+	t.Run("enforces max size", func(t *testing.T) {
+		history := NewClipboardHistory(1, 0)
+		first, _ := model.NewHistoryEntry([]byte("first"), value.MIMETypePlainText)
+		second, _ := model.NewHistoryEntry([]byte("second"), value.MIMETypePlainText)
+
+		_, err := history.AddEntry(first)
+		require.NoError(t, err)
+		_, err = history.AddEntry(second)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, history.Size())
+		assert.False(t, history.Contains(first.ID()))
+		assert.True(t, history.Contains(second.ID()))
+	})
+
+	t.Run("rejects nil entry", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		_, err := history.AddEntry(nil)
+		assert.Error(t, err)
+	})
+}
+
 func TestClipboardHistory_Get(t *testing.T) {
 	t.Run("gets entry by ID", func(t *testing.T) {
 		history := NewClipboardHistory(100, 24*time.Hour)
@@ -339,6 +377,88 @@ func TestClipboardHistory_GetByMIMEType(t *testing.T) {
 	})
 }
 
+func TestClipboardHistory_Search(t *testing.T) {
+	history := NewClipboardHistory(100, 24*time.Hour)
+
+	require.NoError(t, history.Add([]byte("Hello World"), value.MIMETypePlainText))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, history.Add([]byte("<p>Straße</p>"), value.MIMETypeHTML))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, history.Add([]byte{0x89, 'P', 'N', 'G', 0xff, 0xfe}, value.MIMETypeImagePNG))
+
+	t.Run("matches content case-insensitively", func(t *testing.T) {
+		entries := history.Search("hello WORLD")
+		require.Len(t, entries, 1)
+		text, _ := entries[0].Text()
+		assert.Equal(t, "Hello World", text)
+	})
+
+	t.Run("folds non-ASCII letters", func(t *testing.T) {
+		assert.Len(t, history.Search("STRASSE"), 0, "simple folding does not expand ß")
+		assert.Len(t, history.Search("STRAßE"), 1)
+	})
+
+	t.Run("matches MIME type", func(t *testing.T) {
+		entries := history.Search("image/")
+		require.Len(t, entries, 1)
+		assert.Equal(t, value.MIMETypeImagePNG, entries[0].MIMEType())
+	})
+
+	t.Run("skips binary content", func(t *testing.T) {
+		assert.Empty(t, history.Search("PNG\xff"))
+		assert.Len(t, history.Search("png"), 1, "matches on MIME type only")
+	})
+
+	t.Run("empty query returns everything newest first", func(t *testing.T) {
+		entries := history.Search("")
+		require.Len(t, entries, 3)
+		assert.Equal(t, value.MIMETypeImagePNG, entries[0].MIMEType())
+		assert.Equal(t, value.MIMETypePlainText, entries[2].MIMEType())
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.Empty(t, history.Search("missing"))
+	})
+}
+
+func TestClipboardHistory_Filter(t *testing.T) {
+	history := NewClipboardHistory(100, 24*time.Hour)
+
+	require.NoError(t, history.Add([]byte("short"), value.MIMETypePlainText))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, history.Add([]byte("a much longer entry"), value.MIMETypePlainText))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, history.Add([]byte("longer still, and newest"), value.MIMETypePlainText))
+
+	entries := history.Filter(func(entry *model.HistoryEntry) bool {
+		return entry.Size() > 10
+	})
+	require.Len(t, entries, 2)
+	text, _ := entries[0].Text()
+	assert.Equal(t, "longer still, and newest", text)
+
+	assert.Empty(t, history.Filter(nil))
+}
+
+func TestContainsFold(t *testing.T) {
+	tests := []struct {
+		s, substr string
+		want      bool
+	}{
+		{"Hello", "", true},
+		{"Hello", "ELL", true},
+		{"Hello", "lo", true},
+		{"Hello", "low", false},
+		{"ÀÉÎ", "éî", true},
+		{"ΣΑΣ", "σας", true},
+		{"", "a", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, containsFold(tt.s, tt.substr), "containsFold(%q, %q)", tt.s, tt.substr)
+	}
+}
+
 func TestClipboardHistory_Clear(t *testing.T) {
 	t.Run("removes all entries", func(t *testing.T) {
 		history := NewClipboardHistory(100, 24*time.Hour)
@@ -657,3 +777,113 @@ func TestClipboardHistory_Concurrency(t *testing.T) {
 		assert.Greater(t, history.Size(), 0)
 	})
 }
+
+func TestClipboardHistory_Dedup(t *testing.T) {
+	t.Run("moves duplicate to the front", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		history.SetDedup(true)
+		assert.True(t, history.Dedup())
+
+		require.NoError(t, history.Add([]byte("first"), value.MIMETypePlainText))
+		time.Sleep(2 * time.Millisecond)
+		require.NoError(t, history.Add([]byte("second"), value.MIMETypePlainText))
+		firstID := history.GetRecent(0)[1].ID()
+		time.Sleep(2 * time.Millisecond)
+		require.NoError(t, history.Add([]byte("first"), value.MIMETypePlainText))
+
+		entries := history.GetAll()
+		require.Len(t, entries, 2)
+		assert.Equal(t, []byte("first"), entries[0].Content())
+		assert.Equal(t, firstID, entries[0].ID(), "existing entry keeps its ID")
+		assert.Equal(t, []byte("second"), entries[1].Content())
+	})
+
+	t.Run("different MIME types are distinct", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		history.SetDedup(true)
+
+		require.NoError(t, history.Add([]byte("<b>x</b>"), value.MIMETypePlainText))
+		require.NoError(t, history.Add([]byte("<b>x</b>"), value.MIMETypeHTML))
+
+		assert.Equal(t, 2, history.Size())
+	})
+
+	t.Run("disabled keeps duplicates", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+
+		require.NoError(t, history.Add([]byte("same"), value.MIMETypePlainText))
+		require.NoError(t, history.Add([]byte("same"), value.MIMETypePlainText))
+
+		assert.Equal(t, 2, history.Size())
+	})
+
+	t.Run("AddEntry returns the existing entry", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		history.SetDedup(true)
+		first, _ := model.NewHistoryEntry([]byte("same"), value.MIMETypePlainText)
+		second, _ := model.NewHistoryEntry([]byte("same"), value.MIMETypePlainText)
+
+		_, err := history.AddEntry(first)
+		require.NoError(t, err)
+		stored, err := history.AddEntry(second)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.ID(), stored.ID())
+		assert.Equal(t, 1, history.Size())
+	})
+}
+
+func TestClipboardHistory_Pinning(t *testing.T) {
+	t.Run("pinned entries survive clear", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		keep, _ := model.NewHistoryEntry([]byte("keep"), value.MIMETypePlainText)
+		_, _ = history.AddEntry(keep)
+		require.NoError(t, history.Add([]byte("drop"), value.MIMETypePlainText))
+
+		require.NoError(t, history.SetPinned(keep.ID(), true))
+		history.Clear()
+
+		entries := history.GetAll()
+		require.Len(t, entries, 1)
+		assert.Equal(t, keep.ID(), entries[0].ID())
+		assert.True(t, entries[0].IsPinned())
+	})
+
+	t.Run("pinned entries survive expiration", func(t *testing.T) {
+		history := NewClipboardHistory(0, time.Minute)
+		old, _ := model.NewHistoryEntryWithTime([]byte("old"), value.MIMETypePlainText, time.Now().Add(-time.Hour))
+		older, _ := model.NewHistoryEntryWithTime([]byte("older"), value.MIMETypePlainText, time.Now().Add(-2*time.Hour))
+		_, _ = history.AddEntry(old)
+		_, _ = history.AddEntry(older)
+		require.NoError(t, history.SetPinned(old.ID(), true))
+
+		assert.Equal(t, 1, history.RemoveExpired())
+		assert.True(t, history.Contains(old.ID()))
+	})
+
+	t.Run("eviction skips pinned entries", func(t *testing.T) {
+		history := NewClipboardHistory(2, 0)
+		first, _ := model.NewHistoryEntry([]byte("first"), value.MIMETypePlainText)
+		_, _ = history.AddEntry(first)
+		require.NoError(t, history.SetPinned(first.ID(), true))
+
+		require.NoError(t, history.Add([]byte("second"), value.MIMETypePlainText))
+		require.NoError(t, history.Add([]byte("third"), value.MIMETypePlainText))
+
+		assert.Equal(t, 2, history.Size())
+		assert.True(t, history.Contains(first.ID()))
+	})
+
+	t.Run("unpin and unknown ID", func(t *testing.T) {
+		history := NewClipboardHistory(0, 0)
+		entry, _ := model.NewHistoryEntry([]byte("x"), value.MIMETypePlainText)
+		_, _ = history.AddEntry(entry)
+
+		require.NoError(t, history.SetPinned(entry.ID(), true))
+		require.NoError(t, history.SetPinned(entry.ID(), false))
+		history.Clear()
+
+		assert.True(t, history.IsEmpty())
+		assert.Error(t, history.SetPinned("missing", true))
+	})
+}
@@ -3,8 +3,10 @@ package service
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // ClipboardService provides domain logic for clipboard operations.
@@ -47,6 +49,93 @@ func (s *ClipboardService) Write(content *model.ClipboardContent) error {
 	return provider.Write(content)
 }
 
+// AvailableFormats returns the MIME types currently on the clipboard.
+// FormatProviders list them directly; for other providers the content is
+// read and its type detected, so at most one format is returned.
+func (s *ClipboardService) AvailableFormats() ([]value.MIMEType, error) {
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("no clipboard provider available")
+	}
+
+	if lister, ok := provider.(FormatProvider); ok {
+		return lister.AvailableFormats()
+	}
+
+	content, err := provider.Read()
+	if err != nil {
+		return nil, err
+	}
+	return []value.MIMEType{DetectContentType(content.Data())}, nil
+}
+
+// ReadBest reads the richest format on the clipboard (images, then HTML,
+// then RTF, then plain text) and returns it tagged with its MIME type.
+// Providers that are not FormatProviders expose a single content, whose
+// type is detected from its bytes.
+func (s *ClipboardService) ReadBest() (*model.ClipboardContent, error) {
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("no clipboard provider available")
+	}
+
+	if lister, ok := provider.(FormatProvider); ok {
+		if formats, err := lister.AvailableFormats(); err == nil {
+			if best, ok := RichestFormat(formats); ok {
+				return lister.ReadFormat(best)
+			}
+		}
+	}
+
+	content, err := provider.Read()
+	if err != nil {
+		return nil, err
+	}
+	detected := DetectContentType(content.Data())
+	return content.WithMIMEType(detected).WithEncoding(encodingFor(detected)), nil
+}
+
+// ReadSelection reads content from the given selection.
+// Providers that are not SelectionProviders have a single clipboard, so
+// every selection reads from it.
+func (s *ClipboardService) ReadSelection(selection value.Selection) (*model.ClipboardContent, error) {
+	if !selection.IsValid() {
+		return nil, fmt.Errorf("unknown selection: %q", selection)
+	}
+
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("no clipboard provider available")
+	}
+
+	if selector, ok := provider.(SelectionProvider); ok {
+		return selector.ReadSelection(selection)
+	}
+	return provider.Read()
+}
+
+// WriteSelection writes content to the given selection.
+// Providers that are not SelectionProviders have a single clipboard, so
+// every selection writes to it.
+func (s *ClipboardService) WriteSelection(selection value.Selection, content *model.ClipboardContent) error {
+	if !selection.IsValid() {
+		return fmt.Errorf("unknown selection: %q", selection)
+	}
+	if content == nil {
+		return fmt.Errorf("content cannot be nil")
+	}
+
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return fmt.Errorf("no clipboard provider available")
+	}
+
+	if selector, ok := provider.(SelectionProvider); ok {
+		return selector.WriteSelection(selection, content)
+	}
+	return provider.Write(content)
+}
+
 // ReadText reads text content from the clipboard.
 func (s *ClipboardService) ReadText() (string, error) {
 	content, err := s.Read()
@@ -67,6 +156,60 @@ func (s *ClipboardService) WriteText(text string) error {
 	return s.Write(content)
 }
 
+// WriteFrom writes everything read from r to the clipboard as text.
+// StreamProviders receive the data as it is read; other providers get it
+// in one buffer once r is exhausted.
+func (s *ClipboardService) WriteFrom(r io.Reader) error {
+	if r == nil {
+		return fmt.Errorf("reader cannot be nil")
+	}
+
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return fmt.Errorf("no clipboard provider available")
+	}
+
+	if streamer, ok := provider.(StreamProvider); ok {
+		return streamer.WriteFrom(r)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+	content, err := model.NewTextContent(string(data))
+	if err != nil {
+		return err
+	}
+	return provider.Write(content)
+}
+
+// ReadTo copies the clipboard content to w. StreamProviders copy it as
+// it arrives; other providers read it in one buffer first.
+func (s *ClipboardService) ReadTo(w io.Writer) error {
+	if w == nil {
+		return fmt.Errorf("writer cannot be nil")
+	}
+
+	provider := s.getAvailableProvider()
+	if provider == nil {
+		return fmt.Errorf("no clipboard provider available")
+	}
+
+	if streamer, ok := provider.(StreamProvider); ok {
+		return streamer.ReadTo(w)
+	}
+
+	content, err := provider.Read()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(content.Data()); err != nil {
+		return fmt.Errorf("failed to write content: %w", err)
+	}
+	return nil
+}
+
 // IsAvailable returns true if any provider is available.
 func (s *ClipboardService) IsAvailable() bool {
 	return s.getAvailableProvider() != nil
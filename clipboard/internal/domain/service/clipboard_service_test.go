@@ -1,10 +1,15 @@
 package service
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // MockProvider is a mock implementation of Provider for testing.
@@ -495,3 +500,272 @@ func mustNewTextContent(text string) *model.ClipboardContent {
 	}
 	return content
 }
+
+// MockStreamProvider is a mock StreamProvider that records streamed data.
+type MockStreamProvider struct {
+	MockProvider
+	streamed bytes.Buffer
+}
+
+func (m *MockStreamProvider) WriteFrom(r io.Reader) error {
+	_, err := io.Copy(&m.streamed, r)
+	return err
+}
+
+func (m *MockStreamProvider) ReadTo(w io.Writer) error {
+	_, err := w.Write(m.streamed.Bytes())
+	return err
+}
+
+func TestClipboardService_WriteFrom_ReadTo(t *testing.T) {
+	t.Run("stream provider", func(t *testing.T) {
+		provider := &MockStreamProvider{
+			MockProvider: MockProvider{
+				name:      "stream",
+				available: true,
+				writeFunc: func(*model.ClipboardContent) error {
+					t.Error("Write should not be used for a stream provider")
+					return nil
+				},
+			},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		if err := svc.WriteFrom(strings.NewReader("streamed ✓")); err != nil {
+			t.Fatalf("WriteFrom() error = %v", err)
+		}
+		var out strings.Builder
+		if err := svc.ReadTo(&out); err != nil {
+			t.Fatalf("ReadTo() error = %v", err)
+		}
+		if out.String() != "streamed ✓" {
+			t.Errorf("ReadTo() = %q, want %q", out.String(), "streamed ✓")
+		}
+	})
+
+	t.Run("buffered provider", func(t *testing.T) {
+		var written string
+		provider := &MockProvider{
+			name:      "buffered",
+			available: true,
+			writeFunc: func(content *model.ClipboardContent) error {
+				written, _ = content.Text()
+				return nil
+			},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		if err := svc.WriteFrom(strings.NewReader("line 1\nline 2\n")); err != nil {
+			t.Fatalf("WriteFrom() error = %v", err)
+		}
+		if written != "line 1\nline 2\n" {
+			t.Errorf("provider got %q", written)
+		}
+
+		var out strings.Builder
+		if err := svc.ReadTo(&out); err != nil || out.String() != "mock data" {
+			t.Errorf("ReadTo() = %q, %v; want %q", out.String(), err, "mock data")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		svc, _ := NewClipboardService([]Provider{&MockProvider{name: "off", available: false}})
+		if err := svc.WriteFrom(strings.NewReader("x")); err == nil {
+			t.Error("expected error without an available provider")
+		}
+		if err := svc.ReadTo(io.Discard); err == nil {
+			t.Error("expected error without an available provider")
+		}
+		if err := svc.WriteFrom(nil); err == nil {
+			t.Error("expected error for nil reader")
+		}
+		if err := svc.ReadTo(nil); err == nil {
+			t.Error("expected error for nil writer")
+		}
+
+		failing := &MockProvider{name: "mock", available: true}
+		svc, _ = NewClipboardService([]Provider{failing})
+		if err := svc.WriteFrom(iotest.ErrReader(fmt.Errorf("disk error"))); err == nil {
+			t.Error("expected reader error to be returned")
+		}
+	})
+}
+
+// MockSelectionProvider is a mock SelectionProvider with separate selections.
+type MockSelectionProvider struct {
+	MockProvider
+	selections map[value.Selection]*model.ClipboardContent
+}
+
+func (m *MockSelectionProvider) ReadSelection(selection value.Selection) (*model.ClipboardContent, error) {
+	content, ok := m.selections[selection]
+	if !ok {
+		return nil, fmt.Errorf("%s is empty", selection)
+	}
+	return content, nil
+}
+
+func (m *MockSelectionProvider) WriteSelection(selection value.Selection, content *model.ClipboardContent) error {
+	m.selections[selection] = content
+	return nil
+}
+
+func TestClipboardService_ReadSelection_WriteSelection(t *testing.T) {
+	t.Run("selection provider keeps selections apart", func(t *testing.T) {
+		provider := &MockSelectionProvider{
+			MockProvider: MockProvider{name: "x11", available: true},
+			selections:   map[value.Selection]*model.ClipboardContent{},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		primary, _ := model.NewTextContent("selected")
+		clipboard, _ := model.NewTextContent("copied")
+		if err := svc.WriteSelection(value.SelectionPrimary, primary); err != nil {
+			t.Fatalf("WriteSelection(primary) error = %v", err)
+		}
+		if err := svc.WriteSelection(value.SelectionClipboard, clipboard); err != nil {
+			t.Fatalf("WriteSelection(clipboard) error = %v", err)
+		}
+
+		got, err := svc.ReadSelection(value.SelectionPrimary)
+		if err != nil {
+			t.Fatalf("ReadSelection(primary) error = %v", err)
+		}
+		if text, _ := got.Text(); text != "selected" {
+			t.Errorf("primary = %q, want %q", text, "selected")
+		}
+	})
+
+	t.Run("other providers alias to the clipboard", func(t *testing.T) {
+		var written string
+		provider := &MockProvider{
+			name:      "single",
+			available: true,
+			writeFunc: func(content *model.ClipboardContent) error {
+				written, _ = content.Text()
+				return nil
+			},
+			readFunc: func() (*model.ClipboardContent, error) {
+				return model.NewTextContent("shared")
+			},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		content, _ := model.NewTextContent("to primary")
+		if err := svc.WriteSelection(value.SelectionPrimary, content); err != nil {
+			t.Fatalf("WriteSelection() error = %v", err)
+		}
+		if written != "to primary" {
+			t.Errorf("provider got %q, want %q", written, "to primary")
+		}
+
+		got, err := svc.ReadSelection(value.SelectionPrimary)
+		if err != nil {
+			t.Fatalf("ReadSelection() error = %v", err)
+		}
+		if text, _ := got.Text(); text != "shared" {
+			t.Errorf("ReadSelection() = %q, want %q", text, "shared")
+		}
+	})
+
+	t.Run("invalid selection", func(t *testing.T) {
+		svc, _ := NewClipboardService([]Provider{&MockProvider{name: "mock", available: true}})
+		content, _ := model.NewTextContent("x")
+
+		if _, err := svc.ReadSelection("secondary"); err == nil {
+			t.Error("expected error for unknown selection")
+		}
+		if err := svc.WriteSelection("secondary", content); err == nil {
+			t.Error("expected error for unknown selection")
+		}
+		if err := svc.WriteSelection(value.SelectionPrimary, nil); err == nil {
+			t.Error("expected error for nil content")
+		}
+	})
+}
+
+// MockFormatProvider is a mock FormatProvider offering several formats.
+type MockFormatProvider struct {
+	MockProvider
+	formats map[value.MIMEType]string
+	order   []value.MIMEType
+}
+
+func (m *MockFormatProvider) AvailableFormats() ([]value.MIMEType, error) {
+	return m.order, nil
+}
+
+func (m *MockFormatProvider) ReadFormat(mimeType value.MIMEType) (*model.ClipboardContent, error) {
+	data, ok := m.formats[mimeType]
+	if !ok {
+		return nil, fmt.Errorf("no %s content", mimeType)
+	}
+	return model.NewClipboardContent([]byte(data), mimeType, value.EncodingUTF8)
+}
+
+func TestClipboardService_ReadBest(t *testing.T) {
+	t.Run("format provider", func(t *testing.T) {
+		provider := &MockFormatProvider{
+			MockProvider: MockProvider{name: "formats", available: true},
+			formats: map[value.MIMEType]string{
+				value.MIMETypePlainText: "Bold",
+				value.MIMETypeHTML:      "<b>Bold</b>",
+			},
+			order: []value.MIMEType{value.MIMETypePlainText, value.MIMETypeHTML},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		formats, err := svc.AvailableFormats()
+		if err != nil {
+			t.Fatalf("AvailableFormats() error = %v", err)
+		}
+		if len(formats) != 2 {
+			t.Errorf("AvailableFormats() = %v, want 2 formats", formats)
+		}
+
+		content, err := svc.ReadBest()
+		if err != nil {
+			t.Fatalf("ReadBest() error = %v", err)
+		}
+		if content.MIMEType() != value.MIMETypeHTML || string(content.Data()) != "<b>Bold</b>" {
+			t.Errorf("ReadBest() = %s %q, want HTML", content.MIMEType(), content.Data())
+		}
+	})
+
+	t.Run("single content is detected", func(t *testing.T) {
+		provider := &MockProvider{
+			name:      "single",
+			available: true,
+			readFunc: func() (*model.ClipboardContent, error) {
+				return model.NewTextContent(`{\rtf1\ansi Hello}`)
+			},
+		}
+		svc, _ := NewClipboardService([]Provider{provider})
+
+		formats, err := svc.AvailableFormats()
+		if err != nil {
+			t.Fatalf("AvailableFormats() error = %v", err)
+		}
+		if len(formats) != 1 || formats[0] != value.MIMETypeRTF {
+			t.Errorf("AvailableFormats() = %v, want [text/rtf]", formats)
+		}
+
+		content, err := svc.ReadBest()
+		if err != nil {
+			t.Fatalf("ReadBest() error = %v", err)
+		}
+		if content.MIMEType() != value.MIMETypeRTF {
+			t.Errorf("ReadBest() MIME type = %s, want text/rtf", content.MIMEType())
+		}
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		svc, _ := NewClipboardService([]Provider{&MockProvider{available: false}})
+		if _, err := svc.ReadBest(); err == nil {
+			t.Error("expected error with no available provider")
+		}
+		if _, err := svc.AvailableFormats(); err == nil {
+			t.Error("expected error with no available provider")
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
+)
+
+// formatPreference lists the formats ReadBest understands, richest first.
+// Images win over markup because an image copied from a browser also
+// carries an HTML <img> fallback, not the other way around.
+var formatPreference = []value.MIMEType{
+	value.MIMETypeImagePNG,
+	value.MIMETypeImageJPEG,
+	value.MIMETypeImageGIF,
+	value.MIMETypeImageBMP,
+	value.MIMETypeHTML,
+	value.MIMETypeRTF,
+	value.MIMETypePlainText,
+}
+
+// htmlTagRegex matches a leading HTML document or element tag.
+var htmlTagRegex = regexp.MustCompile(`(?i)^<(!doctype\s+html|html|head|body|meta|div|span|p|a|b|i|u|em|strong|br|img|table|ul|ol|li|h[1-6]|pre|code)[\s>/]`)
+
+// DetectContentType guesses the MIME type of clipboard data from its bytes.
+//
+// Valid UTF-8 is text: RTF if it starts with an RTF header, HTML if it
+// starts with a common HTML tag, plain text otherwise. Anything else is an
+// image if it carries PNG, JPEG, GIF or BMP magic bytes, or binary.
+func DetectContentType(data []byte) value.MIMEType {
+	if utf8.Valid(data) {
+		text := strings.TrimSpace(string(data))
+		switch {
+		case strings.HasPrefix(text, "{\\rtf"):
+			return value.MIMETypeRTF
+		case htmlTagRegex.MatchString(text):
+			return value.MIMETypeHTML
+		default:
+			return value.MIMETypePlainText
+		}
+	}
+
+	if mimeType, err := NewImageCodec().DetectFormat(data); err == nil {
+		return mimeType
+	}
+	return value.MIMETypeBinary
+}
+
+// RichestFormat returns the richest of formats according to the order
+// ReadBest uses, and false if none of them is a format it understands.
+func RichestFormat(formats []value.MIMEType) (value.MIMEType, bool) {
+	for _, preferred := range formatPreference {
+		for _, format := range formats {
+			if format == preferred {
+				return format, true
+			}
+		}
+	}
+	return "", false
+}
+
+// encodingFor returns the content encoding used for data of mimeType.
+func encodingFor(mimeType value.MIMEType) value.Encoding {
+	if mimeType.IsText() {
+		return value.EncodingUTF8
+	}
+	return value.EncodingBinary
+}
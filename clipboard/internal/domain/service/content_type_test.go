@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
+)
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want value.MIMEType
+	}{
+		{"plain text", []byte("Hello, World!"), value.MIMETypePlainText},
+		{"text starting with BM", []byte("BMW is a car"), value.MIMETypePlainText},
+		{"text with angle brackets", []byte("<not a tag> 1 < 2"), value.MIMETypePlainText},
+		{"html fragment", []byte("<b>Bold</b> text"), value.MIMETypeHTML},
+		{"html document", []byte("  <!DOCTYPE html><html><body>x</body></html>"), value.MIMETypeHTML},
+		{"rtf", []byte(`{\rtf1\ansi Hello}`), value.MIMETypeRTF},
+		{"png", []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00}, value.MIMETypeImagePNG},
+		{"jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}, value.MIMETypeImageJPEG},
+		{"gif", []byte{'G', 'I', 'F', '8', '9', 'a', 0xFF, 0x00}, value.MIMETypeImageGIF},
+		{"binary", []byte{0x00, 0xFF, 0xFE, 0x01}, value.MIMETypeBinary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectContentType(tt.data); got != tt.want {
+				t.Errorf("DetectContentType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRichestFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		formats []value.MIMEType
+		want    value.MIMEType
+		wantOK  bool
+	}{
+		{"html over plain", []value.MIMEType{value.MIMETypePlainText, value.MIMETypeHTML}, value.MIMETypeHTML, true},
+		{"html over rtf", []value.MIMEType{value.MIMETypeRTF, value.MIMETypeHTML}, value.MIMETypeHTML, true},
+		{"image over html", []value.MIMEType{value.MIMETypeHTML, value.MIMETypeImagePNG}, value.MIMETypeImagePNG, true},
+		{"plain only", []value.MIMEType{value.MIMETypePlainText}, value.MIMETypePlainText, true},
+		{"unknown only", []value.MIMEType{"application/x-custom"}, "", false},
+		{"none", nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RichestFormat(tt.formats)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("RichestFormat() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
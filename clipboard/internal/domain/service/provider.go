@@ -1,7 +1,10 @@
 package service
 
 import (
+	"io"
+
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // Provider is the interface that clipboard implementations must satisfy.
@@ -19,3 +22,45 @@ type Provider interface {
 	// Name returns the name of the provider (e.g., "OSC52", "Windows Native")
 	Name() string
 }
+
+// StreamProvider is implemented by providers that can move clipboard data
+// without holding all of it in memory (pipe- and escape-sequence-based
+// providers). Providers that need a single buffer only implement Provider;
+// ClipboardService collects the data for them.
+type StreamProvider interface {
+	Provider
+
+	// WriteFrom writes everything read from r to the clipboard
+	WriteFrom(r io.Reader) error
+
+	// ReadTo copies the clipboard content to w
+	ReadTo(w io.Writer) error
+}
+
+// SelectionProvider is implemented by providers that can address more than
+// one selection (X11/Wayland CLIPBOARD and PRIMARY). Providers without a
+// primary selection only implement Provider; ClipboardService falls back to
+// their regular clipboard.
+type SelectionProvider interface {
+	Provider
+
+	// ReadSelection reads content from the given selection
+	ReadSelection(selection value.Selection) (*model.ClipboardContent, error)
+
+	// WriteSelection writes content to the given selection
+	WriteSelection(selection value.Selection, content *model.ClipboardContent) error
+}
+
+// FormatProvider is implemented by providers that can list the formats
+// currently on the clipboard and read a specific one (for example HTML
+// alongside its plain text fallback). For other providers ClipboardService
+// reads the single available content and detects its type from the bytes.
+type FormatProvider interface {
+	Provider
+
+	// AvailableFormats returns the MIME types currently on the clipboard
+	AvailableFormats() ([]value.MIMEType, error)
+
+	// ReadFormat reads the clipboard content in the given format
+	ReadFormat(mimeType value.MIMEType) (*model.ClipboardContent, error)
+}
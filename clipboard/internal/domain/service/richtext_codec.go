@@ -10,7 +10,7 @@ import (
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
-// RichTextCodec provides HTML and RTF encoding/decoding functionality.
+// RichTextCodec provides HTML, RTF and Markdown encoding/decoding functionality.
 // It's a domain service that handles rich text format conversions.
 type RichTextCodec struct{}
 
@@ -20,7 +20,7 @@ func NewRichTextCodec() *RichTextCodec {
 }
 
 // EncodeHTML encodes text with styles into HTML format.
-// Supports basic formatting: bold, italic, underline, and colors.
+// Supports basic formatting: bold, italic, underline, colors, and links.
 func (c *RichTextCodec) EncodeHTML(text string, styles value.TextStyles) (string, error) {
 	if text == "" {
 		return "", nil
@@ -44,6 +44,9 @@ func (c *RichTextCodec) EncodeHTML(text string, styles value.TextStyles) (string
 	if styles.Underline {
 		encoded = fmt.Sprintf("<u>%s</u>", encoded)
 	}
+	if styles.Link != "" {
+		encoded = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(styles.Link), encoded)
+	}
 	if styles.Color != "" {
 		encoded = fmt.Sprintf(`<span style="color:%s">%s</span>`, styles.Color, encoded)
 	}
@@ -88,6 +91,12 @@ func (c *RichTextCodec) DecodeHTML(htmlContent string) (string, value.TextStyles
 		}
 	}
 
+	// Detect link target
+	linkRegex := regexp.MustCompile(`<a\s[^>]*href="([^"]*)"`)
+	if matches := linkRegex.FindStringSubmatch(text); len(matches) > 1 {
+		styles = styles.WithLink(html.UnescapeString(matches[1]))
+	}
+
 	// Strip all HTML tags
 	plainText, err := c.StripHTMLTags(text)
 	if err != nil {
@@ -742,3 +742,24 @@ func TestRTFRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestHTMLLink(t *testing.T) {
+	codec := NewRichTextCodec()
+	styles := value.NewTextStyles().WithLink("https://example.com/?a=1&b=2")
+
+	encoded, err := codec.EncodeHTML("Phoenix", styles)
+	if err != nil {
+		t.Fatalf("EncodeHTML() error = %v", err)
+	}
+	if encoded != `<a href="https://example.com/?a=1&amp;b=2">Phoenix</a>` {
+		t.Errorf("EncodeHTML() = %q", encoded)
+	}
+
+	text, decoded, err := codec.DecodeHTML(encoded)
+	if err != nil {
+		t.Fatalf("DecodeHTML() error = %v", err)
+	}
+	if text != "Phoenix" || !decoded.Equals(styles) {
+		t.Errorf("DecodeHTML() = %q, %v; want %q, %v", text, decoded, "Phoenix", styles)
+	}
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
+)
+
+// markdownHeadingRegex matches an ATX heading marker ("# ", "## ", ...)
+// and an optional closing sequence of '#'.
+var markdownHeadingRegex = regexp.MustCompile(`^ {0,3}#{1,6}(?:[ \t]+|$)(.*?)(?:[ \t]+#+)?[ \t]*$`)
+
+// EncodeMarkdown encodes text with styles into Markdown.
+// Supports bold, italic, and links; Markdown has no underline or color,
+// so those styles are dropped. Markdown special characters in text are
+// escaped, and styles are applied to each non-blank line so that they
+// survive paragraph breaks.
+func (c *RichTextCodec) EncodeMarkdown(text string, styles value.TextStyles) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		encoded := escapeMarkdown(line)
+		if styles.Italic {
+			encoded = "*" + encoded + "*"
+		}
+		if styles.Bold {
+			encoded = "**" + encoded + "**"
+		}
+		if styles.Link != "" {
+			encoded = fmt.Sprintf("[%s](%s)", encoded, escapeMarkdownURL(styles.Link))
+		}
+		lines[i] = encoded
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// DecodeMarkdown decodes Markdown content to plain text and extracts styles.
+// This is a simplified decoder for the common subset: bold (** or __),
+// italic (* or _), code spans, links, headings, and backslash escapes.
+// The first link found becomes the link style. Anything else (lists,
+// quotes, tables, images, unmatched markers) is kept as plain text, so
+// decoding never fails on unusual input.
+// Returns the plain text, detected styles, and any error.
+func (c *RichTextCodec) DecodeMarkdown(markdown string) (string, value.TextStyles, error) {
+	if markdown == "" {
+		return "", value.NewTextStyles(), nil
+	}
+
+	styles := value.NewTextStyles()
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if matches := markdownHeadingRegex.FindStringSubmatch(line); matches != nil {
+			line = matches[1]
+		}
+		lines[i] = decodeMarkdownInline(line, &styles)
+	}
+
+	return strings.Join(lines, "\n"), styles, nil
+}
+
+// HTMLToMarkdown converts HTML content to Markdown format.
+func (c *RichTextCodec) HTMLToMarkdown(htmlContent string) (string, error) {
+	if htmlContent == "" {
+		return "", nil
+	}
+
+	// Decode HTML to get text and styles
+	text, styles, err := c.DecodeHTML(htmlContent)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode HTML: %w", err)
+	}
+
+	// Encode to Markdown
+	markdown, err := c.EncodeMarkdown(text, styles)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode Markdown: %w", err)
+	}
+
+	return markdown, nil
+}
+
+// MarkdownToHTML converts Markdown content to HTML format.
+func (c *RichTextCodec) MarkdownToHTML(markdown string) (string, error) {
+	if markdown == "" {
+		return "", nil
+	}
+
+	// Decode Markdown to get text and styles
+	text, styles, err := c.DecodeMarkdown(markdown)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode Markdown: %w", err)
+	}
+
+	// Encode to HTML
+	htmlContent, err := c.EncodeHTML(text, styles)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode HTML: %w", err)
+	}
+
+	return htmlContent, nil
+}
+
+// escapeMarkdown escapes characters that Markdown would treat as inline
+// markup, and a leading '#' that would start a heading.
+func escapeMarkdown(text string) string {
+	var buf strings.Builder
+
+	for i, r := range text {
+		switch r {
+		case '\\', '`', '*', '_', '[', ']':
+			buf.WriteByte('\\')
+		case '#':
+			if strings.TrimSpace(text[:i]) == "" {
+				buf.WriteByte('\\')
+			}
+		}
+		buf.WriteRune(r)
+	}
+
+	return buf.String()
+}
+
+// escapeMarkdownURL percent-encodes characters that would end a link
+// destination early.
+func escapeMarkdownURL(url string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+}
+
+// decodeMarkdownInline strips inline markup from a single line, recording
+// bold, italic, and the first link target in styles.
+func decodeMarkdownInline(line string, styles *value.TextStyles) string {
+	var buf strings.Builder
+
+	for i := 0; i < len(line); {
+		ch := line[i]
+		switch {
+		case ch == '\\' && i+1 < len(line) && isMarkdownPunct(line[i+1]):
+			// Backslash escape: the next character is literal
+			buf.WriteByte(line[i+1])
+			i += 2
+
+		case ch == '`':
+			// Code span: contents are literal
+			n := runLength(line, i, '`')
+			delim := line[i : i+n]
+			end := strings.Index(line[i+n:], delim)
+			if end < 0 {
+				buf.WriteString(delim)
+				i += n
+				continue
+			}
+			buf.WriteString(strings.TrimSpace(line[i+n : i+n+end]))
+			i += n + end + n
+
+		case ch == '!' && i+1 < len(line) && line[i+1] == '[':
+			// Images are not supported: keep them verbatim
+			if _, _, end := parseMarkdownLink(line, i+1); end > 0 {
+				buf.WriteString(line[i:end])
+				i = end
+				continue
+			}
+			buf.WriteByte(ch)
+			i++
+
+		case ch == '[':
+			label, target, end := parseMarkdownLink(line, i)
+			if end < 0 {
+				buf.WriteByte(ch)
+				i++
+				continue
+			}
+			if styles.Link == "" {
+				*styles = styles.WithLink(target)
+			}
+			buf.WriteString(decodeMarkdownInline(label, styles))
+			i = end
+
+		case ch == '*' || ch == '_':
+			n := runLength(line, i, ch)
+			inner, end := matchEmphasis(line, i, n)
+			if end < 0 {
+				buf.WriteString(line[i : i+n])
+				i += n
+				continue
+			}
+			if n == 1 || n >= 3 {
+				*styles = styles.WithItalic(true)
+			}
+			if n >= 2 {
+				*styles = styles.WithBold(true)
+			}
+			buf.WriteString(decodeMarkdownInline(inner, styles))
+			i = end
+
+		default:
+			buf.WriteByte(ch)
+			i++
+		}
+	}
+
+	return buf.String()
+}
+
+// parseMarkdownLink parses "[label](target)" starting at the '[' at start.
+// Returns the label, the target (without an optional title or angle
+// brackets), and the index just past the closing ')', or end = -1 if the
+// text at start is not a link.
+func parseMarkdownLink(line string, start int) (label, target string, end int) {
+	depth := 0
+	closeLabel := -1
+	for i := start; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+		if depth == 0 {
+			closeLabel = i
+			break
+		}
+	}
+	if closeLabel < 0 || closeLabel+1 >= len(line) || line[closeLabel+1] != '(' {
+		return "", "", -1
+	}
+
+	closeTarget := strings.IndexByte(line[closeLabel+2:], ')')
+	if closeTarget < 0 {
+		return "", "", -1
+	}
+	destination := strings.TrimSpace(line[closeLabel+2 : closeLabel+2+closeTarget])
+	if fields := strings.Fields(destination); len(fields) > 0 {
+		destination = fields[0]
+	}
+	destination = strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">")
+
+	return line[start+1 : closeLabel], destination, closeLabel + 2 + closeTarget + 1
+}
+
+// matchEmphasis finds the closing delimiter for the run of n emphasis
+// characters at start. Returns the enclosed text and the index just past
+// the closing run, or end = -1 if the run does not open emphasis.
+func matchEmphasis(line string, start, n int) (inner string, end int) {
+	ch := line[start]
+	delim := line[start : start+n]
+	open := start + n
+
+	// Opening run must be followed by non-space text
+	if open >= len(line) || line[open] == ' ' || line[open] == '\t' {
+		return "", -1
+	}
+	// Underscores inside words (snake_case) are literal
+	if ch == '_' && start > 0 && isWordRune(lastRune(line[:start])) {
+		return "", -1
+	}
+
+	for i := open; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if !strings.HasPrefix(line[i:], delim) || runLength(line, i, ch) != n {
+			continue
+		}
+		if line[i-1] == ' ' || line[i-1] == '\t' {
+			continue
+		}
+		if ch == '_' && i+n < len(line) && isWordRune(firstRune(line[i+n:])) {
+			continue
+		}
+		return line[open:i], i + n
+	}
+
+	return "", -1
+}
+
+// runLength returns how many times ch repeats starting at start.
+func runLength(line string, start int, ch byte) int {
+	n := 0
+	for start+n < len(line) && line[start+n] == ch {
+		n++
+	}
+	return n
+}
+
+// firstRune returns the first rune of s.
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+// lastRune returns the last rune of s.
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
+
+// isWordRune reports whether r is a letter or digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isMarkdownPunct reports whether ch is ASCII punctuation, which Markdown
+// allows to be backslash-escaped.
+func isMarkdownPunct(ch byte) bool {
+	r := rune(ch)
+	return r < utf8.RuneSelf && (unicode.IsPunct(r) || unicode.IsSymbol(r))
+}
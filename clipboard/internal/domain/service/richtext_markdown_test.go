@@ -0,0 +1,160 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
+)
+
+func TestEncodeMarkdown(t *testing.T) {
+	codec := NewRichTextCodec()
+
+	tests := []struct {
+		name   string
+		text   string
+		styles value.TextStyles
+		want   string
+	}{
+		{"plain", "Hello World", value.NewTextStyles(), "Hello World"},
+		{"bold", "Hello", value.NewTextStyles().WithBold(true), "**Hello**"},
+		{"italic", "Hello", value.NewTextStyles().WithItalic(true), "*Hello*"},
+		{"bold italic", "Hello", value.NewTextStyles().WithBold(true).WithItalic(true), "***Hello***"},
+		{"link", "Phoenix", value.NewTextStyles().WithLink("https://example.com"), "[Phoenix](https://example.com)"},
+		{
+			"bold link",
+			"Phoenix",
+			value.NewTextStyles().WithBold(true).WithLink("https://example.com/a b"),
+			"[**Phoenix**](https://example.com/a%20b)",
+		},
+		{"underline dropped", "Hello", value.NewTextStyles().WithUnderline(true), "Hello"},
+		{"escapes markup", "2*3 = [x] `y` snake_case", value.NewTextStyles(), "2\\*3 = \\[x\\] \\`y\\` snake\\_case"},
+		{"escapes leading hash", "# not a heading", value.NewTextStyles(), "\\# not a heading"},
+		{"styles each line", "one\n\ntwo", value.NewTextStyles().WithBold(true), "**one**\n\n**two**"},
+		{"empty", "", value.NewTextStyles().WithBold(true), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := codec.EncodeMarkdown(tt.text, tt.styles)
+			if err != nil {
+				t.Fatalf("EncodeMarkdown() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EncodeMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeMarkdown(t *testing.T) {
+	codec := NewRichTextCodec()
+
+	tests := []struct {
+		name     string
+		markdown string
+		wantText string
+		want     value.TextStyles
+	}{
+		{"plain", "Hello World", "Hello World", value.NewTextStyles()},
+		{"bold", "Hello **World**", "Hello World", value.NewTextStyles().WithBold(true)},
+		{"bold underscores", "__Hello__", "Hello", value.NewTextStyles().WithBold(true)},
+		{"italic", "*Hello* World", "Hello World", value.NewTextStyles().WithItalic(true)},
+		{"italic underscores", "_Hello_", "Hello", value.NewTextStyles().WithItalic(true)},
+		{"bold italic", "***Hello***", "Hello", value.NewTextStyles().WithBold(true).WithItalic(true)},
+		{"nested", "**bold and *italic***", "bold and italic", value.NewTextStyles().WithBold(true).WithItalic(true)},
+		{"code span", "run `go *test*` now", "run go *test* now", value.NewTextStyles()},
+		{
+			"link",
+			"see [the docs](https://example.com \"Docs\")",
+			"see the docs",
+			value.NewTextStyles().WithLink("https://example.com"),
+		},
+		{
+			"styled link",
+			"[**Phoenix**](<https://example.com>)",
+			"Phoenix",
+			value.NewTextStyles().WithBold(true).WithLink("https://example.com"),
+		},
+		{"heading", "## Install ##", "Install", value.NewTextStyles()},
+		{"escapes", "2\\*3 \\# \\[x\\]", "2*3 # [x]", value.NewTextStyles()},
+		{"snake case", "use snake_case_names", "use snake_case_names", value.NewTextStyles()},
+		{"spaced asterisks", "2 * 3 * 4", "2 * 3 * 4", value.NewTextStyles()},
+		{"unclosed markers", "**open `tick [bracket", "**open `tick [bracket", value.NewTextStyles()},
+		{"image kept", "![logo](logo.png)", "![logo](logo.png)", value.NewTextStyles()},
+		{"list kept", "- item\n> quote", "- item\n> quote", value.NewTextStyles()},
+		{"empty", "", "", value.NewTextStyles()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, styles, err := codec.DecodeMarkdown(tt.markdown)
+			if err != nil {
+				t.Fatalf("DecodeMarkdown() error = %v", err)
+			}
+			if text != tt.wantText {
+				t.Errorf("DecodeMarkdown() text = %q, want %q", text, tt.wantText)
+			}
+			if !styles.Equals(tt.want) {
+				t.Errorf("DecodeMarkdown() styles = %v, want %v", styles, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	codec := NewRichTextCodec()
+
+	text := "Release *notes* for [v1] and snake_case\n\n# not a heading"
+	styles := value.NewTextStyles().WithBold(true).WithItalic(true).WithLink("https://example.com/notes")
+
+	markdown, err := codec.EncodeMarkdown(text, styles)
+	if err != nil {
+		t.Fatalf("EncodeMarkdown() error = %v", err)
+	}
+	decoded, decodedStyles, err := codec.DecodeMarkdown(markdown)
+	if err != nil {
+		t.Fatalf("DecodeMarkdown() error = %v", err)
+	}
+
+	if decoded != text {
+		t.Errorf("round trip text = %q, want %q", decoded, text)
+	}
+	if !decodedStyles.Equals(styles) {
+		t.Errorf("round trip styles = %v, want %v", decodedStyles, styles)
+	}
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	codec := NewRichTextCodec()
+
+	markdown, err := codec.HTMLToMarkdown(`<a href="https://example.com"><strong>Phoenix</strong></a>`)
+	if err != nil {
+		t.Fatalf("HTMLToMarkdown() error = %v", err)
+	}
+	if markdown != "[**Phoenix**](https://example.com)" {
+		t.Errorf("HTMLToMarkdown() = %q", markdown)
+	}
+
+	empty, err := codec.HTMLToMarkdown("")
+	if err != nil || empty != "" {
+		t.Errorf("HTMLToMarkdown(\"\") = %q, %v", empty, err)
+	}
+}
+
+func TestMarkdownToHTML(t *testing.T) {
+	codec := NewRichTextCodec()
+
+	html, err := codec.MarkdownToHTML("# *Hello* & [world](https://example.com)")
+	if err != nil {
+		t.Fatalf("MarkdownToHTML() error = %v", err)
+	}
+	want := `<a href="https://example.com"><em>Hello &amp; world</em></a>`
+	if html != want {
+		t.Errorf("MarkdownToHTML() = %q, want %q", html, want)
+	}
+
+	empty, err := codec.MarkdownToHTML("")
+	if err != nil || empty != "" {
+		t.Errorf("MarkdownToHTML(\"\") = %q, %v", empty, err)
+	}
+}
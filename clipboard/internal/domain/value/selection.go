@@ -0,0 +1,42 @@
+package value
+
+import "fmt"
+
+// Selection identifies which system clipboard to use.
+//
+// X11 and Wayland have two: CLIPBOARD, filled by explicit copy (Ctrl+C)
+// and pasted with Ctrl+V, and PRIMARY, filled by selecting text and pasted
+// with a middle click. Other platforms only have CLIPBOARD.
+type Selection string
+
+const (
+	// SelectionClipboard is the regular copy/paste clipboard.
+	SelectionClipboard Selection = "clipboard"
+
+	// SelectionPrimary is the X11/Wayland primary selection (middle-click paste).
+	SelectionPrimary Selection = "primary"
+)
+
+// NewSelection creates a new selection value object.
+func NewSelection(selection string) (Selection, error) {
+	s := Selection(selection)
+	if !s.IsValid() {
+		return "", fmt.Errorf("unknown selection: %q", selection)
+	}
+	return s, nil
+}
+
+// String returns the string representation of the selection.
+func (s Selection) String() string {
+	return string(s)
+}
+
+// IsValid returns true if the selection is one of the known selections.
+func (s Selection) IsValid() bool {
+	return s == SelectionClipboard || s == SelectionPrimary
+}
+
+// IsPrimary returns true for the primary selection.
+func (s Selection) IsPrimary() bool {
+	return s == SelectionPrimary
+}
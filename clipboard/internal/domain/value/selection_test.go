@@ -0,0 +1,49 @@
+package value
+
+import (
+	"testing"
+)
+
+func TestNewSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection string
+		wantError bool
+	}{
+		{"clipboard", "clipboard", false},
+		{"primary", "primary", false},
+		{"secondary", "secondary", true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewSelection(tt.selection)
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if result.String() != tt.selection {
+				t.Errorf("expected %s, got %s", tt.selection, result.String())
+			}
+		})
+	}
+}
+
+func TestSelection_IsPrimary(t *testing.T) {
+	if SelectionClipboard.IsPrimary() {
+		t.Error("SelectionClipboard.IsPrimary() = true, want false")
+	}
+	if !SelectionPrimary.IsPrimary() {
+		t.Error("SelectionPrimary.IsPrimary() = false, want true")
+	}
+}
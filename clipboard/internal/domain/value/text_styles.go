@@ -13,6 +13,7 @@ type TextStyles struct {
 	Italic    bool
 	Underline bool
 	Color     string // hex color like "#FF0000" (RGB format)
+	Link      string // link target URL (empty = no link)
 }
 
 // NewTextStyles creates a new TextStyles value object with default values (no styling).
@@ -47,6 +48,7 @@ func (t TextStyles) WithBold(bold bool) TextStyles {
 		Italic:    t.Italic,
 		Underline: t.Underline,
 		Color:     t.Color,
+		Link:      t.Link,
 	}
 }
 
@@ -57,6 +59,7 @@ func (t TextStyles) WithItalic(italic bool) TextStyles {
 		Italic:    italic,
 		Underline: t.Underline,
 		Color:     t.Color,
+		Link:      t.Link,
 	}
 }
 
@@ -67,6 +70,7 @@ func (t TextStyles) WithUnderline(underline bool) TextStyles {
 		Italic:    t.Italic,
 		Underline: underline,
 		Color:     t.Color,
+		Link:      t.Link,
 	}
 }
 
@@ -84,12 +88,25 @@ func (t TextStyles) WithColor(color string) (TextStyles, error) {
 		Italic:    t.Italic,
 		Underline: t.Underline,
 		Color:     color,
+		Link:      t.Link,
 	}, nil
 }
 
+// WithLink returns a new TextStyles linking to the given URL.
+// Pass empty string to remove the link.
+func (t TextStyles) WithLink(link string) TextStyles {
+	return TextStyles{
+		Bold:      t.Bold,
+		Italic:    t.Italic,
+		Underline: t.Underline,
+		Color:     t.Color,
+		Link:      link,
+	}
+}
+
 // IsPlain returns true if the TextStyles has no formatting applied.
 func (t TextStyles) IsPlain() bool {
-	return !t.Bold && !t.Italic && !t.Underline && t.Color == ""
+	return !t.Bold && !t.Italic && !t.Underline && t.Color == "" && t.Link == ""
 }
 
 // Equals compares two TextStyles for equality.
@@ -97,7 +114,8 @@ func (t TextStyles) Equals(other TextStyles) bool {
 	return t.Bold == other.Bold &&
 		t.Italic == other.Italic &&
 		t.Underline == other.Underline &&
-		strings.EqualFold(t.Color, other.Color) // Case-insensitive color comparison
+		strings.EqualFold(t.Color, other.Color) && // Case-insensitive color comparison
+		t.Link == other.Link
 }
 
 // String returns a human-readable representation of the TextStyles.
@@ -119,6 +137,9 @@ func (t TextStyles) String() string {
 	if t.Color != "" {
 		styles = append(styles, fmt.Sprintf("color:%s", t.Color))
 	}
+	if t.Link != "" {
+		styles = append(styles, fmt.Sprintf("link:%s", t.Link))
+	}
 
 	return strings.Join(styles, ",")
 }
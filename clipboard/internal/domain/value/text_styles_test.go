@@ -106,6 +106,27 @@ func TestTextStylesWithUnderline(t *testing.T) {
 	}
 }
 
+func TestTextStylesWithLink(t *testing.T) {
+	styles := NewTextStyles().WithBold(true)
+
+	linked := styles.WithLink("https://example.com")
+	if linked.Link != "https://example.com" || !linked.Bold {
+		t.Errorf("WithLink() = %+v, want bold link", linked)
+	}
+	if linked.IsPlain() {
+		t.Error("Expected linked styles not to be plain")
+	}
+	if styles.Link != "" {
+		t.Error("Original TextStyles was mutated")
+	}
+	if linked.WithLink("").Link != "" {
+		t.Error("Expected empty link to remove the link")
+	}
+	if linked.Equals(styles) {
+		t.Error("Expected styles with different links not to be equal")
+	}
+}
+
 func TestTextStylesWithColor(t *testing.T) {
 	styles := NewTextStyles()
 
@@ -315,6 +336,11 @@ func TestTextStylesString(t *testing.T) {
 			}(),
 			want: "bold,italic,underline,color:#FF0000",
 		},
+		{
+			name:   "Link only",
+			styles: NewTextStyles().WithLink("https://example.com"),
+			want:   "link:https://example.com",
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,9 +5,12 @@ package native
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os/exec"
+	"strings"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // Provider implements clipboard operations using macOS pbcopy/pbpaste.
@@ -18,16 +21,17 @@ func NewProvider() *Provider {
 	return &Provider{}
 }
 
+// WithSelection is a no-op: macOS has no primary selection, so every
+// selection uses the regular clipboard.
+func (p *Provider) WithSelection(_ value.Selection) *Provider {
+	return p
+}
+
 // Read reads content from the macOS clipboard using pbpaste.
 func (p *Provider) Read() (*model.ClipboardContent, error) {
-	// Use pbpaste to read from clipboard
-	cmd := exec.Command("pbpaste")
 	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	err := cmd.Run()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read from clipboard: %w", err)
+	if err := p.ReadTo(&out); err != nil {
+		return nil, err
 	}
 
 	text := out.String()
@@ -50,12 +54,27 @@ func (p *Provider) Write(content *model.ClipboardContent) error {
 		return fmt.Errorf("only text content is supported: %w", err)
 	}
 
-	// Use pbcopy to write to clipboard
+	return p.WriteFrom(strings.NewReader(text))
+}
+
+// ReadTo streams the clipboard content from pbpaste to w.
+func (p *Provider) ReadTo(w io.Writer) error {
+	cmd := exec.Command("pbpaste")
+	cmd.Stdout = w
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to read from clipboard: %w", err)
+	}
+
+	return nil
+}
+
+// WriteFrom streams everything read from r to pbcopy.
+func (p *Provider) WriteFrom(r io.Reader) error {
 	cmd := exec.Command("pbcopy")
-	cmd.Stdin = bytes.NewBufferString(text)
+	cmd.Stdin = r
 
-	err = cmd.Run()
-	if err != nil {
+	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to write to clipboard: %w", err)
 	}
 
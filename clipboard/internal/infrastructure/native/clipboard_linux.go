@@ -5,22 +5,27 @@ package native
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os/exec"
+	"strings"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // Provider implements clipboard operations using Linux clipboard tools.
-// Supports both X11 (xclip/xsel) and Wayland (wl-copy/wl-paste)
+// Supports both X11 (xclip/xsel) and Wayland (wl-copy/wl-paste), and both
+// the CLIPBOARD and PRIMARY selections.
 type Provider struct {
-	readCmd  string
-	writeCmd string
+	readCmd   string
+	writeCmd  string
+	selection value.Selection // Selection used by Read/Write and streaming
 }
 
 // NewProvider creates a new Linux native clipboard provider.
 // Automatically detects available clipboard tools.
 func NewProvider() *Provider {
-	p := &Provider{}
+	p := &Provider{selection: value.SelectionClipboard}
 
 	// Try Wayland first (wl-clipboard)
 	if _, err := exec.LookPath("wl-copy"); err == nil {
@@ -49,31 +54,31 @@ func NewProvider() *Provider {
 	return p
 }
 
-// Read reads content from the Linux clipboard.
+// WithSelection sets the selection used by Read, Write, ReadTo and WriteFrom.
+func (p *Provider) WithSelection(selection value.Selection) *Provider {
+	p.selection = selection
+	return p
+}
+
+// Read reads content from the provider's selection.
 func (p *Provider) Read() (*model.ClipboardContent, error) {
-	if !p.IsAvailable() {
-		return nil, fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
-	}
+	return p.ReadSelection(p.selection)
+}
 
-	var cmd *exec.Cmd
+// Write writes content to the provider's selection.
+func (p *Provider) Write(content *model.ClipboardContent) error {
+	return p.WriteSelection(p.selection, content)
+}
 
-	switch p.readCmd {
-	case "xclip":
-		cmd = exec.Command("xclip", "-selection", "clipboard", "-o")
-	case "xsel":
-		cmd = exec.Command("xsel", "--clipboard", "--output")
-	case "wl-paste":
-		cmd = exec.Command("wl-paste", "--no-newline")
-	default:
-		return nil, fmt.Errorf("unknown read command: %s", p.readCmd)
+// ReadSelection reads content from the given selection.
+func (p *Provider) ReadSelection(selection value.Selection) (*model.ClipboardContent, error) {
+	if !p.IsAvailable() {
+		return nil, fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
 	}
 
 	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	err := cmd.Run()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read from clipboard: %w", err)
+	if err := p.readTo(&out, selection); err != nil {
+		return nil, err
 	}
 
 	text := out.String()
@@ -84,8 +89,8 @@ func (p *Provider) Read() (*model.ClipboardContent, error) {
 	return model.NewTextContent(text)
 }
 
-// Write writes content to the Linux clipboard.
-func (p *Provider) Write(content *model.ClipboardContent) error {
+// WriteSelection writes content to the given selection.
+func (p *Provider) WriteSelection(selection value.Selection, content *model.ClipboardContent) error {
 	if content == nil {
 		return fmt.Errorf("content cannot be nil")
 	}
@@ -100,29 +105,222 @@ func (p *Provider) Write(content *model.ClipboardContent) error {
 		return fmt.Errorf("only text content is supported: %w", err)
 	}
 
+	return p.writeFrom(strings.NewReader(text), selection)
+}
+
+// ReadTo streams the content of the provider's selection from the
+// clipboard tool to w.
+func (p *Provider) ReadTo(w io.Writer) error {
+	return p.readTo(w, p.selection)
+}
+
+// WriteFrom streams everything read from r to the clipboard tool's stdin,
+// into the provider's selection.
+func (p *Provider) WriteFrom(r io.Reader) error {
+	return p.writeFrom(r, p.selection)
+}
+
+// readTo streams the content of selection from the clipboard tool to w.
+func (p *Provider) readTo(w io.Writer, selection value.Selection) error {
+	if !p.IsAvailable() {
+		return fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
+	}
+
+	var cmd *exec.Cmd
+
+	switch p.readCmd {
+	case "xclip":
+		cmd = exec.Command("xclip", "-selection", selection.String(), "-o")
+	case "xsel":
+		cmd = exec.Command("xsel", xselFlag(selection), "--output")
+	case "wl-paste":
+		if selection.IsPrimary() {
+			cmd = exec.Command("wl-paste", "--primary", "--no-newline")
+		} else {
+			cmd = exec.Command("wl-paste", "--no-newline")
+		}
+	default:
+		return fmt.Errorf("unknown read command: %s", p.readCmd)
+	}
+
+	cmd.Stdout = w
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to read from clipboard: %w", err)
+	}
+
+	return nil
+}
+
+// writeFrom streams everything read from r to the clipboard tool's stdin,
+// into selection.
+func (p *Provider) writeFrom(r io.Reader, selection value.Selection) error {
+	if !p.IsAvailable() {
+		return fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
+	}
+
 	var cmd *exec.Cmd
 
 	switch p.writeCmd {
 	case "xclip":
-		cmd = exec.Command("xclip", "-selection", "clipboard", "-i")
+		cmd = exec.Command("xclip", "-selection", selection.String(), "-i")
 	case "xsel":
-		cmd = exec.Command("xsel", "--clipboard", "--input")
+		cmd = exec.Command("xsel", xselFlag(selection), "--input")
 	case "wl-copy":
-		cmd = exec.Command("wl-copy")
+		if selection.IsPrimary() {
+			cmd = exec.Command("wl-copy", "--primary")
+		} else {
+			cmd = exec.Command("wl-copy")
+		}
 	default:
 		return fmt.Errorf("unknown write command: %s", p.writeCmd)
 	}
 
-	cmd.Stdin = bytes.NewBufferString(text)
+	cmd.Stdin = r
 
-	err = cmd.Run()
-	if err != nil {
+	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to write to clipboard: %w", err)
 	}
 
 	return nil
 }
 
+// AvailableFormats returns the MIME types offered on the provider's
+// selection. xclip and wl-paste list the owner's targets; xsel cannot,
+// so with xsel only plain text is reported (when the selection is not
+// empty).
+func (p *Provider) AvailableFormats() ([]value.MIMEType, error) {
+	if !p.IsAvailable() {
+		return nil, fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
+	}
+
+	var cmd *exec.Cmd
+
+	switch p.readCmd {
+	case "xclip":
+		cmd = exec.Command("xclip", "-selection", p.selection.String(), "-t", "TARGETS", "-o")
+	case "wl-paste":
+		if p.selection.IsPrimary() {
+			cmd = exec.Command("wl-paste", "--primary", "--list-types")
+		} else {
+			cmd = exec.Command("wl-paste", "--list-types")
+		}
+	default:
+		if _, err := p.Read(); err != nil {
+			return nil, err
+		}
+		return []value.MIMEType{value.MIMETypePlainText}, nil
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clipboard formats: %w", err)
+	}
+
+	var formats []value.MIMEType
+	seen := make(map[value.MIMEType]bool)
+	for _, target := range strings.Fields(string(out)) {
+		mimeType, ok := targetMIMEType(target)
+		if ok && !seen[mimeType] {
+			seen[mimeType] = true
+			formats = append(formats, mimeType)
+		}
+	}
+	return formats, nil
+}
+
+// ReadFormat reads the provider's selection in the given format.
+func (p *Provider) ReadFormat(mimeType value.MIMEType) (*model.ClipboardContent, error) {
+	if mimeType == value.MIMETypePlainText || p.readCmd == "xsel" {
+		return p.Read()
+	}
+	if !p.IsAvailable() {
+		return nil, fmt.Errorf("no clipboard tool available (install xclip, xsel, or wl-clipboard)")
+	}
+
+	// Owners advertise some formats under more than one name
+	targets := []string{mimeType.String()}
+	switch mimeType {
+	case value.MIMETypeRTF:
+		targets = append(targets, "application/rtf", "text/richtext")
+	case value.MIMETypeImageJPEG:
+		targets = append(targets, "image/jpg")
+	}
+
+	var lastErr error
+	for _, target := range targets {
+		var cmd *exec.Cmd
+
+		switch p.readCmd {
+		case "xclip":
+			cmd = exec.Command("xclip", "-selection", p.selection.String(), "-t", target, "-o")
+		case "wl-paste":
+			if p.selection.IsPrimary() {
+				cmd = exec.Command("wl-paste", "--primary", "--no-newline", "--type", target)
+			} else {
+				cmd = exec.Command("wl-paste", "--no-newline", "--type", target)
+			}
+		default:
+			return nil, fmt.Errorf("unknown read command: %s", p.readCmd)
+		}
+
+		data, err := cmd.Output()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to read %s from clipboard: %w", mimeType, err)
+			continue
+		}
+		if len(data) == 0 {
+			lastErr = fmt.Errorf("clipboard has no %s content", mimeType)
+			continue
+		}
+
+		encoding := value.EncodingBinary
+		if mimeType.IsText() {
+			encoding = value.EncodingUTF8
+		}
+		return model.NewClipboardContent(data, mimeType, encoding)
+	}
+
+	return nil, lastErr
+}
+
+// targetMIMEType maps an X11 target or Wayland MIME type to the MIME type
+// it carries. Returns false for targets that are not content (TARGETS,
+// TIMESTAMP, ...) and for unknown formats.
+func targetMIMEType(target string) (value.MIMEType, bool) {
+	// Drop parameters such as ";charset=utf-8"
+	if i := strings.IndexByte(target, ';'); i >= 0 {
+		target = target[:i]
+	}
+
+	switch strings.ToLower(target) {
+	case "utf8_string", "string", "text", "text/plain":
+		return value.MIMETypePlainText, true
+	case "text/html":
+		return value.MIMETypeHTML, true
+	case "text/rtf", "application/rtf", "text/richtext":
+		return value.MIMETypeRTF, true
+	case "image/png":
+		return value.MIMETypeImagePNG, true
+	case "image/jpeg", "image/jpg":
+		return value.MIMETypeImageJPEG, true
+	case "image/gif":
+		return value.MIMETypeImageGIF, true
+	case "image/bmp":
+		return value.MIMETypeImageBMP, true
+	default:
+		return "", false
+	}
+}
+
+// xselFlag returns the xsel option that selects selection.
+func xselFlag(selection value.Selection) string {
+	if selection.IsPrimary() {
+		return "--primary"
+	}
+	return "--clipboard"
+}
+
 // IsAvailable returns true if a clipboard tool is available.
 func (p *Provider) IsAvailable() bool {
 	return p.readCmd != "" && p.writeCmd != ""
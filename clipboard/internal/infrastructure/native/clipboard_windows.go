@@ -29,6 +29,7 @@ import (
 	"unsafe"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 var (
@@ -58,6 +59,12 @@ func NewProvider() *Provider {
 	return &Provider{}
 }
 
+// WithSelection is a no-op: Windows has no primary selection, so every
+// selection uses the regular clipboard.
+func (p *Provider) WithSelection(_ value.Selection) *Provider {
+	return p
+}
+
 // Read reads content from the Windows clipboard.
 func (p *Provider) Read() (*model.ClipboardContent, error) {
 	// Open clipboard
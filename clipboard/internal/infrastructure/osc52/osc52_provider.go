@@ -2,26 +2,32 @@
 package osc52
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 // Provider implements clipboard operations using OSC 52 escape sequences.
 // This works over SSH connections by sending escape sequences to the terminal.
 type Provider struct {
-	timeout time.Duration
-	output  *os.File // Terminal output (usually os.Stdout)
+	timeout   time.Duration
+	output    *os.File        // Terminal output (usually os.Stdout)
+	selection value.Selection // Selection used by Write/WriteFrom
 }
 
 // NewProvider creates a new OSC 52 clipboard provider.
 func NewProvider(timeout time.Duration) *Provider {
 	return &Provider{
-		timeout: timeout,
-		output:  os.Stdout,
+		timeout:   timeout,
+		output:    os.Stdout,
+		selection: value.SelectionClipboard,
 	}
 }
 
@@ -35,31 +41,41 @@ func (p *Provider) Read() (*model.ClipboardContent, error) {
 
 // Write writes content to the clipboard using OSC 52 escape sequences.
 func (p *Provider) Write(content *model.ClipboardContent) error {
+	return p.WriteSelection(p.selection, content)
+}
+
+// ReadSelection is not supported: most terminals do not answer OSC 52 queries.
+func (p *Provider) ReadSelection(_ value.Selection) (*model.ClipboardContent, error) {
+	return p.Read()
+}
+
+// WriteSelection writes content to the given selection.
+// Terminals without a primary selection may ignore primary writes.
+func (p *Provider) WriteSelection(selection value.Selection, content *model.ClipboardContent) error {
 	if content == nil {
 		return fmt.Errorf("content cannot be nil")
 	}
 
-	// Get the data to write
-	data := content.Data()
+	return p.writeFrom(bytes.NewReader(content.Data()), selection)
+}
 
-	// OSC 52 format: ESC ] 52 ; c ; <base64 data> ESC \
-	// Where 'c' is the clipboard selection (c = clipboard, p = primary)
-	encoded := base64.StdEncoding.EncodeToString(data)
+// WriteFrom streams everything read from r to the clipboard as one OSC 52
+// sequence, base64-encoding it on the fly instead of building the whole
+// sequence in memory. The timeout covers the entire transfer.
+func (p *Provider) WriteFrom(r io.Reader) error {
+	return p.writeFrom(r, p.selection)
+}
 
-	// Build the OSC 52 sequence
-	// Using 'c' for clipboard (as opposed to 'p' for primary selection)
-	sequence := fmt.Sprintf("\033]52;c;%s\033\\", encoded)
+// writeFrom streams r to selection with the provider's timeout.
+func (p *Provider) writeFrom(r io.Reader, selection value.Selection) error {
+	if r == nil {
+		return fmt.Errorf("reader cannot be nil")
+	}
 
 	// Write to terminal with timeout
 	done := make(chan error, 1)
 	go func() {
-		_, err := p.output.WriteString(sequence)
-		if err != nil {
-			done <- err
-			return
-		}
-		// Flush is important to ensure the escape sequence is sent immediately
-		done <- p.output.Sync()
+		done <- p.writeSequence(r, selection)
 	}()
 
 	// Wait for write to complete or timeout
@@ -71,6 +87,44 @@ func (p *Provider) Write(content *model.ClipboardContent) error {
 	}
 }
 
+// ReadTo is not supported: most terminals do not answer OSC 52 queries.
+func (p *Provider) ReadTo(_ io.Writer) error {
+	return fmt.Errorf("OSC 52 clipboard reading is not supported by most terminals")
+}
+
+// writeSequence writes r to the terminal as an OSC 52 sequence:
+// ESC ] 52 ; c ; <base64 data> ESC \
+// where 'c' is the clipboard selection ('p' for primary).
+func (p *Provider) writeSequence(r io.Reader, selection value.Selection) error {
+	target := "c"
+	if selection.IsPrimary() {
+		target = "p"
+	}
+
+	out := bufio.NewWriter(p.output)
+	if _, err := out.WriteString("\033]52;" + target + ";"); err != nil {
+		return err
+	}
+
+	encoder := base64.NewEncoder(base64.StdEncoding, out)
+	if _, err := io.Copy(encoder, r); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	if _, err := out.WriteString("\033\\"); err != nil {
+		return err
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	// Flush is important to ensure the escape sequence is sent immediately
+	return p.output.Sync()
+}
+
 // IsAvailable returns true if OSC 52 can be used.
 func (p *Provider) IsAvailable() bool {
 	// Check if we have a valid output file
@@ -115,6 +169,12 @@ func (p *Provider) Name() string {
 	return "OSC52"
 }
 
+// WithSelection sets the selection used by Write and WriteFrom.
+func (p *Provider) WithSelection(selection value.Selection) *Provider {
+	p.selection = selection
+	return p
+}
+
 // WithOutput sets a custom output file (useful for testing).
 func (p *Provider) WithOutput(output *os.File) *Provider {
 	p.output = output
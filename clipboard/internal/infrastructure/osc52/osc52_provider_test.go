@@ -1,11 +1,17 @@
 package osc52
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/clipboard/internal/domain/value"
 )
 
 func TestNewProvider(t *testing.T) {
@@ -499,3 +505,114 @@ func TestProvider_IsAvailable_SSHIndicators_Priority(_ *testing.T) {
 	// Should still depend on terminal check
 	_ = provider.IsAvailable()
 }
+
+func TestProvider_WriteFrom(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "clipboard-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	provider := NewProvider(5 * time.Second).WithOutput(tmpFile)
+
+	// Stream 1 MiB of log lines without building the string first.
+	pr, pw := io.Pipe()
+	go func() {
+		for i := 0; i < 65536; i++ {
+			fmt.Fprintf(pw, "log line %06d\n", i)
+		}
+		pw.Close()
+	}()
+
+	if err := provider.WriteFrom(pr); err != nil {
+		t.Fatalf("WriteFrom() error = %v", err)
+	}
+
+	output, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read output: %v", err)
+	}
+	seq := string(output)
+	if !strings.HasPrefix(seq, "\033]52;c;") || !strings.HasSuffix(seq, "\033\\") {
+		t.Fatalf("output is not a single OSC 52 sequence: %q...", seq[:20])
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSuffix(strings.TrimPrefix(seq, "\033]52;c;"), "\033\\"))
+	if err != nil {
+		t.Fatalf("payload is not valid base64: %v", err)
+	}
+	if len(decoded) != 65536*16 || !strings.HasSuffix(string(decoded), "log line 065535\n") {
+		t.Errorf("decoded %d bytes, want %d ending with the last line", len(decoded), 65536*16)
+	}
+}
+
+func TestProvider_WriteFrom_NilReader(t *testing.T) {
+	if err := NewProvider(time.Second).WriteFrom(nil); err == nil {
+		t.Error("expected error for nil reader")
+	}
+}
+
+func TestProvider_ReadTo(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewProvider(time.Second).ReadTo(&buf); err == nil {
+		t.Error("expected error: OSC 52 reading is not supported")
+	}
+}
+
+func TestProvider_WriteSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection value.Selection
+		want      string
+	}{
+		{"clipboard", value.SelectionClipboard, "\033]52;c;"},
+		{"primary", value.SelectionPrimary, "\033]52;p;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpFile, err := os.CreateTemp("", "clipboard-test-*")
+			if err != nil {
+				t.Fatalf("failed to create temp file: %v", err)
+			}
+			defer os.Remove(tmpFile.Name())
+			defer tmpFile.Close()
+
+			provider := NewProvider(5 * time.Second).WithOutput(tmpFile)
+			content, _ := model.NewTextContent("selected")
+			if err := provider.WriteSelection(tt.selection, content); err != nil {
+				t.Fatalf("WriteSelection() error = %v", err)
+			}
+
+			output, _ := os.ReadFile(tmpFile.Name())
+			if !strings.HasPrefix(string(output), tt.want) {
+				t.Errorf("output = %q, want prefix %q", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvider_WithSelection(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "clipboard-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	provider := NewProvider(5 * time.Second).
+		WithOutput(tmpFile).
+		WithSelection(value.SelectionPrimary)
+	if err := provider.WriteFrom(strings.NewReader("middle click")); err != nil {
+		t.Fatalf("WriteFrom() error = %v", err)
+	}
+
+	output, _ := os.ReadFile(tmpFile.Name())
+	if !strings.HasPrefix(string(output), "\033]52;p;") {
+		t.Errorf("output = %q, want primary selection sequence", output)
+	}
+	if _, err := provider.ReadSelection(value.SelectionPrimary); err == nil {
+		t.Error("expected ReadSelection to be unsupported")
+	}
+}
@@ -22,6 +22,12 @@
 //	    Negative("Cancel").
 //	    DefaultNo() // Safe default for dangerous action
 //
+// Example (defaulting to No after 10 seconds):
+//
+//	c := confirm.New("Overwrite existing config?").
+//	    DefaultNo().
+//	    WithTimeout(10 * time.Second)
+//
 // Example (three-button with cancel):
 //
 //	c := confirm.New("Save changes?").
@@ -48,6 +54,9 @@ type Confirm struct {
 	domain  *model.Confirm
 	keymap  *infrastructure.KeyBindingMap
 	focused bool // Whether this component has input focus
+
+	timer        countdown // Timed auto-default, if any
+	autoSelected bool      // Whether the result was selected by the timeout
 }
 
 // New creates a new Confirm dialog with the given title.
@@ -63,9 +72,11 @@ func New(title string) *Confirm {
 // Description sets the description text shown below the title.
 func (c *Confirm) Description(desc string) *Confirm {
 	return &Confirm{
-		domain:  c.domain.WithDescription(desc),
-		keymap:  c.keymap,
-		focused: c.focused,
+		domain:       c.domain.WithDescription(desc),
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        c.timer,
+		autoSelected: c.autoSelected,
 	}
 }
 
@@ -103,18 +114,22 @@ func (c *Confirm) WithCancel(enabled bool) *Confirm {
 // DefaultYes focuses the "Yes" button by default.
 func (c *Confirm) DefaultYes() *Confirm {
 	return &Confirm{
-		domain:  c.domain.WithDefaultYes(),
-		keymap:  c.keymap,
-		focused: c.focused,
+		domain:       c.domain.WithDefaultYes(),
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        c.timer,
+		autoSelected: c.autoSelected,
 	}
 }
 
 // DefaultNo focuses the "No" button by default (recommended for dangerous actions).
 func (c *Confirm) DefaultNo() *Confirm {
 	return &Confirm{
-		domain:  c.domain.WithDefaultNo(),
-		keymap:  c.keymap,
-		focused: c.focused,
+		domain:       c.domain.WithDefaultNo(),
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        c.timer,
+		autoSelected: c.autoSelected,
 	}
 }
 
@@ -144,18 +159,32 @@ func (c *Confirm) Result() model.Result {
 }
 
 // Init implements tea.Model.
+// Starts the countdown if a timeout is set.
 func (c *Confirm) Init() tea.Cmd {
-	return nil
+	if c.domain.Done() {
+		return nil
+	}
+	return c.timer.tick()
 }
 
 // Update implements tea.Model.
 func (c *Confirm) Update(msg tea.Msg) (*Confirm, tea.Cmd) {
-	if !c.focused || c.domain.Done() {
+	if c.domain.Done() {
+		return c, nil
+	}
+
+	// The countdown runs whether or not the dialog has focus
+	if tickMsg, ok := msg.(timeoutTickMsg); ok {
+		return c.handleTimeoutTick(tickMsg)
+	}
+
+	if !c.focused {
 		return c, nil
 	}
 
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		return c.handleKey(keyMsg)
+		// Any key press cancels the timeout
+		return c.withoutTimeout().handleKey(keyMsg)
 	}
 
 	return c, nil
@@ -166,9 +195,11 @@ func (c *Confirm) handleKey(msg tea.KeyMsg) (*Confirm, tea.Cmd) {
 	action := c.keymap.GetAction(msg)
 
 	newC := &Confirm{
-		domain:  c.domain,
-		keymap:  c.keymap,
-		focused: c.focused,
+		domain:       c.domain,
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        c.timer,
+		autoSelected: c.autoSelected,
 	}
 
 	switch action {
@@ -227,6 +258,13 @@ func (c *Confirm) renderButtons(b *strings.Builder) {
 
 		isFocused := (i == focused)
 		c.renderButton(b, label, isFocused)
+
+		// Countdown next to the button selected on timeout
+		if isFocused && c.timer.running() {
+			b.WriteString(" (")
+			b.WriteString(c.timer.String())
+			b.WriteString(")")
+		}
 	}
 }
 
@@ -246,9 +284,11 @@ func (c *Confirm) renderButton(b *strings.Builder, label string, focused bool) {
 // withButtons returns a new Confirm with the specified button labels.
 func (c *Confirm) withButtons(labels ...string) *Confirm {
 	return &Confirm{
-		domain:  c.domain.WithButtons(labels...),
-		keymap:  c.keymap,
-		focused: c.focused,
+		domain:       c.domain.WithButtons(labels...),
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        c.timer,
+		autoSelected: c.autoSelected,
 	}
 }
 
@@ -262,10 +302,12 @@ func ConfirmResultCmd(result model.Result) tea.Cmd {
 	}
 }
 
-// ConfirmResultMsg is sent when the user makes a selection.
+// ConfirmResultMsg is sent when the user makes a selection, or when the
+// default is selected because the timeout expired (see WithTimeout).
 // Name intentionally stutters for consistency with Select component (ConfirmSelectionMsg).
 //
 //nolint:revive // Intentional stuttering for API consistency
 type ConfirmResultMsg struct {
-	Result model.Result
+	Result       model.Result
+	AutoSelected bool // True if the timeout expired and the default was selected
 }
@@ -0,0 +1,140 @@
+package confirm
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// lastTimerID hands out IDs so countdown ticks reach only their dialog.
+var lastTimerID atomic.Int64
+
+// timeoutTickMsg advances the countdown with the given id by elapsed.
+// Ticks of a canceled or replaced countdown are ignored.
+type timeoutTickMsg struct {
+	id      int64
+	elapsed time.Duration
+}
+
+// countdown is the state of a timed auto-default.
+type countdown struct {
+	id        int64         // Matches the countdown's ticks
+	remaining time.Duration // Time left; 0 when no countdown is running
+}
+
+// running returns whether the countdown is in progress.
+func (t countdown) running() bool {
+	return t.remaining > 0
+}
+
+// tick returns a command that delivers the next tick: after a second, or
+// after the fraction of a second left over so that later ticks fall on
+// whole seconds remaining. Returns nil if the countdown is not running.
+func (t countdown) tick() tea.Cmd {
+	if !t.running() {
+		return nil
+	}
+
+	wait := t.remaining % time.Second
+	if wait == 0 {
+		wait = time.Second
+	}
+
+	id := t.id
+	return func() tea.Msg {
+		time.Sleep(wait)
+		return timeoutTickMsg{id: id, elapsed: wait}
+	}
+}
+
+// String renders the time left in whole seconds, rounded up (e.g. "10s").
+func (t countdown) String() string {
+	seconds := (t.remaining + time.Second - 1) / time.Second
+	return strconv.FormatInt(int64(seconds), 10) + "s"
+}
+
+// WithTimeout selects the default button automatically once d has passed
+// without a key press, so that prompts in unattended tools cannot block
+// forever. The time left is shown next to the default button, e.g.
+// "[ No ] (10s)". Any key press cancels the timeout. On expiry a
+// ConfirmResultMsg with AutoSelected set is sent.
+//
+// The countdown starts with Init. A duration of 0 or less disables the
+// timeout.
+func (c *Confirm) WithTimeout(d time.Duration) *Confirm {
+	timer := countdown{}
+	if d > 0 {
+		timer = countdown{id: lastTimerID.Add(1), remaining: d}
+	}
+
+	return &Confirm{
+		domain:       c.domain,
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        timer,
+		autoSelected: c.autoSelected,
+	}
+}
+
+// Remaining returns the time left before the default is selected, or 0 if
+// no timeout is running.
+func (c *Confirm) Remaining() time.Duration {
+	return c.timer.remaining
+}
+
+// AutoSelected returns true if the result was selected because the
+// timeout expired rather than by the user.
+func (c *Confirm) AutoSelected() bool {
+	return c.autoSelected
+}
+
+// withoutTimeout returns a Confirm with the countdown canceled.
+func (c *Confirm) withoutTimeout() *Confirm {
+	if !c.timer.running() {
+		return c
+	}
+
+	return &Confirm{
+		domain:       c.domain,
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        countdown{},
+		autoSelected: c.autoSelected,
+	}
+}
+
+// handleTimeoutTick advances the countdown, selecting the default button
+// when it runs out.
+func (c *Confirm) handleTimeoutTick(msg timeoutTickMsg) (*Confirm, tea.Cmd) {
+	if !c.timer.running() || msg.id != c.timer.id {
+		return c, nil
+	}
+
+	timer := countdown{id: c.timer.id, remaining: c.timer.remaining - msg.elapsed}
+	if timer.running() {
+		return &Confirm{
+			domain:       c.domain,
+			keymap:       c.keymap,
+			focused:      c.focused,
+			timer:        timer,
+			autoSelected: c.autoSelected,
+		}, timer.tick()
+	}
+
+	// Expired: the focused button is still the default, as any key
+	// press would have canceled the countdown.
+	newC := &Confirm{
+		domain:       c.domain.Confirm(),
+		keymap:       c.keymap,
+		focused:      c.focused,
+		timer:        countdown{},
+		autoSelected: true,
+	}
+	result := newC.domain.Result()
+
+	return newC, func() tea.Msg {
+		return ConfirmResultMsg{Result: result, AutoSelected: true}
+	}
+}
@@ -0,0 +1,143 @@
+package confirm
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// expire delivers ticks to c until the countdown stops, without waiting.
+func expire(t *testing.T, c *Confirm) (*Confirm, tea.Cmd) {
+	t.Helper()
+	var cmd tea.Cmd
+	for i := 0; c.timer.running(); i++ {
+		if i > 100 {
+			t.Fatal("countdown did not expire")
+		}
+		wait := c.timer.remaining % time.Second
+		if wait == 0 {
+			wait = time.Second
+		}
+		c, cmd = c.Update(timeoutTickMsg{id: c.timer.id, elapsed: wait})
+	}
+	return c, cmd
+}
+
+func TestWithTimeout_Countdown(t *testing.T) {
+	c := New("Overwrite?").DefaultNo().WithTimeout(10 * time.Second)
+
+	if c.Init() == nil {
+		t.Fatal("Init() should start the countdown")
+	}
+	if !strings.Contains(c.View(), "[ No ] (10s)") {
+		t.Errorf("View() should show countdown next to default button, got:\n%s", c.View())
+	}
+
+	c, cmd := c.Update(timeoutTickMsg{id: c.timer.id, elapsed: time.Second})
+	if cmd == nil {
+		t.Error("tick should schedule the next tick")
+	}
+	if c.Remaining() != 9*time.Second {
+		t.Errorf("Remaining() = %v, want 9s", c.Remaining())
+	}
+	if !strings.Contains(c.View(), "(9s)") {
+		t.Errorf("View() should show updated countdown, got:\n%s", c.View())
+	}
+}
+
+func TestWithTimeout_AutoSelectsDefault(t *testing.T) {
+	tests := []struct {
+		name    string
+		confirm *Confirm
+		wantYes bool
+	}{
+		{"default no", New("Continue?").DefaultNo(), false},
+		{"default yes", New("Continue?").DefaultYes(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, cmd := expire(t, tt.confirm.WithTimeout(1500*time.Millisecond))
+
+			if !c.Done() || !c.AutoSelected() {
+				t.Fatalf("Done() = %v, AutoSelected() = %v, want both true", c.Done(), c.AutoSelected())
+			}
+			if c.IsYes() != tt.wantYes {
+				t.Errorf("IsYes() = %v, want %v", c.IsYes(), tt.wantYes)
+			}
+			if cmd == nil {
+				t.Fatal("expiry should send ConfirmResultMsg")
+			}
+			msg, ok := cmd().(ConfirmResultMsg)
+			if !ok || !msg.AutoSelected || msg.Result != c.Result() {
+				t.Errorf("expiry msg = %+v, want auto-selected %v", msg, c.Result())
+			}
+			if strings.Contains(c.View(), "s)") {
+				t.Error("View() should not show countdown after expiry")
+			}
+		})
+	}
+}
+
+func TestWithTimeout_FractionalTicks(t *testing.T) {
+	c := New("Continue?").WithTimeout(1500 * time.Millisecond)
+
+	if got := c.timer.String(); got != "2s" {
+		t.Errorf("countdown = %q, want %q (rounded up)", got, "2s")
+	}
+
+	// The first tick covers the fraction so later ticks land on whole seconds
+	c, _ = c.Update(timeoutTickMsg{id: c.timer.id, elapsed: 500 * time.Millisecond})
+	if c.Remaining() != time.Second {
+		t.Errorf("Remaining() = %v, want 1s", c.Remaining())
+	}
+}
+
+func TestWithTimeout_KeyPressCancels(t *testing.T) {
+	c := New("Continue?").WithTimeout(5 * time.Second)
+	id := c.timer.id
+
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if c.Remaining() != 0 {
+		t.Errorf("Remaining() = %v after key press, want 0", c.Remaining())
+	}
+	if strings.Contains(c.View(), "(5s)") {
+		t.Error("View() should not show countdown after key press")
+	}
+
+	// Ticks already in flight are ignored
+	c, cmd := c.Update(timeoutTickMsg{id: id, elapsed: 5 * time.Second})
+	if c.Done() || cmd != nil {
+		t.Error("tick after cancellation should be ignored")
+	}
+
+	c, cmd = c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(ConfirmResultMsg); !ok || msg.AutoSelected || c.AutoSelected() {
+		t.Errorf("user selection should not be auto-selected, got %+v", msg)
+	}
+}
+
+func TestWithTimeout_IgnoresOtherTicks(t *testing.T) {
+	c := New("Continue?").WithTimeout(5 * time.Second)
+	other := New("Other?").WithTimeout(5 * time.Second)
+
+	c, cmd := c.Update(timeoutTickMsg{id: other.timer.id, elapsed: 5 * time.Second})
+	if c.Done() || cmd != nil || c.Remaining() != 5*time.Second {
+		t.Error("ticks of another dialog should be ignored")
+	}
+}
+
+func TestWithTimeout_Disabled(t *testing.T) {
+	c := New("Continue?")
+	if c.Init() != nil {
+		t.Error("Init() without timeout should return nil")
+	}
+	if c.WithTimeout(0).Init() != nil || c.WithTimeout(-time.Second).Init() != nil {
+		t.Error("non-positive timeout should not start a countdown")
+	}
+	if got := c.WithTimeout(time.Second).WithTimeout(0).Remaining(); got != 0 {
+		t.Errorf("WithTimeout(0) should disable an earlier timeout, Remaining() = %v", got)
+	}
+}
@@ -68,8 +68,6 @@ func (s *simpleInput) SetFocus(focus bool) *simpleInput {
 // model is the application model.
 type model struct {
 	formComponent *form.Form
-	nameInput     *simpleInput
-	emailInput    *simpleInput
 	submitted     bool
 	result        string
 }
@@ -89,8 +87,6 @@ func initialModel() model {
 
 	return model{
 		formComponent: f,
-		nameInput:     nameInput,
-		emailInput:    emailInput,
 		submitted:     false,
 	}
 }
@@ -104,9 +100,10 @@ func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
 	case form.SubmitMsg:
 		// Form submitted successfully (all validations passed)
 		m.submitted = true
+		values := m.formComponent.Values()
 		m.result = fmt.Sprintf("Submitted!\nName: %s\nEmail: %s",
-			m.nameInput.Value(),
-			m.emailInput.Value())
+			values["name"],
+			values["email"])
 		return m, tea.Quit()
 
 	case tea.KeyMsg:
@@ -0,0 +1,156 @@
+package form
+
+import (
+	"reflect"
+
+	"github.com/phoenix-tui/phoenix/components/form/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+var (
+	msgType = reflect.TypeOf((*tea.Msg)(nil)).Elem()
+	cmdType = reflect.TypeOf((*tea.Cmd)(nil)).Elem()
+)
+
+// FocusField moves focus to the field with the given name.
+// Unknown, hidden, and disabled fields are ignored.
+func (f *Form) FocusField(name string) *Form {
+	index, ok := f.fieldNames[name]
+	if !ok {
+		return f
+	}
+
+	newForm := &Form{
+		domain:     f.domain.FocusIndex(index),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+
+	return newForm.syncFocus()
+}
+
+// FocusedField returns the name of the focused field, or "" if the form
+// has no fields.
+func (f *Form) FocusedField() string {
+	field := f.domain.FocusedField()
+	if field == nil {
+		return ""
+	}
+	return field.Name()
+}
+
+// WrapFocus sets whether Tab on the last field moves focus to the first
+// field (and Shift+Tab on the first to the last). Enabled by default.
+func (f *Form) WrapFocus(enabled bool) *Form {
+	return &Form{
+		domain:     f.domain.WithWrap(enabled),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+}
+
+// SetDisabled disables or enables the field with the given name.
+// Disabled fields are still shown but are skipped by navigation.
+func (f *Form) SetDisabled(name string, disabled bool) *Form {
+	index, ok := f.fieldNames[name]
+	if !ok {
+		return f
+	}
+
+	newForm := &Form{
+		domain:     f.domain.WithFieldDisabled(index, disabled),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+
+	return newForm.syncFocus()
+}
+
+// syncFocus tells each field model whether it has focus, so that models
+// such as input.Input show their cursor only while focused. Models without
+// a focus setter are left alone.
+func (f *Form) syncFocus() *Form {
+	fields := f.domain.Fields()
+	focusedIndex := f.domain.FocusedIndex()
+
+	var newFields []*value.Field
+	for i, field := range fields {
+		model, ok := setModelFocus(field.Model(), i == focusedIndex)
+		if !ok {
+			continue
+		}
+		if newFields == nil {
+			newFields = append([]*value.Field(nil), fields...)
+		}
+		newFields[i] = field.WithModel(model)
+	}
+
+	if newFields == nil {
+		return f
+	}
+
+	return &Form{
+		domain:     f.domain.WithFields(newFields),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+}
+
+// setModelFocus sets a field model's focus state, returning the updated
+// model and whether the model supports it. Supported are SetFocus(bool),
+// mutating in place or returning the updated model, and Focused(bool)
+// returning the updated model (like input.Input). Like setStringValue, it
+// calls the setter on a copy of the model.
+func setModelFocus(model value.FieldModel, focused bool) (value.FieldModel, bool) {
+	model = cloneModel(model)
+	if setter, ok := model.(interface{ SetFocus(bool) }); ok {
+		setter.SetFocus(focused)
+		return model, true
+	}
+
+	modelValue := reflect.ValueOf(model)
+	for _, name := range []string{"SetFocus", "Focused"} {
+		if method := modelValue.MethodByName(name); method.IsValid() {
+			if updated, ok := callSetter(method, reflect.ValueOf(focused)); ok {
+				return updated, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// updateModel forwards a message to a field model, returning the updated
+// model, its command, and whether the model handles messages. Components
+// return their concrete type from Update (e.g. (input.Input, tea.Cmd)), so
+// those are called by reflection.
+func updateModel(model value.FieldModel, msg tea.Msg) (value.FieldModel, tea.Cmd, bool) {
+	if updater, ok := model.(interface {
+		Update(tea.Msg) (value.FieldModel, tea.Cmd)
+	}); ok {
+		newModel, cmd := updater.Update(msg)
+		return newModel, cmd, true
+	}
+
+	method := reflect.ValueOf(model).MethodByName("Update")
+	if !method.IsValid() {
+		return nil, nil, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 1 || methodType.In(0) != msgType ||
+		methodType.NumOut() != 2 || methodType.Out(1) != cmdType {
+		return nil, nil, false
+	}
+
+	results := method.Call([]reflect.Value{reflect.ValueOf(&msg).Elem()})
+	newModel, ok := results[0].Interface().(value.FieldModel)
+	if !ok {
+		return nil, nil, false
+	}
+	cmd, _ := results[1].Interface().(tea.Cmd)
+	return newModel, cmd, true
+}
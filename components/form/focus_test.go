@@ -0,0 +1,195 @@
+package form_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/form"
+	"github.com/phoenix-tui/phoenix/components/input"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// recorder is a value-semantics model that records the keys it receives
+// and its focus state.
+type recorder struct {
+	keys    []tea.KeyType
+	focused bool
+}
+
+func (r recorder) View() string { return "" }
+func (r recorder) Focused(focused bool) recorder {
+	r.focused = focused
+	return r
+}
+func (r recorder) Update(msg tea.Msg) (recorder, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		r.keys = append(append([]tea.KeyType(nil), r.keys...), key.Type)
+	}
+	return r, nil
+}
+
+func threeFieldForm() *form.Form {
+	return form.New("Test").
+		Field("a", "A", recorder{}).
+		Field("b", "B", recorder{}).
+		Field("c", "C", recorder{})
+}
+
+func TestTabOrder(t *testing.T) {
+	f := threeFieldForm()
+
+	want := []string{"b", "c", "a"}
+	for _, name := range want {
+		f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab})
+		if got := f.FocusedField(); got != name {
+			t.Errorf("after Tab FocusedField() = %q, want %q", got, name)
+		}
+	}
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab, Shift: true})
+	if got := f.FocusedField(); got != "c" {
+		t.Errorf("after Shift+Tab FocusedField() = %q, want %q", got, "c")
+	}
+}
+
+func TestTabSkipsDisabled(t *testing.T) {
+	f := threeFieldForm().SetDisabled("b", true)
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := f.FocusedField(); got != "c" {
+		t.Errorf("FocusedField() = %q, want %q (skipping disabled)", got, "c")
+	}
+	if !containsSubstring(f.View(), "B: (disabled)") {
+		t.Error("View() should mark disabled field")
+	}
+
+	// Disabling the focused field moves focus on
+	f = f.SetDisabled("c", true)
+	if got := f.FocusedField(); got != "a" {
+		t.Errorf("FocusedField() = %q, want %q after disabling focused field", got, "a")
+	}
+}
+
+func TestWrapFocus(t *testing.T) {
+	f := threeFieldForm().WrapFocus(false).FocusField("c")
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := f.FocusedField(); got != "c" {
+		t.Errorf("Tab on last field without wrap: FocusedField() = %q, want %q", got, "c")
+	}
+
+	f, _ = f.FocusField("a").Update(tea.KeyMsg{Type: tea.KeyTab, Shift: true})
+	if got := f.FocusedField(); got != "a" {
+		t.Errorf("Shift+Tab on first field without wrap: FocusedField() = %q, want %q", got, "a")
+	}
+}
+
+func TestFocusField(t *testing.T) {
+	f := threeFieldForm()
+
+	if got := f.FocusedField(); got != "a" {
+		t.Errorf("FocusedField() = %q, want %q", got, "a")
+	}
+	if got := f.FocusField("c").FocusedField(); got != "c" {
+		t.Errorf("FocusField(c) focused %q", got)
+	}
+	if got := f.FocusField("missing").FocusedField(); got != "a" {
+		t.Errorf("FocusField(missing) focused %q, want unchanged", got)
+	}
+	if got := f.SetDisabled("b", true).FocusField("b").FocusedField(); got != "a" {
+		t.Errorf("FocusField on disabled field focused %q, want unchanged", got)
+	}
+	if got := form.New("Empty").FocusedField(); got != "" {
+		t.Errorf("FocusedField() on empty form = %q, want empty", got)
+	}
+}
+
+func TestEnterMovesThenSubmits(t *testing.T) {
+	f := threeFieldForm()
+
+	f, cmd := f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || f.FocusedField() != "b" {
+		t.Errorf("Enter on first field: FocusedField() = %q, cmd = %v; want move to b", f.FocusedField(), cmd != nil)
+	}
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Enter on last field should submit")
+	}
+	if _, ok := cmd().(form.SubmitMsg); !ok {
+		t.Error("Enter on last field should send SubmitMsg")
+	}
+}
+
+func TestArrowKeysReachFocusedField(t *testing.T) {
+	f := threeFieldForm().FocusField("b")
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyUp})
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	got, ok := f.Value("b").(recorder)
+	if !ok || len(got.keys) != 2 || got.keys[0] != tea.KeyUp || got.keys[1] != tea.KeyDown {
+		t.Errorf("focused field received %v, want [Up Down]", got.keys)
+	}
+	if other := f.Value("a").(recorder); len(other.keys) != 0 {
+		t.Errorf("unfocused field received %v", other.keys)
+	}
+}
+
+func TestFocusSyncedToModels(t *testing.T) {
+	f := threeFieldForm()
+
+	if !f.Value("a").(recorder).focused || f.Value("b").(recorder).focused {
+		t.Error("only the first field's model should be focused initially")
+	}
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if f.Value("a").(recorder).focused || !f.Value("b").(recorder).focused {
+		t.Error("focus should move to the second field's model on Tab")
+	}
+}
+
+// focusable mutates its focus state in place.
+type focusable struct {
+	focused bool
+}
+
+func (p *focusable) View() string          { return "" }
+func (p *focusable) SetFocus(focused bool) { p.focused = focused }
+
+func TestFocusDoesNotMutateSharedModels(t *testing.T) {
+	a, b := &focusable{}, &focusable{}
+	f := form.New("Test").Field("a", "A", a).Field("b", "B", b)
+
+	moved, _ := f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if !moved.Value("b").(*focusable).focused || moved.Value("a").(*focusable).focused {
+		t.Error("focus should move to the second field's model on Tab")
+	}
+	if !f.Value("a").(*focusable).focused || f.Value("b").(*focusable).focused {
+		t.Error("Tab changed the focus of the original form's models")
+	}
+	if a.focused || b.focused {
+		t.Error("the models passed to Field were mutated")
+	}
+}
+
+func TestInputFieldIntegration(t *testing.T) {
+	f := form.New("Test").
+		Field("name", "Name", *input.New(20)).
+		Field("email", "Email", *input.New(20))
+
+	for _, r := range "Ada" {
+		f, _ = f.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+
+	name, ok := f.Value("name").(input.Input)
+	if !ok || name.Value() != "Ada" || !name.IsFocused() {
+		t.Errorf("name input = %q (focused %v), want %q focused", name.Value(), name.IsFocused(), "Ada")
+	}
+	if email := f.Value("email").(input.Input); email.IsFocused() {
+		t.Error("email input should not be focused")
+	}
+	if got := f.Values()["name"]; got != "Ada" {
+		t.Errorf("Values()[name] = %q, want %q", got, "Ada")
+	}
+}
@@ -1,10 +1,13 @@
 // Package form provides a form container component with field management and validation.
 //
 // The Form component allows users to:
-// - Navigate between fields with Tab/Shift+Tab
+// - Navigate between fields with Tab/Shift+Tab, skipping hidden and disabled ones
+// - Move on with Enter, submitting from the last field
 // - Validate fields individually or all at once
+// - Validate rules spanning several fields with CrossValidate
 // - Track dirty and touched state
 // - Submit or reset the form
+// - Show fields conditionally with FieldIf
 //
 // Example (basic form with validation):
 //
@@ -31,10 +34,9 @@
 //
 //	// In your parent model's Update function
 //	case form.SubmitMsg:
-//	    // Access field values through your stored models
-//	    name := nameInput.Value()
-//	    email := emailInput.Value()
-//	    fmt.Printf("Name: %s, Email: %s\n", name, email)
+//	    // Read field values from the form; it holds the current models
+//	    values := f.Values()
+//	    fmt.Printf("Name: %s, Email: %s\n", values["name"], values["email"])
 package form
 
 import (
@@ -55,7 +57,8 @@ type Form struct {
 	theme      *style.Theme // Optional theme, defaults to DefaultTheme if nil
 	domain     *model.Form
 	keymap     *infrastructure.KeyBindingMap
-	fieldNames map[string]int // Maps field name to index
+	fieldNames map[string]int              // Maps field name to index
+	conditions map[string]func(*Form) bool // Visibility conditions of conditional fields by name
 }
 
 // New creates a new Form with the given title.
@@ -64,6 +67,7 @@ func New(title string) *Form {
 		domain:     model.New(title),
 		keymap:     infrastructure.DefaultKeyBindingMap(),
 		fieldNames: make(map[string]int),
+		conditions: make(map[string]func(*Form) bool),
 	}
 }
 
@@ -82,23 +86,108 @@ func (f *Form) Field(name, label string, fieldModel value.FieldModel, validators
 	}
 	newFieldNames[name] = len(fields) - 1
 
-	return &Form{
+	newForm := &Form{
 		domain:     f.domain.WithFields(fields),
 		keymap:     f.keymap,
 		fieldNames: newFieldNames,
+		conditions: f.conditions,
 	}
+
+	return newForm.refreshVisibility().syncFocus()
 }
 
-// Values returns a map of field name → field model.
-// Use type assertion to extract values from the models.
-func (f *Form) Values() map[string]value.FieldModel {
-	result := make(map[string]value.FieldModel)
+// FieldIf adds a conditional field to the form, shown only while condition
+// returns true. A hidden field is not rendered, is skipped by Tab/Shift+Tab
+// navigation, and is ignored by validation. The condition is evaluated
+// against the whole form after every Update, so changing another field
+// (e.g. choosing "Other" in a select) reveals or hides it immediately.
+//
+// Example:
+//
+//	f := form.New("Feedback").
+//	    Field("source", "How did you hear about us?", sourceSelect).
+//	    FieldIf("other", "Please specify", otherInput, func(f *form.Form) bool {
+//	        sel, ok := f.Value("source").(*selectcomponent.Select[string])
+//	        if !ok {
+//	            return false
+//	        }
+//	        v, ok := sel.SelectedValue()
+//	        return ok && v == "other"
+//	    }, value.Required())
+func (f *Form) FieldIf(name, label string, fieldModel value.FieldModel, condition func(*Form) bool, validators ...value.Validator) *Form {
+	conditions := make(map[string]func(*Form) bool, len(f.conditions)+1)
+	for k, v := range f.conditions {
+		conditions[k] = v
+	}
+	conditions[name] = condition
 
-	for _, field := range f.domain.Fields() {
-		result[field.Name()] = field.Model()
+	withConditions := &Form{
+		domain:     f.domain,
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: conditions,
 	}
 
-	return result
+	return withConditions.Field(name, label, fieldModel, validators...)
+}
+
+// VisibleFields returns the names of the fields currently shown, in order.
+// Conditional fields whose condition is false are left out.
+func (f *Form) VisibleFields() []string {
+	fields := f.domain.VisibleFields()
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name()
+	}
+	return names
+}
+
+// refreshVisibility re-evaluates the conditions of conditional fields and
+// shows or hides them accordingly.
+func (f *Form) refreshVisibility() *Form {
+	if len(f.conditions) == 0 {
+		return f
+	}
+
+	fields := f.domain.Fields()
+	visible := make([]bool, len(fields))
+	for i, field := range fields {
+		condition, ok := f.conditions[field.Name()]
+		visible[i] = !ok || condition == nil || condition(f)
+	}
+
+	return &Form{
+		domain:     f.domain.WithVisibility(visible),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+}
+
+// CrossValidate adds a form-level validator for rules spanning several
+// fields, such as a password confirmation that must match the password.
+// Cross validators receive the values of all visible fields by name and run
+// on submit once every field passes its own validators. All of them run,
+// and each error is shown in the form-level error area (see FormErrors).
+//
+// Example:
+//
+//	f := form.New("Sign up").
+//	    Field("password", "Password", passwordInput, value.Required()).
+//	    Field("confirm", "Confirm password", confirmInput, value.Required()).
+//	    CrossValidate(func(values map[string]string) error {
+//	        if values["password"] != values["confirm"] {
+//	            return errors.New("passwords do not match")
+//	        }
+//	        return nil
+//	    })
+func (f *Form) CrossValidate(validator value.CrossValidator) *Form {
+	return &Form{
+		domain:     f.domain.WithCrossValidator(validator),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
 }
 
 // Value returns the model for a specific field by name.
@@ -132,6 +221,17 @@ func (f *Form) Errors() map[string][]string {
 	return f.domain.Errors()
 }
 
+// FormErrors returns the error messages reported by cross validators on the
+// last submit attempt, in the order the validators were added.
+func (f *Form) FormErrors() []string {
+	errs := f.domain.FormErrors()
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return messages
+}
+
 // Init implements tea.Model.
 func (f *Form) Init() tea.Cmd {
 	// Initialize all field models
@@ -153,13 +253,23 @@ func (f *Form) Init() tea.Cmd {
 }
 
 // Update implements tea.Model.
+// Conditional fields are shown or hidden according to the form both before
+// the message is handled and after, so navigation and validation always see
+// current visibility.
 func (f *Form) Update(msg tea.Msg) (*Form, tea.Cmd) {
+	var newForm *Form
+	var cmd tea.Cmd
+
+	f = f.refreshVisibility()
+
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		return f.handleKey(keyMsg)
+		newForm, cmd = f.handleKey(keyMsg)
+	} else {
+		// Delegate message to focused field
+		newForm, cmd = f.delegateToFocusedField(msg)
 	}
 
-	// Delegate message to focused field
-	return f.delegateToFocusedField(msg)
+	return newForm.refreshVisibility().syncFocus(), cmd
 }
 
 // handleKey processes keyboard input.
@@ -168,30 +278,37 @@ func (f *Form) handleKey(msg tea.KeyMsg) (*Form, tea.Cmd) {
 
 	switch action {
 	case infrastructure.ActionNextField:
-		newForm := &Form{
-			domain:     f.domain.MoveFocusNext(),
-			keymap:     f.keymap,
-			fieldNames: f.fieldNames,
-		}
-		return newForm, nil
+		return f.focusNext(), nil
 
 	case infrastructure.ActionPrevField:
 		newForm := &Form{
 			domain:     f.domain.MoveFocusPrev(),
 			keymap:     f.keymap,
 			fieldNames: f.fieldNames,
+			conditions: f.conditions,
 		}
 		return newForm, nil
 
 	case infrastructure.ActionSubmit:
-		// Validate all fields before submit
+		// Enter moves on to the next field until the last one
+		if !f.domain.IsLastFocusable() {
+			return f.focusNext(), nil
+		}
+
+		// Validate all fields before submit, then the form as a whole
 		fieldValues := f.extractFieldValues()
 		newDomain := f.domain.ValidateAll(fieldValues)
+		if newDomain.FieldsValid() {
+			newDomain = newDomain.ValidateCross(f.extractStringValues())
+		} else {
+			newDomain = newDomain.ClearFormErrors()
+		}
 
 		newForm := &Form{
 			domain:     newDomain,
 			keymap:     f.keymap,
 			fieldNames: f.fieldNames,
+			conditions: f.conditions,
 		}
 
 		if newDomain.IsValid() {
@@ -206,6 +323,7 @@ func (f *Form) handleKey(msg tea.KeyMsg) (*Form, tea.Cmd) {
 			domain:     f.domain.Reset(),
 			keymap:     f.keymap,
 			fieldNames: f.fieldNames,
+			conditions: f.conditions,
 		}
 		return newForm, ResetCmd()
 
@@ -217,6 +335,16 @@ func (f *Form) handleKey(msg tea.KeyMsg) (*Form, tea.Cmd) {
 	return f.delegateToFocusedField(msg)
 }
 
+// focusNext moves focus to the next focusable field.
+func (f *Form) focusNext() *Form {
+	return &Form{
+		domain:     f.domain.MoveFocusNext(),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+}
+
 // delegateToFocusedField forwards the message to the currently focused field.
 func (f *Form) delegateToFocusedField(msg tea.Msg) (*Form, tea.Cmd) {
 	focusedIndex := f.domain.FocusedIndex()
@@ -227,16 +355,14 @@ func (f *Form) delegateToFocusedField(msg tea.Msg) (*Form, tea.Cmd) {
 	focusedField := f.domain.Fields()[focusedIndex]
 
 	// Update the focused field's model
-	if updater, ok := focusedField.Model().(interface {
-		Update(tea.Msg) (value.FieldModel, tea.Cmd)
-	}); ok {
-		newModel, cmd := updater.Update(msg)
+	if newModel, cmd, ok := updateModel(focusedField.Model(), msg); ok {
 		newDomain := f.domain.UpdateField(focusedIndex, newModel)
 
 		newForm := &Form{
 			domain:     newDomain,
 			keymap:     f.keymap,
 			fieldNames: f.fieldNames,
+			conditions: f.conditions,
 		}
 
 		return newForm, cmd
@@ -250,7 +376,7 @@ func (f *Form) delegateToFocusedField(msg tea.Msg) (*Form, tea.Cmd) {
 func (f *Form) extractFieldValues() map[string]interface{} {
 	result := make(map[string]interface{})
 
-	for _, field := range f.domain.Fields() {
+	for _, field := range f.domain.VisibleFields() {
 		// Try to extract value using common patterns
 		switch valuer := field.Model().(type) {
 		case interface{ Value() string }:
@@ -268,6 +394,20 @@ func (f *Form) extractFieldValues() map[string]interface{} {
 	return result
 }
 
+// extractStringValues extracts field values as strings for cross validators.
+// Fields whose models expose no value are left out.
+func (f *Form) extractStringValues() map[string]string {
+	result := make(map[string]string)
+
+	for _, field := range f.domain.VisibleFields() {
+		if val, ok := stringValue(field.Model()); ok {
+			result[field.Name()] = val
+		}
+	}
+
+	return result
+}
+
 // View implements tea.Model.
 func (f *Form) View() string {
 	var b strings.Builder
@@ -284,25 +424,41 @@ func (f *Form) View() string {
 	// Render fields
 	f.renderFields(&b)
 
+	// Render form-level errors
+	if formErrors := f.FormErrors(); len(formErrors) > 0 {
+		_ = b.WriteByte('\n')
+		for _, message := range formErrors {
+			_ = b.WriteByte('\n')
+			b.WriteString("✗ ")
+			b.WriteString(message)
+		}
+	}
+
 	// Render help text
 	_ = b.WriteByte('\n')
 	b.WriteString(strings.Repeat("─", 40))
 	_ = b.WriteByte('\n')
-	b.WriteString("Tab: next field  Shift+Tab: prev  Enter: submit")
+	b.WriteString("Tab: next field  Shift+Tab: prev  Enter: next/submit")
 
 	return b.String()
 }
 
-// renderFields renders all form fields.
+// renderFields renders all visible form fields.
 func (f *Form) renderFields(b *strings.Builder) {
 	fields := f.domain.Fields()
 	focusedIndex := f.domain.FocusedIndex()
 
+	rendered := 0
 	for i, field := range fields {
-		if i > 0 {
+		if field.Hidden() {
+			continue
+		}
+
+		if rendered > 0 {
 			_ = b.WriteByte('\n')
 			_ = b.WriteByte('\n')
 		}
+		rendered++
 
 		isFocused := (i == focusedIndex)
 		f.renderField(b, field, isFocused)
@@ -315,7 +471,10 @@ func (f *Form) renderField(b *strings.Builder, field *value.Field, focused bool)
 	b.WriteString(field.Label())
 	b.WriteString(":")
 
-	// Render focus indicator
+	// Render disabled and focus indicators
+	if field.Disabled() {
+		b.WriteString(" (disabled)")
+	}
 	if focused {
 		b.WriteString(" ← focused")
 	}
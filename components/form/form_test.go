@@ -2,10 +2,12 @@ package form_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/form"
 	"github.com/phoenix-tui/phoenix/components/form/internal/domain/value"
+	selectcomponent "github.com/phoenix-tui/phoenix/components/select"
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
 
@@ -33,9 +35,8 @@ func (m *mockValuer) Value() string {
 func TestNew(t *testing.T) {
 	f := form.New("Test Form")
 
-	values := f.Values()
-	if len(values) != 0 {
-		t.Errorf("New form has %d fields, want 0", len(values))
+	if len(f.VisibleFields()) != 0 {
+		t.Errorf("New form has %d fields, want 0", len(f.VisibleFields()))
 	}
 
 	if f.IsValid() != true {
@@ -55,16 +56,11 @@ func TestField(t *testing.T) {
 		Field("name", "Name", model1).
 		Field("email", "Email", model2)
 
-	values := f.Values()
-	if len(values) != 2 {
-		t.Errorf("Form has %d fields, want 2", len(values))
-	}
-
-	if values["name"] != model1 {
+	if f.Value("name") != model1 {
 		t.Error("Field 'name' not stored correctly")
 	}
 
-	if values["email"] != model2 {
+	if f.Value("email") != model2 {
 		t.Error("Field 'email' not stored correctly")
 	}
 }
@@ -315,7 +311,7 @@ func TestFormImmutability(t *testing.T) {
 	}
 
 	// Original should be unchanged
-	if len(f.Values()) != 1 {
+	if len(f.VisibleFields()) != 1 {
 		t.Error("Original form was mutated")
 	}
 }
@@ -333,3 +329,201 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestFieldIfVisibility(t *testing.T) {
+	show := false
+	f := form.New("Test").
+		Field("source", "Source", &mockModel{content: "source"}).
+		FieldIf("other", "Other", &mockModel{content: "other input"}, func(*form.Form) bool { return show }).
+		Field("email", "Email", &mockModel{content: "email"})
+
+	if got := f.VisibleFields(); len(got) != 2 || got[0] != "source" || got[1] != "email" {
+		t.Errorf("VisibleFields() = %v, want [source email]", got)
+	}
+	if strings.Contains(f.View(), "other input") {
+		t.Error("View() should not render hidden field")
+	}
+
+	// Any update re-evaluates the condition
+	show = true
+	f, _ = f.Update(struct{}{})
+
+	if got := f.VisibleFields(); len(got) != 3 || got[1] != "other" {
+		t.Errorf("VisibleFields() = %v, want [source other email]", got)
+	}
+	if !strings.Contains(f.View(), "other input") {
+		t.Error("View() should render field once its condition is true")
+	}
+}
+
+func TestFieldIfConditionSeesForm(t *testing.T) {
+	source := &mockValuer{val: "other"}
+	f := form.New("Test").
+		Field("source", "Source", source).
+		FieldIf("other", "Other", &mockModel{content: "other input"}, func(f *form.Form) bool {
+			v, ok := f.Value("source").(*mockValuer)
+			return ok && v.Value() == "other"
+		})
+
+	if got := f.VisibleFields(); len(got) != 2 {
+		t.Errorf("VisibleFields() = %v, want [source other]", got)
+	}
+
+	source.val = "friend"
+	f, _ = f.Update(struct{}{})
+	if got := f.VisibleFields(); len(got) != 1 || got[0] != "source" {
+		t.Errorf("VisibleFields() = %v, want [source]", got)
+	}
+}
+
+func TestFieldIfSkippedInNavigation(t *testing.T) {
+	f := form.New("Test").
+		Field("source", "Source", &mockModel{content: "source"}).
+		FieldIf("other", "Other", &mockModel{content: "other"}, func(*form.Form) bool { return false }).
+		Field("email", "Email", &mockModel{content: "email"})
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if !strings.Contains(f.View(), "Email: ← focused") {
+		t.Errorf("Tab should skip hidden field, view:\n%s", f.View())
+	}
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyTab, Shift: true})
+	if !strings.Contains(f.View(), "Source: ← focused") {
+		t.Errorf("Shift+Tab should skip hidden field, view:\n%s", f.View())
+	}
+}
+
+func TestFieldIfSkippedInValidation(t *testing.T) {
+	show := false
+	f := form.New("Test").
+		Field("source", "Source", &mockValuer{val: "friend"}, value.Required()).
+		FieldIf("other", "Other", &mockValuer{val: ""}, func(*form.Form) bool { return show }, value.Required())
+
+	_, cmd := f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Submit should succeed when the invalid field is hidden")
+	}
+	if _, ok := cmd().(form.SubmitMsg); !ok {
+		t.Error("Submit command should return SubmitMsg")
+	}
+
+	show = true
+	f, _ = f.Update(struct{}{})
+	f, cmd = f.FocusField("other").Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("Submit should fail once the invalid field is visible")
+	}
+	if _, ok := f.Errors()["other"]; !ok {
+		t.Error("Errors() should report the visible conditional field")
+	}
+
+	// Hiding the field again drops its errors
+	show = false
+	f, _ = f.Update(struct{}{})
+	if !f.IsValid() {
+		t.Errorf("IsValid() = false after hiding field, errors: %v", f.Errors())
+	}
+}
+
+func TestCrossValidate(t *testing.T) {
+	password := &mockValuer{val: "secret"}
+	confirm := &mockValuer{val: "secrte"}
+
+	f := form.New("Sign up").
+		Field("password", "Password", password, value.Required()).
+		Field("confirm", "Confirm", confirm, value.Required()).
+		CrossValidate(func(values map[string]string) error {
+			if values["password"] != values["confirm"] {
+				return errors.New("passwords do not match")
+			}
+			return nil
+		}).
+		FocusField("confirm")
+
+	f, cmd := f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("Submit should not fire when cross validation fails")
+	}
+	if got := f.FormErrors(); len(got) != 1 || got[0] != "passwords do not match" {
+		t.Errorf("FormErrors() = %v, want [passwords do not match]", got)
+	}
+	if f.IsValid() {
+		t.Error("IsValid() = true with form-level errors")
+	}
+	if !strings.Contains(f.View(), "✗ passwords do not match") {
+		t.Errorf("View() should show form-level error, got:\n%s", f.View())
+	}
+
+	confirm.val = "secret"
+	f, cmd = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Submit should fire when cross validation passes")
+	}
+	if _, ok := cmd().(form.SubmitMsg); !ok {
+		t.Error("Submit command should return SubmitMsg")
+	}
+	if len(f.FormErrors()) != 0 {
+		t.Errorf("FormErrors() = %v, want none", f.FormErrors())
+	}
+}
+
+func TestCrossValidateSelect(t *testing.T) {
+	source := selectcomponent.NewString("Source", []string{"search", "other"})
+	source, _ = source.Update(tea.KeyMsg{Type: tea.KeyDown})
+	source, _ = source.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	var got map[string]string
+	f := form.New("Survey").
+		Field("source", "Source", source).
+		Field("detail", "Detail", &mockValuer{val: ""}).
+		CrossValidate(func(values map[string]string) error {
+			got = values
+			if values["source"] == "other" && values["detail"] == "" {
+				return errors.New("tell us where you heard about us")
+			}
+			return nil
+		}).
+		FocusField("detail")
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if got["source"] != "other" {
+		t.Errorf("cross validator values = %v, want the selected source", got)
+	}
+	if errs := f.FormErrors(); len(errs) != 1 || errs[0] != "tell us where you heard about us" {
+		t.Errorf("FormErrors() = %v, want the select-dependent error", errs)
+	}
+}
+
+func TestCrossValidateAllErrors(t *testing.T) {
+	f := form.New("Test").
+		Field("a", "A", &mockValuer{val: "x"}).
+		CrossValidate(func(map[string]string) error { return errors.New("first") }).
+		CrossValidate(func(map[string]string) error { return nil }).
+		CrossValidate(func(map[string]string) error { return errors.New("second") })
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if got := f.FormErrors(); len(got) != 2 || got[0] != "first" || got[1] != "second" {
+		t.Errorf("FormErrors() = %v, want [first second]", got)
+	}
+}
+
+func TestCrossValidateAfterFieldValidation(t *testing.T) {
+	called := false
+	f := form.New("Test").
+		Field("a", "A", &mockValuer{val: ""}, value.Required()).
+		CrossValidate(func(map[string]string) error {
+			called = true
+			return errors.New("cross")
+		})
+
+	f, _ = f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if called {
+		t.Error("cross validators should not run while a field is invalid")
+	}
+	if len(f.FormErrors()) != 0 {
+		t.Errorf("FormErrors() = %v, want none", f.FormErrors())
+	}
+}
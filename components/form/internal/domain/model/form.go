@@ -11,15 +11,22 @@ type Form struct {
 	fields    []*value.Field
 	focused   int
 	submitted bool
+	wrap      bool // Whether focus wraps around from the last field to the first
+
+	crossValidators []value.CrossValidator // Form-level validators, run after field validation
+	formErrors      []error                // Errors reported by cross validators
 }
 
 // New creates a new Form with the given title.
 func New(title string) *Form {
 	return &Form{
-		title:     title,
-		fields:    []*value.Field{},
-		focused:   0,
-		submitted: false,
+		title:           title,
+		fields:          []*value.Field{},
+		focused:         0,
+		submitted:       false,
+		wrap:            true,
+		crossValidators: nil,
+		formErrors:      nil,
 	}
 }
 
@@ -51,10 +58,13 @@ func (f *Form) WithFields(fields []*value.Field) *Form {
 	}
 
 	return &Form{
-		title:     f.title,
-		fields:    fields,
-		focused:   focused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          fields,
+		focused:         focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
@@ -66,13 +76,35 @@ func (f *Form) FocusedField() *value.Field {
 	return f.fields[f.focused]
 }
 
-// MoveFocusNext moves focus to the next field (wraps around).
+// Wrap returns whether focus wraps around between the last and first field.
+func (f *Form) Wrap() bool {
+	return f.wrap
+}
+
+// WithWrap returns a new form with focus wrapping enabled or disabled.
+func (f *Form) WithWrap(wrap bool) *Form {
+	return &Form{
+		title:           f.title,
+		fields:          f.fields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
+	}
+}
+
+// MoveFocusNext moves focus to the next focusable field, wrapping around
+// from the last field to the first if wrapping is enabled.
 func (f *Form) MoveFocusNext() *Form {
 	if len(f.fields) == 0 {
 		return f
 	}
 
-	newFocused := (f.focused + 1) % len(f.fields)
+	newFocused := f.findFocusable(f.focused, 1, f.wrap)
+	if newFocused < 0 {
+		newFocused = f.focused
+	}
 
 	// Mark current field as touched when leaving it
 	newFields := make([]*value.Field, len(f.fields))
@@ -82,22 +114,26 @@ func (f *Form) MoveFocusNext() *Form {
 	}
 
 	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   newFocused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          newFields,
+		focused:         newFocused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
-// MoveFocusPrev moves focus to the previous field (wraps around).
+// MoveFocusPrev moves focus to the previous focusable field, wrapping around
+// from the first field to the last if wrapping is enabled.
 func (f *Form) MoveFocusPrev() *Form {
 	if len(f.fields) == 0 {
 		return f
 	}
 
-	newFocused := f.focused - 1
+	newFocused := f.findFocusable(f.focused, -1, f.wrap)
 	if newFocused < 0 {
-		newFocused = len(f.fields) - 1
+		newFocused = f.focused
 	}
 
 	// Mark current field as touched when leaving it
@@ -108,11 +144,140 @@ func (f *Form) MoveFocusPrev() *Form {
 	}
 
 	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   newFocused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          newFields,
+		focused:         newFocused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
+	}
+}
+
+// FocusIndex moves focus to the field at the given index.
+// Returns the form unchanged if the index is out of range or the field
+// cannot be focused.
+func (f *Form) FocusIndex(index int) *Form {
+	if index < 0 || index >= len(f.fields) || !f.focusable(index) {
+		return f
+	}
+
+	return &Form{
+		title:           f.title,
+		fields:          f.fields,
+		focused:         index,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
+	}
+}
+
+// IsLastFocusable returns whether no focusable field follows the focused one.
+func (f *Form) IsLastFocusable() bool {
+	return f.findFocusable(f.focused, 1, false) < 0
+}
+
+// WithFieldDisabled returns a new form with the field at the given index
+// disabled or enabled. Focus moves off the field if it becomes disabled.
+func (f *Form) WithFieldDisabled(index int, disabled bool) *Form {
+	if index < 0 || index >= len(f.fields) {
+		return f
+	}
+
+	newFields := make([]*value.Field, len(f.fields))
+	copy(newFields, f.fields)
+	newFields[index] = newFields[index].WithDisabled(disabled)
+
+	newForm := &Form{
+		title:           f.title,
+		fields:          newFields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
+	newForm.ensureFocusable()
+	return newForm
+}
+
+// focusable returns whether the field at index can receive focus.
+func (f *Form) focusable(index int) bool {
+	field := f.fields[index]
+	return !field.Hidden() && !field.Disabled()
+}
+
+// findFocusable returns the index of the first focusable field after from in
+// the given direction, wrapping around if wrap is set, or -1 if there is none.
+func (f *Form) findFocusable(from, step int, wrap bool) int {
+	n := len(f.fields)
+	for i := 1; i < n; i++ {
+		index := from + step*i
+		if !wrap && (index < 0 || index >= n) {
+			return -1
+		}
+		index = (index%n + n) % n
+		if f.focusable(index) {
+			return index
+		}
+	}
+	return -1
+}
+
+// ensureFocusable moves focus off a field that cannot be focused, to the
+// next focusable field or else the previous one. Only used on newly built
+// forms, before they are returned.
+func (f *Form) ensureFocusable() {
+	if f.focused >= len(f.fields) || f.focusable(f.focused) {
+		return
+	}
+	if next := f.findFocusable(f.focused, 1, false); next >= 0 {
+		f.focused = next
+	} else if prev := f.findFocusable(f.focused, -1, false); prev >= 0 {
+		f.focused = prev
+	}
+}
+
+// WithVisibility returns a new form with each field shown or hidden as
+// given by visible (indexed like Fields). Hidden fields lose their errors,
+// and focus moves on to another field if the focused one is hidden.
+func (f *Form) WithVisibility(visible []bool) *Form {
+	newFields := make([]*value.Field, len(f.fields))
+	copy(newFields, f.fields)
+	for i := range newFields {
+		hidden := i < len(visible) && !visible[i]
+		if hidden == newFields[i].Hidden() {
+			continue
+		}
+		newFields[i] = newFields[i].WithHidden(hidden)
+		if hidden {
+			newFields[i] = newFields[i].ClearErrors()
+		}
+	}
+
+	newForm := &Form{
+		title:           f.title,
+		fields:          newFields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
+	}
+	newForm.ensureFocusable()
+	return newForm
+}
+
+// VisibleFields returns the fields that are not hidden, in order.
+func (f *Form) VisibleFields() []*value.Field {
+	result := make([]*value.Field, 0, len(f.fields))
+	for _, field := range f.fields {
+		if !field.Hidden() {
+			result = append(result, field)
+		}
+	}
+	return result
 }
 
 // UpdateField updates the field at the given index with a new model.
@@ -131,10 +296,13 @@ func (f *Form) UpdateField(index int, model interface{}) *Form {
 	}
 
 	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   f.focused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          newFields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
@@ -160,19 +328,26 @@ func (f *Form) ValidateField(index int, fieldValue interface{}) *Form {
 	newFields[index] = newFields[index].WithErrors(errors)
 
 	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   f.focused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          newFields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
-// ValidateAll validates all fields.
+// ValidateAll validates all visible fields. Hidden fields are skipped.
 func (f *Form) ValidateAll(fieldValues map[string]interface{}) *Form {
 	newFields := make([]*value.Field, len(f.fields))
 	copy(newFields, f.fields)
 
 	for i, field := range newFields {
+		if field.Hidden() {
+			continue
+		}
+
 		var errors []error
 
 		// Get value for this field
@@ -192,30 +367,101 @@ func (f *Form) ValidateAll(fieldValues map[string]interface{}) *Form {
 	}
 
 	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   f.focused,
-		submitted: f.submitted,
+		title:           f.title,
+		fields:          newFields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
-// IsValid returns whether all fields are valid.
+// IsValid returns whether all visible fields are valid and no cross
+// validator has reported an error.
 func (f *Form) IsValid() bool {
+	return f.FieldsValid() && len(f.formErrors) == 0
+}
+
+// FieldsValid returns whether all visible fields are valid, ignoring
+// form-level errors.
+func (f *Form) FieldsValid() bool {
 	for _, field := range f.fields {
-		if !field.IsValid() {
+		if !field.Hidden() && !field.IsValid() {
 			return false
 		}
 	}
 	return true
 }
 
+// CrossValidators returns the form-level validators.
+func (f *Form) CrossValidators() []value.CrossValidator {
+	return f.crossValidators
+}
+
+// FormErrors returns the errors reported by cross validators.
+func (f *Form) FormErrors() []error {
+	return f.formErrors
+}
+
+// WithCrossValidator returns a new form with the cross validator added.
+func (f *Form) WithCrossValidator(validator value.CrossValidator) *Form {
+	validators := make([]value.CrossValidator, 0, len(f.crossValidators)+1)
+	validators = append(validators, f.crossValidators...)
+	validators = append(validators, validator)
+
+	return &Form{
+		title:           f.title,
+		fields:          f.fields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: validators,
+		formErrors:      f.formErrors,
+	}
+}
+
+// ValidateCross runs all cross validators against the given field values,
+// collecting every error they report.
+func (f *Form) ValidateCross(fieldValues map[string]string) *Form {
+	var errors []error
+	for _, validator := range f.crossValidators {
+		if err := validator(fieldValues); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	return f.withFormErrors(errors)
+}
+
+// ClearFormErrors returns a new form without form-level errors.
+func (f *Form) ClearFormErrors() *Form {
+	return f.withFormErrors(nil)
+}
+
+// withFormErrors returns a new form with the specified form-level errors.
+func (f *Form) withFormErrors(errors []error) *Form {
+	return &Form{
+		title:           f.title,
+		fields:          f.fields,
+		focused:         f.focused,
+		submitted:       f.submitted,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      errors,
+	}
+}
+
 // Submit marks the form as submitted.
 func (f *Form) Submit() *Form {
 	return &Form{
-		title:     f.title,
-		fields:    f.fields,
-		focused:   f.focused,
-		submitted: true,
+		title:           f.title,
+		fields:          f.fields,
+		focused:         f.focused,
+		submitted:       true,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      f.formErrors,
 	}
 }
 
@@ -225,23 +471,30 @@ func (f *Form) Reset() *Form {
 	newFields := make([]*value.Field, len(f.fields))
 	for i, field := range f.fields {
 		newFields[i] = value.NewField(field.Name(), field.Label(), field.Model()).
-			WithValidators(field.Validators()...)
+			WithValidators(field.Validators()...).
+			WithHidden(field.Hidden()).
+			WithDisabled(field.Disabled())
 	}
 
-	return &Form{
-		title:     f.title,
-		fields:    newFields,
-		focused:   0,
-		submitted: false,
+	newForm := &Form{
+		title:           f.title,
+		fields:          newFields,
+		focused:         0,
+		submitted:       false,
+		wrap:            f.wrap,
+		crossValidators: f.crossValidators,
+		formErrors:      nil,
 	}
+	newForm.ensureFocusable()
+	return newForm
 }
 
-// Errors returns a map of field name → error messages.
+// Errors returns a map of field name → error messages for visible fields.
 func (f *Form) Errors() map[string][]string {
 	result := make(map[string][]string)
 
 	for _, field := range f.fields {
-		if len(field.Errors()) > 0 {
+		if !field.Hidden() && len(field.Errors()) > 0 {
 			messages := make([]string, len(field.Errors()))
 			for i, err := range field.Errors() {
 				messages[i] = err.Error()
@@ -338,3 +338,110 @@ func TestErrors(t *testing.T) {
 		t.Error("Errors() should not include fields without errors")
 	}
 }
+
+func TestWithVisibilityMovesFocus(t *testing.T) {
+	form := model.New("Test").WithFields([]*value.Field{
+		value.NewField("a", "A", &mockModel{}),
+		value.NewField("b", "B", &mockModel{}),
+		value.NewField("c", "C", &mockModel{}),
+	}).MoveFocusNext()
+
+	hidden := form.WithVisibility([]bool{true, false, true})
+
+	if hidden.FocusedIndex() != 2 {
+		t.Errorf("FocusedIndex() = %d, want 2 (focus moves off hidden field)", hidden.FocusedIndex())
+	}
+	if len(hidden.VisibleFields()) != 2 {
+		t.Errorf("VisibleFields() = %d fields, want 2", len(hidden.VisibleFields()))
+	}
+	if next := hidden.MoveFocusNext(); next.FocusedIndex() != 0 {
+		t.Errorf("MoveFocusNext() focused %d, want 0", next.FocusedIndex())
+	}
+	if prev := hidden.MoveFocusNext().MoveFocusPrev(); prev.FocusedIndex() != 2 {
+		t.Errorf("MoveFocusPrev() focused %d, want 2 (skipping hidden field)", prev.FocusedIndex())
+	}
+}
+
+func TestHiddenFieldsIgnoredByValidation(t *testing.T) {
+	form := model.New("Test").WithFields([]*value.Field{
+		value.NewField("a", "A", &mockModel{}).WithValidators(value.Required()),
+		value.NewField("b", "B", &mockModel{}).WithValidators(value.Required()),
+	}).WithVisibility([]bool{true, false})
+
+	validated := form.ValidateAll(map[string]interface{}{"a": "x", "b": ""})
+
+	if !validated.IsValid() {
+		t.Errorf("IsValid() = false, errors: %v", validated.Errors())
+	}
+
+	shown := validated.WithVisibility([]bool{true, true}).
+		ValidateAll(map[string]interface{}{"a": "x", "b": ""})
+	if shown.IsValid() {
+		t.Error("IsValid() = true, want false once field is visible")
+	}
+	if len(shown.WithVisibility([]bool{true, false}).Errors()) != 0 {
+		t.Error("hiding a field should clear its errors")
+	}
+}
+
+func TestValidateCross(t *testing.T) {
+	form := model.New("Test").
+		WithCrossValidator(func(values map[string]string) error {
+			if values["a"] != values["b"] {
+				return errors.New("a and b differ")
+			}
+			return nil
+		})
+
+	invalid := form.ValidateCross(map[string]string{"a": "1", "b": "2"})
+	if invalid.IsValid() || !invalid.FieldsValid() {
+		t.Errorf("IsValid() = %v, FieldsValid() = %v, want false, true", invalid.IsValid(), invalid.FieldsValid())
+	}
+	if len(invalid.FormErrors()) != 1 {
+		t.Errorf("FormErrors() = %v, want 1 error", invalid.FormErrors())
+	}
+	if len(form.FormErrors()) != 0 {
+		t.Error("Original form was mutated")
+	}
+	if !invalid.ClearFormErrors().IsValid() {
+		t.Error("ClearFormErrors() should make the form valid")
+	}
+	if len(invalid.Reset().FormErrors()) != 0 || len(invalid.Reset().CrossValidators()) != 1 {
+		t.Error("Reset() should clear form errors and keep cross validators")
+	}
+}
+
+func TestFocusNavigationWithoutWrap(t *testing.T) {
+	form := model.New("Test").WithFields([]*value.Field{
+		value.NewField("a", "A", &mockModel{}),
+		value.NewField("b", "B", &mockModel{}),
+		value.NewField("c", "C", &mockModel{}),
+	}).WithWrap(false).WithFieldDisabled(1, true)
+
+	if form.Wrap() {
+		t.Error("Wrap() = true, want false")
+	}
+
+	last := form.MoveFocusNext()
+	if last.FocusedIndex() != 2 {
+		t.Errorf("MoveFocusNext() focused %d, want 2 (skipping disabled)", last.FocusedIndex())
+	}
+	if !last.IsLastFocusable() {
+		t.Error("IsLastFocusable() = false on last field")
+	}
+	if form.IsLastFocusable() {
+		t.Error("IsLastFocusable() = true on first field")
+	}
+	if got := last.MoveFocusNext().FocusedIndex(); got != 2 {
+		t.Errorf("MoveFocusNext() on last field without wrap focused %d, want 2", got)
+	}
+	if got := form.MoveFocusPrev().FocusedIndex(); got != 0 {
+		t.Errorf("MoveFocusPrev() on first field without wrap focused %d, want 0", got)
+	}
+	if got := form.FocusIndex(1).FocusedIndex(); got != 0 {
+		t.Errorf("FocusIndex(disabled) focused %d, want 0", got)
+	}
+	if got := form.FocusIndex(2).FocusedIndex(); got != 2 {
+		t.Errorf("FocusIndex(2) focused %d, want 2", got)
+	}
+}
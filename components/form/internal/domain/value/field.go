@@ -15,6 +15,8 @@ type Field struct {
 	touched    bool
 	dirty      bool
 	errors     []error
+	hidden     bool // Hidden fields are not shown, focused, or validated
+	disabled   bool // Disabled fields are shown but cannot be focused
 }
 
 // NewField creates a new field with the given name, label, and model.
@@ -27,6 +29,8 @@ func NewField(name, label string, model FieldModel) *Field {
 		touched:    false,
 		dirty:      false,
 		errors:     []error{},
+		hidden:     false,
+		disabled:   false,
 	}
 }
 
@@ -60,6 +64,11 @@ func (f *Field) Dirty() bool {
 	return f.dirty
 }
 
+// Hidden returns whether the field is hidden because its condition is false.
+func (f *Field) Hidden() bool {
+	return f.hidden
+}
+
 // Errors returns the validation errors.
 func (f *Field) Errors() []error {
 	return f.errors
@@ -75,6 +84,8 @@ func (f *Field) WithValidators(validators ...Validator) *Field {
 		touched:    f.touched,
 		dirty:      f.dirty,
 		errors:     f.errors,
+		hidden:     f.hidden,
+		disabled:   f.disabled,
 	}
 }
 
@@ -88,6 +99,8 @@ func (f *Field) WithModel(model FieldModel) *Field {
 		touched:    f.touched,
 		dirty:      f.dirty,
 		errors:     f.errors,
+		hidden:     f.hidden,
+		disabled:   f.disabled,
 	}
 }
 
@@ -101,6 +114,8 @@ func (f *Field) MarkTouched() *Field {
 		touched:    true,
 		dirty:      f.dirty,
 		errors:     f.errors,
+		hidden:     f.hidden,
+		disabled:   f.disabled,
 	}
 }
 
@@ -114,6 +129,8 @@ func (f *Field) MarkDirty() *Field {
 		touched:    f.touched,
 		dirty:      true,
 		errors:     f.errors,
+		hidden:     f.hidden,
+		disabled:   f.disabled,
 	}
 }
 
@@ -127,6 +144,8 @@ func (f *Field) WithErrors(errors []error) *Field {
 		touched:    f.touched,
 		dirty:      f.dirty,
 		errors:     errors,
+		hidden:     f.hidden,
+		disabled:   f.disabled,
 	}
 }
 
@@ -140,6 +159,43 @@ func (f *Field) ClearErrors() *Field {
 		touched:    f.touched,
 		dirty:      f.dirty,
 		errors:     []error{},
+		hidden:     f.hidden,
+		disabled:   f.disabled,
+	}
+}
+
+// Disabled returns whether the field is disabled.
+func (f *Field) Disabled() bool {
+	return f.disabled
+}
+
+// WithDisabled returns a new field with the specified disabled state.
+func (f *Field) WithDisabled(disabled bool) *Field {
+	return &Field{
+		name:       f.name,
+		label:      f.label,
+		model:      f.model,
+		validators: f.validators,
+		touched:    f.touched,
+		dirty:      f.dirty,
+		errors:     f.errors,
+		hidden:     f.hidden,
+		disabled:   disabled,
+	}
+}
+
+// WithHidden returns a new field with the specified visibility.
+func (f *Field) WithHidden(hidden bool) *Field {
+	return &Field{
+		name:       f.name,
+		label:      f.label,
+		model:      f.model,
+		validators: f.validators,
+		touched:    f.touched,
+		dirty:      f.dirty,
+		errors:     f.errors,
+		hidden:     hidden,
+		disabled:   f.disabled,
 	}
 }
 
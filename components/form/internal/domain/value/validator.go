@@ -10,6 +10,12 @@ import (
 // Validator is a function that validates a value and returns an error if invalid.
 type Validator func(value interface{}) error
 
+// CrossValidator is a function that validates the form as a whole, given the
+// values of its visible fields by name, and returns an error if invalid.
+// It is used for rules spanning several fields, such as a password
+// confirmation that must match the password.
+type CrossValidator func(values map[string]string) error
+
 // Required validates that a value is not empty.
 func Required() Validator {
 	return func(value interface{}) error {
@@ -0,0 +1,239 @@
+package form
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/phoenix-tui/phoenix/components/form/internal/domain/value"
+)
+
+// bindTag is the struct tag naming the form field a struct field binds to.
+const bindTag = "phoenix"
+
+// ErrInvalidBindTarget is returned by Bind when its argument is not a
+// non-nil pointer to a struct.
+var ErrInvalidBindTarget = errors.New("form: bind target must be a non-nil pointer to a struct")
+
+// Values returns a map of field name → field value as a string, for saving
+// form state (e.g. as JSON or config). Fields whose models expose no value
+// are left out; use Value to access the model itself.
+func (f *Form) Values() map[string]string {
+	result := make(map[string]string)
+
+	for _, field := range f.domain.Fields() {
+		if val, ok := stringValue(field.Model()); ok {
+			result[field.Name()] = val
+		}
+	}
+
+	return result
+}
+
+// SetValues returns a new form with field values restored from a map of
+// field name → value, such as one produced by Values. Keys without a
+// matching field, and fields whose models cannot be set from a string, are
+// ignored; fields missing from the map keep their values.
+//
+// A model can be set if it has a SetValue(string) method, either mutating
+// in place or returning the updated model, or a SetContent(string, int)
+// method like input.Input. Pointer models are copied before they are set,
+// so the original form keeps its values.
+func (f *Form) SetValues(values map[string]string) *Form {
+	fields := append([]*value.Field(nil), f.domain.Fields()...)
+
+	for name, val := range values {
+		index, ok := f.fieldNames[name]
+		if !ok || index >= len(fields) {
+			continue
+		}
+		if model, ok := setStringValue(fields[index].Model(), val); ok {
+			fields[index] = fields[index].WithModel(model)
+		}
+	}
+
+	newForm := &Form{
+		domain:     f.domain.WithFields(fields),
+		keymap:     f.keymap,
+		fieldNames: f.fieldNames,
+		conditions: f.conditions,
+	}
+
+	return newForm.refreshVisibility()
+}
+
+// Bind copies the form values into the struct pointed to by ptr. Struct
+// fields are matched to form fields by their `phoenix` tag:
+//
+//	type Signup struct {
+//	    Name  string `phoenix:"name"`
+//	    Age   int    `phoenix:"age"`
+//	    Terms bool   `phoenix:"terms"`
+//	}
+//
+// Untagged struct fields and form fields without a struct field are
+// ignored, and struct fields whose form field has no value are left as
+// they are. Values are converted to strings, bools, integers, floats, or
+// any type implementing encoding.TextUnmarshaler; values that cannot be
+// converted are reported together in the returned error, while the other
+// fields are still set.
+func (f *Form) Bind(ptr any) error {
+	target := reflect.ValueOf(ptr)
+	if target.Kind() != reflect.Pointer || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w, got %T", ErrInvalidBindTarget, ptr)
+	}
+
+	values := f.Values()
+	target = target.Elem()
+	targetType := target.Type()
+
+	var errs []error
+	for i := 0; i < targetType.NumField(); i++ {
+		structField := targetType.Field(i)
+		name := structField.Tag.Get(bindTag)
+		if name == "" || name == "-" || !structField.IsExported() {
+			continue
+		}
+
+		val, ok := values[name]
+		if !ok {
+			continue
+		}
+
+		if err := setField(target.Field(i), val); err != nil {
+			errs = append(errs, fmt.Errorf("form: field %q: cannot bind %q to %s.%s: %w",
+				name, val, targetType.Name(), structField.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// stringValue extracts a field model's value as a string.
+// Models expose their value through Value() string (like input.Input) or
+// a SelectedValue() (T, bool) method for any T (like select.Select[T]),
+// which is found by reflection since it cannot be matched by an interface.
+func stringValue(model value.FieldModel) (string, bool) {
+	if valuer, ok := model.(interface{ Value() string }); ok {
+		return valuer.Value(), true
+	}
+
+	method := reflect.ValueOf(model).MethodByName("SelectedValue")
+	if !method.IsValid() {
+		return "", false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 2 || methodType.Out(1).Kind() != reflect.Bool {
+		return "", false
+	}
+	results := method.Call(nil)
+	if !results[1].Bool() {
+		return "", false
+	}
+	return fmt.Sprint(results[0].Interface()), true
+}
+
+// setStringValue sets a field model's value from a string, returning the
+// updated model and whether the model supports it. Value-semantics
+// components return a modified copy from their setters, so those are found
+// by reflection. The model itself is never changed: setters are called on
+// a copy.
+func setStringValue(model value.FieldModel, val string) (value.FieldModel, bool) {
+	model = cloneModel(model)
+	if setter, ok := model.(interface{ SetValue(string) }); ok {
+		setter.SetValue(val)
+		return model, true
+	}
+
+	modelValue := reflect.ValueOf(model)
+	if method := modelValue.MethodByName("SetValue"); method.IsValid() {
+		if updated, ok := callSetter(method, reflect.ValueOf(val)); ok {
+			return updated, true
+		}
+	}
+	if method := modelValue.MethodByName("SetContent"); method.IsValid() {
+		cursor := len([]rune(val)) // Cursor at the end, as after typing
+		if updated, ok := callSetter(method, reflect.ValueOf(val), reflect.ValueOf(cursor)); ok {
+			return updated, true
+		}
+	}
+
+	return nil, false
+}
+
+// cloneModel returns a shallow copy of a pointer model, so that setters
+// mutating in place do not change a model that other forms still share.
+// Other models are returned as is: calling their methods copies them anyway.
+func cloneModel(model value.FieldModel) value.FieldModel {
+	original := reflect.ValueOf(model)
+	if original.Kind() != reflect.Pointer || original.IsNil() {
+		return model
+	}
+
+	clone := reflect.New(original.Elem().Type())
+	clone.Elem().Set(original.Elem())
+	if cloned, ok := clone.Interface().(value.FieldModel); ok {
+		return cloned
+	}
+	return model
+}
+
+// callSetter calls a setter method if it takes exactly the given arguments
+// and returns a single FieldModel.
+func callSetter(method reflect.Value, args ...reflect.Value) (value.FieldModel, bool) {
+	methodType := method.Type()
+	if methodType.NumIn() != len(args) || methodType.NumOut() != 1 {
+		return nil, false
+	}
+	for i, arg := range args {
+		if methodType.In(i) != arg.Type() {
+			return nil, false
+		}
+	}
+
+	updated, ok := method.Call(args)[0].Interface().(value.FieldModel)
+	return updated, ok
+}
+
+// setField converts a string to the type of a struct field and sets it.
+func setField(field reflect.Value, val string) error {
+	if field.CanAddr() {
+		if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return unmarshaler.UnmarshalText([]byte(val))
+		}
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(val, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(val, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(x)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
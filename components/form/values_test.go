@@ -0,0 +1,214 @@
+package form_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/form"
+	selectcomponent "github.com/phoenix-tui/phoenix/components/select"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// settableInput is a value-semantics model like input.Input: setters return
+// an updated copy.
+type settableInput struct {
+	val string
+}
+
+func (s settableInput) View() string                            { return s.val }
+func (s settableInput) Value() string                           { return s.val }
+func (s settableInput) SetValue(v string) settableInput         { s.val = v; return s }
+func (s settableInput) Update(tea.Msg) (settableInput, tea.Cmd) { return s, nil }
+
+// contentInput sets its value like input.Input, together with the cursor.
+type contentInput struct {
+	val    string
+	cursor int
+}
+
+func (c contentInput) View() string  { return c.val }
+func (c contentInput) Value() string { return c.val }
+func (c contentInput) SetContent(content string, cursorPos int) contentInput {
+	c.val, c.cursor = content, cursorPos
+	return c
+}
+
+// pointerInput mutates in place.
+type pointerInput struct {
+	val string
+}
+
+func (p *pointerInput) View() string      { return p.val }
+func (p *pointerInput) Value() string     { return p.val }
+func (p *pointerInput) SetValue(v string) { p.val = v }
+
+func TestValues(t *testing.T) {
+	f := form.New("Test").
+		Field("name", "Name", settableInput{val: "Ada"}).
+		Field("email", "Email", &mockValuer{val: "ada@example.com"}).
+		Field("static", "Static", &mockModel{content: "no value"})
+
+	values := f.Values()
+
+	if len(values) != 2 || values["name"] != "Ada" || values["email"] != "ada@example.com" {
+		t.Errorf("Values() = %v, want name and email only", values)
+	}
+}
+
+func TestSetValues(t *testing.T) {
+	ptr := &pointerInput{}
+	f := form.New("Test").
+		Field("name", "Name", settableInput{}).
+		Field("bio", "Bio", contentInput{}).
+		Field("email", "Email", ptr).
+		Field("static", "Static", &mockModel{content: "static"})
+
+	restored := f.SetValues(map[string]string{
+		"name":    "Ada",
+		"bio":     "Mathematician",
+		"email":   "ada@example.com",
+		"static":  "ignored",
+		"unknown": "ignored",
+	})
+
+	values := restored.Values()
+	if values["name"] != "Ada" || values["bio"] != "Mathematician" || values["email"] != "ada@example.com" {
+		t.Errorf("Values() after SetValues = %v", values)
+	}
+	if c, ok := restored.Value("bio").(contentInput); !ok || c.cursor != len("Mathematician") {
+		t.Errorf("SetContent cursor = %v, want end of content", restored.Value("bio"))
+	}
+	if f.Values()["name"] != "" || f.Values()["email"] != "" || ptr.val != "" {
+		t.Error("Original form was mutated")
+	}
+	if restored.IsDirty() {
+		t.Error("SetValues should not mark fields dirty")
+	}
+
+	// Missing keys keep their values
+	partial := restored.SetValues(map[string]string{"name": "Grace"})
+	if got := partial.Values(); got["name"] != "Grace" || got["bio"] != "Mathematician" {
+		t.Errorf("Values() after partial SetValues = %v", got)
+	}
+}
+
+func TestSetValuesRefreshesVisibility(t *testing.T) {
+	f := form.New("Test").
+		Field("source", "Source", settableInput{}).
+		FieldIf("other", "Other", settableInput{}, func(f *form.Form) bool {
+			return f.Values()["source"] == "other"
+		})
+
+	f = f.SetValues(map[string]string{"source": "other"})
+
+	if got := f.VisibleFields(); len(got) != 2 {
+		t.Errorf("VisibleFields() = %v, want [source other]", got)
+	}
+}
+
+func TestBind(t *testing.T) {
+	type signup struct {
+		Name     string  `phoenix:"name"`
+		Age      int     `phoenix:"age"`
+		Score    float64 `phoenix:"score"`
+		Terms    bool    `phoenix:"terms"`
+		Nickname string  `phoenix:"nickname"`
+		Internal string
+	}
+
+	f := form.New("Test").
+		Field("name", "Name", settableInput{val: "Ada"}).
+		Field("age", "Age", settableInput{val: "36"}).
+		Field("score", "Score", settableInput{val: "9.5"}).
+		Field("terms", "Terms", settableInput{val: "true"}).
+		Field("extra", "Extra", settableInput{val: "not bound"})
+
+	got := signup{Nickname: "keep", Internal: "keep"}
+	if err := f.Bind(&got); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	want := signup{Name: "Ada", Age: 36, Score: 9.5, Terms: true, Nickname: "keep", Internal: "keep"}
+	if got != want {
+		t.Errorf("Bind() = %+v, want %+v", got, want)
+	}
+}
+
+// selectedPort returns a select over ports with the second option (8080)
+// chosen.
+func selectedPort() *selectcomponent.Select[int] {
+	sel := selectcomponent.New[int]("Port").Options(
+		selectcomponent.Opt("HTTP", 80),
+		selectcomponent.Opt("Alt HTTP", 8080),
+	)
+	sel, _ = sel.Update(tea.KeyMsg{Type: tea.KeyDown})
+	sel, _ = sel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return sel
+}
+
+func TestValuesAndBindSelect(t *testing.T) {
+	f := form.New("Test").
+		Field("name", "Name", settableInput{val: "Ada"}).
+		Field("port", "Port", selectedPort()).
+		Field("unset", "Unset", selectcomponent.NewString("Unset", []string{"a", "b"}))
+
+	values := f.Values()
+	if len(values) != 2 || values["name"] != "Ada" || values["port"] != "8080" {
+		t.Errorf("Values() = %v, want name and the selected port", values)
+	}
+
+	var got struct {
+		Name string `phoenix:"name"`
+		Port int    `phoenix:"port"`
+	}
+	if err := f.Bind(&got); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if got.Name != "Ada" || got.Port != 8080 {
+		t.Errorf("Bind() = %+v, want the selected port bound", got)
+	}
+}
+
+func TestBindTypeMismatch(t *testing.T) {
+	type record struct {
+		Name  string `phoenix:"name"`
+		Age   int    `phoenix:"age"`
+		Terms bool   `phoenix:"terms"`
+	}
+
+	f := form.New("Test").
+		Field("name", "Name", settableInput{val: "Ada"}).
+		Field("age", "Age", settableInput{val: "thirty"}).
+		Field("terms", "Terms", settableInput{val: "maybe"})
+
+	var got record
+	err := f.Bind(&got)
+	if err == nil {
+		t.Fatal("Bind() should report type mismatches")
+	}
+	for _, want := range []string{`"age"`, `"thirty"`, `"terms"`, `"maybe"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Bind() error %q should mention %s", err, want)
+		}
+	}
+	if got.Name != "Ada" {
+		t.Errorf("Bind() should still set valid fields, got Name = %q", got.Name)
+	}
+}
+
+func TestBindInvalidTarget(t *testing.T) {
+	f := form.New("Test").Field("name", "Name", settableInput{val: "Ada"})
+
+	var s struct {
+		Name string `phoenix:"name"`
+	}
+	var nilPtr *struct{}
+	targets := []any{nil, s, nilPtr, new(string)}
+
+	for _, target := range targets {
+		if err := f.Bind(target); !errors.Is(err, form.ErrInvalidBindTarget) {
+			t.Errorf("Bind(%T) error = %v, want ErrInvalidBindTarget", target, err)
+		}
+	}
+}
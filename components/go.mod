@@ -3,12 +3,15 @@ module github.com/phoenix-tui/phoenix/components
 go 1.25.1
 
 require (
+	github.com/phoenix-tui/phoenix/clipboard v0.2.4
+	github.com/phoenix-tui/phoenix/core v0.2.4
+	github.com/phoenix-tui/phoenix/mouse v0.2.4
 	github.com/phoenix-tui/phoenix/tea v0.2.4
 	github.com/rivo/uniseg v0.4.7
 )
 
 require (
-	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
+	github.com/google/uuid v1.6.0 // indirect
 	github.com/unilibs/uniwidth v0.2.0 // indirect
 )
 
@@ -21,6 +24,8 @@ require (
 
 replace github.com/phoenix-tui/phoenix/tea => ../tea
 
+replace github.com/phoenix-tui/phoenix/clipboard => ../clipboard
+
 // Local development
 replace github.com/phoenix-tui/phoenix/terminal => ../terminal
 
@@ -30,3 +35,5 @@ replace github.com/phoenix-tui/phoenix/testing => ../testing
 replace github.com/phoenix-tui/phoenix/style => ../style
 
 replace github.com/phoenix-tui/phoenix/core => ../core
+
+replace github.com/phoenix-tui/phoenix/mouse => ../mouse
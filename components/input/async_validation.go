@@ -0,0 +1,196 @@
+package input
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// DefaultValidationDebounce is how long typing must pause before an async
+// validator runs, unless changed with ValidationDebounce.
+const DefaultValidationDebounce = 300 * time.Millisecond
+
+// lastValidatorID hands out IDs so validation results reach only their input.
+// An ID is assigned on the first check, so copies of a configured Input
+// (e.g. one template used for several fields) each get their own.
+var lastValidatorID atomic.Int64
+
+// AsyncValidationFunc starts an expensive check of value, such as asking a
+// server whether a username is taken. The message of the returned command
+// is the result: an error means value is invalid, anything else (nil
+// included) means it is valid.
+type AsyncValidationFunc func(value string) tea.Cmd
+
+// AsyncValidationMsg carries the result of an async validator back to its
+// input. Pass it to Input.Update; results for values that have since been
+// edited are ignored.
+type AsyncValidationMsg struct {
+	id    int64
+	Value string // The validated value
+	Err   error  // nil if Value is valid
+}
+
+// asyncValidation is the state of an async validator. The pending check and
+// the last result each remember the value they are for, so editing the
+// content through any method (SetContent included) makes them stale.
+type asyncValidation struct {
+	id         int64 // Matches the validator's results; 0 until the first check
+	fn         AsyncValidationFunc
+	debounce   time.Duration
+	validating bool   // A check of pending is running
+	pending    string // Value being checked
+	checked    bool   // err is the result for value
+	value      string // Value of the last result
+	err        error  // Result of the last check of value
+}
+
+// checking reports whether a check of value is running.
+func (a *asyncValidation) checking(value string) bool {
+	return a != nil && a.validating && a.pending == value
+}
+
+// result reports whether value has been checked and, if so, the result.
+func (a *asyncValidation) result(value string) (checked bool, err error) {
+	if a == nil || !a.checked || a.value != value {
+		return false, nil
+	}
+	return true, a.err
+}
+
+// AsyncValidator sets a validator for checks too slow to run on every
+// keystroke. It runs once typing pauses for the validation debounce
+// (DefaultValidationDebounce unless set with ValidationDebounce), and only
+// if the synchronous Validator accepts the value, so cheap checks still
+// fail fast. While a check is pending Validating reports true.
+//
+// Update returns the validator's command; the parent must run it and pass
+// the resulting AsyncValidationMsg back to Update.
+//
+// Example:
+//
+//	username := input.New(20).AsyncValidator(func(name string) tea.Cmd {
+//		return func() tea.Msg {
+//			if api.UsernameTaken(name) {
+//				return errors.New("username is taken")
+//			}
+//			return nil
+//		}
+//	})
+//
+// IMPORTANT: Must reassign: input = input.AsyncValidator(fn).
+func (i Input) AsyncValidator(fn AsyncValidationFunc) Input {
+	debounce := DefaultValidationDebounce
+	if i.async != nil {
+		debounce = i.async.debounce
+	}
+	if fn == nil {
+		i.async = nil
+		return i
+	}
+
+	i.async = &asyncValidation{fn: fn, debounce: debounce}
+	return i
+}
+
+// ValidationDebounce sets how long typing must pause before the async
+// validator runs. 0 runs it on every change.
+// IMPORTANT: Must reassign: input = input.ValidationDebounce(d).
+func (i Input) ValidationDebounce(d time.Duration) Input {
+	if i.async == nil {
+		i.async = &asyncValidation{}
+	} else {
+		async := *i.async
+		i.async = &async
+	}
+	i.async.debounce = max(d, 0)
+	return i
+}
+
+// Validating returns true while the async validator is checking the
+// current value. Use it to show a spinner next to the field.
+func (i Input) Validating() bool {
+	return i.async.checking(i.domain.Content())
+}
+
+// ValidationError returns why the content is invalid: the error of the
+// synchronous validator, or else the result of the last async check.
+// Returns nil if the content is valid or has not been checked yet.
+func (i Input) ValidationError() error {
+	if err := i.domain.Validate(); err != nil {
+		return err
+	}
+	_, err := i.async.result(i.domain.Content())
+	return err
+}
+
+// Validate starts an async check of the current content and returns its
+// command. Edits made through Update start one on their own; call Validate
+// after changing the content from code, e.g. with SetContent, which leaves
+// the content unchecked (IsValid false) until a check succeeds.
+// Returns nil if there is no async validator or the synchronous validator
+// rejects the content.
+// IMPORTANT: Must reassign: input, cmd = input.Validate().
+func (i Input) Validate() (Input, tea.Cmd) {
+	return i.validate()
+}
+
+// asyncValid reports whether the async validator accepted the current
+// content. Without an async validator every content is accepted.
+func (i Input) asyncValid() bool {
+	if i.async == nil || i.async.fn == nil {
+		return true
+	}
+	checked, err := i.async.result(i.domain.Content())
+	return checked && err == nil
+}
+
+// validate starts an async check of the current value after an edit and
+// returns its command, or nil if there is no async validator or the
+// synchronous validator already rejects the value.
+func (i Input) validate() (Input, tea.Cmd) {
+	if i.async == nil || i.async.fn == nil {
+		return i, nil
+	}
+
+	value := i.domain.Content()
+	var cmd tea.Cmd
+	if i.domain.IsValid() {
+		cmd = i.async.fn(value)
+	}
+	async := *i.async
+	async.validating = cmd != nil
+	async.pending = value
+	i.async = &async
+	if cmd == nil {
+		return i, nil
+	}
+	if async.id == 0 {
+		async.id = lastValidatorID.Add(1)
+	}
+
+	id := async.id
+	check := func() tea.Msg {
+		msg := cmd()
+		err, _ := msg.(error)
+		return AsyncValidationMsg{id: id, Value: value, Err: err}
+	}
+	return i, tea.Debounce("components/input:"+strconv.FormatInt(id, 10), async.debounce, check)
+}
+
+// handleAsyncValidation records the result of an async check if it is for
+// the current value.
+func (i Input) handleAsyncValidation(msg AsyncValidationMsg) Input {
+	if i.async == nil || i.async.id == 0 || msg.id != i.async.id || !i.async.checking(msg.Value) || msg.Value != i.domain.Content() {
+		return i
+	}
+
+	async := *i.async
+	async.validating = false
+	async.checked = true
+	async.value = msg.Value
+	async.err = msg.Err
+	i.async = &async
+	return i
+}
@@ -0,0 +1,266 @@
+package input
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+var errTaken = errors.New("username is taken")
+
+// takenValidator rejects "admin" and counts the values it was asked about.
+func takenValidator(asked *[]string) AsyncValidationFunc {
+	return func(name string) tea.Cmd {
+		*asked = append(*asked, name)
+		return func() tea.Msg {
+			if name == "admin" {
+				return errTaken
+			}
+			return nil
+		}
+	}
+}
+
+// typeText feeds text to in one key at a time and returns the last command.
+func typeText(in Input, text string) (Input, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, r := range text {
+		in, cmd = in.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	return in, cmd
+}
+
+// cmdRunner is a model that starts its commands and forwards the validation
+// results they deliver.
+type cmdRunner struct {
+	cmds []tea.Cmd
+	got  chan<- AsyncValidationMsg
+}
+
+func (r cmdRunner) Init() tea.Cmd { return tea.Batch(r.cmds...) }
+
+func (r cmdRunner) Update(msg tea.Msg) (cmdRunner, tea.Cmd) {
+	if result, ok := msg.(AsyncValidationMsg); ok {
+		r.got <- result
+	}
+	return r, nil
+}
+
+func (r cmdRunner) View() string { return "" }
+
+// runCmds runs cmds in a program, which runs debounced commands, and returns
+// the validation results delivered within wait.
+func runCmds(t *testing.T, wait time.Duration, cmds ...tea.Cmd) []AsyncValidationMsg {
+	t.Helper()
+
+	got := make(chan AsyncValidationMsg, len(cmds))
+	p := tea.New(cmdRunner{cmds: cmds, got: got},
+		tea.WithInput[cmdRunner](strings.NewReader("")),
+		tea.WithOutput[cmdRunner](&bytes.Buffer{}))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Stop()
+
+	var results []AsyncValidationMsg
+	timeout := time.After(wait)
+	for {
+		select {
+		case result := <-got:
+			results = append(results, result)
+		case <-timeout:
+			return results
+		}
+	}
+}
+
+func TestAsyncValidator_Result(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(0)
+
+	in, cmd := typeText(in, "admin")
+	if cmd == nil {
+		t.Fatal("editing should return the validation command")
+	}
+	if !in.Validating() || in.IsValid() {
+		t.Errorf("pending check: Validating() = %v, IsValid() = %v, want true, false", in.Validating(), in.IsValid())
+	}
+
+	in, _ = in.Update(cmd())
+	if in.Validating() {
+		t.Error("Validating() = true after the result arrived")
+	}
+	if !errors.Is(in.ValidationError(), errTaken) || in.IsValid() {
+		t.Errorf("ValidationError() = %v, want %v", in.ValidationError(), errTaken)
+	}
+
+	in, cmd = typeText(in, "2")
+	in, _ = in.Update(cmd())
+	if !in.IsValid() || in.ValidationError() != nil {
+		t.Errorf("admin2: IsValid() = false, ValidationError() = %v", in.ValidationError())
+	}
+}
+
+func TestAsyncValidator_IgnoresStaleResult(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(0)
+
+	in, stale := typeText(in, "admin")
+	in, fresh := typeText(in, "x")
+
+	in, _ = in.Update(stale())
+	if !in.Validating() || in.ValidationError() != nil {
+		t.Error("a result for an edited value should be ignored")
+	}
+	in, _ = in.Update(fresh())
+	if !in.IsValid() {
+		t.Errorf("adminx should be valid, got %v", in.ValidationError())
+	}
+}
+
+func TestAsyncValidator_Debounce(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(20 * time.Millisecond)
+
+	in, first := typeText(in, "a")
+	in, last := typeText(in, "b")
+
+	results := runCmds(t, 100*time.Millisecond, first, last)
+	if len(results) != 1 || results[0].Value != "ab" {
+		t.Fatalf("checks delivered %v, want only the result for ab", results)
+	}
+	in, _ = in.Update(results[0])
+	if in.Validating() || !in.IsValid() {
+		t.Error("ab should be valid once checked")
+	}
+}
+
+func TestAsyncValidator_SyncValidatorFirst(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).
+		Validator(MinLength(3)).
+		AsyncValidator(takenValidator(&asked)).
+		ValidationDebounce(0)
+
+	in, cmd := typeText(in, "ad")
+	if cmd != nil || in.Validating() {
+		t.Error("async check should not run while the sync validator fails")
+	}
+	if !errors.Is(in.ValidationError(), ErrTooShort) {
+		t.Errorf("ValidationError() = %v, want %v", in.ValidationError(), ErrTooShort)
+	}
+	if len(asked) != 0 {
+		t.Errorf("async validator asked about %v", asked)
+	}
+}
+
+func TestAsyncValidator_IgnoresOtherInputs(t *testing.T) {
+	var asked []string
+	a := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(0)
+	b := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(0)
+
+	a, cmd := typeText(a, "admin")
+	b, _ = typeText(b, "admin")
+
+	b, _ = b.Update(cmd())
+	if !b.Validating() {
+		t.Error("b consumed a's result")
+	}
+	a, _ = a.Update(cmd())
+	if a.Validating() {
+		t.Error("a ignored its own result")
+	}
+}
+
+func TestAsyncValidator_CopiesOfConfiguredInput(t *testing.T) {
+	var asked []string
+	field := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(20 * time.Millisecond)
+	a, b := field, field
+
+	a, cmdA := typeText(a, "admin")
+	b, cmdB := typeText(b, "bob")
+
+	// Separate debounce keys: neither check supersedes the other
+	results := runCmds(t, 100*time.Millisecond, cmdA, cmdB)
+	if len(results) != 2 {
+		t.Fatalf("checks delivered %v, want results for admin and bob", results)
+	}
+	msgA, msgB := results[0], results[1]
+	if msgA.Value != "admin" {
+		msgA, msgB = msgB, msgA
+	}
+	if msgA.Value != "admin" || msgB.Value != "bob" {
+		t.Fatalf("checks delivered %v, want results for admin and bob", results)
+	}
+
+	b, _ = b.Update(msgA)
+	a, _ = a.Update(msgB)
+	if !a.Validating() || !b.Validating() {
+		t.Error("a copy consumed the other copy's result")
+	}
+	a, _ = a.Update(msgA)
+	b, _ = b.Update(msgB)
+	if !errors.Is(a.ValidationError(), errTaken) || !b.IsValid() {
+		t.Errorf("a: %v, b valid: %v, want %v, true", a.ValidationError(), b.IsValid(), errTaken)
+	}
+}
+
+func TestAsyncValidator_CursorMovesDoNotValidate(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).Content("abc").AsyncValidator(takenValidator(&asked))
+
+	in, cmd := in.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if cmd != nil || in.Validating() || len(asked) != 0 {
+		t.Error("moving the cursor should not start a check")
+	}
+}
+
+func TestAsyncValidator_SetContentClearsResult(t *testing.T) {
+	var asked []string
+	in := New(20).Focused(true).AsyncValidator(takenValidator(&asked)).ValidationDebounce(0)
+
+	in, cmd := typeText(in, "admin")
+	in, _ = in.Update(cmd())
+	if !errors.Is(in.ValidationError(), errTaken) {
+		t.Fatalf("ValidationError() = %v, want %v", in.ValidationError(), errTaken)
+	}
+
+	in = in.SetContent("alice", 5)
+	if in.ValidationError() != nil || in.Validating() {
+		t.Errorf("after SetContent: ValidationError() = %v, Validating() = %v, want nil, false", in.ValidationError(), in.Validating())
+	}
+	if in.IsValid() {
+		t.Error("IsValid() = true before alice was checked")
+	}
+
+	in, cmd = in.Validate()
+	if cmd == nil || !in.Validating() {
+		t.Fatal("Validate() should start a check")
+	}
+	in, _ = in.Update(cmd())
+	if !in.IsValid() {
+		t.Errorf("alice should be valid once checked, got %v", in.ValidationError())
+	}
+
+	// Restoring the checked value brings its result back.
+	in = in.SetContent("admin", 5)
+	if in.IsValid() {
+		t.Error("IsValid() = true for admin")
+	}
+}
+
+func TestAsyncValidator_UncheckedIsNotValid(t *testing.T) {
+	var asked []string
+	in := New(20).Content("bob").AsyncValidator(takenValidator(&asked))
+
+	if in.IsValid() || in.Validating() {
+		t.Errorf("unchecked content: IsValid() = %v, Validating() = %v, want false, false", in.IsValid(), in.Validating())
+	}
+	if in.ValidationError() != nil {
+		t.Errorf("ValidationError() = %v, want nil before the first check", in.ValidationError())
+	}
+}
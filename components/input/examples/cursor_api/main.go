@@ -103,6 +103,7 @@ func (m cursorAPIModel) View() string {
 	b.WriteString("  Ctrl-J: Jump to middle of content\n")
 	b.WriteString("  Ctrl-S: Set content atomically\n")
 	b.WriteString("  Ctrl-P: Insert cursor marker (demo ContentParts)\n")
+	b.WriteString("  Tab / Right at end: Accept the dimmed suggestion (try typing \"sel\")\n")
 	b.WriteString("  Esc: Quit\n\n")
 
 	b.WriteString("Why This Matters:\n")
@@ -110,6 +111,7 @@ func (m cursorAPIModel) View() string {
 	b.WriteString("• CursorPosition() - Get exact cursor offset\n")
 	b.WriteString("• ContentParts() - Split around cursor for custom rendering\n")
 	b.WriteString("• SetContent() - Atomic content + cursor update (race-free)\n")
+	b.WriteString("• CurrentSuggestion() - Inline autocomplete shown as ghost text\n")
 	b.WriteString("\n")
 	b.WriteString("This API enables:\n")
 	b.WriteString("  ✓ Custom cursor rendering (gosh will use for shell prompt)\n")
@@ -126,6 +128,12 @@ func main() {
 	model := cursorAPIModel{
 		input: input.New(60).
 			Content("Try moving the cursor and pressing Ctrl-I").
+			WithSuggestions([]string{
+				"SELECT * FROM users",
+				"SELECT id, name FROM orders",
+				"UPDATE users SET name = ''",
+				"DELETE FROM sessions",
+			}).
 			Focused(true),
 		info: "Press Ctrl-I to see cursor information",
 	}
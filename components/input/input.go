@@ -2,13 +2,21 @@
 package input
 
 import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+
 	"github.com/phoenix-tui/phoenix/components/input/internal/input/domain/model"
 	"github.com/phoenix-tui/phoenix/components/input/internal/input/domain/service"
 	"github.com/phoenix-tui/phoenix/components/input/internal/input/infrastructure"
+	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// DefaultPasswordMask is the mask rune used by Password().
+const DefaultPasswordMask = '•'
+
 // Input is the public API for the TextInput component.
 // It wraps the domain model and provides a fluent interface for configuration.
 // Implements tea.Model for use in Elm Architecture applications.
@@ -22,7 +30,8 @@ import (
 type Input struct {
 	domain      model.TextInput // VALUE, not pointer!
 	keyBindings KeyBindingHandler
-	theme       *style.Theme // Optional theme, defaults to DefaultTheme if nil
+	theme       *style.Theme     // Optional theme, defaults to DefaultTheme if nil
+	async       *asyncValidation // Optional async validator
 }
 
 // KeyBindingHandler handles key messages and returns updated input.
@@ -83,6 +92,102 @@ func (i Input) ShowCursor(show bool) Input {
 	return i
 }
 
+// Mask renders every character as r (e.g. '*') while Value() keeps the
+// real text. Validators still run against the real value, and the
+// placeholder is shown unmasked. Word movement and deletion treat masked
+// content as a single word. Pass 0 to disable masking.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.Mask('*').
+func (i Input) Mask(r rune) Input {
+	i.domain = i.domain.WithMask(r)
+	return i
+}
+
+// Password is a shortcut for Mask('•') for password entry.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.Password().
+func (i Input) Password() Input {
+	return i.Mask(DefaultPasswordMask)
+}
+
+// WithHistory enables shell-like history: Up/Down recall entries (oldest
+// first in entries), placing the cursor at the end of the line. Text being
+// typed, and edits to recalled entries, are preserved while browsing, like
+// bash.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.WithHistory(entries).
+func (i Input) WithHistory(entries []string) Input {
+	i.domain = i.domain.WithHistory(entries)
+	return i
+}
+
+// AddHistory appends a submitted entry to the history (enabling it if
+// needed) and ends browsing. Call it when the user submits, then clear the
+// input. Empty entries and repeats of the last entry are ignored.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.AddHistory(input.Value()).
+func (i Input) AddHistory(entry string) Input {
+	i.domain = i.domain.AddHistory(entry)
+	return i
+}
+
+// WithSuggestions enables inline autocomplete from a fixed list of
+// candidates, in order of preference. As the user types, the first
+// candidate matching the content is shown as dimmed ghost text after the
+// cursor; Tab, or Right with the cursor at the end, accepts it.
+// Matching is a case-insensitive prefix match by default (see
+// WithSuggestionMatcher). Replaces any suggestion function.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.WithSuggestions(commands).
+func (i Input) WithSuggestions(suggestions []string) Input {
+	i.domain = i.domain.WithSuggestions(suggestions)
+	return i
+}
+
+// WithSuggestionFunc enables inline autocomplete from candidates computed
+// for the current content, e.g. file names in the typed directory. fn is
+// called whenever the suggestion is needed (including every View), so it
+// should be fast. Replaces any fixed suggestions.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.WithSuggestionFunc(fn).
+func (i Input) WithSuggestionFunc(fn func(value string) []string) Input {
+	i.domain = i.domain.WithSuggestionFunc(fn)
+	return i
+}
+
+// WithSuggestionMatcher sets how candidates are matched against the
+// content. The ghost text is the candidate past the content's length in
+// graphemes, so the matcher should accept candidates that extend the
+// content. nil restores PrefixMatcher.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.WithSuggestionMatcher(m).
+func (i Input) WithSuggestionMatcher(matcher SuggestionMatcher) Input {
+	i.domain = i.domain.WithSuggestionMatcher(service.SuggestionMatcher(matcher))
+	return i
+}
+
+// Numeric switches the input to integer entry within [minValue, maxValue].
+//
+// Only digits can be typed or pasted, plus a leading minus when minValue < 0.
+// Up/Down increment and decrement the number, clamped to the range (this
+// takes precedence over history). The value may be emptied with Backspace;
+// IsValid reports out-of-range numbers, and IntValue parses the value.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.Numeric(1, 99).
+func (i Input) Numeric(minValue, maxValue int) Input {
+	i.domain = i.domain.WithNumeric(minValue, maxValue)
+	return i
+}
+
+// UndoLimit sets how many edits Undo can revert (default 100).
+// The undo history is cleared; a limit below 1 disables undo.
+// Returns new Input for method chaining (value semantics).
+// IMPORTANT: Must reassign: input = input.UndoLimit(20).
+func (i Input) UndoLimit(limit int) Input {
+	i.domain = i.domain.WithUndoLimit(limit)
+	return i
+}
+
 // Width sets the visible width.
 // Returns new Input for method chaining (value semantics).
 // IMPORTANT: Must reassign: input = input.Width(80).
@@ -122,6 +227,7 @@ func (i Input) ContentParts() (before, at, after string) {
 
 // SetContent sets both content and cursor position atomically.
 // This is a KEY DIFFERENTIATOR - prevents race conditions.
+// New content is not async-validated until Validate is called.
 // Returns new Input for method chaining (value semantics).
 // IMPORTANT: Must reassign: input = input.SetContent("text", 0).
 func (i Input) SetContent(content string, cursorPos int) Input {
@@ -129,14 +235,134 @@ func (i Input) SetContent(content string, cursorPos int) Input {
 	return i
 }
 
+// MoveWordLeft moves the cursor to the start of the previous word.
+// Words are runs of letters and digits; everything else separates them.
+// Bound to ctrl+left, alt+left and alt+b by default; exposed so apps can
+// bind custom keys.
+// Returns new Input for method chaining (value semantics).
+func (i Input) MoveWordLeft() Input {
+	i.domain = i.domain.MoveWordLeft()
+	return i
+}
+
+// MoveWordRight moves the cursor to the end of the next word.
+// Bound to ctrl+right, alt+right and alt+f by default.
+// Returns new Input for method chaining (value semantics).
+func (i Input) MoveWordRight() Input {
+	i.domain = i.domain.MoveWordRight()
+	return i
+}
+
+// DeleteWordBackward deletes from the start of the previous word to the cursor.
+// Bound to ctrl+w and alt+backspace by default.
+// Returns new Input for method chaining (value semantics).
+func (i Input) DeleteWordBackward() Input {
+	i.domain = i.domain.DeleteWordBackward()
+	return i
+}
+
+// DeleteWordForward deletes from the cursor to the end of the next word.
+// Bound to alt+d by default.
+// Returns new Input for method chaining (value semantics).
+func (i Input) DeleteWordForward() Input {
+	i.domain = i.domain.DeleteWordForward()
+	return i
+}
+
+// Insert inserts text at the cursor as a single edit, replacing the selection.
+// One Undo removes all of it. Line breaks are turned into spaces (the input is
+// single-line). Pastes (tea.PasteMsg) are inserted this way automatically.
+// Returns new Input for method chaining (value semantics).
+func (i Input) Insert(text string) Input {
+	i.domain = i.domain.Insert(text)
+	return i
+}
+
+// AcceptSuggestion replaces the content with the current suggestion, cursor
+// at the end, as one undoable edit (bound to Tab, and Right at the end).
+// No-op if there is no suggestion.
+// Returns new Input for method chaining (value semantics).
+func (i Input) AcceptSuggestion() Input {
+	i.domain = i.domain.AcceptSuggestion()
+	return i
+}
+
+// Undo reverts the last edit (bound to ctrl+z by default).
+// Typed text is undone a word at a time; a paste or Insert at once.
+// Content set with Content/SetContent starts a fresh history.
+// Returns new Input for method chaining (value semantics).
+func (i Input) Undo() Input {
+	i.domain = i.domain.Undo()
+	return i
+}
+
+// Redo re-applies the last undone edit (bound to ctrl+y by default).
+// Returns new Input for method chaining (value semantics).
+func (i Input) Redo() Input {
+	i.domain = i.domain.Redo()
+	return i
+}
+
+// Increment adds one to the number in numeric mode, clamped to the range
+// (bound to Up). Empty content becomes the in-range value closest to 0.
+// Returns new Input for method chaining (value semantics).
+func (i Input) Increment() Input {
+	i.domain = i.domain.Increment()
+	return i
+}
+
+// Decrement subtracts one from the number in numeric mode, clamped to the
+// range (bound to Down).
+// Returns new Input for method chaining (value semantics).
+func (i Input) Decrement() Input {
+	i.domain = i.domain.Decrement()
+	return i
+}
+
+// IntValue parses the value as an integer.
+// Returns ErrEmpty for an empty value and ErrNotANumber if it does not parse.
+// In numeric mode a number outside the range is returned together with an
+// error wrapping ErrOutOfRange.
+func (i Input) IntValue() (int, error) {
+	return i.domain.IntValue()
+}
+
+// CanUndo reports whether there is an edit to undo.
+func (i Input) CanUndo() bool {
+	return i.domain.CanUndo()
+}
+
+// CanRedo reports whether there is an undone edit to redo.
+func (i Input) CanRedo() bool {
+	return i.domain.CanRedo()
+}
+
 // Value returns the current input value.
 func (i Input) Value() string {
 	return i.domain.Content()
 }
 
-// IsValid returns true if the content passes validation.
+// CurrentSuggestion returns the full autocomplete candidate for the current
+// value, or "" when the value is empty, masked, or nothing matches.
+func (i Input) CurrentSuggestion() string {
+	return i.domain.CurrentSuggestion()
+}
+
+// IsValid returns true if the content passes validation. With an async
+// validator, it is false until the check of the current content succeeds.
 func (i Input) IsValid() bool {
-	return i.domain.IsValid()
+	return i.domain.IsValid() && i.asyncValid()
+}
+
+// History returns the history entries, oldest first.
+func (i Input) History() []string {
+	return i.domain.History()
+}
+
+// HistoryIndex returns the position of the recalled history entry
+// (0 = oldest), or -1 when not browsing. Useful for "3/10" indicators.
+func (i Input) HistoryIndex() int {
+	return i.domain.HistoryIndex()
 }
 
 // IsFocused returns true if the input is focused.
@@ -160,9 +386,23 @@ func (i Input) Update(msg tea.Msg) (Input, tea.Cmd) {
 		}
 
 		// Handle key via bindings.
-		result := i.keyBindings.Handle(i.domain, msg)
-		i.domain = result
-		return i, nil
+		before := i.domain.Content()
+		i.domain = i.keyBindings.Handle(i.domain, msg)
+		if i.domain.Content() == before {
+			return i, nil
+		}
+		return i.validate()
+
+	case tea.PasteMsg:
+		// Bracketed paste arrives whole; insert it as one undoable edit.
+		if !i.domain.Focused() {
+			return i, nil
+		}
+		i.domain = i.domain.Insert(msg.Text)
+		return i.validate()
+
+	case AsyncValidationMsg:
+		return i.handleAsyncValidation(msg), nil
 
 	default:
 		return i, nil
@@ -198,68 +438,142 @@ func (i Input) renderPlaceholder() string {
 
 // renderContent renders the input content with cursor.
 //
-//nolint:gocognit // rendering logic requires multiple conditions
+// Content wider than the input scrolls horizontally to keep the cursor
+// visible. The visible window is measured in terminal cells and always
+// contains whole graphemes; when a wide grapheme (emoji, CJK) would straddle
+// the right edge it is left out and the gap is padded with a space.
 func (i Input) renderContent() string {
-	before, at, after := i.domain.ContentParts()
-
-	// Calculate visible portion based on width.
-	content := before + at + after
+	cells := i.displayGraphemes()
+	focused := i.domain.Focused()
+	cursorPos := i.domain.CursorPosition()
+	start, end, pad := visibleRange(cells, cursorPos, i.domain.ScrollOffset(), i.domain.Width())
 
-	if len(content) <= i.domain.Width() {
-		// Content fits, render cursor.
-		if i.domain.Focused() {
-			return before + i.renderCursor(at) + after
+	var b strings.Builder
+	for idx := start; idx < end; idx++ {
+		if focused && idx == cursorPos {
+			b.WriteString(i.renderCursor(cells[idx]))
+			continue
+		}
+		b.WriteString(cells[idx])
+	}
+	// Cursor past the last grapheme (end of content), over the ghost text
+	// of the autocomplete suggestion if there is one.
+	if focused && cursorPos == len(cells) && end == len(cells) {
+		used := 0
+		for idx := start; idx < end; idx++ {
+			used += core.StringWidth(cells[idx])
 		}
-		return content
+		b.WriteString(i.renderSuggestion(i.domain.Width() - used))
 	}
+	b.WriteString(strings.Repeat(" ", pad))
+	return b.String()
+}
 
-	// Content too long, needs scrolling.
-	// Simple approach: keep cursor in view.
-	cursorPos := i.domain.CursorPosition()
-	width := i.domain.Width()
+// renderSuggestion renders the cursor at the end of the content followed by
+// the ghost text of the current suggestion, dimmed, cut to fit in width
+// cells. The cursor sits on the first ghost grapheme.
+func (i Input) renderSuggestion(width int) string {
+	remainder := i.domain.SuggestionRemainder()
+	first, rest := service.SplitGraphemes(remainder, 1)
+	if first == "" || core.StringWidth(first) > width {
+		return i.renderCursor("")
+	}
 
-	// Calculate scroll offset to keep cursor visible.
-	scrollOffset := i.domain.ScrollOffset()
+	theme := i.theme
+	if theme == nil {
+		theme = style.DefaultTheme()
+	}
+	ghostStyle := style.New().Foreground(theme.Typography().PlaceholderColor)
 
-	// Adjust scroll if cursor is out of view.
-	if cursorPos < scrollOffset {
-		scrollOffset = cursorPos
+	var b strings.Builder
+	if i.domain.ShowCursor() {
+		b.WriteString(i.renderCursor(first))
+	} else {
+		b.WriteString(style.Render(ghostStyle, first))
 	}
-	if cursorPos >= scrollOffset+width {
-		scrollOffset = cursorPos - width + 1
+
+	// Whole graphemes that still fit.
+	width -= core.StringWidth(first)
+	fit := 0
+	gr := uniseg.NewGraphemes(rest)
+	for gr.Next() {
+		w := core.StringWidth(gr.Str())
+		if w > width {
+			break
+		}
+		width -= w
+		_, fit = gr.Positions()
 	}
+	if fit > 0 {
+		b.WriteString(style.Render(ghostStyle, rest[:fit]))
+	}
+	return b.String()
+}
+
+// displayGraphemes splits the content into graphemes as they are displayed:
+// each one is replaced by the mask rune when masking is enabled.
+func (i Input) displayGraphemes() []string {
+	content := i.domain.Content()
+	mask := i.domain.Mask()
+
+	cells := make([]string, 0, len(content))
+	gr := uniseg.NewGraphemes(content)
+	for gr.Next() {
+		if mask != 0 {
+			cells = append(cells, string(mask))
+		} else {
+			cells = append(cells, gr.Str())
+		}
+	}
+	return cells
+}
 
-	// Extract visible portion.
-	// Note: Uses byte offsets for simplicity - works for ASCII and most Unicode.
-	// For complex grapheme clusters (emoji with modifiers), phoenix/core UnicodeService
-	// should be used, but that's a future optimization.
-	visibleStart := scrollOffset
-	visibleEnd := scrollOffset + width
-	if visibleEnd > len(content) {
-		visibleEnd = len(content)
+// visibleRange returns the grapheme range [start, end) of cells that fits in
+// width terminal cells while keeping the cursor visible. scrollOffset is the
+// preferred first grapheme. pad is the number of cells left empty at the right
+// edge because the next grapheme is too wide to fit.
+func visibleRange(cells []string, cursorPos, scrollOffset, width int) (start, end, pad int) {
+	widths := make([]int, len(cells))
+	total := 0
+	for idx, cell := range cells {
+		widths[idx] = core.StringWidth(cell)
+		total += widths[idx]
+	}
+	if total <= width {
+		return 0, len(cells), 0 // Everything fits
 	}
 
-	visibleContent := content[visibleStart:visibleEnd]
-
-	// Render cursor if in visible range.
-	//nolint:nestif // rendering logic requires nested conditions for cursor positioning
-	if i.domain.Focused() && cursorPos >= scrollOffset && cursorPos < scrollOffset+width {
-		cursorInVisible := cursorPos - scrollOffset
-		if cursorInVisible <= len(visibleContent) {
-			visibleBefore := visibleContent[:cursorInVisible]
-			visibleAt := ""
-			if cursorInVisible < len(visibleContent) {
-				visibleAt = string(visibleContent[cursorInVisible])
-			}
-			visibleAfter := ""
-			if cursorInVisible+1 < len(visibleContent) {
-				visibleAfter = visibleContent[cursorInVisible+1:]
-			}
-			return visibleBefore + i.renderCursor(visibleAt) + visibleAfter
+	// cellWidth is the width of grapheme idx (the cursor cell past the end is 1).
+	cellWidth := func(idx int) int {
+		if idx >= len(widths) {
+			return 1
 		}
+		return widths[idx]
 	}
 
-	return visibleContent
+	// Scroll left if the cursor is before the window, right until the
+	// cursor cell fits inside it.
+	start = min(max(scrollOffset, 0), cursorPos)
+	used := 0
+	for idx := start; idx <= cursorPos; idx++ {
+		used += cellWidth(idx)
+	}
+	for used > width && start < cursorPos {
+		used -= cellWidth(start)
+		start++
+	}
+
+	// Fill the rest of the window with whole graphemes.
+	end = start
+	used = 0
+	for end < len(cells) && used+widths[end] <= width {
+		used += widths[end]
+		end++
+	}
+	if end < len(cells) {
+		pad = width - used
+	}
+	return start, end, pad
 }
 
 // renderCursor renders the cursor at the current position using theme colors.
@@ -330,12 +644,24 @@ func Chain(validators ...ValidationFunc) ValidationFunc {
 	return ValidationFunc(internal)
 }
 
+// SuggestionMatcher reports whether suggestion is a completion of input.
+// See WithSuggestionMatcher.
+type SuggestionMatcher func(input, suggestion string) bool
+
+// PrefixMatcher returns the default matcher: suggestions that start with
+// the input, ignoring case, compared by whole graphemes.
+func PrefixMatcher() SuggestionMatcher {
+	return SuggestionMatcher(service.PrefixMatcher())
+}
+
 // Validation errors (re-exported for convenience).
 var (
 	ErrEmpty         = service.ErrEmpty
 	ErrTooShort      = service.ErrTooShort
 	ErrTooLong       = service.ErrTooLong
 	ErrInvalidFormat = service.ErrInvalidFormat
+	ErrNotANumber    = service.ErrNotANumber
+	ErrOutOfRange    = service.ErrOutOfRange
 )
 
 // CustomKeyBindings creates custom key bindings for input handling.
@@ -2,13 +2,23 @@ package input
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/input/internal/input/domain/model"
+	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// ansiRegex matches SGR escape sequences added by theme styling.
+var ansiRegex = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// stripANSI removes styling so tests can compare visible text.
+func stripANSI(s string) string {
+	return ansiRegex.ReplaceAllString(s, "")
+}
+
 func TestNew(t *testing.T) {
 	input := New(40)
 
@@ -671,3 +681,280 @@ func TestInput_ContentParts_Unicode(t *testing.T) {
 		t.Errorf("after = %q, want %q", after, "界")
 	}
 }
+
+func TestInput_Mask(t *testing.T) {
+	input := New(20).Content("secret").Mask('*').Focused(false)
+
+	if view := input.View(); view != "******" {
+		t.Errorf("View() = %q, want %q", view, "******")
+	}
+	if input.Value() != "secret" {
+		t.Errorf("Value() = %q, want real text", input.Value())
+	}
+}
+
+func TestInput_Password(t *testing.T) {
+	input := New(20).Content("pa😀ss").Password().Focused(false)
+
+	// One mask rune per grapheme, regardless of the grapheme's width.
+	if view := input.View(); view != "•••••" {
+		t.Errorf("View() = %q, want %q", view, "•••••")
+	}
+}
+
+func TestInput_Mask_CursorAndScrolling(t *testing.T) {
+	input := New(5).SetContent("abcdefghij", 10).Password().Focused(true).ShowCursor(false)
+
+	// Window of 5 cells: 4 masked graphemes + the cursor cell at the end
+	// (rendered empty because the terminal cursor is used).
+	if view := input.View(); view != "••••" {
+		t.Errorf("View() = %q, want %q", view, "••••")
+	}
+	if strings.Contains(input.View(), "j") {
+		t.Error("masked view must not leak content")
+	}
+}
+
+func TestInput_Mask_ValidatorUsesRealValue(t *testing.T) {
+	input := New(20).Password().Validator(MinLength(4)).Content("abcd")
+
+	if !input.IsValid() {
+		t.Error("validator should run against the real value")
+	}
+}
+
+func TestInput_Mask_PlaceholderUnchanged(t *testing.T) {
+	input := New(20).Password().Placeholder("Password").Focused(false)
+
+	if !strings.Contains(input.View(), "Password") {
+		t.Errorf("placeholder should render unmasked, got %q", input.View())
+	}
+}
+
+func TestInput_History(t *testing.T) {
+	input := New(40).WithHistory([]string{"first", "second"}).Focused(true)
+	up := tea.KeyMsg{Type: tea.KeyUp}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'x'})
+	input, _ = input.Update(up)
+	if input.Value() != "second" || input.HistoryIndex() != 1 {
+		t.Fatalf("Up: Value() = %q, HistoryIndex() = %d", input.Value(), input.HistoryIndex())
+	}
+
+	// Edit the recalled entry, browse away and back.
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: '!'})
+	input, _ = input.Update(up)
+	input, _ = input.Update(down)
+	if input.Value() != "second!" {
+		t.Errorf("edited entry = %q, want %q", input.Value(), "second!")
+	}
+	if input.CursorPosition() != 7 {
+		t.Errorf("CursorPosition() = %d, want end of line", input.CursorPosition())
+	}
+
+	// Back to the draft.
+	input, _ = input.Update(down)
+	if input.Value() != "x" || input.HistoryIndex() != -1 {
+		t.Errorf("draft = %q (index %d), want x (-1)", input.Value(), input.HistoryIndex())
+	}
+
+	input = input.AddHistory("third").SetContent("", 0)
+	input, _ = input.Update(up)
+	if input.Value() != "third" {
+		t.Errorf("after AddHistory, Up = %q, want third", input.Value())
+	}
+	if got := len(input.History()); got != 3 {
+		t.Errorf("len(History()) = %d, want 3", got)
+	}
+}
+
+func TestInput_WordMovement(t *testing.T) {
+	input := New(40).SetContent("привет, мир", 11)
+
+	input = input.MoveWordLeft()
+	if input.CursorPosition() != 8 {
+		t.Errorf("MoveWordLeft() cursor = %d, want 8", input.CursorPosition())
+	}
+
+	input = input.DeleteWordBackward()
+	if input.Value() != "мир" || input.CursorPosition() != 0 {
+		t.Errorf("DeleteWordBackward() = (%q, %d), want (\"мир\", 0)", input.Value(), input.CursorPosition())
+	}
+
+	input = input.MoveWordRight()
+	if input.CursorPosition() != 3 {
+		t.Errorf("MoveWordRight() cursor = %d, want 3", input.CursorPosition())
+	}
+
+	input = input.SetContent("foo bar", 0).DeleteWordForward()
+	if input.Value() != " bar" {
+		t.Errorf("DeleteWordForward() = %q, want \" bar\"", input.Value())
+	}
+}
+
+func TestVisibleRange_WideGraphemes(t *testing.T) {
+	cells := []string{"H", "i", "👋", "中", "文"} // widths 1,1,2,2,2
+
+	tests := []struct {
+		name         string
+		cursorPos    int
+		scrollOffset int
+		wantStart    int
+		wantEnd      int
+		wantPad      int
+	}{
+		{"cursor at start", 0, 0, 0, 4, 0},
+		{"cursor on last visible", 3, 0, 0, 4, 0},
+		{"cursor on last grapheme", 4, 0, 2, 5, 0},
+		{"cursor past end", 5, 0, 3, 5, 0},
+		{"wide grapheme straddles edge", 1, 1, 1, 4, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, pad := visibleRange(cells, tt.cursorPos, tt.scrollOffset, 6)
+			if start != tt.wantStart || end != tt.wantEnd || pad != tt.wantPad {
+				t.Errorf("visibleRange() = (%d, %d, %d), want (%d, %d, %d)",
+					start, end, pad, tt.wantStart, tt.wantEnd, tt.wantPad)
+			}
+		})
+	}
+}
+
+func TestInput_View_WideGraphemesAtWidth6(t *testing.T) {
+	tests := []struct {
+		cursorPos int
+		want      string
+	}{
+		{0, "Hi👋中"},
+		{2, "Hi👋中"},
+		{4, "👋中文"},
+		{5, "中文"},
+	}
+
+	for _, tt := range tests {
+		input := New(6).Focused(true).ShowCursor(false).SetContent("Hi👋中文", tt.cursorPos)
+		if got := input.View(); got != tt.want {
+			t.Errorf("cursor %d: View() = %q, want %q", tt.cursorPos, got, tt.want)
+		}
+	}
+
+	// A wide grapheme that does not fit is replaced by padding, never split.
+	input := New(5).Focused(true).ShowCursor(false).SetContent("Hi👋中文", 0)
+	if got := input.View(); got != "Hi👋 " {
+		t.Errorf("View() = %q, want %q", got, "Hi👋 ")
+	}
+}
+
+func TestInput_PasteUndo(t *testing.T) {
+	input := New(40).Focused(true)
+
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: '>'})
+	input, _ = input.Update(tea.PasteMsg{Text: "user@example.com"})
+	if input.Value() != ">user@example.com" {
+		t.Fatalf("after paste Value() = %q", input.Value())
+	}
+
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Ctrl: true, Rune: 'z'})
+	if input.Value() != ">" {
+		t.Errorf("ctrl+z should remove the whole paste, Value() = %q", input.Value())
+	}
+
+	input = input.Redo()
+	if input.Value() != ">user@example.com" {
+		t.Errorf("Redo() Value() = %q", input.Value())
+	}
+}
+
+func TestInput_PasteIgnoredWhenBlurred(t *testing.T) {
+	input := *New(40)
+	input, _ = input.Update(tea.PasteMsg{Text: "text"})
+	if input.Value() != "" {
+		t.Errorf("blurred input accepted paste: %q", input.Value())
+	}
+}
+
+func TestInput_UndoLimit(t *testing.T) {
+	input := New(40).UndoLimit(1).Insert("a").Insert("b")
+
+	input = input.Undo().Undo()
+	if input.Value() != "a" {
+		t.Errorf("Value() = %q, want a (only one edit kept)", input.Value())
+	}
+	if input.CanUndo() {
+		t.Error("CanUndo() should be false")
+	}
+}
+
+func TestInput_Numeric(t *testing.T) {
+	input := New(10).Numeric(1, 99).Focused(true)
+
+	for _, r := range "4x2" {
+		input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	if input.Value() != "42" {
+		t.Errorf("Value() = %q, want 42", input.Value())
+	}
+
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyUp})
+	n, err := input.IntValue()
+	if err != nil || n != 43 {
+		t.Errorf("IntValue() = (%d, %v), want (43, nil)", n, err)
+	}
+
+	input = input.Content("100")
+	if _, err := input.IntValue(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("IntValue() error = %v, want ErrOutOfRange", err)
+	}
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if input.Value() != "99" {
+		t.Errorf("Up above max: Value() = %q, want 99", input.Value())
+	}
+
+	input = input.Content("")
+	if _, err := input.IntValue(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("IntValue() on empty error = %v, want ErrEmpty", err)
+	}
+}
+
+func TestInput_Suggestions(t *testing.T) {
+	input := New(20).
+		WithSuggestions([]string{"kubectl", "kustomize"}).
+		Focused(true).
+		ShowCursor(false)
+
+	if view := input.View(); strings.Contains(view, "kubectl") {
+		t.Errorf("empty input should not show a suggestion: %q", view)
+	}
+
+	for _, r := range "kub" {
+		input, _ = input.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	if got := input.CurrentSuggestion(); got != "kubectl" {
+		t.Fatalf("CurrentSuggestion() = %q, want kubectl", got)
+	}
+	if view := stripANSI(input.View()); view != "kubectl" {
+		t.Errorf("View() = %q, want the value followed by ghost text", view)
+	}
+	if input.Value() != "kub" {
+		t.Errorf("ghost text must not change Value(): %q", input.Value())
+	}
+
+	input, _ = input.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if input.Value() != "kubectl" {
+		t.Errorf("Tab: Value() = %q, want kubectl", input.Value())
+	}
+}
+
+func TestInput_Suggestions_GhostTextFitsWidth(t *testing.T) {
+	input := New(6).
+		WithSuggestions([]string{"abcdefghij"}).
+		Content("abc").
+		Focused(true)
+	input = input.SetContent("abc", 3)
+
+	if width := core.StringWidth(stripANSI(input.View())); width != 6 {
+		t.Errorf("View() width = %d, want ghost text cut to 6 cells", width)
+	}
+}
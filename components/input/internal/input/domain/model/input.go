@@ -2,6 +2,11 @@
 package model
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
 	service2 "github.com/phoenix-tui/phoenix/components/input/internal/input/domain/service"
 	value2 "github.com/phoenix-tui/phoenix/components/input/internal/input/domain/value"
 )
@@ -19,6 +24,14 @@ type TextInput struct {
 	placeholder    string                  // Placeholder text (when empty)
 	focused        bool                    // Focus state
 	showCursor     bool                    // Whether to render cursor (default: true)
+	mask           rune                    // Display rune for every grapheme (0 = no masking)
+	history        *value2.History         // Submitted entries for up/down recall (nil = disabled)
+	numeric        *value2.IntRange        // Accepted range in numeric mode (nil = any text)
+	undo           *value2.UndoStack       // Snapshots for Undo/Redo
+	suggestions    []string                // Static autocomplete candidates
+	suggestFunc    func(string) []string   // Dynamic candidates for the content (overrides suggestions)
+	matcher        service2.SuggestionMatcher
+	typingAt       int // Cursor after the last typed rune of the open undo group (-1 = none)
 	cursorMovement *service2.CursorMovementService
 	validationSvc  *service2.ValidationService
 }
@@ -39,6 +52,8 @@ func New(width int) *TextInput {
 		placeholder:    "",
 		focused:        false,
 		showCursor:     true, // Default: show cursor
+		undo:           value2.NewUndoStack(value2.DefaultUndoLimit),
+		typingAt:       -1,
 		cursorMovement: service2.NewCursorMovementService(),
 		validationSvc:  service2.NewValidationService(),
 	}
@@ -79,6 +94,11 @@ func (t *TextInput) ShowCursor() bool {
 	return t.showCursor
 }
 
+// Mask returns the rune displayed in place of each grapheme (0 if unmasked).
+func (t *TextInput) Mask() rune {
+	return t.mask
+}
+
 // HasSelection returns true if there's an active selection.
 func (t *TextInput) HasSelection() bool {
 	return t.selection != nil && !t.selection.IsEmpty()
@@ -99,8 +119,10 @@ func (t *TextInput) ContentParts() (before, at, after string) {
 }
 
 // WithContent sets the content (immutable).
+// The undo history is cleared: programmatic content is the new baseline.
 func (t TextInput) WithContent(content string) TextInput {
 	t.content = content
+	t = t.resetUndo()
 
 	// Clamp cursor to new content length.
 	maxOffset := t.cursorMovement.GraphemeCount(content)
@@ -119,8 +141,10 @@ func (t TextInput) WithContent(content string) TextInput {
 
 // SetContent sets both content and cursor position atomically.
 // This is a KEY DIFFERENTIATOR - prevents race conditions.
+// The undo history is cleared: programmatic content is the new baseline.
 func (t TextInput) SetContent(content string, cursorPos int) TextInput {
 	t.content = content
+	t = t.resetUndo()
 
 	// Clamp cursor to content length.
 	maxOffset := t.cursorMovement.GraphemeCount(content)
@@ -190,6 +214,13 @@ func (t TextInput) WithShowCursor(show bool) TextInput {
 	return t
 }
 
+// WithMask sets the rune displayed in place of each grapheme (immutable).
+// Content is unaffected; 0 disables masking.
+func (t TextInput) WithMask(mask rune) TextInput {
+	t.mask = mask
+	return t
+}
+
 // MoveLeft moves cursor left by one grapheme (immutable).
 func (t TextInput) MoveLeft() TextInput {
 	newPos := t.cursorMovement.MoveLeft(t.content, t.cursor.Offset())
@@ -221,27 +252,128 @@ func (t TextInput) MoveEnd() TextInput {
 	return t
 }
 
+// MoveWordLeft moves cursor to the start of the previous word (immutable).
+func (t TextInput) MoveWordLeft() TextInput {
+	t.cursor = value2.NewCursor(t.wordLeft(t.cursor.Offset()))
+	t.selection = nil // Clear selection on cursor movement
+	return t
+}
+
+// MoveWordRight moves cursor to the end of the next word (immutable).
+func (t TextInput) MoveWordRight() TextInput {
+	t.cursor = value2.NewCursor(t.wordRight(t.cursor.Offset()))
+	t.selection = nil // Clear selection on cursor movement
+	return t
+}
+
+// DeleteWordBackward deletes from the start of the previous word to the
+// cursor (Ctrl-W) (immutable).
+func (t TextInput) DeleteWordBackward() TextInput {
+	if t.selection != nil && !t.selection.IsEmpty() {
+		return t.deleteSelection().recordEdit(t)
+	}
+	pos := t.cursor.Offset()
+	return t.deleteRange(t.wordLeft(pos), pos).recordEdit(t)
+}
+
+// DeleteWordForward deletes from the cursor to the end of the next word
+// (Alt-D) (immutable).
+func (t TextInput) DeleteWordForward() TextInput {
+	if t.selection != nil && !t.selection.IsEmpty() {
+		return t.deleteSelection().recordEdit(t)
+	}
+	pos := t.cursor.Offset()
+	return t.deleteRange(pos, t.wordRight(pos)).recordEdit(t)
+}
+
+// wordLeft returns the start of the word before pos. Masked content is one
+// word, so word boundaries in hidden text are not revealed.
+func (t TextInput) wordLeft(pos int) int {
+	if t.mask != 0 {
+		return 0
+	}
+	return t.cursorMovement.WordLeft(t.content, pos)
+}
+
+// wordRight returns the end of the word after pos, treating masked content
+// as one word like wordLeft.
+func (t TextInput) wordRight(pos int) int {
+	if t.mask != 0 {
+		return t.cursorMovement.GraphemeCount(t.content)
+	}
+	return t.cursorMovement.WordRight(t.content, pos)
+}
+
 // InsertRune inserts a rune at cursor position (immutable).
+//
+// Consecutively typed runes form one undo step; whitespace starts a new one,
+// so Undo removes typed text a word at a time.
 func (t TextInput) InsertRune(r rune) TextInput {
+	continues := t.typingAt == t.cursor.Offset() && !t.HasSelection() && !unicode.IsSpace(r)
+
+	result := t
 	// Delete selection if present.
-	if t.selection != nil && !t.selection.IsEmpty() {
-		t = t.deleteSelection()
+	if result.selection != nil && !result.selection.IsEmpty() {
+		result = result.deleteSelection()
 	}
 
 	// Insert rune at cursor position.
 	// SplitAtCursor gives us (before, at, after) where 'at' is the grapheme at cursor.
 	// We want to insert BEFORE 'at', so: before + newRune + at + after.
-	before, at, after := t.cursorMovement.SplitAtCursor(t.content, t.cursor.Offset())
-	t.content = before + string(r) + at + after
+	before, at, after := result.cursorMovement.SplitAtCursor(result.content, result.cursor.Offset())
+	result.content = before + string(r) + at + after
 
 	// Move cursor right.
-	t.cursor = t.cursor.MoveBy(1, t.cursorMovement.GraphemeCount(t.content))
+	result.cursor = result.cursor.MoveBy(1, result.cursorMovement.GraphemeCount(result.content))
 
-	return t
+	if !result.acceptsContent() {
+		return t
+	}
+	if !continues {
+		result = result.recordEdit(t)
+	}
+	result.typingAt = result.cursor.Offset()
+	return result
+}
+
+// Insert inserts text at the cursor as a single edit, replacing the selection
+// if there is one (immutable). Used for pastes: one Undo removes all of it.
+//
+// The input is single-line: trailing line breaks are dropped and inner line
+// breaks and tabs become spaces.
+func (t TextInput) Insert(text string) TextInput {
+	text = strings.TrimRight(text, "\r\n")
+	text = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ", "\t", " ").Replace(text)
+	if t.numeric != nil {
+		text = strings.TrimSpace(text)
+	}
+	if text == "" {
+		return t
+	}
+
+	result := t
+	if result.selection != nil && !result.selection.IsEmpty() {
+		result = result.deleteSelection()
+	}
+
+	before, at, after := result.cursorMovement.SplitAtCursor(result.content, result.cursor.Offset())
+	result.content = before + text + at + after
+	result.cursor = value2.NewCursor(result.cursorMovement.GraphemeCount(before + text))
+	result.selection = nil
+
+	if !result.acceptsContent() {
+		return t
+	}
+	return result.recordEdit(t)
 }
 
 // DeleteBackward deletes grapheme before cursor (Backspace) (immutable).
 func (t TextInput) DeleteBackward() TextInput {
+	return t.deleteBackward().recordEdit(t)
+}
+
+// deleteBackward implements DeleteBackward without recording undo history.
+func (t TextInput) deleteBackward() TextInput {
 	// If selection exists, delete it.
 	if t.selection != nil && !t.selection.IsEmpty() {
 		return t.deleteSelection()
@@ -273,6 +405,11 @@ func (t TextInput) DeleteBackward() TextInput {
 
 // DeleteForward deletes grapheme after cursor (Delete) (immutable).
 func (t TextInput) DeleteForward() TextInput {
+	return t.deleteForward().recordEdit(t)
+}
+
+// deleteForward implements DeleteForward without recording undo history.
+func (t TextInput) deleteForward() TextInput {
 	// If selection exists, delete it.
 	if t.selection != nil && !t.selection.IsEmpty() {
 		return t.deleteSelection()
@@ -294,11 +431,12 @@ func (t TextInput) DeleteForward() TextInput {
 
 // Clear removes all content (Ctrl-U) (immutable).
 func (t TextInput) Clear() TextInput {
-	t.content = ""
-	t.cursor = value2.NewCursor(0)
-	t.selection = nil
-	t.scrollOffset = 0
-	return t
+	result := t
+	result.content = ""
+	result.cursor = value2.NewCursor(0)
+	result.selection = nil
+	result.scrollOffset = 0
+	return result.recordEdit(t)
 }
 
 // SelectAll selects all content (Ctrl-A) (immutable).
@@ -315,14 +453,321 @@ func (t TextInput) ClearSelection() TextInput {
 	return t
 }
 
+// WithHistory sets the entries recalled with HistoryPrev/HistoryNext,
+// oldest first (immutable).
+func (t TextInput) WithHistory(entries []string) TextInput {
+	t.history = value2.NewHistory(entries)
+	return t
+}
+
+// History returns the history entries, oldest first (nil if not enabled).
+func (t *TextInput) History() []string {
+	if t.history == nil {
+		return nil
+	}
+	return t.history.Entries()
+}
+
+// HistoryIndex returns the position of the recalled history entry
+// (0 = oldest), or -1 when not browsing history.
+func (t *TextInput) HistoryIndex() int {
+	if t.history == nil {
+		return -1
+	}
+	return t.history.Index()
+}
+
+// AddHistory appends a submitted entry and stops browsing (immutable).
+// Enables history if it wasn't. Content is left unchanged.
+func (t TextInput) AddHistory(entry string) TextInput {
+	if t.history == nil {
+		t.history = value2.NewHistory(nil)
+	}
+	t.history = t.history.Add(entry)
+	return t
+}
+
+// HistoryPrev recalls the previous (older) history entry, keeping the
+// current text so it can be restored (immutable).
+func (t TextInput) HistoryPrev() TextInput {
+	if t.history == nil {
+		return t
+	}
+	h, text, ok := t.history.Prev(t.content)
+	if !ok {
+		return t
+	}
+	t.history = h
+	return t.recall(text)
+}
+
+// HistoryNext recalls the next (newer) history entry, ending at the text
+// that was being typed before browsing started (immutable).
+func (t TextInput) HistoryNext() TextInput {
+	if t.history == nil {
+		return t
+	}
+	h, text, ok := t.history.Next(t.content)
+	if !ok {
+		return t
+	}
+	t.history = h
+	return t.recall(text)
+}
+
+// recall replaces the content with a history entry, cursor at end of line.
+// The replacement is recorded as an edit, so Undo returns to the old text.
+func (t TextInput) recall(text string) TextInput {
+	result := t
+	result.content = text
+	result.cursor = value2.NewCursor(result.cursorMovement.GraphemeCount(text))
+	result.selection = nil
+	return result.recordEdit(t)
+}
+
+// WithUndoLimit sets how many edits Undo can revert (immutable).
+// The undo history is cleared; a limit below 1 disables undo.
+func (t TextInput) WithUndoLimit(limit int) TextInput {
+	t.undo = value2.NewUndoStack(limit)
+	t.typingAt = -1
+	return t
+}
+
+// CanUndo reports whether there is an edit to undo.
+func (t *TextInput) CanUndo() bool {
+	return t.undo != nil && t.undo.CanUndo()
+}
+
+// CanRedo reports whether there is an undone edit to redo.
+func (t *TextInput) CanRedo() bool {
+	return t.undo != nil && t.undo.CanRedo()
+}
+
+// Undo reverts the last edit, restoring content and cursor (immutable).
+func (t TextInput) Undo() TextInput {
+	if t.undo == nil {
+		return t
+	}
+	stack, s, ok := t.undo.Undo(t.snapshot())
+	if !ok {
+		return t
+	}
+	t.undo = stack
+	return t.restore(s)
+}
+
+// Redo re-applies the last undone edit (immutable).
+func (t TextInput) Redo() TextInput {
+	if t.undo == nil {
+		return t
+	}
+	stack, s, ok := t.undo.Redo(t.snapshot())
+	if !ok {
+		return t
+	}
+	t.undo = stack
+	return t.restore(s)
+}
+
+// snapshot captures the state Undo/Redo restore.
+func (t *TextInput) snapshot() value2.Snapshot {
+	return value2.Snapshot{Content: t.content, Cursor: t.cursor.Offset()}
+}
+
+// restore applies a snapshot and closes the open typing group.
+func (t TextInput) restore(s value2.Snapshot) TextInput {
+	t.content = s.Content
+	t.cursor = value2.NewCursor(s.Cursor)
+	t.selection = nil
+	t.typingAt = -1
+	return t
+}
+
+// recordEdit pushes the state of before onto the undo stack if t changed the
+// content, and closes the open typing group (immutable).
+func (t TextInput) recordEdit(before TextInput) TextInput {
+	if t.content == before.content {
+		return t
+	}
+	if t.undo != nil {
+		t.undo = t.undo.Push(before.snapshot())
+	}
+	t.typingAt = -1
+	return t
+}
+
+// resetUndo clears the undo history, keeping its limit.
+func (t TextInput) resetUndo() TextInput {
+	if t.undo != nil {
+		t.undo = value2.NewUndoStack(t.undo.Limit())
+	}
+	t.typingAt = -1
+	return t
+}
+
+// WithSuggestions sets the autocomplete candidates, in order of preference
+// (immutable). Replaces any suggestion function. The slice is copied.
+func (t TextInput) WithSuggestions(suggestions []string) TextInput {
+	t.suggestions = append([]string(nil), suggestions...)
+	t.suggestFunc = nil
+	return t
+}
+
+// WithSuggestionFunc sets a function returning the autocomplete candidates
+// for the current content (immutable). Replaces any static suggestions.
+func (t TextInput) WithSuggestionFunc(fn func(string) []string) TextInput {
+	t.suggestFunc = fn
+	t.suggestions = nil
+	return t
+}
+
+// WithSuggestionMatcher sets how candidates are matched against the content
+// (immutable). nil restores the default case-insensitive prefix matcher.
+func (t TextInput) WithSuggestionMatcher(matcher service2.SuggestionMatcher) TextInput {
+	t.matcher = matcher
+	return t
+}
+
+// CurrentSuggestion returns the best candidate for the current content, or
+// "" if the content is empty, masked, or nothing matches.
+func (t *TextInput) CurrentSuggestion() string {
+	if t.content == "" || t.mask != 0 {
+		return ""
+	}
+	candidates := t.suggestions
+	if t.suggestFunc != nil {
+		candidates = t.suggestFunc(t.content)
+	}
+	return service2.BestSuggestion(t.content, candidates, t.matcher)
+}
+
+// IsCursorAtEnd reports whether the cursor is after the last grapheme.
+func (t *TextInput) IsCursorAtEnd() bool {
+	return t.cursor.Offset() >= t.cursorMovement.GraphemeCount(t.content)
+}
+
+// SuggestionRemainder returns the part of the current suggestion past the
+// content, shown as ghost text after the cursor ("" if there is none).
+func (t *TextInput) SuggestionRemainder() string {
+	suggestion := t.CurrentSuggestion()
+	if suggestion == "" {
+		return ""
+	}
+	_, rest := service2.SplitGraphemes(suggestion, t.cursorMovement.GraphemeCount(t.content))
+	return rest
+}
+
+// AcceptSuggestion replaces the content with the current suggestion and
+// moves the cursor to the end, as one undoable edit (immutable).
+// No-op if there is no suggestion.
+func (t TextInput) AcceptSuggestion() TextInput {
+	suggestion := t.CurrentSuggestion()
+	if suggestion == "" {
+		return t
+	}
+
+	result := t
+	result.content = suggestion
+	result.cursor = value2.NewCursor(result.cursorMovement.GraphemeCount(suggestion))
+	result.selection = nil
+	if !result.acceptsContent() {
+		return t
+	}
+	return result.recordEdit(t)
+}
+
+// WithNumeric switches to numeric mode (immutable): only digits (and a
+// leading minus if minValue < 0) can be entered, and Increment/Decrement step
+// within [minValue, maxValue]. Bounds given in the wrong order are swapped.
+func (t TextInput) WithNumeric(minValue, maxValue int) TextInput {
+	t.numeric = value2.NewIntRange(minValue, maxValue)
+	return t
+}
+
+// IsNumeric reports whether numeric mode is enabled.
+func (t *TextInput) IsNumeric() bool {
+	return t.numeric != nil
+}
+
+// Increment adds one to the number, clamped to the range (immutable).
+// Empty content becomes the in-range value closest to 0.
+// No-op unless numeric mode is enabled.
+func (t TextInput) Increment() TextInput {
+	return t.step(1)
+}
+
+// Decrement subtracts one from the number, clamped to the range (immutable).
+// Empty content becomes the in-range value closest to 0.
+// No-op unless numeric mode is enabled.
+func (t TextInput) Decrement() TextInput {
+	return t.step(-1)
+}
+
+// IntValue parses the content as an integer.
+// Returns ErrEmpty for empty content, ErrNotANumber if it does not parse and,
+// in numeric mode, the number together with ErrOutOfRange if it is outside
+// the range.
+func (t *TextInput) IntValue() (int, error) {
+	if t.content == "" {
+		return 0, service2.ErrEmpty
+	}
+	n, err := strconv.Atoi(t.content)
+	if err != nil {
+		return 0, service2.ErrNotANumber
+	}
+	if t.numeric != nil && !t.numeric.Contains(n) {
+		return n, fmt.Errorf("%w: %d is not in [%d, %d]",
+			service2.ErrOutOfRange, n, t.numeric.Min(), t.numeric.Max())
+	}
+	return n, nil
+}
+
 // Validate runs the validator on current content.
+// In numeric mode non-empty content must also be a number within the range;
+// empty content is left to the validator (use NotEmpty to require a value).
 func (t *TextInput) Validate() error {
+	if t.numeric != nil && t.content != "" {
+		if _, err := t.IntValue(); err != nil {
+			return err
+		}
+	}
 	return t.validationSvc.Validate(t.content, t.validator)
 }
 
 // IsValid returns true if content passes validation.
 func (t *TextInput) IsValid() bool {
-	return t.validationSvc.IsValid(t.content, t.validator)
+	return t.Validate() == nil
+}
+
+// step replaces the number with the stepped and clamped value, cursor at end.
+func (t TextInput) step(delta int) TextInput {
+	if t.numeric == nil {
+		return t
+	}
+	result := t
+	result.content = strconv.Itoa(t.numeric.Step(t.content, delta))
+	result.cursor = value2.NewCursor(result.cursorMovement.GraphemeCount(result.content))
+	result.selection = nil
+	return result.recordEdit(t)
+}
+
+// acceptsContent reports whether the content is allowed in the current mode.
+func (t *TextInput) acceptsContent() bool {
+	return t.numeric == nil || t.numeric.IsPartial(t.content)
+}
+
+// deleteRange deletes graphemes [start, end) and puts the cursor at start.
+func (t TextInput) deleteRange(start, end int) TextInput {
+	if start >= end {
+		return t
+	}
+	startByteOffset := t.cursorMovement.GraphemeOffsetToByteOffset(t.content, start)
+	endByteOffset := t.cursorMovement.GraphemeOffsetToByteOffset(t.content, end)
+
+	t.content = t.content[:startByteOffset] + t.content[endByteOffset:]
+	t.cursor = value2.NewCursor(start)
+	t.selection = nil
+	return t
 }
 
 // deleteSelection is a helper that deletes the selected text.
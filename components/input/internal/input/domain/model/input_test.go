@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	service2 "github.com/phoenix-tui/phoenix/components/input/internal/input/domain/service"
@@ -554,6 +555,21 @@ func TestTextInput_WithPlaceholder(t *testing.T) {
 	}
 }
 
+func TestTextInput_WithMask(t *testing.T) {
+	input := New(40).WithContent("secret").WithMask('*')
+
+	if input.Mask() != '*' {
+		t.Errorf("Mask() = %q, want '*'", input.Mask())
+	}
+	if input.Content() != "secret" {
+		t.Errorf("Content() = %q, masking must not change content", input.Content())
+	}
+	unmasked := input.WithMask(0)
+	if unmasked.Mask() != 0 {
+		t.Error("WithMask(0) should disable masking")
+	}
+}
+
 func TestTextInput_WithWidth(t *testing.T) {
 	input := New(40).WithWidth(80)
 
@@ -652,3 +668,318 @@ func TestTextInput_ComplexUnicode(t *testing.T) {
 		})
 	}
 }
+
+func TestTextInput_History(t *testing.T) {
+	input := New(40).WithHistory([]string{"ls", "pwd"}).WithContent("draft")
+
+	input = input.HistoryPrev()
+	if input.Content() != "pwd" || input.HistoryIndex() != 1 {
+		t.Fatalf("HistoryPrev = (%q, %d), want (pwd, 1)", input.Content(), input.HistoryIndex())
+	}
+	if input.CursorPosition() != 3 {
+		t.Errorf("CursorPosition() = %d, want 3 (end of line)", input.CursorPosition())
+	}
+
+	input = input.HistoryNext()
+	if input.Content() != "draft" || input.HistoryIndex() != -1 {
+		t.Errorf("HistoryNext = (%q, %d), want (draft, -1)", input.Content(), input.HistoryIndex())
+	}
+
+	input = input.AddHistory("cd /tmp")
+	if got := input.History(); len(got) != 3 || got[2] != "cd /tmp" {
+		t.Errorf("History() = %v", got)
+	}
+}
+
+func TestTextInput_History_Disabled(t *testing.T) {
+	input := New(40).WithContent("text")
+
+	if got := input.HistoryPrev(); got.Content() != "text" {
+		t.Error("HistoryPrev without history should be a no-op")
+	}
+	if input.HistoryIndex() != -1 || input.History() != nil {
+		t.Error("history should be empty by default")
+	}
+}
+
+func TestTextInput_WordMovement(t *testing.T) {
+	input := New(40).SetContent("git commit --amend", 18)
+
+	input = input.MoveWordLeft()
+	if input.CursorPosition() != 13 {
+		t.Errorf("MoveWordLeft() cursor = %d, want 13", input.CursorPosition())
+	}
+	input = input.MoveWordLeft()
+	if input.CursorPosition() != 4 {
+		t.Errorf("MoveWordLeft() cursor = %d, want 4", input.CursorPosition())
+	}
+	input = input.MoveWordRight()
+	if input.CursorPosition() != 10 {
+		t.Errorf("MoveWordRight() cursor = %d, want 10", input.CursorPosition())
+	}
+}
+
+func TestTextInput_DeleteWord(t *testing.T) {
+	input := New(40).SetContent("hello wide 世界", 13)
+
+	input = input.DeleteWordBackward()
+	if input.Content() != "hello wide " || input.CursorPosition() != 11 {
+		t.Errorf("DeleteWordBackward() = (%q, %d)", input.Content(), input.CursorPosition())
+	}
+
+	input = input.SetContent("hello wide world", 5).DeleteWordForward()
+	if input.Content() != "hello world" || input.CursorPosition() != 5 {
+		t.Errorf("DeleteWordForward() = (%q, %d)", input.Content(), input.CursorPosition())
+	}
+
+	input = input.SetContent("abc", 0).DeleteWordBackward()
+	if input.Content() != "abc" {
+		t.Error("DeleteWordBackward() at start should be a no-op")
+	}
+}
+
+func TestTextInput_MaskedWordIsWholeContent(t *testing.T) {
+	input := New(40).WithMask('*').SetContent("correct horse battery", 10)
+
+	left := input.MoveWordLeft()
+	if left.CursorPosition() != 0 {
+		t.Errorf("masked MoveWordLeft() cursor = %d, want 0", left.CursorPosition())
+	}
+	right := input.MoveWordRight()
+	if right.CursorPosition() != 21 {
+		t.Errorf("masked MoveWordRight() cursor = %d, want 21", right.CursorPosition())
+	}
+
+	backward := input.DeleteWordBackward()
+	if backward.Content() != "rse battery" || backward.CursorPosition() != 0 {
+		t.Errorf("masked DeleteWordBackward() = (%q, %d)", backward.Content(), backward.CursorPosition())
+	}
+	forward := input.DeleteWordForward()
+	if forward.Content() != "correct ho" || forward.CursorPosition() != 10 {
+		t.Errorf("masked DeleteWordForward() = (%q, %d)", forward.Content(), forward.CursorPosition())
+	}
+}
+
+func TestTextInput_Insert(t *testing.T) {
+	input := New(40).SetContent("mail: ", 6)
+
+	input = input.Insert("user@example.com\n")
+	if input.Content() != "mail: user@example.com" {
+		t.Errorf("Content() = %q, want trailing newline dropped", input.Content())
+	}
+	if input.CursorPosition() != 22 {
+		t.Errorf("CursorPosition() = %d, want 22", input.CursorPosition())
+	}
+
+	input = input.SetContent("ab", 1).Insert("x\ny")
+	if input.Content() != "ax yb" || input.CursorPosition() != 4 {
+		t.Errorf("Insert() = (%q, %d), want (\"ax yb\", 4)", input.Content(), input.CursorPosition())
+	}
+
+	input = input.SelectAll().Insert("new")
+	if input.Content() != "new" {
+		t.Errorf("Insert() over selection = %q, want new", input.Content())
+	}
+}
+
+func TestTextInput_UndoPaste(t *testing.T) {
+	input := New(40).InsertRune('a').Insert("https://example.com")
+
+	input = input.Undo()
+	if input.Content() != "a" || input.CursorPosition() != 1 {
+		t.Errorf("Undo() = (%q, %d), want (\"a\", 1)", input.Content(), input.CursorPosition())
+	}
+
+	input = input.Redo()
+	if input.Content() != "ahttps://example.com" {
+		t.Errorf("Redo() = %q", input.Content())
+	}
+	if input.CanRedo() {
+		t.Error("CanRedo() should be false after redoing everything")
+	}
+}
+
+func TestTextInput_UndoTypingGroups(t *testing.T) {
+	input := *New(40)
+	for _, r := range "hello world" {
+		input = input.InsertRune(r)
+	}
+
+	input = input.Undo()
+	if input.Content() != "hello" {
+		t.Errorf("first Undo() = %q, want hello", input.Content())
+	}
+	input = input.Undo()
+	if input.Content() != "" {
+		t.Errorf("second Undo() = %q, want empty", input.Content())
+	}
+	if input.CanUndo() {
+		t.Error("CanUndo() should be false")
+	}
+
+	// Moving the cursor closes the typing group.
+	input = input.SetContent("ab", 2).InsertRune('c').MoveLeft().MoveLeft().InsertRune('x')
+	input = input.Undo()
+	if input.Content() != "abc" {
+		t.Errorf("Undo() after cursor move = %q, want abc", input.Content())
+	}
+}
+
+func TestTextInput_UndoEdits(t *testing.T) {
+	input := New(40).Insert("one two").DeleteWordBackward().DeleteBackward().Clear()
+
+	want := []string{"one", "one ", "one two", ""}
+	for _, w := range want {
+		input = input.Undo()
+		if input.Content() != w {
+			t.Errorf("Undo() = %q, want %q", input.Content(), w)
+		}
+	}
+
+	// Programmatic content resets history.
+	input = input.Insert("x").SetContent("reset", 0)
+	if input.CanUndo() {
+		t.Error("SetContent should clear undo history")
+	}
+}
+
+func TestTextInput_UndoLimit(t *testing.T) {
+	input := New(40).WithUndoLimit(2).Insert("a").Insert("b").Insert("c")
+
+	input = input.Undo().Undo()
+	if input.Content() != "a" {
+		t.Errorf("Content() = %q, want a", input.Content())
+	}
+	if input.CanUndo() {
+		t.Error("only 2 edits should be kept")
+	}
+
+	disabled := New(40).WithUndoLimit(0).Insert("a")
+	if disabled.CanUndo() {
+		t.Error("limit 0 should disable undo")
+	}
+}
+
+func TestTextInput_NumericFiltering(t *testing.T) {
+	input := New(10).WithNumeric(-50, 50)
+
+	for _, r := range "-a1.2" {
+		input = input.InsertRune(r)
+	}
+	if input.Content() != "-12" {
+		t.Errorf("Content() = %q, want -12 (non-digits rejected)", input.Content())
+	}
+
+	// Minus only at the start.
+	input = input.InsertRune('-')
+	if input.Content() != "-12" {
+		t.Errorf("Content() = %q, minus accepted mid-number", input.Content())
+	}
+
+	unsigned := New(10).WithNumeric(0, 10).InsertRune('-')
+	if unsigned.Content() != "" {
+		t.Errorf("minus accepted with min >= 0: %q", unsigned.Content())
+	}
+
+	pasted := New(10).WithNumeric(0, 100).Insert(" 42\n").Insert("x")
+	if pasted.Content() != "42" {
+		t.Errorf("paste Content() = %q, want 42", pasted.Content())
+	}
+}
+
+func TestTextInput_NumericBackspaceToEmpty(t *testing.T) {
+	input := New(10).WithNumeric(5, 10).Increment()
+	if input.Content() != "5" {
+		t.Fatalf("Increment() on empty = %q, want 5 (closest to 0)", input.Content())
+	}
+
+	input = input.DeleteBackward()
+	if input.Content() != "" {
+		t.Errorf("Content() = %q, want empty (no snapping to min)", input.Content())
+	}
+	if !input.IsValid() {
+		t.Error("empty numeric input should be valid without NotEmpty")
+	}
+}
+
+func TestTextInput_IntValue(t *testing.T) {
+	tests := []struct {
+		content string
+		want    int
+		wantErr error
+	}{
+		{"42", 42, nil},
+		{"-7", -7, nil},
+		{"", 0, service2.ErrEmpty},
+		{"-", 0, service2.ErrNotANumber},
+		{"101", 101, service2.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		input := New(10).WithNumeric(-10, 100).SetContent(tt.content, 0)
+		got, err := input.IntValue()
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("IntValue(%q) = (%d, %v), want (%d, %v)", tt.content, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	outOfRange := New(10).WithNumeric(0, 10).SetContent("11", 0)
+	if outOfRange.IsValid() {
+		t.Error("out-of-range number should be invalid")
+	}
+}
+
+func TestTextInput_Suggestions(t *testing.T) {
+	input := New(40).WithSuggestions([]string{"deploy", "describe", "delete"})
+
+	if got := input.CurrentSuggestion(); got != "" {
+		t.Errorf("CurrentSuggestion() on empty content = %q, want \"\"", got)
+	}
+
+	input = input.InsertRune('D').InsertRune('e').InsertRune('s')
+	if got := input.CurrentSuggestion(); got != "describe" {
+		t.Errorf("CurrentSuggestion() = %q, want describe", got)
+	}
+	if got := input.SuggestionRemainder(); got != "cribe" {
+		t.Errorf("SuggestionRemainder() = %q, want cribe", got)
+	}
+
+	input = input.AcceptSuggestion()
+	if input.Content() != "describe" || !input.IsCursorAtEnd() {
+		t.Errorf("AcceptSuggestion() = %q at %d, want describe at the end", input.Content(), input.CursorPosition())
+	}
+	if input.CurrentSuggestion() != "" {
+		t.Error("a complete value should have no suggestion")
+	}
+
+	input = input.Undo()
+	if input.Content() != "Des" {
+		t.Errorf("Undo() after AcceptSuggestion = %q, want Des", input.Content())
+	}
+}
+
+func TestTextInput_SuggestionFuncAndMatcher(t *testing.T) {
+	calls := 0
+	input := New(40).
+		WithSuggestionFunc(func(content string) []string {
+			calls++
+			return []string{content + "/", content + ".go"}
+		}).
+		SetContent("main", 4)
+
+	if got := input.CurrentSuggestion(); got != "main/" || calls != 1 {
+		t.Errorf("CurrentSuggestion() = %q after %d calls, want main/ after 1", got, calls)
+	}
+
+	input = input.WithSuggestionMatcher(func(_, suggestion string) bool {
+		return strings.HasSuffix(suggestion, ".go")
+	})
+	if got := input.CurrentSuggestion(); got != "main.go" {
+		t.Errorf("CurrentSuggestion() with matcher = %q, want main.go", got)
+	}
+
+	masked := input.WithMask('*')
+	if got := masked.CurrentSuggestion(); got != "" {
+		t.Errorf("CurrentSuggestion() on masked input = %q, want \"\"", got)
+	}
+}
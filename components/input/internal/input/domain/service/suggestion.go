@@ -0,0 +1,57 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// SuggestionMatcher reports whether suggestion is a completion of input.
+// The part of suggestion past the length of input (in graphemes) is shown
+// as ghost text, so matchers should accept suggestions that extend input.
+type SuggestionMatcher func(input, suggestion string) bool
+
+// PrefixMatcher returns a matcher that accepts suggestions starting with
+// the input, ignoring case. The comparison covers whole graphemes, so a
+// base letter never matches half of a letter with a combining mark.
+func PrefixMatcher() SuggestionMatcher {
+	return func(input, suggestion string) bool {
+		prefix, _ := SplitGraphemes(suggestion, uniseg.GraphemeClusterCount(input))
+		return strings.EqualFold(prefix, input)
+	}
+}
+
+// BestSuggestion returns the first candidate that matcher accepts for input
+// and that is longer than input, or "" if there is none or input is empty.
+// A nil matcher means PrefixMatcher.
+func BestSuggestion(input string, candidates []string, matcher SuggestionMatcher) string {
+	if input == "" {
+		return ""
+	}
+	if matcher == nil {
+		matcher = PrefixMatcher()
+	}
+
+	inputLen := uniseg.GraphemeClusterCount(input)
+	for _, candidate := range candidates {
+		if uniseg.GraphemeClusterCount(candidate) > inputLen && matcher(input, candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// SplitGraphemes splits s after its first n graphemes.
+// If s has fewer than n graphemes, rest is empty.
+func SplitGraphemes(s string, n int) (head, rest string) {
+	if n <= 0 {
+		return "", s
+	}
+
+	gr := uniseg.NewGraphemes(s)
+	for count := 0; count < n && gr.Next(); count++ {
+		_, end := gr.Positions()
+		head = s[:end]
+	}
+	return head, s[len(head):]
+}
@@ -0,0 +1,83 @@
+package service
+
+import "testing"
+
+func TestPrefixMatcher(t *testing.T) {
+	match := PrefixMatcher()
+
+	tests := []struct {
+		name       string
+		input      string
+		suggestion string
+		want       bool
+	}{
+		{"prefix", "git", "git status", true},
+		{"case-insensitive", "GIT s", "git status", true},
+		{"not a prefix", "status", "git status", false},
+		{"cyrillic", "ПРИ", "привет", true},
+		{"input longer than suggestion", "gitx", "git", false},
+		{"combining mark is part of the grapheme", "cafe", "café", false},
+		{"whole grapheme matches", "café", "café au lait", true},
+		{"emoji", "👍🏽 ok", "👍🏽 okay", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := match(tt.input, tt.suggestion); got != tt.want {
+				t.Errorf("PrefixMatcher()(%q, %q) = %v, want %v", tt.input, tt.suggestion, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBestSuggestion(t *testing.T) {
+	candidates := []string{"git", "git status", "git stash", "go build"}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"first match wins", "git st", "git status"},
+		{"exact match is skipped", "git", "git status"},
+		{"empty input", "", ""},
+		{"no match", "make", ""},
+		{"complete input", "git status", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BestSuggestion(tt.input, candidates, nil); got != tt.want {
+				t.Errorf("BestSuggestion(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	contains := func(input, suggestion string) bool { return suggestion[len(suggestion)-1:] == input }
+	if got := BestSuggestion("d", candidates, contains); got != "go build" {
+		t.Errorf("BestSuggestion() with custom matcher = %q, want %q", got, "go build")
+	}
+}
+
+func TestSplitGraphemes(t *testing.T) {
+	tests := []struct {
+		s        string
+		n        int
+		wantHead string
+		wantRest string
+	}{
+		{"hello", 2, "he", "llo"},
+		{"hello", 0, "", "hello"},
+		{"hi", 5, "hi", ""},
+		{"café!", 4, "café", "!"},
+		{"中文字", 1, "中", "文字"},
+	}
+
+	for _, tt := range tests {
+		head, rest := SplitGraphemes(tt.s, tt.n)
+		if head != tt.wantHead || rest != tt.wantRest {
+			t.Errorf("SplitGraphemes(%q, %d) = (%q, %q), want (%q, %q)",
+				tt.s, tt.n, head, rest, tt.wantHead, tt.wantRest)
+		}
+	}
+}
@@ -34,6 +34,8 @@ var (
 	ErrTooShort      = errors.New("content is too short")
 	ErrTooLong       = errors.New("content is too long")
 	ErrInvalidFormat = errors.New("content has invalid format")
+	ErrNotANumber    = errors.New("content is not a number")
+	ErrOutOfRange    = errors.New("number is out of range")
 )
 
 // NotEmpty returns a validator that ensures content is not empty.
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// WordLeft returns the grapheme offset of the start of the word before
+// currentPos (readline backward-word): separators are skipped first, then
+// the word itself.
+//
+// Words are runs of letters and digits in any script (combining marks stay
+// with their base); whitespace and punctuation separate words.
+func (s *CursorMovementService) WordLeft(content string, currentPos int) int {
+	kinds := wordKinds(content)
+	pos := min(max(currentPos, 0), len(kinds))
+
+	for pos > 0 && !kinds[pos-1] {
+		pos--
+	}
+	for pos > 0 && kinds[pos-1] {
+		pos--
+	}
+	return pos
+}
+
+// WordRight returns the grapheme offset of the end of the word after
+// currentPos (readline forward-word). See WordLeft for what counts as a word.
+func (s *CursorMovementService) WordRight(content string, currentPos int) int {
+	kinds := wordKinds(content)
+	pos := min(max(currentPos, 0), len(kinds))
+
+	for pos < len(kinds) && !kinds[pos] {
+		pos++
+	}
+	for pos < len(kinds) && kinds[pos] {
+		pos++
+	}
+	return pos
+}
+
+// wordKinds classifies each grapheme cluster of content: true for word
+// graphemes, false for separators.
+func wordKinds(content string) []bool {
+	var kinds []bool
+	gr := uniseg.NewGraphemes(content)
+	for gr.Next() {
+		kinds = append(kinds, isWordGrapheme(gr.Str()))
+	}
+	return kinds
+}
+
+// isWordGrapheme reports whether a grapheme cluster is part of a word,
+// judged by its base rune.
+func isWordGrapheme(g string) bool {
+	r, _ := utf8.DecodeRuneInString(g)
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,58 @@
+package service
+
+import "testing"
+
+func TestCursorMovementService_WordLeft(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		pos     int
+		want    int
+	}{
+		{"end of text", "hello world", 11, 6},
+		{"inside word", "hello world", 8, 6},
+		{"from word start", "hello world", 6, 0},
+		{"skips punctuation", "foo.bar--baz", 9, 4},
+		{"start stays", "hello", 0, 0},
+		{"digits are words", "v2 1024", 7, 3},
+		{"cyrillic", "привет мир", 10, 7},
+		{"cjk", "中文 字", 4, 3},
+		{"combining mark stays with base", "café bar", 5, 0},
+		{"clamps out of range", "ab", 10, 0},
+	}
+
+	s := NewCursorMovementService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.WordLeft(tt.content, tt.pos); got != tt.want {
+				t.Errorf("WordLeft(%q, %d) = %d, want %d", tt.content, tt.pos, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorMovementService_WordRight(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		pos     int
+		want    int
+	}{
+		{"start of text", "hello world", 0, 5},
+		{"at separator", "hello world", 5, 11},
+		{"inside word", "hello world", 2, 5},
+		{"skips punctuation", "foo.bar", 3, 7},
+		{"end stays", "hello", 5, 5},
+		{"emoji is a separator", "hi 👋 there", 2, 10},
+		{"negative clamps", "ab cd", -3, 2},
+	}
+
+	s := NewCursorMovementService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.WordRight(tt.content, tt.pos); got != tt.want {
+				t.Errorf("WordRight(%q, %d) = %d, want %d", tt.content, tt.pos, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package value
+
+// History is a list of previously submitted entries with a shell-like
+// browsing position.
+//
+// Browsing works like bash: the text being typed (the draft) is kept while
+// recalling older entries, and edits made to a recalled entry survive moving
+// away from it and back, until the next entry is added.
+// All operations are immutable - they return new instances.
+type History struct {
+	entries []string       // Oldest first
+	index   int            // Browsing position; len(entries) = the draft (not browsing)
+	edits   map[int]string // Unsubmitted edits by position (including the draft)
+}
+
+// NewHistory creates a history from entries (oldest first).
+// Empty entries are skipped. The slice is copied.
+func NewHistory(entries []string) *History {
+	h := &History{entries: make([]string, 0, len(entries))}
+	for _, e := range entries {
+		if e != "" {
+			h.entries = append(h.entries, e)
+		}
+	}
+	h.index = len(h.entries)
+	return h
+}
+
+// Entries returns a copy of the entries, oldest first.
+func (h *History) Entries() []string {
+	return append([]string(nil), h.entries...)
+}
+
+// Len returns the number of entries.
+func (h *History) Len() int {
+	return len(h.entries)
+}
+
+// Index returns the position of the recalled entry (0 = oldest),
+// or -1 when not browsing (editing the draft).
+func (h *History) Index() int {
+	if h.index == len(h.entries) {
+		return -1
+	}
+	return h.index
+}
+
+// Add appends entry and stops browsing, discarding the draft and all
+// unsubmitted edits. Empty entries and repeats of the newest entry are not
+// stored.
+// Returns a new History instance (immutable).
+func (h *History) Add(entry string) *History {
+	entries := h.entries
+	if entry != "" && (len(entries) == 0 || entries[len(entries)-1] != entry) {
+		entries = append(append([]string(nil), entries...), entry)
+	}
+	return &History{entries: entries, index: len(entries)}
+}
+
+// Prev moves to the previous (older) entry. current is the text being edited
+// at the current position; it is kept so coming back restores it.
+// Returns the new History and the text to show, or ok=false at the oldest
+// entry.
+func (h *History) Prev(current string) (next *History, text string, ok bool) {
+	if h.index == 0 {
+		return h, current, false
+	}
+	return h.moveTo(h.index-1, current)
+}
+
+// Next moves to the next (newer) entry, ending at the draft. See Prev.
+func (h *History) Next(current string) (next *History, text string, ok bool) {
+	if h.index >= len(h.entries) {
+		return h, current, false
+	}
+	return h.moveTo(h.index+1, current)
+}
+
+// moveTo records current as the text of the current position and moves to
+// index (copy-on-write for edits).
+func (h *History) moveTo(index int, current string) (next *History, text string, ok bool) {
+	edits := make(map[int]string, len(h.edits)+1)
+	for k, v := range h.edits {
+		edits[k] = v
+	}
+	if current != h.original(h.index) {
+		edits[h.index] = current
+	} else {
+		delete(edits, h.index)
+	}
+
+	next = &History{entries: h.entries, index: index, edits: edits}
+	if edited, ok := edits[index]; ok {
+		return next, edited, true
+	}
+	return next, h.original(index), true
+}
+
+// original returns the stored text of a position ("" for the draft).
+func (h *History) original(index int) string {
+	if index >= len(h.entries) {
+		return ""
+	}
+	return h.entries[index]
+}
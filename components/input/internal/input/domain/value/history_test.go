@@ -0,0 +1,77 @@
+package value
+
+import "testing"
+
+func TestNewHistory(t *testing.T) {
+	h := NewHistory([]string{"ls", "", "pwd"})
+
+	if h.Len() != 2 {
+		t.Errorf("Len() = %d, want 2 (empty entries skipped)", h.Len())
+	}
+	if h.Index() != -1 {
+		t.Errorf("Index() = %d, want -1 (not browsing)", h.Index())
+	}
+}
+
+func TestHistory_PrevNext(t *testing.T) {
+	h := NewHistory([]string{"one", "two"})
+
+	h, text, ok := h.Prev("draft")
+	if !ok || text != "two" || h.Index() != 1 {
+		t.Fatalf("Prev = (%q, %v, index %d), want (two, true, 1)", text, ok, h.Index())
+	}
+	h, text, _ = h.Prev(text)
+	if text != "one" || h.Index() != 0 {
+		t.Fatalf("Prev = (%q, index %d), want (one, 0)", text, h.Index())
+	}
+	if _, text, ok = h.Prev(text); ok || text != "one" {
+		t.Errorf("Prev at oldest = (%q, %v), want (one, false)", text, ok)
+	}
+
+	h, _, _ = h.Next("one")
+	h, text, ok = h.Next("two")
+	if !ok || text != "draft" || h.Index() != -1 {
+		t.Errorf("Next to draft = (%q, %v, index %d), want (draft, true, -1)", text, ok, h.Index())
+	}
+	if _, _, ok = h.Next(text); ok {
+		t.Error("Next past the draft should fail")
+	}
+}
+
+func TestHistory_PreservesEdits(t *testing.T) {
+	h := NewHistory([]string{"one", "two"})
+
+	h, _, _ = h.Prev("draft")
+	h, _, _ = h.Prev("two (edited)")
+	h, text, _ := h.Next("one")
+	if text != "two (edited)" {
+		t.Errorf("recalled entry = %q, want the edited text", text)
+	}
+	_, text, _ = h.Next(text)
+	if text != "draft" {
+		t.Errorf("draft = %q, want draft", text)
+	}
+	if h.Entries()[1] != "two" {
+		t.Error("edits must not change stored entries")
+	}
+}
+
+func TestHistory_Add(t *testing.T) {
+	h := NewHistory([]string{"one"})
+	h, _, _ = h.Prev("draft")
+
+	h2 := h.Add("two").Add("two").Add("")
+
+	if got := h2.Entries(); len(got) != 2 || got[1] != "two" {
+		t.Errorf("Entries() = %v, want [one two]", got)
+	}
+	if h2.Index() != -1 {
+		t.Error("Add should stop browsing")
+	}
+	if _, text, _ := h2.Prev("x"); text != "two" {
+		t.Errorf("Prev after Add = %q, want two", text)
+	}
+	if h.Len() != 1 {
+		t.Error("original history should be unchanged")
+	}
+}
@@ -0,0 +1,82 @@
+package value
+
+import (
+	"errors"
+	"strconv"
+)
+
+// IntRange is the inclusive range of integers a numeric input accepts.
+// All operations are immutable - they return new instances.
+type IntRange struct {
+	minValue int
+	maxValue int
+}
+
+// NewIntRange creates a range from minValue to maxValue inclusive.
+// The bounds are swapped if given in the wrong order.
+func NewIntRange(minValue, maxValue int) *IntRange {
+	if minValue > maxValue {
+		minValue, maxValue = maxValue, minValue
+	}
+	return &IntRange{minValue: minValue, maxValue: maxValue}
+}
+
+// Min returns the lower bound.
+func (r *IntRange) Min() int {
+	return r.minValue
+}
+
+// Max returns the upper bound.
+func (r *IntRange) Max() int {
+	return r.maxValue
+}
+
+// Contains reports whether n is within the range.
+func (r *IntRange) Contains(n int) bool {
+	return n >= r.minValue && n <= r.maxValue
+}
+
+// Clamp returns n limited to the range.
+func (r *IntRange) Clamp(n int) int {
+	return min(max(n, r.minValue), r.maxValue)
+}
+
+// AllowsNegative reports whether a leading minus sign may be typed.
+func (r *IntRange) AllowsNegative() bool {
+	return r.minValue < 0
+}
+
+// IsPartial reports whether text can appear while typing a number in this
+// range: digits with an optional leading minus (only if negatives are
+// allowed), including "" and a lone "-".
+// Bounds are not checked - "9" is a valid prefix of "90" even if max is 50.
+func (r *IntRange) IsPartial(text string) bool {
+	for i, c := range text {
+		switch {
+		case c >= '0' && c <= '9':
+		case c == '-' && i == 0 && r.AllowsNegative():
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Step adds delta to the number in text and clamps the result to the range.
+// Empty or unparsable text starts from 0 (clamped) without stepping; numbers
+// too large for int are treated as the nearest bound.
+func (r *IntRange) Step(text string, delta int) int {
+	n, err := strconv.Atoi(text) // Saturates on overflow
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return r.Clamp(0)
+	}
+	// Step from the clamped value so out-of-range text moves back inside.
+	n = r.Clamp(n)
+	switch {
+	case delta > 0 && n > r.maxValue-delta:
+		return r.maxValue
+	case delta < 0 && n < r.minValue-delta:
+		return r.minValue
+	}
+	return n + delta
+}
@@ -0,0 +1,78 @@
+package value
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewIntRange(t *testing.T) {
+	r := NewIntRange(10, -5)
+	if r.Min() != -5 || r.Max() != 10 {
+		t.Errorf("NewIntRange(10, -5) = [%d, %d], want [-5, 10]", r.Min(), r.Max())
+	}
+	if !r.AllowsNegative() {
+		t.Error("AllowsNegative() should be true for min < 0")
+	}
+	if NewIntRange(0, 10).AllowsNegative() {
+		t.Error("AllowsNegative() should be false for min >= 0")
+	}
+}
+
+func TestIntRange_IsPartial(t *testing.T) {
+	signed := NewIntRange(-10, 10)
+	unsigned := NewIntRange(0, 10)
+
+	tests := []struct {
+		text         string
+		wantSigned   bool
+		wantUnsigned bool
+	}{
+		{"", true, true},
+		{"42", true, true},
+		{"-", true, false},
+		{"-7", true, false},
+		{"4-2", false, false},
+		{"1.5", false, false},
+		{"abc", false, false},
+		{"٣", false, false}, // Only ASCII digits
+	}
+
+	for _, tt := range tests {
+		if got := signed.IsPartial(tt.text); got != tt.wantSigned {
+			t.Errorf("signed IsPartial(%q) = %v, want %v", tt.text, got, tt.wantSigned)
+		}
+		if got := unsigned.IsPartial(tt.text); got != tt.wantUnsigned {
+			t.Errorf("unsigned IsPartial(%q) = %v, want %v", tt.text, got, tt.wantUnsigned)
+		}
+	}
+}
+
+func TestIntRange_Step(t *testing.T) {
+	r := NewIntRange(1, 10)
+
+	tests := []struct {
+		text  string
+		delta int
+		want  int
+	}{
+		{"5", 1, 6},
+		{"5", -1, 4},
+		{"10", 1, 10},
+		{"1", -1, 1},
+		{"", 1, 1},                      // Empty starts at clamp(0)
+		{"-", -1, 1},                    // Unparsable starts at clamp(0)
+		{"50", -1, 9},                   // Out of range moves back inside
+		{"99999999999999999999", -1, 9}, // Overflow saturates, then clamps
+	}
+
+	for _, tt := range tests {
+		if got := r.Step(tt.text, tt.delta); got != tt.want {
+			t.Errorf("Step(%q, %d) = %d, want %d", tt.text, tt.delta, got, tt.want)
+		}
+	}
+
+	wide := NewIntRange(math.MinInt, math.MaxInt)
+	if got := wide.Step("9223372036854775807", 1); got != math.MaxInt {
+		t.Errorf("Step at MaxInt = %d, want MaxInt", got)
+	}
+}
@@ -0,0 +1,85 @@
+package value
+
+// DefaultUndoLimit is the number of edits an UndoStack keeps by default.
+const DefaultUndoLimit = 100
+
+// Snapshot is the editable state of an input at one point in time.
+type Snapshot struct {
+	Content string // Text content
+	Cursor  int    // Cursor position (grapheme offset)
+}
+
+// UndoStack records snapshots taken before each edit so they can be undone
+// and redone. The oldest snapshots are dropped once limit is reached.
+// All operations are immutable - they return new instances.
+type UndoStack struct {
+	undo  []Snapshot // Oldest first
+	redo  []Snapshot // Most recently undone last
+	limit int
+}
+
+// NewUndoStack creates an empty undo stack keeping at most limit edits.
+// A limit below 1 disables undo.
+func NewUndoStack(limit int) *UndoStack {
+	if limit < 0 {
+		limit = 0
+	}
+	return &UndoStack{limit: limit}
+}
+
+// Limit returns the maximum number of edits kept.
+func (u *UndoStack) Limit() int {
+	return u.limit
+}
+
+// CanUndo reports whether there is an edit to undo.
+func (u *UndoStack) CanUndo() bool {
+	return len(u.undo) > 0
+}
+
+// CanRedo reports whether there is an undone edit to redo.
+func (u *UndoStack) CanRedo() bool {
+	return len(u.redo) > 0
+}
+
+// Push records the state before an edit and discards the redo history.
+// Returns a new UndoStack instance (immutable).
+func (u *UndoStack) Push(before Snapshot) *UndoStack {
+	if u.limit == 0 {
+		return u
+	}
+	undo := u.undo
+	if len(undo) >= u.limit {
+		undo = undo[len(undo)-u.limit+1:]
+	}
+	undo = append(append(make([]Snapshot, 0, len(undo)+1), undo...), before)
+	return &UndoStack{undo: undo, limit: u.limit}
+}
+
+// Undo returns the state before the last edit. current is saved so the
+// edit can be redone. ok is false if there is nothing to undo.
+func (u *UndoStack) Undo(current Snapshot) (stack *UndoStack, restored Snapshot, ok bool) {
+	if len(u.undo) == 0 {
+		return u, current, false
+	}
+	restored = u.undo[len(u.undo)-1]
+	return &UndoStack{
+		undo:  u.undo[: len(u.undo)-1 : len(u.undo)-1],
+		redo:  append(append(make([]Snapshot, 0, len(u.redo)+1), u.redo...), current),
+		limit: u.limit,
+	}, restored, true
+}
+
+// Redo returns the state after the last undone edit. current is saved so
+// the edit can be undone again. ok is false if there is nothing to redo.
+func (u *UndoStack) Redo(current Snapshot) (stack *UndoStack, restored Snapshot, ok bool) {
+	if len(u.redo) == 0 {
+		return u, current, false
+	}
+	restored = u.redo[len(u.redo)-1]
+	return &UndoStack{
+		undo:  append(append(make([]Snapshot, 0, len(u.undo)+1), u.undo...), current),
+		redo:  u.redo[: len(u.redo)-1 : len(u.redo)-1],
+		limit: u.limit,
+	}, restored, true
+}
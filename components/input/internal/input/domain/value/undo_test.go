@@ -0,0 +1,75 @@
+package value
+
+import "testing"
+
+func TestUndoStack_UndoRedo(t *testing.T) {
+	u := NewUndoStack(10)
+	if u.CanUndo() || u.CanRedo() {
+		t.Fatal("new stack should be empty")
+	}
+
+	u = u.Push(Snapshot{Content: "", Cursor: 0})
+	u = u.Push(Snapshot{Content: "a", Cursor: 1})
+
+	u, s, ok := u.Undo(Snapshot{Content: "ab", Cursor: 2})
+	if !ok || s.Content != "a" || s.Cursor != 1 {
+		t.Fatalf("Undo = (%+v, %v), want ({a 1}, true)", s, ok)
+	}
+	if !u.CanRedo() {
+		t.Error("CanRedo() should be true after Undo")
+	}
+
+	u, s, ok = u.Redo(s)
+	if !ok || s.Content != "ab" {
+		t.Fatalf("Redo = (%+v, %v), want ab", s, ok)
+	}
+
+	u, _, _ = u.Undo(s)
+	u, _, _ = u.Undo(Snapshot{Content: "a", Cursor: 1})
+	if _, _, ok = u.Undo(Snapshot{}); ok {
+		t.Error("Undo past the first edit should fail")
+	}
+}
+
+func TestUndoStack_PushClearsRedo(t *testing.T) {
+	u := NewUndoStack(10).Push(Snapshot{Content: ""})
+	u, _, _ = u.Undo(Snapshot{Content: "a"})
+
+	u = u.Push(Snapshot{Content: ""})
+	if u.CanRedo() {
+		t.Error("Push should discard redo history")
+	}
+}
+
+func TestUndoStack_Limit(t *testing.T) {
+	u := NewUndoStack(2)
+	for _, c := range []string{"", "a", "ab"} {
+		u = u.Push(Snapshot{Content: c})
+	}
+
+	u, s, _ := u.Undo(Snapshot{Content: "abc"})
+	if s.Content != "ab" {
+		t.Errorf("first Undo = %q, want ab", s.Content)
+	}
+	u, s, _ = u.Undo(s)
+	if s.Content != "a" {
+		t.Errorf("second Undo = %q, want a", s.Content)
+	}
+	if u.CanUndo() {
+		t.Error("oldest edit should have been dropped at the limit")
+	}
+
+	if NewUndoStack(0).Push(Snapshot{}).CanUndo() {
+		t.Error("limit 0 should disable undo")
+	}
+}
+
+func TestUndoStack_Immutable(t *testing.T) {
+	u := NewUndoStack(10).Push(Snapshot{Content: "x"})
+	_ = u.Push(Snapshot{Content: "y"})
+	_, _, _ = u.Undo(Snapshot{Content: "z"})
+
+	if _, s, _ := u.Undo(Snapshot{}); s.Content != "x" {
+		t.Errorf("original stack changed: Undo = %q, want x", s.Content)
+	}
+}
@@ -36,6 +36,43 @@ func (kb *DefaultKeyBindings) Handle(input model.TextInput, msg tea.KeyMsg) mode
 		case 'e', 'E':
 			// Ctrl-E moves to end.
 			return input.MoveEnd()
+		case 'w', 'W':
+			// Ctrl-W deletes the previous word.
+			return input.DeleteWordBackward()
+		case 'z', 'Z':
+			// Ctrl-Z undoes the last edit.
+			return input.Undo()
+		case 'y', 'Y':
+			// Ctrl-Y redoes the last undone edit.
+			return input.Redo()
+		}
+	}
+
+	// Handle Alt (Meta) key combinations, readline style.
+	if msg.Alt && msg.Type == tea.KeyRune {
+		switch msg.Rune {
+		case 'b', 'B':
+			// Alt-B moves back one word.
+			return input.MoveWordLeft()
+		case 'f', 'F':
+			// Alt-F moves forward one word.
+			return input.MoveWordRight()
+		case 'd', 'D':
+			// Alt-D deletes the next word.
+			return input.DeleteWordForward()
+		}
+		return input
+	}
+
+	// Ctrl/Alt + arrows and Alt-Backspace work on whole words.
+	if msg.Ctrl || msg.Alt {
+		switch msg.Type {
+		case tea.KeyLeft:
+			return input.MoveWordLeft()
+		case tea.KeyRight:
+			return input.MoveWordRight()
+		case tea.KeyBackspace:
+			return input.DeleteWordBackward()
 		}
 	}
 
@@ -45,14 +82,35 @@ func (kb *DefaultKeyBindings) Handle(input model.TextInput, msg tea.KeyMsg) mode
 		return input.MoveLeft()
 
 	case tea.KeyRight:
+		// At the end of the content, Right accepts the autocomplete suggestion.
+		if input.IsCursorAtEnd() && input.CurrentSuggestion() != "" {
+			return input.AcceptSuggestion()
+		}
 		return input.MoveRight()
 
+	case tea.KeyTab:
+		// Tab accepts the autocomplete suggestion (no-op without one).
+		return input.AcceptSuggestion()
+
 	case tea.KeyHome:
 		return input.MoveHome()
 
 	case tea.KeyEnd:
 		return input.MoveEnd()
 
+	// Numeric stepping, or history (no-op unless history is enabled).
+	case tea.KeyUp:
+		if input.IsNumeric() {
+			return input.Increment()
+		}
+		return input.HistoryPrev()
+
+	case tea.KeyDown:
+		if input.IsNumeric() {
+			return input.Decrement()
+		}
+		return input.HistoryNext()
+
 	// Editing.
 	case tea.KeyBackspace:
 		return input.DeleteBackward()
@@ -82,6 +140,11 @@ func IsNavigationKey(msg tea.KeyMsg) bool {
 		return true
 	}
 
+	// Alt+B / Alt+F (word movement) are navigation.
+	if msg.Alt && msg.Type == tea.KeyRune && (msg.Rune == 'b' || msg.Rune == 'f') {
+		return true
+	}
+
 	switch msg.Type {
 	case tea.KeyLeft, tea.KeyRight, tea.KeyHome, tea.KeyEnd:
 		return true
@@ -92,8 +155,16 @@ func IsNavigationKey(msg tea.KeyMsg) bool {
 
 // IsEditingKey returns true if the key modifies content.
 func IsEditingKey(msg tea.KeyMsg) bool {
-	// Ctrl+U (clear) is editing.
-	if msg.Ctrl && (msg.Rune == 'u' || msg.Rune == 'U') {
+	// Ctrl+U (clear), Ctrl+W (delete word), Ctrl+Z/Ctrl+Y (undo/redo) are editing.
+	if msg.Ctrl {
+		switch msg.Rune {
+		case 'u', 'U', 'w', 'W', 'z', 'Z', 'y', 'Y':
+			return true
+		}
+	}
+
+	// Alt+D (delete next word) is editing.
+	if msg.Alt && msg.Type == tea.KeyRune && msg.Rune == 'd' {
 		return true
 	}
 
@@ -21,6 +21,10 @@ func TestDefaultKeyBindings_Navigation(t *testing.T) {
 		{"home", tea.KeyMsg{Type: tea.KeyHome}, 0},
 		{"end", tea.KeyMsg{Type: tea.KeyEnd}, 11},
 		{"ctrl-e (end)", tea.KeyMsg{Ctrl: true, Rune: 'e'}, 11},
+		{"ctrl+left (word left)", tea.KeyMsg{Type: tea.KeyLeft, Ctrl: true}, 0},
+		{"ctrl+right (word right)", tea.KeyMsg{Type: tea.KeyRight, Ctrl: true}, 11},
+		{"alt-b (word left)", tea.KeyMsg{Type: tea.KeyRune, Rune: 'b', Alt: true}, 0},
+		{"alt-f (word right)", tea.KeyMsg{Type: tea.KeyRune, Rune: 'f', Alt: true}, 11},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +72,30 @@ func TestDefaultKeyBindings_Editing(t *testing.T) {
 			wantContent: "",
 			wantCursor:  0,
 		},
+		{
+			name:        "ctrl-w delete word backward",
+			initial:     "hello world",
+			cursorPos:   11,
+			key:         tea.KeyMsg{Type: tea.KeyRune, Ctrl: true, Rune: 'w'},
+			wantContent: "hello ",
+			wantCursor:  6,
+		},
+		{
+			name:        "alt-d delete word forward",
+			initial:     "hello world",
+			cursorPos:   0,
+			key:         tea.KeyMsg{Type: tea.KeyRune, Alt: true, Rune: 'd'},
+			wantContent: " world",
+			wantCursor:  0,
+		},
+		{
+			name:        "alt-backspace delete word backward",
+			initial:     "hello world",
+			cursorPos:   5,
+			key:         tea.KeyMsg{Type: tea.KeyBackspace, Alt: true},
+			wantContent: " world",
+			wantCursor:  0,
+		},
 		{
 			name:        "insert rune",
 			initial:     "hello",
@@ -109,6 +137,38 @@ func TestDefaultKeyBindings_Editing(t *testing.T) {
 	}
 }
 
+func TestDefaultKeyBindings_UndoRedo(t *testing.T) {
+	kb := NewDefaultKeyBindings()
+	input := model.New(40).Insert("pasted text")
+
+	input = kb.Handle(input, tea.KeyMsg{Type: tea.KeyRune, Ctrl: true, Rune: 'z'})
+	if input.Content() != "" {
+		t.Errorf("ctrl-z: Content() = %q, want empty", input.Content())
+	}
+
+	input = kb.Handle(input, tea.KeyMsg{Type: tea.KeyRune, Ctrl: true, Rune: 'y'})
+	if input.Content() != "pasted text" {
+		t.Errorf("ctrl-y: Content() = %q, want %q", input.Content(), "pasted text")
+	}
+}
+
+func TestDefaultKeyBindings_NumericStep(t *testing.T) {
+	kb := NewDefaultKeyBindings()
+	input := model.New(10).WithNumeric(0, 2).WithHistory([]string{"ignored"})
+
+	for _, want := range []string{"0", "1", "2", "2"} {
+		input = kb.Handle(input, tea.KeyMsg{Type: tea.KeyUp})
+		if input.Content() != want {
+			t.Errorf("up: Content() = %q, want %q", input.Content(), want)
+		}
+	}
+
+	input = kb.Handle(input, tea.KeyMsg{Type: tea.KeyDown})
+	if input.Content() != "1" {
+		t.Errorf("down: Content() = %q, want 1", input.Content())
+	}
+}
+
 func TestDefaultKeyBindings_InsertMultipleRunes(t *testing.T) {
 	kb := NewDefaultKeyBindings()
 	input := model.New(40).SetContent("", 0)
@@ -301,3 +361,26 @@ func TestCustomKeyBindings_MultipleHandlers(t *testing.T) {
 		t.Error("second handler failed")
 	}
 }
+
+func TestDefaultKeyBindings_AcceptSuggestion(t *testing.T) {
+	kb := NewDefaultKeyBindings()
+	input := model.New(40).WithSuggestions([]string{"hello world"}).SetContent("hel", 3)
+
+	if got := kb.Handle(input, tea.KeyMsg{Type: tea.KeyTab}); got.Content() != "hello world" {
+		t.Errorf("Tab: content = %q, want hello world", got.Content())
+	}
+	if got := kb.Handle(input, tea.KeyMsg{Type: tea.KeyRight}); got.Content() != "hello world" {
+		t.Errorf("Right at end: content = %q, want hello world", got.Content())
+	}
+
+	// Right before the end just moves the cursor
+	moved := kb.Handle(input.WithCursor(1), tea.KeyMsg{Type: tea.KeyRight})
+	if moved.Content() != "hel" || moved.CursorPosition() != 2 {
+		t.Errorf("Right inside content = %q at %d, want hel at 2", moved.Content(), moved.CursorPosition())
+	}
+
+	// Without a suggestion Tab does nothing
+	if got := kb.Handle(model.New(40).SetContent("x", 1), tea.KeyMsg{Type: tea.KeyTab}); got.Content() != "x" {
+		t.Errorf("Tab without suggestion changed content to %q", got.Content())
+	}
+}
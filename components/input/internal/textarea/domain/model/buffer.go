@@ -265,6 +265,63 @@ func (b *Buffer) TextInRange(r value.Range) string {
 	return result.String()
 }
 
+// DeleteRange removes text in range (returns new buffer and deleted text).
+// The range end is exclusive; lines it spans are joined.
+func (b *Buffer) DeleteRange(r value.Range) (*Buffer, string) {
+	deleted := b.TextInRange(r)
+	updated := b.Copy()
+
+	startRow, startCol := r.StartRowCol()
+	endRow, endCol := r.EndRowCol()
+	if startRow >= len(updated.lines) || endRow >= len(updated.lines) {
+		return updated, ""
+	}
+
+	first := []rune(updated.lines[startRow])
+	last := []rune(updated.lines[endRow])
+	startCol = min(startCol, len(first))
+	endCol = min(endCol, len(last))
+
+	joined := string(first[:startCol]) + string(last[endCol:])
+
+	newLines := make([]string, 0, len(updated.lines)-(endRow-startRow))
+	newLines = append(newLines, updated.lines[:startRow]...)
+	newLines = append(newLines, joined)
+	newLines = append(newLines, updated.lines[endRow+1:]...)
+
+	updated.lines = newLines
+	return updated, deleted
+}
+
+// InsertText inserts (possibly multi-line) text at position.
+// Returns new buffer and the position just after the inserted text.
+func (b *Buffer) InsertText(row, col int, text string) (updated *Buffer, endRow, endCol int) {
+	updated = b.Copy()
+
+	if row >= len(updated.lines) {
+		return updated, row, col
+	}
+
+	line := []rune(updated.lines[row])
+	col = min(col, len(line))
+	before := string(line[:col])
+	after := string(line[col:])
+
+	parts := strings.Split(text, "\n")
+	parts[0] = before + parts[0]
+	endRow = row + len(parts) - 1
+	endCol = len([]rune(parts[len(parts)-1]))
+	parts[len(parts)-1] += after
+
+	newLines := make([]string, 0, len(updated.lines)+len(parts)-1)
+	newLines = append(newLines, updated.lines[:row]...)
+	newLines = append(newLines, parts...)
+	newLines = append(newLines, updated.lines[row+1:]...)
+
+	updated.lines = newLines
+	return updated, endRow, endCol
+}
+
 // Copy returns deep copy of buffer.
 func (b *Buffer) Copy() *Buffer {
 	linesCopy := make([]string, len(b.lines))
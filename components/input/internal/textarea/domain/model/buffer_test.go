@@ -747,3 +747,93 @@ func TestBuffer_TextInRange_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestBuffer_DeleteRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		start, end  value.Position
+		want        string
+		wantDeleted string
+	}{
+		{
+			name:        "within line",
+			text:        "hello world",
+			start:       value.NewPosition(0, 5),
+			end:         value.NewPosition(0, 11),
+			want:        "hello",
+			wantDeleted: " world",
+		},
+		{
+			name:        "across lines",
+			text:        "line1\nline2\nline3",
+			start:       value.NewPosition(0, 2),
+			end:         value.NewPosition(2, 3),
+			want:        "lie3",
+			wantDeleted: "ne1\nline2\nlin",
+		},
+		{
+			name:        "newline only",
+			text:        "ab\ncd",
+			start:       value.NewPosition(0, 2),
+			end:         value.NewPosition(1, 0),
+			want:        "abcd",
+			wantDeleted: "\n",
+		},
+		{
+			name:        "empty range",
+			text:        "abc",
+			start:       value.NewPosition(0, 1),
+			end:         value.NewPosition(0, 1),
+			want:        "abc",
+			wantDeleted: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := NewBufferFromString(tt.text)
+			got, deleted := buf.DeleteRange(value.NewRange(tt.start, tt.end))
+
+			if got.String() != tt.want {
+				t.Errorf("DeleteRange() = %q, want %q", got.String(), tt.want)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("DeleteRange() deleted %q, want %q", deleted, tt.wantDeleted)
+			}
+			if buf.String() != tt.text {
+				t.Error("DeleteRange() mutated the original buffer")
+			}
+		})
+	}
+}
+
+func TestBuffer_InsertText(t *testing.T) {
+	tests := []struct {
+		name             string
+		text             string
+		row, col         int
+		insert           string
+		want             string
+		wantRow, wantCol int
+	}{
+		{"single line", "held", 0, 3, "lo wor", "hello word", 0, 9},
+		{"multi-line", "ad", 0, 1, "b\nc", "ab\ncd", 1, 1},
+		{"trailing newline", "x", 0, 1, "y\n", "xy\n", 1, 0},
+		{"wide runes", "日語", 0, 1, "本", "日本語", 0, 2},
+		{"second line", "a\nb", 1, 0, "c\nd", "a\nc\ndb", 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, row, col := NewBufferFromString(tt.text).InsertText(tt.row, tt.col, tt.insert)
+
+			if got.String() != tt.want {
+				t.Errorf("InsertText() = %q, want %q", got.String(), tt.want)
+			}
+			if row != tt.wantRow || col != tt.wantCol {
+				t.Errorf("InsertText() end = (%d, %d), want (%d, %d)", row, col, tt.wantRow, tt.wantCol)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package model
 
 import (
 	"fmt"
+
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/value"
 )
 
 // TextArea is the rich domain model for multiline text editing.
@@ -28,6 +30,7 @@ type TextArea struct {
 	maxChars    int    // Maximum total characters (0 = unlimited)
 	placeholder string // Placeholder text
 	wrap        bool   // Word wrap (false = horizontal scroll)
+	wrapWidth   int    // Soft-wrap width in cells (0 = no soft wrap)
 	readOnly    bool   // Read-only mode
 
 	// Appearance.
@@ -95,6 +98,20 @@ func (t *TextArea) WithWrap(wrap bool) *TextArea {
 	return updated
 }
 
+// WithWrapWidth enables soft-wrapping of long lines at the given width in
+// terminal cells (0 disables it). Navigation then moves by visual row.
+func (t *TextArea) WithWrapWidth(width int) *TextArea {
+	if width < 0 {
+		width = 0
+	}
+	updated := t.copy()
+	updated.wrapWidth = width
+	updated.wrap = width > 0
+	updated.scrollCol = 0
+	updated.ensureCursorVisible()
+	return updated
+}
+
 // WithPlaceholder sets placeholder text.
 func (t *TextArea) WithPlaceholder(text string) *TextArea {
 	updated := t.copy()
@@ -140,6 +157,13 @@ func (t *TextArea) WithBuffer(buffer *Buffer) *TextArea {
 	return updated
 }
 
+// WithSelection sets the selection (nil clears it).
+func (t *TextArea) WithSelection(selection *Selection) *TextArea {
+	updated := t.copy()
+	updated.selection = selection.Copy()
+	return updated
+}
+
 // WithCursor sets cursor position (returns new instance).
 // This is used by domain services for cursor movement.
 func (t *TextArea) WithCursor(cursor *Cursor) *TextArea {
@@ -191,9 +215,9 @@ func (t *TextArea) ContentParts() (before, at, after string) {
 	return before, at, after
 }
 
-// HasSelection returns true if there is active selection.
+// HasSelection returns true if there is active (non-empty) selection.
 func (t *TextArea) HasSelection() bool {
-	return t.selection != nil
+	return t.selection != nil && !t.selection.Range().IsEmpty()
 }
 
 // SelectedText returns selected text (empty if no selection).
@@ -204,6 +228,15 @@ func (t *TextArea) SelectedText() string {
 	return t.buffer.TextInRange(t.selection.Range())
 }
 
+// SelectionRange returns the selected range, normalized so start <= end.
+// ok is false if there is no selection.
+func (t *TextArea) SelectionRange() (r value.Range, ok bool) {
+	if !t.HasSelection() {
+		return value.Range{}, false
+	}
+	return t.selection.Range(), true
+}
+
 // IsEmpty returns true if buffer has no content.
 func (t *TextArea) IsEmpty() bool {
 	return t.buffer.IsEmpty()
@@ -235,6 +268,17 @@ func (t *TextArea) Height() int {
 	return t.height
 }
 
+// WrapWidth returns the soft-wrap width in cells (0 = no soft wrap).
+func (t *TextArea) WrapWidth() int {
+	return t.wrapWidth
+}
+
+// VisualRows returns the visual rows of the given logical row when
+// soft-wrapping; a single row spanning the whole line otherwise.
+func (t *TextArea) VisualRows(row int) []WrapSpan {
+	return WrapLine(t.buffer.Line(row), t.wrapWidth)
+}
+
 // ScrollRow returns the first visible row (scroll offset).
 func (t *TextArea) ScrollRow() int {
 	return t.scrollRow
@@ -322,6 +366,11 @@ func (t *TextArea) GetCursor() *Cursor {
 	return t.cursor
 }
 
+// GetSelection returns selection (for services, nil if none).
+func (t *TextArea) GetSelection() *Selection {
+	return t.selection
+}
+
 // GetKillRing returns kill ring (for services).
 func (t *TextArea) GetKillRing() *KillRing {
 	return t.killRing
@@ -397,7 +446,12 @@ func (t *TextArea) ensureCursorVisible() {
 	if row < t.scrollRow {
 		t.scrollRow = row
 	}
-	if row >= t.scrollRow+t.height {
+	if t.wrapWidth > 0 {
+		// Count visual rows: the cursor's visual row must be within height.
+		for t.scrollRow < row && t.visualRowsBetween(t.scrollRow, row) > t.height {
+			t.scrollRow++
+		}
+	} else if row >= t.scrollRow+t.height {
 		t.scrollRow = row - t.height + 1
 	}
 
@@ -413,6 +467,16 @@ func (t *TextArea) ensureCursorVisible() {
 	}
 }
 
+// visualRowsBetween returns the number of visual rows from the start of
+// logical row from up to and including the cursor's visual row in row to.
+func (t *TextArea) visualRowsBetween(from, to int) int {
+	count := 0
+	for r := from; r < to; r++ {
+		count += len(t.VisualRows(r))
+	}
+	return count + WrapSpanIndex(t.VisualRows(to), t.cursor.Col()) + 1
+}
+
 // Private helper: deep copy all fields.
 func (t *TextArea) copy() *TextArea {
 	return &TextArea{
@@ -428,6 +492,7 @@ func (t *TextArea) copy() *TextArea {
 		maxChars:           t.maxChars,
 		placeholder:        t.placeholder,
 		wrap:               t.wrap,
+		wrapWidth:          t.wrapWidth,
 		readOnly:           t.readOnly,
 		showLineNumbers:    t.showLineNumbers,
 		lineNumberWidth:    t.lineNumberWidth,
@@ -946,3 +946,35 @@ func TestTextArea_VisibleLines_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestTextArea_WithSelection(t *testing.T) {
+	ta := NewTextArea().WithBuffer(NewBufferFromString("hello\nworld"))
+
+	selected := ta.WithSelection(NewSelection(value.NewPosition(1, 2), value.NewPosition(0, 3)))
+	if !selected.HasSelection() {
+		t.Fatal("HasSelection() = false after WithSelection")
+	}
+	if ta.HasSelection() {
+		t.Error("WithSelection() mutated the original")
+	}
+
+	r, ok := selected.SelectionRange()
+	if !ok || r.Start() != value.NewPosition(0, 3) || r.End() != value.NewPosition(1, 2) {
+		t.Errorf("SelectionRange() = %v, %v, want normalized (0,3)-(1,2)", r, ok)
+	}
+	if got := selected.SelectedText(); got != "lo\nwo" {
+		t.Errorf("SelectedText() = %q, want %q", got, "lo\nwo")
+	}
+
+	if selected.WithSelection(nil).HasSelection() {
+		t.Error("WithSelection(nil) should clear the selection")
+	}
+
+	empty := ta.WithSelection(NewSelection(value.NewPosition(0, 1), value.NewPosition(0, 1)))
+	if empty.HasSelection() {
+		t.Error("empty selection should not count as a selection")
+	}
+	if _, ok := empty.SelectionRange(); ok {
+		t.Error("SelectionRange() ok = true for empty selection")
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import "github.com/phoenix-tui/phoenix/core"
+
+// WrapSpan is the part of a logical line shown on one visual row when
+// soft-wrapping: runes [Start, End) of the line.
+type WrapSpan struct {
+	Start int // Rune offset of the first rune on the row
+	End   int // Rune offset after the last rune on the row
+}
+
+// Contains returns whether a cursor at col is shown on this row.
+// A cursor at End belongs to the next row, unless this is the last row.
+func (s WrapSpan) Contains(col int, last bool) bool {
+	return col >= s.Start && (col < s.End || (last && col == s.End))
+}
+
+// WrapLine splits a line into the visual rows it occupies when wrapped at
+// width terminal cells. Wide runes (e.g. CJK) count as two cells and never
+// straddle a row boundary; a rune wider than width gets a row of its own.
+// Lines that fit, and all lines when width <= 0, occupy a single row.
+func WrapLine(line string, width int) []WrapSpan {
+	runes := []rune(line)
+	if width <= 0 || len(runes) == 0 {
+		return []WrapSpan{{Start: 0, End: len(runes)}}
+	}
+
+	var spans []WrapSpan
+	start, cells := 0, 0
+	for i, r := range runes {
+		w := RuneWidth(r)
+		if cells+w > width && i > start {
+			spans = append(spans, WrapSpan{Start: start, End: i})
+			start, cells = i, 0
+		}
+		cells += w
+	}
+	return append(spans, WrapSpan{Start: start, End: len(runes)})
+}
+
+// WrapSpanIndex returns the index of the span showing a cursor at col.
+func WrapSpanIndex(spans []WrapSpan, col int) int {
+	for i, span := range spans {
+		if span.Contains(col, i == len(spans)-1) {
+			return i
+		}
+	}
+	return len(spans) - 1
+}
+
+// RuneWidth returns the number of terminal cells r occupies.
+func RuneWidth(r rune) int {
+	return core.StringWidth(string(r))
+}
+
+// CellOffset returns the width in cells of runes [from, to) of line.
+func CellOffset(line []rune, from, to int) int {
+	cells := 0
+	for i := from; i < to && i < len(line); i++ {
+		cells += RuneWidth(line[i])
+	}
+	return cells
+}
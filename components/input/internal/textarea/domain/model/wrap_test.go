@@ -0,0 +1,104 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrapLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		width int
+		want  []WrapSpan
+	}{
+		{"empty", "", 4, []WrapSpan{{0, 0}}},
+		{"fits", "abc", 4, []WrapSpan{{0, 3}}},
+		{"exact", "abcd", 4, []WrapSpan{{0, 4}}},
+		{"ascii", "abcdefghij", 4, []WrapSpan{{0, 4}, {4, 8}, {8, 10}}},
+		{"no wrap width", "abcdefghij", 0, []WrapSpan{{0, 10}}},
+		// Each CJK rune is two cells: two per row at width 4.
+		{"cjk", "日本語テキスト", 4, []WrapSpan{{0, 2}, {2, 4}, {4, 6}, {6, 7}}},
+		// A wide rune that would straddle the boundary moves to the next row.
+		{"cjk odd width", "日本語", 5, []WrapSpan{{0, 2}, {2, 3}}},
+		{"mixed", "ab日本cd", 4, []WrapSpan{{0, 3}, {3, 6}}},
+		// A rune wider than the row gets a row of its own.
+		{"narrower than rune", "a日b", 1, []WrapSpan{{0, 1}, {1, 2}, {2, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapLine(tt.line, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WrapLine(%q, %d) = %v, want %v", tt.line, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapSpanIndex(t *testing.T) {
+	spans := []WrapSpan{{0, 4}, {4, 8}, {8, 10}}
+
+	tests := []struct {
+		col  int
+		want int
+	}{
+		{0, 0},
+		{3, 0},
+		{4, 1}, // End of a row belongs to the next row
+		{8, 2},
+		{10, 2}, // End of line stays on the last row
+	}
+
+	for _, tt := range tests {
+		if got := WrapSpanIndex(spans, tt.col); got != tt.want {
+			t.Errorf("WrapSpanIndex(col %d) = %d, want %d", tt.col, got, tt.want)
+		}
+	}
+}
+
+func TestCellOffset(t *testing.T) {
+	line := []rune("ab日本c")
+
+	if got := CellOffset(line, 0, 4); got != 6 {
+		t.Errorf("CellOffset(0, 4) = %d, want 6", got)
+	}
+	if got := CellOffset(line, 2, 3); got != 2 {
+		t.Errorf("CellOffset(2, 3) = %d, want 2", got)
+	}
+}
+
+func TestTextArea_WithWrapWidth(t *testing.T) {
+	ta := NewTextArea().WithBuffer(NewBufferFromString("abcdefghij"))
+
+	wrapped := ta.WithWrapWidth(4)
+	if wrapped.WrapWidth() != 4 || !wrapped.wrap {
+		t.Errorf("WrapWidth() = %d, wrap = %v, want 4, true", wrapped.WrapWidth(), wrapped.wrap)
+	}
+	if len(wrapped.VisualRows(0)) != 3 {
+		t.Errorf("VisualRows(0) = %v, want 3 rows", wrapped.VisualRows(0))
+	}
+	if ta.WrapWidth() != 0 {
+		t.Error("WithWrapWidth() mutated original")
+	}
+	if got := wrapped.WithWrapWidth(-1).WrapWidth(); got != 0 {
+		t.Errorf("WithWrapWidth(-1) = %d, want 0", got)
+	}
+}
+
+func TestTextArea_WrapScrollsByVisualRow(t *testing.T) {
+	// Each line wraps into 3 rows; 2 lines do not fit into 4 rows.
+	ta := NewTextArea().
+		WithBuffer(NewBufferFromString("abcdefghij\nklmnopqrst")).
+		WithSize(4, 4).
+		WithWrapWidth(4)
+
+	ta = ta.WithCursor(NewCursor(1, 9))
+	if ta.ScrollRow() != 1 {
+		t.Errorf("ScrollRow() = %d, want 1 (cursor on 6th visual row)", ta.ScrollRow())
+	}
+
+	ta = ta.WithCursor(NewCursor(1, 0))
+	if ta.ScrollRow() != 1 {
+		t.Errorf("ScrollRow() = %d, want 1", ta.ScrollRow())
+	}
+}
@@ -101,11 +101,15 @@ func (s *NavigationService) MoveRight(ta *model.TextArea) *model.TextArea {
 	return result
 }
 
-// MoveUp moves cursor up one line.
+// MoveUp moves cursor up one line (one visual row when soft-wrapping).
 func (s *NavigationService) MoveUp(ta *model.TextArea) *model.TextArea {
 	row, col := ta.CursorPosition()
 	from := model.NewCursorPos(row, col)
 
+	if toRow, toCol, ok := s.visualTarget(ta, -1); ok {
+		return s.moveCursor(ta, row, col, toRow, toCol)
+	}
+
 	//nolint:nestif // domain boundary validation requires nested conditions
 	if row == 0 {
 		// Already at top - check if validator wants to handle this.
@@ -153,11 +157,15 @@ func (s *NavigationService) MoveUp(ta *model.TextArea) *model.TextArea {
 	return result
 }
 
-// MoveDown moves cursor down one line.
+// MoveDown moves cursor down one line (one visual row when soft-wrapping).
 func (s *NavigationService) MoveDown(ta *model.TextArea) *model.TextArea {
 	row, col := ta.CursorPosition()
 	from := model.NewCursorPos(row, col)
 
+	if toRow, toCol, ok := s.visualTarget(ta, 1); ok {
+		return s.moveCursor(ta, row, col, toRow, toCol)
+	}
+
 	//nolint:nestif // domain boundary validation requires nested conditions
 	if row >= ta.LineCount()-1 {
 		// Already at bottom - check if validator wants to handle this.
@@ -205,27 +213,29 @@ func (s *NavigationService) MoveDown(ta *model.TextArea) *model.TextArea {
 	return result
 }
 
-// MoveToLineStart moves cursor to start of current line (Ctrl+A / Home).
-// If validator blocks column 0, tries to find first allowed position (col 1, 2, ...).
+// MoveToLineStart moves cursor to start of current line (Ctrl+A / Home),
+// or of the current visual row when soft-wrapping.
+// If validator blocks the start, tries to find first allowed position after it.
 func (s *NavigationService) MoveToLineStart(ta *model.TextArea) *model.TextArea {
 	row, col := ta.CursorPosition()
 	from := model.NewCursorPos(row, col)
+	start, _ := s.visualRowBounds(ta)
 
 	validator := ta.GetMovementValidator()
 	if validator == nil {
-		// No validator - move to column 0.
-		return s.moveCursor(ta, row, col, row, 0)
+		// No validator - move to start.
+		return s.moveCursor(ta, row, col, row, start)
 	}
 
-	// Try column 0 first.
-	to := model.NewCursorPos(row, 0)
+	// Try the start first.
+	to := model.NewCursorPos(row, start)
 	if validator(from, to) {
-		return s.moveCursor(ta, row, col, row, 0)
+		return s.moveCursor(ta, row, col, row, start)
 	}
 
-	// Column 0 blocked - find first allowed position.
+	// Start blocked - find first allowed position.
 	lineLen := len([]rune(ta.CurrentLine()))
-	for tryCol := 1; tryCol <= lineLen; tryCol++ {
+	for tryCol := start + 1; tryCol <= lineLen; tryCol++ {
 		to = model.NewCursorPos(row, tryCol)
 		if validator(from, to) {
 			return s.moveCursor(ta, row, col, row, tryCol)
@@ -234,16 +244,90 @@ func (s *NavigationService) MoveToLineStart(ta *model.TextArea) *model.TextArea
 
 	// All positions blocked - fire boundary hit and stay put.
 	if handler := ta.GetBoundaryHitHandler(); handler != nil {
-		handler(model.NewCursorPos(row, 0), "no valid position found in line")
+		handler(model.NewCursorPos(row, start), "no valid position found in line")
 	}
 	return ta
 }
 
-// MoveToLineEnd moves cursor to end of current line (Ctrl+E / End).
+// MoveToLineEnd moves cursor to end of current line (Ctrl+E / End),
+// or to the last character of the current visual row when soft-wrapping.
 func (s *NavigationService) MoveToLineEnd(ta *model.TextArea) *model.TextArea {
 	row, col := ta.CursorPosition()
-	lineLen := len([]rune(ta.CurrentLine()))
-	return s.moveCursor(ta, row, col, row, lineLen)
+	_, end := s.visualRowBounds(ta)
+	return s.moveCursor(ta, row, col, row, end)
+}
+
+// visualRowBounds returns the first and last cursor column on the cursor's
+// visual row. Without soft-wrap that is the whole line. On a wrapped row
+// other than the last, the last column is the row's final character, as a
+// cursor after it would show at the start of the next row.
+func (s *NavigationService) visualRowBounds(ta *model.TextArea) (start, end int) {
+	row, col := ta.CursorPosition()
+	spans := ta.VisualRows(row)
+	i := model.WrapSpanIndex(spans, col)
+	span := spans[i]
+
+	if i < len(spans)-1 && span.End > span.Start {
+		return span.Start, span.End - 1
+	}
+	return span.Start, span.End
+}
+
+// visualTarget returns the position one visual row up (dir -1) or down
+// (dir 1) from the cursor when soft-wrapping, keeping the cursor's cell
+// offset within the row as closely as possible. ok is false without
+// soft-wrap or when there is no row in that direction.
+func (s *NavigationService) visualTarget(ta *model.TextArea, dir int) (row, col int, ok bool) {
+	if ta.WrapWidth() <= 0 {
+		return 0, 0, false
+	}
+
+	row, col = ta.CursorPosition()
+	line := []rune(ta.CurrentLine())
+	spans := ta.VisualRows(row)
+	i := model.WrapSpanIndex(spans, col)
+	x := model.CellOffset(line, spans[i].Start, col)
+
+	target := i + dir
+	switch {
+	case target < 0:
+		if row == 0 {
+			return 0, 0, false
+		}
+		row--
+		spans = ta.VisualRows(row)
+		target = len(spans) - 1
+	case target >= len(spans):
+		if row >= ta.LineCount()-1 {
+			return 0, 0, false
+		}
+		row++
+		spans = ta.VisualRows(row)
+		target = 0
+	}
+
+	line = []rune(ta.Lines()[row])
+	return row, colAtCell(line, spans[target], target == len(spans)-1, x), true
+}
+
+// colAtCell returns the column on a visual row whose character covers cell
+// offset x, or the row's last column if the row is shorter.
+func colAtCell(line []rune, span model.WrapSpan, last bool, x int) int {
+	col, cells := span.Start, 0
+	for col < span.End {
+		w := model.RuneWidth(line[col])
+		if cells+w > x {
+			break
+		}
+		cells += w
+		col++
+	}
+
+	// A cursor at the end of a row other than the last shows on the next row.
+	if col == span.End && !last && col > span.Start {
+		col--
+	}
+	return col
 }
 
 // MoveToBufferStart moves cursor to start of buffer (Alt+<).
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/model"
+)
+
+// wrapped creates a textarea soft-wrapped at width with the cursor at (row, col).
+func wrapped(text string, width, row, col int) *model.TextArea {
+	return model.NewTextArea().
+		WithBuffer(model.NewBufferFromString(text)).
+		WithWrapWidth(width).
+		WithCursor(model.NewCursor(row, col))
+}
+
+func TestNavigationService_WrapMoveUpDown(t *testing.T) {
+	svc := NewNavigationService()
+
+	tests := []struct {
+		name    string
+		text    string
+		width   int
+		fromRow int
+		fromCol int
+		down    bool
+		wantRow int
+		wantCol int
+	}{
+		{
+			name: "down within wrapped line", text: "abcdefghij", width: 4,
+			fromRow: 0, fromCol: 1, down: true, wantRow: 0, wantCol: 5,
+		},
+		{
+			name: "up within wrapped line", text: "abcdefghij", width: 4,
+			fromRow: 0, fromCol: 9, wantRow: 0, wantCol: 5,
+		},
+		{
+			name: "down onto short last row clamps to line end", text: "abcdefghij", width: 4,
+			fromRow: 0, fromCol: 7, down: true, wantRow: 0, wantCol: 10,
+		},
+		{
+			name: "down from last row to next line", text: "abcdefghij\nxyz", width: 4,
+			fromRow: 0, fromCol: 9, down: true, wantRow: 1, wantCol: 1,
+		},
+		{
+			name: "up to last visual row of previous line", text: "abcdefghij\nxyz", width: 4,
+			fromRow: 1, fromCol: 2, wantRow: 0, wantCol: 10,
+		},
+		{
+			// From the end of the line (cell 4) the cursor cannot go past the
+			// full row above, as it would show on the row below.
+			name: "up onto full row stays on the row", text: "abcdefgh\nxyzuvwxy", width: 4,
+			fromRow: 1, fromCol: 8, wantRow: 1, wantCol: 3,
+		},
+		{
+			// "日本語テキスト" at width 4: [日本][語テ][キス][ト]; cursor after
+			// "ab" (cell 2) on "abcd" lands on 本 (cells 2-3).
+			name: "down into cjk by cell offset", text: "abcd\n日本語テキスト", width: 4,
+			fromRow: 0, fromCol: 2, down: true, wantRow: 1, wantCol: 1,
+		},
+		{
+			// Cell 1 is the right half of 語: the cursor lands on 語.
+			name: "down from ascii to cjk mid-rune", text: "abcdefg\n日本語", width: 4,
+			fromRow: 0, fromCol: 5, down: true, wantRow: 1, wantCol: 0,
+		},
+		{
+			// On 語 (cell 0 of row [語テ]) up to row [日本]: lands on 日.
+			name: "up within cjk line", text: "日本語テキスト", width: 4,
+			fromRow: 0, fromCol: 2, wantRow: 0, wantCol: 0,
+		},
+		{
+			// テ is cells 2-3 of [語テ]; down lands on ス (cells 2-3 of [キス]).
+			name: "down within cjk line keeps cell offset", text: "日本語テキスト", width: 4,
+			fromRow: 0, fromCol: 3, down: true, wantRow: 0, wantCol: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := wrapped(tt.text, tt.width, tt.fromRow, tt.fromCol)
+
+			var result *model.TextArea
+			if tt.down {
+				result = svc.MoveDown(ta)
+			} else {
+				result = svc.MoveUp(ta)
+			}
+
+			row, col := result.CursorPosition()
+			if row != tt.wantRow || col != tt.wantCol {
+				t.Errorf("cursor = (%d, %d), want (%d, %d)", row, col, tt.wantRow, tt.wantCol)
+			}
+		})
+	}
+}
+
+func TestNavigationService_WrapMoveAtEdges(t *testing.T) {
+	svc := NewNavigationService()
+
+	var hits []string
+	ta := wrapped("abcdefghij", 4, 0, 2).
+		WithMovementValidator(func(_, _ model.CursorPos) bool { return false }).
+		WithBoundaryHitHandler(func(_ model.CursorPos, reason string) { hits = append(hits, reason) })
+
+	if row, col := svc.MoveUp(ta).CursorPosition(); row != 0 || col != 2 {
+		t.Errorf("MoveUp on first visual row moved to (%d, %d)", row, col)
+	}
+	bottom := ta.WithCursor(model.NewCursor(0, 9))
+	if row, col := svc.MoveDown(bottom).CursorPosition(); row != 0 || col != 9 {
+		t.Errorf("MoveDown on last visual row moved to (%d, %d)", row, col)
+	}
+	if len(hits) != 2 || hits[0] != "already at top" || hits[1] != "already at bottom" {
+		t.Errorf("boundary hits = %v, want top and bottom", hits)
+	}
+}
+
+func TestNavigationService_WrapHomeEnd(t *testing.T) {
+	svc := NewNavigationService()
+
+	tests := []struct {
+		name      string
+		text      string
+		col       int
+		wantStart int
+		wantEnd   int
+	}{
+		{"first row", "abcdefghij", 1, 0, 3},
+		{"middle row", "abcdefghij", 6, 4, 7},
+		{"row start", "abcdefghij", 4, 4, 7},
+		{"last row", "abcdefghij", 9, 8, 10},
+		{"cjk row", "日本語テキスト", 3, 2, 3},
+		{"cjk last row", "日本語テキスト", 6, 6, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := wrapped(tt.text, 4, 0, tt.col)
+
+			if _, col := svc.MoveToLineStart(ta).CursorPosition(); col != tt.wantStart {
+				t.Errorf("MoveToLineStart() col = %d, want %d", col, tt.wantStart)
+			}
+			if _, col := svc.MoveToLineEnd(ta).CursorPosition(); col != tt.wantEnd {
+				t.Errorf("MoveToLineEnd() col = %d, want %d", col, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestNavigationService_WrapValidatorSeesLogicalPositions(t *testing.T) {
+	svc := NewNavigationService()
+
+	// Prompt "> " must not be entered, even though the line wraps.
+	var seen []model.CursorPos
+	ta := wrapped("> abcdefghij", 4, 0, 3).
+		WithMovementValidator(func(_, to model.CursorPos) bool {
+			seen = append(seen, to)
+			return to.Row != 0 || to.Col >= 2
+		})
+
+	// Home on the first visual row skips the blocked prompt.
+	if _, col := svc.MoveToLineStart(ta).CursorPosition(); col != 2 {
+		t.Errorf("MoveToLineStart() col = %d, want 2", col)
+	}
+
+	// Down from (0, 3) moves one visual row: logical column 7.
+	seen = nil
+	if row, col := svc.MoveDown(ta).CursorPosition(); row != 0 || col != 7 {
+		t.Errorf("MoveDown() = (%d, %d), want (0, 7)", row, col)
+	}
+	if len(seen) != 1 || seen[0] != model.NewCursorPos(0, 7) {
+		t.Errorf("validator saw %v, want logical target (0, 7)", seen)
+	}
+
+	// Up from the second visual row into the prompt is blocked.
+	if _, col := svc.MoveUp(ta.WithCursor(model.NewCursor(0, 4))).CursorPosition(); col != 4 {
+		t.Errorf("MoveUp() into prompt col = %d, want 4 (blocked)", col)
+	}
+}
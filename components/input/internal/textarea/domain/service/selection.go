@@ -0,0 +1,108 @@
+package service
+
+import (
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/model"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/value"
+)
+
+// SelectionService handles text selection.
+// This is a domain service that operates on the TextArea aggregate.
+type SelectionService struct{}
+
+// NewSelectionService creates selection service.
+func NewSelectionService() *SelectionService {
+	return &SelectionService{}
+}
+
+// Extend moves the cursor with move and selects from where the selection
+// began (the cursor position if there is none) to the new position.
+// Moving back onto the anchor clears the selection.
+func (s *SelectionService) Extend(ta *model.TextArea, move func(*model.TextArea) *model.TextArea) *model.TextArea {
+	row, col := ta.CursorPosition()
+	anchor := value.NewPosition(row, col)
+	if sel := ta.GetSelection(); sel != nil {
+		anchor = sel.Anchor()
+	}
+
+	moved := move(ta)
+	row, col = moved.CursorPosition()
+	cursor := value.NewPosition(row, col)
+
+	if cursor.Equals(anchor) {
+		return moved.WithSelection(nil)
+	}
+	return moved.WithSelection(model.NewSelection(anchor, cursor))
+}
+
+// Select selects from anchor to cursor and moves the cursor there.
+// Positions are clamped to the buffer.
+func (s *SelectionService) Select(ta *model.TextArea, anchor, cursor value.Position) *model.TextArea {
+	// SetCursorPosition clamps both positions to the buffer.
+	anchorRow, anchorCol := ta.SetCursorPosition(anchor.Row(), anchor.Col()).CursorPosition()
+	row, col := ta.SetCursorPosition(cursor.Row(), cursor.Col()).CursorPosition()
+
+	return ta.WithCursor(model.NewCursor(row, col)).WithSelection(model.NewSelection(
+		value.NewPosition(anchorRow, anchorCol),
+		value.NewPosition(row, col),
+	))
+}
+
+// Clear removes the selection, leaving the cursor where it is.
+func (s *SelectionService) Clear(ta *model.TextArea) *model.TextArea {
+	if ta.GetSelection() == nil {
+		return ta
+	}
+	return ta.WithSelection(nil)
+}
+
+// DeleteSelection deletes the selected text and places the cursor where it
+// started. Does nothing without a selection or in read-only mode.
+func (s *SelectionService) DeleteSelection(ta *model.TextArea) *model.TextArea {
+	r, ok := ta.SelectionRange()
+	if !ok || ta.IsReadOnly() {
+		return ta
+	}
+
+	newBuffer, _ := ta.GetBuffer().DeleteRange(r)
+	row, col := r.StartRowCol()
+
+	return ta.WithBuffer(newBuffer).WithCursor(model.NewCursor(row, col))
+}
+
+// ReplaceSelection inserts text at the cursor, replacing the selection if
+// there is one. The cursor ends up after the inserted text.
+// Like typed newlines (see EditingService.InsertNewline), text never grows
+// the buffer past MaxLines: lines of text beyond the limit are dropped.
+func (s *SelectionService) ReplaceSelection(ta *model.TextArea, text string) *model.TextArea {
+	if ta.IsReadOnly() {
+		return ta
+	}
+
+	ta = s.DeleteSelection(ta)
+	if maxLines := ta.MaxLines(); maxLines > 0 {
+		text = limitLines(text, max(maxLines-ta.LineCount(), 0))
+	}
+	if text == "" {
+		return ta
+	}
+
+	row, col := ta.CursorPosition()
+	newBuffer, row, col := ta.GetBuffer().InsertText(row, col, text)
+
+	return ta.WithBuffer(newBuffer).WithCursor(model.NewCursor(row, col))
+}
+
+// limitLines cuts text before its (n+1)th newline, so that inserting it adds
+// at most n lines.
+func limitLines(text string, n int) string {
+	for i, r := range text {
+		if r != '\n' {
+			continue
+		}
+		if n == 0 {
+			return text[:i]
+		}
+		n--
+	}
+	return text
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/model"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/value"
+)
+
+func TestSelectionService_Extend(t *testing.T) {
+	sel := NewSelectionService()
+	nav := NewNavigationService()
+
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("hello\nworld")).
+		WithCursor(model.NewCursor(0, 2))
+
+	ta = sel.Extend(ta, nav.MoveRight)
+	ta = sel.Extend(ta, nav.MoveRight)
+	if got := ta.SelectedText(); got != "ll" {
+		t.Errorf("after two shift+right: SelectedText() = %q, want %q", got, "ll")
+	}
+
+	ta = sel.Extend(ta, nav.MoveDown)
+	if got := ta.SelectedText(); got != "llo\nworl" {
+		t.Errorf("after shift+down: SelectedText() = %q, want %q", got, "llo\nworl")
+	}
+
+	// Extending backwards past the anchor flips the selection.
+	ta = sel.Extend(ta, nav.MoveUp)
+	ta = sel.Extend(ta, nav.MoveToLineStart)
+	if got := ta.SelectedText(); got != "he" {
+		t.Errorf("after shift+home: SelectedText() = %q, want %q", got, "he")
+	}
+	if row, col := ta.CursorPosition(); row != 0 || col != 0 {
+		t.Errorf("CursorPosition() = (%d, %d), want (0, 0)", row, col)
+	}
+
+	// Returning to the anchor clears the selection.
+	ta = sel.Extend(ta, nav.MoveRight)
+	ta = sel.Extend(ta, nav.MoveRight)
+	if ta.HasSelection() {
+		t.Errorf("selection should be cleared at the anchor, got %q", ta.SelectedText())
+	}
+}
+
+func TestSelectionService_Select(t *testing.T) {
+	ta := model.NewTextArea().WithBuffer(model.NewBufferFromString("hello\nworld"))
+
+	ta = NewSelectionService().Select(ta, value.NewPosition(0, 3), value.NewPosition(5, 99))
+	if got := ta.SelectedText(); got != "lo\nworld" {
+		t.Errorf("SelectedText() = %q, want %q", got, "lo\nworld")
+	}
+	if row, col := ta.CursorPosition(); row != 1 || col != 5 {
+		t.Errorf("CursorPosition() = (%d, %d), want clamped (1, 5)", row, col)
+	}
+}
+
+func TestSelectionService_DeleteSelection(t *testing.T) {
+	svc := NewSelectionService()
+	ta := model.NewTextArea().WithBuffer(model.NewBufferFromString("line1\nline2\nline3"))
+	ta = svc.Select(ta, value.NewPosition(2, 2), value.NewPosition(0, 4))
+
+	got := svc.DeleteSelection(ta)
+	if got.Value() != "linene3" {
+		t.Errorf("Value() = %q, want %q", got.Value(), "linene3")
+	}
+	if row, col := got.CursorPosition(); row != 0 || col != 4 {
+		t.Errorf("CursorPosition() = (%d, %d), want (0, 4)", row, col)
+	}
+	if got.HasSelection() {
+		t.Error("selection should be cleared after delete")
+	}
+
+	if ro := svc.DeleteSelection(ta.WithReadOnly(true)); ro.Value() != ta.Value() {
+		t.Error("DeleteSelection() should not modify read-only textarea")
+	}
+}
+
+func TestSelectionService_ReplaceSelection(t *testing.T) {
+	svc := NewSelectionService()
+	ta := model.NewTextArea().WithBuffer(model.NewBufferFromString("hello world"))
+
+	t.Run("replaces selection", func(t *testing.T) {
+		got := svc.ReplaceSelection(svc.Select(ta, value.NewPosition(0, 6), value.NewPosition(0, 11)), "there\nfriend")
+		if got.Value() != "hello there\nfriend" {
+			t.Errorf("Value() = %q", got.Value())
+		}
+		if row, col := got.CursorPosition(); row != 1 || col != 6 {
+			t.Errorf("CursorPosition() = (%d, %d), want (1, 6)", row, col)
+		}
+	})
+
+	t.Run("inserts at cursor without selection", func(t *testing.T) {
+		got := svc.ReplaceSelection(ta.WithCursor(model.NewCursor(0, 5)), ",")
+		if got.Value() != "hello, world" {
+			t.Errorf("Value() = %q", got.Value())
+		}
+	})
+
+	t.Run("empty text deletes", func(t *testing.T) {
+		got := svc.ReplaceSelection(svc.Select(ta, value.NewPosition(0, 0), value.NewPosition(0, 6)), "")
+		if got.Value() != "world" {
+			t.Errorf("Value() = %q", got.Value())
+		}
+	})
+
+	t.Run("respects max lines", func(t *testing.T) {
+		limited := model.NewTextArea().WithMaxLines(3).
+			WithBuffer(model.NewBufferFromString("a\nb")).
+			WithCursor(model.NewCursor(1, 1))
+
+		got := svc.ReplaceSelection(limited, "1\n2\n3")
+		if got.Value() != "a\nb1\n2" {
+			t.Errorf("Value() = %q, want %q", got.Value(), "a\nb1\n2")
+		}
+		if row, col := got.CursorPosition(); row != 2 || col != 1 {
+			t.Errorf("CursorPosition() = (%d, %d), want (2, 1)", row, col)
+		}
+	})
+
+	t.Run("selection frees lines", func(t *testing.T) {
+		limited := model.NewTextArea().WithMaxLines(2).WithBuffer(model.NewBufferFromString("a\nb"))
+
+		got := svc.ReplaceSelection(svc.Select(limited, value.NewPosition(0, 1), value.NewPosition(1, 1)), "x\ny\nz")
+		if got.Value() != "ax\ny" {
+			t.Errorf("Value() = %q, want %q", got.Value(), "ax\ny")
+		}
+	})
+}
+
+func TestSelectionService_Clear(t *testing.T) {
+	svc := NewSelectionService()
+	ta := model.NewTextArea().WithBuffer(model.NewBufferFromString("hello"))
+	ta = svc.Select(ta, value.NewPosition(0, 0), value.NewPosition(0, 3))
+
+	got := svc.Clear(ta)
+	if got.HasSelection() {
+		t.Error("Clear() should remove the selection")
+	}
+	if row, col := got.CursorPosition(); row != 0 || col != 3 {
+		t.Errorf("Clear() moved the cursor to (%d, %d)", row, col)
+	}
+}
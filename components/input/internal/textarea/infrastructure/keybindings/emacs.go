@@ -12,6 +12,7 @@ import (
 type EmacsKeybindings struct {
 	navigation *service.NavigationService
 	editing    *service.EditingService
+	selection  *service.SelectionService
 }
 
 // NewEmacsKeybindings creates Emacs keybindings handler.
@@ -19,6 +20,7 @@ func NewEmacsKeybindings() *EmacsKeybindings {
 	return &EmacsKeybindings{
 		navigation: service.NewNavigationService(),
 		editing:    service.NewEditingService(),
+		selection:  service.NewSelectionService(),
 	}
 }
 
@@ -26,6 +28,25 @@ func NewEmacsKeybindings() *EmacsKeybindings {
 //
 //nolint:gocognit,gocyclo,cyclop,funlen // keybindings require state machine logic
 func (e *EmacsKeybindings) Handle(msg tea.KeyMsg, ta *model.TextArea) (*model.TextArea, tea.Cmd) {
+	// Shift+movement extends the selection.
+	if msg.Shift && !msg.Ctrl && !msg.Alt {
+		if move := e.selectionMove(msg.Type); move != nil {
+			return e.selection.Extend(ta, move), nil
+		}
+	}
+
+	// Deleting or typing over a selection replaces it; any other key drops it.
+	if ta.HasSelection() {
+		switch {
+		case isDeleteKey(msg):
+			return e.selection.DeleteSelection(ta), nil
+		case isInsertKey(msg):
+			ta = e.selection.DeleteSelection(ta)
+		default:
+			ta = e.selection.Clear(ta)
+		}
+	}
+
 	// Handle Ctrl key combinations.
 	if msg.Ctrl {
 		//nolint:gocritic // switch with single case is intentional for Emacs bindings structure
@@ -148,3 +169,46 @@ func (e *EmacsKeybindings) Handle(msg tea.KeyMsg, ta *model.TextArea) (*model.Te
 	// Unhandled key.
 	return ta, nil
 }
+
+// selectionMove returns the movement that Shift+key extends the selection
+// with, or nil if key does not select.
+func (e *EmacsKeybindings) selectionMove(key tea.KeyType) func(*model.TextArea) *model.TextArea {
+	switch key {
+	case tea.KeyUp:
+		return e.navigation.MoveUp
+	case tea.KeyDown:
+		return e.navigation.MoveDown
+	case tea.KeyLeft:
+		return e.navigation.MoveLeft
+	case tea.KeyRight:
+		return e.navigation.MoveRight
+	case tea.KeyHome:
+		return e.navigation.MoveToLineStart
+	case tea.KeyEnd:
+		return e.navigation.MoveToLineEnd
+	default:
+		return nil
+	}
+}
+
+// isDeleteKey reports whether key deletes a character (Backspace, Delete, Ctrl+H, Ctrl+D).
+func isDeleteKey(msg tea.KeyMsg) bool {
+	if msg.Alt {
+		return false
+	}
+	if msg.Ctrl {
+		return msg.Type == tea.KeyRune && (msg.Rune == 'h' || msg.Rune == 'H' || msg.Rune == 'd' || msg.Rune == 'D')
+	}
+	return msg.Type == tea.KeyBackspace || msg.Type == tea.KeyDelete
+}
+
+// isInsertKey reports whether key inserts text (characters, Space, Enter, Ctrl+M).
+func isInsertKey(msg tea.KeyMsg) bool {
+	if msg.Alt {
+		return false
+	}
+	if msg.Ctrl {
+		return msg.Type == tea.KeyRune && (msg.Rune == 'm' || msg.Rune == 'M')
+	}
+	return msg.Type == tea.KeyRune || msg.Type == tea.KeySpace || msg.Type == tea.KeyEnter
+}
@@ -740,3 +740,68 @@ func insertText(t *testing.T, handler *EmacsKeybindings, ta **model.TextArea, te
 		*ta, _ = handler.Handle(msg, *ta)
 	}
 }
+
+// TestEmacsKeybindings_ShiftSelection verifies Shift+arrows select and plain arrows deselect.
+func TestEmacsKeybindings_ShiftSelection(t *testing.T) {
+	handler := NewEmacsKeybindings()
+	ta := model.NewTextArea().WithBuffer(model.NewBufferFromString("hello\nworld"))
+
+	ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyRight, Shift: true}, ta)
+	ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyRight, Shift: true}, ta)
+	ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyDown, Shift: true}, ta)
+	if got := ta.SelectedText(); got != "hello\nwo" {
+		t.Errorf("SelectedText() = %q, want %q", got, "hello\nwo")
+	}
+
+	ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyEnd, Shift: true}, ta)
+	if got := ta.SelectedText(); got != "hello\nworld" {
+		t.Errorf("after Shift+End: SelectedText() = %q, want %q", got, "hello\nworld")
+	}
+
+	ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyLeft}, ta)
+	if ta.HasSelection() {
+		t.Error("plain arrow should clear the selection")
+	}
+	if row, col := ta.CursorPosition(); row != 1 || col != 4 {
+		t.Errorf("CursorPosition() = (%d, %d), want (1, 4)", row, col)
+	}
+}
+
+// TestEmacsKeybindings_EditOverSelection verifies typing and deleting replace the selection.
+func TestEmacsKeybindings_EditOverSelection(t *testing.T) {
+	tests := []struct {
+		name string
+		key  tea.KeyMsg
+		want string
+	}{
+		{"backspace", tea.KeyMsg{Type: tea.KeyBackspace}, "hd"},
+		{"delete", tea.KeyMsg{Type: tea.KeyDelete}, "hd"},
+		{"ctrl+d", tea.KeyMsg{Type: tea.KeyRune, Rune: 'd', Ctrl: true}, "hd"},
+		{"rune", tea.KeyMsg{Type: tea.KeyRune, Rune: 'X'}, "hXd"},
+		{"space", tea.KeyMsg{Type: tea.KeySpace}, "h d"},
+		{"enter", tea.KeyMsg{Type: tea.KeyEnter}, "h\nd"},
+		{"movement keeps text", tea.KeyMsg{Type: tea.KeyRune, Rune: 'a', Ctrl: true}, "hello\nworld"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewEmacsKeybindings()
+			ta := model.NewTextArea().
+				WithBuffer(model.NewBufferFromString("hello\nworld")).
+				WithCursor(model.NewCursor(0, 1))
+
+			// Select "ello\nworl".
+			ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyDown, Shift: true}, ta)
+			ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyEnd, Shift: true}, ta)
+			ta, _ = handler.Handle(tea.KeyMsg{Type: tea.KeyLeft, Shift: true}, ta)
+
+			ta, _ = handler.Handle(tt.key, ta)
+			if ta.Value() != tt.want {
+				t.Errorf("Value() = %q, want %q", ta.Value(), tt.want)
+			}
+			if ta.HasSelection() {
+				t.Error("selection should be gone")
+			}
+		})
+	}
+}
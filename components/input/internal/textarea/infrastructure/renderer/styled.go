@@ -0,0 +1,119 @@
+package renderer
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+// Reverse video on/off draws the cursor; a gray background marks the
+// selection.
+const (
+	reverseOn    = "\x1b[7m"
+	reverseOff   = "\x1b[27m"
+	selectionOn  = "\x1b[48;5;240m"
+	selectionOff = "\x1b[49m"
+	resetStyle   = "\x1b[0m"
+)
+
+// runeRange is a half-open range [start, end) of rune offsets in a line.
+type runeRange struct {
+	start, end int
+}
+
+// contains reports whether rune offset i is in the range.
+func (r runeRange) contains(i int) bool {
+	return i >= r.start && i < r.end
+}
+
+// styledLine is a highlighted line split into its visible runes, each with
+// the escape sequences preceding it. This lets the cursor and soft-wrap
+// work on rune offsets of the raw text while keeping the highlighting.
+type styledLine struct {
+	prefixes []string // prefixes[i]: escape sequences before rune i
+	chars    []string // chars[i]: visible rune i
+	trailing string   // Escape sequences after the last rune
+	styled   bool     // Whether the line contains any escape sequences
+}
+
+// parseStyled splits a string with ANSI escape sequences into visible runes.
+func parseStyled(s string) styledLine {
+	var line styledLine
+	var prefix strings.Builder
+
+	for i := 0; i < len(s); {
+		if n := style.EscapeLen(s[i:]); n > 0 {
+			prefix.WriteString(s[i : i+n])
+			line.styled = true
+			i += n
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		line.prefixes = append(line.prefixes, prefix.String())
+		line.chars = append(line.chars, s[i:i+size])
+		prefix.Reset()
+		i += size
+	}
+
+	line.trailing = prefix.String()
+	return line
+}
+
+// render renders visible runes [start, end) with the styles in effect, the
+// cursor at rune offset cursor (-1 for none) and the runes in sel selected.
+// A cursor at end is drawn as a reverse video space. Rows that do not reach
+// the end of the line reset the style, so it does not carry over into the
+// next row.
+func (l styledLine) render(start, end, cursor int, sel runeRange) string {
+	start = max(0, min(start, len(l.chars)))
+	end = max(start, min(end, len(l.chars)))
+
+	var b strings.Builder
+
+	// Replay styles set before the row starts.
+	for i := 0; i < start; i++ {
+		b.WriteString(l.prefixes[i])
+	}
+
+	selecting := false
+	for i := start; i < end; i++ {
+		b.WriteString(l.prefixes[i])
+
+		// The cursor takes precedence over the selection. Escape sequences
+		// from the highlighter may reset the background, so restore it.
+		want := sel.contains(i) && i != cursor
+		switch {
+		case want && (!selecting || l.prefixes[i] != ""):
+			b.WriteString(selectionOn)
+		case !want && selecting:
+			b.WriteString(selectionOff)
+		}
+		selecting = want
+
+		if i == cursor {
+			b.WriteString(reverseOn)
+			b.WriteString(l.chars[i])
+			b.WriteString(reverseOff)
+		} else {
+			b.WriteString(l.chars[i])
+		}
+	}
+
+	if selecting {
+		b.WriteString(selectionOff)
+	}
+
+	if end == len(l.chars) {
+		b.WriteString(l.trailing)
+	} else if l.styled {
+		b.WriteString(resetStyle)
+	}
+
+	if cursor == end && end == len(l.chars) {
+		b.WriteString(reverseOn + " " + reverseOff)
+	}
+
+	return b.String()
+}
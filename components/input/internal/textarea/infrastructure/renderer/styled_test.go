@@ -0,0 +1,95 @@
+package renderer
+
+import "testing"
+
+func TestStyledLine_Render(t *testing.T) {
+	// "if x" with "if" in red.
+	line := parseStyled("\x1b[31mif\x1b[0m x")
+
+	tests := []struct {
+		name       string
+		start, end int
+		cursor     int
+		want       string
+	}{
+		{"whole line", 0, 4, -1, "\x1b[31mif\x1b[0m x"},
+		{"cursor in styled word", 0, 4, 1, "\x1b[31mi" + reverseOn + "f" + reverseOff + "\x1b[0m x"},
+		{"cursor after style reset", 0, 4, 3, "\x1b[31mif\x1b[0m " + reverseOn + "x" + reverseOff},
+		{"cursor at end", 0, 4, 4, "\x1b[31mif\x1b[0m x" + reverseOn + " " + reverseOff},
+		{"first row resets style", 0, 1, -1, "\x1b[31mi" + resetStyle},
+		{"later row replays style", 1, 4, -1, "\x1b[31mf\x1b[0m x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := line.render(tt.start, tt.end, tt.cursor, runeRange{}); got != tt.want {
+				t.Errorf("render(%d, %d, %d) = %q, want %q", tt.start, tt.end, tt.cursor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyledLine_WideRunes(t *testing.T) {
+	line := parseStyled("\x1b[1m日本\x1b[0m語")
+
+	if len(line.chars) != 3 {
+		t.Fatalf("parsed %d runes, want 3", len(line.chars))
+	}
+	if got := line.render(2, 3, 2, runeRange{}); got != "\x1b[1m\x1b[0m"+reverseOn+"語"+reverseOff {
+		t.Errorf("render(2, 3, 2) = %q", got)
+	}
+}
+
+func TestStyledLine_Unstyled(t *testing.T) {
+	// Without escape sequences, rows are not followed by a reset.
+	if got := parseStyled("abcdef").render(0, 3, -1, runeRange{}); got != "abc" {
+		t.Errorf("render(0, 3) = %q, want %q", got, "abc")
+	}
+}
+
+func TestStyledLine_RenderSelection(t *testing.T) {
+	plain := parseStyled("abcdef")
+
+	tests := []struct {
+		name       string
+		line       styledLine
+		start, end int
+		cursor     int
+		sel        runeRange
+		want       string
+	}{
+		{
+			name: "selected run",
+			line: plain, start: 0, end: 6, cursor: -1, sel: runeRange{1, 4},
+			want: "a" + selectionOn + "bcd" + selectionOff + "ef",
+		},
+		{
+			name: "cursor at selection start",
+			line: plain, start: 0, end: 6, cursor: 1, sel: runeRange{1, 4},
+			want: "a" + reverseOn + "b" + reverseOff + selectionOn + "cd" + selectionOff + "ef",
+		},
+		{
+			name: "cursor after selection",
+			line: plain, start: 0, end: 6, cursor: 4, sel: runeRange{1, 4},
+			want: "a" + selectionOn + "bcd" + selectionOff + reverseOn + "e" + reverseOff + "f",
+		},
+		{
+			name: "selection clipped to row",
+			line: plain, start: 3, end: 6, cursor: -1, sel: runeRange{1, 4},
+			want: selectionOn + "d" + selectionOff + "ef",
+		},
+		{
+			name: "highlighter reset restores selection",
+			line: parseStyled("\x1b[31mab\x1b[0mcd"), start: 0, end: 4, cursor: -1, sel: runeRange{0, 4},
+			want: "\x1b[31m" + selectionOn + "ab\x1b[0m" + selectionOn + "cd" + selectionOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.line.render(tt.start, tt.end, tt.cursor, tt.sel); got != tt.want {
+				t.Errorf("render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
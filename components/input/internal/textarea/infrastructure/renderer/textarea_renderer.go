@@ -11,13 +11,23 @@ import (
 
 // TextAreaRenderer renders a textarea to a string.
 // This is an infrastructure component that handles presentation logic.
-type TextAreaRenderer struct{}
+type TextAreaRenderer struct {
+	highlighter func(line string) string // Styles each line for display (nil = plain)
+}
 
 // NewTextAreaRenderer creates a new textarea renderer.
 func NewTextAreaRenderer() *TextAreaRenderer {
 	return &TextAreaRenderer{}
 }
 
+// WithHighlighter returns a renderer that passes each line through fn
+// before display (nil disables highlighting). fn may only add ANSI escape
+// sequences: the cursor and soft-wrap are placed by rune offsets of the
+// raw line, which must match the visible runes of the result.
+func (r *TextAreaRenderer) WithHighlighter(fn func(line string) string) *TextAreaRenderer {
+	return &TextAreaRenderer{highlighter: fn}
+}
+
 // Render renders the textarea to a string.
 func (r *TextAreaRenderer) Render(ta *model.TextArea) string {
 	if ta.IsEmpty() {
@@ -28,6 +38,10 @@ func (r *TextAreaRenderer) Render(ta *model.TextArea) string {
 		return ""
 	}
 
+	if ta.WrapWidth() > 0 {
+		return r.renderWrapped(ta)
+	}
+
 	return r.renderContent(ta)
 }
 
@@ -56,9 +70,17 @@ func (r *TextAreaRenderer) renderContent(ta *model.TextArea) string {
 		}
 
 		// Render line content.
+		cursor := -1
 		if actualRow == cursorRow && ta.ShowCursor() {
+			cursor = cursorCol
+		}
+		sel := selectedRunes(ta, actualRow, len([]rune(line)))
+
+		if r.highlighter != nil || sel.start < sel.end {
+			b.WriteString(r.styleLine(line).render(0, len([]rune(line)), cursor, sel))
+		} else if cursor >= 0 {
 			// Render line with cursor (only if ShowCursor enabled)
-			b.WriteString(r.renderLineWithCursor(line, cursorCol))
+			b.WriteString(r.renderLineWithCursor(line, cursor))
 		} else {
 			// Render line without cursor.
 			b.WriteString(line)
@@ -73,6 +95,96 @@ func (r *TextAreaRenderer) renderContent(ta *model.TextArea) string {
 	return b.String()
 }
 
+// renderWrapped renders content soft-wrapped into visual rows, filling up
+// to the display height starting at the scroll offset. Line numbers are
+// shown on the first visual row of each line only.
+func (r *TextAreaRenderer) renderWrapped(ta *model.TextArea) string {
+	lines := ta.Lines()
+	cursorRow, cursorCol := ta.CursorPosition()
+
+	rows := make([]string, 0, ta.Height())
+	for row := ta.ScrollRow(); row < len(lines) && len(rows) < ta.Height(); row++ {
+		runes := []rune(lines[row])
+		spans := ta.VisualRows(row)
+
+		sel := selectedRunes(ta, row, len(runes))
+		useStyled := r.highlighter != nil || sel.start < sel.end
+
+		var styled styledLine
+		if useStyled {
+			styled = r.styleLine(lines[row])
+		}
+
+		cursorSpan := -1
+		if row == cursorRow && ta.ShowCursor() {
+			cursorSpan = model.WrapSpanIndex(spans, cursorCol)
+		}
+
+		for i, span := range spans {
+			if len(rows) == ta.Height() {
+				break
+			}
+
+			var b strings.Builder
+			if ta.ShowLineNumbers() {
+				if i == 0 {
+					b.WriteString(fmt.Sprintf("%4d ", row+1))
+				} else {
+					b.WriteString("     ")
+				}
+			}
+
+			text := string(runes[span.Start:span.End])
+			if useStyled {
+				cursor := -1
+				if i == cursorSpan {
+					cursor = cursorCol
+				}
+				b.WriteString(styled.render(span.Start, span.End, cursor, sel))
+			} else if i == cursorSpan {
+				b.WriteString(r.renderLineWithCursor(text, cursorCol-span.Start))
+			} else {
+				b.WriteString(text)
+			}
+			rows = append(rows, b.String())
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// styleLine splits a line into visible runes, highlighted if a
+// highlighter is set.
+func (r *TextAreaRenderer) styleLine(line string) styledLine {
+	if r.highlighter != nil {
+		return parseStyled(r.highlighter(line))
+	}
+	return parseStyled(line)
+}
+
+// selectedRunes returns the selected runes of row (an empty range if none).
+func selectedRunes(ta *model.TextArea, row, lineLen int) runeRange {
+	r, ok := ta.SelectionRange()
+	if !ok {
+		return runeRange{}
+	}
+
+	startRow, startCol := r.StartRowCol()
+	endRow, endCol := r.EndRowCol()
+	if row < startRow || row > endRow {
+		return runeRange{}
+	}
+
+	sel := runeRange{start: 0, end: lineLen}
+	if row == startRow {
+		sel.start = startCol
+	}
+	if row == endRow {
+		sel.end = endCol
+	}
+	return sel
+}
+
 // renderLineWithCursor renders a line with cursor visible using reverse video.
 func (r *TextAreaRenderer) renderLineWithCursor(line string, col int) string {
 	runes := []rune(line)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/model"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/value"
 )
 
 func TestTextAreaRenderer_Render_Empty(t *testing.T) {
@@ -360,3 +361,120 @@ func TestTextAreaRenderer_Render_WithScrollOffset(t *testing.T) {
 		t.Errorf("Third visible line should have cursor on 'n': %q", lines[2])
 	}
 }
+
+func TestTextAreaRenderer_Render_Wrapped(t *testing.T) {
+	r := NewTextAreaRenderer()
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("abcdefghij\n日本語テキスト")).
+		WithWrapWidth(4).
+		WithShowCursor(false)
+
+	got := r.Render(ta)
+	want := "abcd\nefgh\nij\n日本\n語テ\nキス\nト"
+	if got != want {
+		t.Errorf("Render() wrapped = %q, want %q", got, want)
+	}
+}
+
+func TestTextAreaRenderer_Render_WrappedCursorAndLineNumbers(t *testing.T) {
+	r := NewTextAreaRenderer()
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("abcdefghij")).
+		WithWrapWidth(4).
+		WithLineNumbers(true).
+		WithCursor(model.NewCursor(0, 5))
+
+	lines := strings.Split(r.Render(ta), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Render() = %d rows, want 3: %q", len(lines), lines)
+	}
+	if lines[0] != "   1 abcd" {
+		t.Errorf("row 0 = %q, want line number on first visual row", lines[0])
+	}
+	// Cursor at col 5 is on the second visual row, over "f".
+	if lines[1] != "     e\x1b[7mf\x1b[27mgh" {
+		t.Errorf("row 1 = %q, want continuation with cursor on 'f'", lines[1])
+	}
+}
+
+func TestTextAreaRenderer_Render_WrappedHeight(t *testing.T) {
+	r := NewTextAreaRenderer()
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("abcdefghij\nklm")).
+		WithSize(4, 2).
+		WithWrapWidth(4).
+		WithShowCursor(false)
+
+	if got := r.Render(ta); got != "abcd\nefgh" {
+		t.Errorf("Render() = %q, want first 2 visual rows", got)
+	}
+}
+
+// redIf highlights the keyword "if" in red.
+func redIf(line string) string {
+	return strings.ReplaceAll(line, "if", "\x1b[31mif\x1b[0m")
+}
+
+func TestTextAreaRenderer_Render_Highlighted(t *testing.T) {
+	r := NewTextAreaRenderer().WithHighlighter(redIf)
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("if x\nelse")).
+		WithCursor(model.NewCursor(0, 1))
+
+	want := "\x1b[31mi\x1b[7mf\x1b[27m\x1b[0m x\nelse"
+	if got := r.Render(ta); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTextAreaRenderer_Render_HighlightedWrapped(t *testing.T) {
+	r := NewTextAreaRenderer().WithHighlighter(redIf)
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("xxxif")).
+		WithWrapWidth(4).
+		WithShowCursor(false)
+
+	// "if" is split across rows: the style is reset at the end of the first
+	// row and replayed at the start of the second.
+	want := "xxx\x1b[31mi\x1b[0m\n\x1b[31mf\x1b[0m"
+	if got := r.Render(ta); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTextAreaRenderer_WithHighlighterNil(t *testing.T) {
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("if x")).
+		WithShowCursor(false)
+
+	if got := NewTextAreaRenderer().WithHighlighter(nil).Render(ta); got != "if x" {
+		t.Errorf("Render() = %q, want plain text", got)
+	}
+}
+
+func TestTextAreaRenderer_Render_Selection(t *testing.T) {
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("hello\nworld\n!")).
+		WithShowCursor(false).
+		WithSelection(model.NewSelection(value.NewPosition(0, 3), value.NewPosition(1, 2)))
+
+	want := "hel" + selectionOn + "lo" + selectionOff + "\n" +
+		selectionOn + "wo" + selectionOff + "rld\n!"
+	if got := NewTextAreaRenderer().Render(ta); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTextAreaRenderer_Render_SelectionWrapped(t *testing.T) {
+	ta := model.NewTextArea().
+		WithBuffer(model.NewBufferFromString("abcdef")).
+		WithWrapWidth(3).
+		WithShowCursor(false).
+		WithSelection(model.NewSelection(value.NewPosition(0, 2), value.NewPosition(0, 4)))
+
+	want := "ab" + selectionOn + "c" + selectionOff + "\n" +
+		selectionOn + "d" + selectionOff + "ef"
+	if got := NewTextAreaRenderer().Render(ta); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
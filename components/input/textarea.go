@@ -3,6 +3,8 @@ package input
 
 import (
 	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/model"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/service"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/value"
 	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/infrastructure/keybindings"
 	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/infrastructure/renderer"
 	"github.com/phoenix-tui/phoenix/tea"
@@ -43,6 +45,7 @@ type TextArea struct {
 	model       *model.TextArea
 	keybindings KeybindingMode
 	renderer    *renderer.TextAreaRenderer
+	clipboard   Clipboard
 }
 
 // KeybindingMode defines keybinding style.
@@ -63,6 +66,7 @@ func NewTextArea() TextArea {
 		model:       model.NewTextArea(),
 		keybindings: KeybindingsEmacs, // Default to Emacs
 		renderer:    renderer.NewTextAreaRenderer(),
+		clipboard:   systemClipboard{},
 	}
 }
 
@@ -110,6 +114,33 @@ func (t TextArea) Wrap(wrap bool) TextArea {
 	return t
 }
 
+// WrapWidth soft-wraps long lines at the given width in terminal cells
+// (0 disables soft-wrap). Wide characters such as CJK count as two cells.
+// While wrapping, Up/Down move by visual row and Home/End go to the start
+// and end of the visual row. The cursor position and the positions passed
+// to OnMovement stay logical (line and rune offset).
+func (t TextArea) WrapWidth(width int) TextArea {
+	t.model = t.model.WithWrapWidth(width)
+	return t
+}
+
+// WithHighlighter sets a function that styles each line for display, e.g.
+// to add syntax highlighting (nil disables it). The function receives the
+// raw line and returns it with ANSI escape sequences added; it must not
+// change the visible text. Editing, the cursor, and soft-wrap all work on
+// the raw text, so highlighting never affects cursor positions or widths.
+//
+// Example - Highlight shell keywords:
+//
+//	ta := input.NewTextArea().
+//	    WithHighlighter(func(line string) string {
+//	        return strings.ReplaceAll(line, "sudo", "\x1b[31msudo\x1b[0m")
+//	    })
+func (t TextArea) WithHighlighter(fn func(line string) string) TextArea {
+	t.renderer = t.renderer.WithHighlighter(fn)
+	return t
+}
+
 // ReadOnly enables/disables read-only mode.
 func (t TextArea) ReadOnly(readOnly bool) TextArea {
 	t.model = t.model.WithReadOnly(readOnly)
@@ -314,6 +345,41 @@ func (t TextArea) SelectedText() string {
 	return t.model.SelectedText()
 }
 
+// Selection returns the selected range, with start before end. The end is
+// exclusive. Without a selection, both are the cursor position.
+//
+// Text is selected with Shift+arrows (and Shift+Home/End); typing or
+// deleting replaces the selection, and any other key clears it.
+func (t TextArea) Selection() (start, end CursorPos) {
+	r, ok := t.model.SelectionRange()
+	if !ok {
+		row, col := t.model.CursorPosition()
+		return CursorPos{Row: row, Col: col}, CursorPos{Row: row, Col: col}
+	}
+	startRow, startCol := r.StartRowCol()
+	endRow, endCol := r.EndRowCol()
+	return CursorPos{Row: startRow, Col: startCol}, CursorPos{Row: endRow, Col: endCol}
+}
+
+// SetSelection selects from anchor to cursor and moves the cursor there.
+// Positions are clamped to the buffer; equal positions clear the selection.
+//
+// Example - Select the second line:
+//
+//	ta = ta.SetSelection(input.CursorPos{Row: 1}, input.CursorPos{Row: 1, Col: 5})
+func (t TextArea) SetSelection(anchor, cursor CursorPos) TextArea {
+	t.model = service.NewSelectionService().Select(t.model,
+		value.NewPosition(anchor.Row, anchor.Col),
+		value.NewPosition(cursor.Row, cursor.Col))
+	return t
+}
+
+// ClearSelection removes the selection, leaving the cursor where it is.
+func (t TextArea) ClearSelection() TextArea {
+	t.model = service.NewSelectionService().Clear(t.model)
+	return t
+}
+
 // Bubbletea Integration (Elm Architecture)
 
 // Init initializes the component.
@@ -325,6 +391,11 @@ func (t TextArea) Init() tea.Cmd {
 func (t TextArea) Update(msg tea.Msg) (TextArea, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Clipboard keys work in every keybinding mode.
+		if updated, cmd, ok := t.handleClipboardKey(msg); ok {
+			return updated, cmd
+		}
+
 		// Delegate to keybindings handler.
 		switch t.keybindings {
 		case KeybindingsEmacs, KeybindingsDefault:
@@ -341,6 +412,11 @@ func (t TextArea) Update(msg tea.Msg) (TextArea, tea.Cmd) {
 			return t, nil
 		}
 
+	case tea.PasteMsg:
+		// Bracketed paste replaces the selection, like Ctrl+V.
+		t.model = service.NewSelectionService().ReplaceSelection(t.model, msg.Text)
+		return t, nil
+
 	case tea.WindowSizeMsg:
 		// Handle window resize.
 		// For now, we don't auto-resize the textarea.
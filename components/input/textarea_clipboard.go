@@ -0,0 +1,130 @@
+package input
+
+import (
+	"github.com/phoenix-tui/phoenix/clipboard"
+	"github.com/phoenix-tui/phoenix/components/input/internal/textarea/domain/service"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// Clipboard is the clipboard a TextArea copies to and pastes from.
+// *clipboard.Clipboard from the phoenix/clipboard package satisfies it;
+// tests can inject an in-memory implementation.
+type Clipboard interface {
+	Read() (string, error)
+	Write(text string) error
+}
+
+// ClipboardErrorMsg reports a failed clipboard read or write.
+// A failed paste leaves the textarea unchanged. The selection of a cut is
+// deleted before the write completes, so Text holds the cut text for the
+// application to restore or keep; it is empty for copies and pastes.
+type ClipboardErrorMsg struct {
+	Err  error
+	Text string
+}
+
+// systemClipboard is the default Clipboard, backed by the global instance
+// of the phoenix/clipboard package (native clipboard or OSC 52).
+type systemClipboard struct{}
+
+func (systemClipboard) Read() (string, error) {
+	return clipboard.Read()
+}
+
+func (systemClipboard) Write(text string) error {
+	return clipboard.Write(text)
+}
+
+// Clipboard sets the clipboard used by Ctrl+C, Ctrl+X and Ctrl+V.
+// Defaults to the system clipboard; nil disables clipboard keys.
+//
+// Example - In-memory clipboard for tests:
+//
+//	type memClipboard struct{ text string }
+//
+//	func (m *memClipboard) Read() (string, error)  { return m.text, nil }
+//	func (m *memClipboard) Write(text string) error { m.text = text; return nil }
+//
+//	ta := input.NewTextArea().Clipboard(&memClipboard{})
+func (t TextArea) Clipboard(cb Clipboard) TextArea {
+	t.clipboard = cb
+	return t
+}
+
+// Copy returns a command that writes the selected text to the clipboard
+// (Ctrl+C). The command's message is nil, or ClipboardErrorMsg if the write
+// fails. Returns no command without a selection.
+func (t TextArea) Copy() (TextArea, tea.Cmd) {
+	if !t.model.HasSelection() || t.clipboard == nil {
+		return t, nil
+	}
+	return t, writeClipboard(t.clipboard, t.model.SelectedText(), "")
+}
+
+// Cut deletes the selected text and returns a command that writes it to
+// the clipboard (Ctrl+X). If the write fails, the command's
+// ClipboardErrorMsg carries the cut text. In read-only mode the text is
+// copied but not deleted.
+func (t TextArea) Cut() (TextArea, tea.Cmd) {
+	if !t.model.HasSelection() || t.clipboard == nil {
+		return t, nil
+	}
+	text := t.model.SelectedText()
+	if t.model.IsReadOnly() {
+		return t, writeClipboard(t.clipboard, text, "")
+	}
+	t.model = service.NewSelectionService().DeleteSelection(t.model)
+	return t, writeClipboard(t.clipboard, text, text)
+}
+
+// Paste returns a command that reads the clipboard (Ctrl+V). Its message is
+// a tea.PasteMsg, which Update inserts at the cursor like a bracketed
+// paste, replacing the selection; or ClipboardErrorMsg if the read fails.
+func (t TextArea) Paste() (TextArea, tea.Cmd) {
+	if t.clipboard == nil {
+		return t, nil
+	}
+	cb := t.clipboard
+	return t, func() tea.Msg {
+		text, err := cb.Read()
+		if err != nil {
+			return ClipboardErrorMsg{Err: err}
+		}
+		return tea.PasteMsg{Text: text}
+	}
+}
+
+// handleClipboardKey handles Ctrl+C, Ctrl+X and Ctrl+V.
+// ok is false for any other key.
+func (t TextArea) handleClipboardKey(msg tea.KeyMsg) (updated TextArea, cmd tea.Cmd, ok bool) {
+	if msg.Type == tea.KeyCtrlC {
+		updated, cmd = t.Copy()
+		return updated, cmd, true
+	}
+	if !msg.Ctrl || msg.Alt || msg.Type != tea.KeyRune {
+		return t, nil, false
+	}
+
+	switch msg.Rune {
+	case 'c', 'C':
+		updated, cmd = t.Copy()
+	case 'x', 'X':
+		updated, cmd = t.Cut()
+	case 'v', 'V':
+		updated, cmd = t.Paste()
+	default:
+		return t, nil, false
+	}
+	return updated, cmd, true
+}
+
+// writeClipboard returns a command that writes text to cb and reports a
+// failure as ClipboardErrorMsg with the given cut text.
+func writeClipboard(cb Clipboard, text, cut string) tea.Cmd {
+	return func() tea.Msg {
+		if err := cb.Write(text); err != nil {
+			return ClipboardErrorMsg{Err: err, Text: cut}
+		}
+		return nil
+	}
+}
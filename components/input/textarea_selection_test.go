@@ -0,0 +1,238 @@
+package input
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// memClipboard is an in-memory Clipboard for tests.
+type memClipboard struct {
+	text string
+	err  error
+}
+
+func (m *memClipboard) Read() (string, error) {
+	return m.text, m.err
+}
+
+func (m *memClipboard) Write(text string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.text = text
+	return nil
+}
+
+func ctrlKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRune, Rune: r, Ctrl: true}
+}
+
+// pressClipboardKey presses a clipboard key and feeds the message of the
+// returned command back to Update, as a program would. It returns that
+// message.
+func pressClipboardKey(ta TextArea, r rune) (TextArea, tea.Msg) {
+	ta, cmd := ta.Update(ctrlKey(r))
+	if cmd == nil {
+		return ta, nil
+	}
+	msg := cmd()
+	ta, _ = ta.Update(msg)
+	return ta, msg
+}
+
+func TestTextArea_ShiftSelection(t *testing.T) {
+	ta := NewTextArea().SetValue("hello\nworld").SetCursorPosition(0, 1)
+
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyDown, Shift: true})
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyLeft, Shift: true})
+
+	if got := ta.SelectedText(); got != "ello\n" {
+		t.Errorf("SelectedText() = %q, want %q", got, "ello\n")
+	}
+	start, end := ta.Selection()
+	if start != (CursorPos{Row: 0, Col: 1}) || end != (CursorPos{Row: 1, Col: 0}) {
+		t.Errorf("Selection() = %v, %v", start, end)
+	}
+
+	// Shift+Up past the anchor selects backwards; Selection() stays ordered.
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyUp, Shift: true})
+	start, end = ta.Selection()
+	if start != (CursorPos{Row: 0, Col: 0}) || end != (CursorPos{Row: 0, Col: 1}) {
+		t.Errorf("Selection() after Shift+Up = %v, %v", start, end)
+	}
+}
+
+func TestTextArea_Selection_None(t *testing.T) {
+	ta := NewTextArea().SetValue("hello").SetCursorPosition(0, 3)
+
+	start, end := ta.Selection()
+	if start != (CursorPos{Row: 0, Col: 3}) || end != start {
+		t.Errorf("Selection() = %v, %v, want both at cursor", start, end)
+	}
+	if ta.HasSelection() || ta.SelectedText() != "" {
+		t.Error("expected no selection")
+	}
+}
+
+func TestTextArea_SetSelection(t *testing.T) {
+	ta := NewTextArea().SetValue("hello\nworld").
+		SetSelection(CursorPos{Row: 1, Col: 3}, CursorPos{Row: 0, Col: 2})
+
+	if got := ta.SelectedText(); got != "llo\nwor" {
+		t.Errorf("SelectedText() = %q, want %q", got, "llo\nwor")
+	}
+	if row, col := ta.CursorPosition(); row != 0 || col != 2 {
+		t.Errorf("CursorPosition() = (%d, %d), want (0, 2)", row, col)
+	}
+
+	if ta.ClearSelection().HasSelection() {
+		t.Error("ClearSelection() should remove the selection")
+	}
+}
+
+func TestTextArea_CopyCutPaste(t *testing.T) {
+	cb := &memClipboard{}
+	ta := NewTextArea().
+		Clipboard(cb).
+		SetValue("hello world").
+		SetSelection(CursorPos{Row: 0, Col: 0}, CursorPos{Row: 0, Col: 5})
+
+	// Copy keeps the text and selection, and writes only when the command runs.
+	ta, cmd := ta.Update(ctrlKey('c'))
+	if cmd == nil || cb.text != "" {
+		t.Fatalf("Ctrl+C should return a write command, clipboard = %q", cb.text)
+	}
+	if msg := cmd(); msg != nil || cb.text != "hello" {
+		t.Fatalf("after Ctrl+C clipboard = %q, msg = %v", cb.text, msg)
+	}
+	if ta.Value() != "hello world" || !ta.HasSelection() {
+		t.Errorf("Ctrl+C changed the textarea: %q", ta.Value())
+	}
+
+	// Cut removes the selection.
+	ta = ta.SetSelection(CursorPos{Row: 0, Col: 5}, CursorPos{Row: 0, Col: 11})
+	ta, _ = pressClipboardKey(ta, 'x')
+	if cb.text != " world" || ta.Value() != "hello" {
+		t.Errorf("after Ctrl+X clipboard = %q, value = %q", cb.text, ta.Value())
+	}
+
+	// Paste replaces the selection.
+	ta = ta.SetSelection(CursorPos{Row: 0, Col: 0}, CursorPos{Row: 0, Col: 1})
+	ta, _ = pressClipboardKey(ta, 'v')
+	if ta.Value() != " worldello" {
+		t.Errorf("after Ctrl+V value = %q, want %q", ta.Value(), " worldello")
+	}
+	if row, col := ta.CursorPosition(); row != 0 || col != 6 {
+		t.Errorf("CursorPosition() = (%d, %d), want (0, 6)", row, col)
+	}
+}
+
+func TestTextArea_PasteMultiline(t *testing.T) {
+	ta := NewTextArea().
+		Clipboard(&memClipboard{text: "one\ntwo"}).
+		SetValue("[]").
+		SetCursorPosition(0, 1)
+
+	ta, _ = pressClipboardKey(ta, 'v')
+	if ta.Value() != "[one\ntwo]" {
+		t.Errorf("Value() = %q, want %q", ta.Value(), "[one\ntwo]")
+	}
+	if row, col := ta.CursorPosition(); row != 1 || col != 3 {
+		t.Errorf("CursorPosition() = (%d, %d), want (1, 3)", row, col)
+	}
+}
+
+func TestTextArea_ClipboardError(t *testing.T) {
+	errBroken := errors.New("no clipboard")
+	ta := NewTextArea().
+		Clipboard(&memClipboard{err: errBroken}).
+		SetValue("hello").
+		SetSelection(CursorPos{}, CursorPos{Col: 5})
+
+	tests := []struct {
+		key       rune
+		wantValue string
+		wantText  string
+	}{
+		{'c', "hello", ""},
+		{'x', "", "hello"}, // The cut text comes back with the error
+		{'v', "hello", ""},
+	}
+	for _, tt := range tests {
+		got, msg := pressClipboardKey(ta, tt.key)
+		if got.Value() != tt.wantValue {
+			t.Errorf("ctrl+%c with failing clipboard: value = %q, want %q", tt.key, got.Value(), tt.wantValue)
+		}
+		errMsg, ok := msg.(ClipboardErrorMsg)
+		if !ok || !errors.Is(errMsg.Err, errBroken) || errMsg.Text != tt.wantText {
+			t.Errorf("ctrl+%c: msg = %#v, want ClipboardErrorMsg with text %q", tt.key, msg, tt.wantText)
+		}
+	}
+}
+
+func TestTextArea_PasteRespectsMaxLines(t *testing.T) {
+	ta := NewTextArea().
+		MaxLines(2).
+		Clipboard(&memClipboard{text: "one\ntwo\nthree"}).
+		SetValue("x")
+
+	ta, _ = pressClipboardKey(ta.SetCursorPosition(0, 1), 'v')
+	if ta.Value() != "xone\ntwo" {
+		t.Errorf("Ctrl+V: Value() = %q, want %q", ta.Value(), "xone\ntwo")
+	}
+
+	ta, _ = ta.SetValue("x").Update(tea.PasteMsg{Text: "1\n2\n3"})
+	if ta.LineCount() != 2 {
+		t.Errorf("bracketed paste: LineCount() = %d, want 2", ta.LineCount())
+	}
+}
+
+func TestTextArea_CutReadOnly(t *testing.T) {
+	cb := &memClipboard{}
+	ta := NewTextArea().
+		Clipboard(cb).
+		SetValue("hello").
+		ReadOnly(true).
+		SetSelection(CursorPos{}, CursorPos{Col: 4})
+
+	ta, _ = pressClipboardKey(ta, 'x')
+	if cb.text != "hell" || ta.Value() != "hello" {
+		t.Errorf("read-only cut: clipboard = %q, value = %q", cb.text, ta.Value())
+	}
+}
+
+func TestTextArea_NilClipboard(t *testing.T) {
+	ta := NewTextArea().
+		Clipboard(nil).
+		SetValue("hello").
+		SetSelection(CursorPos{}, CursorPos{Col: 5})
+
+	ta, cmd := ta.Update(ctrlKey('x'))
+	if cmd != nil || ta.Value() != "hello" {
+		t.Errorf("Ctrl+X without clipboard: value = %q, cmd = %v", ta.Value(), cmd)
+	}
+}
+
+func TestTextArea_BracketedPasteReplacesSelection(t *testing.T) {
+	ta := NewTextArea().
+		SetValue("hello world").
+		SetSelection(CursorPos{Col: 6}, CursorPos{Col: 11})
+
+	ta, _ = ta.Update(tea.PasteMsg{Text: "there"})
+	if ta.Value() != "hello there" {
+		t.Errorf("Value() = %q, want %q", ta.Value(), "hello there")
+	}
+}
+
+func TestTextArea_SelectionView(t *testing.T) {
+	ta := NewTextArea().
+		SetValue("abc").
+		ShowCursor(false).
+		SetSelection(CursorPos{Col: 1}, CursorPos{Col: 2})
+
+	if got := ta.View(); got != "a\x1b[48;5;240mb\x1b[49mc" {
+		t.Errorf("View() = %q", got)
+	}
+}
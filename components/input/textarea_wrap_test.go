@@ -0,0 +1,110 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+func TestTextArea_WrapWidth_Navigation(t *testing.T) {
+	ta := NewTextArea().
+		SetValue("こんにちは世界、ようこそ\nok").
+		WrapWidth(10).
+		ShowCursor(false)
+
+	// 5 wide runes per 10-cell row: [こんにちは][世界、よう][こそ]
+	if got := ta.View(); got != "こんにちは\n世界、よう\nこそ\nok" {
+		t.Fatalf("View() = %q", got)
+	}
+
+	ta = ta.SetCursorPosition(0, 2) // に
+	steps := []struct {
+		key     tea.KeyType
+		wantRow int
+		wantCol int
+	}{
+		{tea.KeyDown, 0, 7},  // 、 (same cells on next visual row)
+		{tea.KeyDown, 0, 12}, // past こそ: clamps to end of line
+		{tea.KeyDown, 1, 2},  // "ok" is only 2 cells wide
+		{tea.KeyUp, 0, 11},   // back to the last visual row, cell 2 is そ
+		{tea.KeyHome, 0, 10}, // start of visual row
+		{tea.KeyUp, 0, 5},    // 世
+		{tea.KeyEnd, 0, 9},   // う, the last character of the visual row
+	}
+
+	for _, step := range steps {
+		ta, _ = ta.Update(tea.KeyMsg{Type: step.key})
+		if row, col := ta.CursorPosition(); row != step.wantRow || col != step.wantCol {
+			t.Fatalf("after %v: cursor = (%d, %d), want (%d, %d)", step.key, row, col, step.wantRow, step.wantCol)
+		}
+	}
+}
+
+func TestTextArea_WrapWidth_OnMovementLogical(t *testing.T) {
+	var targets []CursorPos
+	ta := NewTextArea().
+		SetValue("> 日本語の長いテキスト").
+		WrapWidth(6).
+		SetCursorPosition(0, 6).
+		OnMovement(func(_, to CursorPos) bool {
+			targets = append(targets, to)
+			return to.Col >= 2
+		})
+
+	// Rows: [> 日本][語の長][いテキ][スト]; col 6 (長) is on row 1.
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if _, col := ta.CursorPosition(); col != 3 {
+		t.Errorf("Up: col = %d, want 3 (本)", col)
+	}
+	if len(targets) != 1 || targets[0] != (CursorPos{Row: 0, Col: 3}) {
+		t.Errorf("validator targets = %v, want logical (0, 3)", targets)
+	}
+
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyHome})
+	if _, col := ta.CursorPosition(); col != 2 {
+		t.Errorf("Home: col = %d, want 2 (after prompt)", col)
+	}
+}
+
+func TestTextArea_WrapWidth_Typing(t *testing.T) {
+	ta := NewTextArea().WrapWidth(4).ShowCursor(false)
+
+	for _, r := range "abcdef" {
+		ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+
+	if got := ta.View(); got != "abcd\nef" {
+		t.Errorf("View() = %q, want wrapped text", got)
+	}
+	if row, col := ta.CursorPosition(); row != 0 || col != 6 {
+		t.Errorf("CursorPosition() = (%d, %d), want logical (0, 6)", row, col)
+	}
+}
+
+func TestTextArea_WithHighlighter(t *testing.T) {
+	var seen []string
+	ta := NewTextArea().
+		SetValue("let x").
+		WithHighlighter(func(line string) string {
+			seen = append(seen, line)
+			return "\x1b[34m" + line + "\x1b[0m"
+		})
+
+	// Editing works on the raw text.
+	ta = ta.MoveCursorToEnd()
+	ta, _ = ta.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: '1'})
+	if ta.Value() != "let x1" {
+		t.Errorf("Value() = %q, want raw text", ta.Value())
+	}
+	if row, col := ta.CursorPosition(); row != 0 || col != 6 {
+		t.Errorf("CursorPosition() = (%d, %d), want (0, 6)", row, col)
+	}
+
+	view := ta.View()
+	if view != "\x1b[34mlet x1\x1b[0m\x1b[7m \x1b[27m" {
+		t.Errorf("View() = %q", view)
+	}
+	if len(seen) == 0 || seen[len(seen)-1] != "let x1" {
+		t.Errorf("highlighter received %q, want raw line", seen)
+	}
+}
@@ -2,10 +2,16 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/service"
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/core"
 )
 
+// columnGap separates columns in grid layout.
+const columnGap = "  "
+
 // List is the aggregate root for the list component.
 type List struct {
 	items           []*value.Item       // All items
@@ -15,9 +21,13 @@ type List struct {
 	selectionMode   value.SelectionMode // Single or Multi
 	itemRenderer    func(item *value.Item, index int, selected, focused bool) string
 	filterFunc      func(item *value.Item, query string) bool
-	filterQuery     string // Current filter query
-	height          int    // Visible height (for scrolling)
-	scrollOffset    int    // Scroll offset
+	filterQuery     string                      // Current filter query
+	highlightFunc   func(match string) string   // Decorates the filter match in labels (nil = no highlight)
+	height          int                         // Visible height in rows (for scrolling)
+	scrollOffset    int                         // Index of the first visible item
+	columns         int                         // Items per row (1 = plain list)
+	paginated       bool                        // Show whole pages instead of scrolling
+	headerRenderer  func(section string) string // Renders section header lines
 
 	// Services.
 	navService    *service.NavigationService
@@ -37,6 +47,8 @@ func NewList(selectionMode value.SelectionMode) *List {
 		filterQuery:     "",
 		height:          10, // Default height
 		scrollOffset:    0,
+		columns:         1,
+		headerRenderer:  defaultHeaderRenderer,
 		navService:      service.NewNavigationService(),
 		filterService:   service.NewFilterService(),
 	}
@@ -60,6 +72,11 @@ func defaultItemRenderer(item *value.Item, _ int, selected, focused bool) string
 	return prefix + item.Label()
 }
 
+// defaultHeaderRenderer renders a section header as its plain label.
+func defaultHeaderRenderer(section string) string {
+	return section
+}
+
 // WithItems returns a new List with the given items.
 func (l *List) WithItems(items []*value.Item) *List {
 	newList := l.clone()
@@ -85,6 +102,51 @@ func (l *List) WithFilter(filterFunc func(*value.Item, string) bool) *List {
 	return newList
 }
 
+// WithMatchHighlighter returns a new List that passes the part of each label
+// matching the filter query through highlight before rendering (e.g. to make
+// it bold). Only labels containing the query as a substring are decorated.
+func (l *List) WithMatchHighlighter(highlight func(match string) string) *List {
+	newList := l.clone()
+	newList.highlightFunc = highlight
+	return newList
+}
+
+// WithSections returns a new List where item i belongs to sections[i].
+// Consecutive items with the same label form a group that is rendered under
+// a header line; "" means no section. Extra labels are ignored and items
+// beyond the end of sections keep their current section.
+func (l *List) WithSections(sections []string) *List {
+	newList := l.clone()
+	newList.items = make([]*value.Item, len(l.items))
+	for i, item := range l.items {
+		if i < len(sections) {
+			item = item.WithSection(sections[i])
+		}
+		newList.items[i] = item
+	}
+	newList.applyFilter()
+	newList.resetFocus()
+	return newList
+}
+
+// WithSectionHeaderRenderer returns a new List that renders section header
+// lines with renderer.
+func (l *List) WithSectionHeaderRenderer(renderer func(section string) string) *List {
+	newList := l.clone()
+	newList.headerRenderer = renderer
+	return newList
+}
+
+// CurrentSection returns the section of the focused item ("" if the item
+// has no section or the list is empty).
+func (l *List) CurrentSection() string {
+	item := l.FocusedItem()
+	if item == nil {
+		return ""
+	}
+	return item.Section()
+}
+
 // WithHeight returns a new List with the specified visible height.
 func (l *List) WithHeight(height int) *List {
 	newList := l.clone()
@@ -93,46 +155,167 @@ func (l *List) WithHeight(height int) *List {
 	return newList
 }
 
-// MoveUp moves the focus up by one item.
+// WithColumns returns a new List that lays items out in n columns, filled
+// row by row. Values below 1 mean a single column (plain list).
+func (l *List) WithColumns(n int) *List {
+	if n < 1 {
+		n = 1
+	}
+	newList := l.clone()
+	newList.columns = n
+	newList.updateScrollOffset()
+	return newList
+}
+
+// Columns returns the number of columns.
+func (l *List) Columns() int {
+	return l.columns
+}
+
+// WithPaginated returns a new List that shows one page of height rows at a
+// time instead of scrolling. Page navigation jumps a whole page and keeps
+// the focus at the same position on the page; moving past the first or
+// last item of a page turns the page.
+func (l *List) WithPaginated(paginated bool) *List {
+	newList := l.clone()
+	newList.paginated = paginated
+	newList.updateScrollOffset()
+	return newList
+}
+
+// IsPaginated returns true if the list is in paginated mode.
+func (l *List) IsPaginated() bool {
+	return l.paginated
+}
+
+// PageSize returns the number of items per page (height rows of columns).
+func (l *List) PageSize() int {
+	return max(l.height, 1) * l.columns
+}
+
+// Page returns the zero-based page of the focused item.
+func (l *List) Page() int {
+	return l.focusedIndex / l.PageSize()
+}
+
+// PageCount returns the number of pages of filtered items (0 if empty).
+func (l *List) PageCount() int {
+	size := l.PageSize()
+	return (len(l.filteredItems) + size - 1) / size
+}
+
+// NextPage moves the focus to the same position on the next page, or to
+// the last item if the next page is shorter. No-op on the last page.
+func (l *List) NextPage() *List {
+	if l.Page() >= l.PageCount()-1 {
+		return l
+	}
+	newList := l.clone()
+	newList.focusedIndex = min(newList.focusedIndex+newList.PageSize(), len(newList.filteredItems)-1)
+	newList.updateScrollOffset()
+	return newList
+}
+
+// PrevPage moves the focus to the same position on the previous page.
+// No-op on the first page.
+func (l *List) PrevPage() *List {
+	if l.Page() == 0 {
+		return l
+	}
+	newList := l.clone()
+	newList.focusedIndex -= newList.PageSize()
+	newList.updateScrollOffset()
+	return newList
+}
+
+// MoveUp moves the focus up by one item (one row in grid layout).
 func (l *List) MoveUp() *List {
 	if len(l.filteredItems) == 0 {
 		return l
 	}
 	newList := l.clone()
-	newList.focusedIndex = newList.navService.MoveUp(newList.focusedIndex, len(newList.filteredItems))
+	if newList.columns > 1 {
+		newList.focusedIndex = newList.navService.MoveUpInGrid(newList.focusedIndex, newList.columns)
+	} else {
+		newList.focusedIndex = newList.navService.MoveUp(newList.focusedIndex, len(newList.filteredItems))
+	}
 	newList.updateScrollOffset()
 	return newList
 }
 
-// MoveDown moves the focus down by one item.
+// MoveDown moves the focus down by one item (one row in grid layout).
 func (l *List) MoveDown() *List {
 	if len(l.filteredItems) == 0 {
 		return l
 	}
 	newList := l.clone()
-	newList.focusedIndex = newList.navService.MoveDown(newList.focusedIndex, len(newList.filteredItems))
+	if newList.columns > 1 {
+		newList.focusedIndex = newList.navService.MoveDownInGrid(newList.focusedIndex, newList.columns, len(newList.filteredItems))
+	} else {
+		newList.focusedIndex = newList.navService.MoveDown(newList.focusedIndex, len(newList.filteredItems))
+	}
 	newList.updateScrollOffset()
 	return newList
 }
 
+// MoveLeft moves the focus to the previous column of the same row.
+// No-op in a single-column list. In paginated mode, moving left from the
+// first column turns to the previous page.
+func (l *List) MoveLeft() *List {
+	if l.paginated && (l.columns <= 1 || l.focusedIndex%l.columns == 0) {
+		return l.PrevPage()
+	}
+	if len(l.filteredItems) == 0 || l.columns <= 1 || l.focusedIndex%l.columns == 0 {
+		return l
+	}
+	newList := l.clone()
+	newList.focusedIndex--
+	return newList
+}
+
+// MoveRight moves the focus to the next column of the same row.
+// No-op in a single-column list. In paginated mode, moving right from the
+// last column turns to the next page.
+func (l *List) MoveRight() *List {
+	if l.paginated && (l.columns <= 1 || l.focusedIndex%l.columns == l.columns-1 ||
+		l.focusedIndex+1 >= len(l.filteredItems)) {
+		return l.NextPage()
+	}
+	if len(l.filteredItems) == 0 || l.columns <= 1 ||
+		l.focusedIndex%l.columns == l.columns-1 || l.focusedIndex+1 >= len(l.filteredItems) {
+		return l
+	}
+	newList := l.clone()
+	newList.focusedIndex++
+	return newList
+}
+
 // MovePageUp moves the focus up by one page.
+// In paginated mode this is PrevPage.
 func (l *List) MovePageUp() *List {
+	if l.paginated {
+		return l.PrevPage()
+	}
 	if len(l.filteredItems) == 0 {
 		return l
 	}
 	newList := l.clone()
-	newList.focusedIndex = newList.navService.MovePageUp(newList.focusedIndex, newList.height, len(newList.filteredItems))
+	newList.focusedIndex = newList.navService.MovePageUp(newList.focusedIndex, newList.height*newList.columns, len(newList.filteredItems))
 	newList.updateScrollOffset()
 	return newList
 }
 
 // MovePageDown moves the focus down by one page.
+// In paginated mode this is NextPage.
 func (l *List) MovePageDown() *List {
+	if l.paginated {
+		return l.NextPage()
+	}
 	if len(l.filteredItems) == 0 {
 		return l
 	}
 	newList := l.clone()
-	newList.focusedIndex = newList.navService.MovePageDown(newList.focusedIndex, newList.height, len(newList.filteredItems))
+	newList.focusedIndex = newList.navService.MovePageDown(newList.focusedIndex, newList.height*newList.columns, len(newList.filteredItems))
 	newList.updateScrollOffset()
 	return newList
 }
@@ -226,11 +409,21 @@ func (l *List) FocusedItem() *value.Item {
 }
 
 // SetFilterQuery updates the filter query and reapplies filtering.
+// Focus stays on the focused item if it still matches, otherwise it moves
+// to the first match.
 func (l *List) SetFilterQuery(query string) *List {
+	focused := l.FocusedItem()
 	newList := l.clone()
 	newList.filterQuery = query
 	newList.applyFilter()
-	newList.resetFocus()
+	newList.focusedIndex = 0
+	for i, item := range newList.filteredItems {
+		if item == focused {
+			newList.focusedIndex = i
+			break
+		}
+	}
+	newList.updateScrollOffset()
 	return newList
 }
 
@@ -253,6 +446,11 @@ func (l *List) FilteredItems() []*value.Item {
 	return result
 }
 
+// FilterQuery returns the current filter query.
+func (l *List) FilterQuery() string {
+	return l.filterQuery
+}
+
 // IsFiltered returns true if a filter is currently active.
 func (l *List) IsFiltered() bool {
 	return l.filterQuery != ""
@@ -293,18 +491,44 @@ func (l *List) RenderItem(index int) string {
 		return ""
 	}
 
-	item := l.filteredItems[index]
+	item := l.highlightMatch(l.filteredItems[index])
 	selected := l.selectedIndices[index]
 	focused := index == l.focusedIndex
 
 	return l.itemRenderer(item, index, selected, focused)
 }
 
+// highlightMatch returns item with the filter match in its label decorated
+// by the highlighter, or item unchanged if there is nothing to highlight.
+func (l *List) highlightMatch(item *value.Item) *value.Item {
+	if l.highlightFunc == nil || l.filterQuery == "" {
+		return item
+	}
+	label := item.Label()
+	start, end, ok := l.filterService.Match(label, l.filterQuery)
+	if !ok {
+		return item
+	}
+	label = label[:start] + l.highlightFunc(label[start:end]) + label[end:]
+	return item.WithLabel(label)
+}
+
 // RenderVisibleItems renders all visible items based on scroll offset.
+// In grid layout each returned line is one row of items; in a sectioned
+// list header lines are included and count towards the height.
 func (l *List) RenderVisibleItems() []string {
 	if len(l.filteredItems) == 0 {
 		return []string{}
 	}
+	if l.columns > 1 {
+		return l.renderVisibleRows()
+	}
+	if l.hasSections() {
+		if l.paginated {
+			return l.renderPageSections()
+		}
+		return l.renderVisibleSections()
+	}
 
 	start := l.scrollOffset
 	end := l.scrollOffset + l.height
@@ -323,6 +547,142 @@ func (l *List) RenderVisibleItems() []string {
 	return result
 }
 
+// renderVisibleRows renders the visible rows of a grid layout. Every column
+// is as wide as the widest rendered item, measured in terminal cells.
+func (l *List) renderVisibleRows() []string {
+	rendered := make([]string, len(l.filteredItems))
+	colWidth := 0
+	for i := range l.filteredItems {
+		rendered[i] = l.RenderItem(i)
+		colWidth = max(colWidth, displayWidth(rendered[i]))
+	}
+
+	start := min(l.scrollOffset, len(rendered))
+	end := min(start+l.height*l.columns, len(rendered))
+
+	rows := make([]string, 0, l.height)
+	for rowStart := start; rowStart < end; rowStart += l.columns {
+		rowEnd := min(rowStart+l.columns, end)
+		var b strings.Builder
+		for i := rowStart; i < rowEnd; i++ {
+			b.WriteString(rendered[i])
+			if i < rowEnd-1 {
+				// Pad to the column width; the last cell needs no padding.
+				b.WriteString(strings.Repeat(" ", colWidth-displayWidth(rendered[i])))
+				b.WriteString(columnGap)
+			}
+		}
+		rows = append(rows, b.String())
+	}
+	return rows
+}
+
+// sectionLine is one line of a sectioned list: an item, or the header of
+// the group that follows.
+type sectionLine struct {
+	item    int // Index in filteredItems, -1 for a header
+	section string
+}
+
+// hasSections reports whether any filtered item belongs to a section.
+func (l *List) hasSections() bool {
+	for _, item := range l.filteredItems {
+		if item.Section() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// sectionLines lays out the filtered items with a header line before each
+// group of consecutive items sharing a section.
+func (l *List) sectionLines() []sectionLine {
+	lines := make([]sectionLine, 0, len(l.filteredItems)*2)
+	prev := ""
+	for i, item := range l.filteredItems {
+		section := item.Section()
+		if section != "" && (i == 0 || section != prev) {
+			lines = append(lines, sectionLine{item: -1, section: section})
+		}
+		lines = append(lines, sectionLine{item: i, section: section})
+		prev = section
+	}
+	return lines
+}
+
+// renderVisibleSections renders the visible lines of a sectioned list.
+// Scrolling works on lines so the focused item stays visible; when the
+// viewport starts inside a group, that group's header sticks to the top line.
+func (l *List) renderVisibleSections() []string {
+	lines := l.sectionLines()
+	focusLine := 0
+	for i, line := range lines {
+		if line.item == l.focusedIndex {
+			focusLine = i
+			break
+		}
+	}
+
+	start := l.navService.CalculateScrollOffset(focusLine, l.height, len(lines))
+	end := max(start, min(start+l.height, len(lines)))
+
+	result := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		line := lines[i]
+		switch {
+		case line.item < 0:
+			result = append(result, l.headerRenderer(line.section))
+		case i == start && line.section != "" && i != focusLine:
+			// Sticky header: the group's own header has scrolled away.
+			result = append(result, l.headerRenderer(line.section))
+		default:
+			result = append(result, l.RenderItem(line.item))
+		}
+	}
+	return result
+}
+
+// renderPageSections renders the current page of a paginated, sectioned
+// list. Header lines are added on top of the page's items, and the first
+// item's group header is always shown so each page names its section.
+func (l *List) renderPageSections() []string {
+	start := min(l.scrollOffset, len(l.filteredItems))
+	end := min(start+l.PageSize(), len(l.filteredItems))
+
+	result := make([]string, 0, (end-start)*2)
+	prev := ""
+	for i := start; i < end; i++ {
+		section := l.filteredItems[i].Section()
+		if section != "" && (i == start || section != prev) {
+			result = append(result, l.headerRenderer(section))
+		}
+		result = append(result, l.RenderItem(i))
+		prev = section
+	}
+	return result
+}
+
+// displayWidth returns the width of s in terminal cells, ignoring ANSI
+// escape sequences (styled or highlighted items).
+func displayWidth(s string) int {
+	if !strings.Contains(s, "\x1b[") {
+		return core.StringWidth(s)
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '[' {
+			// Skip CSI: parameters up to the final byte (0x40-0x7E).
+			i += 2
+			for i < len(s) && (s[i] < 0x40 || s[i] > 0x7e) {
+				i++
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return core.StringWidth(b.String())
+}
+
 // clone creates a shallow copy of the list for immutability.
 func (l *List) clone() *List {
 	newSelectedIndices := make(map[int]bool, len(l.selectedIndices))
@@ -339,8 +699,12 @@ func (l *List) clone() *List {
 		itemRenderer:    l.itemRenderer,
 		filterFunc:      l.filterFunc,
 		filterQuery:     l.filterQuery,
+		highlightFunc:   l.highlightFunc,
 		height:          l.height,
 		scrollOffset:    l.scrollOffset,
+		columns:         l.columns,
+		paginated:       l.paginated,
+		headerRenderer:  l.headerRenderer,
 		navService:      l.navService,
 		filterService:   l.filterService,
 	}
@@ -361,7 +725,19 @@ func (l *List) resetFocus() {
 }
 
 // updateScrollOffset updates the scroll offset based on the focused item.
+// In grid layout whole rows are scrolled; in paginated mode the offset is
+// the first item of the focused item's page.
 func (l *List) updateScrollOffset() {
+	if l.paginated {
+		l.scrollOffset = l.Page() * l.PageSize()
+		return
+	}
+	if l.columns > 1 {
+		rows := (len(l.filteredItems) + l.columns - 1) / l.columns
+		firstRow := l.navService.CalculateScrollOffset(l.focusedIndex/l.columns, l.height, rows)
+		l.scrollOffset = firstRow * l.columns
+		return
+	}
 	l.scrollOffset = l.navService.CalculateScrollOffset(
 		l.focusedIndex,
 		l.height,
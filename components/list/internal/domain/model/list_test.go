@@ -2,6 +2,7 @@ package model
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/value"
@@ -342,6 +343,235 @@ func TestList_ClearFilter(t *testing.T) {
 	}
 }
 
+func TestList_SetFilterQuery_KeepsFocusedItem(t *testing.T) {
+	items := []*value.Item{
+		value.NewItem(1, "apple"),
+		value.NewItem(2, "banana"),
+		value.NewItem(3, "apricot"),
+	}
+
+	l := NewListWithItems(items, value.SelectionModeSingle).MoveToEnd() // apricot
+	l = l.SetFilterQuery("ap")
+	if l.FocusedItem().Label() != "apricot" {
+		t.Errorf("FocusedItem() = %s, want apricot (still matches)", l.FocusedItem().Label())
+	}
+
+	l = l.SetFilterQuery("apple")
+	if l.FocusedIndex() != 0 {
+		t.Errorf("FocusedIndex() = %d, want 0 (focused item filtered out)", l.FocusedIndex())
+	}
+}
+
+func TestList_WithMatchHighlighter(t *testing.T) {
+	items := []*value.Item{
+		value.NewItem(1, "Apple"),
+		value.NewItem(2, "pineapple"),
+	}
+
+	l := NewListWithItems(items, value.SelectionModeSingle).
+		WithMatchHighlighter(func(match string) string { return "[" + match + "]" }).
+		SetFilterQuery("APP")
+
+	if l.FilterQuery() != "APP" {
+		t.Errorf("FilterQuery() = %q, want APP", l.FilterQuery())
+	}
+	if got := l.RenderItem(0); got != "> [App]le" {
+		t.Errorf("RenderItem(0) = %q, want %q", got, "> [App]le")
+	}
+	if got := l.RenderItem(1); got != "  pine[app]le" {
+		t.Errorf("RenderItem(1) = %q, want %q", got, "  pine[app]le")
+	}
+
+	// Without a query nothing is highlighted.
+	if got := l.ClearFilter().RenderItem(0); got != "> Apple" {
+		t.Errorf("RenderItem(0) without filter = %q, want %q", got, "> Apple")
+	}
+}
+
+func TestList_WithColumns_Navigation(t *testing.T) {
+	l := NewListWithItems(createTestItems(5), value.SelectionModeSingle).WithColumns(2)
+
+	l = l.MoveRight()
+	if l.FocusedIndex() != 1 {
+		t.Errorf("MoveRight() = %d, want 1", l.FocusedIndex())
+	}
+	if l.MoveRight().FocusedIndex() != 1 {
+		t.Error("MoveRight() at last column should stay")
+	}
+
+	l = l.MoveDown().MoveDown() // 1 -> 3 -> 4 (short last row)
+	if l.FocusedIndex() != 4 {
+		t.Errorf("MoveDown() into short row = %d, want 4", l.FocusedIndex())
+	}
+	if l.MoveRight().FocusedIndex() != 4 {
+		t.Error("MoveRight() past the last item should stay")
+	}
+
+	l = l.MoveUp()
+	if l.FocusedIndex() != 2 {
+		t.Errorf("MoveUp() = %d, want 2", l.FocusedIndex())
+	}
+	if l.MoveLeft().FocusedIndex() != 2 {
+		t.Error("MoveLeft() should stop at the first column")
+	}
+}
+
+func TestList_WithColumns_Render(t *testing.T) {
+	items := []*value.Item{
+		value.NewItem(1, "a"),
+		value.NewItem(2, "中文"),
+		value.NewItem(3, "bb"),
+		value.NewItem(4, "c"),
+		value.NewItem(5, "d"),
+	}
+	l := NewListWithItems(items, value.SelectionModeSingle).WithColumns(2)
+
+	got := l.RenderVisibleItems()
+	want := []string{
+		"> a       中文", // "> a" padded to 6 cells + gap + "  中文"
+		"  bb      c",
+		"  d",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderVisibleItems() = %q, want %q", got, want)
+	}
+}
+
+func TestList_WithColumns_Scrolling(t *testing.T) {
+	l := NewListWithItems(createTestItems(12), value.SelectionModeSingle).
+		WithColumns(3).
+		WithHeight(2)
+
+	if rows := l.RenderVisibleItems(); len(rows) != 2 {
+		t.Fatalf("visible rows = %d, want 2", len(rows))
+	}
+
+	l = l.MoveToEnd()
+	if l.ScrollOffset()%3 != 0 {
+		t.Errorf("ScrollOffset() = %d, should be a row start", l.ScrollOffset())
+	}
+	rows := l.RenderVisibleItems()
+	if len(rows) != 2 || !strings.Contains(rows[1], "> Item L") {
+		t.Errorf("last row should be visible and focused, got %q", rows)
+	}
+}
+
+func TestList_WithColumns_One(t *testing.T) {
+	l := NewListWithItems(createTestItems(3), value.SelectionModeSingle).WithColumns(0)
+	if l.Columns() != 1 {
+		t.Errorf("Columns() = %d, want 1", l.Columns())
+	}
+	if l.MoveUp().FocusedIndex() != 2 {
+		t.Error("single column should keep wrap-around navigation")
+	}
+	if l.MoveRight().FocusedIndex() != 0 {
+		t.Error("MoveRight() should be a no-op in a single column")
+	}
+}
+
+func TestList_WithPaginated_Navigation(t *testing.T) {
+	l := NewListWithItems(createTestItems(11), value.SelectionModeSingle).
+		WithHeight(4).
+		WithPaginated(true)
+
+	if l.PageCount() != 3 || l.Page() != 0 {
+		t.Fatalf("PageCount() = %d, Page() = %d, want 3, 0", l.PageCount(), l.Page())
+	}
+
+	l = l.MoveDown().MovePageDown() // 1 -> 5 (same position, next page)
+	if l.FocusedIndex() != 5 || l.Page() != 1 || l.ScrollOffset() != 4 {
+		t.Errorf("after MovePageDown: focus %d, page %d, offset %d, want 5, 1, 4",
+			l.FocusedIndex(), l.Page(), l.ScrollOffset())
+	}
+
+	l = l.MoveRight() // 5 -> 9
+	if l.FocusedIndex() != 9 {
+		t.Errorf("MoveRight() = %d, want 9", l.FocusedIndex())
+	}
+	if l.MovePageDown().FocusedIndex() != 9 {
+		t.Error("MovePageDown() on the last page should stay")
+	}
+
+	l = l.MoveLeft().MovePageUp() // 9 -> 5 -> 1
+	if l.FocusedIndex() != 1 || l.Page() != 0 {
+		t.Errorf("MovePageUp() = %d on page %d, want 1 on page 0", l.FocusedIndex(), l.Page())
+	}
+	if l.MovePageUp().FocusedIndex() != 1 {
+		t.Error("MovePageUp() on the first page should stay")
+	}
+
+	// Moving down past the page edge turns the page
+	l = l.MoveDown().MoveDown().MoveDown()
+	if l.FocusedIndex() != 4 || l.ScrollOffset() != 4 {
+		t.Errorf("MoveDown() across the page edge: focus %d, offset %d, want 4, 4",
+			l.FocusedIndex(), l.ScrollOffset())
+	}
+}
+
+func TestList_WithPaginated_ShortLastPage(t *testing.T) {
+	l := NewListWithItems(createTestItems(10), value.SelectionModeSingle).
+		WithHeight(4).
+		WithPaginated(true).
+		MoveToStart().MoveDown().MoveDown().MoveDown() // 3
+
+	l = l.MovePageDown().MovePageDown() // 7 -> 9 (last page has 2 items)
+	if l.FocusedIndex() != 9 {
+		t.Errorf("MovePageDown() onto a short page = %d, want 9", l.FocusedIndex())
+	}
+
+	rows := l.RenderVisibleItems()
+	if len(rows) != 2 || !strings.Contains(rows[1], "> Item J") {
+		t.Errorf("RenderVisibleItems() = %q, want the 2 items of the last page", rows)
+	}
+}
+
+func TestList_WithPaginated_Grid(t *testing.T) {
+	l := NewListWithItems(createTestItems(10), value.SelectionModeSingle).
+		WithColumns(2).
+		WithHeight(2).
+		WithPaginated(true)
+
+	if l.PageSize() != 4 || l.PageCount() != 3 {
+		t.Fatalf("PageSize() = %d, PageCount() = %d, want 4, 3", l.PageSize(), l.PageCount())
+	}
+
+	l = l.MoveRight() // 0 -> 1 (next column)
+	if l.FocusedIndex() != 1 {
+		t.Errorf("MoveRight() = %d, want 1", l.FocusedIndex())
+	}
+	l = l.MoveRight() // last column: next page, 1 -> 5
+	if l.FocusedIndex() != 5 || l.Page() != 1 {
+		t.Errorf("MoveRight() from the last column = %d on page %d, want 5 on page 1", l.FocusedIndex(), l.Page())
+	}
+	if rows := l.RenderVisibleItems(); len(rows) != 2 || !strings.HasPrefix(rows[0], "  Item E") {
+		t.Errorf("RenderVisibleItems() = %q, want page 2", rows)
+	}
+}
+
+func TestList_WithPaginated_Sections(t *testing.T) {
+	l := NewListWithItems(createTestItems(4), value.SelectionModeSingle).
+		WithSections([]string{"One", "One", "One", "Two"}).
+		WithHeight(2).
+		WithPaginated(true).
+		MovePageDown()
+
+	got := l.RenderVisibleItems()
+	want := []string{"One", "> Item C", "Two", "  Item D"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderVisibleItems() = %q, want %q", got, want)
+	}
+}
+
+func TestList_WithPaginated_Empty(t *testing.T) {
+	l := NewList(value.SelectionModeSingle).WithPaginated(true)
+	if l.PageCount() != 0 || l.Page() != 0 {
+		t.Errorf("empty list: PageCount() = %d, Page() = %d, want 0, 0", l.PageCount(), l.Page())
+	}
+	if l.NextPage().FocusedIndex() != 0 || l.PrevPage().FocusedIndex() != 0 {
+		t.Error("page navigation on an empty list should be a no-op")
+	}
+}
+
 func TestList_FocusedItem(t *testing.T) {
 	items := createTestItems(5)
 	l := NewListWithItems(items, value.SelectionModeSingle)
@@ -553,6 +783,64 @@ func TestDefaultItemRenderer(t *testing.T) {
 	}
 }
 
+func TestList_WithSections_Render(t *testing.T) {
+	items := []*value.Item{
+		value.NewItem(1, "a1"),
+		value.NewItem(2, "a2"),
+		value.NewItem(3, "b1"),
+		value.NewItem(4, "b2"),
+		value.NewItem(5, "b3"),
+	}
+	l := NewListWithItems(items, value.SelectionModeSingle).
+		WithSections([]string{"A", "A", "B", "B", "B"}).
+		WithHeight(3)
+
+	tests := []struct {
+		focus int
+		want  []string
+	}{
+		{0, []string{"A", "> a1", "  a2"}},
+		{1, []string{"A", "> a2", "B"}}, // a1 scrolled away, "A" sticks
+		{4, []string{"B", "  b2", "> b3"}},
+	}
+	for _, tt := range tests {
+		view := l
+		for i := 0; i < tt.focus; i++ {
+			view = view.MoveDown()
+		}
+		got := view.RenderVisibleItems()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("focus %d: RenderVisibleItems() = %q, want %q", tt.focus, got, tt.want)
+		}
+	}
+}
+
+func TestList_WithSections_Navigation(t *testing.T) {
+	l := NewListWithItems(createTestItems(4), value.SelectionModeSingle).
+		WithSections([]string{"A", "A", "", "B"})
+
+	if l.CurrentSection() != "A" {
+		t.Errorf("CurrentSection() = %q, want A", l.CurrentSection())
+	}
+	// Headers are not items: one step down from the last "A" item is the
+	// unsectioned item, the next one the first "B" item.
+	l = l.MoveDown().MoveDown()
+	if l.FocusedIndex() != 2 || l.CurrentSection() != "" {
+		t.Errorf("focus = %d in %q, want 2 in no section", l.FocusedIndex(), l.CurrentSection())
+	}
+	l = l.MoveDown()
+	if l.CurrentSection() != "B" {
+		t.Errorf("CurrentSection() = %q, want B", l.CurrentSection())
+	}
+
+	// Only groups with matching items keep their header.
+	filtered := l.WithSectionHeaderRenderer(func(s string) string { return "# " + s }).
+		SetFilterQuery("Item D")
+	if got := filtered.RenderVisibleItems(); !reflect.DeepEqual(got, []string{"# B", "> Item D"}) {
+		t.Errorf("filtered RenderVisibleItems() = %q", got)
+	}
+}
+
 func TestList_Items_ReturnsCopy(t *testing.T) {
 	items := createTestItems(3)
 	l := NewListWithItems(items, value.SelectionModeSingle)
@@ -3,6 +3,7 @@ package service
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/value"
 )
@@ -41,8 +42,38 @@ func (s *FilterService) DefaultFilter(item *value.Item, query string) bool {
 	if query == "" {
 		return true
 	}
-	return strings.Contains(
-		strings.ToLower(item.Label()),
-		strings.ToLower(query),
-	)
+	_, _, ok := s.Match(item.Label(), query)
+	return ok
+}
+
+// Match finds the first case-insensitive occurrence of query in text.
+// Case folding is Unicode-aware (e.g. "ПРИВЕТ" matches "привет").
+// Returns the byte range [start, end) of the match in text.
+func (s *FilterService) Match(text, query string) (start, end int, ok bool) {
+	if query == "" {
+		return 0, 0, false
+	}
+	for start = range text {
+		if end, ok = matchFoldAt(text, start, query); ok {
+			return start, end, true
+		}
+	}
+	return 0, 0, false
+}
+
+// matchFoldAt reports whether query matches text at byte offset start,
+// rune by rune with simple case folding, and returns the end offset.
+func matchFoldAt(text string, start int, query string) (int, bool) {
+	pos := start
+	for _, q := range query {
+		if pos >= len(text) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(text[pos:])
+		if !strings.EqualFold(string(r), string(q)) {
+			return 0, false
+		}
+		pos += size
+	}
+	return pos, true
 }
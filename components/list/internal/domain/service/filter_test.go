@@ -187,3 +187,29 @@ func TestFilterService_Filter_PreservesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterService_Match(t *testing.T) {
+	svc := NewFilterService()
+
+	tests := []struct {
+		text, query string
+		wantStart   int
+		wantEnd     int
+		wantOK      bool
+	}{
+		{"main.go", "GO", 5, 7, true},
+		{"Привет мир", "МИР", 13, 19, true},
+		{"Straße", "SS", 0, 0, false}, // Simple folding only: ß is not "ss"
+		{"日本語", "本", 3, 6, true},
+		{"abc", "", 0, 0, false},
+		{"abc", "abcd", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		start, end, ok := svc.Match(tt.text, tt.query)
+		if start != tt.wantStart || end != tt.wantEnd || ok != tt.wantOK {
+			t.Errorf("Match(%q, %q) = (%d, %d, %v), want (%d, %d, %v)",
+				tt.text, tt.query, start, end, ok, tt.wantStart, tt.wantEnd, tt.wantOK)
+		}
+	}
+}
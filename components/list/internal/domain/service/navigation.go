@@ -30,6 +30,29 @@ func (s *NavigationService) MoveDown(currentIndex, itemCount int) int {
 	return currentIndex + 1
 }
 
+// MoveUpInGrid moves the focus one row up in a grid of the given number of
+// columns (items filled row by row). Stays on the first row.
+func (s *NavigationService) MoveUpInGrid(currentIndex, columns int) int {
+	if currentIndex-columns < 0 {
+		return currentIndex
+	}
+	return currentIndex - columns
+}
+
+// MoveDownInGrid moves the focus one row down in a grid of the given number
+// of columns. If the next row is shorter than the current column, the focus
+// moves to its last item; stays on the last row.
+func (s *NavigationService) MoveDownInGrid(currentIndex, columns, itemCount int) int {
+	if currentIndex+columns < itemCount {
+		return currentIndex + columns
+	}
+	lastRow := (itemCount - 1) / columns
+	if currentIndex/columns < lastRow {
+		return itemCount - 1
+	}
+	return currentIndex
+}
+
 // MovePageUp moves the focus up by page size.
 func (s *NavigationService) MovePageUp(currentIndex, pageSize, itemCount int) int {
 	if itemCount == 0 {
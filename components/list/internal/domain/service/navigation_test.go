@@ -294,3 +294,34 @@ func TestNavigationService_CalculateScrollOffset(t *testing.T) {
 		})
 	}
 }
+
+func TestNavigationService_Grid(t *testing.T) {
+	svc := NewNavigationService()
+
+	// 3 columns, 8 items:
+	//   0 1 2
+	//   3 4 5
+	//   6 7
+	tests := []struct {
+		name    string
+		current int
+		up      int
+		down    int
+	}{
+		{"first row", 1, 1, 4},
+		{"middle row", 4, 1, 7},
+		{"into short last row", 5, 2, 7},
+		{"last row", 6, 3, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.MoveUpInGrid(tt.current, 3); got != tt.up {
+				t.Errorf("MoveUpInGrid(%d) = %d, want %d", tt.current, got, tt.up)
+			}
+			if got := svc.MoveDownInGrid(tt.current, 3, 8); got != tt.down {
+				t.Errorf("MoveDownInGrid(%d) = %d, want %d", tt.current, got, tt.down)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package value
 type Item struct {
 	value    interface{}            // Any data associated with the item
 	label    string                 // Display text (used by default rendering)
+	section  string                 // Group label ("" = no section)
 	metadata map[string]interface{} // Additional data for custom rendering
 }
 
@@ -66,6 +67,26 @@ func (i *Item) WithMetadata(key string, value interface{}) *Item {
 	return &Item{
 		value:    i.value,
 		label:    i.label,
+		section:  i.section,
 		metadata: newMetadata,
 	}
 }
+
+// Section returns the group label of the item ("" if it has none).
+func (i *Item) Section() string {
+	return i.section
+}
+
+// WithSection returns a new Item belonging to the given section.
+func (i *Item) WithSection(section string) *Item {
+	newItem := *i
+	newItem.section = section
+	return &newItem
+}
+
+// WithLabel returns a new Item with a different display label.
+func (i *Item) WithLabel(label string) *Item {
+	newItem := *i
+	newItem.label = label
+	return &newItem
+}
@@ -212,3 +212,20 @@ func TestItem_WithMetadata_Overwrite(t *testing.T) {
 		t.Error("Item.WithMetadata() should overwrite existing key")
 	}
 }
+
+func TestItem_WithSection(t *testing.T) {
+	original := NewItemWithMetadata("value", "label", map[string]interface{}{"key": 1})
+	sectioned := original.WithSection("Group")
+
+	if original.Section() != "" {
+		t.Error("Item.WithSection() should not modify original item")
+	}
+	if sectioned.Section() != "Group" || sectioned.Label() != "label" {
+		t.Errorf("Section() = %q, Label() = %q", sectioned.Section(), sectioned.Label())
+	}
+
+	// Other copies keep the section.
+	if sectioned.WithMetadata("k", 2).Section() != "Group" || sectioned.WithLabel("x").Section() != "Group" {
+		t.Error("WithMetadata()/WithLabel() should keep the section")
+	}
+}
@@ -22,6 +22,10 @@ func DefaultKeyBindings() []KeyBinding {
 		{Key: "↓", Action: "move_down"}, // Unicode arrow from tea
 		{Key: "j", Action: "move_down"},
 
+		// Column movement (grid layout).
+		{Key: "←", Action: "move_left"},
+		{Key: "→", Action: "move_right"},
+
 		// Page movement.
 		{Key: "pgup", Action: "page_up"},
 		{Key: "ctrl+u", Action: "page_up"},
@@ -45,6 +49,7 @@ func DefaultKeyBindings() []KeyBinding {
 		{Key: "esc", Action: "clear_selection"},
 
 		// Filter.
+		{Key: "/", Action: "filter"}, // Enter filter mode (Filterable lists)
 		{Key: "ctrl+c", Action: "clear_filter"},
 
 		// Quit.
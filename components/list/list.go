@@ -27,7 +27,9 @@
 package list
 
 import (
+	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/components/list/internal/domain/value"
@@ -36,6 +38,10 @@ import (
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
 
+// maxPageDots is the largest page count for which the page indicator
+// draws one dot per page.
+const maxPageDots = 10
+
 // SelectionMode defines how items can be selected in a list.
 type SelectionMode int
 
@@ -58,6 +64,8 @@ type List struct {
 	domain     *model.List
 	keymap     infrastructure.KeyBindingMap
 	showFilter bool         // Whether to show filter input at bottom
+	filterable bool         // Whether "/" starts incremental filtering
+	filtering  bool         // Whether typed keys currently edit the filter query
 	theme      *style.Theme // Optional theme, defaults to DefaultTheme if nil
 }
 
@@ -100,6 +108,44 @@ func (l *List) Height(height int) *List {
 	return newList
 }
 
+// Columns lays items out in n columns, filled row by row, for lists of short
+// items such as file names. Each column is as wide as the widest item.
+// Left/Right move across columns, Up/Down across rows, and Height counts rows.
+// Columns(1) is the default single-column list.
+func (l *List) Columns(n int) *List {
+	newList := l.clone()
+	newList.domain = newList.domain.WithColumns(n)
+	return newList
+}
+
+// Paginated shows items a page at a time instead of scrolling, with a
+// "Page 2/5" indicator below them. A page holds Height rows (of Columns
+// items). PgUp/PgDn and Left/Right flip whole pages, keeping the focus at
+// the same position on the page; Up/Down move one item and turn the page
+// at its edges. In a sectioned list, header lines are shown in addition to
+// the page's items.
+func (l *List) Paginated(enabled bool) *List {
+	newList := l.clone()
+	newList.domain = newList.domain.WithPaginated(enabled)
+	return newList
+}
+
+// SectionedItems groups items under section headers: item i belongs to
+// sections[i], and consecutive items with the same label form one group
+// rendered below a header line ("" means no section). Headers are never
+// focused - navigation skips them - and while scrolling the header of the
+// group at the top of the view stays pinned to the first line.
+// Header lines count towards Height. Sections are not shown in grid layout.
+// Sections must be the same length as the items.
+func (l *List) SectionedItems(sections []string) *List {
+	if len(sections) != len(l.domain.Items()) {
+		panic("list.SectionedItems: sections and items must have the same length")
+	}
+	newList := l.clone()
+	newList.domain = newList.domain.WithSections(sections)
+	return newList
+}
+
 // ItemRenderer sets a custom item renderer function.
 // The function receives the item, index, selected state, and focused state.
 func (l *List) ItemRenderer(renderer func(item interface{}, index int, selected, focused bool) string) *List {
@@ -131,6 +177,22 @@ func (l *List) ShowFilter(show bool) *List {
 	return newList
 }
 
+// Filterable enables incremental filtering.
+//
+// Pressing "/" enters filter mode: typed characters narrow the visible items
+// by case-insensitive substring match on labels (or the Filter function, if
+// set) and the matching part of each label is highlighted. Arrow keys move
+// over the filtered items, Enter leaves filter mode keeping the filter, and
+// Esc clears the filter.
+func (l *List) Filterable(enabled bool) *List {
+	newList := l.clone()
+	newList.filterable = enabled
+	if !enabled {
+		newList.filtering = false
+	}
+	return newList
+}
+
 // KeyBindings sets custom key bindings.
 func (l *List) KeyBindings(bindings []infrastructure.KeyBinding) *List {
 	newList := l.clone()
@@ -152,6 +214,8 @@ func (l *List) clone() *List {
 		domain:     l.domain,
 		keymap:     l.keymap,
 		showFilter: l.showFilter,
+		filterable: l.filterable,
+		filtering:  l.filtering,
 		theme:      l.theme,
 	}
 }
@@ -185,6 +249,47 @@ func (l *List) FocusedIndex() int {
 	return l.domain.FocusedIndex()
 }
 
+// CurrentSection returns the section of the focused item
+// ("" if it has none or the list is empty).
+func (l *List) CurrentSection() string {
+	return l.domain.CurrentSection()
+}
+
+// CurrentPage returns the zero-based page of the focused item.
+// Pages are counted the same way when the list is not Paginated.
+func (l *List) CurrentPage() int {
+	return l.domain.Page()
+}
+
+// PageCount returns the number of pages of visible items (0 if there are
+// none). Filtering changes the count.
+func (l *List) PageCount() int {
+	return l.domain.PageCount()
+}
+
+// FilterValue returns the current filter query ("" when not filtered).
+func (l *List) FilterValue() string {
+	return l.domain.FilterQuery()
+}
+
+// IsFiltering returns true while typed keys edit the filter query
+// (after "/" in a Filterable list).
+func (l *List) IsFiltering() bool {
+	return l.filtering
+}
+
+// VisibleItems returns the values of the items that pass the current
+// filter, in display order. len(VisibleItems()) over the total item count
+// gives "3/20 items" style indicators.
+func (l *List) VisibleItems() []interface{} {
+	domainItems := l.domain.FilteredItems()
+	result := make([]interface{}, len(domainItems))
+	for i, item := range domainItems {
+		result[i] = item.Value()
+	}
+	return result
+}
+
 // Init implements tea.Model.
 func (l *List) Init() tea.Cmd {
 	return nil
@@ -204,6 +309,12 @@ func (l *List) Update(msg tea.Msg) (*List, tea.Cmd) {
 //
 //nolint:gocyclo,cyclop // Keyboard handling is complex by nature: processes multiple navigation/selection keys
 func (l *List) handleKey(msg tea.KeyMsg) (*List, tea.Cmd) {
+	if l.filtering {
+		if newList, handled := l.handleFilterKey(msg); handled {
+			return newList, nil
+		}
+	}
+
 	action := l.keymap.GetAction(msg)
 	newList := l.clone()
 
@@ -212,6 +323,10 @@ func (l *List) handleKey(msg tea.KeyMsg) (*List, tea.Cmd) {
 		newList.domain = newList.domain.MoveUp()
 	case "move_down":
 		newList.domain = newList.domain.MoveDown()
+	case "move_left":
+		newList.domain = newList.domain.MoveLeft()
+	case "move_right":
+		newList.domain = newList.domain.MoveRight()
 	case "page_up":
 		newList.domain = newList.domain.MovePageUp()
 	case "page_down":
@@ -225,7 +340,16 @@ func (l *List) handleKey(msg tea.KeyMsg) (*List, tea.Cmd) {
 	case "select_all":
 		newList.domain = newList.domain.SelectAll()
 	case "clear_selection":
-		newList.domain = newList.domain.ClearSelection()
+		if newList.filterable && newList.domain.IsFiltered() {
+			// Esc clears an applied filter before the selection.
+			newList.domain = newList.domain.ClearFilter()
+		} else {
+			newList.domain = newList.domain.ClearSelection()
+		}
+	case "filter":
+		if newList.filterable {
+			newList.filtering = true
+		}
 	case "clear_filter":
 		newList.domain = newList.domain.ClearFilter()
 	case "confirm":
@@ -248,6 +372,33 @@ func (l *List) handleKey(msg tea.KeyMsg) (*List, tea.Cmd) {
 	return newList, nil
 }
 
+// handleFilterKey processes a key in filter mode. Keys that don't edit the
+// query (arrows, page keys) are reported as not handled so they navigate.
+func (l *List) handleFilterKey(msg tea.KeyMsg) (*List, bool) {
+	newList := l.clone()
+	query := l.domain.FilterQuery()
+
+	switch {
+	case msg.Type == tea.KeyEsc:
+		newList.filtering = false
+		newList.domain = newList.domain.ClearFilter()
+	case msg.Type == tea.KeyEnter:
+		newList.filtering = false
+	case msg.Type == tea.KeyBackspace:
+		if query != "" {
+			_, size := utf8.DecodeLastRuneInString(query)
+			newList.domain = newList.domain.SetFilterQuery(query[:len(query)-size])
+		}
+	case msg.Type == tea.KeySpace && !msg.Ctrl && !msg.Alt:
+		newList.domain = newList.domain.SetFilterQuery(query + " ")
+	case msg.Type == tea.KeyRune && !msg.Ctrl && !msg.Alt:
+		newList.domain = newList.domain.SetFilterQuery(query + string(msg.Rune))
+	default:
+		return l, false
+	}
+	return newList, true
+}
+
 // View implements tea.Model.
 func (l *List) View() string {
 	// Get theme (use default if not set)
@@ -259,8 +410,19 @@ func (l *List) View() string {
 
 	var b strings.Builder
 
-	// Render visible items.
-	items := l.domain.RenderVisibleItems()
+	// Render visible items, highlighting filter matches.
+	domain := l.domain
+	if l.filterable {
+		matchStyle := style.New().Foreground(colors.Primary).Bold(true)
+		domain = domain.WithMatchHighlighter(func(match string) string {
+			return style.Render(matchStyle, match)
+		})
+	}
+	headerStyle := style.New().Foreground(colors.TextMuted).Bold(true)
+	domain = domain.WithSectionHeaderRenderer(func(section string) string {
+		return style.Render(headerStyle, section)
+	})
+	items := domain.RenderVisibleItems()
 	//nolint:nestif // Empty state handling is clear: filtered vs unfiltered with pagination
 	if len(items) == 0 {
 		// Use muted text color for empty state
@@ -279,6 +441,19 @@ func (l *List) View() string {
 		}
 	}
 
+	// Show the page indicator below the items.
+	if l.domain.IsPaginated() && len(items) > 0 {
+		b.WriteRune('\n')
+		b.WriteString(l.renderPageIndicator(colors))
+	}
+
+	// Show the filter query while filtering or when a filter is applied.
+	if l.filterable && (l.filtering || l.domain.IsFiltered()) {
+		b.WriteRune('\n')
+		b.WriteString(l.renderFilterStatus(colors))
+		return b.String()
+	}
+
 	// Show filter status if enabled.
 	if l.showFilter && l.domain.IsFiltered() {
 		b.WriteRune('\n')
@@ -289,3 +464,41 @@ func (l *List) View() string {
 
 	return b.String()
 }
+
+// renderFilterStatus renders the filter line of a Filterable list:
+// "/query" while typing, "Filter: query" once applied, plus a match count.
+func (l *List) renderFilterStatus(colors style.ColorPalette) string {
+	prompt := "Filter: "
+	if l.filtering {
+		prompt = "/"
+	}
+	count := fmt.Sprintf("  %d/%d", len(l.domain.FilteredItems()), len(l.domain.Items()))
+
+	infoStyle := style.New().Foreground(colors.Info)
+	mutedStyle := style.New().Foreground(colors.TextMuted)
+	return style.Render(infoStyle, prompt+l.domain.FilterQuery()) + style.Render(mutedStyle, count)
+}
+
+// renderPageIndicator renders "Page 2/5" followed by one dot per page,
+// the current page highlighted. Dots are left out when there are too many
+// pages to fit on a line.
+func (l *List) renderPageIndicator(colors style.ColorPalette) string {
+	page, count := l.domain.Page(), l.domain.PageCount()
+
+	mutedStyle := style.New().Foreground(colors.TextMuted)
+	indicator := style.Render(mutedStyle, fmt.Sprintf("Page %d/%d", page+1, count))
+	if count > maxPageDots {
+		return indicator
+	}
+
+	activeStyle := style.New().Foreground(colors.Primary)
+	var dots strings.Builder
+	for i := 0; i < count; i++ {
+		if i == page {
+			dots.WriteString(style.Render(activeStyle, "●"))
+		} else {
+			dots.WriteString(style.Render(mutedStyle, "○"))
+		}
+	}
+	return indicator + "  " + dots.String()
+}
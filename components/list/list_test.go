@@ -1,6 +1,7 @@
 package list
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -533,3 +534,221 @@ func TestList_FocusedItem_ReturnsValue(t *testing.T) {
 		t.Errorf("FocusedItem() = %v, want 'banana'", focused)
 	}
 }
+
+// typeKeys sends each rune of s as a key press.
+func typeKeys(l *List, s string) *List {
+	for _, r := range s {
+		l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	return l
+}
+
+func TestList_Filterable(t *testing.T) {
+	values := []interface{}{"main.go", "README.md", "Makefile", "go.mod"}
+	labels := []string{"main.go", "README.md", "Makefile", "go.mod"}
+	l := NewSingleSelect(values, labels).Filterable(true)
+
+	// Without "/" runes are key bindings, not filter input.
+	l = typeKeys(l, "j")
+	if l.FilterValue() != "" || l.FocusedIndex() != 1 {
+		t.Fatalf("j before /: FilterValue() = %q, FocusedIndex() = %d", l.FilterValue(), l.FocusedIndex())
+	}
+
+	l = typeKeys(l, "/MA")
+	if !l.IsFiltering() {
+		t.Error("IsFiltering() should be true after /")
+	}
+	if l.FilterValue() != "MA" {
+		t.Errorf("FilterValue() = %q, want MA", l.FilterValue())
+	}
+	visible := l.VisibleItems()
+	if len(visible) != 2 || visible[0] != "main.go" || visible[1] != "Makefile" {
+		t.Errorf("VisibleItems() = %v, want [main.go Makefile]", visible)
+	}
+
+	// Arrows navigate over the filtered subset; j/k are typed.
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if l.FocusedItem() != "Makefile" {
+		t.Errorf("FocusedItem() = %v, want Makefile", l.FocusedItem())
+	}
+
+	view := l.View()
+	if !strings.Contains(view, "/MA") || !strings.Contains(view, "2/4") {
+		t.Errorf("View() should show query and count, got:\n%s", view)
+	}
+
+	// Backspace removes one rune, Enter keeps the filter.
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if l.IsFiltering() || l.FilterValue() != "M" {
+		t.Errorf("after Enter: IsFiltering() = %v, FilterValue() = %q", l.IsFiltering(), l.FilterValue())
+	}
+
+	// Esc clears the filter.
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if l.FilterValue() != "" || len(l.VisibleItems()) != 4 {
+		t.Errorf("after Esc: FilterValue() = %q, %d visible", l.FilterValue(), len(l.VisibleItems()))
+	}
+}
+
+func TestList_Filterable_UnicodeAndHighlight(t *testing.T) {
+	values := []interface{}{1, 2}
+	labels := []string{"Привет", "мир"}
+	l := NewSingleSelect(values, labels).Filterable(true)
+
+	l = typeKeys(l, "/ПРИ")
+	if got := l.VisibleItems(); len(got) != 1 || got[0] != 1 {
+		t.Errorf("VisibleItems() = %v, want [1]", got)
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if l.FilterValue() != "ПР" {
+		t.Errorf("Backspace should remove a whole rune, FilterValue() = %q", l.FilterValue())
+	}
+
+	if !strings.Contains(l.View(), "вет") {
+		t.Errorf("View() should keep the unmatched part of the label:\n%s", l.View())
+	}
+}
+
+func TestList_Filterable_Disabled(t *testing.T) {
+	values := []interface{}{1, 2}
+	labels := []string{"a", "b"}
+	l := NewSingleSelect(values, labels)
+
+	l = typeKeys(l, "/a")
+	if l.IsFiltering() || l.FilterValue() != "" {
+		t.Error("/ should do nothing unless Filterable(true)")
+	}
+}
+
+func TestList_Columns(t *testing.T) {
+	values := []interface{}{"a", "b", "c", "d"}
+	l := NewMultiSelect(values, []string{"a", "b", "c", "d"}).Columns(2)
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRight})
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if l.FocusedItem() != "d" {
+		t.Errorf("FocusedItem() = %v, want d", l.FocusedItem())
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if selected := l.SelectedItems(); len(selected) != 1 || selected[0] != "d" {
+		t.Errorf("SelectedItems() = %v, want [d]", selected)
+	}
+
+	lines := strings.Split(l.View(), "\n")
+	if len(lines) != 2 {
+		t.Errorf("View() has %d lines, want 2 rows:\n%s", len(lines), l.View())
+	}
+}
+
+func TestList_Paginated(t *testing.T) {
+	values := make([]interface{}, 12)
+	labels := make([]string, 12)
+	for i := range values {
+		values[i] = i
+		labels[i] = fmt.Sprintf("item %02d", i)
+	}
+	l := NewSingleSelect(values, labels).Height(5).Paginated(true)
+
+	if l.CurrentPage() != 0 || l.PageCount() != 3 {
+		t.Fatalf("CurrentPage() = %d, PageCount() = %d, want 0, 3", l.CurrentPage(), l.PageCount())
+	}
+	if !strings.Contains(l.View(), "Page 1/3") {
+		t.Errorf("View() missing page indicator:\n%s", l.View())
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyDown})
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if l.FocusedItem() != 6 || l.CurrentPage() != 1 {
+		t.Errorf("after PgDn: focused %v on page %d, want 6 on page 1", l.FocusedItem(), l.CurrentPage())
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRight})
+	view := l.View()
+	if l.FocusedItem() != 11 || !strings.Contains(view, "Page 3/3") {
+		t.Errorf("after Right: focused %v, want 11 and \"Page 3/3\":\n%s", l.FocusedItem(), view)
+	}
+	if strings.Contains(view, "item 00") || !strings.Contains(view, "item 10") {
+		t.Errorf("View() should only show the last page:\n%s", view)
+	}
+	if lines := strings.Split(view, "\n"); len(lines) != 3 {
+		t.Errorf("View() has %d lines, want 2 items + indicator", len(lines))
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	if l.FocusedItem() != 1 {
+		t.Errorf("after Left, PgUp: focused %v, want 1", l.FocusedItem())
+	}
+}
+
+func TestList_Paginated_Filter(t *testing.T) {
+	values := []interface{}{"apple", "avocado", "banana", "apricot", "blueberry"}
+	l := NewSingleSelect(values, []string{"apple", "avocado", "banana", "apricot", "blueberry"}).
+		Height(2).
+		Paginated(true).
+		Filterable(true)
+
+	if l.PageCount() != 3 {
+		t.Fatalf("PageCount() = %d, want 3", l.PageCount())
+	}
+
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeyRune, Rune: '/'},
+		{Type: tea.KeyRune, Rune: 'b'},
+	} {
+		l, _ = l.Update(msg)
+	}
+	if l.PageCount() != 1 || strings.Contains(l.View(), "○") {
+		t.Errorf("PageCount() = %d after filtering, want 1 page and one dot:\n%s", l.PageCount(), l.View())
+	}
+}
+
+func TestList_Paginated_ManyPages(t *testing.T) {
+	values := make([]interface{}, 30)
+	labels := make([]string, 30)
+	for i := range values {
+		values[i] = i
+		labels[i] = fmt.Sprint(i)
+	}
+	l := NewSingleSelect(values, labels).Height(1).Paginated(true)
+
+	view := l.View()
+	if !strings.Contains(view, "Page 1/30") || strings.Contains(view, "●") {
+		t.Errorf("View() with many pages should show the count without dots:\n%s", view)
+	}
+}
+
+func TestList_SectionedItems(t *testing.T) {
+	values := []interface{}{"apple", "pear", "carrot"}
+	l := NewSingleSelect(values, []string{"apple", "pear", "carrot"}).
+		SectionedItems([]string{"Fruit", "Fruit", "Vegetables"})
+
+	if l.CurrentSection() != "Fruit" {
+		t.Errorf("CurrentSection() = %q, want Fruit", l.CurrentSection())
+	}
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyDown})
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if l.FocusedItem() != "carrot" || l.CurrentSection() != "Vegetables" {
+		t.Errorf("focused %v in %q, want carrot in Vegetables", l.FocusedItem(), l.CurrentSection())
+	}
+
+	view := l.View()
+	if !strings.Contains(view, "Fruit") || !strings.Contains(view, "Vegetables") {
+		t.Errorf("View() should contain section headers:\n%s", view)
+	}
+	if lines := strings.Split(view, "\n"); len(lines) != 5 {
+		t.Errorf("View() has %d lines, want 3 items + 2 headers", len(lines))
+	}
+}
+
+func TestList_SectionedItems_LengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SectionedItems() should panic on length mismatch")
+		}
+	}()
+	NewSingleSelect([]interface{}{1, 2}, []string{"a", "b"}).SectionedItems([]string{"A"})
+}
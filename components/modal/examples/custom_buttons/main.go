@@ -4,6 +4,7 @@
 //   - Modal with multiple custom buttons.
 //   - Different button actions.
 //   - Handling multiple action types.
+//   - Tab cycling between buttons with a highlighted focused button.
 //   - Real-world use case (save/discard/cancel)
 //
 // Run: go run main.go.
@@ -118,7 +119,10 @@ Status: `
 
 func main() {
 	// Create modal with custom buttons.
-	m := modal.NewWithTitle("Unsaved Changes", "You have unsaved changes. What would you like to do?").
+	// Tab/Shift+Tab (or ←/→) move between the buttons and Enter activates
+	// the focused one; the S/D/C shortcuts work as well.
+	m := modal.NewWithTitle("Unsaved Changes",
+		"You have unsaved changes. What would you like to do?\n\nTab: next button  Enter: choose").
 		Size(60, 10).
 		Buttons([]modal.Button{
 			{Label: "Save", Key: "s", Action: "save"},
@@ -4,6 +4,7 @@
 //   - Modal with multi-line content.
 //   - Larger modal size for documentation.
 //   - No buttons (Esc to close)
+//   - Scrollable content (↑/↓, PgUp/PgDn, mouse wheel) when the help is longer than the modal.
 //   - Typical help screen use case.
 //
 // Run: go run main.go.
@@ -93,11 +94,13 @@ Search:
   Ctrl+R     Replace
   Ctrl+G     Go to Line
 
+Use ↑/↓ or PgUp/PgDn to scroll.
 Press Esc to close this help screen.`
 
 	// Create help modal.
 	m := modal.NewWithTitle("Help", helpText).
 		Size(60, 20).
+		Scrollable(true).
 		DimBackground(true)
 
 	// Create program.
@@ -193,6 +193,25 @@ func (m *Modal) FocusPreviousButton() *Modal {
 	}
 }
 
+// WithFocusedButton returns a new modal with the button at index focused.
+// Out-of-range indices are ignored.
+func (m *Modal) WithFocusedButton(index int) *Modal {
+	if index < 0 || index >= len(m.buttons) {
+		return m
+	}
+
+	return &Modal{
+		title:         m.title,
+		content:       m.content,
+		buttons:       m.buttons,
+		size:          m.size,
+		position:      m.position,
+		focusedButton: index,
+		visible:       m.visible,
+		dimBackground: m.dimBackground,
+	}
+}
+
 // FocusedButton returns the currently focused button (nil if no buttons).
 func (m *Modal) FocusedButton() *Button {
 	if len(m.buttons) == 0 || m.focusedButton < 0 || m.focusedButton >= len(m.buttons) {
@@ -203,6 +203,30 @@ func TestModalFocusNextButton(t *testing.T) {
 	}
 }
 
+func TestModalWithFocusedButton(t *testing.T) {
+	buttons := []*Button{
+		NewButton("First", "1", "first"),
+		NewButton("Second", "2", "second"),
+	}
+	modal := NewModal("Content").WithButtons(buttons)
+
+	focused := modal.WithFocusedButton(1)
+	if focused.FocusedButtonIndex() != 1 {
+		t.Errorf("Expected focus 1, got %d", focused.FocusedButtonIndex())
+	}
+	if modal.FocusedButtonIndex() != 0 {
+		t.Errorf("Original modal should keep focus 0, got %d", modal.FocusedButtonIndex())
+	}
+
+	// Out-of-range indices are ignored.
+	if got := focused.WithFocusedButton(5).FocusedButtonIndex(); got != 1 {
+		t.Errorf("Expected focus to stay 1, got %d", got)
+	}
+	if got := focused.WithFocusedButton(-1).FocusedButtonIndex(); got != 1 {
+		t.Errorf("Expected focus to stay 1, got %d", got)
+	}
+}
+
 func TestModalFocusPreviousButton(t *testing.T) {
 	buttons := []*Button{
 		NewButton("First", "1", "first"),
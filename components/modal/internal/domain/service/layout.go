@@ -40,8 +40,34 @@ func (s *LayoutService) CenterPosition(terminalWidth, terminalHeight, modalWidth
 	return x, y
 }
 
+// AnchoredPosition calculates the position of a modal aligned to a screen
+// edge or corner. Along the axis an edge anchor doesn't fix (e.g. x for
+// AnchorTop) the modal is centered.
+//
+// Returns the x, y coordinates for the top-left corner, never negative.
+func (s *LayoutService) AnchoredPosition(anchor value.Anchor, terminalWidth, terminalHeight, modalWidth, modalHeight int) (x, y int) {
+	x, y = s.CenterPosition(terminalWidth, terminalHeight, modalWidth, modalHeight)
+
+	switch anchor {
+	case value.AnchorLeft, value.AnchorTopLeft, value.AnchorBottomLeft:
+		x = 0
+	case value.AnchorRight, value.AnchorTopRight, value.AnchorBottomRight:
+		x = max(terminalWidth-modalWidth, 0)
+	}
+
+	switch anchor {
+	case value.AnchorTop, value.AnchorTopLeft, value.AnchorTopRight:
+		y = 0
+	case value.AnchorBottom, value.AnchorBottomLeft, value.AnchorBottomRight:
+		y = max(terminalHeight-modalHeight, 0)
+	}
+
+	return x, y
+}
+
 // CalculatePosition calculates the actual position for a modal based on its position value.
 // If the position is centered, calculates the center coordinates.
+// If the position is anchored, aligns the modal to the edge or corner.
 // If the position is custom, returns the custom coordinates as-is.
 //
 // Parameters:
@@ -56,6 +82,9 @@ func (s *LayoutService) CalculatePosition(position *value.Position, terminalWidt
 	if position.IsCenter() {
 		return s.CenterPosition(terminalWidth, terminalHeight, modalWidth, modalHeight)
 	}
+	if position.Anchor() != value.AnchorNone {
+		return s.AnchoredPosition(position.Anchor(), terminalWidth, terminalHeight, modalWidth, modalHeight)
+	}
 
 	// Custom position - return as-is (may be negative or out of bounds)
 	return position.X(), position.Y()
@@ -144,3 +144,37 @@ func TestCalculatePositionCustomOutOfBounds(t *testing.T) {
 		t.Errorf("Expected position (100, 50), got (%d, %d)", x, y)
 	}
 }
+
+func TestCalculatePositionAnchored(t *testing.T) {
+	service := NewLayoutService()
+
+	tests := []struct {
+		anchor value.Anchor
+		x, y   int
+	}{
+		{value.AnchorTop, 20, 0},
+		{value.AnchorBottom, 20, 14},
+		{value.AnchorLeft, 0, 7},
+		{value.AnchorRight, 40, 7},
+		{value.AnchorTopLeft, 0, 0},
+		{value.AnchorTopRight, 40, 0},
+		{value.AnchorBottomLeft, 0, 14},
+		{value.AnchorBottomRight, 40, 14},
+	}
+
+	for _, tt := range tests {
+		x, y := service.CalculatePosition(value.NewPositionAnchored(tt.anchor), 80, 24, 40, 10)
+		if x != tt.x || y != tt.y {
+			t.Errorf("anchor %d: expected (%d, %d), got (%d, %d)", tt.anchor, tt.x, tt.y, x, y)
+		}
+	}
+}
+
+func TestAnchoredPositionModalLargerThanTerminal(t *testing.T) {
+	service := NewLayoutService()
+
+	x, y := service.AnchoredPosition(value.AnchorBottomRight, 30, 8, 40, 10)
+	if x != 0 || y != 0 {
+		t.Errorf("Expected (0, 0), got (%d, %d)", x, y)
+	}
+}
@@ -1,12 +1,32 @@
 // Package value contains value objects for the modal component.
 package value
 
+// Anchor is a screen edge, corner or the center that a modal is aligned to.
+type Anchor int
+
+// Anchor constants.
+const (
+	// AnchorNone means the modal is placed at custom x, y coordinates.
+	AnchorNone Anchor = iota
+	AnchorCenter
+	AnchorTop
+	AnchorBottom
+	AnchorLeft
+	AnchorRight
+	AnchorTopLeft
+	AnchorTopRight
+	AnchorBottomLeft
+	AnchorBottomRight
+)
+
 // Position represents modal position on screen.
-// Positions can be either centered (automatic) or custom (specific x, y coordinates).
+// Positions can be centered (automatic), anchored to a screen edge or
+// corner, or custom (specific x, y coordinates).
 type Position struct {
-	x      int  // X coordinate (or -1 for center)
-	y      int  // Y coordinate (or -1 for center)
-	center bool // Auto-center?
+	x      int    // X coordinate (or -1 for center and anchors)
+	y      int    // Y coordinate (or -1 for center and anchors)
+	center bool   // Auto-center?
+	anchor Anchor // AnchorNone for custom coordinates
 }
 
 // NewPositionCenter creates a position that auto-centers the modal.
@@ -15,6 +35,22 @@ func NewPositionCenter() *Position {
 		x:      -1,
 		y:      -1,
 		center: true,
+		anchor: AnchorCenter,
+	}
+}
+
+// NewPositionAnchored creates a position aligned to the given screen edge or
+// corner. AnchorCenter is the same as NewPositionCenter and AnchorNone
+// places the modal at (0, 0).
+func NewPositionAnchored(anchor Anchor) *Position {
+	if anchor == AnchorNone {
+		return NewPositionCustom(0, 0)
+	}
+	return &Position{
+		x:      -1,
+		y:      -1,
+		center: anchor == AnchorCenter,
+		anchor: anchor,
 	}
 }
 
@@ -41,3 +77,8 @@ func (p *Position) Y() int {
 func (p *Position) IsCenter() bool {
 	return p.center
 }
+
+// Anchor returns the anchor of the position (AnchorNone if custom).
+func (p *Position) Anchor() Anchor {
+	return p.anchor
+}
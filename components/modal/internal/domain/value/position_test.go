@@ -60,3 +60,24 @@ func TestPositionNegativeCustomCoordinates(t *testing.T) {
 		t.Errorf("Expected Y to be -10, got %d", pos.Y())
 	}
 }
+
+func TestNewPositionAnchored(t *testing.T) {
+	pos := NewPositionAnchored(AnchorTopRight)
+
+	if pos.IsCenter() {
+		t.Error("Expected anchored position to not be centered")
+	}
+	if pos.Anchor() != AnchorTopRight {
+		t.Errorf("Expected AnchorTopRight, got %d", pos.Anchor())
+	}
+
+	if !NewPositionAnchored(AnchorCenter).IsCenter() {
+		t.Error("Expected AnchorCenter to be centered")
+	}
+	if custom := NewPositionAnchored(AnchorNone); custom.Anchor() != AnchorNone || custom.X() != 0 || custom.Y() != 0 {
+		t.Error("Expected AnchorNone to be a custom position at (0, 0)")
+	}
+	if NewPositionCustom(3, 4).Anchor() != AnchorNone {
+		t.Error("Expected custom position to have no anchor")
+	}
+}
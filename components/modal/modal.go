@@ -4,9 +4,10 @@
 //   - Overlay rendering (centered or custom positioning)
 //   - Focus trap (modal captures all input when visible)
 //   - Keyboard dismiss (Esc to close)
-//   - Custom content (any string content)
+//   - Custom content (any string content, optionally scrollable)
 //   - Button support (optional action buttons)
 //   - Background dimming (improves UX)
+//   - Stacking (Stack routes input to the topmost of nested modals)
 //
 // This is a UNIVERSAL component - it works for any application (confirmation dialogs,.
 // help screens, settings panels, alerts, etc.). It does NOT include application-specific.
@@ -21,10 +22,16 @@ import (
 	"github.com/phoenix-tui/phoenix/components/modal/internal/domain/service"
 	"github.com/phoenix-tui/phoenix/components/modal/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/components/modal/internal/infrastructure"
+	"github.com/phoenix-tui/phoenix/components/viewport"
+	"github.com/phoenix-tui/phoenix/mouse"
 	"github.com/phoenix-tui/phoenix/style"
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
 
+// onScreen keeps modals fully visible using the mouse package's edge-safe
+// menu positioning.
+var onScreen = mouse.New()
+
 // Button defines a modal action button.
 //
 // Zero value: Button with zero value (empty strings) is valid but not useful.
@@ -52,7 +59,8 @@ type Modal struct {
 	keyBindings    infrastructure.KeyBindings
 	terminalWidth  int // Terminal size for rendering
 	terminalHeight int
-	theme          *style.Theme // Optional theme, defaults to DefaultTheme if nil
+	theme          *style.Theme       // Optional theme, defaults to DefaultTheme if nil
+	body           *viewport.Viewport // Scrollable content (nil = content is clipped)
 }
 
 // New creates a new modal with the given content.
@@ -80,17 +88,119 @@ func NewWithTitle(title, content string) *Modal {
 
 // Size returns a new modal with the specified size.
 func (m *Modal) Size(width, height int) *Modal {
-	return &Modal{
+	return (&Modal{
 		domain:         m.domain.WithSize(width, height),
 		layoutService:  m.layoutService,
 		keyBindings:    m.keyBindings,
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
+	}).resizeBody()
+}
+
+// Scrollable returns a new modal whose content scrolls when it is taller than
+// the modal: ↑/↓, PgUp/PgDn, Home/End and the mouse wheel scroll the body
+// while the title, border and buttons stay in place, and ▲/▼ on the right
+// border show that more content is above or below. Disabled by default
+// (overflowing content is clipped).
+func (m *Modal) Scrollable(enabled bool) *Modal {
+	var body *viewport.Viewport
+	if enabled {
+		body = viewport.NewWithContent(m.domain.Content(), 0, 0).MouseEnabled(true)
+	}
+	return (&Modal{
+		domain:         m.domain,
+		layoutService:  m.layoutService,
+		keyBindings:    m.keyBindings,
+		terminalWidth:  m.terminalWidth,
+		terminalHeight: m.terminalHeight,
+		theme:          m.theme,
+		body:           body,
+	}).resizeBody()
+}
+
+// IsScrollable returns true if the modal content scrolls.
+func (m *Modal) IsScrollable() bool {
+	return m.body != nil
+}
+
+// ScrollOffset returns the index of the first visible content line
+// (always 0 unless Scrollable).
+func (m *Modal) ScrollOffset() int {
+	if m.body == nil {
+		return 0
+	}
+	return m.body.ScrollOffset()
+}
+
+// resizeBody fits the scrollable body to the modal's content area.
+func (m *Modal) resizeBody() *Modal {
+	if m.body != nil {
+		m.body = m.body.SetSize(max(m.domain.Size().Width()-4, 0), m.contentHeight())
+	}
+	return m
+}
+
+// contentHeight returns the number of content rows inside the modal.
+func (m *Modal) contentHeight() int {
+	contentHeight := m.domain.Size().Height() - 4 // Reserve space for borders and buttons
+	if m.domain.Title() != "" {
+		contentHeight -= 2 // Reserve space for title and separator
+	}
+	return max(contentHeight, 0)
+}
+
+// Placement says where on screen a modal appears: centered, aligned to an
+// edge or corner, or at custom coordinates (At).
+//
+// Zero value: the zero Placement is At(0, 0).
+type Placement struct {
+	anchor value.Anchor
+	x, y   int
+}
+
+// Placements relative to the screen. Edge placements center the modal
+// along the edge, e.g. Bottom is bottom-center.
+var (
+	Center      = Placement{anchor: value.AnchorCenter}
+	Top         = Placement{anchor: value.AnchorTop}
+	Bottom      = Placement{anchor: value.AnchorBottom}
+	Left        = Placement{anchor: value.AnchorLeft}
+	Right       = Placement{anchor: value.AnchorRight}
+	TopLeft     = Placement{anchor: value.AnchorTopLeft}
+	TopRight    = Placement{anchor: value.AnchorTopRight}
+	BottomLeft  = Placement{anchor: value.AnchorBottomLeft}
+	BottomRight = Placement{anchor: value.AnchorBottomRight}
+)
+
+// At places the top-left corner of the modal at column x, row y.
+func At(x, y int) Placement {
+	return Placement{x: x, y: y}
+}
+
+// Place returns a new modal shown at the given placement, e.g. TopRight for
+// toast-style notifications or Bottom for confirmations. Whatever the
+// placement, the modal is kept fully on screen when it fits. Default: Center.
+func (m *Modal) Place(p Placement) *Modal {
+	position := value.NewPositionAnchored(p.anchor)
+	if p.anchor == value.AnchorNone {
+		position = value.NewPositionCustom(p.x, p.y)
+	}
+
+	return &Modal{
+		domain:         m.domain.WithPosition(position),
+		layoutService:  m.layoutService,
+		keyBindings:    m.keyBindings,
+		terminalWidth:  m.terminalWidth,
+		terminalHeight: m.terminalHeight,
+		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
 // Position returns a new modal with custom positioning.
+// It is the same as Place(At(x, y)).
 func (m *Modal) Position(x, y int) *Modal {
 	return &Modal{
 		domain:         m.domain.WithPosition(value.NewPositionCustom(x, y)),
@@ -99,10 +209,12 @@ func (m *Modal) Position(x, y int) *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
 // Centered returns a new modal with centered positioning (default).
+// It is the same as Place(Center).
 func (m *Modal) Centered() *Modal {
 	return &Modal{
 		domain:         m.domain.WithPosition(value.NewPositionCenter()),
@@ -111,6 +223,7 @@ func (m *Modal) Centered() *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -128,6 +241,7 @@ func (m *Modal) Buttons(buttons []Button) *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -140,6 +254,7 @@ func (m *Modal) DimBackground(dim bool) *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -152,6 +267,7 @@ func (m *Modal) Show() *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -164,6 +280,22 @@ func (m *Modal) Hide() *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
+	}
+}
+
+// FocusButton returns a new modal with the button at index focused, e.g. to
+// make "Cancel" the default of a destructive dialog. Out-of-range indices
+// are ignored.
+func (m *Modal) FocusButton(index int) *Modal {
+	return &Modal{
+		domain:         m.domain.WithFocusedButton(index),
+		layoutService:  m.layoutService,
+		keyBindings:    m.keyBindings,
+		terminalWidth:  m.terminalWidth,
+		terminalHeight: m.terminalHeight,
+		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -176,6 +308,7 @@ func (m *Modal) KeyBindings(kb infrastructure.KeyBindings) *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          m.theme,
+		body:           m.body,
 	}
 }
 
@@ -189,6 +322,7 @@ func (m *Modal) Theme(theme *style.Theme) *Modal {
 		terminalWidth:  m.terminalWidth,
 		terminalHeight: m.terminalHeight,
 		theme:          theme,
+		body:           m.body,
 	}
 }
 
@@ -214,15 +348,35 @@ func (m *Modal) Update(msg tea.Msg) (*Modal, tea.Cmd) {
 			terminalWidth:  msg.Width,
 			terminalHeight: msg.Height,
 			theme:          m.theme,
+			body:           m.body,
 		}, nil
 
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
+
+	case tea.MouseMsg:
+		if m.body != nil && (msg.Button == tea.MouseButtonWheelUp || msg.Button == tea.MouseButtonWheelDown) {
+			return m.updateBody(msg)
+		}
 	}
 
 	return m, nil
 }
 
+// updateBody forwards msg to the scrollable body.
+func (m *Modal) updateBody(msg tea.Msg) (*Modal, tea.Cmd) {
+	body, cmd := m.body.Update(msg)
+	return &Modal{
+		domain:         m.domain,
+		layoutService:  m.layoutService,
+		keyBindings:    m.keyBindings,
+		terminalWidth:  m.terminalWidth,
+		terminalHeight: m.terminalHeight,
+		theme:          m.theme,
+		body:           body,
+	}, cmd
+}
+
 // handleKeyPress processes keyboard input.
 func (m *Modal) handleKeyPress(msg tea.KeyMsg) (*Modal, tea.Cmd) {
 	kb := m.keyBindings
@@ -241,6 +395,7 @@ func (m *Modal) handleKeyPress(msg tea.KeyMsg) (*Modal, tea.Cmd) {
 			terminalWidth:  m.terminalWidth,
 			terminalHeight: m.terminalHeight,
 			theme:          m.theme,
+			body:           m.body,
 		}, nil
 	}
 
@@ -252,6 +407,7 @@ func (m *Modal) handleKeyPress(msg tea.KeyMsg) (*Modal, tea.Cmd) {
 			terminalWidth:  m.terminalWidth,
 			terminalHeight: m.terminalHeight,
 			theme:          m.theme,
+			body:           m.body,
 		}, nil
 	}
 
@@ -276,6 +432,11 @@ func (m *Modal) handleKeyPress(msg tea.KeyMsg) (*Modal, tea.Cmd) {
 		}
 	}
 
+	// Remaining keys scroll the content.
+	if m.body != nil {
+		return m.updateBody(msg)
+	}
+
 	return m, nil
 }
 
@@ -288,28 +449,34 @@ func (m *Modal) View() string {
 
 	var b strings.Builder
 
-	// Calculate modal position.
-	modalWidth := m.domain.Size().Width()
-	modalHeight := m.domain.Size().Height()
-	x, y := m.layoutService.CalculatePosition(
-		m.domain.Position(),
-		m.terminalWidth,
-		m.terminalHeight,
-		modalWidth,
-		modalHeight,
-	)
-
 	// Render dimmed background (if enabled)
 	if m.domain.DimBackground() {
-		b.WriteString(m.renderDimmedBackground(x, y, modalWidth, modalHeight))
+		b.WriteString(m.renderDimmedBackground(m.bounds()))
 	}
 
 	// Render modal box.
-	b.WriteString(m.renderModalBox(x, y, modalWidth, modalHeight))
+	b.WriteString(m.renderModalBox(m.bounds()))
 
 	return b.String()
 }
 
+// bounds returns the modal's position and size on screen. The position is
+// shifted onto the screen the way context menus are, so that custom
+// coordinates near (or past) an edge don't cut the modal off.
+func (m *Modal) bounds() (x, y, width, height int) {
+	width = m.domain.Size().Width()
+	height = m.domain.Size().Height()
+	x, y = m.layoutService.CalculatePosition(
+		m.domain.Position(),
+		m.terminalWidth,
+		m.terminalHeight,
+		width,
+		height,
+	)
+	pos := onScreen.CalculateMenuPosition(mouse.NewPosition(max(x, 0), max(y, 0)), width, height, m.terminalWidth, m.terminalHeight)
+	return pos.X(), pos.Y(), width, height
+}
+
 // renderDimmedBackground renders the dimmed background overlay.
 func (m *Modal) renderDimmedBackground(modalX, modalY, modalWidth, modalHeight int) string {
 	var b strings.Builder
@@ -359,14 +526,14 @@ func (m *Modal) renderModalBox(x, y, width, height int) string {
 	}
 
 	contentLines := strings.Split(m.domain.Content(), "\n")
-	contentHeight := height - 4 // Reserve space for borders and buttons
-	if m.domain.Title() != "" {
-		contentHeight -= 2 // Reserve space for title and separator
+	contentHeight := m.contentHeight()
+	if m.body != nil {
+		contentLines = m.body.VisibleLines()
 	}
 
 	for i := 0; i < contentHeight && i < len(contentLines); i++ {
 		b.WriteString(fmt.Sprintf("\x1b[%d;%dH", contentStartY+i, x+1))
-		b.WriteString("│ " + m.padOrTruncate(contentLines[i], width-4) + " │\n")
+		b.WriteString("│ " + m.padOrTruncate(contentLines[i], width-4) + " " + m.scrollIndicator(i, contentHeight) + "\n")
 	}
 
 	// Buttons (if any)
@@ -387,6 +554,22 @@ func (m *Modal) renderModalBox(x, y, width, height int) string {
 	return b.String()
 }
 
+// scrollIndicator returns the right border of content row i: ▲ on the first
+// row when content is hidden above, ▼ on the last row when content is hidden
+// below, and │ otherwise.
+func (m *Modal) scrollIndicator(i, contentHeight int) string {
+	switch {
+	case m.body == nil:
+		return "│"
+	case i == 0 && m.body.CanScrollUp():
+		return "▲"
+	case i == contentHeight-1 && m.body.CanScrollDown():
+		return "▼"
+	default:
+		return "│"
+	}
+}
+
 // renderButtons renders the button row.
 func (m *Modal) renderButtons(availableWidth int) string {
 	buttons := m.domain.Buttons()
@@ -406,10 +589,40 @@ func (m *Modal) renderButtons(availableWidth int) string {
 	}
 
 	// Join buttons with spacing.
-	result := strings.Join(parts, "  ")
+	const separator = "  "
+	joined := strings.Join(parts, separator)
+	result := m.padOrTruncate(joined, availableWidth)
+	if focusedIndex < 0 || focusedIndex >= len(parts) {
+		return result
+	}
 
-	// Pad or truncate to fit available width.
-	return m.padOrTruncate(result, availableWidth)
+	// Highlight the focused button by its position, unless truncation cut
+	// into it; other buttons may have the same label.
+	start := 0
+	for _, part := range parts[:focusedIndex] {
+		start += len(part) + len(separator)
+	}
+	end := start + len(parts[focusedIndex])
+	kept := len(joined)
+	if kept > availableWidth {
+		kept = availableWidth
+		if availableWidth > 3 {
+			kept -= 3 // Room for the "..." padOrTruncate appends
+		}
+	}
+	if end > kept {
+		return result
+	}
+	return result[:start] + style.Render(m.focusStyle(), parts[focusedIndex]) + result[end:]
+}
+
+// focusStyle returns the style of the focused button.
+func (m *Modal) focusStyle() style.Style {
+	theme := m.theme
+	if theme == nil {
+		theme = style.DefaultTheme()
+	}
+	return style.New().Foreground(theme.Colors().Focus).Bold(true)
 }
 
 // padOrTruncate pads or truncates a string to the specified width.
@@ -428,9 +641,9 @@ func (m *Modal) IsVisible() bool {
 	return m.domain.IsVisible()
 }
 
-// FocusedButton returns the action of the currently focused button.
+// FocusedAction returns the action of the currently focused button.
 // Returns empty string if no button is focused.
-func (m *Modal) FocusedButton() string {
+func (m *Modal) FocusedAction() string {
 	btn := m.domain.FocusedButton()
 	if btn == nil {
 		return ""
@@ -438,6 +651,16 @@ func (m *Modal) FocusedButton() string {
 	return btn.Action()
 }
 
+// FocusedButton returns the index of the focused button, or -1 if the
+// modal has no buttons. Tab/→ and Shift+Tab/← move the focus (wrapping
+// around) and Enter activates the focused button.
+func (m *Modal) FocusedButton() int {
+	if m.domain.FocusedButton() == nil {
+		return -1
+	}
+	return m.domain.FocusedButtonIndex()
+}
+
 // ButtonPressedMsg is sent when a button is activated.
 type ButtonPressedMsg struct {
 	Action string // Button action identifier
@@ -1,10 +1,12 @@
 package modal
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/modal/internal/infrastructure"
+	"github.com/phoenix-tui/phoenix/style"
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
 
@@ -66,6 +68,56 @@ func TestModalCentered(t *testing.T) {
 	}
 }
 
+func TestModalPlace(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement Placement
+		x, y      int
+	}{
+		{"Center", Center, 20, 7},
+		{"Top", Top, 20, 0},
+		{"Bottom", Bottom, 20, 14},
+		{"TopRight", TopRight, 40, 0},
+		{"BottomLeft", BottomLeft, 0, 14},
+		{"At", At(5, 3), 5, 3},
+		{"At near the right edge", At(70, 3), 40, 3},
+		{"At past the bottom edge", At(5, 30), 5, 14},
+		{"At negative", At(-5, -2), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modal := New("Content").Size(40, 10).Place(tt.placement)
+			x, y, _, _ := modal.bounds()
+			if x != tt.x || y != tt.y {
+				t.Errorf("bounds() = (%d, %d), want (%d, %d)", x, y, tt.x, tt.y)
+			}
+		})
+	}
+}
+
+func TestModalPlace_FollowsTerminalSize(t *testing.T) {
+	modal := New("Toast").Size(20, 3).Place(BottomRight).Show()
+	modal, _ = modal.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	x, y, _, _ := modal.bounds()
+	if x != 80 || y != 37 {
+		t.Errorf("bounds() = (%d, %d), want (80, 37)", x, y)
+	}
+	if !strings.Contains(modal.View(), "\x1b[38;81H") {
+		t.Error("View() should position the modal at row 38, column 81")
+	}
+}
+
+func TestModalPlace_DefaultIsCenter(t *testing.T) {
+	if !New("Content").domain.Position().IsCenter() {
+		t.Error("Modal should be centered by default")
+	}
+	if !New("Content").Position(1, 1).Place(Center).domain.Position().IsCenter() {
+		t.Error("Place(Center) should center the modal")
+	}
+}
+
 func TestModalButtons(t *testing.T) {
 	buttons := []Button{
 		{Label: "Yes", Key: "y", Action: "confirm"},
@@ -161,7 +213,7 @@ func TestModalUpdateKeyNextButton(t *testing.T) {
 	modal := New("Content").Buttons(buttons).Show()
 
 	// Initially focused on first button.
-	if modal.FocusedButton() != "confirm" {
+	if modal.FocusedAction() != "confirm" {
 		t.Error("Should focus on first button initially")
 	}
 
@@ -169,7 +221,7 @@ func TestModalUpdateKeyNextButton(t *testing.T) {
 	msg := tea.KeyMsg{Type: tea.KeyTab}
 	updated, _ := modal.Update(msg)
 
-	if updated.FocusedButton() != "cancel" {
+	if updated.FocusedAction() != "cancel" {
 		t.Error("Should focus on second button after Tab")
 	}
 }
@@ -185,11 +237,102 @@ func TestModalUpdateKeyPreviousButton(t *testing.T) {
 	msg := tea.KeyMsg{Type: tea.KeyTab, Shift: true}
 	updated, _ := modal.Update(msg)
 
-	if updated.FocusedButton() != "cancel" {
+	if updated.FocusedAction() != "cancel" {
 		t.Error("Should wrap to last button with Shift+Tab")
 	}
 }
 
+func TestModalTabCycling(t *testing.T) {
+	buttons := []Button{
+		{Label: "Save", Key: "s", Action: "save"},
+		{Label: "Discard", Key: "d", Action: "discard"},
+		{Label: "Cancel", Key: "c", Action: "cancel"},
+	}
+	modal := New("Content").Buttons(buttons).Show()
+
+	steps := []struct {
+		key  tea.KeyMsg
+		want int
+	}{
+		{tea.KeyMsg{Type: tea.KeyTab}, 1},
+		{tea.KeyMsg{Type: tea.KeyTab}, 2},
+		{tea.KeyMsg{Type: tea.KeyTab}, 0}, // wraps around
+		{tea.KeyMsg{Type: tea.KeyTab, Shift: true}, 2},
+		{tea.KeyMsg{Type: tea.KeyLeft}, 1},
+		{tea.KeyMsg{Type: tea.KeyRight}, 2},
+	}
+	for i, step := range steps {
+		var cmd tea.Cmd
+		modal, cmd = modal.Update(step.key)
+		if cmd != nil {
+			t.Errorf("step %d: navigation should not emit a command", i)
+		}
+		if modal.FocusedButton() != step.want {
+			t.Errorf("step %d (%s): FocusedButton() = %d, want %d", i, step.key, modal.FocusedButton(), step.want)
+		}
+	}
+
+	// Enter activates the focused button.
+	_, cmd := modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Enter should return a command")
+	}
+	if msg, ok := cmd().(ButtonPressedMsg); !ok || msg.Action != "cancel" {
+		t.Errorf("Enter produced %#v, want ButtonPressedMsg{cancel}", cmd())
+	}
+}
+
+func TestModalFocusButton(t *testing.T) {
+	buttons := []Button{
+		{Label: "Delete", Key: "d", Action: "delete"},
+		{Label: "Cancel", Key: "c", Action: "cancel"},
+	}
+	modal := New("Content").Buttons(buttons).FocusButton(1)
+
+	if modal.FocusedButton() != 1 || modal.FocusedAction() != "cancel" {
+		t.Errorf("FocusButton(1): index %d, action %q", modal.FocusedButton(), modal.FocusedAction())
+	}
+	if got := modal.FocusButton(9).FocusedButton(); got != 1 {
+		t.Errorf("out-of-range FocusButton should be ignored, got %d", got)
+	}
+	if got := New("Content").FocusedButton(); got != -1 {
+		t.Errorf("FocusedButton() without buttons = %d, want -1", got)
+	}
+}
+
+func TestModalViewHighlightsFocusedButton(t *testing.T) {
+	buttons := []Button{
+		{Label: "Yes", Key: "y", Action: "confirm"},
+		{Label: "No", Key: "n", Action: "cancel"},
+	}
+	modal := New("Content").Buttons(buttons).FocusButton(1).Show()
+
+	view := modal.View()
+	want := style.Render(modal.focusStyle(), "< No >")
+	if !strings.Contains(view, want) {
+		t.Errorf("View() should highlight the focused button, got %q", view)
+	}
+	if !strings.Contains(view, "[ Yes ]") {
+		t.Errorf("View() should render unfocused buttons plainly, got %q", view)
+	}
+}
+
+func TestModalViewHighlightsFocusedButtonByIndex(t *testing.T) {
+	// The first label renders as "[ < OK > ]", which contains the focused
+	// "< OK >" text; only the second button may be highlighted.
+	buttons := []Button{
+		{Label: "< OK >", Key: "a", Action: "first"},
+		{Label: "OK", Key: "b", Action: "second"},
+	}
+	modal := New("Content").Buttons(buttons).FocusButton(1).Show()
+
+	view := modal.View()
+	want := "[ < OK > ]  " + style.Render(modal.focusStyle(), "< OK >")
+	if !strings.Contains(view, want) {
+		t.Errorf("View() should highlight the second button, got %q", view)
+	}
+}
+
 func TestModalUpdateKeyActivateButton(t *testing.T) {
 	buttons := []Button{
 		{Label: "Yes", Key: "y", Action: "confirm"},
@@ -375,24 +518,108 @@ func TestModalImmutability(t *testing.T) {
 	}
 }
 
-func TestModalFocusedButtonNoButtons(t *testing.T) {
+func TestModalFocusedActionNoButtons(t *testing.T) {
 	modal := New("Content")
 
-	focused := modal.FocusedButton()
+	focused := modal.FocusedAction()
 	if focused != "" {
 		t.Error("Should return empty string when no buttons")
 	}
 }
 
-func TestModalFocusedButton(t *testing.T) {
+func TestModalFocusedAction(t *testing.T) {
 	buttons := []Button{
 		{Label: "Yes", Key: "y", Action: "confirm"},
 		{Label: "No", Key: "n", Action: "cancel"},
 	}
 	modal := New("Content").Buttons(buttons)
 
-	focused := modal.FocusedButton()
+	focused := modal.FocusedAction()
 	if focused != "confirm" {
 		t.Errorf("Expected focused button 'confirm', got '%s'", focused)
 	}
 }
+
+func createScrollableModal() *Modal {
+	lines := make([]string, 20)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %02d", i+1)
+	}
+	return NewWithTitle("Help", strings.Join(lines, "\n")).
+		Size(30, 10).
+		Buttons([]Button{{Label: "OK", Key: "o", Action: "ok"}}).
+		Scrollable(true).
+		Show()
+}
+
+func TestModalScrollable(t *testing.T) {
+	modal := createScrollableModal()
+
+	if !modal.IsScrollable() {
+		t.Fatal("IsScrollable() should be true")
+	}
+	view := modal.View()
+	if !strings.Contains(view, "line 04") || strings.Contains(view, "line 05") {
+		t.Errorf("View() should show the first 4 content lines, got %q", view)
+	}
+	if !strings.Contains(view, "▼") || strings.Contains(view, "▲") {
+		t.Errorf("View() should show only the ▼ indicator at the top, got %q", view)
+	}
+
+	// Arrow keys scroll the body; the buttons stay put.
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if modal.ScrollOffset() != 2 {
+		t.Errorf("ScrollOffset() = %d, want 2", modal.ScrollOffset())
+	}
+	view = modal.View()
+	if !strings.Contains(view, "line 03") || strings.Contains(view, "line 02") {
+		t.Errorf("View() after scrolling should start at line 03, got %q", view)
+	}
+	if !strings.Contains(view, "▲") || !strings.Contains(view, "< OK >") {
+		t.Errorf("View() should show ▲ and keep the buttons, got %q", view)
+	}
+
+	// End jumps to the bottom: no more ▼.
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	if view = modal.View(); !strings.Contains(view, "line 20") || strings.Contains(view, "▼") {
+		t.Errorf("View() at the bottom should show line 20 without ▼, got %q", view)
+	}
+}
+
+func TestModalScrollable_Wheel(t *testing.T) {
+	modal := createScrollableModal()
+
+	modal, _ = modal.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress})
+	if modal.ScrollOffset() == 0 {
+		t.Error("wheel down should scroll the body")
+	}
+	modal, _ = modal.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress})
+	if modal.ScrollOffset() != 0 {
+		t.Errorf("wheel up should scroll back, ScrollOffset() = %d", modal.ScrollOffset())
+	}
+}
+
+func TestModalScrollable_ShortcutsStillWork(t *testing.T) {
+	modal := createScrollableModal()
+
+	_, cmd := modal.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'o'})
+	if cmd == nil {
+		t.Fatal("button shortcut should take precedence over scrolling")
+	}
+	if msg, ok := cmd().(ButtonPressedMsg); !ok || msg.Action != "ok" {
+		t.Errorf("shortcut produced %#v, want ButtonPressedMsg{ok}", cmd())
+	}
+}
+
+func TestModalNotScrollable_Clips(t *testing.T) {
+	modal := createScrollableModal().Scrollable(false)
+
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if modal.IsScrollable() || modal.ScrollOffset() != 0 {
+		t.Error("non-scrollable modal should not scroll")
+	}
+	if view := modal.View(); strings.Contains(view, "line 05") || strings.Contains(view, "▼") {
+		t.Errorf("non-scrollable modal should clip without indicators, got %q", view)
+	}
+}
@@ -0,0 +1,149 @@
+package modal
+
+import (
+	"strings"
+
+	tea "github.com/phoenix-tui/phoenix/tea"
+)
+
+// ANSI sequences used to dim the modals below the top of a Stack.
+const (
+	sgrFaint = "\x1b[2m"
+	sgrReset = "\x1b[0m"
+)
+
+// Stack manages modals opened on top of each other, e.g. a confirmation
+// dialog over a settings dialog. Input goes to the topmost modal only, the
+// modals below it are drawn dimmed, and a modal that closes itself (Esc) is
+// popped so the one beneath becomes active again.
+//
+// Zero value: an empty Stack is valid and ready to use.
+//
+//	var s modal.Stack                        // Zero value - valid, empty
+//	s2 := modal.NewStack().Push(settings)    // Same, with one modal
+type Stack struct {
+	modals         []*Modal
+	terminalWidth  int // Last terminal size seen (0 = unknown)
+	terminalHeight int
+}
+
+// NewStack creates an empty modal stack.
+func NewStack() *Stack {
+	return &Stack{}
+}
+
+// Push returns a new stack with m shown on top. m receives the terminal size
+// the stack last saw, so it is positioned correctly right away.
+func (s *Stack) Push(m *Modal) *Stack {
+	m = m.Show()
+	if s.terminalWidth > 0 && s.terminalHeight > 0 {
+		m, _ = m.Update(tea.WindowSizeMsg{Width: s.terminalWidth, Height: s.terminalHeight})
+	}
+
+	modals := make([]*Modal, len(s.modals), len(s.modals)+1)
+	copy(modals, s.modals)
+	return s.withModals(append(modals, m))
+}
+
+// Pop returns a new stack without its top modal. Popping an empty stack
+// returns it unchanged.
+func (s *Stack) Pop() *Stack {
+	if len(s.modals) == 0 {
+		return s
+	}
+	return s.withModals(s.modals[:len(s.modals)-1])
+}
+
+// Top returns the topmost modal, or nil if the stack is empty.
+func (s *Stack) Top() *Modal {
+	if len(s.modals) == 0 {
+		return nil
+	}
+	return s.modals[len(s.modals)-1]
+}
+
+// Len returns the number of modals on the stack.
+func (s *Stack) Len() int {
+	return len(s.modals)
+}
+
+// IsEmpty returns true if no modal is open.
+func (s *Stack) IsEmpty() bool {
+	return len(s.modals) == 0
+}
+
+// Init implements tea.Model.
+func (s *Stack) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+//
+// Window size messages reach every modal; all other messages go to the top
+// modal only. If the top modal hides itself, it is popped.
+func (s *Stack) Update(msg tea.Msg) (*Stack, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		modals := make([]*Modal, len(s.modals))
+		for i, m := range s.modals {
+			modals[i], _ = m.Update(size)
+		}
+		newStack := s.withModals(modals)
+		newStack.terminalWidth = size.Width
+		newStack.terminalHeight = size.Height
+		return newStack, nil
+	}
+
+	top := s.Top()
+	if top == nil {
+		return s, nil
+	}
+
+	updated, cmd := top.Update(msg)
+	if !updated.IsVisible() {
+		return s.Pop(), cmd
+	}
+
+	modals := make([]*Modal, len(s.modals))
+	copy(modals, s.modals)
+	modals[len(modals)-1] = updated
+	return s.withModals(modals), cmd
+}
+
+// View implements tea.Model.
+//
+// The modals are drawn bottom to top over one dimmed background (if any of
+// them dims the background); every modal except the top one is drawn faint.
+func (s *Stack) View() string {
+	top := s.Top()
+	if top == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	for _, m := range s.modals {
+		if m.domain.DimBackground() {
+			b.WriteString(top.renderDimmedBackground(top.bounds()))
+			break
+		}
+	}
+
+	for _, m := range s.modals[:len(s.modals)-1] {
+		// Re-apply faint after every reset inside the box (e.g. button styles).
+		box := strings.ReplaceAll(m.renderModalBox(m.bounds()), sgrReset, sgrReset+sgrFaint)
+		b.WriteString(sgrFaint + box + sgrReset)
+	}
+	b.WriteString(top.renderModalBox(top.bounds()))
+
+	return b.String()
+}
+
+// withModals returns a new stack with the given modals and the same
+// terminal size.
+func (s *Stack) withModals(modals []*Modal) *Stack {
+	return &Stack{
+		modals:         modals,
+		terminalWidth:  s.terminalWidth,
+		terminalHeight: s.terminalHeight,
+	}
+}
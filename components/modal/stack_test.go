@@ -0,0 +1,110 @@
+package modal
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/phoenix-tui/phoenix/tea"
+)
+
+func TestStack_PushPopTop(t *testing.T) {
+	var empty Stack
+	if !empty.IsEmpty() || empty.Top() != nil || empty.View() != "" {
+		t.Fatal("zero value Stack should be empty")
+	}
+	if empty.Pop().Len() != 0 {
+		t.Error("Pop() on an empty stack should be a no-op")
+	}
+
+	settings := NewWithTitle("Settings", "settings")
+	confirm := NewWithTitle("Confirm", "sure?")
+
+	s := NewStack().Push(settings)
+	s2 := s.Push(confirm)
+
+	if s2.Len() != 2 || s.Len() != 1 {
+		t.Errorf("Len() = %d (original %d), want 2 (1)", s2.Len(), s.Len())
+	}
+	if !s2.Top().IsVisible() || s2.Top().domain.Title() != "Confirm" {
+		t.Error("Push() should show the modal on top")
+	}
+	if s2.Pop().Top().domain.Title() != "Settings" {
+		t.Error("Pop() should reveal the modal below")
+	}
+}
+
+func TestStack_RoutesInputToTop(t *testing.T) {
+	buttons := []Button{
+		{Label: "Yes", Key: "y", Action: "yes"},
+		{Label: "No", Key: "n", Action: "no"},
+	}
+	s := NewStack().
+		Push(New("bottom").Buttons(buttons)).
+		Push(New("top").Buttons(buttons))
+
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	if got := s.Top().FocusedButton(); got != 1 {
+		t.Errorf("top FocusedButton() = %d, want 1", got)
+	}
+	if got := s.modals[0].FocusedButton(); got != 0 {
+		t.Errorf("bottom modal should not receive input, FocusedButton() = %d", got)
+	}
+
+	_, cmd := s.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Enter should activate the top modal's focused button")
+	}
+	if msg, ok := cmd().(ButtonPressedMsg); !ok || msg.Action != "no" {
+		t.Errorf("got %#v, want ButtonPressedMsg{no}", cmd())
+	}
+}
+
+func TestStack_PopsOnClose(t *testing.T) {
+	s := NewStack().Push(New("bottom")).Push(New("top"))
+
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if s.Len() != 1 || s.Top().domain.Content() != "bottom" {
+		t.Fatalf("Esc should pop the top modal, Len() = %d", s.Len())
+	}
+
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !s.IsEmpty() {
+		t.Error("Esc on the last modal should empty the stack")
+	}
+}
+
+func TestStack_WindowSize(t *testing.T) {
+	s := NewStack().Push(New("bottom"))
+	s, _ = s.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	s = s.Push(New("top"))
+
+	for i, m := range s.modals {
+		if m.terminalWidth != 120 || m.terminalHeight != 40 {
+			t.Errorf("modal %d terminal size = %dx%d, want 120x40", i, m.terminalWidth, m.terminalHeight)
+		}
+	}
+}
+
+func TestStack_View(t *testing.T) {
+	s := NewStack().
+		Push(New("bottom").DimBackground(true)).
+		Push(New("top").Size(20, 6))
+
+	view := s.View()
+
+	if strings.Count(view, "░") == 0 {
+		t.Error("View() should draw one dimmed background when a layer dims it")
+	}
+	bottom := strings.Index(view, "bottom")
+	top := strings.Index(view, "top")
+	if bottom < 0 || top < 0 || bottom > top {
+		t.Fatalf("View() should draw the lower modal before the top one, got %q", view)
+	}
+	if faint := strings.LastIndex(view[:bottom], sgrFaint); faint < 0 {
+		t.Error("lower modal should be drawn faint")
+	}
+	if strings.Contains(view[strings.LastIndex(view, sgrReset):], sgrFaint) {
+		t.Error("top modal should not be drawn faint")
+	}
+}
@@ -155,32 +155,72 @@ func (m *MultiSelect[T]) Toggle() *MultiSelect[T] {
 }
 
 // SelectAll selects all filtered options (respecting max constraint).
+// Existing selections are kept; when max is reached, the remaining filtered
+// options stay unselected.
 func (m *MultiSelect[T]) SelectAll() *MultiSelect[T] {
-	// Build list of indices for filtered options
-	indices := make([]int, 0, len(m.filteredOpts))
-	for _, filteredOpt := range m.filteredOpts {
-		for i, opt := range m.options {
-			if opt == filteredOpt {
-				indices = append(indices, i)
-				break
-			}
+	// Start with current selection and add all filtered indices
+	newSelection := m.selection
+	for _, idx := range m.filteredIndices() {
+		if !newSelection.IsSelected(idx) && newSelection.CanSelect() {
+			newSelection = newSelection.Toggle(idx)
 		}
 	}
 
-	// Start with current selection and add all filtered indices
+	return m.withSelection(newSelection)
+}
+
+// SelectNone deselects all filtered options. Selections hidden by the
+// filter are kept; without a filter this clears the whole selection.
+func (m *MultiSelect[T]) SelectNone() *MultiSelect[T] {
+	if !m.IsFiltered() {
+		return m.withSelection(m.selection.Clear())
+	}
+
+	newSelection := m.selection
+	for _, idx := range m.filteredIndices() {
+		if newSelection.IsSelected(idx) {
+			newSelection = newSelection.Toggle(idx)
+		}
+	}
+
+	return m.withSelection(newSelection)
+}
+
+// InvertSelection inverts the selection of the filtered options: selected
+// options are deselected and the others selected, in order, until max is
+// reached. Selections hidden by the filter are kept.
+func (m *MultiSelect[T]) InvertSelection() *MultiSelect[T] {
+	indices := m.filteredIndices()
+
+	// Deselect first so the freed slots count towards max
 	newSelection := m.selection
+	toSelect := make([]int, 0, len(indices))
 	for _, idx := range indices {
-		if !newSelection.IsSelected(idx) && newSelection.CanSelect() {
+		if newSelection.IsSelected(idx) {
 			newSelection = newSelection.Toggle(idx)
+		} else {
+			toSelect = append(toSelect, idx)
+		}
+	}
+	for _, idx := range toSelect {
+		if !newSelection.CanSelect() {
+			break
 		}
+		newSelection = newSelection.Toggle(idx)
 	}
 
 	return m.withSelection(newSelection)
 }
 
-// SelectNone clears all selections.
-func (m *MultiSelect[T]) SelectNone() *MultiSelect[T] {
-	return m.withSelection(m.selection.Clear())
+// FilteredSelectionCount returns the number of selected filtered options.
+func (m *MultiSelect[T]) FilteredSelectionCount() int {
+	count := 0
+	for _, idx := range m.filteredIndices() {
+		if m.selection.IsSelected(idx) {
+			count++
+		}
+	}
+	return count
 }
 
 // SetFilterQuery sets the filter query and updates filtered options.
@@ -231,6 +271,19 @@ func (m *MultiSelect[T]) SelectedValues() []T {
 	return result
 }
 
+// SelectedValuesInOrder returns the currently selected values in the order
+// they were selected.
+func (m *MultiSelect[T]) SelectedValuesInOrder() []T {
+	indices := m.selection.Order()
+	result := make([]T, 0, len(indices))
+	for _, idx := range indices {
+		if idx >= 0 && idx < len(m.options) {
+			result = append(result, m.options[idx].Value())
+		}
+	}
+	return result
+}
+
 // SelectedIndices returns the currently selected indices.
 func (m *MultiSelect[T]) SelectedIndices() []int {
 	return m.selection.Indices()
@@ -300,6 +353,20 @@ func (m *MultiSelect[T]) FilteredCount() int {
 	return len(m.filteredOpts)
 }
 
+// filteredIndices returns the indices in options of the filtered options.
+func (m *MultiSelect[T]) filteredIndices() []int {
+	indices := make([]int, 0, len(m.filteredOpts))
+	for _, filteredOpt := range m.filteredOpts {
+		for i, opt := range m.options {
+			if opt == filteredOpt {
+				indices = append(indices, i)
+				break
+			}
+		}
+	}
+	return indices
+}
+
 // withCursor returns a new MultiSelect with the specified cursor position.
 func (m *MultiSelect[T]) withCursor(cursor int) *MultiSelect[T] {
 	return &MultiSelect[T]{
@@ -363,8 +430,8 @@ func (m *MultiSelect[T]) adjustScrollOffset() *MultiSelect[T] {
 
 // WithSelectionConstraints returns a new MultiSelect with updated min/max constraints.
 func (m *MultiSelect[T]) WithSelectionConstraints(minCount, maxCount int) *MultiSelect[T] {
-	// Get current selection indices
-	currentIndices := m.selection.Indices()
+	// Get current selection indices, keeping the pick order
+	currentIndices := m.selection.Order()
 
 	// Create new selection with new constraints and preserve selections
 	newSelection := value.NewSelection(minCount, maxCount).WithSelected(currentIndices...)
@@ -342,6 +342,60 @@ func TestMultiSelect_SelectAll_WithFilter(t *testing.T) {
 	}
 }
 
+func TestMultiSelect_SelectNone_WithFilter(t *testing.T) {
+	m := New(makeOptions(), 0, 0).
+		WithSelected(0, 1, 2).
+		SetFilterQuery("2").
+		SelectNone()
+
+	// Only the visible option is deselected.
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Errorf("SelectedIndices() = %v, want [0 2]", got)
+	}
+	if m.FilteredSelectionCount() != 0 {
+		t.Errorf("FilteredSelectionCount() = %d, want 0", m.FilteredSelectionCount())
+	}
+}
+
+func TestMultiSelect_InvertSelection(t *testing.T) {
+	m := New(makeOptions(), 0, 0).WithSelected(0, 2).InvertSelection()
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{1, 3, 4}) {
+		t.Errorf("SelectedIndices() = %v, want [1 3 4]", got)
+	}
+
+	// With a filter, hidden selections are untouched.
+	m = New(makeOptions(), 0, 0).
+		WithSelected(0, 3).
+		SetFilterQuery("Option 3").
+		InvertSelection()
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{0, 2, 3}) {
+		t.Errorf("filtered SelectedIndices() = %v, want [0 2 3]", got)
+	}
+}
+
+func TestMultiSelect_InvertSelection_Max(t *testing.T) {
+	// Deselected options free slots before new ones are taken, in order.
+	m := New(makeOptions(), 0, 2).WithSelected(0, 1).InvertSelection()
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("SelectedIndices() = %v, want [2 3]", got)
+	}
+}
+
+func TestMultiSelect_SelectedValuesInOrder(t *testing.T) {
+	m := New(makeOptions(), 0, 0).
+		WithSelected(4).
+		MoveToStart().
+		Toggle().
+		WithSelectionConstraints(0, 3) // Recreating the selection keeps the order
+
+	if got := m.SelectedValuesInOrder(); !reflect.DeepEqual(got, []string{"opt5", "opt1"}) {
+		t.Errorf("SelectedValuesInOrder() = %v, want [opt5 opt1]", got)
+	}
+	if got := m.SelectedValues(); !reflect.DeepEqual(got, []string{"opt1", "opt5"}) {
+		t.Errorf("SelectedValues() = %v, want [opt1 opt5]", got)
+	}
+}
+
 func TestMultiSelect_Immutability(t *testing.T) {
 	original := New(makeOptions(), 0, 0)
 	modified := original.MoveDown().Toggle().SetFilterQuery("test")
@@ -5,6 +5,7 @@ package value
 // It's a value object that tracks which indices are selected and enforces min/max constraints.
 type Selection struct {
 	indices map[int]bool // Selected indices
+	order   []int        // Selected indices in the order they were selected
 	min     int          // Minimum required selections (0 = no minimum)
 	max     int          // Maximum allowed selections (0 = unlimited)
 }
@@ -24,6 +25,7 @@ func NewSelection(minCount, maxCount int) *Selection {
 
 	return &Selection{
 		indices: make(map[int]bool),
+		order:   []int{},
 		min:     minCount,
 		max:     maxCount,
 	}
@@ -36,17 +38,21 @@ func (s *Selection) WithSelected(indices ...int) *Selection {
 		newIndices[k] = v
 	}
 
+	newOrder := append(make([]int, 0, len(s.order)+len(indices)), s.order...)
+
 	for _, idx := range indices {
-		if idx >= 0 {
+		if idx >= 0 && !newIndices[idx] {
 			// Only add if max constraint is not violated
 			if s.max == 0 || len(newIndices) < s.max {
 				newIndices[idx] = true
+				newOrder = append(newOrder, idx)
 			}
 		}
 	}
 
 	return &Selection{
 		indices: newIndices,
+		order:   newOrder,
 		min:     s.min,
 		max:     s.max,
 	}
@@ -63,15 +69,25 @@ func (s *Selection) Toggle(index int) *Selection {
 		newIndices[k] = v
 	}
 
+	newOrder := make([]int, 0, len(s.order)+1)
+	for _, idx := range s.order {
+		if idx != index {
+			newOrder = append(newOrder, idx)
+		}
+	}
+
 	if newIndices[index] {
 		// Deselecting - always allowed
 		delete(newIndices, index)
 	} else if s.max == 0 || len(newIndices) < s.max {
+		// Selecting (again) moves the index to the end of the order
 		newIndices[index] = true
+		newOrder = append(newOrder, index)
 	}
 
 	return &Selection{
 		indices: newIndices,
+		order:   newOrder,
 		min:     s.min,
 		max:     s.max,
 	}
@@ -92,12 +108,15 @@ func (s *Selection) SelectAll(maxIndex int) *Selection {
 		limit = s.max
 	}
 
+	newOrder := make([]int, 0, limit)
 	for i := 0; i < limit; i++ {
 		newIndices[i] = true
+		newOrder = append(newOrder, i)
 	}
 
 	return &Selection{
 		indices: newIndices,
+		order:   newOrder,
 		min:     s.min,
 		max:     s.max,
 	}
@@ -107,6 +126,7 @@ func (s *Selection) SelectAll(maxIndex int) *Selection {
 func (s *Selection) Clear() *Selection {
 	return &Selection{
 		indices: make(map[int]bool),
+		order:   []int{},
 		min:     s.min,
 		max:     s.max,
 	}
@@ -145,6 +165,14 @@ func (s *Selection) Indices() []int {
 	return result
 }
 
+// Order returns a slice of selected indices in the order they were selected.
+// An index selected again after being deselected moves to the end.
+func (s *Selection) Order() []int {
+	result := make([]int, len(s.order))
+	copy(result, s.order)
+	return result
+}
+
 // CanSelect returns true if another item can be selected (max not reached).
 func (s *Selection) CanSelect() bool {
 	return s.max == 0 || len(s.indices) < s.max
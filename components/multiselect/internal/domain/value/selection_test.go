@@ -383,3 +383,33 @@ func TestSelection_CanConfirm(t *testing.T) {
 		})
 	}
 }
+
+func TestSelection_Order(t *testing.T) {
+	s := NewSelection(0, 0).
+		WithSelected(3, 1).
+		Toggle(0).
+		Toggle(1). // Off
+		Toggle(1)  // On again: moves to the end
+
+	if got := s.Order(); !reflect.DeepEqual(got, []int{3, 0, 1}) {
+		t.Errorf("Order() = %v, want [3 0 1]", got)
+	}
+	if got := s.Indices(); !reflect.DeepEqual(got, []int{0, 1, 3}) {
+		t.Errorf("Indices() = %v, want [0 1 3]", got)
+	}
+
+	// Re-selecting an already selected index keeps its place.
+	if got := s.WithSelected(3).Order(); !reflect.DeepEqual(got, []int{3, 0, 1}) {
+		t.Errorf("WithSelected(3).Order() = %v, want [3 0 1]", got)
+	}
+	// Rejected by max: not recorded.
+	if got := NewSelection(0, 1).Toggle(2).Toggle(4).Order(); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Order() with max = %v, want [2]", got)
+	}
+	if got := s.Clear().Order(); len(got) != 0 {
+		t.Errorf("Clear().Order() = %v, want empty", got)
+	}
+	if got := NewSelection(0, 0).SelectAll(2).Order(); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("SelectAll(2).Order() = %v, want [0 1 2]", got)
+	}
+}
@@ -15,8 +15,9 @@ const (
 	ActionMoveToStart Action = "move_to_start" // Move cursor to first option
 	ActionMoveToEnd   Action = "move_to_end"   // Move cursor to last option
 	ActionToggle      Action = "toggle"        // Toggle current item selection
-	ActionSelectAll   Action = "select_all"    // Select all filtered items
-	ActionSelectNone  Action = "select_none"   // Deselect all items
+	ActionSelectAll   Action = "select_all"    // Select all filtered items (up to max)
+	ActionSelectNone  Action = "select_none"   // Deselect all filtered items
+	ActionInvert      Action = "invert"        // Invert selection of filtered items
 	ActionConfirm     Action = "confirm"       // Confirm selection
 	ActionClearFilter Action = "clear_filter"  // Clear filter query
 	ActionQuit        Action = "quit"          // Quit the application
@@ -27,6 +28,7 @@ const (
 type KeyBindingMap struct {
 	bindings map[tea.KeyType]Action
 	runeMap  map[rune]Action
+	ctrlMap  map[rune]Action // Ctrl+letter bindings
 }
 
 // DefaultKeyBindingMap returns the default keyboard bindings for MultiSelect.
@@ -49,6 +51,11 @@ func DefaultKeyBindingMap() *KeyBindingMap {
 			'G': ActionMoveToEnd,
 			'a': ActionSelectAll,
 			'n': ActionSelectNone,
+			'i': ActionInvert,
+		},
+		ctrlMap: map[rune]Action{
+			'a': ActionSelectAll,
+			'd': ActionSelectNone,
 		},
 	}
 }
@@ -60,6 +67,14 @@ func (k *KeyBindingMap) GetAction(msg tea.KeyMsg) Action {
 		return action
 	}
 
+	// Ctrl+letter has its own bindings and never matches plain runes
+	if msg.Type == tea.KeyRune && msg.Ctrl {
+		if action, ok := k.ctrlMap[msg.Rune]; ok {
+			return action
+		}
+		return ActionNone
+	}
+
 	// Check rune-based bindings
 	if msg.Type == tea.KeyRune {
 		if action, ok := k.runeMap[msg.Rune]; ok {
@@ -31,6 +31,12 @@ func TestDefaultKeyBindingMap(t *testing.T) {
 		{"G", tea.KeyMsg{Type: tea.KeyRune, Rune: 'G'}, ActionMoveToEnd},
 		{"a", tea.KeyMsg{Type: tea.KeyRune, Rune: 'a'}, ActionSelectAll},
 		{"n", tea.KeyMsg{Type: tea.KeyRune, Rune: 'n'}, ActionSelectNone},
+		{"i", tea.KeyMsg{Type: tea.KeyRune, Rune: 'i'}, ActionInvert},
+
+		// Ctrl bindings
+		{"ctrl+a", tea.KeyMsg{Type: tea.KeyRune, Rune: 'a', Ctrl: true}, ActionSelectAll},
+		{"ctrl+d", tea.KeyMsg{Type: tea.KeyRune, Rune: 'd', Ctrl: true}, ActionSelectNone},
+		{"ctrl+k", tea.KeyMsg{Type: tea.KeyRune, Rune: 'k', Ctrl: true}, ActionNone},
 
 		// Unmapped keys
 		{"x", tea.KeyMsg{Type: tea.KeyRune, Rune: 'x'}, ActionNone},
@@ -70,6 +76,7 @@ func TestKeyBindingMap_Actions(t *testing.T) {
 		ActionToggle,
 		ActionSelectAll,
 		ActionSelectNone,
+		ActionInvert,
 		ActionConfirm,
 		ActionClearFilter,
 		ActionQuit,
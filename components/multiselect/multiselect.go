@@ -3,7 +3,7 @@
 // The MultiSelect component allows users to choose multiple options from a list with:
 // - Keyboard navigation (j/k or arrows)
 // - Toggle selection with Space
-// - Select all/none with a/n
+// - Select all/none with a/n (or Ctrl+A/Ctrl+D), invert with i
 // - Optional fuzzy filtering
 // - Min/Max selection constraints
 // - Virtualization for large lists
@@ -57,6 +57,8 @@ type MultiSelect[T any] struct {
 	filterable bool
 	min        int
 	max        int
+	notice     string // Message about the last bulk action (e.g. max reached)
+	ordered    bool   // Report selections in pick order (see WithOrderedSelection)
 }
 
 // New creates a new MultiSelect with the given title.
@@ -68,6 +70,7 @@ func New[T any](title string) *MultiSelect[T] {
 		filterable: false,
 		min:        0,
 		max:        0,
+		ordered:    false,
 	}
 }
 
@@ -80,6 +83,8 @@ func (m *MultiSelect[T]) Options(options ...*value.Option[T]) *MultiSelect[T] {
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -92,6 +97,8 @@ func (m *MultiSelect[T]) WithHeight(height int) *MultiSelect[T] {
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -104,6 +111,8 @@ func (m *MultiSelect[T]) WithFilterable(enabled bool) *MultiSelect[T] {
 		filterable: enabled,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -116,6 +125,25 @@ func (m *MultiSelect[T]) WithFilterFunc(fn func(opt *value.Option[T], query stri
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
+	}
+}
+
+// WithOrderedSelection makes the component report selections in the order
+// the user picked them: SelectionOrder returns them in pick order and
+// ConfirmSelectionMsg.Ordered is filled in. Deselecting an item and
+// selecting it again moves it to the end. Off by default.
+func (m *MultiSelect[T]) WithOrderedSelection(enabled bool) *MultiSelect[T] {
+	return &MultiSelect[T]{
+		title:      m.title,
+		domain:     m.domain,
+		keymap:     m.keymap,
+		filterable: m.filterable,
+		min:        m.min,
+		max:        m.max,
+		notice:     m.notice,
+		ordered:    enabled,
 	}
 }
 
@@ -128,6 +156,8 @@ func (m *MultiSelect[T]) WithRenderFunc(fn func(opt *value.Option[T], index int,
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -140,6 +170,8 @@ func (m *MultiSelect[T]) Selected(indices ...int) *MultiSelect[T] {
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -155,6 +187,8 @@ func (m *MultiSelect[T]) Min(minCount int) *MultiSelect[T] {
 		filterable: m.filterable,
 		min:        minCount,
 		max:        m.max,
+		notice:     m.notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -170,6 +204,57 @@ func (m *MultiSelect[T]) Max(maxCount int) *MultiSelect[T] {
 		filterable: m.filterable,
 		min:        m.min,
 		max:        maxCount,
+		notice:     m.notice,
+		ordered:    m.ordered,
+	}
+}
+
+// SelectAll selects all visible options, i.e. those matching the filter if
+// one is active. Existing selections are kept. If that would exceed Max,
+// only the first visible options up to Max are selected and Notice reports
+// the limit.
+func (m *MultiSelect[T]) SelectAll() *MultiSelect[T] {
+	want := m.domain.SelectionCount() + m.domain.FilteredCount() - m.domain.FilteredSelectionCount()
+	return m.withBulkSelection(m.domain.SelectAll(), want)
+}
+
+// DeselectAll deselects all visible options, i.e. those matching the filter
+// if one is active. Selections hidden by the filter are kept.
+func (m *MultiSelect[T]) DeselectAll() *MultiSelect[T] {
+	return m.withBulkSelection(m.domain.SelectNone(), 0)
+}
+
+// InvertSelection inverts the selection of all visible options, i.e. those
+// matching the filter if one is active. If selecting would exceed Max, only
+// the first options up to Max are selected and Notice reports the limit.
+func (m *MultiSelect[T]) InvertSelection() *MultiSelect[T] {
+	selected := m.domain.FilteredSelectionCount()
+	want := m.domain.SelectionCount() - selected + m.domain.FilteredCount() - selected
+	return m.withBulkSelection(m.domain.InvertSelection(), want)
+}
+
+// Notice returns the message about the last bulk selection, e.g. that Max
+// was reached, or "" if there is none. It is cleared by the next key.
+func (m *MultiSelect[T]) Notice() string {
+	return m.notice
+}
+
+// withBulkSelection returns a copy with the domain after a bulk selection
+// change, noting when Max kept the selection below the wanted count.
+func (m *MultiSelect[T]) withBulkSelection(domain *model.MultiSelect[T], want int) *MultiSelect[T] {
+	notice := ""
+	if m.max > 0 && domain.SelectionCount() < want {
+		notice = fmt.Sprintf("Selection limited to %d items", m.max)
+	}
+	return &MultiSelect[T]{
+		title:      m.title,
+		domain:     domain,
+		keymap:     m.keymap,
+		filterable: m.filterable,
+		min:        m.min,
+		max:        m.max,
+		notice:     notice,
+		ordered:    m.ordered,
 	}
 }
 
@@ -178,6 +263,16 @@ func (m *MultiSelect[T]) SelectedItems() []T {
 	return m.domain.SelectedValues()
 }
 
+// SelectionOrder returns the currently selected values in the order they
+// were picked when WithOrderedSelection is enabled, or in option order
+// (like SelectedItems) otherwise.
+func (m *MultiSelect[T]) SelectionOrder() []T {
+	if !m.ordered {
+		return m.domain.SelectedValues()
+	}
+	return m.domain.SelectedValuesInOrder()
+}
+
 // SelectedIndices returns the currently selected indices.
 func (m *MultiSelect[T]) SelectedIndices() []int {
 	return m.domain.SelectedIndices()
@@ -207,6 +302,7 @@ func (m *MultiSelect[T]) Update(msg tea.Msg) (*MultiSelect[T], tea.Cmd) {
 func (m *MultiSelect[T]) handleKey(msg tea.KeyMsg) (*MultiSelect[T], tea.Cmd) {
 	action := m.keymap.GetAction(msg)
 
+	// Any key clears the notice of the previous bulk action
 	newM := &MultiSelect[T]{
 		title:      m.title,
 		domain:     m.domain,
@@ -214,6 +310,7 @@ func (m *MultiSelect[T]) handleKey(msg tea.KeyMsg) (*MultiSelect[T], tea.Cmd) {
 		filterable: m.filterable,
 		min:        m.min,
 		max:        m.max,
+		ordered:    m.ordered,
 	}
 
 	switch action {
@@ -228,12 +325,14 @@ func (m *MultiSelect[T]) handleKey(msg tea.KeyMsg) (*MultiSelect[T], tea.Cmd) {
 	case infrastructure.ActionToggle:
 		newM.domain = newM.domain.Toggle()
 	case infrastructure.ActionSelectAll:
-		newM.domain = newM.domain.SelectAll()
+		newM = newM.SelectAll()
 	case infrastructure.ActionSelectNone:
-		newM.domain = newM.domain.SelectNone()
+		newM = newM.DeselectAll()
+	case infrastructure.ActionInvert:
+		newM = newM.InvertSelection()
 	case infrastructure.ActionConfirm:
 		if newM.domain.CanConfirm() {
-			return newM, ConfirmSelectionCmd[T](newM.SelectedItems)
+			return newM, newM.confirmCmd()
 		}
 		// Don't confirm if min constraint not met
 		return newM, nil
@@ -255,9 +354,9 @@ func (m *MultiSelect[T]) handleFilterInput(newM *MultiSelect[T], msg tea.KeyMsg)
 	}
 
 	if msg.Type == tea.KeyRune {
-		// Skip if it's a bound rune (a, n, j, k, g, G)
+		// Skip if it's a bound rune (a, n, i, j, k, g, G) or a Ctrl combination
 		action := m.keymap.GetAction(msg)
-		if action != infrastructure.ActionNone {
+		if action != infrastructure.ActionNone || msg.Ctrl {
 			return newM
 		}
 
@@ -298,6 +397,13 @@ func (m *MultiSelect[T]) View() string {
 	// Render options
 	m.renderOptions(&b)
 
+	// Show notice of the last bulk action
+	if m.notice != "" {
+		_ = b.WriteByte('\n')
+		_ = b.WriteByte('\n')
+		b.WriteString(m.notice)
+	}
+
 	// Show filter query if active
 	if m.filterable {
 		m.renderFilterStatus(&b)
@@ -352,7 +458,7 @@ func (m *MultiSelect[T]) renderFilterStatus(b *strings.Builder) {
 func (m *MultiSelect[T]) renderHelp(b *strings.Builder) {
 	_ = b.WriteByte('\n')
 	_ = b.WriteByte('\n')
-	b.WriteString("  a: all  n: none  Space: toggle  Enter: confirm")
+	b.WriteString("  a: all  n: none  i: invert  Space: toggle  Enter: confirm")
 }
 
 // Opt creates a new option with label, value, and optional description.
@@ -372,9 +478,24 @@ func ConfirmSelectionCmd[T any](getValuesFunc func() []T) tea.Cmd {
 	}
 }
 
+// confirmCmd returns a command that sends a ConfirmSelectionMsg, including
+// the pick order if WithOrderedSelection is enabled.
+func (m *MultiSelect[T]) confirmCmd() tea.Cmd {
+	if !m.ordered {
+		return ConfirmSelectionCmd[T](m.SelectedItems)
+	}
+	return func() tea.Msg {
+		return ConfirmSelectionMsg[T]{
+			Values:  m.SelectedItems(),
+			Ordered: m.SelectionOrder(),
+		}
+	}
+}
+
 // ConfirmSelectionMsg is sent when the user confirms their selection.
 type ConfirmSelectionMsg[T any] struct {
-	Values []T
+	Values  []T // Selected values in option order
+	Ordered []T // Selected values in pick order (nil unless WithOrderedSelection is enabled)
 }
 
 // NewStrings creates a new string-based MultiSelect (convenience constructor).
@@ -431,3 +431,155 @@ func TestMultiSelect_Immutability(t *testing.T) {
 		t.Errorf("modified.SelectionCount() = %d, want 1", modified.SelectionCount())
 	}
 }
+
+func fruitSelect() *MultiSelect[string] {
+	return NewStrings("Fruit:", []string{"Apple", "Banana", "Blueberry", "Cherry", "Blackberry"}).
+		WithFilterable(true)
+}
+
+func typeFilter(m *MultiSelect[string], query string) *MultiSelect[string] {
+	for _, r := range query {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	return m
+}
+
+func TestMultiSelect_Update_CtrlSelectAll(t *testing.T) {
+	m := fruitSelect().Selected(0)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'a', Ctrl: true})
+	if m.SelectionCount() != 5 {
+		t.Errorf("SelectionCount() = %d, want 5", m.SelectionCount())
+	}
+	if m.Notice() != "" {
+		t.Errorf("Notice() = %q, want none without Max", m.Notice())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'd', Ctrl: true})
+	if m.SelectionCount() != 0 {
+		t.Errorf("SelectionCount() after ctrl+d = %d, want 0", m.SelectionCount())
+	}
+}
+
+func TestMultiSelect_SelectAll_Max(t *testing.T) {
+	m := fruitSelect().Max(2)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'a', Ctrl: true})
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{0, 1}) {
+		t.Errorf("SelectedIndices() = %v, want first 2 [0 1]", got)
+	}
+	if m.Notice() != "Selection limited to 2 items" {
+		t.Errorf("Notice() = %q", m.Notice())
+	}
+	if !strings.Contains(m.View(), "Selection limited to 2 items") {
+		t.Error("View() should show the notice")
+	}
+
+	// The next key clears it.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if m.Notice() != "" || strings.Contains(m.View(), "limited") {
+		t.Errorf("Notice() after next key = %q, want cleared", m.Notice())
+	}
+
+	// Exactly Max options is not a limit.
+	if got := NewStrings("", []string{"A", "B"}).Max(2).SelectAll().Notice(); got != "" {
+		t.Errorf("Notice() selecting exactly Max = %q, want none", got)
+	}
+}
+
+func TestMultiSelect_BulkActions_WithFilter(t *testing.T) {
+	m := typeFilter(fruitSelect().Selected(0), "berry")
+	if m.domain.FilteredCount() != 2 {
+		t.Fatalf("FilteredCount() = %d, want 2", m.domain.FilteredCount())
+	}
+
+	// ctrl+a is an action, not filter input.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'a', Ctrl: true})
+	if m.domain.FilterQuery() != "berry" {
+		t.Errorf("FilterQuery() = %q, want unchanged", m.domain.FilterQuery())
+	}
+	if got := m.SelectedItems(); !reflect.DeepEqual(got, []string{"Apple", "Blueberry", "Blackberry"}) {
+		t.Errorf("SelectedItems() after ctrl+a = %v", got)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'd', Ctrl: true})
+	if got := m.SelectedItems(); !reflect.DeepEqual(got, []string{"Apple"}) {
+		t.Errorf("SelectedItems() after ctrl+d = %v, want hidden Apple kept", got)
+	}
+
+	// Unbound ctrl keys don't type into the filter.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'x', Ctrl: true})
+	if m.domain.FilterQuery() != "berry" {
+		t.Errorf("FilterQuery() after ctrl+x = %q", m.domain.FilterQuery())
+	}
+}
+
+func TestMultiSelect_InvertSelection(t *testing.T) {
+	m := fruitSelect().Selected(1, 3)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'i'})
+	if got := m.SelectedIndices(); !reflect.DeepEqual(got, []int{0, 2, 4}) {
+		t.Errorf("SelectedIndices() = %v, want [0 2 4]", got)
+	}
+
+	limited := fruitSelect().Max(2).Selected(0).InvertSelection()
+	if got := limited.SelectedIndices(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("limited SelectedIndices() = %v, want [1 2]", got)
+	}
+	if limited.Notice() == "" {
+		t.Error("Notice() should report the Max limit")
+	}
+
+	if !strings.Contains(m.View(), "i: invert") {
+		t.Error("help should mention invert")
+	}
+}
+
+func TestMultiSelect_OrderedSelection(t *testing.T) {
+	m := NewStrings("Pipeline:", []string{"grep", "sort", "uniq", "wc"}).
+		WithOrderedSelection(true)
+
+	// Pick wc, sort, grep; then toggle sort off and on again.
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyEnd}, {Type: tea.KeySpace}, // wc
+		{Type: tea.KeyHome}, {Type: tea.KeyDown}, {Type: tea.KeySpace}, // sort
+		{Type: tea.KeyUp}, {Type: tea.KeySpace}, // grep
+		{Type: tea.KeyDown}, {Type: tea.KeySpace}, {Type: tea.KeySpace}, // sort off, on
+	}
+	for _, k := range keys {
+		m, _ = m.Update(k)
+	}
+
+	want := []string{"wc", "grep", "sort"}
+	if got := m.SelectionOrder(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectionOrder() = %v, want %v", got, want)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	msg, ok := cmd().(ConfirmSelectionMsg[string])
+	if !ok {
+		t.Fatalf("msg type = %T, want ConfirmSelectionMsg[string]", cmd())
+	}
+	if !reflect.DeepEqual(msg.Ordered, want) {
+		t.Errorf("msg.Ordered = %v, want %v", msg.Ordered, want)
+	}
+	if !reflect.DeepEqual(msg.Values, []string{"grep", "sort", "wc"}) {
+		t.Errorf("msg.Values = %v, want option order", msg.Values)
+	}
+}
+
+func TestMultiSelect_OrderedSelection_Disabled(t *testing.T) {
+	m := NewStrings("", []string{"A", "B", "C"}).Selected(2, 0)
+
+	if got := m.SelectionOrder(); !reflect.DeepEqual(got, []string{"A", "C"}) {
+		t.Errorf("SelectionOrder() = %v, want option order [A C]", got)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg := cmd().(ConfirmSelectionMsg[string]); msg.Ordered != nil {
+		t.Errorf("msg.Ordered = %v, want nil when disabled", msg.Ordered)
+	}
+	// The option survives other configuration.
+	if !m.WithOrderedSelection(true).Max(5).WithHeight(3).ordered {
+		t.Error("ordered lost by later configuration")
+	}
+}
@@ -2,12 +2,30 @@
 package progress
 
 import (
+	"strings"
+	"time"
+
 	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// indeterminateFPS is the frame rate of the indeterminate animation.
+const indeterminateFPS = 15
+
+// IndeterminateStyle selects how an indeterminate bar animates.
+type IndeterminateStyle int
+
+const (
+	// IndeterminateBounce moves a segment back and forth across the bar.
+	IndeterminateBounce IndeterminateStyle = iota
+	// IndeterminatePulse grows a segment from the center to the full bar and back.
+	IndeterminatePulse
+)
+
 // Bar is the public API for progress bar component.
 // It implements tea.Model and provides a fluent interface for configuration.
 // Uses value semantics for immutable updates.
@@ -21,6 +39,21 @@ type Bar struct {
 	domain  model.Bar // VALUE, not pointer!
 	service *service.RenderService
 	theme   *style.Theme // Optional theme, defaults to DefaultTheme if nil
+	// Gradient of the filled portion (see WithGradient)
+	gradient     bool
+	gradientFrom style.Color
+	gradientTo   style.Color
+	colors       style.TerminalCapability // Color depth the gradient is rendered for
+	// Time remaining and throughput (see WithETA and WithUnit)
+	showETA   bool
+	unit      string
+	total     float64 // Amount of work in units, 100 unless set with WithTotal
+	current   float64 // Completed amount in units
+	estimator model.Estimator
+	clock     func() time.Time // Defaults to time.Now
+	// Hooks (see OnChange and OnComplete)
+	onChange   func(old, new int)
+	onComplete func()
 }
 
 // NewBar creates a new progress bar with the specified width.
@@ -28,18 +61,35 @@ type Bar struct {
 // Returns pointer for initialization, but store as value in Model.
 func NewBar(width int) *Bar {
 	return &Bar{
-		domain:  *model.NewBar(width), // Dereference!
-		service: service.NewRenderService(),
+		domain:    *model.NewBar(width), // Dereference!
+		service:   service.NewRenderService(),
+		colors:    detectColors(),
+		total:     100,
+		estimator: model.NewEstimator(),
 	}
 }
 
 // NewBarWithProgress creates a new progress bar with initial percentage.
 // Returns pointer for initialization, but store as value in Model.
 func NewBarWithProgress(width, percentage int) *Bar {
-	return &Bar{
-		domain:  *model.NewBarWithPercentage(width, percentage), // Dereference!
-		service: service.NewRenderService(),
+	b := Bar{
+		domain:    *model.NewBarWithPercentage(width, percentage), // Dereference!
+		service:   service.NewRenderService(),
+		colors:    detectColors(),
+		total:     100,
+		estimator: model.NewEstimator(),
 	}
+	b.current = float64(b.domain.Percentage()) * b.total / 100
+	return &b
+}
+
+// NewIndeterminate creates a progress bar for work of unknown size.
+// Instead of a percentage it animates a bouncing segment across the bar
+// (see WithIndeterminateStyle); Init starts the animation.
+// Returns pointer for initialization, but store as value in Model.
+func NewIndeterminate(width int) *Bar {
+	b := NewBar(width).SetIndeterminate(true)
+	return &b
 }
 
 // FillChar sets the character used for the filled portion of the bar.
@@ -58,6 +108,104 @@ func (b Bar) EmptyChar(char rune) Bar {
 	return b
 }
 
+// WithRunes sets the characters of the filled and empty portions of the bar.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithRunes('━', '─').
+func (b Bar) WithRunes(filled, empty rune) Bar {
+	b.domain = b.domain.WithFillChar(filled).WithEmptyChar(empty)
+	return b
+}
+
+// WithHead sets a distinct leading character for the filled portion, drawn
+// while the bar is partially filled (e.g. '▶' or '>'). Use 0 for none.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithHead('▶').
+func (b Bar) WithHead(head rune) Bar {
+	b.domain = b.domain.WithHead(head)
+	return b
+}
+
+// WithGradient colors the filled portion with a smooth gradient from one
+// color at the left end of the bar to another at the right end, so the bar
+// shifts towards the "to" color as it fills.
+//
+// The gradient needs a TrueColor terminal. On 256- and 16-color terminals
+// the filled portion is drawn in the solid "from" color instead, and without
+// color support it is left plain. The color depth is detected from the
+// environment; override it with ColorCapability.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithGradient(from, to).
+func (b Bar) WithGradient(from, to style.Color) Bar {
+	b.gradient = true
+	b.gradientFrom = from
+	b.gradientTo = to
+	return b
+}
+
+// ColorCapability sets the terminal color depth the gradient is rendered
+// for, overriding detection (see WithGradient).
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.ColorCapability(style.ANSI256).
+func (b Bar) ColorCapability(tc style.TerminalCapability) Bar {
+	b.colors = tc
+	return b
+}
+
+// WithETA toggles the estimated time remaining after the bar, e.g.
+// "ETA 00:42". The estimate is a moving average over the most recent
+// progress updates; until there are enough of them it reads "ETA --:--", and
+// when progress stands still it reads "stalled". Progress moving backwards
+// (e.g. reusing the bar from 0) starts a fresh estimate.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithETA(true).
+func (b Bar) WithETA(show bool) Bar {
+	b.showETA = show
+	return b
+}
+
+// WithUnit sets the unit of the work being tracked and shows the throughput
+// after the bar, e.g. "1.2 MB/s". Set the amount of work with WithTotal and
+// report progress in the same unit with SetValue. Use "" to hide it.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithUnit("MB").
+func (b Bar) WithUnit(unit string) Bar {
+	b.unit = unit
+	return b
+}
+
+// WithTotal sets the total amount of work in units (default 100, so that
+// SetValue matches SetProgress). Non-positive totals are ignored.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithTotal(250).
+func (b Bar) WithTotal(total float64) Bar {
+	if total <= 0 {
+		return b
+	}
+	b.current = b.current / b.total * total
+	b.total = total
+	return b
+}
+
+// SetIndeterminate switches the bar between showing a percentage and an
+// animation for work of unknown size, e.g. when the total becomes unknown
+// mid-operation. Switching restarts the animation, which advances on
+// tea.TickMsg; return bar.Init() from Update to start the ticks.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.SetIndeterminate(true).
+func (b Bar) SetIndeterminate(indeterminate bool) Bar {
+	b.domain = b.domain.WithIndeterminate(indeterminate)
+	return b
+}
+
+// WithIndeterminateStyle sets the animation shown in indeterminate mode
+// (default IndeterminateBounce).
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.WithIndeterminateStyle(progress.IndeterminatePulse).
+func (b Bar) WithIndeterminateStyle(animation IndeterminateStyle) Bar {
+	b.domain = b.domain.WithIndeterminateStyle(value.IndeterminateStyle(animation))
+	return b
+}
+
 // ShowPercent toggles whether to display the percentage text.
 // Returns new Bar for method chaining (value semantics).
 // IMPORTANT: Must reassign: bar = bar.ShowPercent(true).
@@ -74,13 +222,47 @@ func (b Bar) Label(label string) Bar {
 	return b
 }
 
+// OnChange sets a function called with the old and new percentage whenever
+// SetProgress, SetValue, Increment or Decrement changes it, e.g. to react
+// when progress crosses a threshold. Changes within the same percent do not
+// call it.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.OnChange(fn).
+func (b Bar) OnChange(fn func(old, new int)) Bar {
+	b.onChange = fn
+	return b
+}
+
+// OnComplete sets a function called when the bar fills, i.e. when an update
+// takes it from below 100% to 100%. Setting a full bar to its maximum again
+// does not call it; dropping below 100% and filling up again does.
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.OnComplete(fn).
+func (b Bar) OnComplete(fn func()) Bar {
+	b.onComplete = fn
+	return b
+}
+
 // SetProgress sets the progress percentage (0-100).
 // Values are automatically clamped to valid range.
 // Returns new Bar for method chaining (value semantics).
 // IMPORTANT: Must reassign: bar = bar.SetProgress(50).
 func (b Bar) SetProgress(pct int) Bar {
+	old := b.domain.Percentage()
 	b.domain = b.domain.WithPercentage(pct)
-	return b
+	return b.syncValue().notify(old)
+}
+
+// SetValue sets the completed amount of work in units (see WithTotal).
+// Values are clamped to [0, total].
+// Returns new Bar for method chaining (value semantics).
+// IMPORTANT: Must reassign: bar = bar.SetValue(12.5).
+func (b Bar) SetValue(current float64) Bar {
+	old := b.domain.Percentage()
+	b.current = min(max(current, 0), b.total)
+	b.domain = b.domain.WithPercentage(int(b.current / b.total * 100))
+	b.estimator = b.estimator.Record(b.now(), b.current/b.total)
+	return b.notify(old)
 }
 
 // Increment increases the progress by the specified delta.
@@ -88,8 +270,9 @@ func (b Bar) SetProgress(pct int) Bar {
 // Returns new Bar for method chaining (value semantics).
 // IMPORTANT: Must reassign: bar = bar.Increment(10).
 func (b Bar) Increment(delta int) Bar {
+	old := b.domain.Percentage()
 	b.domain = b.domain.Increment(delta)
-	return b
+	return b.syncValue().notify(old)
 }
 
 // Decrement decreases the progress by the specified delta.
@@ -97,8 +280,9 @@ func (b Bar) Increment(delta int) Bar {
 // Returns new Bar for method chaining (value semantics).
 // IMPORTANT: Must reassign: bar = bar.Decrement(10).
 func (b Bar) Decrement(delta int) Bar {
+	old := b.domain.Percentage()
 	b.domain = b.domain.Decrement(delta)
-	return b
+	return b.syncValue().notify(old)
 }
 
 // Theme sets the theme for styling the progress bar component.
@@ -120,22 +304,175 @@ func (b Bar) IsComplete() bool {
 	return b.domain.IsComplete()
 }
 
+// Value returns the completed amount of work in units (see WithTotal).
+func (b Bar) Value() float64 {
+	return b.current
+}
+
+// EstimatedRemaining returns the estimated time until the bar completes,
+// based on the recent rate of progress. It returns 0 when the bar is
+// complete, and also when no estimate is available yet or progress has
+// stalled (see IsStalled).
+func (b Bar) EstimatedRemaining() time.Duration {
+	remaining, _ := b.estimator.Remaining(b.now())
+	return remaining
+}
+
+// IsIndeterminate returns true if the bar animates instead of showing progress.
+func (b Bar) IsIndeterminate() bool {
+	return b.domain.IsIndeterminate()
+}
+
+// IsStalled returns true if progress has not advanced recently.
+func (b Bar) IsStalled() bool {
+	return b.estimator.Stalled(b.now())
+}
+
 // Init initializes the progress bar (tea.Model interface).
-// Returns nil as bars don't need initialization commands.
+// Indeterminate bars return a command to schedule the first animation tick;
+// determinate bars need no initialization and return nil.
 func (b Bar) Init() tea.Cmd {
+	if b.domain.IsIndeterminate() {
+		return b.tick()
+	}
 	return nil
 }
 
 // Update handles messages (implements tea model contract).
-// Progress bars don't respond to standard messages - use SetProgress() instead.
+// Indeterminate bars advance their animation on tea.TickMsg. Determinate
+// bars don't respond to messages - use SetProgress() instead.
 // IMPORTANT: Must reassign: bar = bar.Update(msg).
-func (b Bar) Update(_ tea.Msg) (Bar, tea.Cmd) {
-	// Progress bars are controlled programmatically, not by messages.
+func (b Bar) Update(msg tea.Msg) (Bar, tea.Cmd) {
+	if _, ok := msg.(tea.TickMsg); ok && b.domain.IsIndeterminate() {
+		b.domain = b.domain.NextFrame()
+		return b, b.tick()
+	}
+	// Determinate bars are controlled programmatically, not by messages.
 	// Application code should call SetProgress() to update.
 	return b, nil
 }
 
 // View renders the progress bar to a string (tea.Model interface).
 func (b Bar) View() string {
-	return b.service.RenderBar(&b.domain)
+	var view string
+	if b.gradient {
+		view = b.service.RenderBarWithFill(&b.domain, b.renderGradient)
+	} else {
+		view = b.service.RenderBar(&b.domain)
+	}
+	if (b.showETA || b.unit != "") && !b.domain.IsIndeterminate() {
+		view += b.renderEstimate()
+	}
+	return view
+}
+
+// tick returns a command to schedule the next animation frame.
+func (b Bar) tick() tea.Cmd {
+	return tea.Tick(time.Second / indeterminateFPS)
+}
+
+// renderEstimate renders the time remaining and throughput shown after the bar.
+func (b Bar) renderEstimate() string {
+	now := b.now()
+	stalled := b.estimator.Stalled(now)
+
+	var out strings.Builder
+	if b.showETA {
+		remaining, ok := b.estimator.Remaining(now)
+		switch {
+		case stalled:
+			out.WriteString(" stalled")
+		case ok:
+			out.WriteString(" " + b.service.FormatETA(remaining))
+		default:
+			out.WriteString(" ETA --:--")
+		}
+	}
+	if b.unit != "" {
+		rate := b.estimator.Rate() * b.total
+		if stalled {
+			rate = 0
+		}
+		out.WriteString(" " + b.service.FormatRate(rate, b.unit))
+	}
+	return out.String()
+}
+
+// syncValue updates the completed amount after the percentage changed and
+// records it for the estimate.
+func (b Bar) syncValue() Bar {
+	b.current = float64(b.domain.Percentage()) * b.total / 100
+	b.estimator = b.estimator.Record(b.now(), b.current/b.total)
+	return b
+}
+
+// notify calls the hooks after the percentage changed from old.
+func (b Bar) notify(old int) Bar {
+	pct := b.domain.Percentage()
+	if pct == old {
+		return b
+	}
+	if b.onChange != nil {
+		b.onChange(old, pct)
+	}
+	if b.onComplete != nil && b.domain.IsComplete() {
+		b.onComplete()
+	}
+	return b
+}
+
+// now returns the current time from the bar's clock.
+func (b Bar) now() time.Time {
+	if b.clock == nil {
+		return time.Now()
+	}
+	return b.clock()
+}
+
+// renderGradient colors the filled characters according to the terminal's
+// color depth.
+func (b Bar) renderGradient(filled []rune) string {
+	if len(filled) == 0 || !b.colors.SupportsColor() {
+		return string(filled)
+	}
+	if !b.colors.SupportsTrueColor() {
+		solid := style.WithTerminalCapability(style.New().Foreground(b.gradientFrom), b.colors)
+		return style.Render(solid, string(filled))
+	}
+
+	var out strings.Builder
+	last := b.domain.Width() - 1
+	for i, r := range filled {
+		color := blend(b.gradientFrom, b.gradientTo, i, last)
+		out.WriteString(style.Render(style.New().Foreground(color), string(r)))
+	}
+	return out.String()
+}
+
+// blend returns the color step/steps of the way from one color to another.
+func blend(from, to style.Color, step, steps int) style.Color {
+	if steps <= 0 {
+		return from
+	}
+	r1, g1, b1 := from.RGB()
+	r2, g2, b2 := to.RGB()
+	mix := func(a, b uint8) uint8 {
+		return uint8(int(a) + (int(b)-int(a))*step/steps) //nolint:gosec // result stays within [a, b]
+	}
+	return style.RGB(mix(r1, r2), mix(g1, g2), mix(b1, b2))
+}
+
+// detectColors returns the color depth of the terminal from the environment.
+func detectColors() style.TerminalCapability {
+	caps := core.AutoDetect().Capabilities()
+	switch {
+	case caps.SupportsTrueColor():
+		return style.TrueColor
+	case caps.ColorDepth() >= core.ColorDepth256:
+		return style.ANSI256
+	case caps.SupportsColor():
+		return style.ANSI16
+	default:
+		return style.NoColor
+	}
 }
@@ -3,7 +3,9 @@ package progress
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
@@ -305,3 +307,240 @@ func TestBarProgressRange(t *testing.T) {
 		}
 	}
 }
+
+func TestBarWithRunesAndHead(t *testing.T) {
+	bar := NewBarWithProgress(10, 50).WithRunes('=', ' ').WithHead('>')
+
+	if got := bar.View(); got != "====>     " {
+		t.Errorf("View() = %q, want %q", got, "====>     ")
+	}
+	if got := bar.SetProgress(100).View(); got != "==========" {
+		t.Errorf("complete View() = %q, want no head", got)
+	}
+}
+
+func TestBarWithGradient(t *testing.T) {
+	from := style.RGB(255, 0, 0)
+	to := style.RGB(0, 0, 255)
+	bar := NewBarWithProgress(5, 100).WithRunes('#', '.').WithGradient(from, to)
+
+	t.Run("TrueColor", func(t *testing.T) {
+		got := bar.ColorCapability(style.TrueColor).View()
+		// First and last cells use the end colors, the middle one is blended.
+		for _, code := range []string{"38;2;255;0;0", "38;2;128;0;127", "38;2;0;0;255"} {
+			if !strings.Contains(got, code) {
+				t.Errorf("View() = %q, missing %s", got, code)
+			}
+		}
+		if strings.Count(got, "#") != 5 {
+			t.Errorf("View() = %q, want 5 filled cells", got)
+		}
+	})
+
+	t.Run("PartialFill", func(t *testing.T) {
+		got := bar.SetProgress(40).ColorCapability(style.TrueColor).View()
+		if strings.Contains(got, "38;2;0;0;255") {
+			t.Errorf("View() = %q, gradient should stay anchored to the full width", got)
+		}
+		if !strings.HasSuffix(got, "...") {
+			t.Errorf("View() = %q, empty cells should be unstyled", got)
+		}
+	})
+
+	t.Run("ANSI256 degrades to solid color", func(t *testing.T) {
+		got := bar.ColorCapability(style.ANSI256).View()
+		if strings.Contains(got, "38;2;") || strings.Count(got, "38;5;") != 1 {
+			t.Errorf("View() = %q, want a single 256-color code", got)
+		}
+	})
+
+	t.Run("NoColor", func(t *testing.T) {
+		if got := bar.ColorCapability(style.NoColor).View(); got != "#####" {
+			t.Errorf("View() = %q, want plain bar", got)
+		}
+	})
+}
+
+// fakeClock is a manually advanced clock for ETA tests.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+func newFakeClock() *fakeClock               { return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)} }
+func withClock(b Bar, c *fakeClock) Bar      { b.clock = c.Now; return b }
+
+func TestBarWithETA(t *testing.T) {
+	clock := newFakeClock()
+	bar := withClock(NewBar(10).WithETA(true), clock)
+
+	bar = bar.SetProgress(0)
+	if got := bar.View(); !strings.HasSuffix(got, " ETA --:--") {
+		t.Errorf("View() before an estimate = %q, want ETA --:--", got)
+	}
+
+	// 10% per second.
+	for i := 0; i < 4; i++ {
+		clock.Advance(time.Second)
+		bar = bar.Increment(10)
+	}
+	if got := bar.EstimatedRemaining(); got != 6*time.Second {
+		t.Errorf("EstimatedRemaining() = %v, want 6s", got)
+	}
+	if got := bar.View(); !strings.HasSuffix(got, " ETA 00:06") {
+		t.Errorf("View() = %q, want suffix ETA 00:06", got)
+	}
+
+	// No progress for too long.
+	clock.Advance(30 * time.Second)
+	bar = bar.SetProgress(40)
+	if !bar.IsStalled() {
+		t.Error("IsStalled() = false after 30s without progress")
+	}
+	if got := bar.View(); !strings.HasSuffix(got, " stalled") {
+		t.Errorf("View() while stalled = %q, want suffix stalled", got)
+	}
+	if got := bar.EstimatedRemaining(); got != 0 {
+		t.Errorf("EstimatedRemaining() while stalled = %v, want 0", got)
+	}
+
+	// Reusing the bar starts a fresh estimate.
+	bar = bar.SetProgress(100).SetProgress(0)
+	if got := bar.View(); !strings.HasSuffix(got, " ETA --:--") {
+		t.Errorf("View() after reuse = %q, want ETA --:--", got)
+	}
+}
+
+func TestBarWithUnit(t *testing.T) {
+	clock := newFakeClock()
+	bar := withClock(NewBar(10).WithTotal(50).WithUnit("MB").WithETA(true), clock)
+
+	bar = bar.SetValue(0)
+	clock.Advance(2 * time.Second)
+	bar = bar.SetValue(2.5) // 1.25 MB/s
+
+	if bar.Progress() != 5 {
+		t.Errorf("Progress() = %d, want 5", bar.Progress())
+	}
+	if bar.Value() != 2.5 {
+		t.Errorf("Value() = %v, want 2.5", bar.Value())
+	}
+	if got := bar.EstimatedRemaining(); got != 38*time.Second {
+		t.Errorf("EstimatedRemaining() = %v, want 38s", got)
+	}
+	if got := bar.View(); !strings.HasSuffix(got, " ETA 00:38 1.2 MB/s") {
+		t.Errorf("View() = %q, want suffix %q", got, " ETA 00:38 1.2 MB/s")
+	}
+
+	// Values are clamped to the total.
+	if got := bar.SetValue(80).Value(); got != 50 {
+		t.Errorf("SetValue(80).Value() = %v, want 50", got)
+	}
+}
+
+func TestBarWithoutETA(t *testing.T) {
+	bar := NewBar(10).SetProgress(50)
+	if got := bar.View(); strings.Contains(got, "ETA") || strings.Contains(got, "/s") {
+		t.Errorf("View() without ETA or unit = %q", got)
+	}
+}
+
+func TestNewIndeterminate(t *testing.T) {
+	bar := NewIndeterminate(8).Label("Scanning").ShowPercent(true)
+	if !bar.IsIndeterminate() {
+		t.Fatal("IsIndeterminate() = false")
+	}
+	if bar.Init() == nil {
+		t.Error("Init() should start the animation")
+	}
+
+	if got, want := bar.View(), "Scanning ██░░░░░░"; got != want {
+		t.Errorf("View() = %q, want %q", got, want)
+	}
+	bar, cmd := bar.Update(tea.TickMsg{})
+	if cmd == nil {
+		t.Error("Update(TickMsg) should schedule the next frame")
+	}
+	if got, want := bar.View(), "Scanning ░██░░░░░"; got != want {
+		t.Errorf("View() after tick = %q, want %q", got, want)
+	}
+
+	// Keys don't animate.
+	if same, cmd := bar.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || same.View() != bar.View() {
+		t.Error("Update(KeyMsg) should be ignored")
+	}
+}
+
+func TestBarSetIndeterminate(t *testing.T) {
+	bar := NewBar(8).SetProgress(50).WithETA(true)
+	if bar.Init() != nil {
+		t.Error("Init() on a determinate bar should return nil")
+	}
+	if _, cmd := bar.Update(tea.TickMsg{}); cmd != nil {
+		t.Error("determinate bar should not animate on TickMsg")
+	}
+
+	// Total became unknown mid-operation.
+	bar = bar.SetIndeterminate(true).WithIndeterminateStyle(IndeterminatePulse)
+	if bar.Init() == nil {
+		t.Error("Init() should start the animation after SetIndeterminate(true)")
+	}
+	bar, _ = bar.Update(tea.TickMsg{})
+	if got, want := bar.View(), "░░░██░░░"; got != want {
+		t.Errorf("View() = %q, want %q (pulse frame 1, no ETA)", got, want)
+	}
+
+	// Back to a known total.
+	bar = bar.SetIndeterminate(false)
+	if _, cmd := bar.Update(tea.TickMsg{}); cmd != nil {
+		t.Error("ticks should stop once the bar is determinate again")
+	}
+	if got := bar.View(); !strings.HasPrefix(got, "████░░░░") {
+		t.Errorf("View() = %q, want 50%% bar", got)
+	}
+}
+
+func TestBarOnChange(t *testing.T) {
+	var changes [][2]int
+	bar := NewBar(40).OnChange(func(old, new int) {
+		changes = append(changes, [2]int{old, new})
+	})
+
+	bar = bar.SetProgress(30).Increment(20).Decrement(10)
+	bar = bar.WithTotal(200).SetValue(80.5) // Still 40%: no call
+	bar = bar.SetValue(100)
+
+	want := [][2]int{{0, 30}, {30, 50}, {50, 40}, {40, 50}}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d = %v, want %v", i, changes[i], want[i])
+		}
+	}
+	if bar.Progress() != 50 {
+		t.Errorf("Progress() = %d, want 50", bar.Progress())
+	}
+}
+
+func TestBarOnComplete(t *testing.T) {
+	completed := 0
+	bar := NewBar(40).WithTotal(10).OnComplete(func() { completed++ })
+
+	bar = bar.SetValue(5)
+	if completed != 0 {
+		t.Fatalf("OnComplete called at 50%%")
+	}
+
+	// Fires once, however often the maximum is set again.
+	bar = bar.SetValue(10).SetValue(10).SetValue(12).Increment(5).SetProgress(100)
+	if completed != 1 {
+		t.Errorf("completed = %d, want exactly 1", completed)
+	}
+
+	// Filling up again after a setback counts as completing again.
+	bar.Decrement(10).SetProgress(100)
+	if completed != 2 {
+		t.Errorf("completed = %d after refilling, want 2", completed)
+	}
+}
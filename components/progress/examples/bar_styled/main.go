@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/phoenix-tui/phoenix/components/progress"
+	"github.com/phoenix-tui/phoenix/style"
 )
 
 // Styled progress bar example.
@@ -46,7 +47,24 @@ func main() {
 
 	fmt.Println()
 
-	// Example 3: Minimal bar (no label, no percentage)
+	// Example 3: Gradient fill with a distinct head character.
+	// Terminals without TrueColor fall back to the solid start color.
+	bar4 := progress.NewBar(50).
+		WithRunes('━', '─').
+		WithHead('╸').
+		WithGradient(style.RGB(0, 200, 255), style.RGB(180, 0, 255)).
+		ShowPercent(true).
+		Label("Uploading...")
+
+	for i := 0; i <= 100; i += 5 {
+		bar4.SetProgress(i)
+		fmt.Printf("\r%s", bar4.View())
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	fmt.Println()
+
+	// Example 4: Minimal bar (no label, no percentage)
 	bar3 := progress.NewBar(30)
 
 	for i := 0; i <= 100; i += 20 {
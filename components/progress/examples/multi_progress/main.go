@@ -1,5 +1,6 @@
 // Package main demonstrates multiple progress bars.
-// This example shows concurrent progress tracking with multiple bars.
+// This example shows concurrent progress tracking with multiple bars, using
+// OnComplete hooks instead of polling IsComplete.
 package main
 
 import (
@@ -15,22 +16,27 @@ import (
 // Multi-progress example.
 // Demonstrates multiple progress bars and a spinner.
 type model struct {
-	spinner progress2.Spinner
-	bars    []progress2.Bar
-	speeds  []int // Progress increment per tick
-	count   int
+	spinner  progress2.Spinner
+	bars     []progress2.Bar
+	speeds   []int     // Progress increment per tick
+	finished *[]string // Completed tasks in order, recorded by OnComplete
+	count    int
 }
 
 func initialModel() model {
+	// Shared by all copies of the model, so the hooks can record into it.
+	finished := &[]string{}
+	task := func(label string) progress2.Bar {
+		return progress2.NewBar(40).Label(label).ShowPercent(true).
+			OnComplete(func() { *finished = append(*finished, label) })
+	}
+
 	return model{
-		spinner: progress2.NewSpinner("dots").Label("Overall progress"),
-		bars: []progress2.Bar{
-			progress2.NewBar(40).Label("Task 1").ShowPercent(true),
-			progress2.NewBar(40).Label("Task 2").ShowPercent(true),
-			progress2.NewBar(40).Label("Task 3").ShowPercent(true),
-		},
-		speeds: []int{3, 2, 1}, // Different speeds
-		count:  0,
+		spinner:  progress2.NewSpinner("dots").Label("Overall progress"),
+		bars:     []progress2.Bar{task("Task 1"), task("Task 2"), task("Task 3")},
+		speeds:   []int{3, 2, 1}, // Different speeds
+		finished: finished,
+		count:    0,
 	}
 }
 
@@ -55,19 +61,16 @@ func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
 		return m, cmd
 
 	case tickProgressMsg:
-		// Update progress bars.
-		allComplete := true
+		// Update progress bars. Full bars stay at 100% and don't
+		// complete again, so there is no need to check IsComplete.
 		for i := range m.bars {
-			if !m.bars[i].IsComplete() {
-				m.bars[i] = m.bars[i].Increment(m.speeds[i])
-				allComplete = false
-			}
+			m.bars[i] = m.bars[i].Increment(m.speeds[i])
 		}
 
 		m.count++
 
 		// Quit if all bars complete or after 100 ticks.
-		if allComplete || m.count >= 100 {
+		if len(*m.finished) == len(m.bars) || m.count >= 100 {
 			return m, tea.Quit()
 		}
 
@@ -95,6 +98,10 @@ func (m model) View() string {
 		b.WriteString("\n")
 	}
 
+	if len(*m.finished) > 0 {
+		b.WriteString("\n  Finished: " + strings.Join(*m.finished, ", ") + "\n")
+	}
+
 	b.WriteString("\n  Press 'q' to quit\n")
 
 	return b.String()
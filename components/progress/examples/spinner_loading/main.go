@@ -0,0 +1,89 @@
+// Package main demonstrates a spinner tied to a background task.
+// This example shows RunWhileLoading for the "spinner during a slow call" pattern.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/components/progress"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// reportMsg is returned by the simulated slow operation.
+type reportMsg struct {
+	rows int
+	took time.Duration
+}
+
+// fetchReport simulates a network call.
+func fetchReport() tea.Msg {
+	start := time.Now()
+	time.Sleep(2 * time.Second)
+	return reportMsg{rows: 42, took: time.Since(start)}
+}
+
+// Loading example.
+// The spinner animates only while fetchReport runs; 'r' fetches again.
+type model struct {
+	spinner progress.Spinner
+	result  string
+}
+
+func initialModel() model {
+	// Init starts the task: a loading spinner's first tick runs it
+	_, spinner := progress.RunWhileLoading(fetchReport)
+	return model{spinner: spinner.Label("Fetching report...")}
+}
+
+func (m model) load() (model, tea.Cmd) {
+	cmd, spinner := progress.RunWhileLoading(fetchReport)
+	m.spinner = spinner.Label("Fetching report...")
+	m.result = ""
+	return m, cmd
+}
+
+func (m model) Init() tea.Cmd {
+	return m.spinner.Init()
+}
+
+func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			return m, tea.Quit()
+		case "r":
+			if !m.spinner.IsLoading() {
+				return m.load()
+			}
+		}
+		return m, nil
+
+	case reportMsg:
+		// Delivered by the spinner once it has stopped
+		m.result = fmt.Sprintf("Loaded %d rows in %v", msg.rows, msg.took.Round(time.Millisecond))
+		return m, nil
+	}
+
+	// Ticks and the task's LoadedMsg go to the spinner
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	if m.spinner.IsLoading() {
+		return fmt.Sprintf("\n  %s\n\n  Press 'q' to quit\n", m.spinner.View())
+	}
+	return fmt.Sprintf("\n  %s\n\n  Press 'r' to reload, 'q' to quit\n", m.result)
+}
+
+func main() {
+	p := tea.New(initialModel())
+	if err := p.Run(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
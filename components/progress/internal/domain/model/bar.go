@@ -10,8 +10,13 @@ type Bar struct {
 	width       int               // Bar width in characters
 	fillChar    rune              // Character for filled portion (e.g., '█')
 	emptyChar   rune              // Character for empty portion (e.g., '░')
+	head        rune              // Leading character of the filled portion (0 = none)
 	showPercent bool              // Show percentage text?
 	label       string            // Optional label
+	// Indeterminate mode: progress unknown, an animated segment is shown instead
+	indeterminate bool
+	animation     value.IndeterminateStyle
+	frame         int // Current animation frame
 }
 
 // NewBar creates a new Bar with default settings.
@@ -26,6 +31,7 @@ func NewBar(width int) *Bar {
 		width:       width,
 		fillChar:    '█',
 		emptyChar:   '░',
+		head:        0,
 		showPercent: false,
 		label:       "",
 	}
@@ -56,6 +62,13 @@ func (b Bar) WithEmptyChar(char rune) Bar {
 	return b
 }
 
+// WithHead returns a new Bar whose filled portion ends in char (e.g. '▶').
+// Use 0 to draw the whole filled portion with the fill character.
+func (b Bar) WithHead(char rune) Bar {
+	b.head = char
+	return b
+}
+
 // WithShowPercent returns a new Bar with percentage display toggled.
 func (b Bar) WithShowPercent(show bool) Bar {
 	b.showPercent = show
@@ -92,6 +105,28 @@ func (b Bar) Reset() Bar {
 	return b.WithPercentage(0)
 }
 
+// WithIndeterminate returns a new Bar in or out of indeterminate mode.
+// The animation restarts when the mode is switched on.
+func (b Bar) WithIndeterminate(indeterminate bool) Bar {
+	if indeterminate && !b.indeterminate {
+		b.frame = 0
+	}
+	b.indeterminate = indeterminate
+	return b
+}
+
+// WithIndeterminateStyle returns a new Bar with the given indeterminate animation.
+func (b Bar) WithIndeterminateStyle(animation value.IndeterminateStyle) Bar {
+	b.animation = animation
+	return b
+}
+
+// NextFrame returns a new Bar advanced to the next animation frame.
+func (b Bar) NextFrame() Bar {
+	b.frame++
+	return b
+}
+
 // Percentage returns the current percentage value (0-100).
 func (b Bar) Percentage() int {
 	return b.percentage.Value()
@@ -112,6 +147,11 @@ func (b Bar) EmptyChar() rune {
 	return b.emptyChar
 }
 
+// Head returns the leading character of the filled portion (0 if none).
+func (b Bar) Head() rune {
+	return b.head
+}
+
 // ShowPercent returns whether percentage display is enabled.
 func (b Bar) ShowPercent() bool {
 	return b.showPercent
@@ -122,6 +162,21 @@ func (b Bar) Label() string {
 	return b.label
 }
 
+// IsIndeterminate returns whether the bar is in indeterminate mode.
+func (b Bar) IsIndeterminate() bool {
+	return b.indeterminate
+}
+
+// IndeterminateStyle returns the indeterminate animation.
+func (b Bar) IndeterminateStyle() value.IndeterminateStyle {
+	return b.animation
+}
+
+// Frame returns the current animation frame.
+func (b Bar) Frame() int {
+	return b.frame
+}
+
 // IsComplete returns true if percentage is 100%.
 func (b Bar) IsComplete() bool {
 	return b.percentage.IsComplete()
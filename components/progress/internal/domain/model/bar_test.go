@@ -1,6 +1,10 @@
 package model
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
+)
 
 func TestNewBar(t *testing.T) {
 	tests := []struct {
@@ -109,6 +113,21 @@ func TestBarWithChars(t *testing.T) {
 	}
 }
 
+func TestBarWithHead(t *testing.T) {
+	bar := NewBar(40)
+
+	if bar.Head() != 0 {
+		t.Errorf("NewBar().Head() = %c, expected none", bar.Head())
+	}
+	newBar := bar.WithHead('▶')
+	if newBar.Head() != '▶' {
+		t.Errorf("WithHead('▶') = %c, expected '▶'", newBar.Head())
+	}
+	if bar.Head() != 0 {
+		t.Errorf("WithHead() mutated original")
+	}
+}
+
 func TestBarWithShowPercent(t *testing.T) {
 	bar := NewBar(40)
 
@@ -313,3 +332,26 @@ func TestBarFluentInterface(t *testing.T) {
 		t.Errorf("Fluent Label() = %s, expected 'Loading...'", bar.Label())
 	}
 }
+
+func TestBarIndeterminate(t *testing.T) {
+	bar := NewBar(10).WithIndeterminateStyle(value.IndeterminatePulse)
+	if bar.IsIndeterminate() {
+		t.Error("new bar should be determinate")
+	}
+
+	bar = bar.WithIndeterminate(true).NextFrame().NextFrame()
+	if !bar.IsIndeterminate() || bar.Frame() != 2 {
+		t.Errorf("IsIndeterminate() = %v, Frame() = %d; want true, 2", bar.IsIndeterminate(), bar.Frame())
+	}
+	if bar.IndeterminateStyle() != value.IndeterminatePulse {
+		t.Errorf("IndeterminateStyle() = %v, want pulse", bar.IndeterminateStyle())
+	}
+
+	// Staying indeterminate keeps the animation going; switching on again restarts it.
+	if got := bar.WithIndeterminate(true).Frame(); got != 2 {
+		t.Errorf("Frame() after re-enabling = %d, want 2", got)
+	}
+	if got := bar.WithIndeterminate(false).WithIndeterminate(true).Frame(); got != 0 {
+		t.Errorf("Frame() after switching back on = %d, want 0", got)
+	}
+}
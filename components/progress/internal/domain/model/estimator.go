@@ -0,0 +1,131 @@
+package model
+
+import "time"
+
+const (
+	// estimatorWindow is the number of progress samples the rate is averaged over.
+	estimatorWindow = 10
+
+	// StallTimeout is how long progress may stand still before the estimate
+	// is reported as stalled.
+	StallTimeout = 10 * time.Second
+)
+
+// sample is a progress reading taken at a point in time.
+type sample struct {
+	at   time.Time
+	done float64 // Completed fraction in [0, 1]
+}
+
+// Estimator derives the progress rate and time remaining from timestamped
+// progress readings, averaging over the most recent samples.
+// Uses value semantics: Record returns a new Estimator.
+type Estimator struct {
+	samples      []sample  // Most recent samples, oldest first
+	lastProgress time.Time // When the completed fraction last increased
+}
+
+// NewEstimator creates an Estimator without samples.
+func NewEstimator() Estimator {
+	return Estimator{}
+}
+
+// Record adds a reading of the completed fraction (clamped to [0, 1]) taken
+// at the given time. Progress moving backwards means the bar is being
+// reused, so the estimate starts over from that reading.
+func (e Estimator) Record(at time.Time, done float64) Estimator {
+	done = clampFraction(done)
+
+	if len(e.samples) > 0 {
+		last := e.samples[len(e.samples)-1]
+		if done < last.done || at.Before(last.at) {
+			e = NewEstimator()
+		}
+	}
+
+	if len(e.samples) == 0 || done > e.samples[len(e.samples)-1].done {
+		e.lastProgress = at
+	}
+
+	start := 0
+	if len(e.samples) >= estimatorWindow {
+		start = len(e.samples) - estimatorWindow + 1
+	}
+	samples := make([]sample, 0, estimatorWindow)
+	samples = append(samples, e.samples[start:]...)
+	e.samples = append(samples, sample{at: at, done: done})
+	return e
+}
+
+// Reset discards all samples.
+func (e Estimator) Reset() Estimator {
+	return NewEstimator()
+}
+
+// Rate returns the average completed fraction per second over the sample
+// window, or 0 if fewer than two samples span a measurable time.
+func (e Estimator) Rate() float64 {
+	if len(e.samples) < 2 {
+		return 0
+	}
+	first := e.samples[0]
+	last := e.samples[len(e.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return (last.done - first.done) / elapsed
+}
+
+// Stalled reports whether progress has not advanced within StallTimeout
+// of now. An estimator without samples or with completed progress is
+// never stalled.
+func (e Estimator) Stalled(now time.Time) bool {
+	if len(e.samples) == 0 || e.Done() >= 1 {
+		return false
+	}
+	return now.Sub(e.lastProgress) > StallTimeout
+}
+
+// Remaining returns the estimated time until completion and whether an
+// estimate is available. There is none without enough samples or while
+// stalled; completed progress has zero time remaining.
+func (e Estimator) Remaining(now time.Time) (time.Duration, bool) {
+	if len(e.samples) == 0 {
+		return 0, false
+	}
+	if e.Done() >= 1 {
+		return 0, true
+	}
+	rate := e.Rate()
+	if rate <= 0 || e.Stalled(now) {
+		return 0, false
+	}
+	// Count down from the latest sample between readings.
+	last := e.samples[len(e.samples)-1]
+	estimate := time.Duration((1 - e.Done()) / rate * float64(time.Second)).Round(time.Millisecond)
+	remaining := estimate - now.Sub(last.at)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+// Done returns the most recently recorded completed fraction.
+func (e Estimator) Done() float64 {
+	if len(e.samples) == 0 {
+		return 0
+	}
+	return e.samples[len(e.samples)-1].done
+}
+
+// clampFraction clamps a completed fraction to [0, 1].
+func clampFraction(f float64) float64 {
+	if f < 0 {
+		return 0
+	}
+	if f > 1 {
+		return 1
+	}
+	return f
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimatorRemaining(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(secs int) time.Time { return start.Add(time.Duration(secs) * time.Second) }
+
+	e := NewEstimator()
+	if _, ok := e.Remaining(start); ok {
+		t.Error("Remaining() without samples should have no estimate")
+	}
+
+	e = e.Record(at(0), 0)
+	if _, ok := e.Remaining(at(0)); ok {
+		t.Error("Remaining() with one sample should have no estimate")
+	}
+
+	// 10% per second.
+	e = e.Record(at(1), 0.1).Record(at(2), 0.2)
+	if got := e.Rate(); got < 0.099 || got > 0.101 {
+		t.Errorf("Rate() = %v, want 0.1", got)
+	}
+	if got, ok := e.Remaining(at(2)); !ok || got != 8*time.Second {
+		t.Errorf("Remaining() = %v, %v; want 8s, true", got, ok)
+	}
+	// Counts down between readings.
+	if got, _ := e.Remaining(at(5)); got != 5*time.Second {
+		t.Errorf("Remaining() 3s later = %v, want 5s", got)
+	}
+
+	e = e.Record(at(3), 1)
+	if got, ok := e.Remaining(at(3)); !ok || got != 0 {
+		t.Errorf("Remaining() when done = %v, %v; want 0, true", got, ok)
+	}
+}
+
+func TestEstimatorWindow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := NewEstimator()
+
+	// Slow start, then a steady 1% per second for the whole window.
+	e = e.Record(start, 0)
+	e = e.Record(start.Add(100*time.Second), 0.01)
+	for i := 1; i <= estimatorWindow; i++ {
+		e = e.Record(start.Add(time.Duration(100+i)*time.Second), 0.01+float64(i)/100)
+	}
+	if got := e.Rate(); got < 0.0099 || got > 0.0101 {
+		t.Errorf("Rate() = %v, want 0.01 (slow start outside the window)", got)
+	}
+}
+
+func TestEstimatorStalled(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := NewEstimator().Record(start, 0).Record(start.Add(time.Second), 0.1)
+
+	if e.Stalled(start.Add(2 * time.Second)) {
+		t.Error("Stalled() right after progress should be false")
+	}
+	// Updates without progress don't count as progress.
+	e = e.Record(start.Add(5*time.Second), 0.1)
+	later := start.Add(time.Second + StallTimeout + time.Second)
+	if !e.Stalled(later) {
+		t.Error("Stalled() after StallTimeout without progress should be true")
+	}
+	if _, ok := e.Remaining(later); ok {
+		t.Error("Remaining() while stalled should have no estimate")
+	}
+
+	done := e.Record(later, 1)
+	if done.Stalled(later.Add(time.Hour)) {
+		t.Error("Stalled() when done should be false")
+	}
+}
+
+func TestEstimatorResetsWhenProgressGoesBack(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := NewEstimator().
+		Record(start, 0).
+		Record(start.Add(time.Second), 0.5).
+		Record(start.Add(2*time.Second), 1)
+
+	// Reused from zero: the old rate must not leak into the new estimate.
+	e = e.Record(start.Add(time.Minute), 0)
+	if e.Done() != 0 || e.Rate() != 0 {
+		t.Errorf("after reuse Done() = %v, Rate() = %v; want 0, 0", e.Done(), e.Rate())
+	}
+	if _, ok := e.Remaining(start.Add(time.Minute)); ok {
+		t.Error("Remaining() right after reuse should have no estimate")
+	}
+
+	if got := e.Reset(); len(got.samples) != 0 {
+		t.Errorf("Reset() kept %d samples", len(got.samples))
+	}
+}
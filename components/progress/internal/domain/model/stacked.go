@@ -0,0 +1,106 @@
+package model
+
+import "github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
+
+// Stacked is the domain model for a stacked progress bar, where several
+// segments share one bar in proportion to their amounts.
+// It provides rich domain logic with immutability and encapsulated behavior.
+type Stacked struct {
+	segments  []value.Segment // Segments, left to right
+	total     int             // Amount the full bar stands for (0 = sum of segments)
+	width     int             // Bar width in characters
+	fillChar  rune            // Character for segments (e.g., '█')
+	emptyChar rune            // Character for the remainder (e.g., '░')
+	label     string          // Optional label
+}
+
+// NewStacked creates a new Stacked bar without segments.
+// width specifies the bar width in characters (minimum 1).
+func NewStacked(width int) *Stacked {
+	if width < 1 {
+		width = 1
+	}
+	return &Stacked{
+		width:     width,
+		fillChar:  '█',
+		emptyChar: '░',
+	}
+}
+
+// WithSegment returns a new Stacked with the segment appended.
+func (s Stacked) WithSegment(segment value.Segment) Stacked {
+	segments := make([]value.Segment, 0, len(s.segments)+1)
+	segments = append(segments, s.segments...)
+	s.segments = append(segments, segment)
+	return s
+}
+
+// WithTotal returns a new Stacked whose full bar stands for total.
+// Use 0 to make the segments fill the bar. Negative totals are treated as 0.
+func (s Stacked) WithTotal(total int) Stacked {
+	if total < 0 {
+		total = 0
+	}
+	s.total = total
+	return s
+}
+
+// WithFillChar returns a new Stacked with the specified segment character.
+func (s Stacked) WithFillChar(char rune) Stacked {
+	s.fillChar = char
+	return s
+}
+
+// WithEmptyChar returns a new Stacked with the specified remainder character.
+func (s Stacked) WithEmptyChar(char rune) Stacked {
+	s.emptyChar = char
+	return s
+}
+
+// WithLabel returns a new Stacked with the specified label.
+func (s Stacked) WithLabel(label string) Stacked {
+	s.label = label
+	return s
+}
+
+// Segments returns a copy of the segments.
+func (s Stacked) Segments() []value.Segment {
+	segments := make([]value.Segment, len(s.segments))
+	copy(segments, s.segments)
+	return segments
+}
+
+// Sum returns the combined amount of all segments.
+func (s Stacked) Sum() int {
+	sum := 0
+	for _, segment := range s.segments {
+		sum += segment.Value()
+	}
+	return sum
+}
+
+// Total returns the amount the full bar stands for: the configured total,
+// or the sum of the segments if that is larger.
+func (s Stacked) Total() int {
+	return max(s.total, s.Sum())
+}
+
+// Width returns the bar width in characters.
+func (s Stacked) Width() int {
+	return s.width
+}
+
+// FillChar returns the segment character.
+func (s Stacked) FillChar() rune {
+	return s.fillChar
+}
+
+// EmptyChar returns the remainder character.
+func (s Stacked) EmptyChar() rune {
+	return s.emptyChar
+}
+
+// Label returns the label.
+func (s Stacked) Label() string {
+	return s.label
+}
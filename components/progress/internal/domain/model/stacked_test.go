@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
+)
+
+func TestNewStacked(t *testing.T) {
+	s := NewStacked(0)
+	if s.Width() != 1 {
+		t.Errorf("Width() = %d, want 1", s.Width())
+	}
+	if s.FillChar() != '█' || s.EmptyChar() != '░' {
+		t.Errorf("chars = %c %c, want █ ░", s.FillChar(), s.EmptyChar())
+	}
+	if len(s.Segments()) != 0 || s.Total() != 0 {
+		t.Errorf("new stacked has %d segments, total %d", len(s.Segments()), s.Total())
+	}
+}
+
+func TestStackedTotal(t *testing.T) {
+	s := NewStacked(10).
+		WithSegment(value.NewSegment("a", 30)).
+		WithSegment(value.NewSegment("b", 20))
+
+	if s.Sum() != 50 || s.Total() != 50 {
+		t.Errorf("Sum() = %d, Total() = %d; want 50, 50", s.Sum(), s.Total())
+	}
+	if got := s.WithTotal(200).Total(); got != 200 {
+		t.Errorf("WithTotal(200).Total() = %d, want 200", got)
+	}
+	// The segments never overflow the bar.
+	if got := s.WithTotal(10).Total(); got != 50 {
+		t.Errorf("WithTotal(10).Total() = %d, want 50", got)
+	}
+}
+
+func TestStackedImmutability(t *testing.T) {
+	base := NewStacked(10).WithSegment(value.NewSegment("a", 1))
+	a := base.WithSegment(value.NewSegment("b", 2))
+	b := base.WithSegment(value.NewSegment("c", 3))
+
+	if len(base.Segments()) != 1 {
+		t.Errorf("base changed: %d segments", len(base.Segments()))
+	}
+	if a.Segments()[1].Label() != "b" || b.Segments()[1].Label() != "c" {
+		t.Error("derived bars share segment storage")
+	}
+}
@@ -2,9 +2,12 @@
 package service
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
 )
 
 // RenderService handles progress bar rendering logic.
@@ -20,6 +23,19 @@ func NewRenderService() *RenderService {
 // Format: [label] [filled][empty] [percentage].
 // Example: "Downloading... ████████░░░░░░░░ 40%".
 func (s *RenderService) RenderBar(bar *model.Bar) string {
+	return s.RenderBarWithFill(bar, func(filled []rune) string { return string(filled) })
+}
+
+// RenderBarWithFill renders a progress bar like RenderBar, producing the
+// filled portion with fill. fill receives the filled characters (including
+// the head, if any) and may decorate them, e.g. with colors.
+//
+// The head replaces the last filled character while the bar is partially
+// filled; empty and complete bars have no head.
+//
+// Indeterminate bars show the animated segment of the current frame as the
+// filled portion (see IndeterminateSegment), without head or percentage.
+func (s *RenderService) RenderBarWithFill(bar *model.Bar, fill func(filled []rune) string) string {
 	if bar == nil {
 		return ""
 	}
@@ -31,13 +47,25 @@ func (s *RenderService) RenderBar(bar *model.Bar) string {
 		parts = append(parts, bar.Label())
 	}
 
+	if bar.IsIndeterminate() {
+		start, end := s.IndeterminateSegment(bar.Width(), bar.IndeterminateStyle(), bar.Frame())
+		segment := []rune(strings.Repeat(string(bar.FillChar()), end-start))
+		parts = append(parts, strings.Repeat(string(bar.EmptyChar()), start)+
+			fill(segment)+
+			strings.Repeat(string(bar.EmptyChar()), bar.Width()-end))
+		return strings.Join(parts, " ")
+	}
+
 	// Calculate filled and empty widths.
 	filledWidth := s.CalculateFilledWidth(bar.Width(), bar.Percentage())
 	emptyWidth := bar.Width() - filledWidth
 
 	// Build bar string.
-	barStr := strings.Repeat(string(bar.FillChar()), filledWidth) +
-		strings.Repeat(string(bar.EmptyChar()), emptyWidth)
+	filled := []rune(strings.Repeat(string(bar.FillChar()), filledWidth))
+	if bar.Head() != 0 && filledWidth > 0 && emptyWidth > 0 {
+		filled[filledWidth-1] = bar.Head()
+	}
+	barStr := fill(filled) + strings.Repeat(string(bar.EmptyChar()), emptyWidth)
 	parts = append(parts, barStr)
 
 	// Add percentage if enabled.
@@ -67,6 +95,120 @@ func (s *RenderService) CalculateFilledWidth(barWidth, percentage int) int {
 	return filled
 }
 
+// SegmentWidths allocates the cells of a stacked bar to its segments in
+// proportion to their amounts. Segment boundaries are rounded from the
+// running total, so rounding errors never accumulate: the widths always add
+// up to the share of the bar the segments cover, and to exactly the bar
+// width when they make up the whole total. Very small segments may get no
+// cells.
+func (s *RenderService) SegmentWidths(stacked *model.Stacked) []int {
+	if stacked == nil {
+		return nil
+	}
+	segments := stacked.Segments()
+	widths := make([]int, len(segments))
+	total := stacked.Total()
+	if total == 0 {
+		return widths
+	}
+
+	width := stacked.Width()
+	sum, prev := 0, 0
+	for i, segment := range segments {
+		sum += segment.Value()
+		// Round half up: (sum * width / total) + 0.5.
+		boundary := (2*sum*width + total) / (2 * total)
+		widths[i] = boundary - prev
+		prev = boundary
+	}
+	return widths
+}
+
+// RenderStacked renders a stacked bar to a string.
+// Format: [label] [segment 1][segment 2]...[empty].
+// fill receives each segment's index and characters and may decorate them,
+// e.g. with the segment's color; segments without cells are skipped.
+func (s *RenderService) RenderStacked(stacked *model.Stacked, fill func(index int, cells []rune) string) string {
+	if stacked == nil {
+		return ""
+	}
+
+	var bar strings.Builder
+	used := 0
+	for i, w := range s.SegmentWidths(stacked) {
+		if w == 0 {
+			continue
+		}
+		bar.WriteString(fill(i, []rune(strings.Repeat(string(stacked.FillChar()), w))))
+		used += w
+	}
+	bar.WriteString(strings.Repeat(string(stacked.EmptyChar()), stacked.Width()-used))
+
+	if stacked.Label() != "" {
+		return stacked.Label() + " " + bar.String()
+	}
+	return bar.String()
+}
+
+// IndeterminateSegment returns the cells [start, end) of a bar of the given
+// width that are filled in an animation frame.
+//
+// Bounce moves a segment a quarter of the bar wide from end to end and back.
+// Pulse grows a segment from the center until it fills the bar, then shrinks
+// it back to nothing.
+func (s *RenderService) IndeterminateSegment(width int, animation value.IndeterminateStyle, frame int) (start, end int) {
+	if width <= 0 {
+		return 0, 0
+	}
+	if frame < 0 {
+		frame = 0
+	}
+
+	switch animation {
+	case value.IndeterminatePulse:
+		half := (width + 1) / 2
+		step := frame % (2 * half)
+		if step > half {
+			step = 2*half - step
+		}
+		size := min(2*step, width)
+		start = (width - size) / 2
+		return start, start + size
+	default:
+		size := max(width/4, 1)
+		travel := width - size
+		if travel == 0 {
+			return 0, width
+		}
+		pos := frame % (2 * travel)
+		if pos > travel {
+			pos = 2*travel - pos
+		}
+		return pos, pos + size
+	}
+}
+
+// FormatETA formats the time remaining as "ETA mm:ss", or "ETA h:mm:ss"
+// from one hour up. Partial seconds are rounded up, so the estimate only
+// reads "ETA 00:00" once nothing remains.
+func (s *RenderService) FormatETA(remaining time.Duration) string {
+	if remaining < 0 {
+		remaining = 0
+	}
+	secs := int64((remaining + time.Second - 1) / time.Second)
+	h, m, sec := secs/3600, secs/60%60, secs%60
+	if h > 0 {
+		return fmt.Sprintf("ETA %d:%02d:%02d", h, m, sec)
+	}
+	return fmt.Sprintf("ETA %02d:%02d", m, sec)
+}
+
+// FormatRate formats a throughput in units per second with one decimal,
+// e.g. "1.2 MB/s".
+func (s *RenderService) FormatRate(perSecond float64, unit string) string {
+	return fmt.Sprintf("%.1f %s/s", perSecond, unit)
+}
+
 // formatPercentage formats a percentage value as a string.
 func (s *RenderService) formatPercentage(pct int) string {
 	// Simple integer formatting.
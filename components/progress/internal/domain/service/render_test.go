@@ -3,8 +3,10 @@ package service
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
 )
 
 func TestNewRenderService(t *testing.T) {
@@ -170,6 +172,44 @@ func TestRenderBarStructure(t *testing.T) {
 	}
 }
 
+func TestRenderBarHead(t *testing.T) {
+	service := NewRenderService()
+
+	tests := []struct {
+		name       string
+		percentage int
+		want       string
+	}{
+		{"empty has no head", 0, "-----"},
+		{"partial ends in head", 60, "==>--"},
+		{"complete has no head", 100, "====="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bar := model.NewBarWithPercentage(5, tt.percentage).
+				WithFillChar('=').
+				WithEmptyChar('-').
+				WithHead('>')
+			if got := service.RenderBar(&bar); got != tt.want {
+				t.Errorf("RenderBar() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderBarWithFill(t *testing.T) {
+	service := NewRenderService()
+	bar := model.NewBarWithPercentage(4, 50).WithFillChar('#').WithEmptyChar('.')
+
+	got := service.RenderBarWithFill(&bar, func(filled []rune) string {
+		return "<" + string(filled) + ">"
+	})
+	if got != "<##>.." {
+		t.Errorf("RenderBarWithFill() = %q, want %q", got, "<##>..")
+	}
+}
+
 func TestRenderBarEmptyLabel(t *testing.T) {
 	service := NewRenderService()
 
@@ -268,3 +308,142 @@ func TestRenderBarAllPercentages(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatETA(t *testing.T) {
+	service := NewRenderService()
+	tests := []struct {
+		remaining time.Duration
+		want      string
+	}{
+		{0, "ETA 00:00"},
+		{-time.Second, "ETA 00:00"},
+		{500 * time.Millisecond, "ETA 00:01"},
+		{42 * time.Second, "ETA 00:42"},
+		{12*time.Minute + 5*time.Second, "ETA 12:05"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "ETA 1:02:03"},
+	}
+	for _, tt := range tests {
+		if got := service.FormatETA(tt.remaining); got != tt.want {
+			t.Errorf("FormatETA(%v) = %q, want %q", tt.remaining, got, tt.want)
+		}
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	service := NewRenderService()
+	if got := service.FormatRate(1.23, "MB"); got != "1.2 MB/s" {
+		t.Errorf("FormatRate() = %q, want %q", got, "1.2 MB/s")
+	}
+}
+
+func TestIndeterminateSegment(t *testing.T) {
+	service := NewRenderService()
+
+	// Bounce: a 2-cell segment travels 0 → 6 → 0 across 8 cells.
+	bounce := []int{0, 1, 2, 3, 4, 5, 6, 5, 4, 3, 2, 1, 0, 1}
+	for frame, want := range bounce {
+		start, end := service.IndeterminateSegment(8, value.IndeterminateBounce, frame)
+		if start != want || end != want+2 {
+			t.Errorf("bounce frame %d = [%d, %d), want [%d, %d)", frame, start, end, want, want+2)
+		}
+	}
+
+	// Pulse: grows from the center to the full width and back.
+	pulse := [][2]int{{4, 4}, {3, 5}, {2, 6}, {1, 7}, {0, 8}, {1, 7}, {2, 6}, {3, 5}, {4, 4}}
+	for frame, want := range pulse {
+		start, end := service.IndeterminateSegment(8, value.IndeterminatePulse, frame)
+		if start != want[0] || end != want[1] {
+			t.Errorf("pulse frame %d = [%d, %d), want %v", frame, start, end, want)
+		}
+	}
+
+	// Odd widths still reach the full bar.
+	if start, end := service.IndeterminateSegment(5, value.IndeterminatePulse, 3); start != 0 || end != 5 {
+		t.Errorf("pulse width 5 peak = [%d, %d), want [0, 5)", start, end)
+	}
+	// Narrow bars bounce a single cell, a 1-cell bar is always full.
+	if start, end := service.IndeterminateSegment(3, value.IndeterminateBounce, 1); start != 1 || end != 2 {
+		t.Errorf("bounce width 3 = [%d, %d), want [1, 2)", start, end)
+	}
+	if start, end := service.IndeterminateSegment(1, value.IndeterminateBounce, 7); start != 0 || end != 1 {
+		t.Errorf("bounce width 1 = [%d, %d), want [0, 1)", start, end)
+	}
+}
+
+func TestRenderBarIndeterminate(t *testing.T) {
+	service := NewRenderService()
+	bar := model.NewBarWithPercentage(8, 50).
+		WithShowPercent(true).
+		WithHead('>').
+		WithLabel("Working").
+		WithIndeterminate(true).
+		NextFrame().NextFrame()
+
+	// No percentage or head for unknown progress.
+	if got, want := service.RenderBar(&bar), "Working ░░██░░░░"; got != want {
+		t.Errorf("RenderBar() = %q, want %q", got, want)
+	}
+}
+
+func TestSegmentWidths(t *testing.T) {
+	service := NewRenderService()
+	stacked := func(width, total int, values ...int) *model.Stacked {
+		s := model.NewStacked(width).WithTotal(total)
+		for _, v := range values {
+			s = s.WithSegment(value.NewSegment("", v))
+		}
+		return &s
+	}
+
+	tests := []struct {
+		name string
+		bar  *model.Stacked
+		want []int
+	}{
+		{"Even split", stacked(10, 0, 1, 1), []int{5, 5}},
+		{"Thirds fill the bar exactly", stacked(10, 0, 1, 1, 1), []int{3, 4, 3}},
+		{"Sevenths", stacked(20, 0, 1, 1, 1, 1, 1, 1, 1), []int{3, 3, 3, 2, 3, 3, 3}},
+		{"Partial total", stacked(10, 100, 25, 25), []int{3, 2}},
+		{"Tiny segment", stacked(10, 0, 1, 1000), []int{0, 10}},
+		{"Zero total", stacked(10, 0, 0, 0), []int{0, 0}},
+		{"No segments", stacked(10, 0), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.SegmentWidths(tt.bar)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SegmentWidths() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("SegmentWidths() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderStacked(t *testing.T) {
+	service := NewRenderService()
+	s := model.NewStacked(10).
+		WithTotal(10).
+		WithLabel("Disk").
+		WithSegment(value.NewSegment("a", 3)).
+		WithSegment(value.NewSegment("b", 0)).
+		WithSegment(value.NewSegment("c", 5))
+
+	var indexes []int
+	got := service.RenderStacked(&s, func(i int, cells []rune) string {
+		indexes = append(indexes, i)
+		return strings.ToUpper(string(rune('a'+i))) + string(cells[1:])
+	})
+	if want := "Disk A██C████░░"; got != want {
+		t.Errorf("RenderStacked() = %q, want %q", got, want)
+	}
+	if len(indexes) != 2 || indexes[0] != 0 || indexes[1] != 2 {
+		t.Errorf("fill called for segments %v, want [0 2]", indexes)
+	}
+	if service.RenderStacked(nil, nil) != "" {
+		t.Error("RenderStacked(nil) should be empty")
+	}
+}
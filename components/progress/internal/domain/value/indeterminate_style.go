@@ -0,0 +1,23 @@
+package value
+
+// IndeterminateStyle selects how an indeterminate progress bar animates.
+type IndeterminateStyle int
+
+const (
+	// IndeterminateBounce moves a segment back and forth across the bar.
+	IndeterminateBounce IndeterminateStyle = iota
+	// IndeterminatePulse grows a segment from the center to the full bar and back.
+	IndeterminatePulse
+)
+
+// String returns the name of the style.
+func (s IndeterminateStyle) String() string {
+	switch s {
+	case IndeterminateBounce:
+		return "bounce"
+	case IndeterminatePulse:
+		return "pulse"
+	default:
+		return "unknown"
+	}
+}
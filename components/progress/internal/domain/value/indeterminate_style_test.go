@@ -0,0 +1,19 @@
+package value
+
+import "testing"
+
+func TestIndeterminateStyleString(t *testing.T) {
+	tests := []struct {
+		style IndeterminateStyle
+		want  string
+	}{
+		{IndeterminateBounce, "bounce"},
+		{IndeterminatePulse, "pulse"},
+		{IndeterminateStyle(99), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.style.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package value
+
+// Segment is one part of a stacked progress bar: an amount of the total
+// with an optional label.
+type Segment struct {
+	label string // Optional label (e.g., "Photos")
+	value int    // Amount (non-negative)
+}
+
+// NewSegment creates a new Segment. Negative values are clamped to 0.
+func NewSegment(label string, value int) Segment {
+	if value < 0 {
+		value = 0
+	}
+	return Segment{label: label, value: value}
+}
+
+// Label returns the segment label.
+func (s Segment) Label() string {
+	return s.label
+}
+
+// Value returns the segment amount.
+func (s Segment) Value() int {
+	return s.value
+}
@@ -0,0 +1,13 @@
+package value
+
+import "testing"
+
+func TestNewSegment(t *testing.T) {
+	s := NewSegment("Photos", 40)
+	if s.Label() != "Photos" || s.Value() != 40 {
+		t.Errorf("NewSegment() = %q %d, want Photos 40", s.Label(), s.Value())
+	}
+	if got := NewSegment("", -5).Value(); got != 0 {
+		t.Errorf("negative value = %d, want 0", got)
+	}
+}
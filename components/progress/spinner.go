@@ -2,6 +2,7 @@ package progress
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/model"
@@ -22,6 +23,33 @@ import (
 type Spinner struct {
 	domain model.Spinner // VALUE, not pointer!
 	theme  *style.Theme  // Optional theme, defaults to DefaultTheme if nil
+	task   *loadingTask  // Task the spinner animates for, nil if none
+}
+
+// LoadedMsg is sent when the task started by RunWhileLoading finishes.
+// Pass it to the spinner's Update: the spinner stops animating and returns
+// a command that delivers the task's own message to your Update.
+type LoadedMsg struct {
+	task *loadingTask
+	Msg  tea.Msg // The message returned by the task
+}
+
+// loadingTask runs a task once and records its result.
+type loadingTask struct {
+	fn   func() tea.Msg
+	once sync.Once
+	done chan struct{}
+	msg  tea.Msg
+}
+
+// start runs the task in the background the first time it is called.
+func (t *loadingTask) start() {
+	t.once.Do(func() {
+		go func() {
+			defer close(t.done)
+			t.msg = t.fn()
+		}()
+	})
 }
 
 // NewSpinner creates a new spinner with the specified pre-defined style.
@@ -45,6 +73,60 @@ func NewSpinnerCustom(_ []string, fps int) *Spinner {
 	}
 }
 
+// RunWhileLoading starts task in the background and returns a "dots"
+// spinner that animates until the task finishes.
+// This is the usual "show a spinner during a network call" pattern:
+//
+//	func (m Model) fetch() (Model, tea.Cmd) {
+//		cmd, spinner := progress.RunWhileLoading(func() tea.Msg {
+//			return fetchUsers()
+//		})
+//		m.spinner = spinner.Label("Loading users...")
+//		return m, cmd
+//	}
+//
+//	func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+//		switch msg := msg.(type) {
+//		case usersMsg:
+//			m.users = msg // Arrives after the spinner has stopped
+//			return m, nil
+//		}
+//		var cmd tea.Cmd
+//		m.spinner, cmd = m.spinner.Update(msg)
+//		return m, cmd
+//	}
+//
+// The returned command starts both the task and the animation, so there is
+// no need to also return the spinner's Init command; where the command
+// cannot be returned (e.g. when building the initial model), returning
+// spinner.Init() from Init starts the task instead. Use Spinner.RunWhile
+// for other spinner styles.
+func RunWhileLoading(task func() tea.Msg) (tea.Cmd, Spinner) {
+	spinner, cmd := NewSpinner("dots").RunWhile(task)
+	return cmd, spinner
+}
+
+// RunWhile starts task in the background and ties the spinner's animation
+// to it: the spinner ticks until the task finishes, then a LoadedMsg
+// carrying the task's message is sent. Update stops the spinner on that
+// LoadedMsg and returns a command delivering the task's message.
+// A nil task returns the spinner unchanged and a nil command.
+// IMPORTANT: Must reassign: spinner, cmd = spinner.RunWhile(task).
+func (s Spinner) RunWhile(task func() tea.Msg) (Spinner, tea.Cmd) {
+	if task == nil {
+		return s, nil
+	}
+
+	s.task = &loadingTask{fn: task, done: make(chan struct{})}
+	return s, s.tick()
+}
+
+// IsLoading returns true if the spinner is animating for a task started
+// with RunWhile or RunWhileLoading that has not been delivered yet.
+func (s Spinner) IsLoading() bool {
+	return s.task != nil
+}
+
 // Label sets the label text displayed with the spinner.
 // Returns new Spinner for method chaining (value semantics).
 // IMPORTANT: Must reassign: spinner = spinner.Label("text").
@@ -70,14 +152,25 @@ func (s Spinner) Init() tea.Cmd {
 
 // Update handles messages (implements tea model contract).
 // Advances the animation frame on tea.TickMsg.
+// On the LoadedMsg of its own task, stops and returns a command that
+// delivers the task's message.
 // IMPORTANT: Must reassign: spinner = spinner.Update(msg).
 func (s Spinner) Update(msg tea.Msg) (Spinner, tea.Cmd) {
-	//nolint:gocritic // single case for now, will expand with pause/resume
-	switch msg.(type) {
+	switch msg := msg.(type) {
 	case tea.TickMsg:
 		// Advance to next frame.
 		s.domain = s.domain.NextFrame()
 		return s, s.tick()
+	case LoadedMsg:
+		if s.task == nil || msg.task != s.task {
+			return s, nil // Another spinner's task, or already delivered
+		}
+		s.task = nil
+		if msg.Msg == nil {
+			return s, nil
+		}
+		result := msg.Msg
+		return s, func() tea.Msg { return result }
 	}
 	return s, nil
 }
@@ -96,8 +189,27 @@ func (s Spinner) View() string {
 }
 
 // tick returns a command to schedule the next animation frame.
+// While loading, the command returns the task's LoadedMsg instead as soon
+// as the task finishes, which ends the tick loop.
 func (s Spinner) tick() tea.Cmd {
 	fps := s.domain.Style().FPS()
 	duration := time.Second / time.Duration(fps)
-	return tea.Tick(duration)
+	if s.task == nil {
+		return tea.Tick(duration)
+	}
+
+	t := s.task
+	return func() tea.Msg {
+		t.start()
+
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+
+		select {
+		case <-t.done:
+			return LoadedMsg{task: t, Msg: t.msg}
+		case now := <-timer.C:
+			return tea.TickMsg{Time: now}
+		}
+	}
 }
@@ -318,3 +318,86 @@ func TestSpinnerViewFormat(t *testing.T) {
 		t.Errorf("Label in view = %q, expected 'Test'", parts[1])
 	}
 }
+
+type usersLoadedMsg struct{ count int }
+
+func TestRunWhileLoading(t *testing.T) {
+	release := make(chan struct{})
+	cmd, spinner := RunWhileLoading(func() tea.Msg {
+		<-release
+		return usersLoadedMsg{count: 3}
+	})
+
+	if cmd == nil {
+		t.Fatal("RunWhileLoading() returned nil cmd")
+	}
+	if !spinner.IsLoading() {
+		t.Fatal("IsLoading() = false while the task is running")
+	}
+
+	// While the task runs, the command produces animation ticks
+	msg := cmd()
+	if _, ok := msg.(tea.TickMsg); !ok {
+		t.Fatalf("cmd() = %T while loading, want tea.TickMsg", msg)
+	}
+	spinner, cmd = spinner.Update(msg)
+	if cmd == nil {
+		t.Fatal("Update(TickMsg) returned nil cmd while loading")
+	}
+
+	// Once the task finishes, the next tick delivers its result instead
+	close(release)
+	msg = cmd()
+	loaded, ok := msg.(LoadedMsg)
+	if !ok {
+		t.Fatalf("cmd() = %T after the task finished, want LoadedMsg", msg)
+	}
+
+	spinner, cmd = spinner.Update(loaded)
+	if spinner.IsLoading() {
+		t.Error("IsLoading() = true after LoadedMsg")
+	}
+	if cmd == nil {
+		t.Fatal("Update(LoadedMsg) returned nil cmd")
+	}
+	if got, ok := cmd().(usersLoadedMsg); !ok || got.count != 3 {
+		t.Errorf("delivered %#v, want usersLoadedMsg{count: 3}", got)
+	}
+
+	// The tick loop has ended and a duplicate LoadedMsg is ignored
+	if _, cmd := spinner.Update(loaded); cmd != nil {
+		t.Error("Update() returned cmd for an already delivered LoadedMsg")
+	}
+}
+
+func TestSpinnerRunWhileIgnoresOtherTasks(t *testing.T) {
+	first, firstCmd := NewSpinner("line").RunWhile(func() tea.Msg { return nil })
+	second, _ := NewSpinner("line").RunWhile(func() tea.Msg { return nil })
+
+	// The task may not have finished before the first frames
+	msg := firstCmd()
+	for {
+		if _, ok := msg.(LoadedMsg); ok {
+			break
+		}
+		first, firstCmd = first.Update(msg)
+		msg = firstCmd()
+	}
+
+	if second, cmd := second.Update(msg); cmd != nil || !second.IsLoading() {
+		t.Error("spinner reacted to another spinner's LoadedMsg")
+	}
+
+	// A nil result stops the spinner without delivering anything
+	first, cmd := first.Update(msg)
+	if first.IsLoading() || cmd != nil {
+		t.Errorf("Update(LoadedMsg{nil}) = loading %v, cmd %v", first.IsLoading(), cmd != nil)
+	}
+}
+
+func TestSpinnerRunWhileNilTask(t *testing.T) {
+	spinner, cmd := NewSpinner("dots").RunWhile(nil)
+	if cmd != nil || spinner.IsLoading() {
+		t.Error("RunWhile(nil) should return a non-loading spinner and nil cmd")
+	}
+}
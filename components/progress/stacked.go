@@ -0,0 +1,176 @@
+package progress
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/components/progress/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// Segment is one part of a stacked bar.
+type Segment struct {
+	Label string      // Legend text (e.g., "Photos"), may be empty
+	Value int         // Amount of the total
+	Style style.Style // Style of the segment's cells (e.g., a foreground color)
+}
+
+// Stacked is the public API for a stacked progress bar, showing how a total
+// is composed (e.g. disk usage by category) with one colored segment per
+// part. It implements tea.Model and provides a fluent interface for
+// configuration. Uses value semantics for immutable updates.
+//
+// Zero value: Stacked with zero value has nil service and will panic if used.
+// Always use NewStacked() to create a valid Stacked instance.
+//
+//	var s progress.Stacked           // Zero value - INVALID, will panic
+//	s2 := progress.NewStacked(50)    // Correct - use constructor with width
+type Stacked struct {
+	domain  model.Stacked // VALUE, not pointer!
+	service *service.RenderService
+	styles  []style.Style            // Segment styles, parallel to domain segments
+	colors  style.TerminalCapability // Color depth the segments are rendered for
+}
+
+// NewStacked creates a new stacked bar with the specified width and no
+// segments. By default the segments fill the whole bar; use WithTotal to
+// leave room for the rest.
+// Returns pointer for initialization, but store as value in Model.
+func NewStacked(width int) *Stacked {
+	return &Stacked{
+		domain:  *model.NewStacked(width), // Dereference!
+		service: service.NewRenderService(),
+		colors:  detectColors(),
+	}
+}
+
+// AddSegment appends an unlabeled segment of the given amount, drawn with
+// the given style. Negative amounts count as 0.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.AddSegment(40, style.New().Foreground(style.Blue)).
+func (s Stacked) AddSegment(amount int, st style.Style) Stacked {
+	return s.AddLabeledSegment("", amount, st)
+}
+
+// AddLabeledSegment appends a segment like AddSegment, with a label for
+// the legend.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.AddLabeledSegment("Photos", 40, photoStyle).
+func (s Stacked) AddLabeledSegment(label string, amount int, st style.Style) Stacked {
+	s.domain = s.domain.WithSegment(value.NewSegment(label, amount))
+	styles := make([]style.Style, 0, len(s.styles)+1)
+	styles = append(styles, s.styles...)
+	s.styles = append(styles, st)
+	return s
+}
+
+// WithTotal sets the amount the full bar stands for, leaving the part not
+// covered by segments empty (e.g. free disk space). Totals smaller than
+// the sum of the segments are ignored; 0 makes the segments fill the bar.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.WithTotal(500).
+func (s Stacked) WithTotal(total int) Stacked {
+	s.domain = s.domain.WithTotal(total)
+	return s
+}
+
+// WithRunes sets the characters of the segments and of the empty remainder.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.WithRunes('■', '·').
+func (s Stacked) WithRunes(filled, empty rune) Stacked {
+	s.domain = s.domain.WithFillChar(filled).WithEmptyChar(empty)
+	return s
+}
+
+// Label sets the label text displayed before the bar.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.Label("Disk").
+func (s Stacked) Label(label string) Stacked {
+	s.domain = s.domain.WithLabel(label)
+	return s
+}
+
+// ColorCapability sets the terminal color depth the segments are rendered
+// for, overriding detection from the environment.
+// Returns new Stacked for method chaining (value semantics).
+// IMPORTANT: Must reassign: s = s.ColorCapability(style.ANSI256).
+func (s Stacked) ColorCapability(tc style.TerminalCapability) Stacked {
+	s.colors = tc
+	return s
+}
+
+// Segments returns the segments in order, left to right.
+func (s Stacked) Segments() []Segment {
+	segments := s.domain.Segments()
+	result := make([]Segment, len(segments))
+	for i, segment := range segments {
+		result[i] = Segment{Label: segment.Label(), Value: segment.Value(), Style: s.styles[i]}
+	}
+	return result
+}
+
+// Total returns the amount the full bar stands for.
+func (s Stacked) Total() int {
+	return s.domain.Total()
+}
+
+// SegmentWidths returns the number of cells of each segment. The widths
+// are proportional to the amounts and add up to exactly the bar width when
+// the segments make up the whole total.
+func (s Stacked) SegmentWidths() []int {
+	return s.service.SegmentWidths(&s.domain)
+}
+
+// Legend renders a key for the segments, one entry per segment with its
+// styled character, label and share of the total, e.g.
+// "█ Photos 45%  █ Music 30%".
+func (s Stacked) Legend() string {
+	segments := s.domain.Segments()
+	total := s.domain.Total()
+	entries := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		entry := []string{s.styled(i, string(s.domain.FillChar()))}
+		if segment.Label() != "" {
+			entry = append(entry, segment.Label())
+		}
+		pct := 0
+		if total > 0 {
+			pct = (segment.Value()*200 + total) / (2 * total) // Rounded
+		}
+		entry = append(entry, strconv.Itoa(pct)+"%")
+		entries = append(entries, strings.Join(entry, " "))
+	}
+	return strings.Join(entries, "  ")
+}
+
+// Init initializes the stacked bar (tea.Model interface).
+// Returns nil as stacked bars don't need initialization commands.
+func (s Stacked) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages (implements tea model contract).
+// Stacked bars don't respond to messages - use AddSegment() instead.
+// IMPORTANT: Must reassign: s = s.Update(msg).
+func (s Stacked) Update(_ tea.Msg) (Stacked, tea.Cmd) {
+	return s, nil
+}
+
+// View renders the stacked bar to a string (tea.Model interface).
+// Format: [label] [segment 1][segment 2]...[empty].
+func (s Stacked) View() string {
+	return s.service.RenderStacked(&s.domain, func(i int, cells []rune) string {
+		return s.styled(i, string(cells))
+	})
+}
+
+// styled renders text in the style of segment i for the terminal's color depth.
+func (s Stacked) styled(i int, text string) string {
+	if !s.colors.SupportsColor() {
+		return text
+	}
+	return style.Render(style.WithTerminalCapability(s.styles[i], s.colors), text)
+}
@@ -0,0 +1,107 @@
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+func TestNewStacked(t *testing.T) {
+	s := NewStacked(10)
+	if got := s.View(); got != "░░░░░░░░░░" {
+		t.Errorf("empty View() = %q", got)
+	}
+	if len(s.Segments()) != 0 || s.Total() != 0 {
+		t.Errorf("new stacked has %d segments, total %d", len(s.Segments()), s.Total())
+	}
+}
+
+func TestStackedView(t *testing.T) {
+	s := NewStacked(12).
+		ColorCapability(style.NoColor).
+		AddSegment(1, style.New()).
+		AddSegment(1, style.New()).
+		AddSegment(1, style.New()).
+		WithRunes('#', '.').
+		Label("Disk")
+
+	if got, want := s.View(), "Disk ############"; got != want {
+		t.Errorf("View() = %q, want %q", got, want)
+	}
+	if got := s.WithTotal(6).View(); got != "Disk ######......" {
+		t.Errorf("View() with total = %q", got)
+	}
+
+	// Rounding never leaves the bar short or long.
+	for width := 1; width <= 40; width++ {
+		bar := NewStacked(width).
+			AddSegment(7, style.New()).
+			AddSegment(11, style.New()).
+			AddSegment(13, style.New())
+		sum := 0
+		for _, w := range bar.SegmentWidths() {
+			sum += w
+		}
+		if sum != width {
+			t.Errorf("width %d: segment widths %v sum to %d", width, bar.SegmentWidths(), sum)
+		}
+	}
+}
+
+func TestStackedColors(t *testing.T) {
+	red := style.New().Foreground(style.RGB(255, 0, 0))
+	blue := style.New().Foreground(style.RGB(0, 0, 255))
+	s := NewStacked(4).
+		ColorCapability(style.TrueColor).
+		AddSegment(1, red).
+		AddSegment(1, blue)
+
+	got := s.View()
+	if !strings.Contains(got, "38;2;255;0;0") || !strings.Contains(got, "38;2;0;0;255") {
+		t.Errorf("View() = %q, want both segment colors", got)
+	}
+	if plain := s.ColorCapability(style.NoColor).View(); plain != "████" {
+		t.Errorf("View() without colors = %q", plain)
+	}
+}
+
+func TestStackedSegmentsAndLegend(t *testing.T) {
+	photos := style.New().Foreground(style.Red)
+	s := NewStacked(10).
+		ColorCapability(style.NoColor).
+		AddLabeledSegment("Photos", 45, photos).
+		AddLabeledSegment("Music", 30, style.New()).
+		AddSegment(-5, style.New()).
+		WithTotal(200)
+
+	segments := s.Segments()
+	if len(segments) != 3 {
+		t.Fatalf("Segments() = %d, want 3", len(segments))
+	}
+	if segments[0].Label != "Photos" || segments[0].Value != 45 || segments[0].Style != photos {
+		t.Errorf("Segments()[0] = %+v", segments[0])
+	}
+	if segments[2].Value != 0 {
+		t.Errorf("negative segment Value = %d, want 0", segments[2].Value)
+	}
+	if s.Total() != 200 {
+		t.Errorf("Total() = %d, want 200", s.Total())
+	}
+
+	if got, want := s.Legend(), "█ Photos 23%  █ Music 15%  █ 0%"; got != want {
+		t.Errorf("Legend() = %q, want %q", got, want)
+	}
+}
+
+func TestStackedTeaModel(t *testing.T) {
+	s := NewStacked(10).AddSegment(5, style.New())
+	if s.Init() != nil {
+		t.Error("Init() should return nil")
+	}
+	updated, cmd := s.Update(tea.TickMsg{})
+	if cmd != nil || updated.View() != s.View() {
+		t.Error("Update() should not change the bar")
+	}
+}
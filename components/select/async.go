@@ -0,0 +1,118 @@
+package selectcomponent
+
+import (
+	"sync"
+
+	"github.com/phoenix-tui/phoenix/components/progress"
+	"github.com/phoenix-tui/phoenix/components/select/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// asyncLoad runs an option loader once in the background and holds its result.
+//
+// The loader runs in its own goroutine rather than as a tea.Cmd, because a
+// command batched with the spinner's tick would hold the tick back until the
+// loader returns. Instead, every spinner tick polls for the result.
+type asyncLoad[T any] struct {
+	once   sync.Once
+	loader func() ([]*value.Option[T], error)
+	done   chan struct{} // Closed once result is set
+	result loadResult[T]
+}
+
+// loadResult is the outcome of an option load.
+type loadResult[T any] struct {
+	options []*value.Option[T]
+	err     error
+}
+
+// newAsyncLoad creates a load that has not started yet.
+func newAsyncLoad[T any](loader func() ([]*value.Option[T], error)) *asyncLoad[T] {
+	return &asyncLoad[T]{
+		loader: loader,
+		done:   make(chan struct{}),
+	}
+}
+
+// start runs the loader in the background, the first time it is called.
+func (l *asyncLoad[T]) start() {
+	l.once.Do(func() {
+		go func() {
+			options, err := l.loader()
+			l.result = loadResult[T]{options: options, err: err}
+			close(l.done)
+		}()
+	})
+}
+
+// poll returns the result if the loader has finished.
+func (l *asyncLoad[T]) poll() (loadResult[T], bool) {
+	select {
+	case <-l.done:
+		return l.result, true
+	default:
+		return loadResult[T]{}, false
+	}
+}
+
+// NewAsync creates a new Select whose options are fetched by loader, e.g.
+// from a network call. Init starts loading in the background and a spinner
+// is shown meanwhile; filter input typed while loading is applied once the
+// options arrive. If loader fails, the error is shown with a prompt to
+// retry with r.
+func NewAsync[T any](title string, loader func() ([]*value.Option[T], error)) *Select[T] {
+	s := New[T](title)
+	s.loader = loader
+	s.load = newAsyncLoad(loader)
+	s.loading = true
+	s.spinner = progress.NewSpinner("dots").Label("Loading options...")
+	return s
+}
+
+// IsLoading returns true while options are being loaded (see NewAsync).
+func (s *Select[T]) IsLoading() bool {
+	return s.loading
+}
+
+// LoadError returns the error of the last failed option load, or nil.
+func (s *Select[T]) LoadError() error {
+	return s.loadErr
+}
+
+// startLoad starts the loader and returns the command animating the spinner.
+func (s *Select[T]) startLoad() tea.Cmd {
+	s.load.start()
+	return s.spinner.Init()
+}
+
+// retryLoad returns a copy that loads the options again after an error,
+// and the command animating its spinner.
+func (s *Select[T]) retryLoad() (*Select[T], tea.Cmd) {
+	newS := s.clone()
+	newS.load = newAsyncLoad(s.loader)
+	newS.loading = true
+	newS.loadErr = nil
+	return newS, newS.startLoad()
+}
+
+// handleLoadingTick applies the load result if it has arrived, keeping the
+// filter query typed while loading, or advances the spinner otherwise.
+func (s *Select[T]) handleLoadingTick(msg tea.TickMsg) (*Select[T], tea.Cmd) {
+	newS := s.clone()
+
+	result, ok := s.load.poll()
+	if !ok {
+		var cmd tea.Cmd
+		newS.spinner, cmd = s.spinner.Update(msg)
+		return newS, cmd
+	}
+
+	newS.load = nil
+	newS.loading = false
+	if result.err != nil {
+		newS.loadErr = result.err
+		return newS, nil
+	}
+	newS.domain = s.domain.WithOptions(result.options)
+	return newS, nil
+}
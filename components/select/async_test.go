@@ -0,0 +1,142 @@
+package selectcomponent
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/components/select/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// waitLoaded ticks the select until it stops loading.
+func waitLoaded[T any](t *testing.T, s *Select[T]) *Select[T] {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for s.IsLoading() {
+		if time.Now().After(deadline) {
+			t.Fatal("options did not load")
+		}
+		s, _ = s.Update(tea.TickMsg{Time: time.Now()})
+		time.Sleep(time.Millisecond)
+	}
+	return s
+}
+
+func fruitLoader() ([]*value.Option[string], error) {
+	return []*value.Option[string]{
+		Opt("Apple", "apple"),
+		Opt("Banana", "banana"),
+		Opt("Mango", "mango"),
+	}, nil
+}
+
+func TestNewAsync(t *testing.T) {
+	release := make(chan struct{})
+	s := NewAsync("Fruit:", func() ([]*value.Option[string], error) {
+		<-release
+		return fruitLoader()
+	}).WithFilterable(true)
+
+	if !s.IsLoading() {
+		t.Fatal("IsLoading() = false before loading")
+	}
+	if s.Init() == nil {
+		t.Fatal("Init() should start loading")
+	}
+	if !strings.Contains(s.View(), "Loading options...") {
+		t.Errorf("View() while loading = %q", s.View())
+	}
+
+	// The spinner keeps animating while the loader is busy.
+	s, cmd := s.Update(tea.TickMsg{})
+	if cmd == nil || !s.IsLoading() {
+		t.Error("tick while loading should schedule the next spinner frame")
+	}
+
+	// Filter input and Enter while loading.
+	for _, r := range "an" {
+		s, _ = s.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	if _, cmd := s.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("Enter while loading should not confirm")
+	}
+
+	close(release)
+	s = waitLoaded(t, s)
+
+	if s.LoadError() != nil {
+		t.Fatalf("LoadError() = %v", s.LoadError())
+	}
+	view := s.View()
+	if !strings.Contains(view, "Banana") || !strings.Contains(view, "Mango") || strings.Contains(view, "Apple") {
+		t.Errorf("View() after load should show options matching the buffered filter, got %q", view)
+	}
+	if !strings.Contains(view, "Filter: an") {
+		t.Errorf("View() should keep the buffered filter, got %q", view)
+	}
+}
+
+func TestNewAsync_ErrorAndRetry(t *testing.T) {
+	calls := 0
+	s := NewAsync("Fruit:", func() ([]*value.Option[string], error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection refused")
+		}
+		return fruitLoader()
+	})
+
+	s.Init()
+	s = waitLoaded(t, s)
+
+	if s.LoadError() == nil || s.LoadError().Error() != "connection refused" {
+		t.Fatalf("LoadError() = %v, want connection refused", s.LoadError())
+	}
+	view := s.View()
+	if !strings.Contains(view, "connection refused") || !strings.Contains(view, "Press r to retry") {
+		t.Errorf("View() after error = %q", view)
+	}
+
+	s, cmd := s.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'r'})
+	if cmd == nil || !s.IsLoading() || s.LoadError() != nil {
+		t.Fatal("r should retry loading")
+	}
+	s = waitLoaded(t, s)
+
+	if calls != 2 {
+		t.Errorf("loader called %d times, want 2", calls)
+	}
+	if got, ok := s.FocusedValue(); !ok || got != "apple" {
+		t.Errorf("FocusedValue() = %q, %v; want apple", got, ok)
+	}
+}
+
+func TestNewAsync_InitTwice(t *testing.T) {
+	calls := 0
+	s := NewAsync("", func() ([]*value.Option[string], error) {
+		calls++
+		return fruitLoader()
+	})
+	s.Init()
+	s.Init()
+	s = waitLoaded(t, s)
+
+	if calls != 1 {
+		t.Errorf("loader called %d times, want 1", calls)
+	}
+	if s.Init() != nil {
+		t.Error("Init() after loading should return nil")
+	}
+}
+
+func TestSelect_NotAsync(t *testing.T) {
+	s := NewString("", []string{"A"})
+	if s.IsLoading() || s.LoadError() != nil || s.Init() != nil {
+		t.Error("plain select should not load")
+	}
+	if s2, cmd := s.Update(tea.TickMsg{}); cmd != nil || s2 != s {
+		t.Error("plain select should ignore ticks")
+	}
+}
@@ -38,3 +38,8 @@ func defaultRender[T any](opt *value.Option[T], _ int, focused bool) string {
 
 	return result
 }
+
+// renderGroupHeader renders a group header as a divider line.
+func renderGroupHeader(group string) string {
+	return "── " + group + " ──"
+}
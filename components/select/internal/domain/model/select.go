@@ -92,6 +92,26 @@ func (s *Select[T]) WithRenderFunc(fn RenderFunc[T]) *Select[T] {
 	}
 }
 
+// WithOptions returns a new Select with the options replaced, keeping its
+// configuration and filter query. The cursor returns to the first option
+// and the selection is cleared.
+func (s *Select[T]) WithOptions(options []*value.Option[T]) *Select[T] {
+	if len(options) == 0 {
+		options = make([]*value.Option[T], 0)
+	}
+	return (&Select[T]{
+		options:       options,
+		cursor:        0,
+		selectedIndex: -1,
+		filterQuery:   s.filterQuery,
+		filteredOpts:  options,
+		height:        s.height,
+		scrollOffset:  0,
+		filterFunc:    s.filterFunc,
+		renderFunc:    s.renderFunc,
+	}).SetFilterQuery(s.filterQuery)
+}
+
 // MoveUp moves the cursor up one position.
 func (s *Select[T]) MoveUp() *Select[T] {
 	newCursor := s.cursor
@@ -194,10 +214,15 @@ func (s *Select[T]) FocusedValue() (T, bool) {
 }
 
 // RenderVisibleOptions returns the rendered strings for visible options.
+// When options are grouped, header lines are included and count towards
+// the height.
 func (s *Select[T]) RenderVisibleOptions() []string {
 	if len(s.filteredOpts) == 0 {
 		return []string{}
 	}
+	if s.hasGroups() {
+		return s.renderVisibleGroups()
+	}
 
 	// Adjust scroll offset to keep cursor visible
 	newS := s.adjustScrollOffset()
@@ -219,6 +244,75 @@ func (s *Select[T]) RenderVisibleOptions() []string {
 	return result
 }
 
+// groupLine is one line of a grouped option list: an option, or the header
+// of the group that follows.
+type groupLine struct {
+	option int // Index in filteredOpts, -1 for a header
+	group  string
+}
+
+// hasGroups reports whether any filtered option belongs to a group.
+func (s *Select[T]) hasGroups() bool {
+	for _, opt := range s.filteredOpts {
+		if opt.Group() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// groupLines lays out the filtered options with a header line before each
+// group of consecutive options sharing a group. Groups without matching
+// options get no header.
+func (s *Select[T]) groupLines() []groupLine {
+	lines := make([]groupLine, 0, len(s.filteredOpts)*2)
+	prev := ""
+	for i, opt := range s.filteredOpts {
+		group := opt.Group()
+		if group != "" && (i == 0 || group != prev) {
+			lines = append(lines, groupLine{option: -1, group: group})
+		}
+		lines = append(lines, groupLine{option: i, group: group})
+		prev = group
+	}
+	return lines
+}
+
+// renderVisibleGroups renders the visible lines of a grouped option list.
+// Scrolling works on lines so the cursor stays visible; when the viewport
+// starts inside a group, that group's header sticks to the top line.
+func (s *Select[T]) renderVisibleGroups() []string {
+	lines := s.groupLines()
+	cursorLine := 0
+	for i, line := range lines {
+		if line.option == s.cursor {
+			cursorLine = i
+			break
+		}
+	}
+
+	start := 0
+	if cursorLine >= s.height {
+		start = cursorLine - s.height + 1
+	}
+	end := min(start+s.height, len(lines))
+
+	result := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		line := lines[i]
+		switch {
+		case line.option < 0:
+			result = append(result, renderGroupHeader(line.group))
+		case i == start && line.group != "" && i != cursorLine:
+			// Sticky header: the group's own header has scrolled away.
+			result = append(result, renderGroupHeader(line.group))
+		default:
+			result = append(result, s.renderFunc(s.filteredOpts[line.option], line.option, line.option == s.cursor))
+		}
+	}
+	return result
+}
+
 // FilterQuery returns the current filter query.
 func (s *Select[T]) FilterQuery() string {
 	return s.filterQuery
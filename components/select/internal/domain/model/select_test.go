@@ -1,6 +1,7 @@
 package model
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/select/internal/domain/value"
@@ -326,3 +327,78 @@ func TestWithRenderFunc(t *testing.T) {
 		t.Errorf("expected '[x] Option 1', got %q", rendered[0])
 	}
 }
+
+func TestWithOptions(t *testing.T) {
+	sel := New[string](nil).
+		WithHeight(3).
+		SetFilterQuery("an")
+
+	sel = sel.WithOptions([]*value.Option[string]{
+		value.NewOption("Apple", "apple"),
+		value.NewOption("Banana", "banana"),
+		value.NewOption("Mango", "mango"),
+	})
+
+	if len(sel.options) != 3 {
+		t.Errorf("expected 3 options, got %d", len(sel.options))
+	}
+	if sel.FilterQuery() != "an" || len(sel.filteredOpts) != 2 {
+		t.Errorf("expected filter %q to match 2 options, got %q matching %d", "an", sel.FilterQuery(), len(sel.filteredOpts))
+	}
+	if sel.height != 3 {
+		t.Errorf("expected height 3 to be kept, got %d", sel.height)
+	}
+	if got, ok := sel.FocusedValue(); !ok || got != "banana" {
+		t.Errorf("expected focus on banana, got %q", got)
+	}
+	if _, ok := sel.SelectedValue(); ok {
+		t.Error("expected no selection")
+	}
+}
+
+func groupedOptions() []*value.Option[string] {
+	return []*value.Option[string]{
+		value.NewOption("main.go", "main").WithGroup("Recent"),
+		value.NewOption("go.mod", "mod").WithGroup("Recent"),
+		value.NewOption("README.md", "readme").WithGroup("All"),
+		value.NewOption("LICENSE", "license").WithGroup("All"),
+		value.NewOption("Makefile", "make").WithGroup("All"),
+	}
+}
+
+func TestRenderVisibleOptions_Groups(t *testing.T) {
+	sel := New(groupedOptions())
+
+	want := []string{"── Recent ──", "> main.go", "  go.mod", "── All ──", "  README.md", "  LICENSE", "  Makefile"}
+	if got := sel.RenderVisibleOptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderVisibleOptions() =\n%q\nwant\n%q", got, want)
+	}
+
+	// Navigation moves over options only: the header is never focused.
+	sel = sel.MoveDown().MoveDown()
+	if got, _ := sel.FocusedValue(); got != "readme" {
+		t.Errorf("expected focus on readme after header, got %q", got)
+	}
+
+	// Groups without matches are hidden.
+	filtered := sel.SetFilterQuery("m")
+	want = []string{"── Recent ──", "  main.go", "  go.mod", "── All ──", "> README.md", "  Makefile"}
+	if got := filtered.RenderVisibleOptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("filtered RenderVisibleOptions() =\n%q\nwant\n%q", got, want)
+	}
+	filtered = sel.SetFilterQuery("license")
+	want = []string{"── All ──", "> LICENSE"}
+	if got := filtered.RenderVisibleOptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderVisibleOptions() with one group left = %q, want %q", got, want)
+	}
+}
+
+func TestRenderVisibleOptions_GroupsScroll(t *testing.T) {
+	sel := New(groupedOptions()).WithHeight(3).MoveToEnd()
+
+	// Header lines count towards the height; the scrolled-away "All" header sticks.
+	want := []string{"── All ──", "  LICENSE", "> Makefile"}
+	if got := sel.RenderVisibleOptions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderVisibleOptions() = %q, want %q", got, want)
+	}
+}
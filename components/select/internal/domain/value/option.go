@@ -8,6 +8,7 @@ type Option[T any] struct {
 	value       T
 	description string
 	disabled    bool
+	group       string // Group shown as a header above the option ("" = none)
 }
 
 // NewOption creates a new option with the given label and value.
@@ -17,6 +18,7 @@ func NewOption[T any](label string, value T) *Option[T] {
 		value:       value,
 		description: "",
 		disabled:    false,
+		group:       "",
 	}
 }
 
@@ -27,6 +29,7 @@ func (o *Option[T]) WithDescription(desc string) *Option[T] {
 		value:       o.value,
 		description: desc,
 		disabled:    o.disabled,
+		group:       o.group,
 	}
 }
 
@@ -37,6 +40,18 @@ func (o *Option[T]) WithDisabled(disabled bool) *Option[T] {
 		value:       o.value,
 		description: o.description,
 		disabled:    disabled,
+		group:       o.group,
+	}
+}
+
+// WithGroup returns a new option belonging to the specified group.
+func (o *Option[T]) WithGroup(group string) *Option[T] {
+	return &Option[T]{
+		label:       o.label,
+		value:       o.value,
+		description: o.description,
+		disabled:    o.disabled,
+		group:       group,
 	}
 }
 
@@ -59,3 +74,8 @@ func (o *Option[T]) Description() string {
 func (o *Option[T]) Disabled() bool {
 	return o.disabled
 }
+
+// Group returns the group of this option ("" if none).
+func (o *Option[T]) Group() string {
+	return o.group
+}
@@ -42,6 +42,22 @@ func TestWithDisabled(t *testing.T) {
 	}
 }
 
+func TestWithGroup(t *testing.T) {
+	original := NewOption("Label", "value").WithDescription("desc")
+	grouped := original.WithGroup("Recent")
+
+	if grouped.Group() != "Recent" {
+		t.Errorf("expected group Recent, got %q", grouped.Group())
+	}
+	if original.Group() != "" {
+		t.Error("original option was mutated")
+	}
+	// Other setters keep the group.
+	if got := grouped.WithDisabled(true).WithDescription("x").Group(); got != "Recent" {
+		t.Errorf("expected group kept, got %q", got)
+	}
+}
+
 func TestOptionImmutability(t *testing.T) {
 	original := NewOption("Original", 1)
 	modified := original.WithDescription("Modified")
@@ -26,11 +26,19 @@
 //	        selectcomponent.Opt("Pending", Pending, "Pending approval"),
 //	    ).
 //	    WithDefault(Active)
+//
+// Example (options loaded asynchronously):
+//
+//	sel := selectcomponent.NewAsync("Choose repo:", func() ([]*value.Option[Repo], error) {
+//	    return fetchRepos() // Runs in the background; a spinner shows meanwhile
+//	})
 package selectcomponent
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/phoenix-tui/phoenix/components/progress"
 	"github.com/phoenix-tui/phoenix/components/select/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/components/select/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/components/select/internal/infrastructure"
@@ -48,6 +56,12 @@ type Select[T any] struct {
 	domain     *model.Select[T]
 	keymap     *infrastructure.KeyBindingMap
 	filterable bool
+	// Asynchronous option loading (see NewAsync)
+	loader  func() ([]*value.Option[T], error)
+	load    *asyncLoad[T] // Load in flight, nil if none
+	loading bool
+	loadErr error
+	spinner progress.Spinner
 }
 
 // New creates a new Select with the given title.
@@ -67,7 +81,23 @@ func (s *Select[T]) Options(options ...*value.Option[T]) *Select[T] {
 		domain:     model.New(options),
 		keymap:     s.keymap,
 		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
+	}
+}
+
+// Groups sets the available options as groups (see OptGroup), shown under
+// divider headers in the given order. Headers can't be focused: navigation
+// skips them, and groups without options matching the filter are hidden.
+func (s *Select[T]) Groups(groups ...[]*value.Option[T]) *Select[T] {
+	var options []*value.Option[T]
+	for _, group := range groups {
+		options = append(options, group...)
 	}
+	return s.Options(options...)
 }
 
 // WithHeight sets the visible height of the option list.
@@ -77,6 +107,11 @@ func (s *Select[T]) WithHeight(height int) *Select[T] {
 		domain:     s.domain.WithHeight(height),
 		keymap:     s.keymap,
 		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
 	}
 }
 
@@ -87,6 +122,11 @@ func (s *Select[T]) WithFilterable(enabled bool) *Select[T] {
 		domain:     s.domain,
 		keymap:     s.keymap,
 		filterable: enabled,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
 	}
 }
 
@@ -97,6 +137,11 @@ func (s *Select[T]) WithFilterFunc(fn func(opt *value.Option[T], query string) b
 		domain:     s.domain.WithFilterFunc(fn),
 		keymap:     s.keymap,
 		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
 	}
 }
 
@@ -107,6 +152,11 @@ func (s *Select[T]) WithRenderFunc(fn func(opt *value.Option[T], index int, focu
 		domain:     s.domain.WithRenderFunc(fn),
 		keymap:     s.keymap,
 		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
 	}
 }
 
@@ -128,18 +178,42 @@ func (s *Select[T]) FocusedValue() (T, bool) {
 }
 
 // Init implements tea.Model.
+// Selects created with NewAsync start loading their options.
 func (s *Select[T]) Init() tea.Cmd {
-	return nil
+	if !s.loading {
+		return nil
+	}
+	return s.startLoad()
 }
 
 // Update implements tea.Model.
 func (s *Select[T]) Update(msg tea.Msg) (*Select[T], tea.Cmd) {
-	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		return s.handleKey(keyMsg)
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return s.handleKey(msg)
+	case tea.TickMsg:
+		if s.loading {
+			return s.handleLoadingTick(msg)
+		}
 	}
 	return s, nil
 }
 
+// clone returns a shallow copy of the Select.
+func (s *Select[T]) clone() *Select[T] {
+	return &Select[T]{
+		title:      s.title,
+		domain:     s.domain,
+		keymap:     s.keymap,
+		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
+	}
+}
+
 // handleKey processes keyboard input.
 func (s *Select[T]) handleKey(msg tea.KeyMsg) (*Select[T], tea.Cmd) {
 	action := s.keymap.GetAction(msg)
@@ -149,6 +223,16 @@ func (s *Select[T]) handleKey(msg tea.KeyMsg) (*Select[T], tea.Cmd) {
 		domain:     s.domain,
 		keymap:     s.keymap,
 		filterable: s.filterable,
+		loader:     s.loader,
+		load:       s.load,
+		loading:    s.loading,
+		loadErr:    s.loadErr,
+		spinner:    s.spinner,
+	}
+
+	// Offer a retry after a failed load
+	if s.loadErr != nil && msg.Type == tea.KeyRune && msg.Rune == 'r' {
+		return s.retryLoad()
 	}
 
 	switch action {
@@ -161,6 +245,9 @@ func (s *Select[T]) handleKey(msg tea.KeyMsg) (*Select[T], tea.Cmd) {
 	case infrastructure.ActionMoveToEnd:
 		newS.domain = newS.domain.MoveToEnd()
 	case infrastructure.ActionSelect:
+		if s.loading || s.loadErr != nil {
+			return newS, nil // Nothing to select yet
+		}
 		newS.domain = newS.domain.Select()
 		return newS, ConfirmSelectionCmd[T](newS.SelectedValue)
 	case infrastructure.ActionClearFilter:
@@ -210,8 +297,16 @@ func (s *Select[T]) View() string {
 		b.WriteRune('\n')
 	}
 
-	// Render options
-	s.renderOptions(&b)
+	// Render options, or the loading state
+	switch {
+	case s.loading:
+		b.WriteString(s.spinner.View())
+	case s.loadErr != nil:
+		b.WriteString(fmt.Sprintf("Failed to load options: %v\n", s.loadErr))
+		b.WriteString("Press r to retry")
+	default:
+		s.renderOptions(&b)
+	}
 
 	// Show filter query if active
 	if s.filterable {
@@ -269,6 +364,17 @@ func Opt[T any](label string, val T, description ...string) *value.Option[T] {
 	return opt
 }
 
+// OptGroup puts options into a group with the given label, for Groups.
+// Options can also be passed to Options directly; consecutive options of
+// the same group share one header.
+func OptGroup[T any](label string, opts ...*value.Option[T]) []*value.Option[T] {
+	group := make([]*value.Option[T], len(opts))
+	for i, opt := range opts {
+		group[i] = opt.WithGroup(label)
+	}
+	return group
+}
+
 // ConfirmSelectionCmd returns a command that sends a ConfirmSelectionMsg.
 func ConfirmSelectionCmd[T any](getValueFunc func() (T, bool)) tea.Cmd {
 	return func() tea.Msg {
@@ -291,3 +397,26 @@ func NewString(title string, options []string) *Select[string] {
 	}
 	return New[string](title).Options(opts...)
 }
+
+// StringGroup creates a group of string options with the given label, for
+// NewStringGroups.
+func StringGroup(label string, options ...string) []*value.Option[string] {
+	opts := make([]*value.Option[string], len(options))
+	for i, opt := range options {
+		opts[i] = value.NewOption(opt, opt)
+	}
+	return OptGroup(label, opts...)
+}
+
+// NewStringGroups creates a new string-based Select with grouped options
+// (convenience constructor).
+//
+// Example:
+//
+//	sel := selectcomponent.NewStringGroups("Open:",
+//	    selectcomponent.StringGroup("Recent", "main.go", "go.mod"),
+//	    selectcomponent.StringGroup("All", "README.md", "go.mod", "main.go"),
+//	)
+func NewStringGroups(title string, groups ...[]*value.Option[string]) *Select[string] {
+	return New[string](title).Groups(groups...)
+}
@@ -233,3 +233,48 @@ func TestFocusedValue(t *testing.T) {
 		}
 	})
 }
+
+func TestSelect_Groups(t *testing.T) {
+	s := New[int]("Pick:").Groups(
+		OptGroup("Low", Opt("One", 1), Opt("Two", 2)),
+		OptGroup("High", Opt("Ten", 10)),
+	)
+
+	view := s.View()
+	if !strings.Contains(view, "── Low ──\n> One\n  Two\n── High ──\n  Ten") {
+		t.Errorf("View() = %q", view)
+	}
+
+	// Down from Two skips the High header.
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyDown})
+	s, _ = s.Update(tea.KeyMsg{Type: tea.KeyDown})
+	s, cmd := s.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if v, ok := s.SelectedValue(); !ok || v != 10 {
+		t.Errorf("SelectedValue() = %d, %v; want 10", v, ok)
+	}
+	if cmd == nil {
+		t.Error("Enter should confirm")
+	}
+}
+
+func TestNewStringGroups(t *testing.T) {
+	s := NewStringGroups("Open:",
+		StringGroup("Recent", "main.go"),
+		StringGroup("All", "README.md", "main.go"),
+	).WithFilterable(true)
+
+	for _, r := range "read" {
+		s, _ = s.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: r})
+	}
+	view := s.View()
+	if strings.Contains(view, "Recent") || !strings.Contains(view, "── All ──\n> README.md") {
+		t.Errorf("View() with filter = %q, want only the All group", view)
+	}
+}
+
+func TestSelect_FlatOptionsHaveNoHeaders(t *testing.T) {
+	view := NewString("", []string{"A", "B"}).View()
+	if strings.Contains(view, "──") {
+		t.Errorf("View() = %q, want no group headers", view)
+	}
+}
@@ -0,0 +1,128 @@
+package table
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ExportOrder selects the row order used by ToCSVWithOrder and ToRecords.
+type ExportOrder int
+
+const (
+	// ExportDisplayed exports rows as displayed, i.e. with any sort applied.
+	ExportDisplayed ExportOrder = iota
+	// ExportOriginal exports rows in the order they were added.
+	ExportOriginal
+)
+
+// FromCSV reads a CSV document into a new table. The first record is the
+// header row: each field becomes a column title (and key), and every
+// following record becomes a row of string values. Quoted fields with
+// embedded commas, quotes and newlines are handled by encoding/csv.
+//
+// Columns are sortable and sized to fit their content (see ColumnAuto).
+// Empty or duplicate headers get a generated key such as "col3" that no
+// other header uses.
+func FromCSV(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // ragged records are padded by FromRecords
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("table: read CSV: %w", err)
+	}
+	return FromRecords(records)
+}
+
+// FromRecords builds a table from records the same way as FromCSV: the
+// first record is the header row, the rest are rows. Records shorter than
+// the header leave the missing cells empty; extra fields are ignored.
+func FromRecords(records [][]string) (*Table, error) {
+	if len(records) == 0 {
+		return nil, errors.New("table: no header row")
+	}
+
+	header := records[0]
+	titles := make(map[string]bool, len(header))
+	for _, title := range header {
+		titles[title] = true
+	}
+
+	columns := make([]Column, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, title := range header {
+		key := title
+		// Generated keys must not collide with any header, including ones
+		// further right, or rows would lose that column's values.
+		for n := i + 1; key == "" || seen[key] || (key != title && titles[key]); n++ {
+			key = fmt.Sprintf("col%d", n)
+		}
+		seen[key] = true
+		columns[i] = Column{Key: key, Title: title, Width: 1, Sortable: true}
+	}
+
+	rows := make([]Row, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(Row, len(columns))
+		for i, col := range columns {
+			if i < len(record) {
+				row[col.Key] = record[i]
+			} else {
+				row[col.Key] = ""
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	t := NewWithRows(columns, rows)
+	for i := range columns {
+		t = t.ColumnAuto(i)
+	}
+	return t, nil
+}
+
+// ToCSV writes the table to w as CSV: a header row of column titles
+// followed by the rows as displayed (see ToCSVWithOrder).
+func (t *Table) ToCSV(w io.Writer) error {
+	return t.ToCSVWithOrder(w, ExportDisplayed)
+}
+
+// ToCSVWithOrder writes the table to w as CSV with rows in the given order.
+// Columns keep their order; cells are written as their raw values (not the
+// output of a custom renderer), with nil as an empty field.
+func (t *Table) ToCSVWithOrder(w io.Writer, order ExportOrder) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(t.ToRecords(order)); err != nil {
+		return fmt.Errorf("table: write CSV: %w", err)
+	}
+	return nil
+}
+
+// ToRecords returns the header row of column titles followed by one record
+// per row in the given order, formatted as in ToCSVWithOrder.
+func (t *Table) ToRecords(order ExportOrder) [][]string {
+	columns := t.domain.Columns()
+	rows := t.domain.DisplayedRows()
+	if order == ExportOriginal {
+		rows = t.domain.Rows()
+	}
+
+	records := make([][]string, 0, len(rows)+1)
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Title()
+	}
+	records = append(records, header)
+
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, col := range columns {
+			if v := row[col.Key()]; v != nil {
+				record[i] = fmt.Sprintf("%v", v)
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,153 @@
+package table
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+const testCSV = `name,city,note
+Charlie,Berlin,"likes ""quotes"""
+Alice,"Paris, France",plain
+Bob,Oslo,"two
+lines"
+`
+
+func TestFromCSV(t *testing.T) {
+	table, err := FromCSV(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatalf("FromCSV() error = %v", err)
+	}
+
+	rows := table.Rows()
+	if len(rows) != 3 {
+		t.Fatalf("len(Rows()) = %d, want 3", len(rows))
+	}
+	if rows[1]["city"] != "Paris, France" {
+		t.Errorf("embedded comma: city = %q", rows[1]["city"])
+	}
+	if rows[0]["note"] != `likes "quotes"` {
+		t.Errorf("escaped quotes: note = %q", rows[0]["note"])
+	}
+	if rows[2]["note"] != "two\nlines" {
+		t.Errorf("embedded newline: note = %q", rows[2]["note"])
+	}
+
+	// Columns are sortable.
+	table = table.SortBy(0, true)
+	if got := table.ToRecords(ExportDisplayed)[1][0]; got != "Alice" {
+		t.Errorf("after sort, first row = %v, want Alice", got)
+	}
+}
+
+func TestFromCSV_ViewQuotedNewline(t *testing.T) {
+	table, err := FromCSV(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatalf("FromCSV() error = %v", err)
+	}
+
+	// A cell that does not wrap stays on its row, its line break shown as a
+	// space.
+	lines := strings.Split(strings.TrimSuffix(table.View(), "\n"), "\n")
+	if len(lines) != 5 { // Header, separator, three rows
+		t.Fatalf("View() has %d lines, want 5:\n%s", len(lines), table.View())
+	}
+	for i, line := range lines {
+		if style.Width(line) != style.Width(lines[0]) {
+			t.Errorf("line %d width = %d, want %d:\n%s", i, style.Width(line), style.Width(lines[0]), table.View())
+		}
+	}
+	if !strings.Contains(table.View(), "two lines") {
+		t.Errorf("View() should show the quoted newline as a space:\n%s", table.View())
+	}
+
+	// A wrapping column splits the cell into lines instead.
+	wrapped := table.WrapColumn(2, true).View()
+	if !strings.Contains(wrapped, "│two ") || !strings.Contains(wrapped, "│lines") {
+		t.Errorf("wrapped View() should put each line of the cell on its own line:\n%s", wrapped)
+	}
+}
+
+func TestFromCSV_Errors(t *testing.T) {
+	if _, err := FromCSV(strings.NewReader("")); err == nil {
+		t.Error("FromCSV(empty) should fail without a header row")
+	}
+	if _, err := FromCSV(strings.NewReader("a,b\n\"unterminated\n")); err == nil {
+		t.Error("FromCSV(malformed) should fail")
+	}
+}
+
+func TestFromRecords(t *testing.T) {
+	table, err := FromRecords([][]string{
+		{"name", "", "name"},
+		{"Alice", "x"},
+	})
+	if err != nil {
+		t.Fatalf("FromRecords() error = %v", err)
+	}
+
+	want := [][]string{
+		{"name", "", "name"},
+		{"Alice", "x", ""},
+	}
+	if got := table.ToRecords(ExportOriginal); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToRecords() = %q, want %q", got, want)
+	}
+}
+
+func TestFromRecords_GeneratedKeysAvoidHeaders(t *testing.T) {
+	table, err := FromRecords([][]string{
+		{"col2", "", "col3", "col2"},
+		{"a", "b", "c", "d"},
+	})
+	if err != nil {
+		t.Fatalf("FromRecords() error = %v", err)
+	}
+
+	want := [][]string{
+		{"col2", "", "col3", "col2"},
+		{"a", "b", "c", "d"},
+	}
+	if got := table.ToRecords(ExportOriginal); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToRecords() = %q, want %q", got, want)
+	}
+}
+
+func TestTable_ToCSV_RoundTrip(t *testing.T) {
+	table, err := FromCSV(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatalf("FromCSV() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := table.ToCSVWithOrder(&buf, ExportOriginal); err != nil {
+		t.Fatalf("ToCSVWithOrder() error = %v", err)
+	}
+	if buf.String() != testCSV {
+		t.Errorf("round trip =\n%s\nwant\n%s", buf.String(), testCSV)
+	}
+}
+
+func TestTable_ToCSV_Order(t *testing.T) {
+	table := createTestTable().SortBy(2, true) // by age: Bob, Alice, Charlie
+
+	var displayed, original bytes.Buffer
+	if err := table.ToCSV(&displayed); err != nil {
+		t.Fatalf("ToCSV() error = %v", err)
+	}
+	if err := table.ToCSVWithOrder(&original, ExportOriginal); err != nil {
+		t.Fatalf("ToCSVWithOrder() error = %v", err)
+	}
+
+	wantDisplayed := "ID,Name,Age\n2,Bob,25\n1,Alice,30\n3,Charlie,35\n"
+	if displayed.String() != wantDisplayed {
+		t.Errorf("ToCSV() = %q, want %q", displayed.String(), wantDisplayed)
+	}
+	wantOriginal := "ID,Name,Age\n1,Alice,30\n2,Bob,25\n3,Charlie,35\n"
+	if original.String() != wantOriginal {
+		t.Errorf("ToCSVWithOrder(ExportOriginal) = %q, want %q", original.String(), wantOriginal)
+	}
+}
@@ -2,6 +2,8 @@
 package model
 
 import (
+	"fmt"
+
 	"github.com/phoenix-tui/phoenix/components/table/internal/domain/value"
 )
 
@@ -14,6 +16,9 @@ type Column struct {
 	alignment value.Alignment          // Cell alignment (left/center/right)
 	sortable  bool                     // Can this column be sorted?
 	renderer  func(interface{}) string // Custom cell renderer (optional)
+	compare   func(a, b string) int    // Custom sort comparator on cell text (optional)
+	policy    value.WidthPolicy        // How the rendered width is determined
+	wrap      bool                     // Wrap long cells onto extra lines instead of truncating
 }
 
 // NewColumn creates a new column with left alignment and no custom renderer.
@@ -25,6 +30,9 @@ func NewColumn(key, title string, width int) *Column {
 		alignment: value.AlignmentLeft,
 		sortable:  false,
 		renderer:  nil,
+		compare:   nil,
+		policy:    value.WidthFixed(),
+		wrap:      false,
 	}
 }
 
@@ -37,6 +45,9 @@ func NewColumnWithAlignment(key, title string, width int, alignment value.Alignm
 		alignment: alignment,
 		sortable:  false,
 		renderer:  nil,
+		compare:   nil,
+		policy:    value.WidthFixed(),
+		wrap:      false,
 	}
 }
 
@@ -49,6 +60,9 @@ func (c *Column) WithWidth(width int) *Column {
 		alignment: c.alignment,
 		sortable:  c.sortable,
 		renderer:  c.renderer,
+		compare:   c.compare,
+		policy:    c.policy,
+		wrap:      c.wrap,
 	}
 }
 
@@ -61,6 +75,9 @@ func (c *Column) WithAlignment(alignment value.Alignment) *Column {
 		alignment: alignment,
 		sortable:  c.sortable,
 		renderer:  c.renderer,
+		compare:   c.compare,
+		policy:    c.policy,
+		wrap:      c.wrap,
 	}
 }
 
@@ -73,6 +90,9 @@ func (c *Column) WithSortable(sortable bool) *Column {
 		alignment: c.alignment,
 		sortable:  sortable,
 		renderer:  c.renderer,
+		compare:   c.compare,
+		policy:    c.policy,
+		wrap:      c.wrap,
 	}
 }
 
@@ -85,6 +105,57 @@ func (c *Column) WithRenderer(renderer func(interface{}) string) *Column {
 		alignment: c.alignment,
 		sortable:  c.sortable,
 		renderer:  renderer,
+		compare:   c.compare,
+		policy:    c.policy,
+		wrap:      c.wrap,
+	}
+}
+
+// WithComparator returns a new column sorted with compare instead of the
+// default value comparison. compare receives the cell text (as rendered)
+// and returns a negative number, zero or a positive number like strings.Compare.
+func (c *Column) WithComparator(compare func(a, b string) int) *Column {
+	return &Column{
+		key:       c.key,
+		title:     c.title,
+		width:     c.width,
+		alignment: c.alignment,
+		sortable:  c.sortable,
+		renderer:  c.renderer,
+		compare:   compare,
+		policy:    c.policy,
+		wrap:      c.wrap,
+	}
+}
+
+// WithWidthPolicy returns a new column laid out with the given width policy.
+func (c *Column) WithWidthPolicy(policy value.WidthPolicy) *Column {
+	return &Column{
+		key:       c.key,
+		title:     c.title,
+		width:     c.width,
+		alignment: c.alignment,
+		sortable:  c.sortable,
+		renderer:  c.renderer,
+		compare:   c.compare,
+		policy:    policy,
+		wrap:      c.wrap,
+	}
+}
+
+// WithWrap returns a new column whose long cells wrap onto extra lines
+// (wrap true) or are truncated with an ellipsis (wrap false).
+func (c *Column) WithWrap(wrap bool) *Column {
+	return &Column{
+		key:       c.key,
+		title:     c.title,
+		width:     c.width,
+		alignment: c.alignment,
+		sortable:  c.sortable,
+		renderer:  c.renderer,
+		compare:   c.compare,
+		policy:    c.policy,
+		wrap:      wrap,
 	}
 }
 
@@ -117,3 +188,27 @@ func (c *Column) IsSortable() bool {
 func (c *Column) Renderer() func(interface{}) string {
 	return c.renderer
 }
+
+// WidthPolicy returns how the column's rendered width is determined.
+func (c *Column) WidthPolicy() value.WidthPolicy {
+	return c.policy
+}
+
+// Wraps returns whether long cells wrap instead of being truncated.
+func (c *Column) Wraps() bool {
+	return c.wrap
+}
+
+// Comparator returns the custom sort comparator, or nil if none is set.
+func (c *Column) Comparator() func(a, b string) int {
+	return c.compare
+}
+
+// CellText returns the display text of value: the custom renderer output if
+// set, otherwise the value formatted with %v.
+func (c *Column) CellText(value interface{}) string {
+	if c.renderer != nil {
+		return c.renderer(value)
+	}
+	return fmt.Sprintf("%v", value)
+}
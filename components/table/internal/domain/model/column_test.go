@@ -155,3 +155,39 @@ func TestColumn_Immutability(t *testing.T) {
 		t.Errorf("New column should have renderer")
 	}
 }
+
+func TestColumn_WithComparator(t *testing.T) {
+	col := NewColumn("size", "Size", 8).WithRenderer(func(v interface{}) string { return v.(string) + "KB" })
+	col2 := col.WithComparator(func(a, b string) int { return len(a) - len(b) })
+
+	if col.Comparator() != nil {
+		t.Error("Original comparator changed")
+	}
+	if col2.Comparator() == nil || col2.Renderer() == nil {
+		t.Error("New column should keep the renderer and have the comparator")
+	}
+	if got := col2.CellText("12"); got != "12KB" {
+		t.Errorf("CellText() = %q, want rendered text", got)
+	}
+	if got := NewColumn("n", "N", 3).CellText(7); got != "7" {
+		t.Errorf("CellText() = %q, want %%v formatting", got)
+	}
+}
+
+func TestColumn_WidthPolicyAndWrap(t *testing.T) {
+	col := NewColumn("desc", "Description", 20).WithSortable(true)
+	if !col.WidthPolicy().IsFixed() || col.Wraps() {
+		t.Fatalf("new column should be fixed width and truncate, got %v wrap=%v", col.WidthPolicy(), col.Wraps())
+	}
+
+	col2 := col.WithWidthPolicy(value.WidthFlex(2)).WithWrap(true)
+	if !col2.WidthPolicy().IsFlex() || col2.WidthPolicy().Weight() != 2 {
+		t.Errorf("WidthPolicy() = %v, want flex(2)", col2.WidthPolicy())
+	}
+	if !col2.Wraps() || !col2.IsSortable() || col2.Width() != 20 {
+		t.Error("WithWidthPolicy/WithWrap should keep the other fields")
+	}
+	if !col.WidthPolicy().IsFixed() || col.Wraps() {
+		t.Error("Original column changed")
+	}
+}
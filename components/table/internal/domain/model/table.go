@@ -1,6 +1,8 @@
 package model
 
 import (
+	"reflect"
+
 	"github.com/phoenix-tui/phoenix/components/table/internal/domain/value"
 )
 
@@ -13,12 +15,16 @@ type Table struct {
 	columns       []*Column           // Column definitions
 	rows          []Row               // Original data rows
 	sortedRows    []Row               // Sorted rows (if sorting active)
+	sortOrder     []int               // Index in rows of each sorted row
 	sortColumnKey string              // Currently sorted column key
 	sortDirection value.SortDirection // Sort direction
 	selectedIndex int                 // Selected row index
 	scrollOffset  int                 // Scroll offset for viewport
 	height        int                 // Visible height (number of rows)
 	showHeader    bool                // Show header row?
+	focusedColumn int                 // Column targeted by sort keys
+	frozenColumns int                 // Leading columns that never scroll horizontally
+	xOffset       int                 // Horizontal scroll offset in cells
 }
 
 // NewTable creates a new table with the given columns.
@@ -33,6 +39,9 @@ func NewTable(columns []*Column) *Table {
 		scrollOffset:  0,
 		height:        10, // Default height
 		showHeader:    true,
+		focusedColumn: 0,
+		frozenColumns: 0,
+		xOffset:       0,
 	}
 }
 
@@ -48,6 +57,9 @@ func NewTableWithRows(columns []*Column, rows []Row) *Table {
 		scrollOffset:  0,
 		height:        10,
 		showHeader:    true,
+		focusedColumn: 0,
+		frozenColumns: 0,
+		xOffset:       0,
 	}
 }
 
@@ -63,21 +75,43 @@ func (t *Table) WithRows(rows []Row) *Table {
 		scrollOffset:  0,
 		height:        t.height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
+// WithColumn returns a new table with the column at index replaced.
+// Out-of-range indices are ignored.
+func (t *Table) WithColumn(index int, column *Column) *Table {
+	if index < 0 || index >= len(t.columns) {
+		return t
+	}
+	columns := make([]*Column, len(t.columns))
+	copy(columns, t.columns)
+	columns[index] = column
+
+	newTable := *t
+	newTable.columns = columns
+	return &newTable
+}
+
 // WithHeight returns a new table with the specified visible height.
 func (t *Table) WithHeight(height int) *Table {
 	return &Table{
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: t.selectedIndex,
 		scrollOffset:  t.scrollOffset,
 		height:        height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
@@ -87,34 +121,44 @@ func (t *Table) WithShowHeader(show bool) *Table {
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: t.selectedIndex,
 		scrollOffset:  t.scrollOffset,
 		height:        t.height,
 		showHeader:    show,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
 // SortBy returns a new table sorted by the specified column and direction.
 // Note: Actual sorting is delegated to SortService (domain service).
+// The selection follows the selected row to its new position.
 func (t *Table) SortBy(columnKey string, direction value.SortDirection, sortedRows []Row) *Table {
-	return &Table{
+	return (&Table{
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    sortedRows,
+		sortOrder:     rowOrder(t.rows, sortedRows),
 		sortColumnKey: columnKey,
 		sortDirection: direction,
-		selectedIndex: 0, // Reset selection when sorting
-		scrollOffset:  0,
+		selectedIndex: 0,
+		scrollOffset:  t.scrollOffset,
 		height:        t.height,
 		showHeader:    t.showHeader,
-	}
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
+	}).withSelectedRow(t.SelectedRow())
 }
 
 // ClearSort returns a new table with sorting removed.
+// The selection follows the selected row back to its original position.
 func (t *Table) ClearSort() *Table {
-	return &Table{
+	return (&Table{
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    nil,
@@ -124,7 +168,31 @@ func (t *Table) ClearSort() *Table {
 		scrollOffset:  t.scrollOffset,
 		height:        t.height,
 		showHeader:    t.showHeader,
-	}
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
+	}).withSelectedRow(t.SelectedRow())
+}
+
+// MoveLeft returns a new table with the previous column focused.
+func (t *Table) MoveLeft() *Table {
+	return t.withFocusedColumn(t.focusedColumn - 1)
+}
+
+// MoveRight returns a new table with the next column focused.
+func (t *Table) MoveRight() *Table {
+	return t.withFocusedColumn(t.focusedColumn + 1)
+}
+
+// WithFocusedColumn returns a new table with column index col focused.
+// Out-of-range indices are ignored.
+func (t *Table) WithFocusedColumn(col int) *Table {
+	return t.withFocusedColumn(col)
+}
+
+// FocusedColumn returns the index of the focused column.
+func (t *Table) FocusedColumn() int {
+	return t.focusedColumn
 }
 
 // MoveUp returns a new table with selection moved up one row.
@@ -145,12 +213,16 @@ func (t *Table) MoveUp() *Table {
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: newIndex,
 		scrollOffset:  newOffset,
 		height:        t.height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
@@ -177,12 +249,16 @@ func (t *Table) MoveDown() *Table {
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: newIndex,
 		scrollOffset:  newOffset,
 		height:        t.height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
@@ -192,12 +268,16 @@ func (t *Table) MoveToStart() *Table {
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: 0,
 		scrollOffset:  0,
 		height:        t.height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
@@ -222,12 +302,16 @@ func (t *Table) MoveToEnd() *Table {
 		columns:       t.columns,
 		rows:          t.rows,
 		sortedRows:    t.sortedRows,
+		sortOrder:     t.sortOrder,
 		sortColumnKey: t.sortColumnKey,
 		sortDirection: t.sortDirection,
 		selectedIndex: maxIndex,
 		scrollOffset:  newOffset,
 		height:        t.height,
 		showHeader:    t.showHeader,
+		focusedColumn: t.focusedColumn,
+		frozenColumns: t.frozenColumns,
+		xOffset:       t.xOffset,
 	}
 }
 
@@ -253,6 +337,18 @@ func (t *Table) SelectedIndex() int {
 	return t.selectedIndex
 }
 
+// RowIndex returns the index in Rows of the displayed row at index i
+// (sorting moves rows on screen but not in Rows), or -1 if i is out of range.
+func (t *Table) RowIndex(i int) int {
+	if i < 0 || i >= len(t.effectiveRows()) {
+		return -1
+	}
+	if t.sortOrder != nil {
+		return t.sortOrder[i]
+	}
+	return i
+}
+
 // Columns returns the column definitions.
 func (t *Table) Columns() []*Column {
 	return t.columns
@@ -263,6 +359,12 @@ func (t *Table) Rows() []Row {
 	return t.rows
 }
 
+// DisplayedRows returns all rows in display order: sorted when a sort is
+// applied, otherwise in their original order.
+func (t *Table) DisplayedRows() []Row {
+	return t.effectiveRows()
+}
+
 // VisibleRows returns the rows currently visible in the viewport.
 func (t *Table) VisibleRows() []Row {
 	rows := t.effectiveRows()
@@ -313,3 +415,91 @@ func (t *Table) ShowHeader() bool {
 func (t *Table) ScrollOffset() int {
 	return t.scrollOffset
 }
+
+// WithFrozenColumns returns a new table whose first n columns stay in place
+// when the table scrolls horizontally. n is clamped to [0, len(columns)].
+func (t *Table) WithFrozenColumns(n int) *Table {
+	newTable := *t
+	newTable.frozenColumns = min(max(n, 0), len(t.columns))
+	return &newTable
+}
+
+// FrozenColumns returns the number of leading columns that do not scroll.
+func (t *Table) FrozenColumns() int {
+	return t.frozenColumns
+}
+
+// WithHorizontalOffset returns a new table scrolled x cells to the right.
+// Negative offsets become 0; the upper bound depends on the rendered column
+// widths and is enforced by the caller.
+func (t *Table) WithHorizontalOffset(x int) *Table {
+	newTable := *t
+	newTable.xOffset = max(x, 0)
+	return &newTable
+}
+
+// HorizontalOffset returns the horizontal scroll offset in cells.
+func (t *Table) HorizontalOffset() int {
+	return t.xOffset
+}
+
+// rowOrder returns the index in rows of each row in sorted, matching rows
+// by identity. Rows missing from rows get -1.
+func rowOrder(rows, sorted []Row) []int {
+	if sorted == nil {
+		return nil
+	}
+	index := make(map[uintptr][]int, len(rows))
+	for i, r := range rows {
+		ptr := reflect.ValueOf(r).Pointer()
+		index[ptr] = append(index[ptr], i)
+	}
+	order := make([]int, len(sorted))
+	for i, r := range sorted {
+		ptr := reflect.ValueOf(r).Pointer()
+		order[i] = -1
+		// The same map may be listed more than once: hand its indices out in order.
+		if positions := index[ptr]; len(positions) > 0 {
+			order[i], index[ptr] = positions[0], positions[1:]
+		}
+	}
+	return order
+}
+
+// withFocusedColumn returns a copy with col focused, or t if col is out of range.
+func (t *Table) withFocusedColumn(col int) *Table {
+	if col < 0 || col >= len(t.columns) || col == t.focusedColumn {
+		return t
+	}
+	newTable := *t
+	newTable.focusedColumn = col
+	return &newTable
+}
+
+// withSelectedRow moves the selection to row (matched by identity) in the
+// displayed rows and scrolls it into view. Keeps the selection at index 0
+// when the row is not found.
+func (t *Table) withSelectedRow(row Row) *Table {
+	newTable := *t
+	newTable.selectedIndex = 0
+	if row != nil {
+		ptr := reflect.ValueOf(row).Pointer()
+		for i, r := range t.effectiveRows() {
+			if reflect.ValueOf(r).Pointer() == ptr {
+				newTable.selectedIndex = i
+				break
+			}
+		}
+	}
+
+	visibleRows := t.height
+	if t.showHeader {
+		visibleRows--
+	}
+	if newTable.selectedIndex < newTable.scrollOffset {
+		newTable.scrollOffset = newTable.selectedIndex
+	} else if visibleRows > 0 && newTable.selectedIndex >= newTable.scrollOffset+visibleRows {
+		newTable.scrollOffset = newTable.selectedIndex - visibleRows + 1
+	}
+	return &newTable
+}
@@ -223,8 +223,118 @@ func TestTable_SortBy(t *testing.T) {
 	if !table2.SortDirection().IsAscending() {
 		t.Errorf("SortDirection should be ascending")
 	}
-	if table2.SelectedIndex() != 0 {
-		t.Errorf("Selection should reset to 0 when sorting")
+}
+
+func TestTable_SortBy_TracksSelectedRow(t *testing.T) {
+	rows := createTestRows()
+	table := NewTableWithRows(createTestColumns(), rows).WithHeight(3).MoveDown() // Bob
+
+	// Descending by name: Eve, Diana, Charlie, Bob, Alice.
+	sorted := []Row{rows[4], rows[3], rows[2], rows[1], rows[0]}
+	table2 := table.SortBy("name", value2.SortDirectionDesc, sorted)
+
+	if table2.SelectedIndex() != 3 || table2.SelectedRow()["name"] != "Bob" {
+		t.Errorf("selection = %d (%v), want Bob at 3", table2.SelectedIndex(), table2.SelectedRow()["name"])
+	}
+	// Two visible rows (height 3 minus header): the row is scrolled into view.
+	if table2.ScrollOffset() != 2 {
+		t.Errorf("ScrollOffset = %d, want 2", table2.ScrollOffset())
+	}
+
+	table3 := table2.ClearSort()
+	if table3.SelectedIndex() != 1 {
+		t.Errorf("after ClearSort selection = %d, want 1 (Bob)", table3.SelectedIndex())
+	}
+}
+
+func TestTable_FocusedColumn(t *testing.T) {
+	table := NewTableWithRows(createTestColumns(), createTestRows())
+
+	if table.MoveLeft().FocusedColumn() != 0 {
+		t.Error("MoveLeft() should stop at the first column")
+	}
+	table = table.MoveRight().MoveRight().MoveRight()
+	if table.FocusedColumn() != 2 {
+		t.Errorf("FocusedColumn = %d, want 2 (last)", table.FocusedColumn())
+	}
+	if table.WithFocusedColumn(7).FocusedColumn() != 2 {
+		t.Error("WithFocusedColumn() should ignore out-of-range indices")
+	}
+}
+
+func TestTable_WithColumn(t *testing.T) {
+	table := NewTableWithRows(createTestColumns(), createTestRows())
+	table2 := table.WithColumn(1, table.Columns()[1].WithSortable(true))
+
+	if !table2.Columns()[1].IsSortable() || table.Columns()[1].IsSortable() {
+		t.Error("WithColumn() should replace the column without modifying the original")
+	}
+	if table.WithColumn(5, NewColumn("x", "X", 1)) != table {
+		t.Error("WithColumn() should ignore out-of-range indices")
+	}
+}
+
+func TestTable_DisplayedRows(t *testing.T) {
+	rows := createTestRows()
+	table := NewTableWithRows(createTestColumns(), rows).WithHeight(2)
+
+	if got := table.DisplayedRows(); len(got) != 5 || got[0]["name"] != "Alice" {
+		t.Fatalf("DisplayedRows() should return all rows in original order, got %d rows", len(got))
+	}
+
+	sortedRows := []Row{rows[4], rows[3], rows[2], rows[1], rows[0]}
+	table = table.SortBy("id", value2.SortDirectionDesc, sortedRows)
+	if got := table.DisplayedRows(); got[0]["name"] != "Eve" {
+		t.Errorf("DisplayedRows()[0] = %v, want Eve", got[0]["name"])
+	}
+	if got := table.Rows(); got[0]["name"] != "Alice" {
+		t.Errorf("Rows()[0] = %v, want Alice (original order)", got[0]["name"])
+	}
+}
+
+func TestTable_RowIndex(t *testing.T) {
+	rows := createTestRows()
+	table := NewTableWithRows(createTestColumns(), rows)
+
+	if table.RowIndex(3) != 3 || table.RowIndex(5) != -1 || table.RowIndex(-1) != -1 {
+		t.Errorf("unsorted RowIndex should be the identity within range")
+	}
+
+	sortedRows := []Row{rows[4], rows[3], rows[2], rows[1], rows[0]}
+	table = table.SortBy("id", value2.SortDirectionDesc, sortedRows).MoveDown()
+	for i, want := range []int{4, 3, 2, 1, 0} {
+		if got := table.RowIndex(i); got != want {
+			t.Errorf("sorted RowIndex(%d) = %d, want %d", i, got, want)
+		}
+	}
+
+	if got := table.ClearSort().RowIndex(0); got != 0 {
+		t.Errorf("RowIndex(0) after ClearSort = %d, want 0", got)
+	}
+}
+
+func TestTable_FrozenColumnsAndHorizontalOffset(t *testing.T) {
+	table := NewTable(createTestColumns())
+
+	if got := table.WithFrozenColumns(2).FrozenColumns(); got != 2 {
+		t.Errorf("FrozenColumns() = %d, want 2", got)
+	}
+	if got := table.WithFrozenColumns(10).FrozenColumns(); got != 3 {
+		t.Errorf("FrozenColumns() = %d, want clamped to 3", got)
+	}
+	if got := table.WithFrozenColumns(-1).FrozenColumns(); got != 0 {
+		t.Errorf("FrozenColumns() = %d, want 0", got)
+	}
+
+	table2 := table.WithHorizontalOffset(7)
+	if table2.HorizontalOffset() != 7 || table.HorizontalOffset() != 0 {
+		t.Errorf("HorizontalOffset() = %d (original %d), want 7 (0)", table2.HorizontalOffset(), table.HorizontalOffset())
+	}
+	if got := table.WithHorizontalOffset(-3).HorizontalOffset(); got != 0 {
+		t.Errorf("HorizontalOffset() = %d, want 0", got)
+	}
+	if got := table2.WithHeight(5).HorizontalOffset(); got != 7 {
+		t.Errorf("HorizontalOffset() after WithHeight = %d, want 7", got)
 	}
 }
 
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/components/table/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+// ellipsis marks truncated cell text.
+const ellipsis = "…"
+
+// lineBreaks replaces the line breaks of text that is drawn on one line.
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// sortIndicatorWidth is the width of the " ▲"/" ▼" suffix of a sorted header.
+const sortIndicatorWidth = 2
+
+// LayoutService computes column widths and fits cell text into them.
+// All widths are terminal cells as measured by core.StringWidth, and text is
+// walked by grapheme cluster (core.ClusterLen), so wide glyphs (CJK, emoji
+// sequences, flags) are never split.
+type LayoutService struct{}
+
+// NewLayoutService creates a new layout service.
+func NewLayoutService() *LayoutService {
+	return &LayoutService{}
+}
+
+// ColumnWidths returns the rendered width of each column.
+//
+// Fixed columns use their configured width. Auto columns fit their title
+// (plus room for a sort indicator when sortable) and their widest cell in
+// rows. Flex columns share what is left of totalWidth after the other
+// columns and the one-cell separators, in proportion to their weights; when
+// totalWidth is unknown (<= 0) they fall back to their configured width.
+// Every column is at least one cell wide.
+func (s *LayoutService) ColumnWidths(columns []*model.Column, rows []model.Row, totalWidth int) []int {
+	widths := make([]int, len(columns))
+	used := max(len(columns)-1, 0) // separators
+	totalWeight := 0
+
+	for i, col := range columns {
+		policy := col.WidthPolicy()
+		switch {
+		case policy.IsAuto():
+			widths[i] = s.contentWidth(col, rows)
+		case policy.IsFlex() && totalWidth > 0:
+			totalWeight += policy.Weight()
+			continue
+		default:
+			widths[i] = col.Width()
+		}
+		widths[i] = max(widths[i], 1)
+		used += widths[i]
+	}
+
+	if totalWeight == 0 {
+		return widths
+	}
+
+	// Share the remaining width by weight; the rounding remainder goes one
+	// cell at a time to the leftmost flex columns.
+	remaining := max(totalWidth-used, 0)
+	given := 0
+	for i, col := range columns {
+		if policy := col.WidthPolicy(); policy.IsFlex() {
+			widths[i] = remaining * policy.Weight() / totalWeight
+			given += widths[i]
+		}
+	}
+	for i, col := range columns {
+		if given >= remaining {
+			break
+		}
+		if col.WidthPolicy().IsFlex() {
+			widths[i]++
+			given++
+		}
+	}
+	for i := range widths {
+		widths[i] = max(widths[i], 1)
+	}
+	return widths
+}
+
+// contentWidth returns the width an auto column needs for its title and cells.
+func (s *LayoutService) contentWidth(col *model.Column, rows []model.Row) int {
+	width := core.StringWidth(s.SingleLine(col.Title()))
+	if col.IsSortable() {
+		width += sortIndicatorWidth
+	}
+	for _, row := range rows {
+		text := col.CellText(row[col.Key()])
+		if !col.Wraps() {
+			width = max(width, core.StringWidth(s.SingleLine(text)))
+			continue
+		}
+		for _, line := range strings.Split(text, "\n") {
+			width = max(width, core.StringWidth(line))
+		}
+	}
+	return width
+}
+
+// SingleLine replaces the line breaks in text with spaces, for text drawn
+// on a single line such as headers and cells of columns that do not wrap
+// (CSV cells may contain quoted newlines).
+func (s *LayoutService) SingleLine(text string) string {
+	return lineBreaks.Replace(text)
+}
+
+// Truncate shortens text to at most width cells, ending it with an ellipsis
+// when anything was cut. A wide glyph or emoji sequence that would straddle
+// the limit is dropped whole, so the result may be one cell narrower than
+// width.
+func (s *LayoutService) Truncate(text string, width int) string {
+	if core.StringWidth(text) <= width {
+		return text
+	}
+	if width <= 0 {
+		return ""
+	}
+	head, _ := splitWidth(text, width-1)
+	return head + ellipsis
+}
+
+// Wrap breaks text into lines of at most width cells, at spaces where
+// possible and inside words that are longer than a line. Newlines in text
+// always start a new line.
+func (s *LayoutService) Wrap(text string, width int) []string {
+	if width <= 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line, lineWidth := "", 0
+		for _, word := range strings.Fields(paragraph) {
+			wordWidth := core.StringWidth(word)
+			if lineWidth > 0 && lineWidth+1+wordWidth <= width {
+				line += " " + word
+				lineWidth += 1 + wordWidth
+				continue
+			}
+			if lineWidth > 0 {
+				lines = append(lines, line)
+			}
+			for wordWidth > width {
+				head, rest := splitWidth(word, width)
+				if head == "" {
+					// A single glyph wider than the column: emit it anyway.
+					head, rest = firstCluster(word)
+				}
+				lines = append(lines, head)
+				word = rest
+				wordWidth = core.StringWidth(word)
+			}
+			line, lineWidth = word, wordWidth
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Slice returns the cells [start, start+width) of text, padded with spaces
+// to exactly width cells. A wide glyph cut by either edge is replaced by
+// spaces for its visible half, so the result never shifts the cells after it.
+func (s *LayoutService) Slice(text string, start, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	end := start + width
+
+	var b strings.Builder
+	pos, used := 0, 0
+	for i := 0; i < len(text) && pos < end; {
+		n := core.ClusterLen(text[i:])
+		cluster := text[i : i+n]
+		i += n
+
+		w := core.StringWidth(cluster)
+		switch {
+		case pos+w <= start:
+			// Entirely left of the window (zero-width clusters included).
+		case pos < start || pos+w > end:
+			// Straddles an edge: keep only the visible cells, as blanks.
+			visible := min(pos+w, end) - max(pos, start)
+			b.WriteString(strings.Repeat(" ", visible))
+			used += visible
+		default:
+			b.WriteString(cluster)
+			used += w
+		}
+		pos += w
+	}
+	if used < width {
+		b.WriteString(strings.Repeat(" ", width-used))
+	}
+	return b.String()
+}
+
+// splitWidth splits text after the longest prefix of whole grapheme
+// clusters that fits in width cells.
+func splitWidth(text string, width int) (head, rest string) {
+	used := 0
+	for i := 0; i < len(text); {
+		n := core.ClusterLen(text[i:])
+		w := core.StringWidth(text[i : i+n])
+		if used+w > width {
+			return text[:i], text[i:]
+		}
+		used += w
+		i += n
+	}
+	return text, ""
+}
+
+// firstCluster splits off the first grapheme cluster of text.
+func firstCluster(text string) (head, rest string) {
+	n := core.ClusterLen(text)
+	return text[:n], text[n:]
+}
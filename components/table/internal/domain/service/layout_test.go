@@ -0,0 +1,154 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/components/table/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/components/table/internal/domain/value"
+)
+
+func TestLayoutService_ColumnWidths(t *testing.T) {
+	svc := NewLayoutService()
+	rows := []model.Row{
+		{"id": 1, "name": "Alice", "note": "x"},
+		{"id": 22, "name": "日本語テキスト", "note": "y"},
+	}
+	fixed := model.NewColumn("id", "ID", 4)
+	auto := model.NewColumn("name", "Name", 4).WithWidthPolicy(value.WidthAuto())
+	flex1 := model.NewColumn("note", "Note", 6).WithWidthPolicy(value.WidthFlex(1))
+	flex2 := model.NewColumn("more", "More", 6).WithWidthPolicy(value.WidthFlex(2))
+
+	tests := []struct {
+		name    string
+		columns []*model.Column
+		total   int
+		want    []int
+	}{
+		{"Fixed only", []*model.Column{fixed}, 80, []int{4}},
+		{"Auto fits widest cell", []*model.Column{fixed, auto}, 0, []int{4, 14}},
+		{"Auto sortable leaves room for indicator",
+			[]*model.Column{auto.WithSortable(true)}, 0, []int{14}},
+		{"Flex without total width uses configured width",
+			[]*model.Column{fixed, flex1}, 0, []int{4, 6}},
+		// 40 - 4 - 14 - 3 separators = 19 cells; 19*1/3 = 6, 19*2/3 = 12, +1 remainder.
+		{"Flex shares remainder by weight",
+			[]*model.Column{fixed, auto, flex1, flex2}, 40, []int{4, 14, 7, 12}},
+		{"Flex never below one cell",
+			[]*model.Column{fixed, auto, flex1}, 10, []int{4, 14, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := svc.ColumnWidths(tt.columns, rows, tt.total)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ColumnWidths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutService_Truncate(t *testing.T) {
+	svc := NewLayoutService()
+
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  string
+	}{
+		{"Fits", "hello", 5, "hello"},
+		{"Ellipsis", "hello world", 8, "hello w…"},
+		{"Width one", "hello", 1, "…"},
+		{"Zero width", "hello", 0, ""},
+		{"Wide glyphs not split", "日本語テキスト", 6, "日本…"},
+		{"Wide glyphs exact", "日本語テキスト", 7, "日本語…"},
+		{"ZWJ sequence not split", "a👨\u200d👩\u200d👧b", 3, "a…"},
+		{"Flags not split", "🇯🇵🇫🇷🇩🇪", 5, "🇯🇵🇫🇷…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.Truncate(tt.text, tt.width); got != tt.want {
+				t.Errorf("Truncate(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutService_Wrap(t *testing.T) {
+	svc := NewLayoutService()
+
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  []string
+	}{
+		{"Fits", "short", 10, []string{"short"}},
+		{"Words", "the quick brown fox", 10, []string{"the quick", "brown fox"}},
+		{"Long word", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"Wide glyphs", "日本語テキスト", 5, []string{"日本", "語テ", "キス", "ト"}},
+		{"Newlines", "a\nb c", 10, []string{"a", "b c"}},
+		{"Empty", "", 5, []string{""}},
+		{"Flags", "🇯🇵🇫🇷🇩🇪", 4, []string{"🇯🇵🇫🇷", "🇩🇪"}},
+		{"Cluster wider than line", "👨\u200d👩\u200d👧x", 1, []string{"👨\u200d👩\u200d👧", "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.Wrap(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutService_SingleLine(t *testing.T) {
+	svc := NewLayoutService()
+
+	for text, want := range map[string]string{
+		"plain":         "plain",
+		"two\nlines":    "two lines",
+		"crlf\r\nbreak": "crlf break",
+		"cr\rbreak":     "cr break",
+		"a\n\nb":        "a  b",
+	} {
+		if got := svc.SingleLine(text); got != want {
+			t.Errorf("SingleLine(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestLayoutService_Slice(t *testing.T) {
+	svc := NewLayoutService()
+
+	tests := []struct {
+		name  string
+		text  string
+		start int
+		width int
+		want  string
+	}{
+		{"Whole", "hello", 0, 5, "hello"},
+		{"Middle", "hello world", 3, 5, "lo wo"},
+		{"Pads past end", "hi", 1, 4, "i   "},
+		{"Past text", "hi", 5, 3, "   "},
+		{"Wide glyph cut at start", "日本語", 1, 4, " 本 "},
+		{"Wide glyph cut at end", "日本語", 0, 3, "日 "},
+		{"Wide glyphs aligned", "日本語", 2, 4, "本語"},
+		{"Zero width", "hello", 0, 0, ""},
+		{"ZWJ sequence kept whole", "👨\u200d👩\u200d👧ab", 2, 2, "ab"},
+		{"ZWJ sequence cut", "👨\u200d👩\u200d👧ab", 1, 2, " a"},
+		{"Flags aligned", "🇯🇵🇫🇷🇩🇪", 2, 2, "🇫🇷"},
+		{"Combining mark", "e\u0301xy", 0, 2, "e\u0301x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.Slice(tt.text, tt.start, tt.width); got != tt.want {
+				t.Errorf("Slice(%q, %d, %d) = %q, want %q", tt.text, tt.start, tt.width, got, tt.want)
+			}
+		})
+	}
+}
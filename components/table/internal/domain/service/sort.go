@@ -46,6 +46,33 @@ func (s *SortService) Sort(rows []model.Row, columnKey string, direction value.S
 	return sorted
 }
 
+// SortByColumn sorts the rows by column like Sort, but compares the cell
+// text with the column's comparator when it has one (e.g. numeric order
+// for numbers rendered as strings). The sort is stable.
+func (s *SortService) SortByColumn(rows []model.Row, column *model.Column, direction value.SortDirection) []model.Row {
+	compare := column.Comparator()
+	if compare == nil {
+		return s.Sort(rows, column.Key(), direction)
+	}
+	if direction.IsNone() || len(rows) == 0 {
+		return rows
+	}
+
+	sorted := make([]model.Row, len(rows))
+	copy(sorted, rows)
+
+	key := column.Key()
+	sort.SliceStable(sorted, func(i, j int) bool {
+		cmp := compare(column.CellText(sorted[i][key]), column.CellText(sorted[j][key]))
+		if direction.IsAscending() {
+			return cmp < 0
+		}
+		return cmp > 0
+	})
+
+	return sorted
+}
+
 // Compare compares two cell values.
 // Supports string, int, int64, float64, and bool types.
 // Returns:
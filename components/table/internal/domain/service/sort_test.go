@@ -1,6 +1,8 @@
 package service
 
 import (
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/table/internal/domain/model"
@@ -306,3 +308,35 @@ func TestSortService_Sort_StableSort(t *testing.T) {
 		t.Errorf("B should have id=3")
 	}
 }
+
+func TestSortService_SortByColumn_Comparator(t *testing.T) {
+	svc := NewSortService()
+	rows := []model.Row{
+		{"size": "10", "id": 1},
+		{"size": "9", "id": 2},
+		{"size": "100", "id": 3},
+		{"size": "9", "id": 4},
+	}
+	numeric := func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x - y
+	}
+	col := model.NewColumn("size", "Size", 5).WithComparator(numeric)
+
+	asc := svc.SortByColumn(rows, col, value.SortDirectionAsc)
+	if ids := []interface{}{asc[0]["id"], asc[1]["id"], asc[2]["id"], asc[3]["id"]}; !reflect.DeepEqual(ids, []interface{}{2, 4, 1, 3}) {
+		t.Errorf("ascending ids = %v, want [2 4 1 3] (numeric, stable)", ids)
+	}
+
+	desc := svc.SortByColumn(rows, col, value.SortDirectionDesc)
+	if desc[0]["id"] != 3 || desc[3]["id"] != 4 {
+		t.Errorf("descending order wrong: first %v, last %v", desc[0]["id"], desc[3]["id"])
+	}
+
+	// Without a comparator strings sort lexicographically.
+	plain := svc.SortByColumn(rows, model.NewColumn("size", "Size", 5), value.SortDirectionAsc)
+	if plain[0]["size"] != "10" {
+		t.Errorf("default sort first = %v, want \"10\"", plain[0]["size"])
+	}
+}
@@ -0,0 +1,75 @@
+package value
+
+import "fmt"
+
+// widthKind identifies how a column's width is determined.
+type widthKind int
+
+const (
+	widthFixed widthKind = iota
+	widthAuto
+	widthFlex
+)
+
+// WidthPolicy defines how a column's width is determined when the table is
+// laid out: a fixed number of cells, the width of its widest content, or a
+// weighted share of the width left over by the other columns.
+type WidthPolicy struct {
+	kind   widthKind
+	weight int
+}
+
+// WidthFixed returns the policy of a column that always uses its configured
+// width (default).
+func WidthFixed() WidthPolicy {
+	return WidthPolicy{kind: widthFixed}
+}
+
+// WidthAuto returns the policy of a column sized to fit its title and its
+// widest cell.
+func WidthAuto() WidthPolicy {
+	return WidthPolicy{kind: widthAuto}
+}
+
+// WidthFlex returns the policy of a column that takes a share of the
+// remaining table width proportional to weight. Weights below 1 become 1.
+func WidthFlex(weight int) WidthPolicy {
+	if weight < 1 {
+		weight = 1
+	}
+	return WidthPolicy{kind: widthFlex, weight: weight}
+}
+
+// IsFixed returns true if the column uses its configured width.
+func (p WidthPolicy) IsFixed() bool {
+	return p.kind == widthFixed
+}
+
+// IsAuto returns true if the column fits its content.
+func (p WidthPolicy) IsAuto() bool {
+	return p.kind == widthAuto
+}
+
+// IsFlex returns true if the column shares the remaining width.
+func (p WidthPolicy) IsFlex() bool {
+	return p.kind == widthFlex
+}
+
+// Weight returns the flex weight (0 for fixed and auto columns).
+func (p WidthPolicy) Weight() int {
+	return p.weight
+}
+
+// String returns the string representation of the policy.
+func (p WidthPolicy) String() string {
+	switch p.kind {
+	case widthFixed:
+		return "fixed"
+	case widthAuto:
+		return "auto"
+	case widthFlex:
+		return fmt.Sprintf("flex(%d)", p.weight)
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,37 @@
+package value
+
+import "testing"
+
+func TestWidthPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy WidthPolicy
+		fixed  bool
+		auto   bool
+		flex   bool
+		weight int
+		str    string
+	}{
+		{"Zero value", WidthPolicy{}, true, false, false, 0, "fixed"},
+		{"Fixed", WidthFixed(), true, false, false, 0, "fixed"},
+		{"Auto", WidthAuto(), false, true, false, 0, "auto"},
+		{"Flex", WidthFlex(3), false, false, true, 3, "flex(3)"},
+		{"Flex clamps weight", WidthFlex(0), false, false, true, 1, "flex(1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.policy
+			if p.IsFixed() != tt.fixed || p.IsAuto() != tt.auto || p.IsFlex() != tt.flex {
+				t.Errorf("IsFixed/IsAuto/IsFlex = %v/%v/%v, want %v/%v/%v",
+					p.IsFixed(), p.IsAuto(), p.IsFlex(), tt.fixed, tt.auto, tt.flex)
+			}
+			if p.Weight() != tt.weight {
+				t.Errorf("Weight() = %d, want %d", p.Weight(), tt.weight)
+			}
+			if p.String() != tt.str {
+				t.Errorf("String() = %q, want %q", p.String(), tt.str)
+			}
+		})
+	}
+}
@@ -11,6 +11,8 @@ type KeyBindings struct {
 	PageDown  []string // Move down by page
 	Home      []string // Move to first row
 	End       []string // Move to last row
+	Left      []string // Focus previous column
+	Right     []string // Focus next column
 	Sort      []string // Toggle sort on current column
 	ClearSort []string // Clear all sorting
 }
@@ -24,6 +26,8 @@ func DefaultKeyBindings() KeyBindings {
 		PageDown:  []string{"pgdown"},
 		Home:      []string{"home", "g"},
 		End:       []string{"end", "G"},
+		Left:      []string{"←", "h"},
+		Right:     []string{"→", "l"},
 		Sort:      []string{"s", "enter"},
 		ClearSort: []string{"c"},
 	}
@@ -59,6 +63,16 @@ func (kb KeyBindings) IsEnd(msg tea.KeyMsg) bool {
 	return kb.matchesAny(msg, kb.End)
 }
 
+// IsLeft returns true if the key message matches a "previous column" binding.
+func (kb KeyBindings) IsLeft(msg tea.KeyMsg) bool {
+	return kb.matchesAny(msg, kb.Left)
+}
+
+// IsRight returns true if the key message matches a "next column" binding.
+func (kb KeyBindings) IsRight(msg tea.KeyMsg) bool {
+	return kb.matchesAny(msg, kb.Right)
+}
+
 // IsSort returns true if the key message matches a "sort" binding.
 func (kb KeyBindings) IsSort(msg tea.KeyMsg) bool {
 	return kb.matchesAny(msg, kb.Sort)
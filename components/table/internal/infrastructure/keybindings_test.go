@@ -163,6 +163,20 @@ func TestKeyBindings_IsEnd(t *testing.T) {
 	}
 }
 
+func TestKeyBindings_IsLeftRight(t *testing.T) {
+	kb := DefaultKeyBindings()
+
+	if !kb.IsLeft(tea.KeyMsg{Type: tea.KeyLeft}) || !kb.IsLeft(tea.KeyMsg{Type: tea.KeyRune, Rune: 'h'}) {
+		t.Errorf("IsLeft('←', 'h') should be true")
+	}
+	if !kb.IsRight(tea.KeyMsg{Type: tea.KeyRight}) || !kb.IsRight(tea.KeyMsg{Type: tea.KeyRune, Rune: 'l'}) {
+		t.Errorf("IsRight('→', 'l') should be true")
+	}
+	if kb.IsLeft(tea.KeyMsg{Type: tea.KeyRight}) {
+		t.Errorf("IsLeft('→') should be false")
+	}
+}
+
 func TestKeyBindings_IsSort(t *testing.T) {
 	kb := DefaultKeyBindings()
 
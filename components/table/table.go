@@ -2,9 +2,11 @@
 //
 // The Table component displays tabular data with support for:
 //   - Column definitions (width, alignment, custom rendering)
+//   - Width policies (fixed, fit content, flex) with truncation or wrapping
 //   - Sorting (by column, ascending/descending)
 //   - Keyboard navigation (arrows, vim keys, home/end)
-//   - Scrolling (for tables larger than viewport)
+//   - Scrolling (vertical, and horizontal with frozen leading columns)
+//   - Cell and row styling (per cell, conditional rows, zebra stripes)
 //
 // This is a UNIVERSAL component - it works for any application (file managers,.
 // data viewers, process lists, etc.). It does NOT include application-specific.
@@ -12,13 +14,13 @@
 package table
 
 import (
-	"fmt"
 	"strings"
 
 	model2 "github.com/phoenix-tui/phoenix/components/table/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/components/table/internal/domain/service"
 	value2 "github.com/phoenix-tui/phoenix/components/table/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/components/table/internal/infrastructure"
+	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style"
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
@@ -41,8 +43,21 @@ type Row map[string]interface{}
 type Table struct {
 	domain      *model2.Table
 	sortService *service.SortService
+	layout      *service.LayoutService
 	keyBindings infrastructure.KeyBindings
 	theme       *style.Theme // Optional theme, defaults to DefaultTheme if nil
+	originX     int          // Screen column of the table's left edge (for mouse)
+	originY     int          // Screen row of the table's first line (for mouse)
+	width       int          // Total width for flex columns (0 = unknown)
+	// Cell styling (applied after layout, so widths ignore it)
+	cellStyles map[cellPos]style.Style         // Per-cell styles (nil = none)
+	rowStyle   func(row []string) *style.Style // Conditional row style (nil = none)
+	zebra      []style.Style                   // Even and odd row styles (nil = no stripes)
+}
+
+// cellPos addresses a cell by its index in Rows and its column index.
+type cellPos struct {
+	row, col int
 }
 
 // New creates a new table with the given columns.
@@ -65,6 +80,7 @@ func New(columns []Column) *Table {
 	return &Table{
 		domain:      model2.NewTable(domainCols),
 		sortService: service.NewSortService(),
+		layout:      service.NewLayoutService(),
 		keyBindings: infrastructure.DefaultKeyBindings(),
 	}
 }
@@ -85,33 +101,20 @@ func NewWithRows(columns []Column, rows []Row) *Table {
 
 // Height returns a new table with the specified visible height.
 func (t *Table) Height(height int) *Table {
-	return &Table{
-		domain:      t.domain.WithHeight(height),
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-		theme:       t.theme,
-	}
+	return t.withDomain(t.domain.WithHeight(height))
 }
 
 // ShowHeader returns a new table with header visibility set.
 func (t *Table) ShowHeader(show bool) *Table {
-	return &Table{
-		domain:      t.domain.WithShowHeader(show),
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-		theme:       t.theme,
-	}
+	return t.withDomain(t.domain.WithShowHeader(show))
 }
 
 // Theme sets the theme for styling the table component.
 // If nil is provided, DefaultTheme will be used during rendering.
 func (t *Table) Theme(theme *style.Theme) *Table {
-	return &Table{
-		domain:      t.domain,
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-		theme:       theme,
-	}
+	newTable := t.withDomain(t.domain)
+	newTable.theme = theme
+	return newTable
 }
 
 // SetRows returns a new table with updated rows (clears sorting).
@@ -120,20 +123,233 @@ func (t *Table) SetRows(rows []Row) *Table {
 	for i, row := range rows {
 		domainRows[i] = model2.Row(row)
 	}
-	return &Table{
-		domain:      t.domain.WithRows(domainRows),
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
+	return t.withDomain(t.domain.WithRows(domainRows))
+}
+
+// StyleCell returns a new table that renders the cell in column col of row
+// with s. row indexes Rows(), so the style stays with its row when the table
+// is sorted. Cell styles take precedence over row styles.
+//
+// Styles are applied to the laid-out cell, including its padding, and do not
+// affect column widths; they should only set colors and text attributes.
+func (t *Table) StyleCell(row, col int, s style.Style) *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.cellStyles = make(map[cellPos]style.Style, len(t.cellStyles)+1)
+	for pos, cellStyle := range t.cellStyles {
+		newTable.cellStyles[pos] = cellStyle
 	}
+	newTable.cellStyles[cellPos{row: row, col: col}] = s
+	return newTable
+}
+
+// ClearCellStyles returns a new table without the styles set by StyleCell.
+func (t *Table) ClearCellStyles() *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.cellStyles = nil
+	return newTable
+}
+
+// StyleRowIf returns a new table that asks fn for the style of every
+// rendered row, passing the row's cell text in column order. A nil result
+// leaves the row unstyled. Row styles cover the cells and the separators
+// between them, and take precedence over ZebraStripe.
+//
+// Example - highlight failed jobs:
+//
+//	t = t.StyleRowIf(func(row []string) *style.Style {
+//		if row[2] == "failed" {
+//			s := style.New().Foreground(style.RGB(255, 85, 85))
+//			return &s
+//		}
+//		return nil
+//	})
+func (t *Table) StyleRowIf(fn func(row []string) *style.Style) *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.rowStyle = fn
+	return newTable
+}
+
+// ZebraStripe returns a new table that renders rows alternately with even
+// and odd in display order (the first row is even), which makes wide tables
+// easier to follow. Stripes scroll with their rows.
+func (t *Table) ZebraStripe(even, odd style.Style) *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.zebra = []style.Style{even, odd}
+	return newTable
 }
 
 // KeyBindings returns a new table with custom key bindings.
 func (t *Table) KeyBindings(kb infrastructure.KeyBindings) *Table {
-	return &Table{
-		domain:      t.domain,
-		sortService: t.sortService,
-		keyBindings: kb,
+	newTable := t.withDomain(t.domain)
+	newTable.keyBindings = kb
+	return newTable
+}
+
+// Sortable makes every column sortable (or none, with false). The user
+// sorts with the sort key ("s"/Enter) on the focused column (moved with
+// ←/→ or h/l) or by clicking a column header; both cycle through ascending,
+// descending and unsorted.
+func (t *Table) Sortable(enabled bool) *Table {
+	domain := t.domain
+	for i, col := range domain.Columns() {
+		domain = domain.WithColumn(i, col.WithSortable(enabled))
+	}
+	return t.withDomain(domain)
+}
+
+// SortBy returns a new table sorted by the column at index col, ascending or
+// descending. Sorting is stable and the selection stays on the same row.
+// Unsortable columns and out-of-range indices are ignored.
+func (t *Table) SortBy(col int, asc bool) *Table {
+	columns := t.domain.Columns()
+	if col < 0 || col >= len(columns) || !columns[col].IsSortable() {
+		return t
+	}
+	direction := value2.SortDirectionDesc
+	if asc {
+		direction = value2.SortDirectionAsc
+	}
+	return t.sortBy(columns[col], direction)
+}
+
+// WithComparator sets the sort comparator of the column at index col.
+// It receives the cell text (as rendered) and returns a negative number,
+// zero or a positive number, e.g. to sort numbers stored as strings:
+//
+//	t = t.WithComparator(2, func(a, b string) int {
+//		x, _ := strconv.Atoi(a)
+//		y, _ := strconv.Atoi(b)
+//		return x - y
+//	})
+//
+// An active sort on that column is re-applied.
+func (t *Table) WithComparator(col int, compare func(a, b string) int) *Table {
+	columns := t.domain.Columns()
+	if col < 0 || col >= len(columns) {
+		return t
+	}
+	newTable := t.withDomain(t.domain.WithColumn(col, columns[col].WithComparator(compare)))
+	if t.domain.IsSorted() && t.domain.SortColumn() == columns[col].Key() {
+		return newTable.sortBy(newTable.domain.Columns()[col], t.domain.SortDirection())
+	}
+	return newTable
+}
+
+// Width returns a new table that lays out flex columns (see ColumnFlex) to
+// fill width terminal cells. The table also adopts the width of every
+// tea.WindowSizeMsg it receives.
+func (t *Table) Width(width int) *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.width = width
+	return newTable
+}
+
+// ColumnWidth returns a new table where the column at index col is exactly
+// width cells wide. This is the default policy, using Column.Width.
+func (t *Table) ColumnWidth(col, width int) *Table {
+	return t.updateColumn(col, func(c *model2.Column) *model2.Column {
+		return c.WithWidth(width).WithWidthPolicy(value2.WidthFixed())
+	})
+}
+
+// ColumnAuto returns a new table where the column at index col is as wide
+// as its title and its widest cell.
+func (t *Table) ColumnAuto(col int) *Table {
+	return t.updateColumn(col, func(c *model2.Column) *model2.Column {
+		return c.WithWidthPolicy(value2.WidthAuto())
+	})
+}
+
+// ColumnFlex returns a new table where the column at index col shares the
+// table width (see Width) left over by the other columns, in proportion to
+// weight. Until the width is known the column uses Column.Width.
+func (t *Table) ColumnFlex(col, weight int) *Table {
+	return t.updateColumn(col, func(c *model2.Column) *model2.Column {
+		return c.WithWidthPolicy(value2.WidthFlex(weight))
+	})
+}
+
+// WrapColumn returns a new table where long cells of the column at index col
+// wrap onto extra lines (wrap true) instead of being truncated with an
+// ellipsis. A wrapped row takes as many lines as its tallest cell; Height
+// still counts rows, not lines.
+func (t *Table) WrapColumn(col int, wrap bool) *Table {
+	return t.updateColumn(col, func(c *model2.Column) *model2.Column {
+		return c.WithWrap(wrap)
+	})
+}
+
+// FreezeColumns returns a new table whose first n columns stay visible when
+// the table scrolls horizontally; a double line separates them from the
+// scrolling columns. Horizontal scrolling happens when the columns are wider
+// than the table width (see Width).
+func (t *Table) FreezeColumns(n int) *Table {
+	return t.withDomain(t.domain.WithFrozenColumns(n))
+}
+
+// HorizontalOffset returns how many cells the non-frozen columns are
+// scrolled to the left.
+func (t *Table) HorizontalOffset() int {
+	return t.horizontalWindow(t.columnWidths()).offset
+}
+
+// SetHorizontalOffset returns a new table with the non-frozen columns
+// scrolled x cells, clamped so the last column stays at the right edge.
+// ←/→ (h/l) also scroll, keeping the focused column in view.
+func (t *Table) SetHorizontalOffset(x int) *Table {
+	newTable := t.withDomain(t.domain.WithHorizontalOffset(x))
+	return newTable.withDomain(newTable.domain.WithHorizontalOffset(newTable.HorizontalOffset()))
+}
+
+// revealFocusedColumn scrolls horizontally so the focused column is visible.
+func (t *Table) revealFocusedColumn() *Table {
+	widths := t.columnWidths()
+	window := t.horizontalWindow(widths)
+	col := t.domain.FocusedColumn()
+	if window.width < 0 || col < window.frozen || col >= len(widths) {
+		return t
 	}
+
+	left := 0
+	for i := window.frozen; i < col; i++ {
+		left += widths[i] + 1 // +1 for separator
+	}
+	offset := window.offset
+	if right := left + widths[col]; right > offset+window.width {
+		offset = right - window.width
+	}
+	offset = min(offset, left)
+	return t.SetHorizontalOffset(offset)
+}
+
+// updateColumn returns a new table with the column at index col replaced by
+// fn(column). Out-of-range indices are ignored.
+func (t *Table) updateColumn(col int, fn func(*model2.Column) *model2.Column) *Table {
+	columns := t.domain.Columns()
+	if col < 0 || col >= len(columns) {
+		return t
+	}
+	return t.withDomain(t.domain.WithColumn(col, fn(columns[col])))
+}
+
+// columnWidths returns the rendered width of each column.
+func (t *Table) columnWidths() []int {
+	return t.layout.ColumnWidths(t.domain.Columns(), t.domain.Rows(), t.width)
+}
+
+// Position tells the table where its top-left corner is drawn on screen,
+// so header clicks (tea.MouseMsg carries screen coordinates) hit the right
+// column. Defaults to (0, 0).
+func (t *Table) Position(x, y int) *Table {
+	newTable := t.withDomain(t.domain)
+	newTable.originX = x
+	newTable.originY = y
+	return newTable
+}
+
+// FocusedColumn returns the index of the column the sort key acts on.
+func (t *Table) FocusedColumn() int {
+	return t.domain.FocusedColumn()
 }
 
 // Init implements tea.Model.
@@ -143,14 +359,101 @@ func (t *Table) Init() tea.Cmd {
 
 // Update implements tea.Model pattern.
 func (t *Table) Update(msg tea.Msg) (*Table, tea.Cmd) {
-	//nolint:gocritic // Type switch with single case is idiomatic for tea.Model pattern
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return t.handleKeyPress(msg), nil
+	case tea.MouseMsg:
+		return t.handleMouse(msg), nil
+	case tea.WindowSizeMsg:
+		return t.Width(msg.Width), nil
 	}
 	return t, nil
 }
 
+// handleMouse sorts by a column when its header is clicked.
+func (t *Table) handleMouse(msg tea.MouseMsg) *Table {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return t
+	}
+	if !t.domain.ShowHeader() || msg.Y != t.originY {
+		return t
+	}
+
+	if col := t.columnAt(msg.X - t.originX); col >= 0 {
+		return t.withDomain(t.domain.WithFocusedColumn(col)).cycleSort(col)
+	}
+	return t
+}
+
+// columnAt returns the index of the column drawn at cell x of a line, or -1
+// for separators and positions outside the table.
+func (t *Table) columnAt(x int) int {
+	widths := t.columnWidths()
+	window := t.horizontalWindow(widths)
+
+	left := 0
+	for i := 0; i < window.frozen; i++ {
+		if x >= left && x < left+widths[i] {
+			return i
+		}
+		left += widths[i] + 1 // +1 for separator
+	}
+
+	// Scrolling columns, in content coordinates.
+	if window.width >= 0 && x-left >= window.width {
+		return -1
+	}
+	x += window.offset - left
+	left = 0
+	for i := window.frozen; i < len(widths); i++ {
+		if x >= left && x < left+widths[i] {
+			return i
+		}
+		left += widths[i] + 1
+	}
+	return -1
+}
+
+// cycleSort advances the sort of column col: unsorted → ascending →
+// descending → unsorted.
+func (t *Table) cycleSort(col int) *Table {
+	column := t.domain.Columns()[col]
+	if !column.IsSortable() {
+		return t
+	}
+	if !t.domain.IsSorted() || t.domain.SortColumn() != column.Key() {
+		return t.sortBy(column, value2.SortDirectionAsc)
+	}
+	if t.domain.SortDirection().IsAscending() {
+		return t.sortBy(column, value2.SortDirectionDesc)
+	}
+	return t.ClearSort()
+}
+
+// sortBy sorts the rows by column in direction.
+func (t *Table) sortBy(column *model2.Column, direction value2.SortDirection) *Table {
+	sortedRows := t.sortService.SortByColumn(t.domain.Rows(), column, direction)
+	return t.withDomain(t.domain.SortBy(column.Key(), direction, sortedRows))
+}
+
+// withDomain returns a new table with the given domain model and the
+// remaining configuration copied.
+func (t *Table) withDomain(domain *model2.Table) *Table {
+	return &Table{
+		domain:      domain,
+		sortService: t.sortService,
+		layout:      t.layout,
+		keyBindings: t.keyBindings,
+		theme:       t.theme,
+		originX:     t.originX,
+		originY:     t.originY,
+		width:       t.width,
+		cellStyles:  t.cellStyles,
+		rowStyle:    t.rowStyle,
+		zebra:       t.zebra,
+	}
+}
+
 // handleKeyPress processes keyboard input.
 func (t *Table) handleKeyPress(msg tea.KeyMsg) *Table {
 	kb := t.keyBindings
@@ -170,17 +473,22 @@ func (t *Table) handleKeyPress(msg tea.KeyMsg) *Table {
 		return t.pageUp()
 	case kb.IsPageDown(msg):
 		return t.pageDown()
+	case kb.IsLeft(msg):
+		return t.withDomain(t.domain.MoveLeft()).revealFocusedColumn()
+	case kb.IsRight(msg):
+		return t.withDomain(t.domain.MoveRight()).revealFocusedColumn()
+	case kb.IsSort(msg):
+		if len(t.domain.Columns()) == 0 {
+			return t
+		}
+		return t.cycleSort(t.domain.FocusedColumn())
 	case kb.IsClearSort(msg):
 		newDomain = t.domain.ClearSort()
 	default:
 		return t
 	}
 
-	return &Table{
-		domain:      newDomain,
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-	}
+	return t.withDomain(newDomain)
 }
 
 // pageUp moves up by one page (visible height).
@@ -193,11 +501,7 @@ func (t *Table) pageUp() *Table {
 	for i := 0; i < pageSize && newDomain.SelectedIndex() > 0; i++ {
 		newDomain = newDomain.MoveUp()
 	}
-	return &Table{
-		domain:      newDomain,
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-	}
+	return t.withDomain(newDomain)
 }
 
 // pageDown moves down by one page (visible height).
@@ -211,11 +515,7 @@ func (t *Table) pageDown() *Table {
 	for i := 0; i < pageSize && newDomain.SelectedIndex() < maxIndex; i++ {
 		newDomain = newDomain.MoveDown()
 	}
-	return &Table{
-		domain:      newDomain,
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-	}
+	return t.withDomain(newDomain)
 }
 
 // View implements tea.Model.
@@ -227,15 +527,16 @@ func (t *Table) View() string {
 	columns := t.domain.Columns()
 	visibleRows := t.domain.VisibleRows()
 
-	// Calculate total width.
-	totalWidth := 0
-	for _, col := range columns {
-		totalWidth += col.Width() + 1 // +1 for separator
-	}
+	widths := t.columnWidths()
+	window := t.horizontalWindow(widths)
+
+	// The focused column is highlighted when the user can sort.
+	focusStyle, showFocus := t.headerFocusStyle()
 
 	// Render header.
 	//nolint:nestif // Header rendering is complex: sort indicators, padding, alignment per column
 	if t.domain.ShowHeader() {
+		cells := make([]string, len(columns))
 		for i, col := range columns {
 			title := col.Title()
 
@@ -248,22 +549,21 @@ func (t *Table) View() string {
 				}
 			}
 
-			cell := t.formatCell(title, col.Width(), col.Alignment())
-			b.WriteString(cell)
-
-			if i < len(columns)-1 {
-				b.WriteString("│")
-			}
+			cells[i] = t.formatCell(title, widths[i], col.Alignment())
 		}
+		var headerStyles []*style.Style
+		if showFocus {
+			headerStyles = make([]*style.Style, len(columns))
+			headerStyles[t.domain.FocusedColumn()] = &focusStyle
+		}
+		b.WriteString(t.joinCells(cells, widths, window, "│", "║", headerStyles, nil))
 		b.WriteString("\n")
 
 		// Header separator.
-		for i, col := range columns {
-			b.WriteString(strings.Repeat("─", col.Width()))
-			if i < len(columns)-1 {
-				b.WriteString("┼")
-			}
+		for i := range columns {
+			cells[i] = strings.Repeat("─", widths[i])
 		}
+		b.WriteString(t.joinCells(cells, widths, window, "┼", "╫", nil, nil))
 		b.WriteString("\n")
 	}
 
@@ -275,51 +575,197 @@ func (t *Table) View() string {
 		absoluteIdx := scrollOffset + rowIdx
 		isSelected := absoluteIdx == selectedIndex
 
+		// Fit each cell into its column; wrapping cells may span several lines.
+		texts := make([]string, len(columns))
+		cells := make([][]string, len(columns))
+		lineCount := 1
 		for colIdx, col := range columns {
-			value := row[col.Key()]
-
-			// Use custom renderer if available.
-			var cellText string
-			if col.Renderer() != nil {
-				cellText = col.Renderer()(value)
+			cellText := col.CellText(row[col.Key()])
+			texts[colIdx] = cellText
+			if col.Wraps() {
+				cells[colIdx] = t.layout.Wrap(cellText, widths[colIdx])
 			} else {
-				cellText = fmt.Sprintf("%v", value)
+				cells[colIdx] = []string{t.layout.Truncate(t.layout.SingleLine(cellText), widths[colIdx])}
 			}
+			lineCount = max(lineCount, len(cells[colIdx]))
+		}
 
-			cell := t.formatCell(cellText, col.Width(), col.Alignment())
+		rowStyle, cellStyles := t.rowStyles(absoluteIdx, t.domain.RowIndex(absoluteIdx), texts)
 
-			// Add selection indicator.
-			if isSelected && colIdx == 0 {
-				if cell != "" {
-					cell = ">" + cell[1:]
+		lineCells := make([]string, len(columns))
+		for line := 0; line < lineCount; line++ {
+			for colIdx, col := range columns {
+				var text string
+				if line < len(cells[colIdx]) {
+					text = cells[colIdx][line]
 				}
-			}
+				lineCells[colIdx] = t.formatCell(text, widths[colIdx], col.Alignment())
 
-			b.WriteString(cell)
-
-			if colIdx < len(columns)-1 {
-				b.WriteString("│")
+				// Add selection indicator.
+				if isSelected && colIdx == 0 && line == 0 {
+					lineCells[colIdx] = markSelected(lineCells[colIdx])
+				}
 			}
+			b.WriteString(t.joinCells(lineCells, widths, window, "│", "║", cellStyles, rowStyle))
+			b.WriteString("\n")
 		}
-		b.WriteString("\n")
 	}
 
 	return b.String()
 }
 
-// formatCell formats a cell with alignment and width.
-func (t *Table) formatCell(text string, width int, alignment value2.Alignment) string {
-	// Truncate if too long.
-	if len(text) > width {
-		if width > 3 {
-			text = text[:width-3] + "..."
+// hWindow is the horizontal layout of a rendered table: the first frozen
+// columns are always drawn, followed by the cells [offset, offset+width) of
+// the remaining columns. A negative width means no limit.
+type hWindow struct {
+	frozen int
+	offset int
+	width  int
+}
+
+// horizontalWindow returns the horizontal layout for the given column
+// widths, clamping the scroll offset to the scrollable content.
+func (t *Table) horizontalWindow(widths []int) hWindow {
+	frozen := min(t.domain.FrozenColumns(), len(widths))
+	if t.width <= 0 || frozen == len(widths) {
+		return hWindow{frozen: frozen, width: -1}
+	}
+
+	frozenWidth, scrollWidth := 0, -1
+	for i, w := range widths {
+		if i < frozen {
+			frozenWidth += w + 1 // +1 for the separator after it
 		} else {
-			text = text[:width]
+			scrollWidth += w + 1
+		}
+	}
+	width := max(t.width-frozenWidth, 0)
+	offset := min(t.domain.HorizontalOffset(), max(scrollWidth-width, 0))
+	return hWindow{frozen: frozen, offset: offset, width: width}
+}
+
+// joinCells joins the cells of one line with sep, using frozenSep after the
+// frozen columns and cutting the scrolling columns to the visible window.
+// Cell i is rendered with styles[i] when it is set, and separators with
+// sepStyle. Styles are applied after cutting, so escape codes never count
+// towards the layout.
+func (t *Table) joinCells(cells []string, widths []int, window hWindow, sep, frozenSep string, styles []*style.Style, sepStyle *style.Style) string {
+	var b strings.Builder
+	styled := func(i int, text string) string {
+		s := sepStyle
+		if i >= 0 && i < len(styles) {
+			s = styles[i]
 		}
+		if s == nil {
+			return text
+		}
+		return style.Render(*s, text)
 	}
 
+	for i := 0; i < window.frozen; i++ {
+		if i > 0 {
+			b.WriteString(styled(-1, sep))
+		}
+		b.WriteString(styled(i, cells[i]))
+	}
+	if window.frozen == len(cells) {
+		return b.String()
+	}
+	if window.frozen > 0 {
+		b.WriteString(styled(-1, frozenSep))
+	}
+
+	// Scrolling columns: write the part of each cell and separator that
+	// falls inside [offset, offset+width).
+	end := window.offset + window.width
+	pos := 0
+	write := func(i int, text string, width int) {
+		from, to := max(pos, window.offset), pos+width
+		if window.width >= 0 {
+			to = min(to, end)
+		}
+		if from < to {
+			if from > pos || to < pos+width {
+				text = t.layout.Slice(text, from-pos, to-from)
+			}
+			b.WriteString(styled(i, text))
+		}
+		pos += width
+	}
+	for i := window.frozen; i < len(cells); i++ {
+		if i > window.frozen {
+			write(-1, sep, 1)
+		}
+		write(i, cells[i], widths[i])
+	}
+	return b.String()
+}
+
+// rowStyles returns the style of the row displayed at index pos (its index
+// in Rows is dataIdx and its cell text texts) and the style of each of
+// its cells: the cell's own style, else the row style. Both are nil when
+// nothing applies.
+func (t *Table) rowStyles(pos, dataIdx int, texts []string) (*style.Style, []*style.Style) {
+	var rowStyle *style.Style
+	if t.rowStyle != nil {
+		rowStyle = t.rowStyle(texts)
+	}
+	if rowStyle == nil && t.zebra != nil {
+		rowStyle = &t.zebra[pos%2]
+	}
+
+	if rowStyle == nil && len(t.cellStyles) == 0 {
+		return nil, nil
+	}
+	cellStyles := make([]*style.Style, len(texts))
+	for col := range texts {
+		cellStyles[col] = rowStyle
+		if s, ok := t.cellStyles[cellPos{row: dataIdx, col: col}]; ok {
+			cellStyles[col] = &s
+		}
+	}
+	return rowStyle, cellStyles
+}
+
+// headerFocusStyle returns the style of the focused column header and
+// whether it should be shown (only when some column is sortable).
+func (t *Table) headerFocusStyle() (style.Style, bool) {
+	sortable := false
+	for _, col := range t.domain.Columns() {
+		sortable = sortable || col.IsSortable()
+	}
+	if !sortable {
+		return style.Style{}, false
+	}
+
+	theme := t.theme
+	if theme == nil {
+		theme = style.DefaultTheme()
+	}
+	return style.New().Foreground(theme.Colors().Primary).Underline(true), true
+}
+
+// markSelected replaces the first cell of the selected row's first column
+// with the selection indicator, keeping the cell width.
+func markSelected(cell string) string {
+	size := core.ClusterLen(cell)
+	if size == 0 {
+		return cell
+	}
+	if core.StringWidth(cell[:size]) > 1 {
+		return "> " + cell[size:]
+	}
+	return ">" + cell[size:]
+}
+
+// formatCell formats a cell with alignment and width. Line breaks are
+// replaced with spaces, and text wider than width is truncated with an
+// ellipsis.
+func (t *Table) formatCell(text string, width int, alignment value2.Alignment) string {
+	text = t.layout.Truncate(t.layout.SingleLine(text), width)
+
 	// Pad based on alignment.
-	padding := width - len(text)
+	padding := width - core.StringWidth(text)
 	if padding <= 0 {
 		return text
 	}
@@ -380,22 +826,10 @@ func (t *Table) SortByColumn(columnKey string) *Table {
 		direction = t.domain.SortDirection().Toggle()
 	}
 
-	// Perform sort.
-	sortedRows := t.sortService.Sort(t.domain.Rows(), columnKey, direction)
-	newDomain := t.domain.SortBy(columnKey, direction, sortedRows)
-
-	return &Table{
-		domain:      newDomain,
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-	}
+	return t.sortBy(targetCol, direction)
 }
 
 // ClearSort returns a new table with sorting removed.
 func (t *Table) ClearSort() *Table {
-	return &Table{
-		domain:      t.domain.ClearSort(),
-		sortService: t.sortService,
-		keyBindings: t.keyBindings,
-	}
+	return t.withDomain(t.domain.ClearSort())
 }
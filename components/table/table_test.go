@@ -1,10 +1,13 @@
 package table
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/components/table/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/core"
+	"github.com/phoenix-tui/phoenix/style"
 	tea "github.com/phoenix-tui/phoenix/tea"
 )
 
@@ -474,3 +477,370 @@ func TestTable_Immutability(t *testing.T) {
 		t.Errorf("New table should hide header")
 	}
 }
+
+func TestTable_SortKey_CyclesFocusedColumn(t *testing.T) {
+	table := createTestTable()
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRight})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'l'})
+	if table.FocusedColumn() != 2 {
+		t.Fatalf("FocusedColumn() = %d, want 2", table.FocusedColumn())
+	}
+
+	sortKey := tea.KeyMsg{Type: tea.KeyRune, Rune: 's'}
+	want := []string{"Bob", "Charlie", "Alice"} // asc, desc, unsorted
+	for i, name := range want {
+		table, _ = table.Update(sortKey)
+		if got := table.domain.VisibleRows()[0]["name"]; got != name {
+			t.Errorf("step %d: first row = %v, want %s", i, got, name)
+		}
+	}
+	if table.domain.IsSorted() {
+		t.Error("third sort key press should clear the sort")
+	}
+}
+
+func TestTable_HeaderClick_Sorts(t *testing.T) {
+	table := createTestTable().Position(2, 4)
+	click := tea.MouseMsg{X: 9, Y: 4, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+
+	table, _ = table.Update(click)
+	if table.FocusedColumn() != 1 {
+		t.Errorf("FocusedColumn() = %d, want 1", table.FocusedColumn())
+	}
+	if !table.domain.IsSorted() || table.domain.SortColumn() != "name" {
+		t.Fatalf("click on Name header should sort by name, got %q", table.domain.SortColumn())
+	}
+
+	// Clicks below the header are ignored.
+	row := click
+	row.Y = 5
+	after, _ := table.Update(row)
+	if !after.domain.SortDirection().IsAscending() {
+		t.Error("click outside the header should not change the sort")
+	}
+}
+
+func TestTable_SortBy(t *testing.T) {
+	table := createTestTable().SortBy(2, false)
+
+	if got := table.domain.VisibleRows()[0]["name"]; got != "Charlie" {
+		t.Errorf("first row = %v, want Charlie", got)
+	}
+	if same := table.SortBy(5, true); same != table {
+		t.Error("out-of-range column should be ignored")
+	}
+}
+
+func TestTable_Sortable(t *testing.T) {
+	columns := []Column{{Key: "name", Title: "Name", Width: 10}}
+	rows := []Row{{"name": "b"}, {"name": "a"}}
+
+	table := NewWithRows(columns, rows).SortBy(0, true)
+	if table.domain.IsSorted() {
+		t.Fatal("unsortable column should not sort")
+	}
+
+	table = NewWithRows(columns, rows).Sortable(true).SortBy(0, true)
+	if got := table.domain.VisibleRows()[0]["name"]; got != "a" {
+		t.Errorf("first row = %v, want a", got)
+	}
+}
+
+func TestTable_WithComparator(t *testing.T) {
+	columns := []Column{{Key: "size", Title: "Size", Width: 6, Sortable: true}}
+	rows := []Row{{"size": "10"}, {"size": "9"}, {"size": "100"}}
+	numeric := func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x - y
+	}
+
+	table := NewWithRows(columns, rows).SortBy(0, true)
+	if got := table.domain.VisibleRows()[0]["size"]; got != "10" {
+		t.Errorf("lexical sort: first row = %v, want 10", got)
+	}
+
+	// Setting the comparator re-applies the active sort.
+	table = table.WithComparator(0, numeric)
+	var got []string
+	for _, r := range table.domain.VisibleRows() {
+		got = append(got, r["size"].(string))
+	}
+	if strings.Join(got, ",") != "9,10,100" {
+		t.Errorf("numeric sort = %v, want [9 10 100]", got)
+	}
+}
+
+func TestTable_Sort_SelectionFollowsRow(t *testing.T) {
+	table := createTestTable()
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyDown}) // Bob
+
+	table = table.SortBy(2, false)
+	if got := table.SelectedRow()["name"]; got != "Bob" {
+		t.Errorf("selected row after sort = %v, want Bob", got)
+	}
+	if table.SelectedIndex() != 2 {
+		t.Errorf("SelectedIndex() = %d, want 2", table.SelectedIndex())
+	}
+
+	table = table.ClearSort()
+	if got := table.SelectedRow()["name"]; got != "Bob" {
+		t.Errorf("selected row after ClearSort = %v, want Bob", got)
+	}
+}
+
+func TestTable_ColumnWidthPolicies(t *testing.T) {
+	columns := []Column{
+		{Key: "id", Title: "ID", Width: 5},
+		{Key: "name", Title: "Name", Width: 3},
+		{Key: "desc", Title: "Description", Width: 10},
+	}
+	rows := []Row{
+		{"id": 1, "name": "Alice", "desc": "first"},
+		{"id": 2, "name": "Bob", "desc": "second"},
+	}
+
+	table := NewWithRows(columns, rows).
+		ColumnWidth(0, 3).
+		ColumnAuto(1).
+		ColumnFlex(2, 1).
+		Width(30)
+
+	lines := strings.Split(table.View(), "\n")
+	// 3 + 1 + 5 (auto: "Alice") + 1 + flex 20 = 30.
+	if got := core.StringWidth(lines[0]); got != 30 {
+		t.Errorf("header width = %d, want 30: %q", got, lines[0])
+	}
+	if !strings.Contains(lines[2], "Alice│first") {
+		t.Errorf("auto column should fit Alice: %q", lines[2])
+	}
+
+	// A window resize re-flows the flex column.
+	table, _ = table.Update(tea.WindowSizeMsg{Width: 20, Height: 10})
+	lines = strings.Split(table.View(), "\n")
+	if got := core.StringWidth(lines[0]); got != 20 {
+		t.Errorf("header width after resize = %d, want 20: %q", got, lines[0])
+	}
+}
+
+func TestTable_View_TruncatesWideGlyphs(t *testing.T) {
+	columns := []Column{{Key: "name", Title: "Name", Width: 6}}
+	rows := []Row{{"name": "日本語テキスト"}, {"name": "abcdefghij"}}
+
+	view := NewWithRows(columns, rows).View()
+	lines := strings.Split(view, "\n")
+
+	if lines[2] != "> 本… " {
+		t.Errorf("wide row = %q, want wide glyphs kept whole", lines[2])
+	}
+	if lines[3] != "abcde…" {
+		t.Errorf("ascii row = %q, want %q", lines[3], "abcde…")
+	}
+}
+
+func TestTable_WrapColumn(t *testing.T) {
+	columns := []Column{
+		{Key: "id", Title: "ID", Width: 3, Alignment: value.AlignmentRight},
+		{Key: "desc", Title: "Desc", Width: 9},
+	}
+	rows := []Row{
+		{"id": 1, "desc": "the quick brown fox"},
+		{"id": 2, "desc": "short"},
+	}
+
+	view := NewWithRows(columns, rows).WrapColumn(1, true).View()
+	want := []string{
+		"> 1│the quick",
+		"   │brown fox",
+		"  2│short    ",
+	}
+	lines := strings.Split(view, "\n")[2:5]
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestTable_HeaderClick_FlexColumns(t *testing.T) {
+	columns := []Column{
+		{Key: "id", Title: "ID", Width: 4, Sortable: true},
+		{Key: "name", Title: "Name", Width: 4, Sortable: true},
+	}
+	table := NewWithRows(columns, nil).ColumnFlex(0, 1).Width(21)
+
+	// The flex id column is 16 wide, so x=12 is still inside it.
+	table, _ = table.Update(tea.MouseMsg{X: 12, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if table.domain.SortColumn() != "id" {
+		t.Errorf("SortColumn() = %q, want id", table.domain.SortColumn())
+	}
+}
+
+func createWideTable() *Table {
+	columns := []Column{
+		{Key: "id", Title: "ID", Width: 3},
+		{Key: "a", Title: "A", Width: 6},
+		{Key: "b", Title: "B", Width: 6},
+		{Key: "c", Title: "C", Width: 6},
+	}
+	rows := []Row{{"id": 1, "a": "aaaaaa", "b": "bbbbbb", "c": "cccccc"}}
+	return NewWithRows(columns, rows).Width(12)
+}
+
+func TestTable_FreezeColumns(t *testing.T) {
+	table := createWideTable().FreezeColumns(1)
+
+	lines := strings.Split(table.View(), "\n")
+	if lines[0] != "ID ║A     │B" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if lines[1] != "───╫──────┼─" {
+		t.Errorf("header separator = %q", lines[1])
+	}
+	if lines[2] != ">  ║aaaaaa│b" {
+		t.Errorf("row = %q", lines[2])
+	}
+
+	// Scroll past the first scrolling column; the frozen column stays.
+	table = table.SetHorizontalOffset(7)
+	lines = strings.Split(table.View(), "\n")
+	if lines[2] != ">  ║bbbbbb│c" {
+		t.Errorf("scrolled row = %q", lines[2])
+	}
+
+	// The offset is clamped to the scrollable width: 20 cells in an 8-cell window.
+	table = table.SetHorizontalOffset(100)
+	if table.HorizontalOffset() != 12 {
+		t.Errorf("HorizontalOffset() = %d, want 12", table.HorizontalOffset())
+	}
+	lines = strings.Split(table.View(), "\n")
+	if lines[2] != ">  ║b│cccccc" {
+		t.Errorf("fully scrolled row = %q", lines[2])
+	}
+}
+
+func TestTable_HorizontalScroll_WideGlyphBoundary(t *testing.T) {
+	columns := []Column{
+		{Key: "id", Title: "ID", Width: 2},
+		{Key: "name", Title: "Name", Width: 8},
+	}
+	rows := []Row{{"id": 1, "name": "日本語"}}
+	table := NewWithRows(columns, rows).Width(8).FreezeColumns(1).SetHorizontalOffset(1)
+
+	row := strings.Split(table.View(), "\n")[2]
+	// "日" is cut in half by the scroll offset and becomes a blank.
+	if row != "> ║ 本語" {
+		t.Errorf("row = %q, want wide glyph cut cleanly", row)
+	}
+	if got := core.StringWidth(row); got != 8 {
+		t.Errorf("row width = %d, want 8", got)
+	}
+}
+
+func TestTable_HorizontalScroll_FollowsFocus(t *testing.T) {
+	table := createWideTable().FreezeColumns(1)
+	right := tea.KeyMsg{Type: tea.KeyRight}
+
+	table, _ = table.Update(right) // A: already visible
+	if table.HorizontalOffset() != 0 {
+		t.Errorf("after focusing A, HorizontalOffset() = %d, want 0", table.HorizontalOffset())
+	}
+	table, _ = table.Update(right) // B: ends at cell 13 of an 8-cell window
+	if table.HorizontalOffset() != 5 {
+		t.Errorf("after focusing B, HorizontalOffset() = %d, want 5", table.HorizontalOffset())
+	}
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyLeft}) // back to A
+	if table.HorizontalOffset() != 0 {
+		t.Errorf("after focusing A again, HorizontalOffset() = %d, want 0", table.HorizontalOffset())
+	}
+}
+
+func TestTable_HeaderClick_ScrolledColumns(t *testing.T) {
+	table := createWideTable().Sortable(true).FreezeColumns(1).SetHorizontalOffset(7)
+
+	// x=5 is inside "B" once the table has scrolled by 7 cells.
+	table, _ = table.Update(tea.MouseMsg{X: 5, Y: 0, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	if table.domain.SortColumn() != "b" {
+		t.Errorf("SortColumn() = %q, want b", table.domain.SortColumn())
+	}
+}
+
+func TestTable_StyleCell(t *testing.T) {
+	red := style.New().Foreground(style.RGB(255, 0, 0))
+	table := createTestTable().StyleCell(1, 1, red)
+
+	bob := style.Render(red, "Bob            ")
+	lines := strings.Split(table.View(), "\n")
+	if lines[3] != "    2│"+bob+"│   25" {
+		t.Errorf("styled row = %q", lines[3])
+	}
+	if strings.Contains(lines[2], "\x1b[") || strings.Contains(lines[4], "\x1b[") {
+		t.Error("only the styled cell should contain escape codes")
+	}
+
+	// The style follows its row when the table is sorted (Bob sorts first by age).
+	sorted := table.SortByColumn("age")
+	lines = strings.Split(sorted.View(), "\n")
+	if lines[2] != "    2│"+bob+"│   25" {
+		t.Errorf("sorted row = %q", lines[2])
+	}
+
+	// The header is styled for the focused column; the rows must be plain.
+	rows := func(table *Table) string {
+		return strings.Join(strings.Split(table.View(), "\n")[2:], "\n")
+	}
+	if strings.Contains(rows(table.ClearCellStyles()), "\x1b[") {
+		t.Error("ClearCellStyles should remove cell styles")
+	}
+	if strings.Contains(rows(createTestTable()), "\x1b[") {
+		t.Error("StyleCell modified the original table")
+	}
+}
+
+func TestTable_StyleRowIf(t *testing.T) {
+	old := style.New().Bold(true)
+	red := style.New().Foreground(style.RGB(255, 0, 0))
+	table := createTestTable().
+		StyleRowIf(func(row []string) *style.Style {
+			if age, _ := strconv.Atoi(row[2]); age > 30 {
+				return &old
+			}
+			return nil
+		}).
+		StyleCell(2, 2, red)
+
+	lines := strings.Split(table.View(), "\n")
+	if strings.Contains(lines[2], "\x1b[") {
+		t.Errorf("unmatched row should be plain: %q", lines[2])
+	}
+
+	// Separators take the row style; the cell style wins over it.
+	want := style.Render(old, "    3") + style.Render(old, "│") + style.Render(old, "Charlie        ") +
+		style.Render(old, "│") + style.Render(red, "   35")
+	if lines[4] != want {
+		t.Errorf("matched row = %q, want %q", lines[4], want)
+	}
+}
+
+func TestTable_ZebraStripe(t *testing.T) {
+	even := style.New().Background(style.RGB(40, 40, 40))
+	odd := style.New().Background(style.RGB(20, 20, 20))
+	table := createTestTable().ShowHeader(false).ZebraStripe(even, odd)
+
+	lines := strings.Split(table.View(), "\n")
+	firstCells := []string{">   1", "    2", "    3"}
+	for i, s := range []style.Style{even, odd, even} {
+		if !strings.HasPrefix(lines[i], style.Render(s, firstCells[i])+style.Render(s, "│")) {
+			t.Errorf("row %d should be striped with style %d: %q", i, i%2, lines[i])
+		}
+	}
+
+	// Styling never changes the layout.
+	plain := strings.Split(createTestTable().ShowHeader(false).View(), "\n")
+	for i := range plain {
+		if style.Width(lines[i]) != core.StringWidth(plain[i]) {
+			t.Errorf("row %d width = %d, want %d", i, style.Width(lines[i]), core.StringWidth(plain[i]))
+		}
+	}
+}
@@ -2,6 +2,9 @@
 package model
 
 import (
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/phoenix-tui/phoenix/components/viewport/internal/domain/service"
@@ -14,11 +17,25 @@ import (
 // All operations are immutable - they return new Viewport instances.
 type Viewport struct {
 	content      []string
+	contentFill  *int // Length the shared content array is filled to (see AppendContent)
 	size         *value2.ViewportSize
 	scrollOffset *value2.ScrollOffset
 	followMode   bool
 	wrapLines    bool
 	scrollSvc    *service.ScrollService
+
+	// Line number gutter.
+	lineNumbers     bool
+	lineNumberStart int                        // Number of the first content line
+	gutterFunc      func(gutter string) string // Decorates gutter numbers (nil = plain)
+
+	// Search state.
+	searchQuery   string
+	caseSensitive bool
+	matches       []value2.Match
+	currentMatch  int                                     // Index into matches, -1 if none
+	highlightFunc func(match string, current bool) string // Decorates matches (nil = no highlight)
+	searchSvc     *service.SearchService
 }
 
 // NewViewport creates a new Viewport with the given dimensions.
@@ -30,6 +47,10 @@ func NewViewport(width, height int) *Viewport {
 		followMode:   false,
 		wrapLines:    false,
 		scrollSvc:    service.NewScrollService(),
+		currentMatch: -1,
+		searchSvc:    service.NewSearchService(),
+
+		lineNumberStart: 1,
 	}
 }
 
@@ -46,6 +67,8 @@ func (v *Viewport) WithContent(content []string) *Viewport {
 	newV := v.clone()
 	newV.content = make([]string, len(content))
 	copy(newV.content, content)
+	newV.contentFill = nil
+	newV.refreshMatches()
 
 	// If follow mode is enabled, scroll to bottom.
 	if newV.followMode {
@@ -60,11 +83,51 @@ func (v *Viewport) WithContent(content []string) *Viewport {
 	return newV
 }
 
+// AppendContent returns a new Viewport with lines added after the existing
+// content. Only the new lines are searched, and the content array is
+// extended in place when no other Viewport sharing it has appended since,
+// so streaming lines one at a time stays cheap for long content.
+// If follow mode is enabled, the viewport scrolls to the bottom.
+func (v *Viewport) AppendContent(lines []string) *Viewport {
+	newV := v.clone()
+	owned := v.contentFill != nil && *v.contentFill == len(v.content)
+	if owned && cap(v.content)-len(v.content) >= len(lines) {
+		newV.content = append(v.content, lines...)
+	} else {
+		// Appending to a full slice always allocates a fresh array.
+		newV.content = append(v.content[:len(v.content):len(v.content)], lines...)
+		newV.contentFill = new(int)
+	}
+	*newV.contentFill = len(newV.content)
+
+	if newV.searchQuery != "" {
+		found := newV.searchSvc.FindAllFrom(newV.content, len(v.content), newV.searchQuery, newV.caseSensitive)
+		if len(found) > 0 {
+			newV.matches = append(v.matches[:len(v.matches):len(v.matches)], found...)
+			newV.currentMatch = max(newV.currentMatch, 0)
+		}
+	}
+
+	if newV.followMode {
+		offset := newV.scrollSvc.FollowModeOffset(len(newV.content), newV.size.Height())
+		newV.scrollOffset = value2.NewScrollOffset(offset)
+	}
+
+	return newV
+}
+
 // WithSize returns a new Viewport with the given dimensions.
+// In follow mode the viewport stays scrolled to the bottom.
 func (v *Viewport) WithSize(width, height int) *Viewport {
 	newV := v.clone()
 	newV.size = value2.NewViewportSize(width, height)
 
+	if newV.followMode {
+		offset := newV.scrollSvc.FollowModeOffset(len(newV.content), height)
+		newV.scrollOffset = value2.NewScrollOffset(offset)
+		return newV
+	}
+
 	// Clamp scroll offset to new size bounds.
 	maxOffset := newV.scrollSvc.MaxScrollOffset(len(newV.content), height)
 	newV.scrollOffset = newV.scrollOffset.Clamp(maxOffset)
@@ -94,6 +157,136 @@ func (v *Viewport) WithWrapLines(enabled bool) *Viewport {
 	return newV
 }
 
+// WithSearch returns a new Viewport searching for query. The first match at
+// or below the top of the view becomes the current match and is scrolled
+// into view. An empty query clears the search.
+func (v *Viewport) WithSearch(query string) *Viewport {
+	newV := v.clone()
+	newV.searchQuery = query
+	newV.matches = newV.searchSvc.FindAll(newV.content, query, newV.caseSensitive)
+	newV.currentMatch = -1
+	if len(newV.matches) == 0 {
+		return newV
+	}
+
+	newV.currentMatch = 0
+	top := newV.scrollOffset.Offset()
+	for i, m := range newV.matches {
+		if m.Line() >= top {
+			newV.currentMatch = i
+			break
+		}
+	}
+	return newV.scrollToMatch()
+}
+
+// WithCaseSensitive returns a new Viewport with case-sensitive search
+// enabled/disabled. An active search is re-run.
+func (v *Viewport) WithCaseSensitive(enabled bool) *Viewport {
+	newV := v.clone()
+	newV.caseSensitive = enabled
+	if newV.searchQuery == "" {
+		return newV
+	}
+	return newV.WithSearch(newV.searchQuery)
+}
+
+// WithMatchHighlighter returns a new Viewport that passes every visible
+// search match through highlight; current is true for the current match.
+func (v *Viewport) WithMatchHighlighter(highlight func(match string, current bool) string) *Viewport {
+	newV := v.clone()
+	newV.highlightFunc = highlight
+	return newV
+}
+
+// NextMatch returns a new Viewport with the next match (wrapping around)
+// as the current match, scrolled into view.
+func (v *Viewport) NextMatch() *Viewport {
+	if len(v.matches) == 0 {
+		return v
+	}
+	newV := v.clone()
+	newV.currentMatch = (v.currentMatch + 1) % len(v.matches)
+	return newV.scrollToMatch()
+}
+
+// PrevMatch returns a new Viewport with the previous match (wrapping
+// around) as the current match, scrolled into view.
+func (v *Viewport) PrevMatch() *Viewport {
+	if len(v.matches) == 0 {
+		return v
+	}
+	newV := v.clone()
+	newV.currentMatch = (v.currentMatch - 1 + len(v.matches)) % len(v.matches)
+	return newV.scrollToMatch()
+}
+
+// SearchQuery returns the active search query ("" if none).
+func (v *Viewport) SearchQuery() string {
+	return v.searchQuery
+}
+
+// CaseSensitive returns true if search is case-sensitive.
+func (v *Viewport) CaseSensitive() bool {
+	return v.caseSensitive
+}
+
+// MatchCount returns the number of matches of the active search.
+func (v *Viewport) MatchCount() int {
+	return len(v.matches)
+}
+
+// CurrentMatch returns the index of the current match, or -1 if none.
+func (v *Viewport) CurrentMatch() int {
+	return v.currentMatch
+}
+
+// WithLineNumbers returns a new Viewport with the line number gutter
+// shown/hidden. The gutter is as wide as the largest line number plus one
+// space, and the content area shrinks accordingly.
+func (v *Viewport) WithLineNumbers(enabled bool) *Viewport {
+	newV := v.clone()
+	newV.lineNumbers = enabled
+	return newV
+}
+
+// WithLineNumberStart returns a new Viewport numbering the first content
+// line n (e.g. 0 or 1).
+func (v *Viewport) WithLineNumberStart(n int) *Viewport {
+	newV := v.clone()
+	newV.lineNumberStart = n
+	return newV
+}
+
+// WithGutterRenderer returns a new Viewport that passes each padded gutter
+// number through render (e.g. to color it).
+func (v *Viewport) WithGutterRenderer(render func(gutter string) string) *Viewport {
+	newV := v.clone()
+	newV.gutterFunc = render
+	return newV
+}
+
+// LineNumbers returns true if the line number gutter is shown.
+func (v *Viewport) LineNumbers() bool {
+	return v.lineNumbers
+}
+
+// LineNumberStart returns the number of the first content line.
+func (v *Viewport) LineNumberStart() int {
+	return v.lineNumberStart
+}
+
+// GutterWidth returns the width of the line number gutter including its
+// separator space, or 0 if the gutter is hidden.
+func (v *Viewport) GutterWidth() int {
+	if !v.lineNumbers {
+		return 0
+	}
+	first := strconv.Itoa(v.lineNumberStart)
+	last := strconv.Itoa(v.lineNumberStart + max(len(v.content)-1, 0))
+	return max(len(first), len(last)) + 1
+}
+
 // ScrollUp returns a new Viewport scrolled up by the given number of lines.
 // If follow mode is enabled, it is automatically disabled.
 func (v *Viewport) ScrollUp(lines int) *Viewport {
@@ -157,6 +350,32 @@ func (v *Viewport) WithScrollOffset(offset int) *Viewport {
 	return newV
 }
 
+// WithScrollPercent returns a new Viewport scrolled to the given fraction of
+// its scroll range: 0 is the top, 1 the bottom. p is clamped to [0, 1] and
+// the offset rounded to the nearest line. Scrolling to 1 behaves like
+// ScrollToBottom and enables follow mode; anything else disables it.
+func (v *Viewport) WithScrollPercent(p float64) *Viewport {
+	if p >= 1 {
+		return v.ScrollToBottom()
+	}
+	if math.IsNaN(p) {
+		p = 0
+	}
+	maxOffset := v.scrollSvc.MaxScrollOffset(len(v.content), v.size.Height())
+	return v.WithScrollOffset(int(math.Round(max(p, 0) * float64(maxOffset))))
+}
+
+// ScrollPercent returns how far the viewport is scrolled as a fraction of its
+// scroll range, from 0 (top) to 1 (bottom). Content that fits entirely in the
+// viewport is fully seen, so it reports 1.
+func (v *Viewport) ScrollPercent() float64 {
+	maxOffset := v.scrollSvc.MaxScrollOffset(len(v.content), v.size.Height())
+	if maxOffset == 0 {
+		return 1
+	}
+	return min(float64(v.scrollOffset.Offset())/float64(maxOffset), 1)
+}
+
 // PageUp returns a new Viewport scrolled up by the viewport height.
 func (v *Viewport) PageUp() *Viewport {
 	return v.ScrollUp(v.size.Height())
@@ -168,15 +387,17 @@ func (v *Viewport) PageDown() *Viewport {
 }
 
 // VisibleLines returns the currently visible lines in the viewport.
-// Lines are truncated or wrapped based on the wrapLines setting.
+// Lines are truncated or wrapped based on the wrapLines setting, and search
+// matches are decorated when a match highlighter is set.
 func (v *Viewport) VisibleLines() []string {
 	visible := v.scrollSvc.VisibleLines(v.content, v.scrollOffset.Offset(), v.size.Height())
+	first := min(v.scrollOffset.Offset(), max(len(v.content)-1, 0))
 
 	if v.wrapLines {
-		return v.wrapVisibleLines(visible)
+		return v.wrapVisibleLines(visible, first)
 	}
 
-	return v.truncateVisibleLines(visible)
+	return v.truncateVisibleLines(visible, first)
 }
 
 // ScrollOffset returns the current scroll offset.
@@ -237,23 +458,109 @@ func (v *Viewport) Content() []string {
 func (v *Viewport) clone() *Viewport {
 	return &Viewport{
 		content:      v.content,
+		contentFill:  v.contentFill,
 		size:         v.size,
 		scrollOffset: v.scrollOffset,
 		followMode:   v.followMode,
 		wrapLines:    v.wrapLines,
 		scrollSvc:    v.scrollSvc,
+
+		searchQuery:   v.searchQuery,
+		caseSensitive: v.caseSensitive,
+		matches:       v.matches,
+		currentMatch:  v.currentMatch,
+		highlightFunc: v.highlightFunc,
+		searchSvc:     v.searchSvc,
+
+		lineNumbers:     v.lineNumbers,
+		lineNumberStart: v.lineNumberStart,
+		gutterFunc:      v.gutterFunc,
+	}
+}
+
+// contentWidth returns the width available for content next to the gutter.
+func (v *Viewport) contentWidth() int {
+	return v.size.Width() - v.GutterWidth()
+}
+
+// gutter returns the gutter for content line lineIdx; continuation lines of
+// a wrapped line (lineIdx < 0) get a blank gutter. Returns "" when hidden.
+func (v *Viewport) gutter(lineIdx int) string {
+	width := v.GutterWidth()
+	if width == 0 {
+		return ""
+	}
+	if lineIdx < 0 {
+		return strings.Repeat(" ", width)
+	}
+	number := strconv.Itoa(v.lineNumberStart + lineIdx)
+	number = strings.Repeat(" ", width-1-len(number)) + number
+	if v.gutterFunc != nil {
+		number = v.gutterFunc(number)
+	}
+	return number + " "
+}
+
+// refreshMatches re-runs the active search after a content change, keeping
+// the current match index in range. The view is not scrolled.
+func (v *Viewport) refreshMatches() {
+	if v.searchQuery == "" {
+		return
+	}
+	v.matches = v.searchSvc.FindAll(v.content, v.searchQuery, v.caseSensitive)
+	v.currentMatch = min(max(v.currentMatch, 0), len(v.matches)-1)
+}
+
+// scrollToMatch scrolls the least amount needed to show the current match.
+func (v *Viewport) scrollToMatch() *Viewport {
+	line := v.matches[v.currentMatch].Line()
+	offset := v.scrollOffset.Offset()
+	if line < offset {
+		offset = line
+	} else if line >= offset+v.size.Height() {
+		offset = line - v.size.Height() + 1
 	}
+	return v.WithScrollOffset(offset)
+}
+
+// highlightMatches decorates the matches of content line lineIdx that fall
+// within segment, where base is the byte offset of segment in the line.
+func (v *Viewport) highlightMatches(segment string, lineIdx, base int) string {
+	if v.highlightFunc == nil || len(v.matches) == 0 {
+		return segment
+	}
+
+	i := sort.Search(len(v.matches), func(i int) bool { return v.matches[i].Line() >= lineIdx })
+	var b strings.Builder
+	pos := 0 // Bytes of segment written so far
+	for ; i < len(v.matches) && v.matches[i].Line() == lineIdx; i++ {
+		start := max(v.matches[i].Start()-base, pos)
+		end := min(v.matches[i].End()-base, len(segment))
+		if start >= end {
+			continue
+		}
+		b.WriteString(segment[pos:start])
+		b.WriteString(v.highlightFunc(segment[start:end], i == v.currentMatch))
+		pos = end
+	}
+	if pos == 0 {
+		return segment
+	}
+	b.WriteString(segment[pos:])
+	return b.String()
 }
 
 // truncateVisibleLines truncates lines that exceed the viewport width.
-func (v *Viewport) truncateVisibleLines(lines []string) []string {
-	if v.size.Width() <= 0 {
+// first is the content index of lines[0].
+func (v *Viewport) truncateVisibleLines(lines []string, first int) []string {
+	if v.contentWidth() <= 0 {
 		return []string{}
 	}
 
 	result := make([]string, len(lines))
 	for i, line := range lines {
-		result[i] = v.truncateLine(line, v.size.Width())
+		text := v.highlightMatches(v.truncateLine(line, v.contentWidth()), first+i, 0)
+		result[i] = v.gutter(first+i) + text
 	}
 	return result
 }
@@ -286,15 +593,21 @@ func (v *Viewport) truncateLine(line string, width int) string {
 
 // wrapVisibleLines wraps lines that exceed the viewport width.
 // This is a simple implementation - each line that's too wide is split.
-func (v *Viewport) wrapVisibleLines(lines []string) []string {
-	if v.size.Width() <= 0 {
+// first is the content index of lines[0].
+func (v *Viewport) wrapVisibleLines(lines []string, first int) []string {
+	if v.contentWidth() <= 0 {
 		return []string{}
 	}
 
 	var result []string
-	for _, line := range lines {
-		wrapped := v.wrapLine(line, v.size.Width())
-		result = append(result, wrapped...)
+	for i, line := range lines {
+		base := 0
+		gutter := v.gutter(first + i)
+		for _, segment := range v.wrapLine(line, v.contentWidth()) {
+			result = append(result, gutter+v.highlightMatches(segment, first+i, base))
+			base += len(segment)
+			gutter = v.gutter(-1)
+		}
 	}
 	return result
 }
@@ -593,6 +593,28 @@ func TestViewport_WithScrollOffset(t *testing.T) {
 	}
 }
 
+func TestViewport_WithScrollPercent(t *testing.T) {
+	content := make([]string, 100)
+	for i := range content {
+		content[i] = "Line"
+	}
+
+	v := NewViewport(80, 20).WithContent(content)
+
+	half := v.WithScrollPercent(0.5)
+	if half.ScrollOffset() != 40 || half.ScrollPercent() != 0.5 {
+		t.Errorf("WithScrollPercent(0.5): offset %d, percent %v, want 40, 0.5", half.ScrollOffset(), half.ScrollPercent())
+	}
+	if half.FollowMode() {
+		t.Error("WithScrollPercent(0.5) should disable follow mode")
+	}
+
+	bottom := half.WithScrollPercent(1)
+	if !bottom.IsAtBottom() || !bottom.FollowMode() {
+		t.Error("WithScrollPercent(1) should scroll to the bottom and enable follow mode")
+	}
+}
+
 func TestViewport_WithScrollOffset_ClampsToZero(t *testing.T) {
 	content := make([]string, 100)
 	for i := range content {
@@ -646,3 +668,213 @@ func TestViewport_Content(t *testing.T) {
 		t.Error("Viewport content was mutated through Content() return value")
 	}
 }
+
+func searchTestContent() []string {
+	content := make([]string, 20)
+	for i := range content {
+		content[i] = "line"
+	}
+	content[2] = "first ERROR here"
+	content[10] = "error and error"
+	content[18] = "last Error"
+	return content
+}
+
+func TestViewport_WithSearch(t *testing.T) {
+	v := NewViewport(40, 5).WithContent(searchTestContent()).WithSearch("error")
+
+	if v.MatchCount() != 4 {
+		t.Fatalf("MatchCount() = %d, want 4", v.MatchCount())
+	}
+	if v.CurrentMatch() != 0 || v.ScrollOffset() != 0 {
+		t.Errorf("CurrentMatch() = %d, offset %d, want 0, 0", v.CurrentMatch(), v.ScrollOffset())
+	}
+
+	// Next scrolls just enough to reveal line 10.
+	v = v.NextMatch()
+	if v.CurrentMatch() != 1 || v.ScrollOffset() != 6 {
+		t.Errorf("NextMatch(): current %d, offset %d, want 1, 6", v.CurrentMatch(), v.ScrollOffset())
+	}
+	v = v.NextMatch().NextMatch()
+	if v.CurrentMatch() != 3 || v.ScrollOffset() != 14 {
+		t.Errorf("NextMatch() x3: current %d, offset %d, want 3, 14", v.CurrentMatch(), v.ScrollOffset())
+	}
+
+	// Wraps around in both directions.
+	if v.NextMatch().CurrentMatch() != 0 {
+		t.Error("NextMatch() should wrap to the first match")
+	}
+	if v.NextMatch().PrevMatch().CurrentMatch() != 3 {
+		t.Error("PrevMatch() should wrap to the last match")
+	}
+}
+
+func TestViewport_WithSearch_StartsAtView(t *testing.T) {
+	v := NewViewport(40, 5).WithContent(searchTestContent()).WithScrollOffset(8).WithSearch("error")
+	if v.CurrentMatch() != 1 {
+		t.Errorf("CurrentMatch() = %d, want first match below the top (1)", v.CurrentMatch())
+	}
+}
+
+func TestViewport_WithCaseSensitive(t *testing.T) {
+	v := NewViewport(40, 5).WithContent(searchTestContent()).WithSearch("error").WithCaseSensitive(true)
+	if v.MatchCount() != 2 || !v.CaseSensitive() {
+		t.Errorf("case-sensitive MatchCount() = %d, want 2", v.MatchCount())
+	}
+	if v.WithSearch("").MatchCount() != 0 || v.WithSearch("").CurrentMatch() != -1 {
+		t.Error("empty query should clear the search")
+	}
+}
+
+func TestViewport_Search_Highlight(t *testing.T) {
+	mark := func(match string, current bool) string {
+		if current {
+			return "[" + match + "]"
+		}
+		return "<" + match + ">"
+	}
+	v := NewViewport(7, 3).WithContent([]string{"error and error"}).
+		WithSearch("error").WithMatchHighlighter(mark)
+
+	if got := v.VisibleLines(); !reflect.DeepEqual(got, []string{"[error] a"}) {
+		t.Errorf("truncated VisibleLines() = %q", got)
+	}
+
+	// Matches split by wrapping are highlighted piecewise.
+	wrapped := v.WithWrapLines(true).NextMatch()
+	want := []string{"<error> a", "nd [erro]", "[r]"}
+	if got := wrapped.VisibleLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("wrapped VisibleLines() = %q, want %q", got, want)
+	}
+
+	// Highlights follow content updates.
+	updated := v.WithContent([]string{"no hits", "ERROR"})
+	if updated.MatchCount() != 1 || updated.CurrentMatch() != 0 {
+		t.Errorf("after WithContent: count %d, current %d", updated.MatchCount(), updated.CurrentMatch())
+	}
+	if got := updated.VisibleLines(); got[1] != "[ERROR]" {
+		t.Errorf("VisibleLines() after update = %q", got)
+	}
+}
+
+func TestViewport_WithLineNumbers(t *testing.T) {
+	content := make([]string, 12)
+	for i := range content {
+		content[i] = "abcdefgh"
+	}
+	v := NewViewport(8, 3).WithContent(content).WithLineNumbers(true)
+
+	if v.GutterWidth() != 3 {
+		t.Errorf("GutterWidth() = %d, want 3 (two digits + space)", v.GutterWidth())
+	}
+	want := []string{" 1 abcde", " 2 abcde", " 3 abcde"}
+	if got := v.VisibleLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("VisibleLines() = %q, want %q", got, want)
+	}
+
+	// Numbers follow the scroll offset.
+	want = []string{"10 abcde", "11 abcde", "12 abcde"}
+	if got := v.ScrollToBottom().VisibleLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("scrolled VisibleLines() = %q, want %q", got, want)
+	}
+
+	// 0-based numbering narrows the gutter back to one digit.
+	zero := v.WithContent(content[:10]).WithLineNumberStart(0)
+	if zero.GutterWidth() != 2 || zero.VisibleLines()[0] != "0 abcdef" {
+		t.Errorf("0-based: width %d, first line %q", zero.GutterWidth(), zero.VisibleLines()[0])
+	}
+
+	if v.WithLineNumbers(false).GutterWidth() != 0 {
+		t.Error("hidden gutter should have zero width")
+	}
+}
+
+func TestViewport_WithLineNumbers_Wrapped(t *testing.T) {
+	v := NewViewport(6, 3).WithContent([]string{"abcdefgh", "x"}).
+		WithLineNumbers(true).
+		WithWrapLines(true).
+		WithGutterRenderer(func(g string) string { return "#" + g })
+
+	want := []string{"#1 abcd", "  efgh", "#2 x"}
+	if got := v.VisibleLines(); !reflect.DeepEqual(got, want) {
+		t.Errorf("VisibleLines() = %q, want %q", got, want)
+	}
+}
+
+func TestViewport_AppendContent(t *testing.T) {
+	v := NewViewport(80, 3).WithContent([]string{"a", "b"})
+
+	v2 := v.AppendContent([]string{"c", "d"})
+	if got := v2.Content(); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Content() = %v", got)
+	}
+	if v2.ScrollOffset() != 0 {
+		t.Errorf("ScrollOffset = %d, want 0 without follow mode", v2.ScrollOffset())
+	}
+	if v.TotalLines() != 2 {
+		t.Error("Original viewport was mutated")
+	}
+}
+
+func TestViewport_AppendContent_SharedHistory(t *testing.T) {
+	// The second append grows the array with spare capacity to append into.
+	base := NewViewport(80, 3).AppendContent([]string{"a", "b", "c"}).AppendContent([]string{"d"})
+
+	// Two viewports appending to the same base must not see each other's lines.
+	left := base.AppendContent([]string{"left"})
+	right := base.AppendContent([]string{"right"})
+	left = left.AppendContent([]string{"more"})
+
+	if got := left.Content(); !reflect.DeepEqual(got, []string{"a", "b", "c", "d", "left", "more"}) {
+		t.Errorf("left Content() = %v", got)
+	}
+	if got := right.Content(); !reflect.DeepEqual(got, []string{"a", "b", "c", "d", "right"}) {
+		t.Errorf("right Content() = %v", got)
+	}
+	if got := base.Content(); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("base Content() = %v", got)
+	}
+}
+
+func TestViewport_AppendContent_FollowMode(t *testing.T) {
+	v := NewViewport(80, 3).WithFollowMode(true).AppendContent(make([]string, 10))
+	if v.ScrollOffset() != 7 {
+		t.Errorf("ScrollOffset = %d, want 7", v.ScrollOffset())
+	}
+
+	// Scrolling up pauses following; appends leave the view alone.
+	paused := v.ScrollUp(2).AppendContent(make([]string, 5))
+	if paused.FollowMode() || paused.ScrollOffset() != 5 {
+		t.Errorf("paused: FollowMode = %v, ScrollOffset = %d, want false, 5", paused.FollowMode(), paused.ScrollOffset())
+	}
+
+	// Scrolling back to the bottom resumes it.
+	resumed := paused.ScrollDown(100).AppendContent(make([]string, 5))
+	if !resumed.FollowMode() || resumed.ScrollOffset() != 17 {
+		t.Errorf("resumed: FollowMode = %v, ScrollOffset = %d, want true, 17", resumed.FollowMode(), resumed.ScrollOffset())
+	}
+}
+
+func TestViewport_AppendContent_Search(t *testing.T) {
+	v := NewViewport(80, 3).WithContent([]string{"info"}).WithSearch("error")
+	if v.MatchCount() != 0 || v.CurrentMatch() != -1 {
+		t.Fatalf("MatchCount = %d, CurrentMatch = %d", v.MatchCount(), v.CurrentMatch())
+	}
+
+	v = v.AppendContent([]string{"error 1", "info", "error 2"})
+	if v.MatchCount() != 2 || v.CurrentMatch() != 0 {
+		t.Errorf("MatchCount = %d, CurrentMatch = %d, want 2, 0", v.MatchCount(), v.CurrentMatch())
+	}
+	if !reflect.DeepEqual(v.matches, v.searchSvc.FindAll(v.content, "error", false)) {
+		t.Errorf("incremental matches %v differ from a full search", v.matches)
+	}
+}
+
+func TestViewport_WithSize_FollowMode(t *testing.T) {
+	v := NewViewport(80, 10).WithContent(make([]string, 20)).WithFollowMode(true)
+
+	v = v.WithSize(80, 5)
+	if !v.IsAtBottom() {
+		t.Errorf("ScrollOffset = %d, shrinking in follow mode should stay at the bottom", v.ScrollOffset())
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	value2 "github.com/phoenix-tui/phoenix/components/viewport/internal/domain/value"
+)
+
+// SearchService finds query matches in viewport content.
+type SearchService struct{}
+
+// NewSearchService creates a new SearchService instance.
+func NewSearchService() *SearchService {
+	return &SearchService{}
+}
+
+// FindAll returns every non-overlapping occurrence of query in content, in
+// reading order. Without caseSensitive, runes are compared with Unicode case
+// folding, so match offsets always refer to the original line.
+// Returns nil for an empty query.
+func (s *SearchService) FindAll(content []string, query string, caseSensitive bool) []value2.Match {
+	return s.FindAllFrom(content, 0, query, caseSensitive)
+}
+
+// FindAllFrom is like FindAll but only searches the lines from index first
+// on, so that content which only grew can be searched incrementally.
+func (s *SearchService) FindAllFrom(content []string, first int, query string, caseSensitive bool) []value2.Match {
+	if query == "" {
+		return nil
+	}
+
+	var matches []value2.Match
+	for lineIdx := max(first, 0); lineIdx < len(content); lineIdx++ {
+		line := content[lineIdx]
+		for pos := 0; pos < len(line); {
+			n, ok := matchAt(line[pos:], query, caseSensitive)
+			if !ok {
+				_, size := utf8.DecodeRuneInString(line[pos:])
+				pos += size
+				continue
+			}
+			matches = append(matches, value2.NewMatch(lineIdx, pos, pos+n))
+			pos += n
+		}
+	}
+	return matches
+}
+
+// matchAt reports whether text starts with query and returns the length in
+// bytes of the matching prefix of text.
+func matchAt(text, query string, caseSensitive bool) (int, bool) {
+	if caseSensitive {
+		return len(query), strings.HasPrefix(text, query)
+	}
+
+	i := 0
+	for _, qr := range query {
+		if i >= len(text) {
+			return 0, false
+		}
+		tr, size := utf8.DecodeRuneInString(text[i:])
+		if !strings.EqualFold(string(tr), string(qr)) {
+			return 0, false
+		}
+		i += size
+	}
+	return i, true
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	value2 "github.com/phoenix-tui/phoenix/components/viewport/internal/domain/value"
+)
+
+func TestSearchService_FindAll(t *testing.T) {
+	content := []string{"Error: disk", "no match", "error ERROR", "Größe GRÖSSE"}
+	s := NewSearchService()
+
+	tests := []struct {
+		name          string
+		query         string
+		caseSensitive bool
+		want          []value2.Match
+	}{
+		{"empty query", "", false, nil},
+		{
+			"case-insensitive", "error", false,
+			[]value2.Match{value2.NewMatch(0, 0, 5), value2.NewMatch(2, 0, 5), value2.NewMatch(2, 6, 11)},
+		},
+		{"case-sensitive", "error", true, []value2.Match{value2.NewMatch(2, 0, 5)}},
+		// Offsets are bytes of the original line: "ö" is 2 bytes.
+		{"unicode folding", "GRÖ", false, []value2.Match{value2.NewMatch(3, 0, 4), value2.NewMatch(3, 8, 12)}},
+		{"no matches", "xyz", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.FindAll(content, tt.query, tt.caseSensitive)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchService_FindAll_NonOverlapping(t *testing.T) {
+	got := NewSearchService().FindAll([]string{"aaaa"}, "aa", false)
+	want := []value2.Match{value2.NewMatch(0, 0, 2), value2.NewMatch(0, 2, 4)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchService_FindAllFrom(t *testing.T) {
+	content := []string{"error", "ok", "error error"}
+	s := NewSearchService()
+
+	got := s.FindAllFrom(content, 1, "error", false)
+	want := []value2.Match{value2.NewMatch(2, 0, 5), value2.NewMatch(2, 6, 11)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAllFrom(1) = %v, want %v", got, want)
+	}
+	if got := s.FindAllFrom(content, 3, "error", false); got != nil {
+		t.Errorf("FindAllFrom(past end) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,29 @@
+package value
+
+// Match is one occurrence of a search query in the viewport content.
+// Start and End are byte offsets into the content line (End exclusive).
+type Match struct {
+	line  int
+	start int
+	end   int
+}
+
+// NewMatch creates a Match on content line `line` spanning [start, end).
+func NewMatch(line, start, end int) Match {
+	return Match{line: line, start: start, end: end}
+}
+
+// Line returns the index of the content line containing the match.
+func (m Match) Line() int {
+	return m.line
+}
+
+// Start returns the byte offset where the match begins.
+func (m Match) Start() int {
+	return m.start
+}
+
+// End returns the byte offset just past the match.
+func (m Match) End() int {
+	return m.end
+}
@@ -0,0 +1,53 @@
+package value
+
+// ScrollAnimation eases the scroll offset from one line to another over a
+// fixed number of frames (ease-out cubic: fast start, gentle stop).
+// It is immutable; Next returns the animation advanced by one frame.
+type ScrollAnimation struct {
+	from   int
+	to     int
+	frame  int
+	frames int
+}
+
+// NewScrollAnimation creates an animation from offset `from` to offset `to`
+// lasting the given number of frames (at least 1).
+func NewScrollAnimation(from, to, frames int) ScrollAnimation {
+	if frames < 1 {
+		frames = 1
+	}
+	return ScrollAnimation{from: from, to: to, frames: frames}
+}
+
+// Target returns the offset the animation ends at.
+func (a ScrollAnimation) Target() int {
+	return a.to
+}
+
+// Offset returns the offset for the current frame.
+func (a ScrollAnimation) Offset() int {
+	if a.frame >= a.frames {
+		return a.to
+	}
+	t := float64(a.frame) / float64(a.frames)
+	eased := 1 - (1-t)*(1-t)*(1-t)
+	delta := float64(a.to-a.from) * eased
+	// Round away from the start so every frame makes progress.
+	if delta < 0 {
+		return a.from + int(delta-0.5)
+	}
+	return a.from + int(delta+0.5)
+}
+
+// Next returns the animation advanced by one frame.
+func (a ScrollAnimation) Next() ScrollAnimation {
+	if a.frame < a.frames {
+		a.frame++
+	}
+	return a
+}
+
+// Done returns true when the animation has reached its target.
+func (a ScrollAnimation) Done() bool {
+	return a.frame >= a.frames
+}
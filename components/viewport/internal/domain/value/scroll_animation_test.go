@@ -0,0 +1,45 @@
+package value
+
+import "testing"
+
+func TestScrollAnimation_EasesToTarget(t *testing.T) {
+	a := NewScrollAnimation(0, 12, 4)
+
+	var offsets []int
+	for !a.Done() {
+		a = a.Next()
+		offsets = append(offsets, a.Offset())
+	}
+
+	if len(offsets) != 4 || offsets[3] != 12 {
+		t.Fatalf("offsets = %v, want 4 frames ending at 12", offsets)
+	}
+	// Ease-out: steps shrink towards the end.
+	first, last := offsets[0], offsets[3]-offsets[2]
+	if first <= last {
+		t.Errorf("offsets = %v, want larger first step than last", offsets)
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			t.Errorf("offsets = %v, want monotonic progress", offsets)
+		}
+	}
+}
+
+func TestScrollAnimation_Upward(t *testing.T) {
+	a := NewScrollAnimation(10, 4, 3)
+	if a.Offset() != 10 || a.Target() != 4 {
+		t.Errorf("start Offset() = %d, Target() = %d", a.Offset(), a.Target())
+	}
+	a = a.Next().Next().Next().Next()
+	if !a.Done() || a.Offset() != 4 {
+		t.Errorf("Offset() = %d after completion, want 4", a.Offset())
+	}
+}
+
+func TestScrollAnimation_MinimumOneFrame(t *testing.T) {
+	a := NewScrollAnimation(0, 5, 0)
+	if a.Done() || a.Next().Offset() != 5 || !a.Next().Done() {
+		t.Error("zero frames should clamp to a single frame")
+	}
+}
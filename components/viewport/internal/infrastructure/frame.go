@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"strconv"
+
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+// eraseLineRight clears from the cursor to the end of the line (ANSI EL).
+const eraseLineRight = "\x1b[K"
+
+// DiffFrame returns the output lines that turn prev, the frame on screen,
+// into frame.
+//
+// Changed lines are followed by an erase-to-end-of-line sequence so a
+// shorter line never leaves characters of the old one behind. Unchanged
+// lines are not rewritten: the cursor is moved past them instead. Rows prev
+// had beyond the end of frame are erased. Writing the result over prev (as
+// the program renderer does, one output line per row) therefore replaces it
+// in place instead of stacking stale rows below it.
+//
+// At most height rows are returned. Without a previous frame (prev == nil)
+// frame is returned as is.
+//
+// Cursor positioning between rows is left to the program renderer so the
+// viewport's View can still be composed with other components.
+func DiffFrame(prev, frame []string, height int) []string {
+	if prev == nil {
+		return frame
+	}
+
+	rows := min(max(len(frame), len(prev)), height)
+	out := make([]string, 0, rows)
+	for i := 0; i < rows; i++ {
+		switch {
+		case i >= len(frame):
+			// Blank out rows left over from a taller previous frame.
+			out = append(out, eraseLineRight)
+		case i < len(prev) && prev[i] == frame[i]:
+			out = append(out, cursorForward(style.Width(frame[i])))
+		default:
+			out = append(out, frame[i]+eraseLineRight)
+		}
+	}
+	return out
+}
+
+// cursorForward moves the cursor n columns right (ANSI CUF), or returns ""
+// for n <= 0 (CUF treats 0 as 1).
+func cursorForward(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return "\x1b[" + strconv.Itoa(n) + "C"
+}
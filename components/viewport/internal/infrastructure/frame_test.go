@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffFrame(t *testing.T) {
+	tests := []struct {
+		name   string
+		prev   []string
+		frame  []string
+		height int
+		want   []string
+	}{
+		{"no previous frame", nil, []string{"a", "b"}, 3, []string{"a", "b"}},
+		{"unchanged lines skipped", []string{"ab", "b"}, []string{"ab", "c"}, 3, []string{"\x1b[2C", "c\x1b[K"}},
+		{"unchanged empty line", []string{""}, []string{""}, 3, []string{""}},
+		{"unchanged wide line", []string{"日本"}, []string{"日本"}, 3, []string{"\x1b[4C"}},
+		{"shorter frame blanks stale rows", []string{"a", "b", "c"}, []string{"a"}, 3, []string{"\x1b[1C", "\x1b[K", "\x1b[K"}},
+		{"taller frame", []string{"a"}, []string{"a", "b", "c"}, 3, []string{"\x1b[1C", "b\x1b[K", "c\x1b[K"}},
+		{"empty frame", []string{"a", "b"}, []string{}, 3, []string{"\x1b[K", "\x1b[K"}},
+		{"stale rows beyond height", []string{"a", "b", "c", "d"}, []string{"x"}, 2, []string{"x\x1b[K", "\x1b[K"}},
+	}
+	for _, tt := range tests {
+		if got := DiffFrame(tt.prev, tt.frame, tt.height); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: DiffFrame() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffFrame_DoesNotModifyInput(t *testing.T) {
+	prev := []string{"a", "b"}
+	frame := []string{"a", "c"}
+	DiffFrame(prev, frame, 2)
+
+	if !reflect.DeepEqual(prev, []string{"a", "b"}) || !reflect.DeepEqual(frame, []string{"a", "c"}) {
+		t.Errorf("DiffFrame() modified its input: prev %q, frame %q", prev, frame)
+	}
+}
@@ -52,6 +52,14 @@ func DefaultKeyBindings() map[string]KeyBinding {
 			Keys: []string{"ctrl+d"},
 			Help: "Scroll down half page",
 		},
+		"nextmatch": {
+			Keys: []string{"n"},
+			Help: "Next search match",
+		},
+		"prevmatch": {
+			Keys: []string{"N"},
+			Help: "Previous search match",
+		},
 	}
 }
 
@@ -114,3 +122,15 @@ func IsHalfPageDownKey(msg tea.KeyMsg) bool {
 	bindings := DefaultKeyBindings()
 	return MatchKey(msg, bindings["halfpagedown"].Keys)
 }
+
+// IsNextMatchKey checks if the key message is a "next search match" key.
+func IsNextMatchKey(msg tea.KeyMsg) bool {
+	bindings := DefaultKeyBindings()
+	return MatchKey(msg, bindings["nextmatch"].Keys)
+}
+
+// IsPrevMatchKey checks if the key message is a "previous search match" key.
+func IsPrevMatchKey(msg tea.KeyMsg) bool {
+	bindings := DefaultKeyBindings()
+	return MatchKey(msg, bindings["prevmatch"].Keys)
+}
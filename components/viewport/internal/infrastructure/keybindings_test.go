@@ -9,7 +9,7 @@ import (
 func TestDefaultKeyBindings(t *testing.T) {
 	bindings := DefaultKeyBindings()
 
-	expectedActions := []string{"up", "down", "pageup", "pagedown", "home", "end", "halfpageup", "halfpagedown"}
+	expectedActions := []string{"up", "down", "pageup", "pagedown", "home", "end", "halfpageup", "halfpagedown", "nextmatch", "prevmatch"}
 
 	for _, action := range expectedActions {
 		binding, exists := bindings[action]
@@ -340,6 +340,30 @@ func TestIsHalfPageDownKey(t *testing.T) {
 	}
 }
 
+func TestIsNextPrevMatchKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		keyMsg tea.KeyMsg
+		next   bool
+		prev   bool
+	}{
+		{"n", tea.KeyMsg{Type: tea.KeyRune, Rune: 'n'}, true, false},
+		{"N", tea.KeyMsg{Type: tea.KeyRune, Rune: 'N'}, false, true},
+		{"ctrl+n", tea.KeyMsg{Type: tea.KeyRune, Rune: 'n', Ctrl: true}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNextMatchKey(tt.keyMsg); got != tt.next {
+				t.Errorf("IsNextMatchKey() = %v, want %v", got, tt.next)
+			}
+			if got := IsPrevMatchKey(tt.keyMsg); got != tt.prev {
+				t.Errorf("IsPrevMatchKey() = %v, want %v", got, tt.prev)
+			}
+		})
+	}
+}
+
 func TestAllKeyBindingsCovered(t *testing.T) {
 	// Ensure all key binding functions are tested.
 	bindings := DefaultKeyBindings()
@@ -353,6 +377,8 @@ func TestAllKeyBindingsCovered(t *testing.T) {
 		"end":          IsEndKey,
 		"halfpageup":   IsHalfPageUpKey,
 		"halfpagedown": IsHalfPageDownKey,
+		"nextmatch":    IsNextMatchKey,
+		"prevmatch":    IsPrevMatchKey,
 	}
 
 	for action := range bindings {
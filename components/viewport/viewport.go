@@ -3,13 +3,57 @@ package viewport
 
 import (
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/phoenix-tui/phoenix/components/viewport/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/components/viewport/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/components/viewport/internal/infrastructure"
 	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// RenderMode selects how View renders consecutive frames.
+type RenderMode int
+
+const (
+	// RenderFull (the default) renders the visible lines as plain text joined
+	// by newlines, without escape sequences. The program renderer replaces
+	// the previous frame, and the output can be composed with other
+	// components (borders, layout).
+	RenderFull RenderMode = iota
+	// RenderDiff makes each frame replace the previous one in place when the
+	// viewport is the whole view: changed lines clear to the end of the
+	// line, unchanged lines are skipped with a cursor move, and rows left
+	// over from a taller previous frame are blanked (for terminals that show
+	// repeated output after scrolling, e.g. GNOME Terminal).
+	//
+	// The diff is against the frame of the value Update was called on, which
+	// is assumed to be on screen. Values derived in any other way render the
+	// full frame, as do values updated with a WindowSizeMsg or ResumeMsg.
+	// After the program redraws the screen in another way (Println, entering
+	// or leaving the alternate screen), call Invalidate.
+	RenderDiff
+)
+
+const (
+	// DefaultSmoothScrollDuration is how long a smooth wheel scroll takes.
+	DefaultSmoothScrollDuration = 120 * time.Millisecond
+
+	// scrollFrameInterval is the delay between smooth scroll frames (~60 FPS).
+	scrollFrameInterval = 16 * time.Millisecond
+)
+
+// lastViewportID hands out IDs so animation frames reach only their viewport.
+var lastViewportID atomic.Int64
+
+// scrollFrameMsg advances the smooth scroll animation of viewport id.
+// Frames of a superseded animation (older gen) are ignored.
+type scrollFrameMsg struct {
+	id  int64
+	gen int
+}
+
 // Viewport is the public API for the Viewport component.
 // It provides a fluent interface for configuration and implements tea.Model.
 type Viewport struct {
@@ -21,6 +65,18 @@ type Viewport struct {
 	dragStartY   int
 	scrollStartY int
 	theme        *style.Theme // Optional theme, defaults to DefaultTheme if nil
+	renderMode   RenderMode
+	prevFrame    []string // RenderDiff: frame on screen (nil = render in full)
+	// Search highlight styles (nil = derived from theme)
+	matchStyle        *style.Style
+	currentMatchStyle *style.Style
+	gutterStyle       *style.Style // Line number style (nil = derived from theme)
+	// Smooth scrolling state
+	id             int64
+	smoothScroll   bool
+	scrollDuration time.Duration
+	animation      *value.ScrollAnimation // In-flight wheel animation (nil = idle)
+	animationGen   int                    // Incremented for every started animation
 }
 
 // New creates a new Viewport with the given dimensions.
@@ -29,6 +85,8 @@ func New(width, height int) *Viewport {
 		domain:         model.NewViewport(width, height),
 		mouseEnabled:   false,
 		linesPerScroll: 3, // Default: 3 lines per wheel tick
+		id:             lastViewportID.Add(1),
+		scrollDuration: DefaultSmoothScrollDuration,
 	}
 }
 
@@ -40,6 +98,8 @@ func NewWithContent(content string, width, height int) *Viewport {
 		domain:         model.NewViewportWithContent(lines, width, height),
 		mouseEnabled:   false,
 		linesPerScroll: 3, // Default: 3 lines per wheel tick
+		id:             lastViewportID.Add(1),
+		scrollDuration: DefaultSmoothScrollDuration,
 	}
 }
 
@@ -49,15 +109,26 @@ func NewWithLines(lines []string, width, height int) *Viewport {
 		domain:         model.NewViewportWithContent(lines, width, height),
 		mouseEnabled:   false,
 		linesPerScroll: 3, // Default: 3 lines per wheel tick
+		id:             lastViewportID.Add(1),
+		scrollDuration: DefaultSmoothScrollDuration,
 	}
 }
 
 // FollowMode enables or disables follow mode (tail -f style auto-scrolling).
 // When enabled, the viewport automatically scrolls to the bottom when content changes.
+// Scrolling up pauses following, like leaving the end in less +F; scrolling
+// back to the bottom (or End) resumes it. See IsFollowing.
 func (v *Viewport) FollowMode(enabled bool) *Viewport {
 	return v.withDomain(v.domain.WithFollowMode(enabled))
 }
 
+// IsFollowing returns true if new content will scroll the viewport to the
+// bottom, i.e. follow mode is on and the user has not scrolled away.
+// Useful for a "following" / "paused" indicator in a log viewer's status line.
+func (v *Viewport) IsFollowing() bool {
+	return v.domain.FollowMode()
+}
+
 // WrapLines enables or disables line wrapping.
 // When enabled, lines wider than the viewport are wrapped to multiple lines.
 func (v *Viewport) WrapLines(enabled bool) *Viewport {
@@ -74,6 +145,17 @@ func (v *Viewport) MouseEnabled(enabled bool) *Viewport {
 		dragStartY:     v.dragStartY,
 		scrollStartY:   v.scrollStartY,
 		theme:          v.theme,
+		renderMode:     v.renderMode,
+
+		matchStyle:        v.matchStyle,
+		currentMatchStyle: v.currentMatchStyle,
+		gutterStyle:       v.gutterStyle,
+
+		id:             v.id,
+		smoothScroll:   v.smoothScroll,
+		scrollDuration: v.scrollDuration,
+		animation:      v.animation,
+		animationGen:   v.animationGen,
 	}
 }
 
@@ -92,9 +174,150 @@ func (v *Viewport) SetWheelScrollLines(lines int) *Viewport {
 		dragStartY:     v.dragStartY,
 		scrollStartY:   v.scrollStartY,
 		theme:          v.theme,
+		renderMode:     v.renderMode,
+
+		matchStyle:        v.matchStyle,
+		currentMatchStyle: v.currentMatchStyle,
+		gutterStyle:       v.gutterStyle,
+
+		id:             v.id,
+		smoothScroll:   v.smoothScroll,
+		scrollDuration: v.scrollDuration,
+		animation:      v.animation,
+		animationGen:   v.animationGen,
 	}
 }
 
+// SetRenderMode selects how View renders frames (RenderFull by default).
+// RenderDiff only fits a viewport that is the whole view of the program.
+func (v *Viewport) SetRenderMode(mode RenderMode) *Viewport {
+	newViewport := v.withDomain(v.domain)
+	newViewport.renderMode = mode
+	return newViewport
+}
+
+// Invalidate returns a copy whose next View renders the full frame. In
+// RenderDiff mode, call it after the program redrew the screen in a way the
+// viewport doesn't see, e.g. after returning tea.Println or entering the
+// alternate screen.
+func (v *Viewport) Invalidate() *Viewport {
+	return v.withDomain(v.domain)
+}
+
+// RenderMode returns the current render mode.
+func (v *Viewport) RenderMode() RenderMode {
+	return v.renderMode
+}
+
+// SmoothScroll enables or disables animated mouse-wheel scrolling.
+// When enabled, a wheel tick eases the view to its new position over
+// SmoothScrollDuration; ticks arriving mid-animation move the target instead
+// of queuing more animations, and keyboard scrolling or a jump such as
+// ScrollToTop stops the animation. The frames are driven by commands returned
+// from Update, so the host model must return them.
+func (v *Viewport) SmoothScroll(enabled bool) *Viewport {
+	newViewport := v.withDomain(v.domain)
+	newViewport.smoothScroll = enabled
+	if !enabled {
+		newViewport.animation = nil
+	}
+	return newViewport
+}
+
+// SmoothScrollDuration sets how long a smooth wheel scroll animation takes
+// (default DefaultSmoothScrollDuration). Durations shorter than one frame
+// (16ms) finish in a single frame.
+func (v *Viewport) SmoothScrollDuration(d time.Duration) *Viewport {
+	newViewport := v.withDomain(v.domain)
+	newViewport.scrollDuration = d
+	return newViewport
+}
+
+// IsScrolling returns true while a smooth scroll animation is running.
+func (v *Viewport) IsScrolling() bool {
+	return v.animation != nil
+}
+
+// ShowLineNumbers shows or hides a right-aligned line number gutter, as
+// used by code and log viewers. The gutter grows with the total line count
+// and the content area shrinks by its width; wrapped continuation lines get
+// a blank gutter.
+func (v *Viewport) ShowLineNumbers(enabled bool) *Viewport {
+	return v.withDomain(v.domain.WithLineNumbers(enabled))
+}
+
+// LineNumberStart sets the number shown for the first content line
+// (1 by default; use 0 for 0-based numbering).
+func (v *Viewport) LineNumberStart(n int) *Viewport {
+	return v.withDomain(v.domain.WithLineNumberStart(n))
+}
+
+// LineNumberStyle sets the style of the gutter numbers.
+// By default they use the theme's muted text color.
+func (v *Viewport) LineNumberStyle(s style.Style) *Viewport {
+	newViewport := v.withDomain(v.domain)
+	newViewport.gutterStyle = &s
+	return newViewport
+}
+
+// Search highlights every occurrence of query and scrolls to the first match
+// at or below the top of the view. It returns the new Viewport and the number
+// of matches. Matching is case-insensitive unless CaseSensitive(true) is set;
+// an empty query clears the search. Matches are kept up to date when the
+// content changes, and n/N move to the next/previous match.
+//
+// Example - log viewer status line:
+//
+//	v, count := v.Search("error")
+//	status := fmt.Sprintf("%d/%d", v.CurrentMatch(), count)
+func (v *Viewport) Search(query string) (*Viewport, int) {
+	newViewport := v.withDomain(v.domain.WithSearch(query))
+	return newViewport, newViewport.MatchCount()
+}
+
+// NextMatch makes the next match current (wrapping around) and scrolls it
+// into view. No-op without matches.
+func (v *Viewport) NextMatch() *Viewport {
+	return v.withDomain(v.domain.NextMatch())
+}
+
+// PrevMatch makes the previous match current (wrapping around) and scrolls
+// it into view. No-op without matches.
+func (v *Viewport) PrevMatch() *Viewport {
+	return v.withDomain(v.domain.PrevMatch())
+}
+
+// CaseSensitive enables or disables case-sensitive search (default: off).
+// An active search is re-run.
+func (v *Viewport) CaseSensitive(enabled bool) *Viewport {
+	return v.withDomain(v.domain.WithCaseSensitive(enabled))
+}
+
+// MatchStyle sets the styles used to highlight search matches and the
+// current match. By default they are derived from the theme.
+func (v *Viewport) MatchStyle(match, current style.Style) *Viewport {
+	newViewport := v.withDomain(v.domain)
+	newViewport.matchStyle = &match
+	newViewport.currentMatchStyle = &current
+	return newViewport
+}
+
+// SearchQuery returns the active search query ("" if none).
+func (v *Viewport) SearchQuery() string {
+	return v.domain.SearchQuery()
+}
+
+// MatchCount returns the number of matches of the active search.
+func (v *Viewport) MatchCount() int {
+	return v.domain.MatchCount()
+}
+
+// CurrentMatch returns the 1-based position of the current match among all
+// matches, or 0 if there are none.
+func (v *Viewport) CurrentMatch() int {
+	return v.domain.CurrentMatch() + 1
+}
+
 // SetContent replaces the viewport content with the given string.
 // Content is split by newlines into individual lines.
 func (v *Viewport) SetContent(content string) *Viewport {
@@ -111,32 +334,30 @@ func (v *Viewport) SetLines(lines []string) *Viewport {
 // Useful for streaming content (like log viewers, command output accumulation).
 // The line is added to the end of existing content.
 func (v *Viewport) AppendLine(line string) *Viewport {
-	currentContent := v.domain.Content()
-	currentContent = append(currentContent, line)
-	return v.SetLines(currentContent)
+	return v.AppendLines([]string{line})
 }
 
 // AppendLines appends multiple lines to the viewport content.
 // Useful for batch updates (e.g., adding multiple log entries at once).
-// More efficient than multiple AppendLine() calls.
+// Unlike SetLines, existing content is neither copied nor searched again,
+// so appending stays cheap however long the log grows. In follow mode the
+// viewport scrolls to show the new lines.
 func (v *Viewport) AppendLines(lines []string) *Viewport {
-	currentContent := v.domain.Content()
-	currentContent = append(currentContent, lines...)
-	return v.SetLines(currentContent)
+	return v.withDomain(v.domain.AppendContent(lines))
 }
 
 // ScrollToBottom scrolls the viewport to the bottom (last line).
 // This is a one-time scroll action, not continuous like FollowMode.
 // Useful for: user presses End key, jump to bottom on command, etc.
 func (v *Viewport) ScrollToBottom() *Viewport {
-	return v.withDomain(v.domain.ScrollToBottom())
+	return v.stopAnimation().withDomain(v.domain.ScrollToBottom())
 }
 
 // ScrollToTop scrolls the viewport to the top (first line).
 // This is a one-time scroll action.
 // Useful for: user presses Home key, reset to top on command, etc.
 func (v *Viewport) ScrollToTop() *Viewport {
-	return v.withDomain(v.domain.ScrollToTop())
+	return v.stopAnimation().withDomain(v.domain.ScrollToTop())
 }
 
 // SetYOffset sets the vertical scroll offset to a specific line number.
@@ -144,7 +365,23 @@ func (v *Viewport) ScrollToTop() *Viewport {
 // Offset is clamped to valid range [0, maxOffset].
 // Useful for: jumping to specific line, restoring scroll position, etc.
 func (v *Viewport) SetYOffset(offset int) *Viewport {
-	return v.withDomain(v.domain.WithScrollOffset(offset))
+	return v.stopAnimation().withDomain(v.domain.WithScrollOffset(offset))
+}
+
+// ScrollToLine scrolls so that logical content line n (0-based, counted
+// before wrapping) is the first visible line. n is clamped to the content,
+// and near the end the viewport stops at the bottom rather than leaving
+// empty space. Useful for: "go to line", jumping to a search result, etc.
+func (v *Viewport) ScrollToLine(n int) *Viewport {
+	return v.stopAnimation().withDomain(v.domain.WithScrollOffset(n))
+}
+
+// ScrollToPercent scrolls to the given fraction of the scroll range, from
+// 0.0 (top) to 1.0 (bottom); p is clamped to that range. Scrolling to 1.0
+// is the same as ScrollToBottom and resumes follow mode.
+// Useful for: clicking a scrollbar track, "jump to 50%" commands, etc.
+func (v *Viewport) ScrollToPercent(p float64) *Viewport {
+	return v.stopAnimation().withDomain(v.domain.WithScrollPercent(p))
 }
 
 // SetSize updates the viewport dimensions.
@@ -160,15 +397,43 @@ func (v *Viewport) Init() tea.Cmd {
 // Update processes messages and returns updated viewport (implements Update(Msg) (*Viewport, Cmd)).
 // Note: Returns concrete *Viewport instead of tea.Model interface for better usability.
 func (v *Viewport) Update(msg tea.Msg) (*Viewport, tea.Cmd) {
+	next, cmd := v.update(msg)
+	if v.renderMode != RenderDiff {
+		return next, cmd
+	}
+
+	next = next.withDomain(next.domain)
+	switch msg.(type) {
+	case tea.WindowSizeMsg, tea.ResumeMsg:
+		// The program redraws the whole screen.
+	default:
+		next.prevFrame = v.frame()
+	}
+	return next, cmd
+}
+
+// update applies msg.
+func (v *Viewport) update(msg tea.Msg) (*Viewport, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		return v.handleKeyMsg(msg), nil
+		return v.stopAnimation().handleKeyMsg(msg), nil
 
 	case tea.MouseMsg:
+		if v.mouseEnabled && v.smoothScroll {
+			switch msg.Button {
+			case tea.MouseButtonWheelUp:
+				return v.animateScroll(-v.linesPerScroll)
+			case tea.MouseButtonWheelDown:
+				return v.animateScroll(v.linesPerScroll)
+			}
+		}
 		if v.mouseEnabled {
-			return v.handleMouseMsg(msg), nil
+			return v.stopAnimation().handleMouseMsg(msg), nil
 		}
 
+	case scrollFrameMsg:
+		return v.handleScrollFrame(msg)
+
 	case tea.WindowSizeMsg:
 		return v.SetSize(msg.Width, msg.Height), nil
 	}
@@ -176,6 +441,72 @@ func (v *Viewport) Update(msg tea.Msg) (*Viewport, tea.Cmd) {
 	return v, nil
 }
 
+// animateScroll starts or retargets a smooth scroll by delta lines.
+// While an animation runs, only the target moves; the running frame loop
+// picks it up, so no additional command is returned.
+func (v *Viewport) animateScroll(delta int) (*Viewport, tea.Cmd) {
+	target := v.domain.ScrollOffset() + delta
+	if v.animation != nil {
+		target = v.animation.Target() + delta
+	}
+	target = v.domain.WithScrollOffset(target).ScrollOffset() // Clamp to content
+
+	frames := int(v.scrollDuration / scrollFrameInterval)
+	animation := value.NewScrollAnimation(v.domain.ScrollOffset(), target, frames)
+
+	newViewport := v.withDomain(v.domain)
+	newViewport.animation = &animation
+	if v.animation != nil {
+		return newViewport, nil
+	}
+	newViewport.animationGen++
+	return newViewport, newViewport.nextScrollFrame()
+}
+
+// handleScrollFrame advances the smooth scroll animation by one frame.
+func (v *Viewport) handleScrollFrame(msg scrollFrameMsg) (*Viewport, tea.Cmd) {
+	if msg.id != v.id || msg.gen != v.animationGen || v.animation == nil {
+		return v, nil
+	}
+
+	animation := v.animation.Next()
+	domain := v.domain
+	// Scroll by delta so ScrollDown re-enables follow mode at the bottom.
+	if delta := animation.Offset() - domain.ScrollOffset(); delta > 0 {
+		domain = domain.ScrollDown(delta)
+	} else if delta < 0 {
+		domain = domain.ScrollUp(-delta)
+	}
+
+	newViewport := v.withDomain(domain)
+	if animation.Done() {
+		newViewport.animation = nil
+		return newViewport, nil
+	}
+	newViewport.animation = &animation
+	return newViewport, newViewport.nextScrollFrame()
+}
+
+// nextScrollFrame returns the command delivering the next animation frame.
+func (v *Viewport) nextScrollFrame() tea.Cmd {
+	msg := scrollFrameMsg{id: v.id, gen: v.animationGen}
+	return func() tea.Msg {
+		time.Sleep(scrollFrameInterval)
+		return msg
+	}
+}
+
+// stopAnimation returns the viewport with any smooth scroll animation
+// stopped at the current offset.
+func (v *Viewport) stopAnimation() *Viewport {
+	if v.animation == nil {
+		return v
+	}
+	newViewport := v.withDomain(v.domain)
+	newViewport.animation = nil
+	return newViewport
+}
+
 // handleKeyMsg processes keyboard input for scrolling.
 func (v *Viewport) handleKeyMsg(msg tea.KeyMsg) *Viewport {
 	if infrastructure.IsUpKey(msg) {
@@ -212,6 +543,14 @@ func (v *Viewport) handleKeyMsg(msg tea.KeyMsg) *Viewport {
 		return v.withDomain(v.domain.ScrollDown(halfPage))
 	}
 
+	if infrastructure.IsNextMatchKey(msg) {
+		return v.NextMatch()
+	}
+
+	if infrastructure.IsPrevMatchKey(msg) {
+		return v.PrevMatch()
+	}
+
 	return v
 }
 
@@ -251,7 +590,11 @@ func (v *Viewport) handleMouseMsg(msg tea.MouseMsg) *Viewport {
 			newScrollOffset := v.scrollStartY - deltaY
 
 			// Apply scroll with bounds checking (domain handles clamping)
-			return v.withDomain(v.domain.WithScrollOffset(newScrollOffset))
+			domain := v.domain.WithScrollOffset(newScrollOffset)
+			if domain.IsAtBottom() {
+				domain = domain.ScrollToBottom() // Dragged back to the end: follow again
+			}
+			return v.withDomain(domain)
 		}
 	}
 
@@ -259,8 +602,14 @@ func (v *Viewport) handleMouseMsg(msg tea.MouseMsg) *Viewport {
 }
 
 // View implements tea.Model.
+//
+// In RenderDiff mode the output is a diff against the frame on screen (see
+// RenderDiff) and never more than Height rows.
 func (v *Viewport) View() string {
-	lines := v.domain.VisibleLines()
+	lines := v.frame()
+	if v.renderMode == RenderDiff {
+		lines = infrastructure.DiffFrame(v.prevFrame, lines, v.domain.VisibleHeight())
+	}
 
 	if len(lines) == 0 {
 		return ""
@@ -269,6 +618,58 @@ func (v *Viewport) View() string {
 	return strings.Join(lines, "\n")
 }
 
+// frame returns the visible lines with search highlights and line numbers.
+func (v *Viewport) frame() []string {
+	domain := v.domain
+	if domain.MatchCount() > 0 {
+		matchStyle, currentStyle := v.searchStyles()
+		domain = domain.WithMatchHighlighter(func(match string, current bool) string {
+			if current {
+				return style.Render(currentStyle, match)
+			}
+			return style.Render(matchStyle, match)
+		})
+	}
+	if domain.LineNumbers() {
+		gutterStyle := v.lineNumberStyle()
+		domain = domain.WithGutterRenderer(func(gutter string) string {
+			return style.Render(gutterStyle, gutter)
+		})
+	}
+	return domain.VisibleLines()
+}
+
+// lineNumberStyle returns the gutter number style.
+func (v *Viewport) lineNumberStyle() style.Style {
+	if v.gutterStyle != nil {
+		return *v.gutterStyle
+	}
+	theme := v.theme
+	if theme == nil {
+		theme = style.DefaultTheme()
+	}
+	return style.New().Foreground(theme.Colors().TextMuted)
+}
+
+// searchStyles returns the match and current-match highlight styles.
+func (v *Viewport) searchStyles() (match, current style.Style) {
+	theme := v.theme
+	if theme == nil {
+		theme = style.DefaultTheme()
+	}
+	colors := theme.Colors()
+
+	match = style.New().Foreground(colors.Background).Background(colors.Warning)
+	current = style.New().Foreground(colors.Background).Background(colors.Primary).Bold(true)
+	if v.matchStyle != nil {
+		match = *v.matchStyle
+	}
+	if v.currentMatchStyle != nil {
+		current = *v.currentMatchStyle
+	}
+	return match, current
+}
+
 // VisibleLines returns the currently visible lines.
 func (v *Viewport) VisibleLines() []string {
 	return v.domain.VisibleLines()
@@ -299,6 +700,13 @@ func (v *Viewport) IsAtBottom() bool {
 	return v.domain.IsAtBottom()
 }
 
+// ScrollPercent returns the scroll position as a fraction of the scroll
+// range, from 0.0 (top) to 1.0 (bottom), e.g. for a scrollbar thumb or a
+// "42%" indicator. Content that fits in the viewport reports 1.0.
+func (v *Viewport) ScrollPercent() float64 {
+	return v.domain.ScrollPercent()
+}
+
 // TotalLines returns the total number of content lines.
 func (v *Viewport) TotalLines() int {
 	return v.domain.TotalLines()
@@ -320,6 +728,17 @@ func (v *Viewport) withDomain(domain *model.Viewport) *Viewport {
 		dragStartY:     v.dragStartY,
 		scrollStartY:   v.scrollStartY,
 		theme:          v.theme,
+		renderMode:     v.renderMode,
+
+		matchStyle:        v.matchStyle,
+		currentMatchStyle: v.currentMatchStyle,
+		gutterStyle:       v.gutterStyle,
+
+		id:             v.id,
+		smoothScroll:   v.smoothScroll,
+		scrollDuration: v.scrollDuration,
+		animation:      v.animation,
+		animationGen:   v.animationGen,
 	}
 }
 
@@ -333,6 +752,17 @@ func (v *Viewport) withDragState(isDragging bool, dragStartY, scrollStartY int)
 		dragStartY:     dragStartY,
 		scrollStartY:   scrollStartY,
 		theme:          v.theme,
+		renderMode:     v.renderMode,
+
+		matchStyle:        v.matchStyle,
+		currentMatchStyle: v.currentMatchStyle,
+		gutterStyle:       v.gutterStyle,
+
+		id:             v.id,
+		smoothScroll:   v.smoothScroll,
+		scrollDuration: v.scrollDuration,
+		animation:      v.animation,
+		animationGen:   v.animationGen,
 	}
 }
 
@@ -347,5 +777,16 @@ func (v *Viewport) Theme(theme *style.Theme) *Viewport {
 		dragStartY:     v.dragStartY,
 		scrollStartY:   v.scrollStartY,
 		theme:          theme,
+		renderMode:     v.renderMode,
+
+		matchStyle:        v.matchStyle,
+		currentMatchStyle: v.currentMatchStyle,
+		gutterStyle:       v.gutterStyle,
+
+		id:             v.id,
+		smoothScroll:   v.smoothScroll,
+		scrollDuration: v.scrollDuration,
+		animation:      v.animation,
+		animationGen:   v.animationGen,
 	}
 }
@@ -1,9 +1,16 @@
 package viewport
 
 import (
+	"bytes"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
@@ -168,6 +175,91 @@ func TestViewport_SetYOffset(t *testing.T) {
 	}
 }
 
+func TestViewport_ScrollToLine(t *testing.T) {
+	content := make([]string, 100)
+	for i := range content {
+		content[i] = "Line " + strconv.Itoa(i)
+	}
+	v := New(80, 20).SetLines(content)
+
+	tests := []struct {
+		line, offset int
+	}{
+		{42, 42},
+		{-5, 0},
+		{95, 80}, // Stops at the bottom
+		{1000, 80},
+	}
+	for _, tt := range tests {
+		if got := v.ScrollToLine(tt.line).ScrollOffset(); got != tt.offset {
+			t.Errorf("ScrollToLine(%d) offset = %d, want %d", tt.line, got, tt.offset)
+		}
+	}
+	if first := v.ScrollToLine(42).VisibleLines()[0]; first != "Line 42" {
+		t.Errorf("first visible line = %q, want Line 42", first)
+	}
+}
+
+func TestViewport_ScrollPercent(t *testing.T) {
+	content := make([]string, 100)
+	for i := range content {
+		content[i] = "Line"
+	}
+	v := New(80, 20).SetLines(content) // Scroll range 0..80
+
+	tests := []struct {
+		offset  int
+		percent float64
+	}{
+		{0, 0},
+		{20, 0.25},
+		{40, 0.5},
+		{80, 1},
+	}
+	for _, tt := range tests {
+		if got := v.SetYOffset(tt.offset).ScrollPercent(); got != tt.percent {
+			t.Errorf("offset %d: ScrollPercent() = %v, want %v", tt.offset, got, tt.percent)
+		}
+	}
+
+	if got := New(80, 20).SetLines([]string{"short"}).ScrollPercent(); got != 1 {
+		t.Errorf("content that fits: ScrollPercent() = %v, want 1", got)
+	}
+}
+
+func TestViewport_ScrollToPercent(t *testing.T) {
+	content := make([]string, 100)
+	for i := range content {
+		content[i] = "Line"
+	}
+	v := New(80, 20).SetLines(content) // Scroll range 0..80
+
+	tests := []struct {
+		percent float64
+		offset  int
+	}{
+		{0, 0},
+		{0.5, 40},
+		{0.33, 26}, // Rounded to the nearest line
+		{-1, 0},
+		{2, 80},
+		{math.NaN(), 0},
+	}
+	for _, tt := range tests {
+		if got := v.ScrollToPercent(tt.percent).ScrollOffset(); got != tt.offset {
+			t.Errorf("ScrollToPercent(%v) offset = %d, want %d", tt.percent, got, tt.offset)
+		}
+	}
+
+	following := v.ScrollToPercent(0.5).ScrollToPercent(1)
+	if !following.IsAtBottom() || !following.IsFollowing() {
+		t.Error("ScrollToPercent(1) should scroll to the bottom and resume following")
+	}
+	if v.FollowMode(true).ScrollToPercent(0.5).IsFollowing() {
+		t.Error("ScrollToPercent(0.5) should pause following")
+	}
+}
+
 func TestViewport_SetYOffset_ClampNegative(t *testing.T) {
 	content := make([]string, 100)
 	for i := range content {
@@ -298,6 +390,33 @@ func TestViewport_FollowMode(t *testing.T) {
 	}
 }
 
+func TestViewport_IsFollowing(t *testing.T) {
+	v := New(80, 5).FollowMode(true).AppendLines(make([]string, 20))
+	if !v.IsFollowing() || !v.IsAtBottom() {
+		t.Fatal("AppendLines in follow mode should scroll to the bottom")
+	}
+
+	// Scrolling up pauses following like tail -f in less.
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if v.IsFollowing() {
+		t.Error("scrolling up should pause following")
+	}
+	v = v.AppendLines([]string{"new"})
+	if v.ScrollOffset() != 14 {
+		t.Errorf("ScrollOffset = %d, want 14 while paused", v.ScrollOffset())
+	}
+
+	// Back at the bottom, new lines are followed again.
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	if !v.IsFollowing() {
+		t.Error("End should resume following")
+	}
+	v = v.AppendLine("newer")
+	if !v.IsAtBottom() || v.TotalLines() != 22 {
+		t.Errorf("TotalLines = %d, IsAtBottom = %v, want 22, true", v.TotalLines(), v.IsAtBottom())
+	}
+}
+
 func TestViewport_WrapLines(t *testing.T) {
 	v := New(10, 5).WrapLines(true)
 
@@ -905,6 +1024,168 @@ func TestViewport_View_Scrolled(t *testing.T) {
 	}
 }
 
+// TestViewport_View_DiffAfterScroll is a regression test for repeated output:
+// consecutive frames must replace each other instead of accumulating lines.
+func TestViewport_View_DiffAfterScroll(t *testing.T) {
+	v := New(80, 3).SetRenderMode(RenderDiff).SetLines([]string{"Line 1", "Line 2", "Line 3", "Line 4", "Line 5"})
+
+	if first := v.View(); first != "Line 1\nLine 2\nLine 3" {
+		t.Errorf("first View() = %q, want plain lines", first)
+	}
+
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyDown})
+	second := v.View()
+
+	want := "Line 2\x1b[K\nLine 3\x1b[K\nLine 4\x1b[K"
+	if second != want {
+		t.Errorf("View() after scroll = %q, want %q", second, want)
+	}
+	if third := v.View(); third != second {
+		t.Errorf("View() is not idempotent: %q, then %q", second, third)
+	}
+
+	// Only the changed line is rewritten; the others are skipped.
+	v = New(80, 3).SetRenderMode(RenderDiff).SetLines([]string{"x", "x", "x", "y"})
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if view := v.View(); view != "\x1b[1C\n\x1b[1C\ny\x1b[K" {
+		t.Errorf("View() after scroll = %q, want only the changed line rewritten", view)
+	}
+}
+
+// TestViewport_View_DiffFullFrame verifies RenderDiff renders the full frame
+// whenever the frame on screen is not known.
+func TestViewport_View_DiffFullFrame(t *testing.T) {
+	v := New(80, 2).SetRenderMode(RenderDiff).SetLines([]string{"a", "b", "c"})
+	scrolled, _ := v.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	tests := []struct {
+		name string
+		v    *Viewport
+	}{
+		{"changed outside Update", scrolled.SetLines([]string{"a", "x"})},
+		{"invalidated", scrolled.Invalidate()},
+		{"resized", must(scrolled.Update(tea.WindowSizeMsg{Width: 80, Height: 2}))},
+		{"resumed", must(scrolled.Update(tea.ResumeMsg{}))},
+	}
+	for _, tt := range tests {
+		if view, want := tt.v.View(), strings.Join(tt.v.VisibleLines(), "\n"); view != want {
+			t.Errorf("%s: View() = %q, want the full frame %q", tt.name, view, want)
+		}
+	}
+
+	// View doesn't change what other values diff against.
+	scrolled.SetLines([]string{"x"}).View()
+	if view := scrolled.View(); view != "b\x1b[K\nc\x1b[K" {
+		t.Errorf("View() = %q after rendering a copy", view)
+	}
+}
+
+func must(v *Viewport, _ tea.Cmd) *Viewport { return v }
+
+// TestViewport_View_PrintlnThenRender verifies the rows of a viewport are
+// drawn again after the program printed a line above it.
+func TestViewport_View_PrintlnThenRender(t *testing.T) {
+	for name, mode := range map[string]RenderMode{"RenderFull": RenderFull, "RenderDiff": RenderDiff} {
+		var out syncBuffer
+		vp := New(20, 2).SetRenderMode(mode).SetLines([]string{"first row", "second row"})
+		p := tea.New(hostModel{vp: vp}, tea.WithInput[hostModel](strings.NewReader("")), tea.WithOutput[hostModel](&out))
+		if err := p.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		// A key that changes nothing, then a line printed above the view.
+		if err := p.Send(tea.KeyMsg{Type: tea.KeyDown}); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Send(printMsg{}); err != nil {
+			t.Fatal(err)
+		}
+		if output := waitForRows(&out, "printed", "first row", "second row"); output != "" {
+			t.Errorf("%s: viewport rows not drawn again after Println:\n%q", name, output)
+		}
+		p.Stop()
+	}
+}
+
+// waitForRows waits until the output has all rows after marker and returns
+// "", or returns the output after a second.
+func waitForRows(out *syncBuffer, marker string, rows ...string) string {
+	deadline := time.After(time.Second)
+	for {
+		output := out.String()
+		if i := strings.Index(output, marker); i >= 0 && containsAll(output[i:], rows) {
+			return ""
+		}
+		select {
+		case <-deadline:
+			return output
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func containsAll(s string, subs []string) bool {
+	for _, sub := range subs {
+		if !strings.Contains(s, sub) {
+			return false
+		}
+	}
+	return true
+}
+
+// printMsg asks hostModel to print a line above its view.
+type printMsg struct{}
+
+// hostModel is a program whose whole view is a viewport.
+type hostModel struct {
+	vp *Viewport
+}
+
+func (m hostModel) Init() tea.Cmd { return nil }
+
+func (m hostModel) Update(msg tea.Msg) (hostModel, tea.Cmd) {
+	if _, ok := msg.(printMsg); ok {
+		m.vp = m.vp.Invalidate() // The program redraws the view below the line
+		return m, tea.Println("printed")
+	}
+	m.vp, _ = m.vp.Update(msg)
+	return m, nil
+}
+
+func (m hostModel) View() string { return m.vp.View() }
+
+// syncBuffer is a bytes.Buffer safe for the program's writes and the test's reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestViewport_RenderMode(t *testing.T) {
+	v := New(80, 3)
+	if v.RenderMode() != RenderFull {
+		t.Errorf("default RenderMode() = %v, want RenderFull", v.RenderMode())
+	}
+	if view := v.SetLines([]string{"a", "b"}).View(); view != "a\nb" {
+		t.Errorf("RenderFull View() = %q, want %q", view, "a\nb")
+	}
+	v = v.SetRenderMode(RenderDiff).SetLines([]string{"a", "b"})
+	if v.RenderMode() != RenderDiff || v.ScrollOffset() != 0 {
+		t.Error("SetRenderMode() should keep the mode across copies")
+	}
+}
+
 // ============================================================================
 // Query Method Tests
 // ============================================================================
@@ -1404,3 +1685,119 @@ func TestViewport_DragScroll_Immutability(t *testing.T) {
 		t.Error("Drag did not create new viewport with updated offset")
 	}
 }
+
+func TestViewport_Search(t *testing.T) {
+	lines := []string{"INFO start", "WARN disk", "ERROR io", "INFO ok", "error again"}
+	v := NewWithLines(lines, 40, 2)
+
+	v, count := v.Search("error")
+	if count != 2 || v.MatchCount() != 2 || v.SearchQuery() != "error" {
+		t.Fatalf("Search() count = %d, MatchCount() = %d", count, v.MatchCount())
+	}
+	if v.CurrentMatch() != 1 || v.ScrollOffset() != 1 {
+		t.Errorf("CurrentMatch() = %d, offset %d, want 1, 1", v.CurrentMatch(), v.ScrollOffset())
+	}
+
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'n'})
+	if v.CurrentMatch() != 2 || v.ScrollOffset() != 3 {
+		t.Errorf("n: CurrentMatch() = %d, offset %d, want 2, 3", v.CurrentMatch(), v.ScrollOffset())
+	}
+	v, _ = v.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'N'})
+	if v.CurrentMatch() != 1 {
+		t.Errorf("N: CurrentMatch() = %d, want 1", v.CurrentMatch())
+	}
+
+	// Highlighting survives re-renders.
+	marked := v.MatchStyle(style.New().Bold(true), style.New().Underline(true))
+	for i := 0; i < 2; i++ {
+		if view := marked.View(); !strings.Contains(view, "\x1b[") || !strings.Contains(view, "ERROR") {
+			t.Errorf("View() #%d should highlight the match: %q", i, view)
+		}
+	}
+
+	if v.CaseSensitive(true).MatchCount() != 1 {
+		t.Error("CaseSensitive(true) should re-run the search")
+	}
+	if cleared, n := v.Search(""); n != 0 || cleared.CurrentMatch() != 0 {
+		t.Error("empty query should clear the search")
+	}
+}
+
+func TestViewport_ShowLineNumbers(t *testing.T) {
+	v := NewWithLines([]string{"alpha", "beta"}, 20, 2).
+		ShowLineNumbers(true).
+		LineNumberStart(0)
+
+	if got := v.VisibleLines(); !reflect.DeepEqual(got, []string{"0 alpha", "1 beta"}) {
+		t.Errorf("VisibleLines() = %q", got)
+	}
+
+	styled := v.LineNumberStyle(style.New().Bold(true)).View()
+	if !strings.Contains(styled, "\x1b[") || !strings.Contains(styled, "alpha") {
+		t.Errorf("View() should style the gutter: %q", styled)
+	}
+}
+
+func smoothScrollTestViewport() *Viewport {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	return NewWithLines(lines, 20, 10).MouseEnabled(true).SmoothScroll(true)
+}
+
+func TestViewport_SmoothScroll(t *testing.T) {
+	v := smoothScrollTestViewport()
+	wheelDown := tea.MouseMsg{Button: tea.MouseButtonWheelDown}
+
+	v, cmd := v.Update(wheelDown)
+	if cmd == nil || !v.IsScrolling() || v.ScrollOffset() != 0 {
+		t.Fatalf("wheel should start an animation: cmd %v, scrolling %v, offset %d",
+			cmd != nil, v.IsScrolling(), v.ScrollOffset())
+	}
+
+	// A second tick mid-animation moves the target without a new frame loop.
+	v, extra := v.Update(wheelDown)
+	if extra != nil {
+		t.Error("wheel during animation should be coalesced, not start another animation")
+	}
+
+	prev := v.ScrollOffset()
+	frames := 0
+	for cmd != nil {
+		v, cmd = v.Update(cmd())
+		if v.ScrollOffset() < prev {
+			t.Fatalf("animation moved backwards to %d", v.ScrollOffset())
+		}
+		prev = v.ScrollOffset()
+		frames++
+	}
+	if v.ScrollOffset() != 6 || v.IsScrolling() {
+		t.Errorf("after animation: offset %d, scrolling %v, want 6 and idle", v.ScrollOffset(), v.IsScrolling())
+	}
+	if want := int(DefaultSmoothScrollDuration / scrollFrameInterval); frames != want {
+		t.Errorf("animation took %d frames, want %d", frames, want)
+	}
+}
+
+func TestViewport_SmoothScroll_Cancel(t *testing.T) {
+	v := smoothScrollTestViewport().SmoothScrollDuration(time.Second)
+
+	v, cmd := v.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	v, _ = v.Update(cmd())
+	v = v.ScrollToTop()
+	if v.IsScrolling() || v.ScrollOffset() != 0 {
+		t.Fatalf("ScrollToTop() should cancel: scrolling %v, offset %d", v.IsScrolling(), v.ScrollOffset())
+	}
+
+	// The pending frame of the cancelled animation is dropped.
+	if v2, next := v.Update(cmd()); next != nil || v2.ScrollOffset() != 0 {
+		t.Error("stale animation frame should be ignored")
+	}
+
+	// Frames of another viewport are ignored as well.
+	_, otherCmd := smoothScrollTestViewport().Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	if v2, next := v.Update(otherCmd()); next != nil || v2.ScrollOffset() != 0 {
+		t.Error("frames of another viewport should be ignored")
+	}
+}
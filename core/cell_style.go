@@ -0,0 +1,164 @@
+package core
+
+import (
+	value2 "github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+// sgrReset resets all SGR attributes and colors.
+const sgrReset = "\x1b[0m"
+
+// Color is a terminal color used by CellStyle.
+//
+// Zero value: Color{} is valid and represents the terminal's default color.
+//
+//	var c core.Color             // Zero value - default color, valid
+//	red := core.Color16(1)       // Standard ANSI red
+//	orange := core.RGB(255, 165, 0)
+type Color struct {
+	domain value2.Color
+}
+
+// Color16 creates a standard ANSI color (0-7 normal, 8-15 bright).
+// Indexes above 15 are clamped to 15.
+//
+// Example:
+//
+//	red := core.Color16(1)
+//	brightRed := core.Color16(9)
+func Color16(index uint8) Color {
+	return Color{domain: value2.NewColor16(index)}
+}
+
+// Color256 creates a color from the 256-color palette.
+//
+// Example:
+//
+//	gray := core.Color256(240)
+func Color256(index uint8) Color {
+	return Color{domain: value2.NewColor256(index)}
+}
+
+// RGB creates a 24-bit true color.
+//
+// Example:
+//
+//	orange := core.RGB(255, 165, 0)
+func RGB(r, g, b uint8) Color {
+	return Color{domain: value2.NewColorRGB(r, g, b)}
+}
+
+// IsDefault returns true if this is the terminal's default color.
+func (c Color) IsDefault() bool {
+	return c.domain.IsDefault()
+}
+
+// CellStyle combines foreground, background and text attributes for a
+// single cell. Methods return new instances (immutable, fluent API).
+//
+// Zero value: CellStyle{} is valid and means unstyled (default colors, no attributes).
+//
+//	var s core.CellStyle // Zero value - unstyled, valid
+//	hover := core.CellStyle{}.Foreground(core.Color16(15)).Background(core.Color16(4)).Bold(true)
+type CellStyle struct {
+	domain value2.CellStyle
+}
+
+// Foreground returns a new style with the given foreground color.
+func (s CellStyle) Foreground(c Color) CellStyle {
+	return CellStyle{domain: s.domain.WithForeground(c.domain)}
+}
+
+// Background returns a new style with the given background color.
+func (s CellStyle) Background(c Color) CellStyle {
+	return CellStyle{domain: s.domain.WithBackground(c.domain)}
+}
+
+// Bold returns a new style with bold enabled or disabled.
+func (s CellStyle) Bold(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrBold, enabled)}
+}
+
+// Dim returns a new style with dim (faint) enabled or disabled.
+func (s CellStyle) Dim(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrDim, enabled)}
+}
+
+// Italic returns a new style with italic enabled or disabled.
+func (s CellStyle) Italic(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrItalic, enabled)}
+}
+
+// Underline returns a new style with underline enabled or disabled.
+func (s CellStyle) Underline(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrUnderline, enabled)}
+}
+
+// Blink returns a new style with blink enabled or disabled.
+func (s CellStyle) Blink(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrBlink, enabled)}
+}
+
+// Reverse returns a new style with reverse video enabled or disabled.
+func (s CellStyle) Reverse(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrReverse, enabled)}
+}
+
+// Hidden returns a new style with hidden text enabled or disabled.
+func (s CellStyle) Hidden(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrHidden, enabled)}
+}
+
+// Strikethrough returns a new style with strikethrough enabled or disabled.
+func (s CellStyle) Strikethrough(enabled bool) CellStyle {
+	return CellStyle{domain: s.domain.WithAttribute(value2.AttrStrikethrough, enabled)}
+}
+
+// IsZero returns true if the style has default colors and no attributes.
+func (s CellStyle) IsZero() bool {
+	return s.domain.IsZero()
+}
+
+// Equal returns true if both styles are identical.
+// Renderers use it to skip re-emitting an unchanged style between cells.
+func (s CellStyle) Equal(other CellStyle) bool {
+	return s.domain.Equal(other.domain)
+}
+
+// SGR returns a single escape sequence applying every attribute and both
+// colors (e.g. "\x1b[1;37;44m"), or "" for the zero style.
+func (s CellStyle) SGR() string {
+	return s.domain.SGR()
+}
+
+// StyledCell is a Cell with a CellStyle.
+// Use plain Cell when only content and width matter.
+//
+// Zero value: StyledCell{} is valid and represents an empty, unstyled cell.
+//
+//	sc := core.NewStyledCell("A", core.CellStyle{}.Bold(true))
+//	fmt.Print(sc.ANSI()) // "\x1b[1mA\x1b[0m"
+type StyledCell struct {
+	Cell
+	Style CellStyle
+}
+
+// NewStyledCell creates a StyledCell with automatic Unicode width calculation
+// (see NewCellAuto).
+func NewStyledCell(content string, style CellStyle) StyledCell {
+	return StyledCell{Cell: NewCellAuto(content), Style: style}
+}
+
+// WithStyle returns a StyledCell with the same content and width as c.
+func (c Cell) WithStyle(style CellStyle) StyledCell {
+	return StyledCell{Cell: c, Style: style}
+}
+
+// ANSI returns the cell content wrapped in the style's escape sequence and
+// a reset. An unstyled cell returns its content unchanged.
+func (sc StyledCell) ANSI() string {
+	sgr := sc.Style.SGR()
+	if sgr == "" {
+		return sc.Content
+	}
+	return sgr + sc.Content + sgrReset
+}
@@ -0,0 +1,65 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+func TestStyledCell_ANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		cell core.StyledCell
+		want string
+	}{
+		{
+			name: "unstyled",
+			cell: core.NewStyledCell("A", core.CellStyle{}),
+			want: "A",
+		},
+		{
+			name: "foreground and background",
+			cell: core.NewStyledCell("A", core.CellStyle{}.Foreground(core.Color16(15)).Background(core.Color16(4))),
+			want: "\x1b[97;44mA\x1b[0m",
+		},
+		{
+			name: "attributes with true color",
+			cell: core.NewStyledCell("👋", core.CellStyle{}.Bold(true).Underline(true).Foreground(core.RGB(255, 165, 0))),
+			want: "\x1b[1;4;38;2;255;165;0m👋\x1b[0m",
+		},
+		{
+			name: "from plain cell",
+			cell: core.NewCell("x", 1).WithStyle(core.CellStyle{}.Reverse(true)),
+			want: "\x1b[7mx\x1b[0m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cell.ANSI(); got != tt.want {
+				t.Errorf("ANSI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyledCell_Width(t *testing.T) {
+	sc := core.NewStyledCell("中", core.CellStyle{}.Bold(true))
+	if sc.Width != 2 {
+		t.Errorf("Width = %d, want 2 (style must not affect width)", sc.Width)
+	}
+}
+
+func TestCellStyle_Equal(t *testing.T) {
+	a := core.CellStyle{}.Bold(true).Foreground(core.Color256(196))
+	b := core.CellStyle{}.Foreground(core.Color256(196)).Bold(true)
+	if !a.Equal(b) {
+		t.Errorf("styles built in different order should be equal")
+	}
+	if a.Bold(false).Equal(b) {
+		t.Errorf("styles differing in bold should not be equal")
+	}
+	if !a.Bold(false).Foreground(core.Color{}).IsZero() {
+		t.Errorf("IsZero() = false after resetting every field")
+	}
+}
@@ -256,6 +256,7 @@ func NewCell(content string, width int) Cell {
 //   - ASCII: "A" -> width 1
 //   - Combining: "é" -> width 1
 //   - Zero-width: correctly handled
+//   - East Asian Ambiguous: "±" -> width 1, or 2 after SetAmbiguousWidth(2)
 //
 // This fixes Charm's lipgloss#562 bug with incorrect emoji/Unicode rendering.
 //
@@ -268,9 +269,8 @@ func NewCell(content string, width int) Cell {
 //
 // For manual width control (rare cases), use NewCell(content, width).
 func NewCellAuto(content string) Cell {
-	// Use UnicodeService to calculate correct width
-	unicodeService := service2.NewUnicodeService()
-	width := unicodeService.StringWidth(content)
+	// Use UnicodeService to calculate correct width (honors SetAmbiguousWidth)
+	width := unicodeSvc.Load().StringWidth(content)
 
 	// Create Cell with calculated width
 	domainCell := value2.NewCell(content, width)
@@ -0,0 +1,315 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	value2 "github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+// sgrReset resets all SGR attributes and colors.
+const sgrReset = "\x1b[0m"
+
+// mergeGap is the longest run of unchanged cells Diff rewrites rather than
+// skipping with a cursor move. A CUP sequence costs 6-8 bytes, so rewriting
+// a few unchanged ASCII cells is cheaper than starting a new change.
+const mergeGap = 4
+
+// Screen is a fixed-size grid of styled cells (a frame buffer).
+//
+// A cell wider than one column (CJK, emoji) occupies its own column plus
+// width-1 continuation columns to its right. Continuations are stored as
+// zero-width cells and never hold user content.
+//
+// Invariants:
+//   - Width, Height >= 0
+//   - Every continuation column belongs to a wide cell to its left
+//   - Overwriting part of a wide cell blanks the rest of it
+type Screen struct {
+	width  int
+	height int
+	cells  []value2.StyledCell // Row-major, len = width*height
+}
+
+// NewScreen creates a screen filled with unstyled spaces.
+// Negative dimensions are clamped to 0.
+func NewScreen(width, height int) *Screen {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	s := &Screen{
+		width:  width,
+		height: height,
+		cells:  make([]value2.StyledCell, width*height),
+	}
+	s.Clear()
+	return s
+}
+
+// blankCell returns a space drawn with style.
+func blankCell(style value2.CellStyle) value2.StyledCell {
+	return value2.NewStyledCell(value2.NewCell(" ", 1), style)
+}
+
+// isContinuation reports whether c is the trailing column of a wide cell.
+func isContinuation(c value2.StyledCell) bool {
+	return c.Cell().Width() == 0
+}
+
+// Width returns the number of columns.
+func (s *Screen) Width() int {
+	return s.width
+}
+
+// Height returns the number of rows.
+func (s *Screen) Height() int {
+	return s.height
+}
+
+// Clear fills the screen with unstyled spaces.
+func (s *Screen) Clear() {
+	blank := blankCell(value2.CellStyle{})
+	for i := range s.cells {
+		s.cells[i] = blank
+	}
+}
+
+// Clone returns an independent copy of the screen.
+func (s *Screen) Clone() *Screen {
+	c := &Screen{width: s.width, height: s.height, cells: make([]value2.StyledCell, len(s.cells))}
+	copy(c.cells, s.cells)
+	return c
+}
+
+// At returns the cell at (x, y). Continuation columns of a wide cell are
+// returned as zero-width cells; out-of-bounds positions return a blank cell.
+func (s *Screen) At(x, y int) value2.StyledCell {
+	if !s.inBounds(x, y) {
+		return blankCell(value2.CellStyle{})
+	}
+	return s.cells[y*s.width+x]
+}
+
+func (s *Screen) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < s.width && y < s.height
+}
+
+// Set places c at (x, y). A cell of width w covers columns x..x+w-1.
+// Zero-width cells are stored as a space so every column stays visible,
+// and a cell that would extend past the right edge is replaced by a space.
+// Out-of-bounds positions are ignored.
+func (s *Screen) Set(x, y int, c value2.StyledCell) {
+	if !s.inBounds(x, y) {
+		return
+	}
+
+	w := c.Cell().Width()
+	if w <= 0 || x+w > s.width {
+		c = blankCell(c.Style())
+		w = 1
+	}
+
+	row := y * s.width
+	for i := x; i < x+w; i++ {
+		s.detach(row, i)
+	}
+
+	s.cells[row+x] = c
+	cont := value2.NewStyledCell(value2.NewCell("", 0), c.Style())
+	for i := x + 1; i < x+w; i++ {
+		s.cells[row+i] = cont
+	}
+}
+
+// detach blanks the wide cell (if any) covering column col of the row
+// starting at index row, so that col can be overwritten without leaving
+// half a wide character behind.
+func (s *Screen) detach(row, col int) {
+	lead := col
+	for lead > 0 && isContinuation(s.cells[row+lead]) {
+		lead--
+	}
+
+	c := s.cells[row+lead]
+	w := c.Cell().Width()
+	if w <= 1 {
+		return
+	}
+
+	blank := blankCell(c.Style())
+	for i := lead; i < lead+w && i < s.width; i++ {
+		s.cells[row+i] = blank
+	}
+}
+
+// WriteCells places cells left to right starting at (x, y), all drawn
+// with style, and returns the number of columns written. Zero-width
+// cells are skipped. Writing stops at the right edge; a wide cell that
+// does not fit is not written.
+func (s *Screen) WriteCells(x, y int, cells []value2.Cell, style value2.CellStyle) int {
+	if y < 0 || y >= s.height {
+		return 0
+	}
+
+	start := x
+	for _, c := range cells {
+		w := c.Width()
+		if w <= 0 {
+			continue
+		}
+		if x+w > s.width {
+			break
+		}
+		if x >= 0 {
+			s.Set(x, y, value2.NewStyledCell(c, style))
+		}
+		x += w
+	}
+
+	if x < 0 {
+		return 0
+	}
+	if start < 0 {
+		start = 0
+	}
+	return x - start
+}
+
+// Change is a run of cells to write at a position to update a frame.
+type Change struct {
+	x, y  int
+	cells []value2.StyledCell // Continuation columns omitted
+}
+
+// NewChange creates a change writing cells starting at (x, y).
+func NewChange(x, y int, cells []value2.StyledCell) Change {
+	return Change{x: x, y: y, cells: cells}
+}
+
+// X returns the starting column (0-based).
+func (c Change) X() int {
+	return c.x
+}
+
+// Y returns the row (0-based).
+func (c Change) Y() int {
+	return c.y
+}
+
+// Cells returns the cells to write, left to right.
+func (c Change) Cells() []value2.StyledCell {
+	return c.cells
+}
+
+// ANSI returns the cursor move (CUP) followed by the styled cells.
+func (c Change) ANSI() string {
+	var b strings.Builder
+	b.WriteString("\x1b[")
+	b.WriteString(strconv.Itoa(c.y + 1))
+	b.WriteByte(';')
+	b.WriteString(strconv.Itoa(c.x + 1))
+	b.WriteByte('H')
+	writeStyledCells(&b, c.cells)
+	return b.String()
+}
+
+// writeStyledCells writes cells, emitting an SGR sequence only where the
+// style changes, and resets at the end if any style was active.
+func writeStyledCells(b *strings.Builder, cells []value2.StyledCell) {
+	var current value2.CellStyle
+	for _, c := range cells {
+		if isContinuation(c) {
+			continue
+		}
+		if st := c.Style(); !st.Equal(current) {
+			if !current.IsZero() {
+				b.WriteString(sgrReset)
+			}
+			b.WriteString(st.SGR())
+			current = st
+		}
+		b.WriteString(c.Cell().Content())
+	}
+	if !current.IsZero() {
+		b.WriteString(sgrReset)
+	}
+}
+
+// Diff returns the changes that turn prev into s. Nearby changes on a row
+// are merged when the unchanged cells between them are cheaper to rewrite
+// than to skip. A nil prev, or one with different dimensions, yields one
+// change per row (a full redraw).
+func (s *Screen) Diff(prev *Screen) []Change {
+	if prev == nil || prev.width != s.width || prev.height != s.height {
+		changes := make([]Change, 0, s.height)
+		for y := 0; y < s.height; y++ {
+			changes = append(changes, s.change(0, s.width, y))
+		}
+		return changes
+	}
+
+	var changes []Change
+	for y := 0; y < s.height; y++ {
+		row := y * s.width
+		differs := func(i int) bool {
+			return !s.cells[row+i].Equal(prev.cells[row+i])
+		}
+
+		for x := 0; x < s.width; {
+			if !differs(x) {
+				x++
+				continue
+			}
+
+			start := x
+			for start > 0 && isContinuation(s.cells[row+start]) {
+				start--
+			}
+
+			end, gap := x+1, 0
+			for i := x + 1; i < s.width && gap <= mergeGap; i++ {
+				if differs(i) {
+					end, gap = i+1, 0
+				} else {
+					gap++
+				}
+			}
+			for end < s.width && isContinuation(s.cells[row+end]) {
+				end++
+			}
+
+			changes = append(changes, s.change(start, end, y))
+			x = end
+		}
+	}
+	return changes
+}
+
+// change builds a Change for columns [start, end) of row y.
+func (s *Screen) change(start, end, y int) Change {
+	row := y * s.width
+	cells := make([]value2.StyledCell, 0, end-start)
+	for _, c := range s.cells[row+start : row+end] {
+		if !isContinuation(c) {
+			cells = append(cells, c)
+		}
+	}
+	return Change{x: start, y: y, cells: cells}
+}
+
+// String renders the whole screen as styled lines joined by "\n",
+// without cursor movement. Suitable as a frame for line-based renderers.
+func (s *Screen) String() string {
+	var b strings.Builder
+	for y := 0; y < s.height; y++ {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		row := y * s.width
+		writeStyledCells(&b, s.cells[row:row+s.width])
+	}
+	return b.String()
+}
@@ -0,0 +1,147 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+func cell(content string, width int) value.StyledCell {
+	return value.NewStyledCell(value.NewCell(content, width), value.CellStyle{})
+}
+
+func row(s *model.Screen, y int) string {
+	out := ""
+	for x := 0; x < s.Width(); x++ {
+		out += s.At(x, y).Cell().Content()
+	}
+	return out
+}
+
+func TestNewScreen(t *testing.T) {
+	s := model.NewScreen(3, 2)
+	if s.Width() != 3 || s.Height() != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", s.Width(), s.Height())
+	}
+	if got := s.String(); got != "   \n   " {
+		t.Errorf("String() = %q, want blank 3x2", got)
+	}
+
+	if s := model.NewScreen(-1, -1); s.Width() != 0 || s.Height() != 0 {
+		t.Errorf("negative size not clamped: %dx%d", s.Width(), s.Height())
+	}
+}
+
+func TestScreen_SetWide(t *testing.T) {
+	s := model.NewScreen(4, 1)
+
+	s.Set(1, 0, cell("中", 2))
+	if got := row(s, 0); got != " 中 " {
+		t.Errorf("row = %q, want %q", got, " 中 ")
+	}
+	if !s.At(2, 0).Cell().Equal(value.NewCell("", 0)) {
+		t.Errorf("column 2 should be a continuation")
+	}
+
+	// Overwriting the continuation blanks the lead.
+	s.Set(2, 0, cell("x", 1))
+	if got := row(s, 0); got != "  x " {
+		t.Errorf("after overwriting continuation row = %q, want %q", got, "  x ")
+	}
+
+	// A wide cell that does not fit at the right edge becomes a space.
+	s.Set(3, 0, cell("中", 2))
+	if got := row(s, 0); got != "  x " {
+		t.Errorf("wide cell at edge row = %q, want %q", got, "  x ")
+	}
+
+	// Out of bounds is ignored.
+	s.Set(10, 10, cell("y", 1))
+}
+
+func TestScreen_WriteCells(t *testing.T) {
+	s := model.NewScreen(5, 1)
+	cells := []value.Cell{value.NewCell("a", 1), value.NewCell("中", 2), value.NewCell("b", 1), value.NewCell("c", 1)}
+
+	if n := s.WriteCells(0, 0, cells, value.CellStyle{}); n != 5 {
+		t.Errorf("WriteCells() = %d, want 5", n)
+	}
+	if got := row(s, 0); got != "a中bc" {
+		t.Errorf("row = %q, want %q", got, "a中bc")
+	}
+
+	// Wide cell that would cross the edge stops the write.
+	s.Clear()
+	if n := s.WriteCells(3, 0, cells, value.CellStyle{}); n != 1 {
+		t.Errorf("WriteCells() at edge = %d, want 1", n)
+	}
+}
+
+func TestScreen_Diff(t *testing.T) {
+	prev := model.NewScreen(20, 2)
+	cur := prev.Clone()
+
+	if changes := cur.Diff(prev); len(changes) != 0 {
+		t.Fatalf("identical screens: got %d changes, want 0", len(changes))
+	}
+
+	cur.Set(2, 0, cell("a", 1))
+	cur.Set(4, 0, cell("b", 1))  // Gap of 1 - merged with previous
+	cur.Set(15, 0, cell("c", 1)) // Far away - separate change
+	cur.Set(0, 1, cell("中", 2))
+
+	changes := cur.Diff(prev)
+	want := []struct {
+		x, y int
+		ansi string
+	}{
+		{2, 0, "\x1b[1;3Ha b"},
+		{15, 0, "\x1b[1;16Hc"},
+		{0, 1, "\x1b[2;1H中"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d", len(changes), len(want))
+	}
+	for i, w := range want {
+		c := changes[i]
+		if c.X() != w.x || c.Y() != w.y || c.ANSI() != w.ansi {
+			t.Errorf("change %d = (%d,%d,%q), want (%d,%d,%q)", i, c.X(), c.Y(), c.ANSI(), w.x, w.y, w.ansi)
+		}
+	}
+}
+
+func TestScreen_DiffWideBoundary(t *testing.T) {
+	prev := model.NewScreen(4, 1)
+	prev.Set(0, 0, cell("中", 2))
+
+	// Replacing the trailing half must rewrite the blanked lead too.
+	cur := prev.Clone()
+	cur.Set(1, 0, cell("x", 1))
+
+	changes := cur.Diff(prev)
+	if len(changes) != 1 || changes[0].ANSI() != "\x1b[1;1H x" {
+		t.Fatalf("changes = %+v, want a single rewrite of columns 0-1", changes)
+	}
+}
+
+func TestScreen_DiffResize(t *testing.T) {
+	cur := model.NewScreen(2, 3)
+	if got := len(cur.Diff(nil)); got != 3 {
+		t.Errorf("Diff(nil) = %d changes, want one per row", got)
+	}
+	if got := len(cur.Diff(model.NewScreen(3, 3))); got != 3 {
+		t.Errorf("Diff(resized) = %d changes, want one per row", got)
+	}
+}
+
+func TestScreen_StyledOutput(t *testing.T) {
+	bold := value.CellStyle{}.WithAttribute(value.AttrBold, true)
+	s := model.NewScreen(4, 1)
+	s.WriteCells(0, 0, []value.Cell{value.NewCell("a", 1), value.NewCell("b", 1)}, bold)
+
+	// One SGR for the run, one reset before the unstyled tail.
+	if got, want := s.String(), "\x1b[1mab\x1b[0m  "; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// ClusterLen returns the length in bytes of the grapheme cluster at the
+// start of s. It covers the cases that matter for terminal width: combining
+// marks, variation selectors, emoji modifiers and tags, ZWJ sequences and
+// regional indicator pairs (flags).
+func ClusterLen(s string) int {
+	first, n := utf8.DecodeRuneInString(s)
+	if n == 0 {
+		return 0
+	}
+
+	joined := false // Previous rune was a ZWJ
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		switch {
+		case joined:
+			joined = false
+		case r == '\u200D':
+			joined = true
+		case isRegionalIndicator(first) && isRegionalIndicator(r) && n == utf8.RuneLen(first):
+			// Second half of a flag.
+		case isExtender(r):
+		default:
+			return n
+		}
+		n += size
+	}
+	return n
+}
+
+// isExtender reports whether r extends the preceding grapheme cluster.
+func isExtender(r rune) bool {
+	switch {
+	case r >= 0xFE00 && r <= 0xFE0F, r >= 0xE0100 && r <= 0xE01EF: // Variation selectors
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF: // Emoji skin tone modifiers
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // Emoji tag sequences
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// isRegionalIndicator reports whether r is a regional indicator symbol.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
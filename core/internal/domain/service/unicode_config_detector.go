@@ -0,0 +1,65 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+// DetectUnicodeConfig derives the East Asian Ambiguous width from the
+// environment.
+//
+// Detection Priority:
+//  1. RUNEWIDTH_EASTASIAN → "1" wide, "0" narrow (go-runewidth convention)
+//  2. Locale (LC_ALL, then LC_CTYPE, then LANG) → Japanese, Chinese and
+//     Korean locales are wide unless they carry the @cjk_narrow modifier
+//  3. Narrow
+//
+// Example:
+//
+//	// LANG=ja_JP.UTF-8
+//	DetectUnicodeConfig(env).IsEastAsianWide() // true
+func DetectUnicodeConfig(env EnvironmentProvider) value.UnicodeConfig {
+	config := value.NewUnicodeConfig()
+
+	switch env.Get("RUNEWIDTH_EASTASIAN") {
+	case "1":
+		return config.WithEastAsianWide()
+	case "0":
+		return config
+	}
+
+	locale := env.Get("LC_ALL")
+	if locale == "" {
+		locale = env.Get("LC_CTYPE")
+	}
+	if locale == "" {
+		locale = env.Get("LANG")
+	}
+
+	if isEastAsianLocale(locale) {
+		return config.WithEastAsianWide()
+	}
+	return config
+}
+
+// isEastAsianLocale reports whether a POSIX locale name
+// (language[_territory][.codeset][@modifier]) is Chinese, Japanese or Korean.
+func isEastAsianLocale(locale string) bool {
+	locale = strings.ToLower(locale)
+	if strings.HasSuffix(locale, "@cjk_narrow") {
+		return false
+	}
+
+	lang := locale
+	if i := strings.IndexAny(lang, "_.@-"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	switch lang {
+	case "ja", "zh", "ko":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+func TestDetectUnicodeConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		vars     map[string]string
+		wantWide bool
+	}{
+		{"empty environment", nil, false},
+		{"english locale", map[string]string{"LANG": "en_US.UTF-8"}, false},
+		{"japanese locale", map[string]string{"LANG": "ja_JP.UTF-8"}, true},
+		{"chinese locale", map[string]string{"LANG": "zh_CN.GB18030"}, true},
+		{"korean bare language", map[string]string{"LANG": "ko"}, true},
+		{"cjk_narrow modifier", map[string]string{"LANG": "ja_JP.UTF-8@cjk_narrow"}, false},
+		{"LC_ALL overrides LANG", map[string]string{"LC_ALL": "C", "LANG": "ja_JP.UTF-8"}, false},
+		{"LC_CTYPE overrides LANG", map[string]string{"LC_CTYPE": "zh_TW.UTF-8", "LANG": "en_US.UTF-8"}, true},
+		{"RUNEWIDTH_EASTASIAN=1", map[string]string{"RUNEWIDTH_EASTASIAN": "1", "LANG": "en_US.UTF-8"}, true},
+		{"RUNEWIDTH_EASTASIAN=0", map[string]string{"RUNEWIDTH_EASTASIAN": "0", "LANG": "ja_JP.UTF-8"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := NewMockEnvironment("linux")
+			for k, v := range tt.vars {
+				env.Set(k, v)
+			}
+
+			if got := service.DetectUnicodeConfig(env).IsEastAsianWide(); got != tt.wantWide {
+				t.Errorf("IsEastAsianWide() = %v, want %v", got, tt.wantWide)
+			}
+		})
+	}
+}
+
+func TestUnicodeServiceWithConfig(t *testing.T) {
+	wide := service.NewUnicodeServiceWithConfig(value.NewUnicodeConfig().WithEastAsianWide())
+	narrow := service.NewUnicodeService()
+
+	if got := wide.StringWidth("±°"); got != 4 {
+		t.Errorf("wide StringWidth(\"±°\") = %d, want 4", got)
+	}
+	if got := narrow.StringWidth("±°"); got != 2 {
+		t.Errorf("narrow StringWidth(\"±°\") = %d, want 2", got)
+	}
+	if got := wide.ClusterWidth("±"); got != 2 {
+		t.Errorf("wide ClusterWidth(\"±\") = %d, want 2", got)
+	}
+	if got := wide.PadRight("±", 4); got != "±  " {
+		t.Errorf("wide PadRight(\"±\", 4) = %q, want %q", got, "±  ")
+	}
+	// Unambiguous characters are unaffected.
+	if got := wide.StringWidth("a中👋"); got != 5 {
+		t.Errorf("wide StringWidth(\"a中👋\") = %d, want 5", got)
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"strings"
 	"unicode"
 
 	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
@@ -13,11 +14,30 @@ import (
 //
 // This service fixes Charm's lipgloss#562 bug by correctly calculating
 // visual width of grapheme clusters including emoji, CJK, and combining chars.
-type UnicodeService struct{}
+type UnicodeService struct {
+	config value.UnicodeConfig // Applied by StringWidth, ClusterWidth and everything built on them
+}
 
-// NewUnicodeService creates a new Unicode service instance.
+// NewUnicodeService creates a new Unicode service instance with the default
+// configuration (East Asian Ambiguous characters are narrow).
 func NewUnicodeService() *UnicodeService {
-	return &UnicodeService{}
+	return &UnicodeService{config: value.NewUnicodeConfig()}
+}
+
+// NewUnicodeServiceWithConfig creates a Unicode service whose StringWidth,
+// ClusterWidth, Truncate, Pad and Cells all use config.
+//
+// Example:
+//
+//	us := NewUnicodeServiceWithConfig(value.NewUnicodeConfig().WithEastAsianWide())
+//	us.StringWidth("±") // 2
+func NewUnicodeServiceWithConfig(config value.UnicodeConfig) *UnicodeService {
+	return &UnicodeService{config: config}
+}
+
+// Config returns the configuration this service measures with.
+func (us *UnicodeService) Config() value.UnicodeConfig {
+	return us.config
 }
 
 // StringWidth calculates the visual width of a string in terminal columns.
@@ -42,6 +62,9 @@ func (us *UnicodeService) StringWidth(s string) int {
 	if s == "" {
 		return 0
 	}
+	if us.config.IsEastAsianWide() {
+		return us.StringWidthWithConfig(s, us.config)
+	}
 	return uniwidth.StringWidth(s)
 }
 
@@ -71,6 +94,9 @@ func (us *UnicodeService) ClusterWidth(cluster string) int {
 	if cluster == "" {
 		return 0
 	}
+	if us.config.IsEastAsianWide() {
+		return us.ClusterWidthWithConfig(cluster, us.config)
+	}
 	return uniwidth.StringWidth(cluster)
 }
 
@@ -258,3 +284,87 @@ func (us *UnicodeService) ClusterWidthWithConfig(cluster string, config value.Un
 
 	return width
 }
+
+// Truncate cuts s to at most width columns and appends tail, which counts
+// towards the width. Grapheme clusters are never split: if a wide cluster
+// would straddle the cut, it is dropped and a space takes its place, so the
+// result is exactly width columns wide. s is returned unchanged if it fits.
+//
+// Example:
+//
+//	Truncate("Hello, World!", 8, "…") // "Hello, …"
+//	Truncate("中文字符", 5, "")         // "中文 " (third char would straddle)
+func (us *UnicodeService) Truncate(s string, width int, tail string) string {
+	if width <= 0 {
+		return ""
+	}
+	if us.StringWidth(s) <= width {
+		return s
+	}
+
+	tailWidth := us.StringWidth(tail)
+	if tailWidth > width {
+		return us.Truncate(tail, width, "")
+	}
+	budget := width - tailWidth
+
+	used := 0
+	end := 0
+	for end < len(s) {
+		n := ClusterLen(s[end:])
+		w := us.ClusterWidth(s[end : end+n])
+		if used+w > budget {
+			break
+		}
+		used += w
+		end += n
+	}
+
+	return s[:end] + strings.Repeat(" ", budget-used) + tail
+}
+
+// PadRight appends spaces to s until it is width columns wide, like
+// fmt's %-*s but measured in columns rather than runes. s is returned
+// unchanged if it is already width columns or wider.
+//
+// Example:
+//
+//	PadRight("中文", 6) // "中文  "
+func (us *UnicodeService) PadRight(s string, width int) string {
+	if pad := width - us.StringWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// PadLeft prepends spaces to s until it is width columns wide, like
+// fmt's %*s but measured in columns rather than runes. s is returned
+// unchanged if it is already width columns or wider.
+//
+// Example:
+//
+//	PadLeft("中文", 6) // "  中文"
+func (us *UnicodeService) PadLeft(s string, width int) string {
+	if pad := width - us.StringWidth(s); pad > 0 {
+		return strings.Repeat(" ", pad) + s
+	}
+	return s
+}
+
+// Cells splits s into grapheme clusters, one Cell per cluster, each with
+// its display width. Zero-width clusters (e.g. a lone combining mark) are
+// returned with width 0; callers laying out a grid decide how to place them.
+//
+// Example:
+//
+//	Cells("a中👋🏻") // ["a" w1, "中" w2, "👋🏻" w2]
+func (us *UnicodeService) Cells(s string) []value.Cell {
+	cells := make([]value.Cell, 0, len(s))
+	for i := 0; i < len(s); {
+		n := ClusterLen(s[i:])
+		cluster := s[i : i+n]
+		cells = append(cells, value.NewCell(cluster, us.ClusterWidth(cluster)))
+		i += n
+	}
+	return cells
+}
@@ -0,0 +1,88 @@
+package service
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	us := NewUnicodeService()
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		tail  string
+		want  string
+	}{
+		{"Fits", "Hello", 5, "…", "Hello"},
+		{"ASCII", "Hello, World!", 8, "…", "Hello, …"},
+		{"No tail", "Hello, World!", 5, "", "Hello"},
+		{"Wide straddles cut", "中文字符", 5, "", "中文 "},
+		{"Wide with tail", "中文字符", 6, "…", "中文 …"},
+		{"Emoji kept whole", "🔥🔥🔥", 3, "", "🔥 "},
+		{"ZWJ family kept whole", "👨‍👩‍👧ab", 2, "", "👨‍👩‍👧"},
+		{"Combining mark kept", "ééé", 2, "", "éé"},
+		{"Flag kept whole", "🇯🇵🇯🇵", 3, "", "🇯🇵 "},
+		{"Tail wider than width", "Hello", 1, "...", "."},
+		{"Zero width", "Hello", 0, "…", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := us.Truncate(tt.s, tt.width, tt.tail)
+			if got != tt.want {
+				t.Errorf("Truncate(%q, %d, %q) = %q, want %q", tt.s, tt.width, tt.tail, got, tt.want)
+			}
+			if tt.width > 0 && us.StringWidth(tt.s) > tt.width && us.StringWidth(got) != tt.width {
+				t.Errorf("Truncate(%q, %d, %q) width = %d, want %d", tt.s, tt.width, tt.tail, us.StringWidth(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestPadRightPadLeft(t *testing.T) {
+	us := NewUnicodeService()
+	tests := []struct {
+		s         string
+		width     int
+		wantRight string
+		wantLeft  string
+	}{
+		{"ab", 4, "ab  ", "  ab"},
+		{"中文", 6, "中文  ", "  中文"},
+		{"🔥", 3, "🔥 ", " 🔥"},
+		{"Café", 5, "Café ", " Café"},
+		{"too long", 3, "too long", "too long"},
+		{"", 2, "  ", "  "},
+	}
+
+	for _, tt := range tests {
+		if got := us.PadRight(tt.s, tt.width); got != tt.wantRight {
+			t.Errorf("PadRight(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.wantRight)
+		}
+		if got := us.PadLeft(tt.s, tt.width); got != tt.wantLeft {
+			t.Errorf("PadLeft(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.wantLeft)
+		}
+	}
+}
+
+func TestCells(t *testing.T) {
+	us := NewUnicodeService()
+
+	got := us.Cells("a中👋🏻é")
+	want := []struct {
+		content string
+		width   int
+	}{
+		{"a", 1},
+		{"中", 2},
+		{"👋🏻", 2},
+		{"é", 1},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Cells() returned %d cells, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Content() != w.content || got[i].Width() != w.width {
+			t.Errorf("cell %d = (%q, %d), want (%q, %d)", i, got[i].Content(), got[i].Width(), w.content, w.width)
+		}
+	}
+}
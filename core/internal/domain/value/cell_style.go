@@ -0,0 +1,134 @@
+package value
+
+import "strings"
+
+// Attribute is a bit set of SGR text attributes.
+type Attribute uint16
+
+const (
+	// AttrBold renders text bold (SGR 1).
+	AttrBold Attribute = 1 << iota
+
+	// AttrDim renders text faint (SGR 2).
+	AttrDim
+
+	// AttrItalic renders text italic (SGR 3).
+	AttrItalic
+
+	// AttrUnderline underlines text (SGR 4).
+	AttrUnderline
+
+	// AttrBlink makes text blink (SGR 5).
+	AttrBlink
+
+	// AttrReverse swaps foreground and background (SGR 7).
+	AttrReverse
+
+	// AttrHidden hides text (SGR 8).
+	AttrHidden
+
+	// AttrStrikethrough strikes text through (SGR 9).
+	AttrStrikethrough
+)
+
+// attributeCodes maps each attribute to its SGR parameter, in emission order.
+var attributeCodes = []struct {
+	attr Attribute
+	code string
+}{
+	{AttrBold, "1"},
+	{AttrDim, "2"},
+	{AttrItalic, "3"},
+	{AttrUnderline, "4"},
+	{AttrBlink, "5"},
+	{AttrReverse, "7"},
+	{AttrHidden, "8"},
+	{AttrStrikethrough, "9"},
+}
+
+// CellStyle holds the foreground, background and text attributes of a cell.
+// This is an immutable value object.
+//
+// The zero value is unstyled: default colors and no attributes.
+type CellStyle struct {
+	fg    Color
+	bg    Color
+	attrs Attribute
+}
+
+// NewCellStyle creates a style from colors and attributes.
+func NewCellStyle(fg, bg Color, attrs Attribute) CellStyle {
+	return CellStyle{fg: fg, bg: bg, attrs: attrs}
+}
+
+// Foreground returns the foreground color.
+func (s CellStyle) Foreground() Color {
+	return s.fg
+}
+
+// Background returns the background color.
+func (s CellStyle) Background() Color {
+	return s.bg
+}
+
+// Attributes returns the attribute bit set.
+func (s CellStyle) Attributes() Attribute {
+	return s.attrs
+}
+
+// Has returns true if all attributes in attr are set.
+func (s CellStyle) Has(attr Attribute) bool {
+	return s.attrs&attr == attr
+}
+
+// WithForeground returns a new style with the given foreground color.
+func (s CellStyle) WithForeground(c Color) CellStyle {
+	s.fg = c
+	return s
+}
+
+// WithBackground returns a new style with the given background color.
+func (s CellStyle) WithBackground(c Color) CellStyle {
+	s.bg = c
+	return s
+}
+
+// WithAttribute returns a new style with attr set or cleared.
+func (s CellStyle) WithAttribute(attr Attribute, enabled bool) CellStyle {
+	if enabled {
+		s.attrs |= attr
+	} else {
+		s.attrs &^= attr
+	}
+	return s
+}
+
+// IsZero returns true if the style has default colors and no attributes.
+func (s CellStyle) IsZero() bool {
+	return s == CellStyle{}
+}
+
+// Equal returns true if both styles are identical.
+func (s CellStyle) Equal(other CellStyle) bool {
+	return s == other
+}
+
+// SGR returns the escape sequence that applies this style, combining all
+// attributes and both colors into a single sequence (e.g. "\x1b[1;31;44m").
+// Returns "" for the zero style.
+func (s CellStyle) SGR() string {
+	if s.IsZero() {
+		return ""
+	}
+
+	params := make([]string, 0, 8)
+	for _, ac := range attributeCodes {
+		if s.attrs&ac.attr != 0 {
+			params = append(params, ac.code)
+		}
+	}
+	params = s.fg.appendSGR(params, false)
+	params = s.bg.appendSGR(params, true)
+
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
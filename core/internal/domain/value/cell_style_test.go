@@ -0,0 +1,97 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+func TestCellStyle_SGR(t *testing.T) {
+	tests := []struct {
+		name  string
+		style value.CellStyle
+		want  string
+	}{
+		{
+			name:  "zero style",
+			style: value.CellStyle{},
+			want:  "",
+		},
+		{
+			name:  "16-color foreground",
+			style: value.CellStyle{}.WithForeground(value.NewColor16(1)),
+			want:  "\x1b[31m",
+		},
+		{
+			name:  "bright 16-color background",
+			style: value.CellStyle{}.WithBackground(value.NewColor16(12)),
+			want:  "\x1b[104m",
+		},
+		{
+			name:  "256-color",
+			style: value.CellStyle{}.WithForeground(value.NewColor256(196)),
+			want:  "\x1b[38;5;196m",
+		},
+		{
+			name:  "true color background",
+			style: value.CellStyle{}.WithBackground(value.NewColorRGB(10, 20, 30)),
+			want:  "\x1b[48;2;10;20;30m",
+		},
+		{
+			name: "attributes and both colors combined",
+			style: value.NewCellStyle(
+				value.NewColor16(3),
+				value.NewColor16(4),
+				value.AttrUnderline|value.AttrBold,
+			),
+			want: "\x1b[1;4;33;44m",
+		},
+		{
+			name:  "all attributes",
+			style: value.NewCellStyle(value.Color{}, value.Color{}, 0xFF),
+			want:  "\x1b[1;2;3;4;5;7;8;9m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.SGR(); got != tt.want {
+				t.Errorf("SGR() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCellStyle_WithAttribute(t *testing.T) {
+	s := value.CellStyle{}.WithAttribute(value.AttrBold, true).WithAttribute(value.AttrItalic, true)
+	if !s.Has(value.AttrBold | value.AttrItalic) {
+		t.Errorf("Has(bold|italic) = false, want true")
+	}
+
+	s = s.WithAttribute(value.AttrBold, false)
+	if s.Has(value.AttrBold) {
+		t.Errorf("Has(bold) after clearing = true, want false")
+	}
+	if !s.Has(value.AttrItalic) {
+		t.Errorf("clearing bold also cleared italic")
+	}
+
+	if s.WithAttribute(value.AttrItalic, false).IsZero() != true {
+		t.Errorf("IsZero() = false after clearing every attribute")
+	}
+}
+
+func TestColor(t *testing.T) {
+	if !(value.Color{}).IsDefault() {
+		t.Errorf("zero Color should be the default color")
+	}
+	if got := value.NewColor16(200); !got.Equal(value.NewColor16(15)) {
+		t.Errorf("NewColor16(200) should clamp to 15")
+	}
+	if value.NewColor16(1).Equal(value.NewColor256(1)) {
+		t.Errorf("16-color and 256-color with the same index should differ")
+	}
+	if got := value.NewColorRGB(1, 2, 3).Mode(); got != value.ColorModeRGB {
+		t.Errorf("Mode() = %v, want ColorModeRGB", got)
+	}
+}
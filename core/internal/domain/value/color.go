@@ -0,0 +1,98 @@
+package value
+
+import "strconv"
+
+// ColorMode identifies how a Color is encoded in an SGR sequence.
+type ColorMode uint8
+
+const (
+	// ColorModeDefault is the terminal's default color (no SGR parameter).
+	ColorModeDefault ColorMode = iota
+
+	// ColorMode16 is one of the 16 standard ANSI colors (30-37, 90-97).
+	ColorMode16
+
+	// ColorMode256 is an index into the 256-color palette (38;5;n).
+	ColorMode256
+
+	// ColorModeRGB is a 24-bit true color (38;2;r;g;b).
+	ColorModeRGB
+)
+
+// Color is a terminal color for a single cell (immutable value object).
+//
+// The zero value is the terminal's default color.
+//
+// Invariants:
+//   - ColorMode16 index is in 0-15
+//   - Color is immutable after creation
+type Color struct {
+	mode    ColorMode
+	index   uint8 // Palette index for ColorMode16 and ColorMode256
+	r, g, b uint8 // Components for ColorModeRGB
+}
+
+// NewColor16 creates a standard ANSI color (0-7 normal, 8-15 bright).
+// Indexes above 15 are clamped to 15.
+func NewColor16(index uint8) Color {
+	if index > 15 {
+		index = 15
+	}
+	return Color{mode: ColorMode16, index: index}
+}
+
+// NewColor256 creates a 256-color palette color.
+func NewColor256(index uint8) Color {
+	return Color{mode: ColorMode256, index: index}
+}
+
+// NewColorRGB creates a 24-bit true color.
+func NewColorRGB(r, g, b uint8) Color {
+	return Color{mode: ColorModeRGB, r: r, g: g, b: b}
+}
+
+// Mode returns how the color is encoded.
+func (c Color) Mode() ColorMode {
+	return c.mode
+}
+
+// IsDefault returns true if this is the terminal's default color.
+func (c Color) IsDefault() bool {
+	return c.mode == ColorModeDefault
+}
+
+// Equal returns true if both colors have the same mode and value.
+func (c Color) Equal(other Color) bool {
+	return c == other
+}
+
+// appendSGR appends the SGR parameters selecting c as foreground (or
+// background when bg is true). Nothing is appended for the default color.
+func (c Color) appendSGR(params []string, bg bool) []string {
+	switch c.mode {
+	case ColorMode16:
+		base := 30
+		if bg {
+			base = 40
+		}
+		if c.index >= 8 {
+			base += 60 // Bright variants: 90-97 / 100-107
+		}
+		return append(params, strconv.Itoa(base+int(c.index%8)))
+	case ColorMode256:
+		prefix := "38"
+		if bg {
+			prefix = "48"
+		}
+		return append(params, prefix, "5", strconv.Itoa(int(c.index)))
+	case ColorModeRGB:
+		prefix := "38"
+		if bg {
+			prefix = "48"
+		}
+		return append(params, prefix, "2",
+			strconv.Itoa(int(c.r)), strconv.Itoa(int(c.g)), strconv.Itoa(int(c.b)))
+	default:
+		return params
+	}
+}
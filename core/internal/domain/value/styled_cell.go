@@ -0,0 +1,28 @@
+package value
+
+// StyledCell is a Cell together with the CellStyle it is drawn with.
+// This is an immutable value object.
+type StyledCell struct {
+	cell  Cell
+	style CellStyle
+}
+
+// NewStyledCell creates a styled cell.
+func NewStyledCell(cell Cell, style CellStyle) StyledCell {
+	return StyledCell{cell: cell, style: style}
+}
+
+// Cell returns the content and width.
+func (sc StyledCell) Cell() Cell {
+	return sc.cell
+}
+
+// Style returns the style.
+func (sc StyledCell) Style() CellStyle {
+	return sc.style
+}
+
+// Equal returns true if content, width and style are all equal.
+func (sc StyledCell) Equal(other StyledCell) bool {
+	return sc.cell.Equal(other.cell) && sc.style.Equal(other.style)
+}
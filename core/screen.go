@@ -0,0 +1,151 @@
+package core
+
+import (
+	"strings"
+
+	model2 "github.com/phoenix-tui/phoenix/core/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/core/internal/domain/value"
+)
+
+// Screen is a fixed-size frame buffer of styled cells with diff rendering.
+//
+// Draw a frame with Set and WriteString, then call Diff (or Render) against
+// the previous frame to get the minimal cursor-move + write operations.
+// Wide characters (CJK, emoji) occupy two columns; overwriting either half
+// of one blanks the other half, so frames never contain split glyphs.
+//
+// Zero value: Screen with zero value has nil internal state and will panic if used.
+// Always use NewScreen() to create a valid Screen.
+//
+// Thread safety: Screen is not safe for concurrent use.
+//
+// Example:
+//
+//	prev := core.NewScreen(80, 24)
+//	cur := prev.Clone()
+//	cur.WriteString(2, 1, "Hello 👋", core.CellStyle{}.Bold(true))
+//	os.Stdout.WriteString(cur.Render(prev)) // Only row 1 is written
+//	prev = cur
+type Screen struct {
+	domain *model2.Screen
+}
+
+// NewScreen creates a screen of width columns and height rows filled with
+// unstyled spaces. Negative dimensions are clamped to 0.
+func NewScreen(width, height int) *Screen {
+	return &Screen{domain: model2.NewScreen(width, height)}
+}
+
+// Width returns the number of columns.
+func (s *Screen) Width() int {
+	return s.domain.Width()
+}
+
+// Height returns the number of rows.
+func (s *Screen) Height() int {
+	return s.domain.Height()
+}
+
+// Clear fills the screen with unstyled spaces.
+func (s *Screen) Clear() {
+	s.domain.Clear()
+}
+
+// Clone returns an independent copy, typically used to keep the previous
+// frame for Diff.
+func (s *Screen) Clone() *Screen {
+	return &Screen{domain: s.domain.Clone()}
+}
+
+// Set places cell at column x, row y (0-based). A wide cell also covers the
+// column to its right. Zero-width cells are stored as a space, a cell that
+// would cross the right edge is replaced by a space, and out-of-bounds
+// positions are ignored.
+func (s *Screen) Set(x, y int, cell StyledCell) {
+	s.domain.Set(x, y, cell.toDomain())
+}
+
+// Get returns the cell at column x, row y. The right half of a wide cell is
+// returned with empty content and width 0.
+func (s *Screen) Get(x, y int) StyledCell {
+	return styledCellFromDomain(s.domain.At(x, y))
+}
+
+// WriteString writes text starting at column x, row y with the given style,
+// one grapheme cluster per cell, and returns the number of columns written.
+// Text is clipped at the right edge without splitting wide characters.
+// Newlines and escape sequences are not interpreted.
+//
+// Example:
+//
+//	n := screen.WriteString(0, 0, "中文", core.CellStyle{}) // n == 4
+func (s *Screen) WriteString(x, y int, text string, style CellStyle) int {
+	return s.domain.WriteCells(x, y, unicodeSvc.Load().Cells(text), style.domain)
+}
+
+// Diff returns the changes that transform prev into s. Nearby changes on
+// the same row are merged when rewriting the cells between them is cheaper
+// than another cursor move. A nil prev or one of a different size yields a
+// full redraw (one change per row).
+func (s *Screen) Diff(prev *Screen) []Change {
+	var prevDomain *model2.Screen
+	if prev != nil {
+		prevDomain = prev.domain
+	}
+
+	domainChanges := s.domain.Diff(prevDomain)
+	changes := make([]Change, len(domainChanges))
+	for i, dc := range domainChanges {
+		cells := make([]StyledCell, len(dc.Cells()))
+		for j, c := range dc.Cells() {
+			cells[j] = styledCellFromDomain(c)
+		}
+		changes[i] = Change{X: dc.X(), Y: dc.Y(), Cells: cells}
+	}
+	return changes
+}
+
+// Render returns the escape sequences that update a terminal showing prev
+// so that it shows s. It is the concatenation of Diff(prev) ANSI output.
+func (s *Screen) Render(prev *Screen) string {
+	var b strings.Builder
+	for _, c := range s.Diff(prev) {
+		b.WriteString(c.ANSI())
+	}
+	return b.String()
+}
+
+// String returns the whole screen as styled lines joined by "\n", without
+// cursor movement. Use it as the View of a line-based renderer.
+func (s *Screen) String() string {
+	return s.domain.String()
+}
+
+// Change is a run of cells written left to right starting at column X, row Y
+// (0-based). The right halves of wide cells are not included.
+type Change struct {
+	X     int
+	Y     int
+	Cells []StyledCell
+}
+
+// ANSI returns a cursor move to (X, Y) followed by the cells, emitting a
+// style sequence only where the style changes between cells.
+func (c Change) ANSI() string {
+	cells := make([]value2.StyledCell, len(c.Cells))
+	for i, sc := range c.Cells {
+		cells[i] = sc.toDomain()
+	}
+	return model2.NewChange(c.X, c.Y, cells).ANSI()
+}
+
+func (sc StyledCell) toDomain() value2.StyledCell {
+	return value2.NewStyledCell(value2.NewCell(sc.Content, sc.Width), sc.Style.domain)
+}
+
+func styledCellFromDomain(c value2.StyledCell) StyledCell {
+	return StyledCell{
+		Cell:  Cell{Content: c.Cell().Content(), Width: c.Cell().Width()},
+		Style: CellStyle{domain: c.Style()},
+	}
+}
@@ -0,0 +1,64 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+func TestScreen_WriteStringAndGet(t *testing.T) {
+	s := core.NewScreen(6, 2)
+
+	if n := s.WriteString(0, 0, "a中b", core.CellStyle{}); n != 4 {
+		t.Errorf("WriteString() = %d, want 4", n)
+	}
+	if got := s.Get(1, 0); got.Content != "中" || got.Width != 2 {
+		t.Errorf("Get(1, 0) = %+v, want 中 width 2", got.Cell)
+	}
+	if got := s.Get(2, 0); got.Content != "" || got.Width != 0 {
+		t.Errorf("Get(2, 0) = %+v, want continuation", got.Cell)
+	}
+
+	// Clipped at the right edge without splitting a wide character.
+	if n := s.WriteString(3, 1, "xy中", core.CellStyle{}); n != 2 {
+		t.Errorf("WriteString() at edge = %d, want 2", n)
+	}
+}
+
+func TestScreen_Render(t *testing.T) {
+	prev := core.NewScreen(10, 3)
+	cur := prev.Clone()
+
+	bold := core.CellStyle{}.Bold(true)
+	cur.WriteString(1, 1, "Hi", bold)
+	cur.Set(8, 2, core.NewStyledCell("👋", core.CellStyle{}))
+
+	changes := cur.Diff(prev)
+	if len(changes) != 2 {
+		t.Fatalf("Diff() = %d changes, want 2", len(changes))
+	}
+	if c := changes[0]; c.X != 1 || c.Y != 1 || len(c.Cells) != 2 {
+		t.Errorf("changes[0] = %+v, want 2 cells at (1, 1)", c)
+	}
+
+	want := "\x1b[2;2H\x1b[1mHi\x1b[0m" + "\x1b[3;9H👋"
+	if got := cur.Render(prev); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	if got := cur.Render(cur.Clone()); got != "" {
+		t.Errorf("Render() of identical frame = %q, want empty", got)
+	}
+}
+
+func TestScreen_RenderFull(t *testing.T) {
+	s := core.NewScreen(2, 2)
+	s.WriteString(0, 0, "ab", core.CellStyle{})
+
+	if got, want := s.Render(nil), "\x1b[1;1Hab\x1b[2;1H  "; got != want {
+		t.Errorf("Render(nil) = %q, want %q", got, want)
+	}
+	if got, want := s.String(), "ab\n  "; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
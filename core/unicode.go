@@ -1,11 +1,59 @@
 package core
 
 import (
+	"sync/atomic"
+
 	"github.com/phoenix-tui/phoenix/core/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/core/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/core/internal/infrastructure/platform"
 )
 
 // Unicode service instance (package-level singleton for performance).
-var unicodeSvc = service.NewUnicodeService()
+// Replaced atomically by SetAmbiguousWidth; initialized from the locale.
+var unicodeSvc atomic.Pointer[service.UnicodeService]
+
+func init() {
+	config := service.DetectUnicodeConfig(platform.OsEnvironmentProvider{})
+	unicodeSvc.Store(service.NewUnicodeServiceWithConfig(config))
+}
+
+// SetAmbiguousWidth sets how many cells East Asian Ambiguous characters
+// (±, °, ×, §, Greek and Cyrillic letters, some box-drawing and symbols)
+// occupy: 1 (narrow) or 2 (wide). Values below 2 mean narrow, 2 and above
+// mean wide.
+//
+// StringWidth, Truncate, PadRight, PadLeft, NewCellAuto, NewStyledCell and
+// Screen.WriteString all follow this setting, which must match how the
+// terminal actually renders these characters or columns will misalign.
+//
+// The initial value is detected from the environment: 2 for Japanese,
+// Chinese and Korean locales (LC_ALL, LC_CTYPE or LANG), unless the locale
+// has the @cjk_narrow modifier; RUNEWIDTH_EASTASIAN=1/0 overrides the
+// locale. Otherwise it is 1. To ask the terminal itself, use
+// terminal.ProbeAmbiguousWidth and pass the result here.
+//
+// Safe for concurrent use.
+//
+// Example:
+//
+//	core.SetAmbiguousWidth(2)
+//	core.StringWidth("±1°") // 5
+func SetAmbiguousWidth(width int) {
+	config := value.NewUnicodeConfig()
+	if width >= 2 {
+		config = config.WithEastAsianWide()
+	}
+	unicodeSvc.Store(service.NewUnicodeServiceWithConfig(config))
+}
+
+// AmbiguousWidth returns the current width (1 or 2) of East Asian Ambiguous
+// characters. See SetAmbiguousWidth.
+func AmbiguousWidth() int {
+	if unicodeSvc.Load().Config().IsEastAsianWide() {
+		return 2
+	}
+	return 1
+}
 
 // StringWidth returns the visual width of a string in terminal cells.
 // This correctly handles Unicode edge cases that many libraries get wrong:
@@ -38,5 +86,59 @@ var unicodeSvc = service.NewUnicodeService()
 //   - Aligning text in columns
 //   - Rendering bordered boxes
 func StringWidth(s string) int {
-	return unicodeSvc.StringWidth(s)
+	return unicodeSvc.Load().StringWidth(s)
+}
+
+// ClusterLen returns the length in bytes of the grapheme cluster at the
+// start of s (0 if s is empty): a character together with its combining
+// marks, variation selectors and emoji modifiers, a ZWJ emoji sequence or a
+// flag. Truncate, PadRight and PadLeft never split these clusters; use
+// ClusterLen to walk text the same way, measuring each cluster with
+// StringWidth.
+//
+// Example:
+//
+//	core.ClusterLen("👍🏻 ok")   // 8 (thumbs up + skin tone modifier)
+//	core.ClusterLen("🇯🇵 flag") // 8 (two regional indicators)
+func ClusterLen(s string) int {
+	return service.ClusterLen(s)
+}
+
+// Truncate cuts s to at most width terminal cells and appends tail (for
+// example "…"), which counts towards the width. Grapheme clusters are never
+// split: if a wide character would straddle the cut, it is dropped and a
+// space takes its place, so the result is exactly width cells wide.
+// s is returned unchanged if it already fits.
+//
+// ANSI escape sequences are not recognized; use style.Truncate for styled text.
+//
+// Example:
+//
+//	core.Truncate("Hello, World!", 8, "…") // "Hello, …"
+//	core.Truncate("中文字符", 5, "")         // "中文 "
+func Truncate(s string, width int, tail string) string {
+	return unicodeSvc.Load().Truncate(s, width, tail)
+}
+
+// PadRight appends spaces to s until it is width terminal cells wide.
+// Use it instead of fmt's %-*s, which counts runes and misaligns wide
+// characters such as CJK and emoji. s is returned unchanged if it is
+// already at least width cells wide.
+//
+// Example:
+//
+//	fmt.Printf("%s|\n", core.PadRight("中文", 6)) // "中文  |"
+func PadRight(s string, width int) string {
+	return unicodeSvc.Load().PadRight(s, width)
+}
+
+// PadLeft prepends spaces to s until it is width terminal cells wide.
+// Use it instead of fmt's %*s for right-aligned columns. s is returned
+// unchanged if it is already at least width cells wide.
+//
+// Example:
+//
+//	fmt.Printf("|%s\n", core.PadLeft("🔥", 4)) // "|  🔥"
+func PadLeft(s string, width int) string {
+	return unicodeSvc.Load().PadLeft(s, width)
 }
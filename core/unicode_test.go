@@ -45,6 +45,84 @@ func TestStringWidth(t *testing.T) {
 	}
 }
 
+func TestClusterLen(t *testing.T) {
+	tests := []struct {
+		s     string
+		want  int
+		width int
+	}{
+		{"", 0, 0},
+		{"ab", 1, 1},
+		{"e\u0301x", 3, 1},          // Combining acute accent
+		{"👍🏻 ok", 8, 2},             // Skin tone modifier
+		{"👨\u200d👩\u200d👧!", 18, 2}, // ZWJ family
+		{"🇯🇵🇫🇷", 8, 2},              // One flag at a time
+		{"中文", 3, 2},
+	}
+
+	for _, tt := range tests {
+		n := core.ClusterLen(tt.s)
+		if n != tt.want {
+			t.Errorf("ClusterLen(%q) = %d, want %d", tt.s, n, tt.want)
+			continue
+		}
+		if w := core.StringWidth(tt.s[:n]); w != tt.width {
+			t.Errorf("StringWidth(%q) = %d, want %d", tt.s[:n], w, tt.width)
+		}
+	}
+
+	// Cluster widths add up to the width of the whole string.
+	s := "a👨\u200d👩\u200d👧b🇯🇵é中"
+	total := 0
+	for i := 0; i < len(s); {
+		n := core.ClusterLen(s[i:])
+		total += core.StringWidth(s[i : i+n])
+		i += n
+	}
+	if total != core.StringWidth(s) {
+		t.Errorf("sum of cluster widths = %d, want StringWidth %d", total, core.StringWidth(s))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		tail  string
+		want  string
+	}{
+		{"fits", "Hello", 10, "…", "Hello"},
+		{"ascii", "Hello, World!", 8, "…", "Hello, …"},
+		{"wide straddles cut", "中文字符", 5, "", "中文 "},
+		{"emoji", "Hi 🔥🔥", 5, "", "Hi 🔥"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := core.Truncate(tt.s, tt.width, tt.tail); got != tt.want {
+				t.Errorf("Truncate(%q, %d, %q) = %q, want %q", tt.s, tt.width, tt.tail, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPadRightPadLeft(t *testing.T) {
+	// A column of mixed-width names lines up when padded by display width.
+	for _, name := range []string{"Alice", "中文名", "🔥 hot"} {
+		if w := core.StringWidth(core.PadRight(name, 10)); w != 10 {
+			t.Errorf("PadRight(%q, 10) width = %d, want 10", name, w)
+		}
+		if w := core.StringWidth(core.PadLeft(name, 10)); w != 10 {
+			t.Errorf("PadLeft(%q, 10) width = %d, want 10", name, w)
+		}
+	}
+
+	if got := core.PadRight("too wide", 4); got != "too wide" {
+		t.Errorf("PadRight() of a wider string = %q, want it unchanged", got)
+	}
+}
+
 // Benchmark public API function
 func BenchmarkStringWidth(b *testing.B) {
 	tests := []struct {
@@ -67,3 +145,34 @@ func BenchmarkStringWidth(b *testing.B) {
 		})
 	}
 }
+
+func TestAmbiguousWidth(t *testing.T) {
+	original := core.AmbiguousWidth()
+	defer core.SetAmbiguousWidth(original)
+
+	core.SetAmbiguousWidth(1)
+	if got := core.AmbiguousWidth(); got != 1 {
+		t.Errorf("AmbiguousWidth() = %d, want 1", got)
+	}
+	if got := core.StringWidth("±1°"); got != 3 {
+		t.Errorf("narrow StringWidth(\"±1°\") = %d, want 3", got)
+	}
+
+	core.SetAmbiguousWidth(2)
+	if got := core.AmbiguousWidth(); got != 2 {
+		t.Errorf("AmbiguousWidth() = %d, want 2", got)
+	}
+	if got := core.StringWidth("±1°"); got != 5 {
+		t.Errorf("wide StringWidth(\"±1°\") = %d, want 5", got)
+	}
+	if got := core.NewCellAuto("±").Width; got != 2 {
+		t.Errorf("wide NewCellAuto(\"±\").Width = %d, want 2", got)
+	}
+	if got := core.PadRight("°", 3); got != "° " {
+		t.Errorf("wide PadRight(\"°\", 3) = %q, want %q", got, "° ")
+	}
+	// Unambiguous characters are unaffected.
+	if got := core.StringWidth("a中"); got != 3 {
+		t.Errorf("wide StringWidth(\"a中\") = %d, want 3", got)
+	}
+}
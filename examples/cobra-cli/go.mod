@@ -22,7 +22,9 @@ require (
 )
 
 require (
+	github.com/google/uuid v1.6.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/phoenix-tui/phoenix/clipboard v0.2.4 // indirect
 	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
 	github.com/phoenix-tui/phoenix/terminal v0.2.4 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
@@ -31,3 +33,5 @@ require (
 	golang.org/x/sys v0.40.0 // indirect
 	golang.org/x/term v0.39.0 // indirect
 )
+
+replace github.com/phoenix-tui/phoenix/clipboard => ../../clipboard
@@ -10,8 +10,9 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
-	"github.com/phoenix-tui/phoenix/components"
+	"github.com/phoenix-tui/phoenix/components/input"
 	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 	"github.com/spf13/cobra"
@@ -96,34 +97,38 @@ func runCLIMode() {
 
 // runTUIMode launches interactive Phoenix TUI
 func runTUIMode() {
-	// Create initial model
-	m := newFormModel()
-
 	// Run Phoenix TUI program
-	p := tea.NewProgram(m, tea.WithAltScreen())
-	if finalModel, err := p.Run(); err != nil {
+	final, err := tea.New(newFormModel(), tea.WithAltScreen[formModel]()).RunReturning()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
 		os.Exit(1)
-	} else {
-		// Show results after TUI exits
-		if fm, ok := finalModel.(formModel); ok && fm.submitted {
-			showResults(fm)
-		}
+	}
+
+	// Show results after TUI exits
+	if final.submitted {
+		showResults(final)
 	}
 }
 
+// Form fields, in focus order
+const (
+	fieldName = iota
+	fieldEmail
+	fieldMessage
+)
+
+// fieldLabels are shown in front of the fields, in focus order
+var fieldLabels = []string{"Name:    ", "Email:   ", "Message: "}
+
 // formModel implements tea.Model for our interactive form
 type formModel struct {
-	// Form fields
-	nameInput    components.TextInput
-	emailInput   components.TextInput
-	messageInput components.TextInput
+	// Form fields; the focus group routes input to the focused one
+	fields tea.FocusGroup[input.Input]
 
 	// State
-	focusIndex int
-	submitted  bool
-	width      int
-	height     int
+	submitted bool
+	width     int
+	height    int
 
 	// Collected data
 	formData struct {
@@ -135,68 +140,42 @@ type formModel struct {
 
 func newFormModel() formModel {
 	// Create form inputs with Phoenix components
-	nameInput := components.NewTextInput(
-		components.WithPlaceholder("Enter your name..."),
-		components.WithPrompt("Name: "),
-		components.WithStyle(style.New().
-			Foreground(style.Color("#00FF00"))),
-	)
-	nameInput.Focus()
-
-	emailInput := components.NewTextInput(
-		components.WithPlaceholder("your.email@example.com"),
-		components.WithPrompt("Email: "),
-		components.WithStyle(style.New().
-			Foreground(style.Color("#00AAFF"))),
-	)
-
-	messageInput := components.NewTextInput(
-		components.WithPlaceholder("Enter your message..."),
-		components.WithPrompt("Message: "),
-		components.WithWidth(60),
-		components.WithStyle(style.New().
-			Foreground(style.Color("#FFAA00"))),
-	)
-
-	return formModel{
-		nameInput:    nameInput,
-		emailInput:   emailInput,
-		messageInput: messageInput,
-		focusIndex:   0,
-	}
+	return formModel{fields: tea.NewFocusGroup(
+		input.New(40).Placeholder("Enter your name..."),
+		input.New(40).Placeholder("your.email@example.com"),
+		input.New(60).Placeholder("Enter your message..."),
+	)}
 }
 
 func (m formModel) Init() tea.Cmd {
 	return nil
 }
 
-func (m formModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var cmd tea.Cmd
-
+func (m formModel) Update(msg tea.Msg) (formModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "esc":
-			return m, tea.Quit
+			return m, tea.Quit()
 
 		case "tab", "down":
 			// Next field
-			m.focusIndex = (m.focusIndex + 1) % 3
-			return m, m.updateFocus()
+			m.fields = m.fields.Next()
+			return m, nil
 
 		case "shift+tab", "up":
 			// Previous field
-			m.focusIndex = (m.focusIndex - 1 + 3) % 3
-			return m, m.updateFocus()
+			m.fields = m.fields.Prev()
+			return m, nil
 
 		case "enter":
 			// Submit form
 			if m.isFormValid() {
-				m.formData.name = m.nameInput.Value()
-				m.formData.email = m.emailInput.Value()
-				m.formData.message = m.messageInput.Value()
+				m.formData.name = m.fields.Child(fieldName).Value()
+				m.formData.email = m.fields.Child(fieldEmail).Value()
+				m.formData.message = m.fields.Child(fieldMessage).Value()
 				m.submitted = true
-				return m, tea.Quit
+				return m, tea.Quit()
 			}
 			return m, nil
 		}
@@ -206,16 +185,9 @@ func (m formModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 	}
 
-	// Update focused input
-	switch m.focusIndex {
-	case 0:
-		m.nameInput, cmd = m.nameInput.Update(msg)
-	case 1:
-		m.emailInput, cmd = m.emailInput.Update(msg)
-	case 2:
-		m.messageInput, cmd = m.messageInput.Update(msg)
-	}
-
+	// Keys go to the focused field, everything else to all fields
+	var cmd tea.Cmd
+	m.fields, cmd = m.fields.Update(msg)
 	return m, cmd
 }
 
@@ -227,81 +199,67 @@ func (m formModel) View() string {
 	// Title
 	titleStyle := style.New().
 		Bold(true).
-		Foreground(style.Color("#FF00FF")).
+		Foreground(style.RGB(255, 0, 255)).
 		MarginBottom(1)
 
-	title := titleStyle.Render("🚀 Phoenix + Cobra Demo")
+	title := style.Render(titleStyle, "🚀 Phoenix + Cobra Demo")
 
 	// Instructions
 	helpStyle := style.New().
-		Foreground(style.Color("#888888")).
+		Foreground(style.RGB(136, 136, 136)).
 		MarginTop(1)
 
-	help := helpStyle.Render("Tab/↓: Next • Shift+Tab/↑: Previous • Enter: Submit • Esc: Quit")
+	help := style.Render(helpStyle, "Tab/↓: Next • Shift+Tab/↑: Previous • Enter: Submit • Esc: Quit")
 
 	// Form fields
-	var fields string
-	fields += m.nameInput.View() + "\n"
-	fields += m.emailInput.View() + "\n"
-	fields += m.messageInput.View() + "\n"
+	labelColors := []style.Color{style.RGB(0, 255, 0), style.RGB(0, 170, 255), style.RGB(255, 170, 0)}
+	var fields strings.Builder
+	for i := 0; i < m.fields.Len(); i++ {
+		label := style.Render(style.New().Foreground(labelColors[i]), fieldLabels[i])
+		fields.WriteString(label + m.fields.Child(i).View() + "\n")
+	}
 
 	// Validation message
 	var validation string
 	if !m.isFormValid() {
 		validationStyle := style.New().
-			Foreground(style.Color("#FF0000")).
+			Foreground(style.RGB(255, 0, 0)).
 			MarginTop(1)
-		validation = validationStyle.Render("⚠ Please fill in all fields")
+		validation = style.Render(validationStyle, "⚠ Please fill in all fields")
 	} else {
 		validationStyle := style.New().
-			Foreground(style.Color("#00FF00")).
+			Foreground(style.RGB(0, 255, 0)).
 			MarginTop(1)
-		validation = validationStyle.Render("✓ Press Enter to submit")
+		validation = style.Render(validationStyle, "✓ Press Enter to submit")
 	}
 
 	// Combine all parts
 	container := style.New().
-		Border(style.BorderRounded).
-		BorderForeground(style.Color("#00FFFF")).
-		Padding(1, 2).
-		Width(m.width - 4).
-		Align(style.AlignCenter)
-
-	content := fmt.Sprintf("%s\n\n%s\n%s\n%s", title, fields, validation, help)
+		Border(style.RoundedBorder).
+		BorderColor(style.RGB(0, 255, 255)).
+		Padding(style.NewPadding(1, 2, 1, 2)).
+		Width(m.width - 4)
 
-	return container.Render(content)
-}
+	content := fmt.Sprintf("%s\n\n%s\n%s\n%s", title, fields.String(), validation, help)
 
-func (m formModel) updateFocus() tea.Cmd {
-	// Update focus state for all inputs
-	if m.focusIndex == 0 {
-		m.nameInput.Focus()
-		m.emailInput.Blur()
-		m.messageInput.Blur()
-	} else if m.focusIndex == 1 {
-		m.nameInput.Blur()
-		m.emailInput.Focus()
-		m.messageInput.Blur()
-	} else {
-		m.nameInput.Blur()
-		m.emailInput.Blur()
-		m.messageInput.Focus()
-	}
-	return nil
+	return style.Render(container, content)
 }
 
 func (m formModel) isFormValid() bool {
-	return m.nameInput.Value() != "" &&
-		m.emailInput.Value() != "" &&
-		m.messageInput.Value() != ""
+	for i := 0; i < m.fields.Len(); i++ {
+		if m.fields.Child(i).Value() == "" {
+			return false
+		}
+	}
+	return true
 }
 
 func showResults(m formModel) {
 	resultStyle := style.New().
 		Bold(true).
-		Foreground(style.Color("#00FF00")).
-		Border(style.BorderDouble).
-		Padding(1, 2)
+		Foreground(style.RGB(0, 255, 0)).
+		Border(style.DoubleBorder).
+		Padding(style.NewPadding(1, 2, 1, 2))
 
 	result := fmt.Sprintf(`✓ Form Submitted Successfully!
 
@@ -314,7 +272,7 @@ Thank you for using Phoenix + Cobra! 🎉`,
 		m.formData.email,
 		m.formData.message)
 
-	fmt.Println(resultStyle.Render(result))
+	fmt.Println(style.Render(resultStyle, result))
 }
 
 func main() {
@@ -71,19 +71,13 @@ func (m Model) View() string {
 	// Render viewport content
 	content := m.viewport.View()
 
-	// Calculate scroll indicator
-	scrollPercent := 0
-	if m.viewport.TotalLines() > m.viewport.Height() {
-		scrollPercent = (m.viewport.ScrollOffset() * 100) / (m.viewport.TotalLines() - m.viewport.Height())
-	}
-
 	// Status bar
 	status := fmt.Sprintf(
-		"Drag Scroll Demo | Lines: %d/%d | Offset: %d | Scroll: %d%% | Press 'q' to quit, 'r' to reset, 'e' to end",
+		"Drag Scroll Demo | Lines: %d/%d | Offset: %d | Scroll: %.0f%% | Press 'q' to quit, 'r' to reset, 'e' to end",
 		m.viewport.Height(),
 		m.viewport.TotalLines(),
 		m.viewport.ScrollOffset(),
-		scrollPercent,
+		m.viewport.ScrollPercent()*100,
 	)
 
 	// Instructions
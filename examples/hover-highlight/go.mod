@@ -15,13 +15,13 @@ replace github.com/phoenix-tui/phoenix/terminal => ../../terminal
 replace github.com/phoenix-tui/phoenix/testing => ../../testing
 
 require (
+	github.com/phoenix-tui/phoenix/core v0.2.4
 	github.com/phoenix-tui/phoenix/mouse v0.2.4
 	github.com/phoenix-tui/phoenix/style v0.2.4
 	github.com/phoenix-tui/phoenix/tea v0.2.4
 )
 
 require (
-	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
 	github.com/phoenix-tui/phoenix/terminal v0.2.4 // indirect
 	github.com/unilibs/uniwidth v0.2.0 // indirect
 	golang.org/x/sys v0.40.0 // indirect
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/mouse"
 	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
@@ -225,26 +226,16 @@ func (m model) View() string {
 	b.WriteString("\n\n")
 
 	// Render buttons
-	// We'll build a 2D grid and render it
-	grid := make([][]rune, m.height)
-	for i := range grid {
-		grid[i] = make([]rune, m.width)
-		for j := range grid[i] {
-			grid[i][j] = ' ' // Initialize with spaces
-		}
-	}
+	// Draw into a screen buffer, leaving room for status
+	screen := core.NewScreen(m.width, max(m.height-6, 0))
 
 	// Draw each button
 	for _, btn := range m.buttons {
-		m.drawButton(&grid, btn, btn.id == m.hoveredID)
+		m.drawButton(screen, btn, btn.id == m.hoveredID)
 	}
 
-	// Convert grid to string
-	for y := 0; y < m.height-6; y++ { // Leave room for status
-		if y >= len(grid) {
-			break
-		}
-		b.WriteString(string(grid[y]))
+	if screen.Height() > 0 {
+		b.WriteString(screen.String())
 		b.WriteString("\n")
 	}
 
@@ -285,8 +276,8 @@ func (m model) View() string {
 	return b.String()
 }
 
-// drawButton renders a button into the grid with optional hover highlighting.
-func (m model) drawButton(grid *[][]rune, btn button, isHovered bool) {
+// drawButton renders a button into the screen with optional hover highlighting.
+func (m model) drawButton(screen *core.Screen, btn button, isHovered bool) {
 	// Button dimensions from bounding box
 	x := btn.area.X()
 	y := btn.area.Y()
@@ -294,17 +285,22 @@ func (m model) drawButton(grid *[][]rune, btn button, isHovered bool) {
 	h := btn.area.Height()
 
 	// Validate bounds
-	if y < 0 || y+h > len(*grid) {
+	if y < 0 || y+h > screen.Height() {
 		return
 	}
 
 	// Choose border style based on hover state
 	var topLeft, topRight, bottomLeft, bottomRight, horizontal, vertical rune
+	var cellStyle core.CellStyle
 	if isHovered {
-		// Hovered: double-line border (more prominent)
+		// Hovered: double-line border (more prominent), highlighted cells
 		topLeft, topRight = '╔', '╗'
 		bottomLeft, bottomRight = '╚', '╝'
 		horizontal, vertical = '═', '║'
+		cellStyle = core.CellStyle{}.
+			Foreground(core.RGB(255, 255, 255)).
+			Background(core.RGB(40, 90, 160)).
+			Bold(true)
 	} else {
 		// Normal: single-line border
 		topLeft, topRight = '╭', '╮'
@@ -312,16 +308,20 @@ func (m model) drawButton(grid *[][]rune, btn button, isHovered bool) {
 		horizontal, vertical = '─', '│'
 	}
 
+	cell := func(r rune) core.StyledCell {
+		return core.NewCell(string(r), 1).WithStyle(cellStyle)
+	}
+
 	// Draw button border and background
 	for dy := 0; dy < h; dy++ {
 		row := y + dy
-		if row >= len(*grid) {
+		if row >= screen.Height() {
 			break
 		}
 
 		for dx := 0; dx < w; dx++ {
 			col := x + dx
-			if col >= len((*grid)[row]) {
+			if col >= screen.Width() {
 				break
 			}
 
@@ -329,43 +329,42 @@ func (m model) drawButton(grid *[][]rune, btn button, isHovered bool) {
 			if dy == 0 {
 				// Top border
 				if dx == 0 {
-					(*grid)[row][col] = topLeft
+					screen.Set(col, row, cell(topLeft))
 				} else if dx == w-1 {
-					(*grid)[row][col] = topRight
+					screen.Set(col, row, cell(topRight))
 				} else {
-					(*grid)[row][col] = horizontal
+					screen.Set(col, row, cell(horizontal))
 				}
 			} else if dy == h-1 {
 				// Bottom border
 				if dx == 0 {
-					(*grid)[row][col] = bottomLeft
+					screen.Set(col, row, cell(bottomLeft))
 				} else if dx == w-1 {
-					(*grid)[row][col] = bottomRight
+					screen.Set(col, row, cell(bottomRight))
 				} else {
-					(*grid)[row][col] = horizontal
+					screen.Set(col, row, cell(horizontal))
 				}
 			} else {
 				// Middle rows
 				if dx == 0 || dx == w-1 {
-					(*grid)[row][col] = vertical
+					screen.Set(col, row, cell(vertical))
 				} else if dy == h/2 {
 					// Center row: write button text
 					textStartCol := x + (w-len(btn.text))/2
 					if col >= textStartCol && col < textStartCol+len(btn.text) {
-						(*grid)[row][col] = rune(btn.text[col-textStartCol])
+						screen.Set(col, row, cell(rune(btn.text[col-textStartCol])))
 					} else {
-						(*grid)[row][col] = ' '
+						screen.Set(col, row, cell(' '))
 					}
 				} else {
-					(*grid)[row][col] = ' '
+					screen.Set(col, row, cell(' '))
 				}
 			}
 		}
 	}
 
-	// Note: For simplicity, this basic renderer doesn't apply ANSI codes to individual cells.
-	// In a real TUI, you'd use phoenix/render or apply styles per-cell.
-	// This example focuses on demonstrating hover detection logic.
+	// Each cell carries its own style, so the hovered button is highlighted
+	// when View renders the screen.
 }
 
 // initialModel creates the initial model with default state.
@@ -5,9 +5,25 @@ import (
 	"strings"
 
 	"github.com/phoenix-tui/phoenix/components/viewport"
+	"github.com/phoenix-tui/phoenix/style"
 	"github.com/phoenix-tui/phoenix/tea"
 )
 
+// keys are the demo's key bindings. Update dispatches on them and the help
+// overlay is generated from them.
+var keys = tea.NewKeyMap(
+	tea.KeyBinding{Keys: []string{"1"}, Help: "Slow (1 line/tick)", Action: "speed-1"},
+	tea.KeyBinding{Keys: []string{"2"}, Help: "Default (3 lines/tick)", Action: "speed-3"},
+	tea.KeyBinding{Keys: []string{"3"}, Help: "Fast (5 lines/tick)", Action: "speed-5"},
+	tea.KeyBinding{Keys: []string{"4"}, Help: "Very Fast (10 lines/tick)", Action: "speed-10"},
+	tea.KeyBinding{Keys: []string{"r"}, Help: "Reset to top", Action: "reset"},
+	tea.KeyBinding{Keys: []string{"h", "?"}, Help: "Toggle help", Action: "help"},
+	tea.KeyBinding{Keys: []string{"q", "ctrl+c"}, Help: "Quit", Action: "quit"},
+)
+
+// wheelSpeeds maps the speed actions to lines per wheel tick.
+var wheelSpeeds = map[string]int{"speed-1": 1, "speed-3": 3, "speed-5": 5, "speed-10": 10}
+
 // model represents the application state
 type model struct {
 	viewport        *viewport.Viewport
@@ -46,39 +62,22 @@ func (m model) Init() tea.Cmd {
 func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c":
-			return m, tea.Quit()
-
-		case "h", "?":
-			m.showHelp = !m.showHelp
-			return m, nil
-
-		case "1":
-			// Slow scroll: 1 line per wheel tick
-			m.scrollSpeed = 1
-			m.viewport = m.viewport.SetWheelScrollLines(1)
-			return m, nil
-
-		case "2":
-			// Default scroll: 3 lines per wheel tick
-			m.scrollSpeed = 3
-			m.viewport = m.viewport.SetWheelScrollLines(3)
+		action := keys.Match(msg)
+		if speed, ok := wheelSpeeds[action]; ok {
+			m.scrollSpeed = speed
+			m.viewport = m.viewport.SetWheelScrollLines(speed)
 			return m, nil
+		}
 
-		case "3":
-			// Fast scroll: 5 lines per wheel tick
-			m.scrollSpeed = 5
-			m.viewport = m.viewport.SetWheelScrollLines(5)
-			return m, nil
+		switch action {
+		case "quit":
+			return m, tea.Quit()
 
-		case "4":
-			// Very fast scroll: 10 lines per wheel tick
-			m.scrollSpeed = 10
-			m.viewport = m.viewport.SetWheelScrollLines(10)
+		case "help":
+			m.showHelp = !m.showHelp
 			return m, nil
 
-		case "r":
+		case "reset":
 			// Reset to top
 			m.viewport = m.viewport.ScrollToTop()
 			m.totalScrolled = 0
@@ -146,18 +145,10 @@ func (m model) View() string {
 	b.WriteString("\n")
 
 	// Footer with stats
-	scrollPercent := 0
-	if m.viewport.TotalLines() > m.viewport.Height() {
-		maxScroll := m.viewport.TotalLines() - m.viewport.Height()
-		if maxScroll > 0 {
-			scrollPercent = (m.viewport.ScrollOffset() * 100) / maxScroll
-		}
-	}
-
 	b.WriteString(fmt.Sprintf("Line %d/%d (%.0f%%) | Scrolled: %d lines | Events: %d | Speed: %d lines/tick",
 		m.viewport.ScrollOffset()+1,
 		m.viewport.TotalLines(),
-		float64(scrollPercent),
+		m.viewport.ScrollPercent()*100,
 		m.totalScrolled,
 		m.wheelEventCount,
 		m.scrollSpeed,
@@ -165,35 +156,16 @@ func (m model) View() string {
 
 	// Help overlay
 	if m.showHelp {
-		help := `
-┌─────────────────────────────────────┐
-│         Wheel Scroll Demo           │
-├─────────────────────────────────────┤
-│ Mouse Wheel Up/Down: Scroll content │
-│ 1: Slow (1 line/tick)               │
-│ 2: Default (3 lines/tick)           │
-│ 3: Fast (5 lines/tick)              │
-│ 4: Very Fast (10 lines/tick)        │
-│ r: Reset to top                     │
-│ h: Toggle help                      │
-│ q: Quit                             │
-└─────────────────────────────────────┘`
-
-		// Center the help box
-		helpLines := strings.Split(help, "\n")
-		topPadding := (m.height - len(helpLines)) / 2
-		leftPadding := (m.width - 40) / 2
+		// Center the help box by its measured size
+		help := helpBox()
+		width, height := style.Measure(help)
+		topPadding := max((m.height-height)/2, 0)
+		leftPadding := strings.Repeat(" ", max((m.width-width)/2, 0))
 
 		var overlay strings.Builder
-		for i := 0; i < topPadding; i++ {
-			overlay.WriteString("\n")
-		}
-		for _, line := range helpLines {
-			if line != "" {
-				overlay.WriteString(strings.Repeat(" ", leftPadding))
-				overlay.WriteString(line)
-			}
-			overlay.WriteString("\n")
+		overlay.WriteString(strings.Repeat("\n", topPadding))
+		for _, line := range strings.Split(help, "\n") {
+			overlay.WriteString(leftPadding + line + "\n")
 		}
 
 		return overlay.String()
@@ -202,6 +174,27 @@ func (m model) View() string {
 	return b.String()
 }
 
+// helpBox frames the key map's full help, headed by the wheel controls
+// (which are mouse events, not key bindings).
+func helpBox() string {
+	const inner = 37
+	row := func(text string) string {
+		return "│ " + text + strings.Repeat(" ", max(inner-2-style.Width(text), 0)) + " │"
+	}
+
+	lines := []string{
+		"┌" + strings.Repeat("─", inner) + "┐",
+		row("        Wheel Scroll Demo"),
+		"├" + strings.Repeat("─", inner) + "┤",
+		row("Mouse Wheel Up/Down: Scroll content"),
+	}
+	for _, line := range strings.Split(keys.FullHelp(), "\n") {
+		lines = append(lines, row(line))
+	}
+	lines = append(lines, "└"+strings.Repeat("─", inner)+"┘")
+	return strings.Join(lines, "\n")
+}
+
 func main() {
 	p := tea.New(
 		initialModel(),
@@ -19,9 +19,12 @@ func main() {
 	// - Right pane: Help sidebar
 
 	fmt.Println("=== Split Pane Layout (Left: Terminal, Right: Help) ===")
-	// For now, show a simpler version
-	left := layout.NewBox("Main Terminal Area\n\nCommand history...\nMore history...\n\n$ current input")
-	right := layout.NewBox("Help\n\nls - list\ncd - change\nexit - quit")
+	// The terminal pane grows to fill everything the help pane does not use
+	left := layout.NewBox("Main Terminal Area\n\nCommand history...\nMore history...\n\n$ current input").
+		Border().
+		Flex(1)
+	right := layout.NewBox("Help\n\nls - list\ncd - change\nexit - quit").
+		Border()
 
 	split := layout.Row().
 		Gap(2).
@@ -41,19 +44,19 @@ func main() {
 		AlignCenter().
 		Border()
 
-	cpu := layout.NewBox("CPU\n45%").Border().PaddingAll(1)
-	memory := layout.NewBox("Memory\n2.1GB").Border().PaddingAll(1)
-	disk := layout.NewBox("Disk\n78%").Border().PaddingAll(1)
+	// Equal flex factors share the row width evenly
+	cpu := layout.NewBox("CPU\n45%").Border().PaddingAll(1).Flex(1)
+	memory := layout.NewBox("Memory\n2.1GB").Border().PaddingAll(1).Flex(1)
+	disk := layout.NewBox("Disk\n78%").Border().PaddingAll(1).Flex(1)
 
 	stats := layout.Row().
 		Gap(3).
-		JustifyCenter().
 		Add(cpu).
 		Add(memory).
 		Add(disk)
 
-	// For now we need to manually render stats
-	statsBox := layout.NewBox(stats.Render(terminalWidth, 5))
+	// Containers do not nest yet, so the stats row is rendered into a box
+	statsBox := layout.NewBox(stats.Render(terminalWidth, 7))
 
 	status := layout.NewBox("Status: Running | Uptime: 3d 12h").
 		AlignCenter()
@@ -70,22 +73,19 @@ func main() {
 	fmt.Println()
 
 	// Example 3: Overlay (completion menu over input)
-	// This demonstrates absolute positioning concept
+	// The menu is composited onto the already rendered screen at a cell position
 
 	baseInput := layout.NewBox("$ git che").
 		Border()
+	screen := layout.Column().
+		Add(layout.NewBox("$ git status\nOn branch main\nnothing to commit")).
+		Add(baseInput).
+		Render(terminalWidth, 10)
 
-	// Completion menu (would be positioned absolutely in real impl)
-	completions := layout.NewBox("Completions:\n  checkout\n  cherry-pick\n  check").
-		Border().
-		PaddingAll(1)
+	completions := layout.NewBox("checkout\ncherry-pick\ncheck").
+		Border()
 
-	fmt.Println("=== Completion Menu Overlay Concept ===")
-	fmt.Println("Base input:")
-	fmt.Println(baseInput.Render())
-	fmt.Println()
-	fmt.Println("Completion menu (would overlay above):")
-	fmt.Println(completions.Render())
-	fmt.Println()
-	fmt.Println("Note: True overlay requires absolute positioning (future feature)")
+	fmt.Println("=== Completion Menu Overlay ===")
+	// Below the input line, aligned with the word being completed
+	fmt.Println(layout.Overlay(screen, completions.Render(), 8, 5))
 }
@@ -336,3 +336,46 @@ func ExampleFlex_Gap() {
 	output := flex.Render(30, 3)
 	_ = output // Use output
 }
+
+func TestFlex_Render_FlexGrow(t *testing.T) {
+	for _, width := range []int{20, 40, 80, 120} {
+		output := Row().
+			Add(NewBox("Nav").Border()).
+			Add(NewBox("Main").Border().Flex(1)).
+			Render(width, 3)
+
+		lines := strings.Split(output, "\n")
+		top := []rune(lines[0])
+
+		// Nav keeps its natural width (7); Main's border spans the rest.
+		if string(top[:7]) != "┌─────┐" {
+			t.Errorf("width %d: nav top = %q", width, string(top[:7]))
+		}
+		if top[7] != '┌' || top[width-1] != '┐' {
+			t.Errorf("width %d: main should span columns 7-%d, got %q", width, width-1, lines[0])
+		}
+		if mid := []rune(lines[1]); mid[width-1] != '│' {
+			t.Errorf("width %d: main right border missing: %q", width, lines[1])
+		}
+	}
+}
+
+func TestFlex_Render_FlexShrink(t *testing.T) {
+	output := Row().
+		Add(NewBox("Title that is far too long").FlexShrink(1).MinWidth(5)).
+		Add(NewBox("[OK]")).
+		Render(16, 1)
+
+	if want := "Title that i[OK]"; output != want {
+		t.Errorf("Render() = %q, want %q", output, want)
+	}
+}
+
+func TestBox_Flex_Negative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Flex(-1) should panic")
+		}
+	}()
+	NewBox("X").Flex(-1)
+}
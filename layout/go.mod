@@ -4,14 +4,22 @@ go 1.25.1
 
 require (
 	github.com/phoenix-tui/phoenix/core v0.2.4
+	github.com/phoenix-tui/phoenix/style v0.2.4
 	github.com/stretchr/testify v1.11.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/phoenix-tui/phoenix/terminal v0.2.4 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/unilibs/uniwidth v0.2.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/term v0.39.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace github.com/phoenix-tui/phoenix/core => ../core
+
+replace github.com/phoenix-tui/phoenix/style => ../style
+
+replace github.com/phoenix-tui/phoenix/terminal => ../terminal
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	value2 "github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
 )
 
 // Box represents a layout box following the CSS box model.
@@ -47,12 +48,21 @@ import (
 //
 //	totalSize := box.TotalSize() // Full outer size
 type Box struct {
-	content   string           // Content text (drives size)
-	padding   value2.Spacing   // Inner spacing (inside border)
-	margin    value2.Spacing   // Outer spacing (outside border)
-	hasBorder bool             // Whether box has border
-	size      value2.Size      // Size constraints
-	alignment value2.Alignment // Alignment within parent
+	content    string                     // Content text (drives size)
+	padding    value2.Spacing             // Inner spacing (inside border)
+	margin     value2.Spacing             // Outer spacing (outside border)
+	hasBorder  bool                       // Whether box has border
+	title      string                     // Title shown in the top border (empty = none)
+	titleAlign value2.HorizontalAlignment // Title position in the top border
+	size       value2.Size                // Size constraints
+	relWidth   value2.Fraction            // Width relative to the container (zero = none)
+	relHeight  value2.Fraction            // Height relative to the container (zero = none)
+	alignment  value2.Alignment           // Alignment within parent
+	flexGrow   int                        // Share of leftover main-axis space (0 = fixed)
+	flexShrink int                        // Share of main-axis overflow to absorb (0 = never shrink)
+	overflow   value2.Overflow            // Handling of content larger than the box
+	scrollTop  int                        // First visible content row (OverflowScroll)
+	background *style.Color               // Fill color inside the border (nil = none)
 }
 
 // NewBox creates a Box with the given content.
@@ -64,6 +74,8 @@ type Box struct {
 //   - Border: Disabled
 //   - Size: Unconstrained
 //   - Alignment: Top-left
+//   - FlexGrow, FlexShrink: 0 (natural size in flex containers)
+//   - Overflow: Clip
 //
 // Example:
 //
@@ -73,12 +85,14 @@ func NewBox(content string) *Box {
 		panic("box: content cannot be empty")
 	}
 	return &Box{
-		content:   content,
-		padding:   value2.NewSpacingZero(),
-		margin:    value2.NewSpacingZero(),
-		hasBorder: false,
-		size:      value2.NewSizeUnconstrained(),
-		alignment: value2.NewAlignmentDefault(),
+		content:    content,
+		padding:    value2.NewSpacingZero(),
+		margin:     value2.NewSpacingZero(),
+		hasBorder:  false,
+		title:      "",
+		titleAlign: value2.AlignLeft,
+		size:       value2.NewSizeUnconstrained(),
+		alignment:  value2.NewAlignmentDefault(),
 	}
 }
 
@@ -102,16 +116,65 @@ func (b *Box) HasBorder() bool {
 	return b.hasBorder
 }
 
+// Title returns the title shown in the top border (empty if none).
+func (b *Box) Title() string {
+	return b.title
+}
+
+// TitleAlign returns the position of the title in the top border.
+func (b *Box) TitleAlign() value2.HorizontalAlignment {
+	return b.titleAlign
+}
+
 // Size returns the size constraints.
 func (b *Box) Size() value2.Size {
 	return b.size
 }
 
+// Background returns the fill color for the area inside the border and
+// whether one is set.
+func (b *Box) Background() (style.Color, bool) {
+	if b.background == nil {
+		return style.Color{}, false
+	}
+	return *b.background, true
+}
+
+// WidthFraction returns the width relative to the container (zero if unset).
+func (b *Box) WidthFraction() value2.Fraction {
+	return b.relWidth
+}
+
+// HeightFraction returns the height relative to the container (zero if unset).
+func (b *Box) HeightFraction() value2.Fraction {
+	return b.relHeight
+}
+
 // Alignment returns the alignment within parent.
 func (b *Box) Alignment() value2.Alignment {
 	return b.alignment
 }
 
+// FlexGrow returns the flex grow factor (0 = does not grow).
+func (b *Box) FlexGrow() int {
+	return b.flexGrow
+}
+
+// FlexShrink returns the flex shrink factor (0 = does not shrink).
+func (b *Box) FlexShrink() int {
+	return b.flexShrink
+}
+
+// Overflow returns the overflow policy.
+func (b *Box) Overflow() value2.Overflow {
+	return b.overflow
+}
+
+// ScrollTop returns the first visible content row used by OverflowScroll.
+func (b *Box) ScrollTop() int {
+	return b.scrollTop
+}
+
 // WithContent returns a new Box with the given content.
 // Panics if content is empty.
 func (b *Box) WithContent(content string) *Box {
@@ -159,6 +222,27 @@ func (b *Box) WithBorder(hasBorder bool) *Box {
 	return &result
 }
 
+// WithTitle returns a new Box with a title shown in the top border.
+// The title only renders when the border is enabled and is truncated
+// to fit the box width.
+//
+// Example:
+//
+//	box := NewBox("Text").WithBorder(true).WithTitle("Info")
+func (b *Box) WithTitle(title string) *Box {
+	result := *b
+	result.title = title
+	return &result
+}
+
+// WithTitleAlign returns a new Box with the title placed at the left,
+// center, or right of the top border.
+func (b *Box) WithTitleAlign(a value2.HorizontalAlignment) *Box {
+	result := *b
+	result.titleAlign = a
+	return &result
+}
+
 // WithSize returns a new Box with the given size constraints.
 // Size constraints are applied during layout pass.
 //
@@ -171,6 +255,80 @@ func (b *Box) WithSize(s value2.Size) *Box {
 	return &result
 }
 
+// WithBackground returns a new Box whose content and padding area (inside
+// the border, outside the margin) is filled with the given color.
+//
+// Example:
+//
+//	card := NewBox("Total: 42").WithBorder(true).WithBackground(style.RGB(30, 30, 60))
+func (b *Box) WithBackground(c style.Color) *Box {
+	result := *b
+	result.background = &c
+	return &result
+}
+
+// WithoutBackground returns a new Box without a background fill.
+func (b *Box) WithoutBackground() *Box {
+	result := *b
+	result.background = nil
+	return &result
+}
+
+// WithWidthFraction returns a new Box whose width is a fraction of its
+// container's width, resolved at layout time. Pass a zero Fraction to
+// clear it.
+//
+// Example:
+//
+//	box := NewBox("Sidebar").WithWidthFraction(value.NewFraction(1, 3))
+func (b *Box) WithWidthFraction(f value2.Fraction) *Box {
+	result := *b
+	result.relWidth = f
+	return &result
+}
+
+// WithHeightFraction returns a new Box whose height is a fraction of its
+// container's height, resolved at layout time. Pass a zero Fraction to
+// clear it.
+func (b *Box) WithHeightFraction(f value2.Fraction) *Box {
+	result := *b
+	result.relHeight = f
+	return &result
+}
+
+// WithResolvedWidth returns a new Box with an exact width, clamped to the
+// box's min/max width constraints.
+func (b *Box) WithResolvedWidth(width int) *Box {
+	width, _ = b.size.WithWidth(-1).Constrain(width, 0)
+	return b.WithSize(b.size.WithWidth(width))
+}
+
+// WithResolvedHeight returns a new Box with an exact height, clamped to
+// the box's min/max height constraints.
+func (b *Box) WithResolvedHeight(height int) *Box {
+	_, height = b.size.WithHeight(-1).Constrain(0, height)
+	return b.WithSize(b.size.WithHeight(height))
+}
+
+// ResolveFractions returns a new Box with its relative width and height
+// turned into exact sizes against the given container size. Boxes without
+// relative sizes are returned unchanged.
+//
+// Example:
+//
+//	box := NewBox("Half").WithWidthFraction(value.NewPercent(50))
+//	box = box.ResolveFractions(80, 24) // Exact width 40
+func (b *Box) ResolveFractions(parentWidth, parentHeight int) *Box {
+	result := b
+	if !b.relWidth.IsZero() {
+		result = result.WithResolvedWidth(b.relWidth.Of(parentWidth))
+	}
+	if !b.relHeight.IsZero() {
+		result = result.WithResolvedHeight(b.relHeight.Of(parentHeight))
+	}
+	return result
+}
+
 // WithAlignment returns a new Box with the given alignment.
 // Alignment determines positioning within parent container.
 //
@@ -183,6 +341,67 @@ func (b *Box) WithAlignment(a value2.Alignment) *Box {
 	return &result
 }
 
+// WithFlexGrow returns a new Box with the given flex grow factor.
+// In a flex container, leftover main-axis space is shared between items
+// in proportion to their grow factors. Panics on negative grow.
+//
+// Example:
+//
+//	sidebar := NewBox("Nav")                  // Natural width
+//	body := NewBox("Content").WithFlexGrow(1) // Takes the rest
+func (b *Box) WithFlexGrow(grow int) *Box {
+	if grow < 0 {
+		panic(fmt.Sprintf("box: flex grow must be non-negative, got %d", grow))
+	}
+	result := *b
+	result.flexGrow = grow
+	return &result
+}
+
+// WithFlexShrink returns a new Box with the given flex shrink factor.
+// When items overflow a flex container, the overflow is taken from items
+// in proportion to shrink factor times natural size, never below their
+// minimum size. Panics on negative shrink.
+func (b *Box) WithFlexShrink(shrink int) *Box {
+	if shrink < 0 {
+		panic(fmt.Sprintf("box: flex shrink must be non-negative, got %d", shrink))
+	}
+	result := *b
+	result.flexShrink = shrink
+	return &result
+}
+
+// WithOverflow returns a new Box with the given overflow policy.
+// The policy applies when content exceeds the box's exact or maximum size.
+// Panics on an unknown policy.
+//
+// Example:
+//
+//	box := NewBox(log).
+//		WithSize(value.NewSizeUnconstrained().WithMaxWidth(40)).
+//		WithOverflow(value.OverflowWrap)
+func (b *Box) WithOverflow(o value2.Overflow) *Box {
+	if !o.Validate() {
+		panic(fmt.Sprintf("box: invalid overflow %d", o))
+	}
+	result := *b
+	result.overflow = o
+	return &result
+}
+
+// WithScrollTop returns a new Box whose visible rows start at the given
+// content row when the overflow policy is OverflowScroll. Offsets past the
+// end are clamped at render time so the box stays filled. Panics on a
+// negative offset.
+func (b *Box) WithScrollTop(row int) *Box {
+	if row < 0 {
+		panic(fmt.Sprintf("box: scroll offset must be non-negative, got %d", row))
+	}
+	result := *b
+	result.scrollTop = row
+	return &result
+}
+
 // ContentSize calculates the size of the content area.
 // For now, this measures string length (simple approach).
 // Later (Day 3), this will integrate with phoenix/core.UnicodeService
@@ -312,14 +531,46 @@ func (b *Box) String() string {
 		parts = append(parts, "border=true")
 	}
 
+	if b.title != "" {
+		parts = append(parts, fmt.Sprintf("title=%q", b.title))
+	}
+
 	if !b.size.IsUnconstrained() {
 		parts = append(parts, fmt.Sprintf("size=%s", b.size))
 	}
 
+	if b.background != nil {
+		parts = append(parts, "background=true")
+	}
+
+	if !b.relWidth.IsZero() {
+		parts = append(parts, fmt.Sprintf("width=%s", b.relWidth))
+	}
+
+	if !b.relHeight.IsZero() {
+		parts = append(parts, fmt.Sprintf("height=%s", b.relHeight))
+	}
+
 	if !b.alignment.IsDefault() {
 		parts = append(parts, fmt.Sprintf("align=%s", b.alignment))
 	}
 
+	if b.flexGrow > 0 {
+		parts = append(parts, fmt.Sprintf("grow=%d", b.flexGrow))
+	}
+
+	if b.flexShrink > 0 {
+		parts = append(parts, fmt.Sprintf("shrink=%d", b.flexShrink))
+	}
+
+	if b.overflow != value2.OverflowClip {
+		parts = append(parts, fmt.Sprintf("overflow=%s", b.overflow))
+	}
+
+	if b.scrollTop > 0 {
+		parts = append(parts, fmt.Sprintf("scroll=%d", b.scrollTop))
+	}
+
 	parts = append(parts, fmt.Sprintf("total=%dx%d", totalSize.Width(), totalSize.Height()))
 
 	return fmt.Sprintf("Box{%s}", strings.Join(parts, " "))
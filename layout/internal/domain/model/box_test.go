@@ -585,3 +585,79 @@ func TestBox_Immutability(t *testing.T) {
 		t.Error("Alignment was mutated")
 	}
 }
+
+// TestBox_WithTitle tests the border title and its alignment.
+func TestBox_WithTitle(t *testing.T) {
+	box := NewBox("Hi")
+	if box.Title() != "" || box.TitleAlign() != value2.AlignLeft {
+		t.Errorf("default title = %q (%v), want empty (Left)", box.Title(), box.TitleAlign())
+	}
+
+	titled := box.WithTitle("Info").WithTitleAlign(value2.AlignRight)
+	if titled.Title() != "Info" || titled.TitleAlign() != value2.AlignRight {
+		t.Errorf("title = %q (%v), want \"Info\" (Right)", titled.Title(), titled.TitleAlign())
+	}
+	if box.Title() != "" {
+		t.Error("original box should be unchanged")
+	}
+}
+
+// TestBox_WithOverflow tests the overflow policy and scroll offset.
+func TestBox_WithOverflow(t *testing.T) {
+	box := NewBox("Hi")
+	if box.Overflow() != value2.OverflowClip || box.ScrollTop() != 0 {
+		t.Errorf("defaults = %s/%d, want clip/0", box.Overflow(), box.ScrollTop())
+	}
+
+	scrolled := box.WithOverflow(value2.OverflowScroll).WithScrollTop(3)
+	if scrolled.Overflow() != value2.OverflowScroll || scrolled.ScrollTop() != 3 {
+		t.Errorf("got %s/%d, want scroll/3", scrolled.Overflow(), scrolled.ScrollTop())
+	}
+	if box.Overflow() != value2.OverflowClip {
+		t.Error("original box should be unchanged")
+	}
+	if s := scrolled.String(); !strings.Contains(s, "overflow=scroll") || !strings.Contains(s, "scroll=3") {
+		t.Errorf("String() = %q, want overflow and scroll offset", s)
+	}
+
+	for name, fn := range map[string]func(){
+		"invalid overflow": func() { box.WithOverflow(value2.Overflow(42)) },
+		"negative scroll":  func() { box.WithScrollTop(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+// TestBox_ResolveFractions tests relative sizes resolved against a parent.
+func TestBox_ResolveFractions(t *testing.T) {
+	box := NewBox("Hi").
+		WithWidthFraction(value2.NewFraction(1, 3)).
+		WithHeightFraction(value2.NewPercent(50))
+
+	resolved := box.ResolveFractions(80, 24)
+	if resolved.Size().Width() != 26 || resolved.Size().Height() != 12 {
+		t.Errorf("resolved size = %s, want 26x12", resolved.Size())
+	}
+	if box.Size().HasWidth() {
+		t.Error("original box should be unchanged")
+	}
+
+	clamped := box.WithSize(value2.NewSizeUnconstrained().WithMaxWidth(10).WithMinHeight(20)).ResolveFractions(80, 24)
+	if clamped.Size().Width() != 10 || clamped.Size().Height() != 20 {
+		t.Errorf("clamped size = %s, want 10x20", clamped.Size())
+	}
+
+	if plain := NewBox("Hi"); plain.ResolveFractions(80, 24) != plain {
+		t.Error("box without fractions should be returned unchanged")
+	}
+	if s := box.String(); !strings.Contains(s, "width=1/3") || !strings.Contains(s, "height=50%") {
+		t.Errorf("String() = %q, want relative sizes", s)
+	}
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GridItem is a box placed in a grid, spanning one or more columns and rows.
+type GridItem struct {
+	box     *Box
+	colSpan int
+	rowSpan int
+}
+
+// NewGridItem creates a GridItem spanning colSpan columns and rowSpan rows.
+// Panics if box is nil or a span is less than 1.
+func NewGridItem(box *Box, colSpan, rowSpan int) GridItem {
+	if box == nil {
+		panic("grid: item box cannot be nil")
+	}
+	if colSpan < 1 || rowSpan < 1 {
+		panic(fmt.Sprintf("grid: spans must be at least 1, got %dx%d", colSpan, rowSpan))
+	}
+	return GridItem{box: box, colSpan: colSpan, rowSpan: rowSpan}
+}
+
+// Box returns the item's box.
+func (i GridItem) Box() *Box {
+	return i.box
+}
+
+// ColSpan returns the number of columns the item spans.
+func (i GridItem) ColSpan() int {
+	return i.colSpan
+}
+
+// RowSpan returns the number of rows the item spans.
+func (i GridItem) RowSpan() int {
+	return i.rowSpan
+}
+
+// GridContainer arranges boxes into a fixed number of columns.
+//
+// Design Philosophy:
+//   - Simplified CSS Grid (auto-flow only, no explicit placement)
+//   - Items flow left-to-right, wrapping to a new row when a row is full
+//   - Column widths and row heights come from the largest cell in each track
+//   - Immutable operations (returns new instances)
+//
+// Example:
+//
+//	grid := NewGridContainer(2).
+//		WithGaps(0, 2).
+//		AddItem(NewGridItem(NewBox("Name:"), 1, 1)).
+//		AddItem(NewGridItem(NewBox("Phoenix"), 1, 1))
+type GridContainer struct {
+	columns   int        // Number of columns (>= 1)
+	rowGap    int        // Empty lines between rows
+	columnGap int        // Empty cells between columns
+	items     []GridItem // Items in flow order
+}
+
+// NewGridContainer creates an empty grid with the given number of columns.
+// Panics if columns is less than 1.
+func NewGridContainer(columns int) *GridContainer {
+	if columns < 1 {
+		panic(fmt.Sprintf("grid: columns must be at least 1, got %d", columns))
+	}
+	return &GridContainer{
+		columns: columns,
+		items:   []GridItem{},
+	}
+}
+
+// Columns returns the number of columns.
+func (g *GridContainer) Columns() int {
+	return g.columns
+}
+
+// RowGap returns the number of empty lines between rows.
+func (g *GridContainer) RowGap() int {
+	return g.rowGap
+}
+
+// ColumnGap returns the number of empty cells between columns.
+func (g *GridContainer) ColumnGap() int {
+	return g.columnGap
+}
+
+// Items returns a copy of the items in flow order.
+func (g *GridContainer) Items() []GridItem {
+	result := make([]GridItem, len(g.items))
+	copy(result, g.items)
+	return result
+}
+
+// ItemCount returns the number of items.
+func (g *GridContainer) ItemCount() int {
+	return len(g.items)
+}
+
+// WithGaps returns a new GridContainer with the given row and column gaps.
+// Negative gaps are treated as 0.
+func (g *GridContainer) WithGaps(rowGap, columnGap int) *GridContainer {
+	result := *g
+	result.rowGap = max(rowGap, 0)
+	result.columnGap = max(columnGap, 0)
+	return &result
+}
+
+// AddItem returns a new GridContainer with the given item appended.
+func (g *GridContainer) AddItem(item GridItem) *GridContainer {
+	result := *g
+	result.items = make([]GridItem, len(g.items)+1)
+	copy(result.items, g.items)
+	result.items[len(result.items)-1] = item
+	return &result
+}
+
+// String returns a human-readable debug representation.
+func (g *GridContainer) String() string {
+	parts := []string{
+		fmt.Sprintf("columns=%d", g.columns),
+		fmt.Sprintf("items=%d", len(g.items)),
+	}
+	if g.rowGap > 0 || g.columnGap > 0 {
+		parts = append(parts, fmt.Sprintf("gap=%dx%d", g.rowGap, g.columnGap))
+	}
+	return fmt.Sprintf("Grid{%s}", strings.Join(parts, " "))
+}
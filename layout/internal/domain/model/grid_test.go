@@ -0,0 +1,55 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewGridContainer(t *testing.T) {
+	grid := NewGridContainer(3)
+	if grid.Columns() != 3 || grid.ItemCount() != 0 || grid.RowGap() != 0 || grid.ColumnGap() != 0 {
+		t.Errorf("unexpected defaults: %s", grid)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for 0 columns")
+		}
+	}()
+	NewGridContainer(0)
+}
+
+func TestGridContainer_Immutability(t *testing.T) {
+	grid := NewGridContainer(2)
+	updated := grid.WithGaps(1, -2).AddItem(NewGridItem(NewBox("A"), 2, 1))
+
+	if grid.ItemCount() != 0 || grid.RowGap() != 0 {
+		t.Error("original grid was mutated")
+	}
+	if updated.RowGap() != 1 || updated.ColumnGap() != 0 {
+		t.Errorf("gaps = %dx%d, want 1x0 (negative clamped)", updated.RowGap(), updated.ColumnGap())
+	}
+	if items := updated.Items(); len(items) != 1 || items[0].ColSpan() != 2 || items[0].RowSpan() != 1 {
+		t.Errorf("items = %+v, want one 2x1 item", items)
+	}
+	if s := updated.String(); !strings.Contains(s, "columns=2") || !strings.Contains(s, "gap=1x0") {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestNewGridItem_Panics(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"nil box":     func() { NewGridItem(nil, 1, 1) },
+		"zero span":   func() { NewGridItem(NewBox("A"), 0, 1) },
+		"negative rs": func() { NewGridItem(NewBox("A"), 1, -1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
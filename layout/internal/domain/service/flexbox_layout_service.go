@@ -14,13 +14,15 @@ import (
 //   - Supports row/column direction
 //   - Handles justify-content and align-items
 //   - Gap support between items
+//   - Flex grow/shrink along the main axis
 //
 // Algorithm Overview:
 //  1. Measure all items (get natural sizes)
-//  2. Calculate main axis distribution (justify-content)
-//  3. Calculate cross axis positioning (align-items)
-//  4. Apply gap spacing
-//  5. Set final positions on nodes
+//  2. Resolve flexible sizes (grow into leftover space, shrink on overflow)
+//  3. Calculate main axis distribution (justify-content)
+//  4. Calculate cross axis positioning (align-items)
+//  5. Apply gap spacing
+//  6. Set final positions (and flexed sizes) on nodes
 //
 // Example:
 //
@@ -55,10 +57,18 @@ func (f *FlexboxLayoutService) Layout(
 		return container // Nothing to layout
 	}
 
-	// 1. Measure all items
+	// 1. Resolve relative sizes, then measure all items
+	container = f.resolveFractions(container, containerWidth, containerHeight)
 	itemSizes := f.measureItems(container)
 
-	// 2. Calculate main axis positions
+	// 2. Resolve flexible sizes
+	mainSize := containerWidth
+	if container.IsVertical() {
+		mainSize = containerHeight
+	}
+	itemSizes, flexed := f.resolveFlexSizes(container, itemSizes, mainSize)
+
+	// 3. Calculate main axis positions
 	mainAxisPositions := f.calculateMainAxisPositions(
 		container,
 		itemSizes,
@@ -66,7 +76,7 @@ func (f *FlexboxLayoutService) Layout(
 		containerHeight,
 	)
 
-	// 3. Calculate cross axis positions
+	// 4. Calculate cross axis positions
 	crossAxisPositions := f.calculateCrossAxisPositions(
 		container,
 		itemSizes,
@@ -74,11 +84,23 @@ func (f *FlexboxLayoutService) Layout(
 		containerHeight,
 	)
 
-	// 4. Apply positions to items
+	// 5. Apply positions (and flexed sizes) to items
 	newItems := make([]*model2.Node, len(container.Items()))
 	items := container.Items()
 
 	for i := 0; i < len(items); i++ {
+		item := items[i]
+		if flexed[i] {
+			box := item.Box()
+			size := box.Size()
+			if container.IsHorizontal() {
+				size = size.WithWidth(itemSizes[i].Width())
+			} else {
+				size = size.WithHeight(itemSizes[i].Height())
+			}
+			item = item.SetBox(box.WithSize(size))
+		}
+
 		var x, y int
 
 		if container.IsHorizontal() {
@@ -89,10 +111,10 @@ func (f *FlexboxLayoutService) Layout(
 			y = mainAxisPositions[i]
 		}
 
-		newItems[i] = items[i].SetPosition(value2.NewPosition(x, y))
+		newItems[i] = item.SetPosition(value2.NewPosition(x, y))
 	}
 
-	// 5. Return new container with positioned items
+	// 6. Return new container with positioned items
 	result := container.ClearItems()
 	for _, item := range newItems {
 		result = result.AddItem(item)
@@ -113,6 +135,228 @@ func (f *FlexboxLayoutService) measureItems(container *model2.FlexContainer) []v
 	return sizes
 }
 
+// resolveFractions turns the relative sizes of items into exact sizes.
+// On the main axis, fractions are taken of the space left after gaps and
+// split between siblings with value.SplitFractions, so rounding never
+// overshoots the container; on the cross axis each fraction is taken of
+// the full container size.
+func (f *FlexboxLayoutService) resolveFractions(
+	container *model2.FlexContainer,
+	containerWidth, containerHeight int,
+) *model2.FlexContainer {
+	items := container.Items()
+	horizontal := container.IsHorizontal()
+
+	mainFractions := make([]value2.Fraction, len(items))
+	hasFractions := false
+	for i, item := range items {
+		box := item.Box()
+		if horizontal {
+			mainFractions[i] = box.WidthFraction()
+		} else {
+			mainFractions[i] = box.HeightFraction()
+		}
+		hasFractions = hasFractions || !box.WidthFraction().IsZero() || !box.HeightFraction().IsZero()
+	}
+	if !hasFractions {
+		return container
+	}
+
+	mainSize := containerWidth
+	if !horizontal {
+		mainSize = containerHeight
+	}
+	mainSizes := value2.SplitFractions(mainFractions, max(mainSize-container.TotalGap(), 0))
+
+	result := container.ClearItems()
+	for i, item := range items {
+		box := item.Box()
+		switch {
+		case mainFractions[i].IsZero():
+			box = box.ResolveFractions(containerWidth, containerHeight)
+		case horizontal:
+			box = box.WithWidthFraction(value2.Fraction{}).ResolveFractions(containerWidth, containerHeight)
+			box = box.WithResolvedWidth(mainSizes[i])
+		default:
+			box = box.WithHeightFraction(value2.Fraction{}).ResolveFractions(containerWidth, containerHeight)
+			box = box.WithResolvedHeight(mainSizes[i])
+		}
+		result = result.AddItem(item.SetBox(box))
+	}
+
+	return result
+}
+
+// resolveFlexSizes grows or shrinks items along the main axis to fit
+// mainSize. It returns the resulting sizes and which items changed.
+//
+// Leftover space goes to items in proportion to FlexGrow; overflow is taken
+// from items in proportion to FlexShrink times natural size. Items are
+// clamped to their min/max constraints (and never below their frame), and
+// the space a clamped item could not take is redistributed among the rest.
+// Items with an exact size on the main axis are never flexed.
+func (f *FlexboxLayoutService) resolveFlexSizes(
+	container *model2.FlexContainer,
+	itemSizes []value2.Size,
+	mainSize int,
+) ([]value2.Size, []bool) {
+	items := container.Items()
+	horizontal := container.IsHorizontal()
+
+	base := make([]int, len(items))
+	minSize := make([]int, len(items))
+	maxSize := make([]int, len(items))
+	used := container.TotalGap()
+
+	for i, item := range items {
+		box := item.Box()
+		constraints := box.Size()
+		frameW, frameH := f.measureService.MeasureFrame(box)
+
+		if horizontal {
+			base[i] = itemSizes[i].Width()
+			minSize[i], maxSize[i] = frameW, -1
+			if constraints.HasMinWidth() && constraints.MinWidth() > minSize[i] {
+				minSize[i] = constraints.MinWidth()
+			}
+			if constraints.HasMaxWidth() {
+				maxSize[i] = constraints.MaxWidth()
+			}
+			if constraints.HasWidth() {
+				minSize[i], maxSize[i] = base[i], base[i]
+			}
+		} else {
+			base[i] = itemSizes[i].Height()
+			minSize[i], maxSize[i] = frameH, -1
+			if constraints.HasMinHeight() && constraints.MinHeight() > minSize[i] {
+				minSize[i] = constraints.MinHeight()
+			}
+			if constraints.HasMaxHeight() {
+				maxSize[i] = constraints.MaxHeight()
+			}
+			if constraints.HasHeight() {
+				minSize[i], maxSize[i] = base[i], base[i]
+			}
+		}
+		used += base[i]
+	}
+
+	free := mainSize - used
+	weights := make([]int, len(items))
+	for i, item := range items {
+		if free > 0 {
+			weights[i] = item.Box().FlexGrow()
+		} else if free < 0 {
+			weights[i] = item.Box().FlexShrink() * base[i]
+		}
+	}
+
+	sizes := distributeFlex(base, weights, minSize, maxSize, free)
+
+	result := make([]value2.Size, len(itemSizes))
+	flexed := make([]bool, len(itemSizes))
+	for i, size := range itemSizes {
+		result[i] = size
+		if sizes[i] == base[i] {
+			continue
+		}
+		flexed[i] = true
+		if horizontal {
+			result[i] = value2.NewSizeExact(sizes[i], size.Height())
+		} else {
+			result[i] = value2.NewSizeExact(size.Width(), sizes[i])
+		}
+	}
+
+	return result, flexed
+}
+
+// distributeFlex adds free (positive: grow, negative: shrink) to base sizes
+// in proportion to weights. Shares are whole cells; remainders go to the
+// items with the largest fractional share, earlier items first on ties, so
+// the sizes always add up exactly unless every item hits a limit.
+// Items clamped to minSize/maxSize (maxSize < 0 = unlimited) are frozen and
+// the distribution is repeated for the others.
+func distributeFlex(base, weights, minSize, maxSize []int, free int) []int {
+	sizes := make([]int, len(base))
+	copy(sizes, base)
+	frozen := make([]bool, len(base))
+
+	for free != 0 {
+		totalWeight := 0
+		for i, w := range weights {
+			if !frozen[i] {
+				totalWeight += w
+			}
+		}
+		if totalWeight == 0 {
+			break
+		}
+
+		// Whole-cell shares, then hand out the remainder by largest fraction.
+		shares := make([]int, len(base))
+		remainders := make([]int, len(base))
+		given := 0
+		for i, w := range weights {
+			if frozen[i] || w == 0 {
+				continue
+			}
+			shares[i] = free * w / totalWeight
+			remainders[i] = free * w % totalWeight
+			given += shares[i]
+		}
+		step := 1
+		if free < 0 {
+			step = -1
+		}
+		for left := free - given; left != 0; left -= step {
+			best := -1
+			for i, w := range weights {
+				if frozen[i] || w == 0 {
+					continue
+				}
+				if best < 0 || remainders[i]*step > remainders[best]*step {
+					best = i
+				}
+			}
+			shares[best] += step
+			remainders[best] = 0
+		}
+
+		// Clamp to limits; clamped items are frozen and their unused
+		// share stays in the pool for the next round.
+		clamped := false
+		for i := range sizes {
+			if frozen[i] || weights[i] == 0 {
+				continue
+			}
+			target := sizes[i] + shares[i]
+			if target < minSize[i] {
+				target, frozen[i], clamped = minSize[i], true, true
+			}
+			if maxSize[i] >= 0 && target > maxSize[i] {
+				target, frozen[i], clamped = maxSize[i], true, true
+			}
+			if frozen[i] {
+				free -= target - sizes[i]
+				sizes[i] = target
+			}
+		}
+		if clamped {
+			continue
+		}
+
+		for i := range sizes {
+			if !frozen[i] {
+				sizes[i] += shares[i]
+			}
+		}
+		break
+	}
+
+	return sizes
+}
+
 // calculateMainAxisPositions calculates positions along the main axis (row/column direction).
 // This implements justify-content (start, end, center, space-between).
 //
@@ -303,8 +547,7 @@ func (f *FlexboxLayoutService) LayoutWithDetails(
 
 	for i, item := range items {
 		positions[i] = item.Position()
-		totalSize := item.Box().TotalSize()
-		sizes[i] = totalSize
+		sizes[i] = f.measureService.Measure(item.Box()) // Includes flexed sizes
 	}
 
 	return LayoutResult{
@@ -437,3 +437,148 @@ func TestFlexboxLayoutService_Layout_Overflow(t *testing.T) {
 		}
 	}
 }
+
+func TestFlexboxLayoutService_Layout_FlexGrow(t *testing.T) {
+	service := NewFlexboxLayoutService(NewMeasureService())
+
+	// Natural widths 3 + 4 + 3 = 10, gap 1 x 2 = 2
+	container := model2.NewFlexContainer(value2.FlexDirectionRow).
+		WithGap(1).
+		AddItems(
+			model2.NewNode(model2.NewBox("Nav")),
+			model2.NewNode(model2.NewBox("Main").WithFlexGrow(2)),
+			model2.NewNode(model2.NewBox("Aux").WithFlexGrow(1)),
+		)
+
+	tests := []struct {
+		width     int
+		wantSizes []int
+		wantX     []int
+	}{
+		{40, []int{3, 23, 12}, []int{0, 4, 28}}, // 28 free: 2/3 → 18.67, 1/3 → 9.33
+		{80, []int{3, 49, 26}, []int{0, 4, 54}}, // 68 free: 45.33 / 22.67
+		{13, []int{3, 5, 3}, []int{0, 4, 10}},   // 1 free: largest remainder goes to Main
+		{12, []int{3, 4, 3}, []int{0, 4, 9}},    // No free space
+	}
+
+	for _, tt := range tests {
+		result := service.LayoutWithDetails(container, tt.width, 5)
+		total := container.TotalGap()
+		for i := range tt.wantSizes {
+			if got := result.ItemSizes[i].Width(); got != tt.wantSizes[i] {
+				t.Errorf("width %d: item %d width = %d, want %d", tt.width, i, got, tt.wantSizes[i])
+			}
+			if got := result.ItemPositions[i].X(); got != tt.wantX[i] {
+				t.Errorf("width %d: item %d x = %d, want %d", tt.width, i, got, tt.wantX[i])
+			}
+			total += result.ItemSizes[i].Width()
+		}
+		if tt.width >= 12 && total != tt.width {
+			t.Errorf("width %d: items + gaps = %d, want the full width", tt.width, total)
+		}
+	}
+}
+
+func TestFlexboxLayoutService_Layout_FlexGrowMax(t *testing.T) {
+	service := NewFlexboxLayoutService(NewMeasureService())
+
+	// A capped at 6; the rest of its share goes to B.
+	container := model2.NewFlexContainer(value2.FlexDirectionRow).
+		AddItems(
+			model2.NewNode(model2.NewBox("A").WithFlexGrow(1).
+				WithSize(value2.NewSizeUnconstrained().WithMaxWidth(6))),
+			model2.NewNode(model2.NewBox("B").WithFlexGrow(1)),
+		)
+
+	result := service.LayoutWithDetails(container, 30, 1)
+	if got := result.ItemSizes[0].Width(); got != 6 {
+		t.Errorf("capped item width = %d, want 6", got)
+	}
+	if got := result.ItemSizes[1].Width(); got != 24 {
+		t.Errorf("other item width = %d, want 24", got)
+	}
+}
+
+func TestFlexboxLayoutService_Layout_FlexShrink(t *testing.T) {
+	service := NewFlexboxLayoutService(NewMeasureService())
+
+	container := model2.NewFlexContainer(value2.FlexDirectionRow).
+		AddItems(
+			model2.NewNode(model2.NewBox("AAAAAAAAAA").WithFlexShrink(1)), // 10
+			model2.NewNode(model2.NewBox("BBBBBBBBBBBBBBBBBBBB").WithFlexShrink(1).
+				WithSize(value2.NewSizeUnconstrained().WithMinWidth(16))), // 20, min 16
+			model2.NewNode(model2.NewBox("CCCCC")), // 5, never shrinks
+		)
+
+	tests := []struct {
+		width     int
+		wantSizes []int
+	}{
+		{35, []int{10, 20, 5}}, // Fits
+		{32, []int{9, 18, 5}},  // 3 over: split 1:2 by natural size
+		{25, []int{4, 16, 5}},  // B clamps at its minimum, A absorbs the rest
+		{10, []int{0, 16, 5}},  // Cannot fit: A at 0, B at its minimum
+	}
+
+	for _, tt := range tests {
+		result := service.LayoutWithDetails(container, tt.width, 1)
+		for i, want := range tt.wantSizes {
+			if got := result.ItemSizes[i].Width(); got != want {
+				t.Errorf("width %d: item %d width = %d, want %d", tt.width, i, got, want)
+			}
+		}
+	}
+}
+
+func TestFlexboxLayoutService_Layout_FlexColumn(t *testing.T) {
+	service := NewFlexboxLayoutService(NewMeasureService())
+
+	container := model2.NewFlexContainer(value2.FlexDirectionColumn).
+		AddItems(
+			model2.NewNode(model2.NewBox("Header")),
+			model2.NewNode(model2.NewBox("Body").WithFlexGrow(1)),
+			model2.NewNode(model2.NewBox("Footer")),
+		)
+
+	result := service.LayoutWithDetails(container, 40, 24)
+	if got := result.ItemSizes[1].Height(); got != 22 {
+		t.Errorf("body height = %d, want 22", got)
+	}
+	if got := result.ItemPositions[2].Y(); got != 23 {
+		t.Errorf("footer y = %d, want 23", got)
+	}
+}
+
+func TestFlexboxLayoutService_Layout_Fractions(t *testing.T) {
+	service := NewFlexboxLayoutService(NewMeasureService())
+	third := value2.NewFraction(1, 3)
+
+	// Thirds of the 11 cells left after two gaps of 2.
+	container := model2.NewFlexContainer(value2.FlexDirectionRow).
+		WithGap(2).
+		AddItems(
+			model2.NewNode(model2.NewBox("A").WithWidthFraction(third)),
+			model2.NewNode(model2.NewBox("B").WithWidthFraction(third).WithHeightFraction(value2.NewPercent(50))),
+			model2.NewNode(model2.NewBox("C").WithWidthFraction(third)),
+		)
+
+	result := service.LayoutWithDetails(container, 15, 4)
+	for i, want := range []int{3, 4, 4} {
+		if got := result.ItemSizes[i].Width(); got != want {
+			t.Errorf("item %d width = %d, want %d", i, got, want)
+		}
+	}
+	if got := result.ItemPositions[2].X(); got != 11 {
+		t.Errorf("last item x = %d, want 11 (flush with the container edge)", got)
+	}
+	if got := result.ItemSizes[1].Height(); got != 2 {
+		t.Errorf("cross-axis height = %d, want 2 (50%% of 4)", got)
+	}
+}
+
+func TestDistributeFlex_NoWeights(t *testing.T) {
+	got := distributeFlex([]int{3, 4}, []int{0, 0}, []int{0, 0}, []int{-1, -1}, 10)
+	if got[0] != 3 || got[1] != 4 {
+		t.Errorf("distributeFlex() with zero weights = %v, want unchanged", got)
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"strings"
+
+	model2 "github.com/phoenix-tui/phoenix/layout/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+// GridLayoutService places grid items into cells and renders the grid.
+//
+// Algorithm:
+//  1. Auto-flow: items fill the grid left-to-right, wrapping to the next
+//     row when the current row has no room; spanning items skip cells that
+//     are already taken by earlier row spans
+//  2. Track sizing: each column is as wide as its widest single-column
+//     cell (display width, grapheme-aware) and each row as tall as its
+//     tallest single-row cell; spanning cells then grow the tracks they
+//     cover evenly if they still do not fit
+//  3. Rendering: each box is drawn at its natural size inside its cell
+//     area, positioned by the box's own alignment
+//
+// Example:
+//
+//	gs := NewGridLayoutService(NewMeasureService())
+//	grid := model.NewGridContainer(2).
+//		AddItem(model.NewGridItem(model.NewBox("Name:"), 1, 1)).
+//		AddItem(model.NewGridItem(model.NewBox("Phoenix"), 1, 1))
+//
+//	output := gs.Render(grid) // "Name:Phoenix"
+type GridLayoutService struct {
+	measureService *MeasureService
+	renderService  *RenderService
+	overlayService *OverlayService
+}
+
+// NewGridLayoutService creates a new GridLayoutService.
+// Panics if measureService is nil.
+func NewGridLayoutService(measureService *MeasureService) *GridLayoutService {
+	if measureService == nil {
+		panic("measureService cannot be nil")
+	}
+	return &GridLayoutService{
+		measureService: measureService,
+		renderService:  NewRenderService(),
+		overlayService: NewOverlayService(),
+	}
+}
+
+// GridCell is an item placed by GridLayoutService.
+// X, Y, Width and Height describe the whole cell area in terminal cells,
+// including the gaps inside a span.
+type GridCell struct {
+	Box    *model2.Box
+	Row    int // First grid row (0-based)
+	Column int // First grid column (0-based)
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// GridLayout is the result of a grid layout calculation.
+type GridLayout struct {
+	ColumnWidths []int
+	RowHeights   []int
+	Cells        []GridCell
+	Width        int // Total width including column gaps
+	Height       int // Total height including row gaps
+}
+
+// Layout places the grid's items and sizes its tracks.
+func (gs *GridLayoutService) Layout(grid *model2.GridContainer) GridLayout {
+	result, _ := gs.layout(grid)
+	return result
+}
+
+// Render lays out the grid and returns its text output.
+// Empty cells are filled with spaces; an empty grid renders as "".
+func (gs *GridLayoutService) Render(grid *model2.GridContainer) string {
+	result, blocks := gs.layout(grid)
+	if result.Width == 0 || result.Height == 0 {
+		return ""
+	}
+
+	canvas := blankCanvas(result.Width, result.Height)
+	for i, cell := range result.Cells {
+		blockWidth, blockHeight := blockSize(blocks[i])
+		dx, dy := cell.Box.Alignment().CalculateOffsets(blockWidth, blockHeight, cell.Width, cell.Height)
+		canvas = gs.overlayService.Overlay(canvas, blocks[i], cell.X+dx, cell.Y+dy)
+	}
+	return canvas
+}
+
+// layout computes the grid layout and the rendered block of every item.
+func (gs *GridLayoutService) layout(grid *model2.GridContainer) (GridLayout, []string) {
+	items := grid.Items()
+	columns := grid.Columns()
+
+	// Step 1: Render every box at its natural size
+	blocks := make([]string, len(items))
+	widths := make([]int, len(items))
+	heights := make([]int, len(items))
+	for i, item := range items {
+		size := gs.measureService.Measure(item.Box())
+		blocks[i] = gs.renderService.Render(item.Box().WithSize(value2.NewSizeExact(size.Width(), size.Height())))
+		widths[i], heights[i] = blockSize(blocks[i])
+	}
+
+	// Step 2: Auto-flow placement
+	rows, cols, colSpans, rowCount := placeGridItems(items, columns)
+
+	// Step 3: Track sizing (single-track cells first, then spans)
+	columnWidths := make([]int, columns)
+	rowHeights := make([]int, rowCount)
+	for i, item := range items {
+		if colSpans[i] == 1 {
+			columnWidths[cols[i]] = max(columnWidths[cols[i]], widths[i])
+		}
+		if item.RowSpan() == 1 {
+			rowHeights[rows[i]] = max(rowHeights[rows[i]], heights[i])
+		}
+	}
+	for i, item := range items {
+		if colSpans[i] > 1 {
+			growTracks(columnWidths[cols[i]:cols[i]+colSpans[i]], grid.ColumnGap(), widths[i])
+		}
+		if item.RowSpan() > 1 {
+			growTracks(rowHeights[rows[i]:rows[i]+item.RowSpan()], grid.RowGap(), heights[i])
+		}
+	}
+
+	// Step 4: Cell areas
+	xs := trackOffsets(columnWidths, grid.ColumnGap())
+	ys := trackOffsets(rowHeights, grid.RowGap())
+	cells := make([]GridCell, len(items))
+	for i, item := range items {
+		cells[i] = GridCell{
+			Box:    item.Box(),
+			Row:    rows[i],
+			Column: cols[i],
+			X:      xs[cols[i]],
+			Y:      ys[rows[i]],
+			Width:  spanSize(columnWidths[cols[i]:cols[i]+colSpans[i]], grid.ColumnGap()),
+			Height: spanSize(rowHeights[rows[i]:rows[i]+item.RowSpan()], grid.RowGap()),
+		}
+	}
+
+	return GridLayout{
+		ColumnWidths: columnWidths,
+		RowHeights:   rowHeights,
+		Cells:        cells,
+		Width:        spanSize(columnWidths, grid.ColumnGap()),
+		Height:       spanSize(rowHeights, grid.RowGap()),
+	}, blocks
+}
+
+// placeGridItems auto-flows items into a grid with the given number of
+// columns. Column spans wider than the grid are clamped to it.
+// Returns each item's row, column and effective column span, and the
+// number of rows used.
+func placeGridItems(items []model2.GridItem, columns int) (rows, cols, colSpans []int, rowCount int) {
+	rows = make([]int, len(items))
+	cols = make([]int, len(items))
+	colSpans = make([]int, len(items))
+
+	var occupied [][]bool
+	taken := func(row, col int) bool {
+		return row < len(occupied) && occupied[row][col]
+	}
+	fits := func(row, col, colSpan, rowSpan int) bool {
+		for r := row; r < row+rowSpan; r++ {
+			for c := col; c < col+colSpan; c++ {
+				if taken(r, c) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	row, col := 0, 0
+	for i, item := range items {
+		colSpan := min(item.ColSpan(), columns)
+		for col+colSpan > columns || !fits(row, col, colSpan, item.RowSpan()) {
+			col++
+			if col+colSpan > columns {
+				row++
+				col = 0
+			}
+		}
+
+		for len(occupied) < row+item.RowSpan() {
+			occupied = append(occupied, make([]bool, columns))
+		}
+		for r := row; r < row+item.RowSpan(); r++ {
+			for c := col; c < col+colSpan; c++ {
+				occupied[r][c] = true
+			}
+		}
+
+		rows[i], cols[i], colSpans[i] = row, col, colSpan
+		col += colSpan
+	}
+
+	return rows, cols, colSpans, len(occupied)
+}
+
+// growTracks widens tracks evenly (extra cells go to the first tracks)
+// until they span at least need cells, gaps included.
+func growTracks(tracks []int, gap, need int) {
+	extra := need - spanSize(tracks, gap)
+	if extra <= 0 {
+		return
+	}
+	for i := range tracks {
+		tracks[i] += extra / len(tracks)
+		if i < extra%len(tracks) {
+			tracks[i]++
+		}
+	}
+}
+
+// spanSize returns the total size of consecutive tracks and the gaps
+// between them.
+func spanSize(tracks []int, gap int) int {
+	if len(tracks) == 0 {
+		return 0
+	}
+	total := gap * (len(tracks) - 1)
+	for _, t := range tracks {
+		total += t
+	}
+	return total
+}
+
+// trackOffsets returns the starting offset of each track.
+func trackOffsets(tracks []int, gap int) []int {
+	offsets := make([]int, len(tracks))
+	for i := 1; i < len(tracks); i++ {
+		offsets[i] = offsets[i-1] + tracks[i-1] + gap
+	}
+	return offsets
+}
+
+// blockSize returns the display width and line count of a rendered block.
+func blockSize(block string) (width, height int) {
+	lines := strings.Split(block, "\n")
+	for _, line := range lines {
+		width = max(width, style.Width(line))
+	}
+	return width, len(lines)
+}
+
+// blankCanvas returns height lines of width spaces.
+func blankCanvas(width, height int) string {
+	line := strings.Repeat(" ", width)
+	lines := make([]string, height)
+	for i := range lines {
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
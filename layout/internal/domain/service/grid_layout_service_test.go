@@ -0,0 +1,102 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	model2 "github.com/phoenix-tui/phoenix/layout/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func gridOf(columns int, items ...model2.GridItem) *model2.GridContainer {
+	grid := model2.NewGridContainer(columns)
+	for _, item := range items {
+		grid = grid.AddItem(item)
+	}
+	return grid
+}
+
+func cell(content string) model2.GridItem {
+	return model2.NewGridItem(model2.NewBox(content), 1, 1)
+}
+
+func TestGridLayout_AutoFlow(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+	result := gs.Layout(gridOf(2, cell("a"), cell("bb"), cell("ccc"), cell("d"), cell("e")))
+
+	assert.Equal(t, []int{3, 2}, result.ColumnWidths, "widest cell per column")
+	assert.Equal(t, []int{1, 1, 1}, result.RowHeights)
+	assert.Equal(t, 5, result.Width)
+	assert.Equal(t, 3, result.Height)
+
+	var placed [][2]int
+	for _, c := range result.Cells {
+		placed = append(placed, [2]int{c.Row, c.Column})
+	}
+	assert.Equal(t, [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}}, placed)
+}
+
+func TestGridLayout_GraphemeWidths(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+	result := gs.Layout(gridOf(2, cell("日本語"), cell("👋🏽"), cell("abc"), cell("x")))
+
+	assert.Equal(t, []int{6, 2}, result.ColumnWidths)
+	assert.Equal(t, "日本語👋🏽\nabc   x ", gs.Render(gridOf(2, cell("日本語"), cell("👋🏽"), cell("abc"), cell("x"))))
+}
+
+func TestGridLayout_Gaps(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+	grid := gridOf(2, cell("a"), cell("b"), cell("c"), cell("d")).WithGaps(1, 2)
+
+	result := gs.Layout(grid)
+	assert.Equal(t, 4, result.Width)
+	assert.Equal(t, 3, result.Height)
+	assert.Equal(t, 3, result.Cells[3].X)
+	assert.Equal(t, 2, result.Cells[3].Y)
+	assert.Equal(t, "a  b\n    \nc  d", gs.Render(grid))
+}
+
+func TestGridLayout_Spans(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+
+	t.Run("colspan grows spanned columns evenly", func(t *testing.T) {
+		grid := gridOf(2, model2.NewGridItem(model2.NewBox("header!"), 2, 1), cell("a"), cell("b"))
+		result := gs.Layout(grid)
+		assert.Equal(t, []int{4, 3}, result.ColumnWidths)
+		assert.Equal(t, 7, result.Cells[0].Width)
+		assert.Equal(t, "header!\na   b  ", gs.Render(grid))
+	})
+
+	t.Run("rowspan reserves cells below", func(t *testing.T) {
+		grid := gridOf(2,
+			model2.NewGridItem(model2.NewBox("L\nL\nL"), 1, 2),
+			cell("1"), cell("2"), cell("3"))
+		result := gs.Layout(grid)
+
+		assert.Equal(t, [2]int{1, 1}, [2]int{result.Cells[2].Row, result.Cells[2].Column})
+		assert.Equal(t, [2]int{2, 0}, [2]int{result.Cells[3].Row, result.Cells[3].Column})
+		assert.Equal(t, []int{2, 1, 1}, result.RowHeights)
+	})
+
+	t.Run("colspan wider than grid is clamped", func(t *testing.T) {
+		grid := gridOf(2, cell("a"), model2.NewGridItem(model2.NewBox("wide"), 5, 1))
+		result := gs.Layout(grid)
+		assert.Equal(t, [2]int{1, 0}, [2]int{result.Cells[1].Row, result.Cells[1].Column})
+		assert.Equal(t, 4, result.Width)
+	})
+}
+
+func TestGridLayout_CellAlignment(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+	right := model2.NewBox("1").WithAlignment(value2.NewAlignment(value2.AlignRight, value2.AlignBottom))
+	grid := gridOf(2, cell("tall\ntall"), model2.NewGridItem(right, 1, 1), cell("x"), cell("wide"))
+
+	lines := strings.Split(gs.Render(grid), "\n")
+	assert.Equal(t, []string{"tall    ", "tall   1", "x   wide"}, lines)
+}
+
+func TestGridLayout_Empty(t *testing.T) {
+	gs := NewGridLayoutService(NewMeasureService())
+	assert.Equal(t, "", gs.Render(model2.NewGridContainer(3)))
+}
@@ -70,8 +70,8 @@ func NewLayoutService(ms *MeasureService) *LayoutService {
 // Returns:
 //   - Position within parent (0-based coordinates)
 func (ls *LayoutService) Layout(box *model2.Box, parentSize value2.Size) value2.Position {
-	// Step 1: Measure box size
-	boxSize := ls.measureService.Measure(box)
+	// Step 1: Measure box size (relative sizes resolved against the parent)
+	boxSize := ls.measureService.Measure(box.ResolveFractions(parentSize.Width(), parentSize.Height()))
 
 	// Step 2: Calculate alignment offsets
 	alignment := box.Alignment()
@@ -71,8 +71,9 @@ func NewMeasureService() *MeasureService {
 // Returns:
 //   - Size with width and height including all box model layers
 func (ms *MeasureService) Measure(box *model.Box) value.Size {
-	// Step 1: Measure content (Unicode-aware)
-	contentWidth, contentHeight := ms.measureContent(box.Content())
+	// Step 1: Measure content (Unicode-aware), wrapped first when the
+	// box wraps overflowing content to a fixed or maximum width
+	contentWidth, contentHeight := ms.measureContent(ms.wrappedContent(box))
 
 	// Step 2: Add padding (explicit + implicit for borders)
 	// When border is enabled, add 1-space aesthetic padding between border and content
@@ -134,6 +135,47 @@ func (ms *MeasureService) measureContent(content string) (width, height int) {
 	return maxWidth, height
 }
 
+// wrappedContent returns the box content as it will be rendered under
+// OverflowWrap, or the content unchanged for other policies.
+func (ms *MeasureService) wrappedContent(box *model.Box) string {
+	size := box.Size()
+	if box.Overflow() != value.OverflowWrap || (!size.HasWidth() && !size.HasMaxWidth()) {
+		return box.Content()
+	}
+
+	outer := size.MaxWidth()
+	if size.HasWidth() {
+		outer = size.Width()
+	}
+	frameWidth, _ := ms.MeasureFrame(box)
+	width := outer - frameWidth
+	if width <= 0 {
+		return box.Content()
+	}
+	return strings.Join(wrapLines(strings.Split(box.Content(), "\n"), width), "\n")
+}
+
+// MeasureFrame returns the size of a box without its content: padding
+// (including the implicit spacing inside a border), border and margin.
+// A box cannot be rendered smaller than this.
+//
+// Example:
+//
+//	box := model.NewBox("Hello").WithBorder(true)
+//	w, h := ms.MeasureFrame(box) // 4, 4 (border + spacing on each side)
+func (ms *MeasureService) MeasureFrame(box *model.Box) (width, height int) {
+	padding := box.Padding()
+	width = padding.Horizontal() + box.Margin().Horizontal()
+	height = padding.Vertical() + box.Margin().Vertical()
+
+	if box.HasBorder() {
+		width += 4 // Border characters + implicit spacing
+		height += 4
+	}
+
+	return width, height
+}
+
 // MeasureContent is a public helper for measuring raw content text.
 // This is useful for measuring text before creating a box.
 //
@@ -656,3 +656,25 @@ func TestMeasure_EdgeCases(t *testing.T) {
 		assert.Equal(t, 1, size.Height())
 	})
 }
+
+// TestMeasure_OverflowWrap tests that wrapped boxes are measured at their
+// wrapped height, so flex layout reserves room for every wrapped row.
+func TestMeasure_OverflowWrap(t *testing.T) {
+	ms := NewMeasureService()
+	text := "the quick brown fox jumps"
+
+	clipped := model.NewBox(text).WithSize(value2.NewSizeUnconstrained().WithMaxWidth(9))
+	size := ms.Measure(clipped)
+	assert.Equal(t, 9, size.Width())
+	assert.Equal(t, 1, size.Height())
+
+	wrapped := clipped.WithOverflow(value2.OverflowWrap)
+	size = ms.Measure(wrapped)
+	assert.Equal(t, 9, size.Width())
+	assert.Equal(t, 3, size.Height()) // "the quick" / "brown fox" / "jumps"
+
+	bordered := wrapped.WithBorder(true).WithSize(value2.NewSizeUnconstrained().WithMaxWidth(13))
+	size = ms.Measure(bordered)
+	assert.Equal(t, 13, size.Width())
+	assert.Equal(t, 7, size.Height()) // 3 rows + spacing + border
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+// OverlayService composites one rendered block on top of another at cell
+// coordinates (absolute positioning).
+//
+// Design Philosophy:
+//   - Domain service (pure string in, string out)
+//   - Columns are visible cells: ANSI sequences are ignored for positioning
+//     and preserved in the output
+//   - The overlay is an opaque rectangle as wide as its widest line
+//   - The base defines the canvas: anything outside it is clipped
+//
+// Example:
+//
+//	os := NewOverlayService()
+//	out := os.Overlay("......\n......\n......", "ab\ncd", 2, 1)
+//	// ......
+//	// ..ab..
+//	// ..cd..
+type OverlayService struct{}
+
+// NewOverlayService creates a new OverlayService.
+func NewOverlayService() *OverlayService {
+	return &OverlayService{}
+}
+
+// Overlay draws over onto base with its top-left corner at column x, row y
+// (0-based, may be negative). Rows and columns of over that fall outside
+// base are clipped. Base lines shorter than the overlay's left edge are
+// padded with spaces; wide characters in base cut by the overlay edges are
+// replaced by spaces.
+func (os *OverlayService) Overlay(base, over string, x, y int) string {
+	baseLines := strings.Split(base, "\n")
+	overLines := strings.Split(over, "\n")
+
+	baseWidth := 0
+	for _, line := range baseLines {
+		baseWidth = max(baseWidth, style.Width(line))
+	}
+	overWidth := 0
+	for _, line := range overLines {
+		overWidth = max(overWidth, style.Width(line))
+	}
+
+	// Visible columns of the overlay on the base canvas
+	start := max(x, 0)
+	end := min(x+overWidth, baseWidth)
+	if start >= end {
+		return base
+	}
+
+	for i, overLine := range overLines {
+		row := y + i
+		if row < 0 || row >= len(baseLines) {
+			continue
+		}
+
+		line := baseLines[row]
+		lineWidth := style.Width(line)
+
+		var b strings.Builder
+
+		// Base to the left of the overlay, padded if the line is short
+		left := style.Cut(line, 0, start)
+		b.WriteString(left)
+		b.WriteString(strings.Repeat(" ", start-style.Width(left)))
+
+		// Overlay, padded to its full rectangle
+		mid := style.Cut(overLine, start-x, end-x)
+		b.WriteString(mid)
+		b.WriteString(strings.Repeat(" ", end-start-style.Width(mid)))
+
+		// Base to the right of the overlay
+		if lineWidth > end {
+			b.WriteString(style.Cut(line, end, lineWidth))
+		}
+
+		baseLines[row] = b.String()
+	}
+
+	return strings.Join(baseLines, "\n")
+}
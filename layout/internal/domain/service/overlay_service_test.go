@@ -0,0 +1,105 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style"
+)
+
+func TestOverlayService_Overlay(t *testing.T) {
+	base := strings.Join([]string{
+		"......",
+		"......",
+		"......",
+	}, "\n")
+
+	tests := []struct {
+		name string
+		over string
+		x, y int
+		want []string
+	}{
+		{
+			name: "inside",
+			over: "ab\ncd",
+			x:    2, y: 1,
+			want: []string{"......", "..ab..", "..cd.."},
+		},
+		{
+			name: "ragged overlay is a rectangle",
+			over: "abc\nd",
+			x:    0, y: 0,
+			want: []string{"abc...", "d  ...", "......"},
+		},
+		{
+			name: "clipped right and bottom",
+			over: "abcd\nefgh",
+			x:    4, y: 2,
+			want: []string{"......", "......", "....ab"},
+		},
+		{
+			name: "clipped left and top",
+			over: "abc\ndef",
+			x:    -1, y: -1,
+			want: []string{"ef....", "......", "......"},
+		},
+		{
+			name: "entirely outside",
+			over: "ab",
+			x:    10, y: 0,
+			want: []string{"......", "......", "......"},
+		},
+	}
+
+	os := NewOverlayService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := os.Overlay(base, tt.over, tt.x, tt.y)
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Errorf("Overlay() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestOverlayService_OverlayWide(t *testing.T) {
+	os := NewOverlayService()
+
+	// The overlay edges cut both 中 and 字; their remaining halves become spaces.
+	got := os.Overlay("中文字", "ab", 1, 0)
+	if got != " ab 字" {
+		t.Errorf("Overlay() over wide characters = %q, want %q", got, " ab 字")
+	}
+
+	// A wide overlay lands on the right columns.
+	got = os.Overlay("abcdef", "中", 2, 0)
+	if got != "ab中ef" {
+		t.Errorf("Overlay() of a wide character = %q, want %q", got, "ab中ef")
+	}
+}
+
+func TestOverlayService_OverlayStyled(t *testing.T) {
+	os := NewOverlayService()
+
+	base := "\x1b[31mred text\x1b[0m"
+	got := os.Overlay(base, "\x1b[1mXY\x1b[0m", 2, 0)
+
+	// Each segment keeps its own style and resets it, so colors never leak.
+	want := "\x1b[31mre\x1b[0m" + "\x1b[1mXY\x1b[0m" + "\x1b[31mtext\x1b[0m"
+	if got != want {
+		t.Errorf("Overlay() = %q, want %q", got, want)
+	}
+	if w := style.Width(got); w != style.Width(base) {
+		t.Errorf("visible width = %d, want %d (unchanged)", w, style.Width(base))
+	}
+}
+
+func TestOverlayService_ShortBaseLine(t *testing.T) {
+	os := NewOverlayService()
+
+	got := os.Overlay("......\n.", "ab", 3, 1)
+	if got != "......\n.  ab" {
+		t.Errorf("Overlay() on a short line = %q, want %q", got, "......\n.  ab")
+	}
+}
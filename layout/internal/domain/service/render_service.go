@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	model2 "github.com/phoenix-tui/phoenix/layout/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
 )
 
 // RenderService converts positioned box trees into final text output.
@@ -85,9 +87,6 @@ func (rs *RenderService) Render(box *model2.Box) string {
 	// Split content into lines
 	contentLines := strings.Split(content, "\n")
 
-	// Calculate widths
-	contentWidth := rs.calculateContentWidth(contentLines)
-
 	// Calculate total padding (explicit + implicit for borders)
 	// When border is enabled, add 1-space aesthetic padding between border and content
 	// This is added to any explicit padding (│  Hi  │ with padding=1 gives 2 spaces)
@@ -98,6 +97,51 @@ func (rs *RenderService) Render(box *model2.Box) string {
 		totalPaddingRight++
 	}
 
+	// An exact size (e.g. assigned by flex layout) is the total outer size:
+	// the content area absorbs the difference, padded or clipped.
+	// A maximum size only limits the content area; smaller content keeps
+	// its natural size. What happens to content that does not fit is up
+	// to the overflow policy.
+	size := box.Size()
+	exactWidth := size.HasWidth()
+	widthLimit := -1
+	if exactWidth || size.HasMaxWidth() {
+		frame := totalPaddingLeft + totalPaddingRight + margin.Horizontal()
+		if hasBorder {
+			frame += 2
+		}
+		outer := size.MaxWidth()
+		if exactWidth {
+			outer = size.Width()
+		}
+		widthLimit = max(outer-frame, 0)
+		if box.Overflow() == value2.OverflowWrap && widthLimit > 0 {
+			contentLines = wrapLines(contentLines, widthLimit)
+		}
+	}
+
+	contentWidth := rs.calculateContentWidth(contentLines)
+	if exactWidth {
+		contentWidth = widthLimit
+	} else if widthLimit >= 0 {
+		contentWidth = min(contentWidth, widthLimit)
+	}
+
+	if size.HasHeight() || size.HasMaxHeight() {
+		// Same vertical frame as MeasureService.Measure, which also counts
+		// one implicit spacing line inside each horizontal border edge.
+		frame := padding.Vertical() + margin.Vertical()
+		if hasBorder {
+			frame += 4
+		}
+		if size.HasHeight() {
+			rows := max(size.Height()-frame, 0)
+			contentLines = rs.fitLines(rs.visibleRows(box, contentLines, rows), rows)
+		} else {
+			contentLines = rs.visibleRows(box, contentLines, max(size.MaxHeight()-frame, 0))
+		}
+	}
+
 	// innerWidth includes content + total padding
 	innerWidth := contentWidth + totalPaddingLeft + totalPaddingRight
 
@@ -113,17 +157,26 @@ func (rs *RenderService) Render(box *model2.Box) string {
 
 	// Step 2: Top border
 	if hasBorder {
-		borderLine := rs.renderMarginLeft(margin) + "┌" + strings.Repeat("─", innerWidth) + "┐" + rs.renderMarginRight(margin)
+		topEdge := rs.renderTopEdge(innerWidth, box.Title(), box.TitleAlign())
+		borderLine := rs.renderMarginLeft(margin) + "┌" + topEdge + "┐" + rs.renderMarginRight(margin)
 		lines = append(lines, borderLine)
 	}
 
+	// The area inside the border (padding + content) is filled with the
+	// background color, if any, so every line is padded to full width.
+	paint := func(s string) string { return s }
+	bg, hasBackground := box.Background()
+	if hasBackground {
+		paint = backgroundPainter(bg)
+	}
+
 	// Step 3: Padding top
 	for i := 0; i < padding.Top(); i++ {
 		line := rs.renderMarginLeft(margin)
 		if hasBorder {
 			line += "│"
 		}
-		line += strings.Repeat(" ", innerWidth)
+		line += paint(strings.Repeat(" ", innerWidth))
 		if hasBorder {
 			line += "│"
 		}
@@ -138,23 +191,28 @@ func (rs *RenderService) Render(box *model2.Box) string {
 			line += "│"
 		}
 		// Add left padding (total = explicit + implicit for borders)
-		line += strings.Repeat(" ", totalPaddingLeft)
+		inner := strings.Repeat(" ", totalPaddingLeft)
 
-		// Add content
-		line += contentLine
+		// Add content (clipped to an exact width)
+		lineWidth := style.Width(contentLine)
+		if lineWidth > contentWidth {
+			contentLine = style.Truncate(contentLine, contentWidth, "")
+			lineWidth = style.Width(contentLine)
+		}
+		inner += contentLine
 
 		// Add right padding to align right border
-		lineWidth := len(contentLine)
 		spacesNeeded := contentWidth - lineWidth
 
-		// Only pad to contentWidth if we have a border (to align it)
-		// Without border, no alignment padding needed
-		if hasBorder {
-			line += strings.Repeat(" ", spacesNeeded) + strings.Repeat(" ", totalPaddingRight)
+		// Only pad to contentWidth if we have a border, an exact width or
+		// a background (to align it). Otherwise no alignment padding needed
+		if hasBorder || exactWidth || hasBackground {
+			inner += strings.Repeat(" ", spacesNeeded) + strings.Repeat(" ", totalPaddingRight)
 		} else {
 			// Without border, just add explicit padding (if any)
-			line += strings.Repeat(" ", totalPaddingRight)
+			inner += strings.Repeat(" ", totalPaddingRight)
 		}
+		line += paint(inner)
 
 		if hasBorder {
 			line += "│"
@@ -169,7 +227,7 @@ func (rs *RenderService) Render(box *model2.Box) string {
 		if hasBorder {
 			line += "│"
 		}
-		line += strings.Repeat(" ", innerWidth)
+		line += paint(strings.Repeat(" ", innerWidth))
 		if hasBorder {
 			line += "│"
 		}
@@ -206,12 +264,37 @@ func (rs *RenderService) RenderNode(node *model2.Node) string {
 	return rs.Render(node.Box())
 }
 
-// calculateContentWidth finds the maximum line width in content.
-// This is a simple character count (Unicode width handled by caller if needed).
+// visibleRows returns at most rows lines: the first ones, or for
+// OverflowScroll the window starting at the box's scroll offset (clamped
+// so that the window stays filled).
+func (rs *RenderService) visibleRows(box *model2.Box, lines []string, rows int) []string {
+	if len(lines) <= rows {
+		return lines
+	}
+	top := 0
+	if box.Overflow() == value2.OverflowScroll {
+		top = min(box.ScrollTop(), len(lines)-rows)
+	}
+	return lines[top : top+rows]
+}
+
+// fitLines pads lines with empty lines or drops trailing lines so that
+// exactly height lines remain.
+func (rs *RenderService) fitLines(lines []string, height int) []string {
+	if len(lines) >= height {
+		return lines[:height]
+	}
+	result := make([]string, height)
+	copy(result, lines)
+	return result
+}
+
+// calculateContentWidth finds the maximum display width of the lines,
+// ignoring ANSI escape sequences.
 func (rs *RenderService) calculateContentWidth(lines []string) int {
 	maxWidth := 0
 	for _, line := range lines {
-		width := len(line)
+		width := style.Width(line)
 		if width > maxWidth {
 			maxWidth = width
 		}
@@ -219,6 +302,29 @@ func (rs *RenderService) calculateContentWidth(lines []string) int {
 	return maxWidth
 }
 
+// renderTopEdge renders the top border edge with the title (if any) inline,
+// e.g. "─ Title ───". At least one "─" stays on each side; titles that do
+// not fit are truncated with "…" by display width.
+func (rs *RenderService) renderTopEdge(width int, title string, align value2.HorizontalAlignment) string {
+	room := width - 4 // One "─" and one space on each side of the title
+	if title == "" || room < 1 {
+		return strings.Repeat("─", width)
+	}
+
+	title = " " + style.Truncate(title, room, "…") + " "
+	fill := width - 2 - style.Width(title)
+
+	left := 0
+	switch align {
+	case value2.AlignCenter:
+		left = (fill + 1) / 2
+	case value2.AlignRight:
+		left = fill
+	}
+
+	return strings.Repeat("─", 1+left) + title + strings.Repeat("─", 1+fill-left)
+}
+
 // renderMarginLeft renders left margin spaces.
 func (rs *RenderService) renderMarginLeft(margin interface{ Left() int }) string {
 	return strings.Repeat(" ", margin.Left())
@@ -228,3 +334,30 @@ func (rs *RenderService) renderMarginLeft(margin interface{ Left() int }) string
 func (rs *RenderService) renderMarginRight(margin interface{ Right() int }) string {
 	return strings.Repeat(" ", margin.Right())
 }
+
+// backgroundPainter returns a function that fills a line segment with the
+// background color. Resets inside the segment (from styled content) are
+// followed by the background again, so the fill has no gaps. When the
+// terminal has no color support, segments are returned unchanged.
+func backgroundPainter(c style.Color) func(string) string {
+	open, _, found := strings.Cut(style.Render(style.New().Background(c), " "), " ")
+	if !found || open == "" {
+		return func(s string) string { return s }
+	}
+	return func(s string) string {
+		for _, r := range []string{"\x1b[0m", "\x1b[m"} {
+			s = strings.ReplaceAll(s, r, r+open)
+		}
+		return open + s + "\x1b[0m"
+	}
+}
+
+// wrapLines soft-wraps each line to width cells (ANSI- and grapheme-aware),
+// breaking words that are wider than width.
+func wrapLines(lines []string, width int) []string {
+	wrapped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		wrapped = append(wrapped, strings.Split(style.Wrap(line, width, style.WithHardBreak()), "\n")...)
+	}
+	return wrapped
+}
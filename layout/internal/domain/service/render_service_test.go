@@ -8,6 +8,7 @@ import (
 
 	model2 "github.com/phoenix-tui/phoenix/layout/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -123,6 +124,78 @@ func TestRender_WithBorder(t *testing.T) {
 	}
 }
 
+// TestRender_WithBorderTitle tests rendering a title in the top border.
+func TestRender_WithBorderTitle(t *testing.T) {
+	rs := NewRenderService()
+
+	tests := []struct {
+		name     string
+		content  string
+		title    string
+		align    value.HorizontalAlignment
+		expected string
+	}{
+		{
+			name:    "left",
+			content: "Hello, World",
+			title:   "Info",
+			align:   value.AlignLeft,
+			expected: strings.Join([]string{
+				"┌─ Info ───────┐",
+				"│ Hello, World │",
+				"└──────────────┘",
+			}, "\n"),
+		},
+		{
+			name:     "center",
+			content:  "Hello, World",
+			title:    "Info",
+			align:    value.AlignCenter,
+			expected: "┌──── Info ────┐",
+		},
+		{
+			name:     "right",
+			content:  "Hello, World",
+			title:    "Info",
+			align:    value.AlignRight,
+			expected: "┌─────── Info ─┐",
+		},
+		{
+			name:     "truncated",
+			content:  "Hello",
+			title:    "Information",
+			align:    value.AlignLeft,
+			expected: "┌─ In… ─┐",
+		},
+		{
+			name:     "too narrow",
+			content:  "X",
+			title:    "Info",
+			align:    value.AlignLeft,
+			expected: "┌───┐",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			box := model2.NewBox(tt.content).WithBorder(true).WithTitle(tt.title).WithTitleAlign(tt.align)
+			output := rs.Render(box)
+			top := strings.Split(output, "\n")[0]
+			if strings.Contains(tt.expected, "\n") {
+				assert.Equal(t, tt.expected, output)
+			} else {
+				assert.Equal(t, tt.expected, top)
+			}
+		})
+	}
+}
+
+// TestRender_BorderTitleWithoutBorder tests that the title needs a border.
+func TestRender_BorderTitleWithoutBorder(t *testing.T) {
+	box := model2.NewBox("Hi").WithTitle("Info")
+	assert.Equal(t, "Hi", NewRenderService().Render(box))
+}
+
 // TestRender_WithPadding tests rendering with padding.
 func TestRender_WithPadding(t *testing.T) {
 	rs := NewRenderService()
@@ -637,6 +710,137 @@ func TestRender_Unicode(t *testing.T) {
 	}
 }
 
+// TestRender_Overflow tests MaxWidth/MaxHeight with each overflow policy,
+// using content far wider than the box.
+func TestRender_Overflow(t *testing.T) {
+	rs := NewRenderService()
+	maxSize := func(w, h int) value.Size {
+		size := value.NewSizeUnconstrained()
+		if w >= 0 {
+			size = size.WithMaxWidth(w)
+		}
+		if h >= 0 {
+			size = size.WithMaxHeight(h)
+		}
+		return size
+	}
+
+	tests := []struct {
+		name string
+		box  *model2.Box
+		want string
+	}{
+		{
+			name: "clip CJK by display width",
+			box:  model2.NewBox("こんにちは世界").WithSize(maxSize(7, -1)),
+			want: "こんに",
+		},
+		{
+			name: "clip emoji grapheme clusters",
+			box:  model2.NewBox("👋🏽👋🏽👋🏽 hi").WithSize(maxSize(5, -1)),
+			want: "👋🏽👋🏽",
+		},
+		{
+			name: "clip inside border",
+			box:  model2.NewBox("日本語のテキスト\nok").WithBorder(true).WithSize(maxSize(10, -1)),
+			want: "┌────────┐\n│ 日本語 │\n│ ok     │\n└────────┘",
+		},
+		{
+			name: "content narrower than max keeps natural width",
+			box:  model2.NewBox("short").WithSize(maxSize(40, -1)),
+			want: "short",
+		},
+		{
+			name: "clip drops rows below max height",
+			box:  model2.NewBox("一\n二\n三\n四").WithSize(maxSize(-1, 2)),
+			want: "一\n二",
+		},
+		{
+			name: "max height counts border and inner spacing",
+			box:  model2.NewBox("a\nb\nc\nd").WithBorder(true).WithSize(maxSize(-1, 6)),
+			want: "┌───┐\n│ a │\n│ b │\n└───┘",
+		},
+		{
+			name: "wrap at word boundaries",
+			box: model2.NewBox("the quick brown fox").
+				WithSize(maxSize(9, -1)).
+				WithOverflow(value.OverflowWrap),
+			want: "the quick\nbrown fox",
+		},
+		{
+			name: "wrap breaks wide words then clips rows",
+			box: model2.NewBox("東京都千代田区丸の内").
+				WithSize(maxSize(6, 2)).
+				WithOverflow(value.OverflowWrap),
+			want: "東京都\n千代田",
+		},
+		{
+			name: "scroll shows rows from offset",
+			box: model2.NewBox("1\n2\n3\n4\n5").
+				WithSize(maxSize(-1, 2)).
+				WithOverflow(value.OverflowScroll).
+				WithScrollTop(2),
+			want: "3\n4",
+		},
+		{
+			name: "scroll offset past end is clamped",
+			box: model2.NewBox("1\n2\n3\n4\n5").
+				WithSize(maxSize(-1, 2)).
+				WithOverflow(value.OverflowScroll).
+				WithScrollTop(99),
+			want: "4\n5",
+		},
+		{
+			name: "scroll offset ignored by clip",
+			box:  model2.NewBox("1\n2\n3").WithSize(maxSize(-1, 1)).WithScrollTop(2),
+			want: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rs.Render(tt.box))
+		})
+	}
+}
+
+// TestRender_Background tests that the area inside the border is filled
+// line by line with equal visible widths.
+func TestRender_Background(t *testing.T) {
+	rs := NewRenderService()
+	bg := "\x1b[48;2;10;20;30m"
+
+	t.Run("border and padding", func(t *testing.T) {
+		box := model2.NewBox("日本語\nab").
+			WithPadding(value.NewSpacingVH(1, 0)).
+			WithBorder(true).
+			WithBackground(style.RGB(10, 20, 30))
+
+		want := "┌────────┐\n" +
+			"│" + bg + "        \x1b[0m│\n" +
+			"│" + bg + " 日本語 \x1b[0m│\n" +
+			"│" + bg + " ab     \x1b[0m│\n" +
+			"│" + bg + "        \x1b[0m│\n" +
+			"└────────┘"
+		assert.Equal(t, want, rs.Render(box))
+	})
+
+	t.Run("no border pads ragged lines", func(t *testing.T) {
+		box := model2.NewBox("👋🏽\nwide").WithBackground(style.RGB(10, 20, 30))
+		assert.Equal(t, bg+"👋🏽  \x1b[0m\n"+bg+"wide\x1b[0m", rs.Render(box))
+	})
+
+	t.Run("styled content keeps the fill", func(t *testing.T) {
+		box := model2.NewBox("\x1b[1mB\x1b[0m!").WithBackground(style.RGB(10, 20, 30))
+		assert.Equal(t, bg+"\x1b[1mB\x1b[0m"+bg+"!\x1b[0m", rs.Render(box))
+	})
+
+	t.Run("margin is not filled", func(t *testing.T) {
+		box := model2.NewBox("x").WithMargin(value.NewSpacing(0, 1, 0, 2)).WithBackground(style.RGB(10, 20, 30))
+		assert.Equal(t, "  "+bg+"x\x1b[0m ", rs.Render(box))
+	})
+}
+
 // TestRender_VisualVerification prints output for manual verification.
 func TestRender_VisualVerification(t *testing.T) {
 	if testing.Short() {
@@ -0,0 +1,115 @@
+package value
+
+import "fmt"
+
+// Fraction is a size relative to a container, such as 1/3 or 50%.
+//
+// Design Philosophy:
+//   - Immutable value object
+//   - Exact integer arithmetic (no float rounding surprises)
+//   - Zero value means "not set"
+//   - Never larger than the whole container (numerators are clamped)
+//
+// Example:
+//
+//	third := NewFraction(1, 3)
+//	third.Of(80) // 26
+//
+//	half := NewPercent(50)
+//	half.Of(81) // 40
+type Fraction struct {
+	num int // Numerator (0..den)
+	den int // Denominator (> 0; 0 = not set)
+}
+
+// NewFraction creates the fraction num/den.
+// A numerator larger than the denominator is clamped to a whole (1/1).
+// Panics if den is not positive or num is negative.
+func NewFraction(num, den int) Fraction {
+	if den <= 0 {
+		panic(fmt.Sprintf("fraction: denominator must be positive, got %d", den))
+	}
+	if num < 0 {
+		panic(fmt.Sprintf("fraction: numerator must be non-negative, got %d", num))
+	}
+	return Fraction{num: min(num, den), den: den}
+}
+
+// NewPercent creates the fraction percent/100 (clamped to 100%).
+// Panics if percent is negative.
+func NewPercent(percent int) Fraction {
+	return NewFraction(percent, 100)
+}
+
+// Num returns the numerator.
+func (f Fraction) Num() int {
+	return f.num
+}
+
+// Den returns the denominator (0 if the fraction is not set).
+func (f Fraction) Den() int {
+	return f.den
+}
+
+// IsZero returns true if the fraction is not set.
+func (f Fraction) IsZero() bool {
+	return f.den == 0
+}
+
+// Of returns the fraction of total in whole cells, rounded down.
+// Returns 0 for an unset fraction or a negative total.
+func (f Fraction) Of(total int) int {
+	if f.den == 0 || total <= 0 {
+		return 0
+	}
+	return total * f.num / f.den
+}
+
+// Add returns the sum of two fractions, clamped to a whole.
+// An unset fraction counts as zero.
+func (f Fraction) Add(other Fraction) Fraction {
+	if f.IsZero() {
+		return other
+	}
+	if other.IsZero() {
+		return f
+	}
+	num := f.num*other.den + other.num*f.den
+	den := f.den * other.den
+	g := gcd(num, den)
+	return NewFraction(num/g, den/g)
+}
+
+// String returns a human-readable representation ("1/3", "50%").
+func (f Fraction) String() string {
+	if f.den == 100 {
+		return fmt.Sprintf("%d%%", f.num)
+	}
+	return fmt.Sprintf("%d/%d", f.num, f.den)
+}
+
+// SplitFractions resolves sibling fractions against total so that rounding
+// never overshoots: each size is the difference between consecutive
+// rounded-down running totals. Sizes therefore add up to the rounded-down
+// sum of the fractions, and fractions that add up to a whole fill total
+// exactly (1/3 + 1/3 + 1/3 of 10 gives 3, 3, 4).
+func SplitFractions(fractions []Fraction, total int) []int {
+	sizes := make([]int, len(fractions))
+	var running Fraction
+	prev := 0
+	for i, f := range fractions {
+		running = running.Add(f)
+		next := running.Of(total)
+		sizes[i] = next - prev
+		prev = next
+	}
+	return sizes
+}
+
+// gcd returns the greatest common divisor of a and b (b > 0).
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,94 @@
+package value
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFraction_Of(t *testing.T) {
+	tests := []struct {
+		name     string
+		fraction Fraction
+		total    int
+		want     int
+	}{
+		{"third of 80", NewFraction(1, 3), 80, 26},
+		{"half of odd", NewPercent(50), 81, 40},
+		{"whole", NewFraction(3, 3), 7, 7},
+		{"clamped above 100%", NewPercent(150), 10, 10},
+		{"zero total", NewPercent(50), 0, 0},
+		{"negative total", NewPercent(50), -4, 0},
+		{"unset", Fraction{}, 80, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fraction.Of(tt.total); got != tt.want {
+				t.Errorf("%s.Of(%d) = %d, want %d", tt.fraction, tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_Add(t *testing.T) {
+	sum := NewFraction(1, 3).Add(NewFraction(1, 6))
+	if sum.Num() != 1 || sum.Den() != 2 {
+		t.Errorf("1/3 + 1/6 = %s, want 1/2", sum)
+	}
+	if whole := NewPercent(70).Add(NewPercent(70)); whole.Num() != whole.Den() {
+		t.Errorf("70%% + 70%% = %s, want clamped to a whole", whole)
+	}
+	if got := (Fraction{}).Add(NewPercent(20)); got != NewPercent(20) {
+		t.Errorf("unset + 20%% = %s, want 20%%", got)
+	}
+}
+
+func TestFraction_String(t *testing.T) {
+	if s := NewPercent(25).String(); s != "25%" {
+		t.Errorf("String() = %q, want 25%%", s)
+	}
+	if s := NewFraction(2, 3).String(); s != "2/3" {
+		t.Errorf("String() = %q, want 2/3", s)
+	}
+}
+
+func TestNewFraction_Panics(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"zero denominator":   func() { NewFraction(1, 0) },
+		"negative numerator": func() { NewFraction(-1, 3) },
+		"negative percent":   func() { NewPercent(-5) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestSplitFractions(t *testing.T) {
+	third := NewFraction(1, 3)
+	tests := []struct {
+		name      string
+		fractions []Fraction
+		total     int
+		want      []int
+	}{
+		{"thirds fill exactly", []Fraction{third, third, third}, 10, []int{3, 3, 4}},
+		{"halves of odd", []Fraction{NewPercent(50), NewPercent(50)}, 11, []int{5, 6}},
+		{"percentages never overshoot", []Fraction{NewPercent(33), NewPercent(33), NewPercent(34)}, 7, []int{2, 2, 3}},
+		{"over 100% is cut off", []Fraction{NewPercent(60), NewPercent(60)}, 10, []int{6, 4}},
+		{"unset fractions take nothing", []Fraction{{}, NewPercent(50)}, 10, []int{0, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitFractions(tt.fractions, tt.total); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitFractions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package value
+
+// Overflow defines how a box handles content that does not fit its
+// maximum (or exact) size.
+//
+// Design Philosophy:
+//   - Enum-like type (type-safe)
+//   - Clip is the zero value, so boxes never overflow into their neighbours
+//   - Widths are display widths (grapheme-aware), never byte lengths
+//
+// Example:
+//
+//	box := NewBox(log).
+//		WithSize(NewSizeUnconstrained().WithMaxWidth(40)).
+//		WithOverflow(OverflowWrap)
+type Overflow int
+
+const (
+	// OverflowClip truncates lines wider than the box and drops rows below it.
+	// This is the default.
+	OverflowClip Overflow = iota
+
+	// OverflowWrap soft-wraps long lines at word boundaries (breaking words
+	// that are wider than the box), then drops rows below the box.
+	OverflowWrap
+
+	// OverflowScroll clips like OverflowClip, but shows the rows starting
+	// at the box's scroll offset instead of the first rows.
+	OverflowScroll
+)
+
+// String returns a human-readable representation.
+func (o Overflow) String() string {
+	switch o {
+	case OverflowClip:
+		return "clip"
+	case OverflowWrap:
+		return "wrap"
+	case OverflowScroll:
+		return "scroll"
+	default:
+		return "unknown"
+	}
+}
+
+// Validate checks if the overflow value is valid.
+func (o Overflow) Validate() bool {
+	return o >= OverflowClip && o <= OverflowScroll
+}
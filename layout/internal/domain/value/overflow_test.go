@@ -0,0 +1,39 @@
+package value
+
+import "testing"
+
+func TestOverflow_String(t *testing.T) {
+	tests := []struct {
+		overflow Overflow
+		want     string
+	}{
+		{OverflowClip, "clip"},
+		{OverflowWrap, "wrap"},
+		{OverflowScroll, "scroll"},
+		{Overflow(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.overflow.String(); got != tt.want {
+			t.Errorf("Overflow(%d).String() = %q, want %q", tt.overflow, got, tt.want)
+		}
+	}
+}
+
+func TestOverflow_Validate(t *testing.T) {
+	for _, o := range []Overflow{OverflowClip, OverflowWrap, OverflowScroll} {
+		if !o.Validate() {
+			t.Errorf("%s should be valid", o)
+		}
+	}
+	if Overflow(-1).Validate() || Overflow(3).Validate() {
+		t.Error("out-of-range overflow values should be invalid")
+	}
+}
+
+func TestOverflow_DefaultIsClip(t *testing.T) {
+	var o Overflow
+	if o != OverflowClip {
+		t.Errorf("zero Overflow = %s, want clip", o)
+	}
+}
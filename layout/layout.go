@@ -6,8 +6,9 @@
 //   - CSS Box Model (content, padding, border, margin)
 //   - Flexbox (row/column containers with flex grow/shrink/basis)
 //   - Gap spacing between flex items
+//   - Grid (fixed columns, auto-flow, column/row spans)
 //   - Alignment (horizontal, vertical, justify, align-items)
-//   - Responsive sizing (fixed, percentage, flex, auto)
+//   - Responsive sizing (fixed, percentage/fraction, flex, auto)
 //   - Unicode-aware width calculations
 //
 // # Features
@@ -38,9 +39,9 @@
 //	row := layout.Row().
 //		Gap(2).
 //		Items(
-//			layout.NewBox("Left").FlexGrow(1),
-//			layout.NewBox("Center").FlexGrow(2),
-//			layout.NewBox("Right").FlexGrow(1),
+//			layout.NewBox("Left").Flex(1),
+//			layout.NewBox("Center").Flex(2),
+//			layout.NewBox("Right").Flex(1),
 //		).
 //		Render()
 //
@@ -51,7 +52,7 @@
 //		AlignCenter().
 //		Items(
 //			layout.NewBox("Header"),
-//			layout.NewBox("Body").FlexGrow(1),
+//			layout.NewBox("Body").Flex(1),
 //			layout.NewBox("Footer"),
 //		).
 //		Render()
@@ -86,6 +87,7 @@ import (
 	model2 "github.com/phoenix-tui/phoenix/layout/internal/domain/model"
 	service2 "github.com/phoenix-tui/phoenix/layout/internal/domain/service"
 	value2 "github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
 )
 
 // Box is the public API for creating layout boxes.
@@ -142,7 +144,7 @@ func NewBox(content string) *Box {
 //
 //	box := layout.NewBox("Hi").Width(20)  // Forces width to 20 cells
 func (b *Box) Width(width int) *Box {
-	b.domain = b.domain.WithSize(b.domain.Size().WithWidth(width))
+	b.domain = b.domain.WithSize(b.domain.Size().WithWidth(width)).WithWidthFraction(value2.Fraction{})
 	return b
 }
 
@@ -153,7 +155,7 @@ func (b *Box) Width(width int) *Box {
 //
 //	box := layout.NewBox("Hi").Height(5)  // Forces height to 5 lines
 func (b *Box) Height(height int) *Box {
-	b.domain = b.domain.WithSize(b.domain.Size().WithHeight(height))
+	b.domain = b.domain.WithSize(b.domain.Size().WithHeight(height)).WithHeightFraction(value2.Fraction{})
 	return b
 }
 
@@ -180,7 +182,8 @@ func (b *Box) MinHeight(minHeight int) *Box {
 }
 
 // MaxWidth sets a maximum width constraint.
-// The box will be at most this width.
+// The box will be at most this width; wider content is handled by the
+// Overflow policy (clipped by default).
 //
 // Example:
 //
@@ -191,7 +194,8 @@ func (b *Box) MaxWidth(maxWidth int) *Box {
 }
 
 // MaxHeight sets a maximum height constraint.
-// The box will be at most this height.
+// The box will be at most this height; rows that do not fit are handled
+// by the Overflow policy (dropped by default).
 //
 // Example:
 //
@@ -201,6 +205,139 @@ func (b *Box) MaxHeight(maxHeight int) *Box {
 	return b
 }
 
+// WidthPercent sets the width as a percentage of the container width
+// passed to Render (Row/Column) or Layout. Inside a Row, percentages are
+// taken of the space left after gaps and rounded so that siblings never
+// add up to more than the container. Values above 100 are clamped;
+// MinWidth/MaxWidth still apply. Replaces any exact Width.
+//
+// Example:
+//
+//	layout.Row().
+//		Add(layout.NewBox("Nav").WidthPercent(25)).
+//		Add(layout.NewBox("Content").WidthPercent(75)).
+//		Render(termWidth, termHeight)
+func (b *Box) WidthPercent(percent int) *Box {
+	b.domain = b.domain.WithWidthFraction(value2.NewPercent(percent)).
+		WithSize(b.domain.Size().WithWidth(-1))
+	return b
+}
+
+// WidthFraction sets the width as num/den of the container width, e.g.
+// WidthFraction(1, 3) for a third. Resolved like WidthPercent; three
+// thirds always fill the container exactly. Panics if den is not positive
+// or num is negative.
+//
+// Example:
+//
+//	box := layout.NewBox("Column").WidthFraction(1, 3)
+func (b *Box) WidthFraction(num, den int) *Box {
+	b.domain = b.domain.WithWidthFraction(value2.NewFraction(num, den)).
+		WithSize(b.domain.Size().WithWidth(-1))
+	return b
+}
+
+// HeightPercent sets the height as a percentage of the container height.
+// See WidthPercent.
+//
+// Example:
+//
+//	box := layout.NewBox("Log").HeightPercent(50)
+func (b *Box) HeightPercent(percent int) *Box {
+	b.domain = b.domain.WithHeightFraction(value2.NewPercent(percent)).
+		WithSize(b.domain.Size().WithHeight(-1))
+	return b
+}
+
+// HeightFraction sets the height as num/den of the container height.
+// See WidthFraction.
+//
+// Example:
+//
+//	box := layout.NewBox("Preview").HeightFraction(2, 3)
+func (b *Box) HeightFraction(num, den int) *Box {
+	b.domain = b.domain.WithHeightFraction(value2.NewFraction(num, den)).
+		WithSize(b.domain.Size().WithHeight(-1))
+	return b
+}
+
+// ============================================================================
+// Overflow (content larger than the box)
+// ============================================================================
+
+// Overflow selects what a box does with content that exceeds its
+// Width/Height or MaxWidth/MaxHeight.
+type Overflow = value2.Overflow
+
+const (
+	// OverflowClip truncates long lines and drops rows that do not fit (default).
+	OverflowClip = value2.OverflowClip
+	// OverflowWrap wraps long lines at word boundaries, then drops rows that do not fit.
+	OverflowWrap = value2.OverflowWrap
+	// OverflowScroll clips, showing the rows from ScrollTop onward.
+	OverflowScroll = value2.OverflowScroll
+)
+
+// Overflow sets the overflow policy. Widths are measured in terminal cells
+// (CJK and emoji count as two), so clipped content never spills into
+// neighbouring boxes. Panics on an unknown policy.
+//
+// Example:
+//
+//	box := layout.NewBox(longText).MaxWidth(40).MaxHeight(10).Overflow(layout.OverflowWrap)
+func (b *Box) Overflow(overflow Overflow) *Box {
+	b.domain = b.domain.WithOverflow(overflow)
+	return b
+}
+
+// ScrollTop sets the first content row shown when the overflow policy is
+// OverflowScroll. Offsets past the end are clamped so the box stays
+// filled. Panics on a negative row.
+//
+// Example:
+//
+//	logView := layout.NewBox(logs).MaxHeight(10).Overflow(layout.OverflowScroll).ScrollTop(offset)
+func (b *Box) ScrollTop(row int) *Box {
+	b.domain = b.domain.WithScrollTop(row)
+	return b
+}
+
+// ============================================================================
+// Flex sizing (used inside Row/Column)
+// ============================================================================
+
+// Flex sets the flex grow factor. Inside a Row or Column, space left over
+// after every item gets its natural size is shared between items in
+// proportion to their grow factors, up to each item's MaxWidth/MaxHeight.
+// The default, 0, keeps the natural size. Panics on negative grow.
+//
+// Example:
+//
+//	// Sidebar keeps its width, content takes 2/3 and preview 1/3 of the rest
+//	layout.Row().
+//		Add(layout.NewBox("Nav")).
+//		Add(layout.NewBox("Content").Flex(2)).
+//		Add(layout.NewBox("Preview").Flex(1)).
+//		Render(80, 24)
+func (b *Box) Flex(grow int) *Box {
+	b.domain = b.domain.WithFlexGrow(grow)
+	return b
+}
+
+// FlexShrink sets the flex shrink factor. When items do not fit in a Row
+// or Column, the overflow is taken from items in proportion to shrink
+// factor times natural size, never below MinWidth/MinHeight (or the size
+// of padding and border). Shrunk content is clipped. The default, 0,
+// never shrinks. Panics on negative shrink.
+//
+// Example:
+//
+//	title := layout.NewBox(longTitle).FlexShrink(1).MinWidth(10)
+func (b *Box) FlexShrink(shrink int) *Box {
+	b.domain = b.domain.WithFlexShrink(shrink)
+	return b
+}
+
 // ============================================================================
 // Padding (space inside border)
 // ============================================================================
@@ -256,6 +393,29 @@ func (b *Box) Border() *Box {
 	return b
 }
 
+// BorderTitle sets a title shown inline in the top border (┌─ Title ─┐).
+// It only renders with Border() enabled and is truncated with "…" when
+// wider than the box; it never widens the box.
+//
+// Example:
+//
+//	box := layout.NewBox("Are you sure?").Border().BorderTitle("Confirm")
+func (b *Box) BorderTitle(title string) *Box {
+	b.domain = b.domain.WithTitle(title)
+	return b
+}
+
+// BorderTitleAlign places the border title at the left (default), center,
+// or right of the top border.
+//
+// Example:
+//
+//	box := layout.NewBox("Saved").Border().BorderTitle("Info").BorderTitleAlign(value.AlignCenter)
+func (b *Box) BorderTitleAlign(align value2.HorizontalAlignment) *Box {
+	b.domain = b.domain.WithTitleAlign(align)
+	return b
+}
+
 // NoBorder explicitly disables the border.
 // This is the default, but can be used to override previous settings.
 //
@@ -267,6 +427,33 @@ func (b *Box) NoBorder() *Box {
 	return b
 }
 
+// ============================================================================
+// Background
+// ============================================================================
+
+// Background fills the box's content and padding area (everything inside
+// the border; margin stays transparent) with a color. Every line is padded
+// to the full box width by display width, so cards and panels have no
+// ragged right edge, and styled content keeps the fill after its own
+// resets.
+//
+// Example:
+//
+//	card := layout.NewBox("CPU 42%").
+//		PaddingVH(1, 2).
+//		Border().
+//		Background(style.RGB(30, 30, 60))
+func (b *Box) Background(color style.Color) *Box {
+	b.domain = b.domain.WithBackground(color)
+	return b
+}
+
+// NoBackground removes the background fill (the default).
+func (b *Box) NoBackground() *Box {
+	b.domain = b.domain.WithoutBackground()
+	return b
+}
+
 // ============================================================================
 // Margin (space outside border)
 // ============================================================================
@@ -752,8 +939,6 @@ func (f *Flex) Height(height int) *Flex {
 //		Add(layout.NewBox("Right")).
 //		Render(80, 24)
 //	fmt.Println(output)
-//
-//nolint:gocognit // Flex rendering orchestrates multiple services
 func (f *Flex) Render(containerWidth, containerHeight int) string {
 	// Create services
 	measureService := service2.NewMeasureService()
@@ -763,50 +948,16 @@ func (f *Flex) Render(containerWidth, containerHeight int) string {
 	// Layout the flexbox
 	laidOut := flexService.Layout(f.domain, containerWidth, containerHeight)
 
-	// Render each item
-	var result strings.Builder
-
-	// Create a grid to track occupied positions
-	grid := make([][]rune, containerHeight)
-	for i := range grid {
-		grid[i] = make([]rune, containerWidth)
-		for j := range grid[i] {
-			grid[i][j] = ' '
-		}
-	}
-
-	// Render each item into the grid
+	// Composite each item onto a blank canvas. Overlay measures display
+	// width, so wide characters and styled text keep their columns.
+	canvas := strings.TrimSuffix(strings.Repeat(strings.Repeat(" ", containerWidth)+"\n", containerHeight), "\n")
+	overlayService := service2.NewOverlayService()
 	for _, item := range laidOut.Items() {
-		itemOutput := renderService.Render(item.Box())
-		itemLines := strings.Split(itemOutput, "\n")
-
 		pos := item.Position()
-		for lineIdx, line := range itemLines {
-			y := pos.Y() + lineIdx
-			if y >= containerHeight {
-				break
-			}
-
-			x := pos.X()
-			for _, ch := range line {
-				if x >= containerWidth {
-					break
-				}
-				grid[y][x] = ch
-				x++
-			}
-		}
+		canvas = overlayService.Overlay(canvas, renderService.Render(item.Box()), pos.X(), pos.Y())
 	}
 
-	// Convert grid to string
-	for i, row := range grid {
-		result.WriteString(string(row))
-		if i < len(grid)-1 {
-			result.WriteRune('\n')
-		}
-	}
-
-	return result.String()
+	return canvas
 }
 
 // String implements fmt.Stringer.
@@ -824,3 +975,125 @@ func (f *Flex) String() string {
 func (f *Flex) Domain() *model2.FlexContainer {
 	return f.domain
 }
+
+// ============================================================================
+// Grid Layout API
+// ============================================================================
+
+// GridContainer is the public API for grid layouts: boxes arranged into a
+// fixed number of columns, for dashboards, forms and tables.
+//
+// Items flow left-to-right and wrap to the next row when a row is full.
+// Each column is as wide as its widest cell and each row as tall as its
+// tallest cell (display width, so CJK and emoji count as two cells). A box
+// smaller than its cell is placed by its own alignment (AlignRight,
+// AlignMiddle, ...).
+//
+// Zero value: GridContainer with zero value has nil internal state and will
+// panic if used. Always use Grid() to create a valid instance.
+//
+// Example (form):
+//
+//	form := layout.Grid(2).
+//		ColumnGap(1).
+//		Add(layout.NewBox("Name:").AlignRight()).
+//		Add(layout.NewBox("Phoenix")).
+//		Add(layout.NewBox("Version:").AlignRight()).
+//		Add(layout.NewBox("0.2.4")).
+//		Render()
+type GridContainer struct {
+	domain *model2.GridContainer
+}
+
+// Grid creates a new grid container with the given number of columns.
+// Panics if cols is less than 1.
+//
+// Example:
+//
+//	grid := layout.Grid(3).Gap(1).Add(box1).Add(box2).Add(box3)
+func Grid(cols int) *GridContainer {
+	return &GridContainer{
+		domain: model2.NewGridContainer(cols),
+	}
+}
+
+// Add adds a box occupying a single cell.
+func (g *GridContainer) Add(box *Box) *GridContainer {
+	return g.Cell(box, 1, 1)
+}
+
+// Cell adds a box spanning colspan columns and rowspan rows.
+// A colspan wider than the grid is clamped to the number of columns.
+// Panics if a span is less than 1.
+//
+// Example:
+//
+//	dashboard := layout.Grid(3).
+//		Cell(layout.NewBox("Header").AlignCenter(), 3, 1). // Full-width header
+//		Cell(layout.NewBox("Menu").Border(), 1, 2).        // Left column, two rows tall
+//		Add(layout.NewBox("CPU")).
+//		Add(layout.NewBox("Memory")).
+//		Add(layout.NewBox("Disk")).
+//		Add(layout.NewBox("Network"))
+func (g *GridContainer) Cell(box *Box, colspan, rowspan int) *GridContainer {
+	g.domain = g.domain.AddItem(model2.NewGridItem(box.domain, colspan, rowspan))
+	return g
+}
+
+// Gap sets both the row gap (empty lines) and the column gap (empty cells).
+func (g *GridContainer) Gap(gap int) *GridContainer {
+	g.domain = g.domain.WithGaps(gap, gap)
+	return g
+}
+
+// RowGap sets the number of empty lines between rows.
+func (g *GridContainer) RowGap(gap int) *GridContainer {
+	g.domain = g.domain.WithGaps(gap, g.domain.ColumnGap())
+	return g
+}
+
+// ColumnGap sets the number of empty cells between columns.
+func (g *GridContainer) ColumnGap(gap int) *GridContainer {
+	g.domain = g.domain.WithGaps(g.domain.RowGap(), gap)
+	return g
+}
+
+// Render lays out and renders the grid at its natural size.
+// Every line has the same display width; an empty grid renders as "".
+func (g *GridContainer) Render() string {
+	return service2.NewGridLayoutService(service2.NewMeasureService()).Render(g.domain)
+}
+
+// String implements fmt.Stringer. Equivalent to calling Render().
+func (g *GridContainer) String() string {
+	return g.Render()
+}
+
+// Domain returns the underlying domain model.
+// This is provided for advanced use cases.
+func (g *GridContainer) Domain() *model2.GridContainer {
+	return g.domain
+}
+
+// ============================================================================
+// Overlay (absolute positioning)
+// ============================================================================
+
+// Overlay draws the over block on top of base with its top-left corner at
+// column x, row y (0-based cells), for completion menus, tooltips and
+// modals drawn over existing content.
+//
+// Positions are measured in visible cells: ANSI styles in both blocks are
+// preserved and never count towards the width, and wide characters (CJK,
+// emoji) align correctly. The overlay is an opaque rectangle as wide as its
+// widest line. Parts of it past the edges of base (including negative x or
+// y) are clipped, so the result always has the size of base.
+//
+// Example:
+//
+//	screen := layout.Row().Add(layout.NewBox("$ git che").Border()).Render(40, 10)
+//	menu := layout.NewBox("checkout\ncherry-pick").Border().Render()
+//	fmt.Println(layout.Overlay(screen, menu, 4, 3))
+func Overlay(base, over string, x, y int) string {
+	return service2.NewOverlayService().Overlay(base, over, x, y)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/layout/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -117,6 +118,16 @@ func TestBox_Padding(t *testing.T) {
 	})
 }
 
+// TestBox_BorderTitle tests the border title methods.
+func TestBox_BorderTitle(t *testing.T) {
+	box := NewBox("Hello, World").Border().BorderTitle("Info").BorderTitleAlign(value.AlignCenter)
+	assert.Equal(t, "Info", box.domain.Title())
+	assert.Equal(t, value.AlignCenter, box.domain.TitleAlign())
+
+	top := strings.Split(box.Render(), "\n")[0]
+	assert.Equal(t, "┌──── Info ────┐", top)
+}
+
 // TestBox_Border tests border methods.
 func TestBox_Border(t *testing.T) {
 	t.Run("Border enables border", func(t *testing.T) {
@@ -467,3 +478,142 @@ func TestBox_Unicode(t *testing.T) {
 		assert.Contains(t, output, "└")
 	})
 }
+
+func TestOverlay(t *testing.T) {
+	base := Row().Add(NewBox("$ git che").Border()).Render(20, 6)
+	menu := NewBox("checkout\ncherry-pick").Border().Render()
+
+	output := Overlay(base, menu, 2, 2)
+	lines := strings.Split(output, "\n")
+
+	assert.Len(t, lines, 6, "overlay keeps the base height")
+	assert.Equal(t, "┌───────────┐       ", lines[0], "rows above the overlay are untouched")
+	assert.Equal(t, "└─┌─────────────┐   ", lines[2])
+	assert.Equal(t, "  │ checkout    │   ", lines[3])
+	assert.Equal(t, "  │ cherry-pick │   ", lines[4])
+	assert.Equal(t, "  └─────────────┘   ", lines[5])
+}
+
+func TestBox_Overflow(t *testing.T) {
+	t.Run("clip keeps unicode content inside the box", func(t *testing.T) {
+		output := Row().
+			Add(NewBox("こんにちは世界、長い行です").MaxWidth(7)).
+			Add(NewBox("|next")).
+			Render(20, 1)
+		assert.Equal(t, "こんに |next        ", output)
+	})
+
+	t.Run("clip drops rows beyond MaxHeight", func(t *testing.T) {
+		output := Column().
+			Add(NewBox("one\ntwo\nthree").MaxHeight(2)).
+			Add(NewBox("next")).
+			Render(5, 3)
+		assert.Equal(t, "one  \ntwo  \nnext ", output)
+	})
+
+	t.Run("wrap", func(t *testing.T) {
+		output := NewBox("wrap 👋🏽 long words").MaxWidth(10).Overflow(OverflowWrap).Render()
+		assert.Equal(t, "wrap 👋🏽   \nlong words", output)
+	})
+
+	t.Run("scroll", func(t *testing.T) {
+		output := NewBox("1\n2\n3\n4").MaxHeight(2).Overflow(OverflowScroll).ScrollTop(1).Render()
+		assert.Equal(t, "2\n3", output)
+	})
+}
+
+func TestFlex_Render_WideCharacters(t *testing.T) {
+	output := Row().Add(NewBox("日本")).Add(NewBox("|")).Render(8, 1)
+	assert.Equal(t, "日本|   ", output, "wide characters occupy two columns")
+}
+
+func TestGrid(t *testing.T) {
+	form := Grid(2).
+		ColumnGap(1).
+		Add(NewBox("Name:").AlignRight()).
+		Add(NewBox("フェニックス")).
+		Add(NewBox("Version:").AlignRight()).
+		Add(NewBox("0.2.4")).
+		Render()
+
+	assert.Equal(t, "   Name: フェニックス\nVersion: 0.2.4       ", form)
+}
+
+func TestGrid_CellSpan(t *testing.T) {
+	output := Grid(3).
+		Cell(NewBox("Dashboard").AlignCenter(), 3, 1).
+		Cell(NewBox("Nav\nNav\nNav"), 1, 2).
+		Add(NewBox("CPU")).
+		Add(NewBox("RAM")).
+		Add(NewBox("Disk")).
+		Add(NewBox("Net")).
+		Render()
+
+	lines := strings.Split(output, "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, "Dashboard ", lines[0])
+	assert.Equal(t, "NavCPU RAM", lines[1], "column 1 is as wide as Disk")
+	assert.Equal(t, "Nav       ", lines[2], "the 3-line span grows the first row")
+	assert.Equal(t, "NavDiskNet", lines[3])
+}
+
+func TestGrid_InvalidArguments(t *testing.T) {
+	assert.Panics(t, func() { Grid(0) })
+	assert.Panics(t, func() { Grid(2).Cell(NewBox("x"), 0, 1) })
+}
+
+func TestBox_RelativeSize(t *testing.T) {
+	t.Run("percent of container width", func(t *testing.T) {
+		output := Row().
+			Add(NewBox("nav").WidthPercent(25)).
+			Add(NewBox("content").WidthPercent(75)).
+			Render(20, 1)
+		assert.Equal(t, "nav  content        ", output)
+	})
+
+	t.Run("fractions never overshoot", func(t *testing.T) {
+		output := Row().
+			Add(NewBox("a").WidthFraction(1, 3)).
+			Add(NewBox("b").WidthFraction(1, 3)).
+			Add(NewBox("c").WidthFraction(1, 3)).
+			Render(10, 1)
+		assert.Equal(t, "a  b  c   ", output)
+	})
+
+	t.Run("height percent in column", func(t *testing.T) {
+		output := Column().
+			Add(NewBox("top").HeightPercent(50)).
+			Add(NewBox("end")).
+			Render(3, 4)
+		assert.Equal(t, "top\n   \nend\n   ", output)
+	})
+
+	t.Run("layout resolves against parent", func(t *testing.T) {
+		pos := NewBox("x").WidthPercent(50).AlignCenter().Layout(80, 24)
+		assert.Equal(t, 20, pos.X())
+	})
+
+	t.Run("exact width replaces percent", func(t *testing.T) {
+		box := NewBox("x").WidthPercent(50).Width(4)
+		assert.True(t, box.Domain().WidthFraction().IsZero())
+		assert.Equal(t, 4, box.Domain().Size().Width())
+	})
+}
+
+func TestBox_Background(t *testing.T) {
+	output := NewBox("カード\n42").
+		PaddingVH(0, 1).
+		Border().
+		Background(style.RGB(30, 30, 60)).
+		Render()
+
+	lines := strings.Split(output, "\n")
+	require.Len(t, lines, 4)
+	for _, line := range lines {
+		assert.Equal(t, 12, style.Width(line), "line %q", line)
+	}
+	assert.Contains(t, lines[1], "\x1b[48;2;30;30;60m  カード  \x1b[0m")
+	assert.Contains(t, lines[2], "\x1b[48;2;30;30;60m  42      \x1b[0m")
+
+	assert.NotContains(t, NewBox("x").Background(style.RGB(1, 2, 3)).NoBackground().Render(), "\x1b[")
+}
@@ -14,6 +14,12 @@ import (
 // This is re-exported from the service layer for application layer use.
 type ComponentArea = service2.ComponentArea
 
+// TopmostArea returns the index of the topmost area containing position,
+// or -1 if none does.
+func TopmostArea(position value.Position, areas []ComponentArea) int {
+	return service2.TopmostArea(position, areas)
+}
+
 // EventProcessor processes raw mouse events and enriches them with
 // higher-level semantics (clicks, drags, etc.).
 type EventProcessor struct {
@@ -108,6 +114,16 @@ func (p *EventProcessor) ScrollDelta(event model.MouseEvent) int {
 	return p.scrollCalculator.CalculateDelta(event)
 }
 
+// HorizontalScrollDelta calculates the horizontal scroll delta for a scroll event.
+func (p *EventProcessor) HorizontalScrollDelta(event model.MouseEvent) int {
+	return p.scrollCalculator.CalculateHorizontalDelta(event)
+}
+
+// SetShiftWheelHorizontal sets whether Shift+vertical wheel scrolls horizontally.
+func (p *EventProcessor) SetShiftWheelHorizontal(enabled bool) {
+	p.scrollCalculator.SetShiftWheelHorizontal(enabled)
+}
+
 // IsScrollUp checks if the event is a scroll up event.
 func (p *EventProcessor) IsScrollUp(event model.MouseEvent) bool {
 	return p.scrollCalculator.IsScrollUp(event)
@@ -163,3 +179,14 @@ func (p *EventProcessor) CalculateMenuPosition(
 ) value.Position {
 	return p.menuPositioner.CalculatePosition(cursorPos, menuWidth, menuHeight, screenWidth, screenHeight)
 }
+
+// CalculateSubmenuPosition calculates the position of a submenu opened from
+// the parent menu item, preferring the given side and flipping on overflow.
+func (p *EventProcessor) CalculateSubmenuPosition(
+	parent value.BoundingBox,
+	menuWidth, menuHeight int,
+	screenWidth, screenHeight int,
+	prefer value.Direction,
+) value.Position {
+	return p.menuPositioner.CalculateSubmenuPosition(parent, menuWidth, menuHeight, screenWidth, screenHeight, prefer)
+}
@@ -19,9 +19,26 @@ func NewHoverTracker() *HoverTracker {
 }
 
 // ComponentArea represents a component's hover-detection area.
+// Areas with a higher Z are on top of areas with a lower Z.
 type ComponentArea struct {
 	ID   string
 	Area value.BoundingBox
+	Z    int
+}
+
+// TopmostArea returns the index of the topmost area containing position,
+// or -1 if none does. Among areas with the same Z, the first one wins.
+func TopmostArea(position value.Position, areas []ComponentArea) int {
+	top := -1
+	for i, area := range areas {
+		if !area.Area.Contains(position) {
+			continue
+		}
+		if top == -1 || area.Z > areas[top].Z {
+			top = i
+		}
+	}
+	return top
 }
 
 // Update processes a mouse position update and returns hover events.
@@ -30,13 +47,10 @@ type ComponentArea struct {
 // - Left the current component (HoverLeave)
 // - Moved within the current component (HoverMove)
 func (h *HoverTracker) Update(position value.Position, areas []ComponentArea) value.EventType {
-	// Find which component (if any) contains the current position
+	// Find which component (if any) is topmost at the current position
 	var hoveredComponentID string
-	for _, area := range areas {
-		if area.Area.Contains(position) {
-			hoveredComponentID = area.ID
-			break
-		}
+	if i := TopmostArea(position, areas); i >= 0 {
+		hoveredComponentID = areas[i].ID
 	}
 
 	// Determine what happened
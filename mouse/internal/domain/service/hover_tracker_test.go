@@ -525,3 +525,55 @@ func TestHoverTrackerUpdate_LargeCoordinates(t *testing.T) {
 		t.Errorf("Expected panel, got %s", tracker.CurrentComponentID())
 	}
 }
+
+// TestTopmostArea tests hit-testing of overlapping areas.
+func TestTopmostArea(t *testing.T) {
+	button := ComponentArea{ID: "button", Area: value2.NewBoundingBox(5, 5, 10, 3)}
+	menu := ComponentArea{ID: "menu", Area: value2.NewBoundingBox(0, 0, 20, 10), Z: 1}
+	modal := ComponentArea{ID: "modal", Area: value2.NewBoundingBox(6, 6, 4, 2), Z: 2}
+
+	tests := []struct {
+		name     string
+		position value2.Position
+		areas    []ComponentArea
+		want     int
+	}{
+		{"no areas", value2.NewPosition(6, 6), nil, -1},
+		{"outside all areas", value2.NewPosition(50, 50), []ComponentArea{button, menu}, -1},
+		{"higher Z wins regardless of order", value2.NewPosition(6, 5), []ComponentArea{button, menu}, 1},
+		{"highest of three", value2.NewPosition(7, 7), []ComponentArea{modal, button, menu}, 0},
+		{"lower area where top does not reach", value2.NewPosition(1, 1), []ComponentArea{modal, button, menu}, 2},
+		{
+			name:     "equal Z keeps first match",
+			position: value2.NewPosition(6, 6),
+			areas:    []ComponentArea{button, {ID: "other", Area: value2.NewBoundingBox(5, 5, 10, 3)}},
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopmostArea(tt.position, tt.areas); got != tt.want {
+				t.Errorf("TopmostArea() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHoverTrackerUpdate_Overlap tests that hover follows the topmost area.
+func TestHoverTrackerUpdate_Overlap(t *testing.T) {
+	tracker := NewHoverTracker()
+	areas := []ComponentArea{
+		{ID: "button", Area: value2.NewBoundingBox(5, 5, 10, 3)},
+		{ID: "menu", Area: value2.NewBoundingBox(0, 0, 8, 10), Z: 1},
+	}
+
+	if tracker.Update(value2.NewPosition(6, 6), areas) != value2.EventHoverEnter || tracker.CurrentComponentID() != "menu" {
+		t.Errorf("expected to enter menu, got %q", tracker.CurrentComponentID())
+	}
+
+	// Moving off the menu onto the visible part of the button switches to it.
+	if tracker.Update(value2.NewPosition(10, 6), areas) != value2.EventHoverEnter || tracker.CurrentComponentID() != "button" {
+		t.Errorf("expected to enter button, got %q", tracker.CurrentComponentID())
+	}
+}
@@ -91,6 +91,84 @@ func (p *MenuPositioner) CalculatePosition(
 	return value.NewPosition(x, y)
 }
 
+// CalculateSubmenuPosition determines the position of a submenu opened from
+// a parent menu item.
+//
+// Parameters:
+//   - parent: bounding box of the invoking menu item
+//   - menuWidth: width of the submenu in terminal cells
+//   - menuHeight: height of the submenu in terminal cells
+//   - screenWidth: terminal width in cells
+//   - screenHeight: terminal height in cells
+//   - prefer: side of the parent the submenu should open on
+//
+// Behavior:
+//   - Opens on the preferred side if the submenu fits there
+//   - Flips to the opposite side if it would overflow
+//   - If neither side fits: shifts onto the screen, overlapping the parent
+//   - Right/Left: top aligned with the parent item, shifted up if needed
+//   - Down/Up: left edge aligned with the parent item, shifted left if needed
+//   - If menu larger than screen: pins to top-left (0,0)
+func (p *MenuPositioner) CalculateSubmenuPosition(
+	parent value.BoundingBox,
+	menuWidth, menuHeight int,
+	screenWidth, screenHeight int,
+	prefer value.Direction,
+) value.Position {
+	menuWidth = max(menuWidth, 0)
+	menuHeight = max(menuHeight, 0)
+	screenWidth = max(screenWidth, 0)
+	screenHeight = max(screenHeight, 0)
+
+	if menuWidth > screenWidth || menuHeight > screenHeight {
+		return value.NewPosition(0, 0)
+	}
+
+	if prefer.IsHorizontal() {
+		x := placeBeside(parent.X(), parent.Width(), menuWidth, screenWidth, prefer == value.DirectionRight)
+		y := clampToScreen(parent.Y(), menuHeight, screenHeight)
+		return value.NewPosition(x, y)
+	}
+
+	x := clampToScreen(parent.X(), menuWidth, screenWidth)
+	y := placeBeside(parent.Y(), parent.Height(), menuHeight, screenHeight, prefer == value.DirectionDown)
+	return value.NewPosition(x, y)
+}
+
+// placeBeside returns the start coordinate of a menu of the given size
+// placed after (forward) or before the parent span [start, start+length),
+// flipping to the other side if the preferred one overflows the screen.
+func placeBeside(start, length, size, screen int, forward bool) int {
+	after := start + length
+	before := start - size
+	fitsAfter := after+size <= screen
+	fitsBefore := before >= 0
+
+	if forward {
+		if fitsAfter {
+			return after
+		}
+		if fitsBefore {
+			return before
+		}
+		// Neither side fits: overlap the parent, staying on screen.
+		return clampToScreen(after, size, screen)
+	}
+
+	if fitsBefore {
+		return before
+	}
+	if fitsAfter {
+		return after
+	}
+	return clampToScreen(before, size, screen)
+}
+
+// clampToScreen shifts a menu starting at pos so that it ends on screen.
+func clampToScreen(pos, size, screen int) int {
+	return max(0, min(pos, screen-size))
+}
+
 // WouldOverflow checks if a menu at the given position would overflow screen bounds.
 // Returns (overflowsRight, overflowsBottom).
 func (p *MenuPositioner) WouldOverflow(
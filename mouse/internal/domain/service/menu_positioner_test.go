@@ -371,3 +371,104 @@ func TestFitsOnScreen_ExactFit(t *testing.T) {
 		t.Error("expected menu to fit on screen (exact fit)")
 	}
 }
+
+// TestMenuPositioner_CalculateSubmenuPosition tests submenu placement beside a parent item.
+func TestMenuPositioner_CalculateSubmenuPosition(t *testing.T) {
+	positioner := NewMenuPositioner()
+	const screenW, screenH = 80, 24
+
+	tests := []struct {
+		name         string
+		parent       value.BoundingBox
+		menuW, menuH int
+		prefer       value.Direction
+		want         value.Position
+	}{
+		{
+			name:   "opens right, top aligned with item",
+			parent: value.NewBoundingBox(10, 5, 20, 1),
+			menuW:  15, menuH: 6,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(30, 5),
+		},
+		{
+			name:   "flips left when right overflows",
+			parent: value.NewBoundingBox(50, 6, 20, 1),
+			menuW:  15, menuH: 5,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(35, 6),
+		},
+		{
+			name:   "opens left",
+			parent: value.NewBoundingBox(40, 2, 20, 1),
+			menuW:  15, menuH: 5,
+			prefer: value.DirectionLeft,
+			want:   value.NewPosition(25, 2),
+		},
+		{
+			name:   "flips right when left overflows",
+			parent: value.NewBoundingBox(5, 2, 20, 1),
+			menuW:  15, menuH: 5,
+			prefer: value.DirectionLeft,
+			want:   value.NewPosition(25, 2),
+		},
+		{
+			name:   "exactly fits on the right",
+			parent: value.NewBoundingBox(45, 0, 20, 1),
+			menuW:  15, menuH: 5,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(65, 0),
+		},
+		{
+			name:   "shifts up near bottom edge",
+			parent: value.NewBoundingBox(10, 22, 20, 1),
+			menuW:  15, menuH: 6,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(30, 18),
+		},
+		{
+			name:   "fits on neither side: overlaps parent on preferred side",
+			parent: value.NewBoundingBox(10, 0, 60, 1),
+			menuW:  30, menuH: 5,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(50, 0),
+		},
+		{
+			name:   "fits on neither side, prefer left",
+			parent: value.NewBoundingBox(10, 0, 60, 1),
+			menuW:  30, menuH: 5,
+			prefer: value.DirectionLeft,
+			want:   value.NewPosition(0, 0),
+		},
+		{
+			name:   "drops down below menu bar item",
+			parent: value.NewBoundingBox(12, 0, 6, 1),
+			menuW:  20, menuH: 8,
+			prefer: value.DirectionDown,
+			want:   value.NewPosition(12, 1),
+		},
+		{
+			name:   "dropdown flips up and shifts left",
+			parent: value.NewBoundingBox(70, 23, 6, 1),
+			menuW:  20, menuH: 8,
+			prefer: value.DirectionDown,
+			want:   value.NewPosition(60, 15),
+		},
+		{
+			name:   "menu larger than screen pins to origin",
+			parent: value.NewBoundingBox(10, 5, 20, 1),
+			menuW:  100, menuH: 5,
+			prefer: value.DirectionRight,
+			want:   value.NewPosition(0, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := positioner.CalculateSubmenuPosition(tt.parent, tt.menuW, tt.menuH, screenW, screenH, tt.prefer)
+			if !got.Equals(tt.want) {
+				t.Errorf("CalculateSubmenuPosition() = (%d, %d), want (%d, %d)", got.X(), got.Y(), tt.want.X(), tt.want.Y())
+			}
+		})
+	}
+}
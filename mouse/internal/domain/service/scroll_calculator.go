@@ -7,7 +7,8 @@ import (
 
 // ScrollCalculator is a domain service that calculates scroll deltas.
 type ScrollCalculator struct {
-	linesPerScroll int
+	linesPerScroll       int
+	shiftWheelHorizontal bool // Treat Shift+vertical wheel as horizontal scroll
 }
 
 // NewScrollCalculator creates a new ScrollCalculator.
@@ -21,10 +22,23 @@ func NewScrollCalculator(linesPerScroll int) *ScrollCalculator {
 	}
 }
 
+// SetShiftWheelHorizontal sets whether Shift+vertical wheel scrolls horizontally.
+// Most terminals report Shift+wheel as a vertical wheel event with the Shift
+// modifier instead of converting it to a horizontal one.
+func (s *ScrollCalculator) SetShiftWheelHorizontal(enabled bool) {
+	s.shiftWheelHorizontal = enabled
+}
+
+// ShiftWheelHorizontal returns whether Shift+vertical wheel scrolls horizontally.
+func (s *ScrollCalculator) ShiftWheelHorizontal() bool {
+	return s.shiftWheelHorizontal
+}
+
 // CalculateDelta calculates the scroll delta for a scroll event.
 // Returns the number of lines to scroll (positive = down, negative = up).
+// Horizontal scroll events (and Shift+wheel, if treated as horizontal) return 0.
 func (s *ScrollCalculator) CalculateDelta(scrollEvent model.MouseEvent) int {
-	if scrollEvent.Type() != value2.EventScroll {
+	if scrollEvent.Type() != value2.EventScroll || s.isShiftWheel(scrollEvent) {
 		return 0
 	}
 
@@ -38,6 +52,39 @@ func (s *ScrollCalculator) CalculateDelta(scrollEvent model.MouseEvent) int {
 	}
 }
 
+// CalculateHorizontalDelta calculates the horizontal scroll delta for a scroll event.
+// Returns the number of columns to scroll (positive = right, negative = left).
+func (s *ScrollCalculator) CalculateHorizontalDelta(scrollEvent model.MouseEvent) int {
+	if scrollEvent.Type() != value2.EventScroll {
+		return 0
+	}
+
+	switch scrollEvent.Button() {
+	case value2.ButtonWheelLeft:
+		return -s.linesPerScroll
+	case value2.ButtonWheelRight:
+		return s.linesPerScroll
+	}
+
+	if s.isShiftWheel(scrollEvent) {
+		// Shift+wheel up scrolls left, Shift+wheel down scrolls right.
+		if scrollEvent.Button() == value2.ButtonWheelUp {
+			return -s.linesPerScroll
+		}
+		return s.linesPerScroll
+	}
+	return 0
+}
+
+// isShiftWheel returns true if the event is a Shift+vertical wheel event
+// that should scroll horizontally.
+func (s *ScrollCalculator) isShiftWheel(scrollEvent model.MouseEvent) bool {
+	button := scrollEvent.Button()
+	return s.shiftWheelHorizontal &&
+		scrollEvent.Modifiers().HasShift() &&
+		(button == value2.ButtonWheelUp || button == value2.ButtonWheelDown)
+}
+
 // IsScrollUp returns true if the event is a scroll up event.
 func (s *ScrollCalculator) IsScrollUp(scrollEvent model.MouseEvent) bool {
 	return scrollEvent.Type() == value2.EventScroll && scrollEvent.Button() == value2.ButtonWheelUp
@@ -500,3 +500,52 @@ func TestScrollCalculator_DeltaSymmetry(t *testing.T) {
 		})
 	}
 }
+
+// Test horizontal scroll delta calculation
+func TestScrollCalculator_CalculateHorizontalDelta(t *testing.T) {
+	tests := []struct {
+		name           string
+		shiftWheel     bool
+		button         value2.Button
+		modifiers      value2.Modifiers
+		wantHorizontal int
+		wantVertical   int
+	}{
+		{"wheel left", false, value2.ButtonWheelLeft, value2.ModifierNone, -3, 0},
+		{"wheel right", false, value2.ButtonWheelRight, value2.ModifierNone, 3, 0},
+		{"wheel up", false, value2.ButtonWheelUp, value2.ModifierNone, 0, -3},
+		{"shift+wheel up, not converted", false, value2.ButtonWheelUp, value2.ModifierShift, 0, -3},
+		{"shift+wheel up, converted", true, value2.ButtonWheelUp, value2.ModifierShift, -3, 0},
+		{"shift+wheel down, converted", true, value2.ButtonWheelDown, value2.ModifierShift, 3, 0},
+		{"wheel down without shift, converting", true, value2.ButtonWheelDown, value2.ModifierNone, 0, 3},
+		{"shift+wheel right, converting", true, value2.ButtonWheelRight, value2.ModifierShift, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewScrollCalculator(3)
+			calc.SetShiftWheelHorizontal(tt.shiftWheel)
+
+			event := model.NewMouseEvent(value2.EventScroll, tt.button, value2.NewPosition(10, 5), tt.modifiers)
+			if got := calc.CalculateHorizontalDelta(event); got != tt.wantHorizontal {
+				t.Errorf("CalculateHorizontalDelta() = %d, want %d", got, tt.wantHorizontal)
+			}
+			if got := calc.CalculateDelta(event); got != tt.wantVertical {
+				t.Errorf("CalculateDelta() = %d, want %d", got, tt.wantVertical)
+			}
+		})
+	}
+}
+
+// Test horizontal delta ignores non-scroll events
+func TestScrollCalculator_HorizontalNonScroll(t *testing.T) {
+	calc := NewScrollCalculator(3)
+	event := model.NewMouseEvent(value2.EventPress, value2.ButtonWheelLeft, value2.NewPosition(0, 0), value2.ModifierNone)
+
+	if got := calc.CalculateHorizontalDelta(event); got != 0 {
+		t.Errorf("CalculateHorizontalDelta() = %d for press event, want 0", got)
+	}
+	if calc.ShiftWheelHorizontal() {
+		t.Error("ShiftWheelHorizontal() should default to false")
+	}
+}
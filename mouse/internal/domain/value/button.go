@@ -18,6 +18,10 @@ const (
 	ButtonWheelUp
 	// ButtonWheelDown represents scroll wheel down.
 	ButtonWheelDown
+	// ButtonWheelLeft represents horizontal scroll left (tilt wheel, trackpad).
+	ButtonWheelLeft
+	// ButtonWheelRight represents horizontal scroll right (tilt wheel, trackpad).
+	ButtonWheelRight
 )
 
 // String returns the string representation of the button.
@@ -35,14 +39,23 @@ func (b Button) String() string {
 		return "WheelUp"
 	case ButtonWheelDown:
 		return "WheelDown"
+	case ButtonWheelLeft:
+		return "WheelLeft"
+	case ButtonWheelRight:
+		return "WheelRight"
 	default:
 		return "Unknown"
 	}
 }
 
-// IsWheel returns true if the button is a scroll wheel action.
+// IsWheel returns true if the button is a scroll wheel action (vertical or horizontal).
 func (b Button) IsWheel() bool {
-	return b == ButtonWheelUp || b == ButtonWheelDown
+	return b >= ButtonWheelUp && b <= ButtonWheelRight
+}
+
+// IsHorizontalWheel returns true if the button is a horizontal scroll action.
+func (b Button) IsHorizontalWheel() bool {
+	return b == ButtonWheelLeft || b == ButtonWheelRight
 }
 
 // IsButton returns true if the button is an actual button (not wheel).
@@ -13,6 +13,8 @@ func TestButton_String(t *testing.T) {
 		{ButtonRight, "Right"},
 		{ButtonWheelUp, "WheelUp"},
 		{ButtonWheelDown, "WheelDown"},
+		{ButtonWheelLeft, "WheelLeft"},
+		{ButtonWheelRight, "WheelRight"},
 		{Button(99), "Unknown"},
 	}
 
@@ -36,6 +38,8 @@ func TestButton_IsWheel(t *testing.T) {
 		{ButtonRight, false},
 		{ButtonWheelUp, true},
 		{ButtonWheelDown, true},
+		{ButtonWheelLeft, true},
+		{ButtonWheelRight, true},
 	}
 
 	for _, tt := range tests {
@@ -68,3 +72,24 @@ func TestButton_IsButton(t *testing.T) {
 		})
 	}
 }
+
+func TestButton_IsHorizontalWheel(t *testing.T) {
+	tests := []struct {
+		button   Button
+		expected bool
+	}{
+		{ButtonLeft, false},
+		{ButtonWheelUp, false},
+		{ButtonWheelDown, false},
+		{ButtonWheelLeft, true},
+		{ButtonWheelRight, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.button.String(), func(t *testing.T) {
+			if got := tt.button.IsHorizontalWheel(); got != tt.expected {
+				t.Errorf("Button.IsHorizontalWheel() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package value
+
+// Direction represents the side of a parent item a menu opens on.
+type Direction int
+
+const (
+	// DirectionRight opens the menu to the right of the parent (submenus).
+	DirectionRight Direction = iota
+	// DirectionLeft opens the menu to the left of the parent.
+	DirectionLeft
+	// DirectionDown opens the menu below the parent (menu bar dropdowns).
+	DirectionDown
+	// DirectionUp opens the menu above the parent.
+	DirectionUp
+)
+
+// String returns the string representation of the direction.
+func (d Direction) String() string {
+	switch d {
+	case DirectionRight:
+		return "Right"
+	case DirectionLeft:
+		return "Left"
+	case DirectionDown:
+		return "Down"
+	case DirectionUp:
+		return "Up"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsHorizontal returns true for DirectionRight and DirectionLeft.
+func (d Direction) IsHorizontal() bool {
+	return d == DirectionRight || d == DirectionLeft
+}
+
+// Opposite returns the direction on the other side of the parent.
+func (d Direction) Opposite() Direction {
+	switch d {
+	case DirectionRight:
+		return DirectionLeft
+	case DirectionLeft:
+		return DirectionRight
+	case DirectionDown:
+		return DirectionUp
+	case DirectionUp:
+		return DirectionDown
+	default:
+		return d
+	}
+}
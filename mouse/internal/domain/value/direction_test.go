@@ -0,0 +1,48 @@
+package value
+
+import "testing"
+
+func TestDirection_String(t *testing.T) {
+	tests := []struct {
+		direction Direction
+		expected  string
+	}{
+		{DirectionRight, "Right"},
+		{DirectionLeft, "Left"},
+		{DirectionDown, "Down"},
+		{DirectionUp, "Up"},
+		{Direction(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := tt.direction.String(); got != tt.expected {
+				t.Errorf("Direction.String() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDirection_IsHorizontalAndOpposite(t *testing.T) {
+	tests := []struct {
+		direction  Direction
+		horizontal bool
+		opposite   Direction
+	}{
+		{DirectionRight, true, DirectionLeft},
+		{DirectionLeft, true, DirectionRight},
+		{DirectionDown, false, DirectionUp},
+		{DirectionUp, false, DirectionDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.direction.String(), func(t *testing.T) {
+			if got := tt.direction.IsHorizontal(); got != tt.horizontal {
+				t.Errorf("IsHorizontal() = %v, want %v", got, tt.horizontal)
+			}
+			if got := tt.direction.Opposite(); got != tt.opposite {
+				t.Errorf("Opposite() = %v, want %v", got, tt.opposite)
+			}
+		})
+	}
+}
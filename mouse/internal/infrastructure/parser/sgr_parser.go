@@ -14,7 +14,7 @@ import (
 // SGRParser parses SGR (1006) mouse protocol sequences.
 // Format: \x1b[<button;x;y(M|m)
 // M = press, m = release
-// button: 0=left, 1=middle, 2=right, 64=wheel up, 65=wheel down, +4=shift, +8=alt, +16=ctrl, +32=motion
+// button: 0=left, 1=middle, 2=right, 64=wheel up, 65=wheel down, 66=wheel left, 67=wheel right, +4=shift, +8=alt, +16=ctrl, +32=motion
 type SGRParser struct{}
 
 // NewSGRParser creates a new SGR parser.
@@ -96,6 +96,10 @@ func (p *SGRParser) decodeButton(code int) (value2.Button, value2.Modifiers) {
 		button = value2.ButtonWheelUp
 	case 65:
 		button = value2.ButtonWheelDown
+	case 66:
+		button = value2.ButtonWheelLeft
+	case 67:
+		button = value2.ButtonWheelRight
 	case 32, 35: // Motion events (bit 5 set)
 		button = value2.ButtonNone
 	default:
@@ -145,6 +149,10 @@ func (p *SGRParser) encodeButton(button value2.Button, modifiers value2.Modifier
 		code = 64
 	case value2.ButtonWheelDown:
 		code = 65
+	case value2.ButtonWheelLeft:
+		code = 66
+	case value2.ButtonWheelRight:
+		code = 67
 	case value2.ButtonNone:
 		code = 32 // Motion
 	}
@@ -367,3 +367,43 @@ func TestSGRParser_DecodeButton_MotionEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestSGRParser_HorizontalWheel(t *testing.T) {
+	parser := NewSGRParser()
+
+	tests := []struct {
+		name          string
+		sequence      string
+		wantButton    value2.Button
+		wantModifiers value2.Modifiers
+	}{
+		{"wheel left", "<66;10;5", value2.ButtonWheelLeft, value2.ModifierNone},
+		{"wheel right", "<67;10;5", value2.ButtonWheelRight, value2.ModifierNone},
+		{"ctrl+wheel right", "<83;10;5", value2.ButtonWheelRight, value2.ModifierCtrl},
+		// Shift+wheel passed through as vertical wheel with the Shift bit.
+		{"shift+wheel up", "<68;10;5", value2.ButtonWheelUp, value2.ModifierShift},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := parser.Parse(tt.sequence, true)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if event.Button() != tt.wantButton {
+				t.Errorf("Button = %v, want %v", event.Button(), tt.wantButton)
+			}
+			if event.Modifiers() != tt.wantModifiers {
+				t.Errorf("Modifiers = %v, want %v", event.Modifiers(), tt.wantModifiers)
+			}
+			if event.Type() != value2.EventScroll {
+				t.Errorf("Type = %v, want EventScroll", event.Type())
+			}
+
+			// Round trip.
+			if got := parser.FormatSequence(*event, true); got != "\x1b["+tt.sequence+"M" {
+				t.Errorf("FormatSequence() = %q, want %q", got, "\x1b["+tt.sequence+"M")
+			}
+		})
+	}
+}
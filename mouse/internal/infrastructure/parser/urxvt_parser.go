@@ -87,6 +87,10 @@ func (p *URxvtParser) decodeButton(code int) (value2.Button, value2.Modifiers) {
 		button = value2.ButtonWheelUp
 	case 65:
 		button = value2.ButtonWheelDown
+	case 66:
+		button = value2.ButtonWheelLeft
+	case 67:
+		button = value2.ButtonWheelRight
 	case 32, 35: // Motion events
 		button = value2.ButtonNone
 	default:
@@ -124,6 +128,10 @@ func (p *URxvtParser) encodeButton(button value2.Button, modifiers value2.Modifi
 		code = 64
 	case value2.ButtonWheelDown:
 		code = 65
+	case value2.ButtonWheelLeft:
+		code = 66
+	case value2.ButtonWheelRight:
+		code = 67
 	case value2.ButtonNone:
 		code = 32
 	}
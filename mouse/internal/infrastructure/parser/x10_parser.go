@@ -70,6 +70,10 @@ func (p *X10Parser) decodeButton(code int) (value2.Button, value2.Modifiers) {
 		button = value2.ButtonWheelUp
 	case 65:
 		button = value2.ButtonWheelDown
+	case 66:
+		button = value2.ButtonWheelLeft
+	case 67:
+		button = value2.ButtonWheelRight
 	case 32, 35: // Motion events
 		button = value2.ButtonNone
 	default:
@@ -108,6 +112,10 @@ func (p *X10Parser) encodeButton(button value2.Button, modifiers value2.Modifier
 		code = 64
 	case value2.ButtonWheelDown:
 		code = 65
+	case value2.ButtonWheelLeft:
+		code = 66
+	case value2.ButtonWheelRight:
+		code = 67
 	case value2.ButtonNone:
 		code = 32
 	}
@@ -198,6 +198,9 @@ type (
 
 	// BoundingBox represents a rectangular area in terminal coordinates.
 	BoundingBox = value2.BoundingBox
+
+	// Direction represents the side of a parent item a menu opens on.
+	Direction = value2.Direction
 )
 
 // Event types.
@@ -217,12 +220,22 @@ const (
 
 // Buttons.
 const (
-	ButtonNone      = value2.ButtonNone
-	ButtonLeft      = value2.ButtonLeft
-	ButtonMiddle    = value2.ButtonMiddle
-	ButtonRight     = value2.ButtonRight
-	ButtonWheelUp   = value2.ButtonWheelUp
-	ButtonWheelDown = value2.ButtonWheelDown
+	ButtonNone       = value2.ButtonNone
+	ButtonLeft       = value2.ButtonLeft
+	ButtonMiddle     = value2.ButtonMiddle
+	ButtonRight      = value2.ButtonRight
+	ButtonWheelUp    = value2.ButtonWheelUp
+	ButtonWheelDown  = value2.ButtonWheelDown
+	ButtonWheelLeft  = value2.ButtonWheelLeft
+	ButtonWheelRight = value2.ButtonWheelRight
+)
+
+// Menu directions.
+const (
+	DirectionRight = value2.DirectionRight
+	DirectionLeft  = value2.DirectionLeft
+	DirectionDown  = value2.DirectionDown
+	DirectionUp    = value2.DirectionUp
 )
 
 // Modifiers.
@@ -306,6 +319,28 @@ func (m *Mouse) ScrollDelta(event MouseEvent) int {
 	return m.handler.Processor().ScrollDelta(event)
 }
 
+// HorizontalScrollDelta calculates the horizontal scroll delta (in columns)
+// for a scroll event. Returns negative for scroll left, positive for scroll
+// right, and 0 for vertical scrolling.
+//
+// Horizontal wheels and trackpads arrive as ButtonWheelLeft/ButtonWheelRight.
+// Shift+wheel is reported differently per terminal; see SetShiftWheelHorizontal.
+func (m *Mouse) HorizontalScrollDelta(event MouseEvent) int {
+	return m.handler.Processor().HorizontalScrollDelta(event)
+}
+
+// SetShiftWheelHorizontal makes Shift+vertical wheel scroll horizontally:
+// HorizontalScrollDelta then reports it (wheel up = left, wheel down = right)
+// and ScrollDelta returns 0 for it. Off by default.
+//
+// Enable it for terminals that pass Shift+wheel through as a vertical wheel
+// event with the Shift modifier (most Linux and Windows terminals). Where the
+// OS or terminal already converts Shift+wheel to horizontal scrolling (macOS),
+// events arrive as ButtonWheelLeft/ButtonWheelRight either way.
+func (m *Mouse) SetShiftWheelHorizontal(enabled bool) {
+	m.handler.Processor().SetShiftWheelHorizontal(enabled)
+}
+
 // IsDragging returns true if a drag is currently in progress.
 func (m *Mouse) IsDragging() bool {
 	return m.handler.Processor().IsDragging()
@@ -338,14 +373,45 @@ func (m *Mouse) ProcessHover(position Position, areas []ComponentArea) EventType
 	// Convert public ComponentArea to internal service.ComponentArea
 	internalAreas := make([]application.ComponentArea, len(areas))
 	for i, area := range areas {
-		internalAreas[i] = application.ComponentArea{
-			ID:   area.ID,
-			Area: area.Area,
-		}
+		internalAreas[i] = toInternalArea(area)
 	}
 	return m.handler.Processor().ProcessHover(position, internalAreas)
 }
 
+// HitTest returns the topmost component area under position, for routing
+// clicks the same way ProcessHover routes hover. Areas with a higher Z
+// (e.g. an open menu or modal) take precedence over the areas they overlay;
+// among areas with the same Z, the first one in the slice wins.
+//
+// Example usage:
+//
+//	areas := []mouse.ComponentArea{
+//	    {ID: "save", Area: mouse.NewBoundingBox(5, 10, 20, 3)},
+//	    {ID: "menu", Area: mouse.NewBoundingBox(0, 8, 30, 10), Z: 1},
+//	}
+//	if area, ok := mouse.HitTest(evt.Position, areas); ok {
+//	    fmt.Println("Clicked:", area.ID) // "menu", not the button beneath it
+//	}
+func HitTest(position Position, areas []ComponentArea) (ComponentArea, bool) {
+	internalAreas := make([]application.ComponentArea, len(areas))
+	for i, area := range areas {
+		internalAreas[i] = toInternalArea(area)
+	}
+	if i := application.TopmostArea(position, internalAreas); i >= 0 {
+		return areas[i], true
+	}
+	return ComponentArea{}, false
+}
+
+// toInternalArea converts a public ComponentArea to the internal one.
+func toInternalArea(area ComponentArea) application.ComponentArea {
+	return application.ComponentArea{
+		ID:   area.ID,
+		Area: area.Area,
+		Z:    area.Z,
+	}
+}
+
 // IsHovering returns true if a component is currently being hovered.
 func (m *Mouse) IsHovering() bool {
 	return m.handler.Processor().IsHovering()
@@ -388,6 +454,35 @@ func (m *Mouse) CalculateMenuPosition(
 	return m.handler.Processor().CalculateMenuPosition(cursorPos, menuWidth, menuHeight, screenWidth, screenHeight)
 }
 
+// CalculateSubmenuPosition calculates the position of a submenu opened from
+// a parent menu item. The submenu opens on the preferred side of the item
+// and flips to the opposite side only if it would overflow the screen.
+//
+// Parameters:
+//   - parentBox: bounding box of the invoking menu item (one row of the parent menu)
+//   - menuWidth, menuHeight: size of the submenu in terminal cells
+//   - screenWidth, screenHeight: terminal size in cells
+//   - prefer: DirectionRight/DirectionLeft for submenus (top aligned with the item),
+//     DirectionDown/DirectionUp for menu bar dropdowns (left edge aligned with the item)
+//
+// If the submenu fits on neither side, it is shifted onto the screen and
+// overlaps the parent. A submenu larger than the screen is pinned to (0,0).
+//
+// Example usage:
+//
+//	// "Open Recent" item at row 6 of a menu spanning columns 50-69
+//	item := mouse.NewBoundingBox(50, 6, 20, 1)
+//	pos := mouseHandler.CalculateSubmenuPosition(item, 15, 5, 80, 24, mouse.DirectionRight)
+//	// Not enough room on the right (70+15 > 80): opens left at (35, 6)
+func (m *Mouse) CalculateSubmenuPosition(
+	parentBox BoundingBox,
+	menuWidth, menuHeight int,
+	screenWidth, screenHeight int,
+	prefer Direction,
+) Position {
+	return m.handler.Processor().CalculateSubmenuPosition(parentBox, menuWidth, menuHeight, screenWidth, screenHeight, prefer)
+}
+
 // ComponentArea represents a component's hover-detection area.
 //
 // Zero value: ComponentArea with zero value (empty ID and zero BoundingBox) is valid but not useful.
@@ -400,6 +495,9 @@ type ComponentArea struct {
 	ID string
 	// Area is the bounding box defining the component's hover area.
 	Area BoundingBox
+	// Z is the stacking order: where areas overlap, the highest Z is on top.
+	// Leave it 0 for non-overlapping areas (the first match wins).
+	Z int
 }
 
 // Helper functions for creating values
@@ -310,3 +310,116 @@ func TestBoundingBox_Contains(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================================
+// Z-order Hit-Testing Tests
+// ============================================================================
+
+func TestHitTest_TopmostWins(t *testing.T) {
+	areas := []ComponentArea{
+		{ID: "button", Area: NewBoundingBox(5, 10, 20, 3)},
+		{ID: "menu", Area: NewBoundingBox(0, 8, 30, 10), Z: 1},
+	}
+
+	area, ok := HitTest(NewPosition(10, 11), areas)
+	if !ok || area.ID != "menu" {
+		t.Errorf("HitTest() = %q, %v, want menu on top of button", area.ID, ok)
+	}
+	if area.Z != 1 {
+		t.Errorf("HitTest() returned area with Z = %d, want 1", area.Z)
+	}
+
+	if _, ok := HitTest(NewPosition(50, 50), areas); ok {
+		t.Error("HitTest() outside all areas should return false")
+	}
+}
+
+func TestHitTest_UnsetZKeepsFirstMatch(t *testing.T) {
+	areas := []ComponentArea{
+		{ID: "first", Area: NewBoundingBox(0, 0, 10, 10)},
+		{ID: "second", Area: NewBoundingBox(0, 0, 10, 10)},
+	}
+
+	if area, _ := HitTest(NewPosition(1, 1), areas); area.ID != "first" {
+		t.Errorf("HitTest() = %q, want first", area.ID)
+	}
+}
+
+func TestMouse_ProcessHover_Overlap(t *testing.T) {
+	m := New()
+	m.Enable()
+	defer m.Disable()
+
+	areas := []ComponentArea{
+		{ID: "button", Area: NewBoundingBox(5, 10, 20, 3)},
+		{ID: "menu", Area: NewBoundingBox(0, 8, 30, 10), Z: 1},
+	}
+
+	if eventType := m.ProcessHover(NewPosition(10, 11), areas); eventType != EventHoverEnter {
+		t.Errorf("expected EventHoverEnter, got %v", eventType)
+	}
+	if m.CurrentHoverComponent() != "menu" {
+		t.Errorf("expected hover on menu, got %q", m.CurrentHoverComponent())
+	}
+}
+
+// ============================================================================
+// Horizontal Scroll Tests
+// ============================================================================
+
+func TestMouse_HorizontalScroll(t *testing.T) {
+	m := New()
+
+	events, err := m.ParseSequence("\x1b[<66;10;5M")
+	if err != nil {
+		t.Fatalf("ParseSequence() error = %v", err)
+	}
+	if len(events) != 1 || events[0].Button() != ButtonWheelLeft {
+		t.Fatalf("expected one ButtonWheelLeft event, got %+v", events)
+	}
+	if got := m.HorizontalScrollDelta(events[0]); got != -3 {
+		t.Errorf("HorizontalScrollDelta() = %d, want -3", got)
+	}
+	if got := m.ScrollDelta(events[0]); got != 0 {
+		t.Errorf("ScrollDelta() = %d for horizontal scroll, want 0", got)
+	}
+}
+
+func TestMouse_SetShiftWheelHorizontal(t *testing.T) {
+	m := New()
+
+	// Shift+wheel down, as most Linux terminals report it.
+	events, err := m.ParseSequence("\x1b[<69;10;5M")
+	if err != nil || len(events) != 1 {
+		t.Fatalf("ParseSequence() = %+v, %v", events, err)
+	}
+	if m.HorizontalScrollDelta(events[0]) != 0 || m.ScrollDelta(events[0]) != 3 {
+		t.Error("Shift+wheel should scroll vertically by default")
+	}
+
+	m.SetShiftWheelHorizontal(true)
+	if got := m.HorizontalScrollDelta(events[0]); got != 3 {
+		t.Errorf("HorizontalScrollDelta() = %d, want 3", got)
+	}
+	if got := m.ScrollDelta(events[0]); got != 0 {
+		t.Errorf("ScrollDelta() = %d, want 0", got)
+	}
+}
+
+// ============================================================================
+// Submenu Positioning Tests
+// ============================================================================
+
+func TestMouse_CalculateSubmenuPosition(t *testing.T) {
+	m := New()
+
+	item := NewBoundingBox(50, 6, 20, 1)
+	if pos := m.CalculateSubmenuPosition(item, 8, 5, 80, 24, DirectionRight); pos.X() != 70 || pos.Y() != 6 {
+		t.Errorf("expected submenu at (70, 6), got (%d, %d)", pos.X(), pos.Y())
+	}
+
+	// Not enough room on the right: flips left.
+	if pos := m.CalculateSubmenuPosition(item, 15, 5, 80, 24, DirectionRight); pos.X() != 35 || pos.Y() != 6 {
+		t.Errorf("expected submenu at (35, 6), got (%d, %d)", pos.X(), pos.Y())
+	}
+}
@@ -0,0 +1,61 @@
+package style
+
+import (
+	"sync/atomic"
+	"time"
+
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/terminal"
+)
+
+type (
+	// TerminalColor is a color accepted by Style.Foreground and
+	// Style.Background: either a Color or an AdaptiveColor.
+	TerminalColor = value2.TerminalColor
+
+	// AdaptiveColor holds one color for light and one for dark terminal
+	// backgrounds. The variant is picked when rendering, based on
+	// BackgroundIsDark, so one style looks right on both.
+	//
+	// Example:
+	//
+	//	subtle := style.AdaptiveColor{Light: style.RGB(90, 90, 90), Dark: style.RGB(170, 170, 170)}
+	//	s := style.New().Foreground(subtle)
+	AdaptiveColor = value2.AdaptiveColor
+)
+
+// backgroundIsLight stores the terminal background (zero value: dark, the
+// most common case).
+var backgroundIsLight atomic.Bool
+
+// SetBackgroundIsDark sets whether the terminal background is dark, which
+// decides the variant of every AdaptiveColor at render time. The default is
+// dark. Safe for concurrent use.
+func SetBackgroundIsDark(dark bool) {
+	backgroundIsLight.Store(!dark)
+}
+
+// BackgroundIsDark reports whether adaptive colors render for a dark
+// terminal background.
+func BackgroundIsDark() bool {
+	return !backgroundIsLight.Load()
+}
+
+// DetectBackground asks the terminal for its background color (OSC 11) and
+// calls SetBackgroundIsDark with the result. This is best-effort: if the
+// terminal does not answer within timeout, the setting is left unchanged
+// and the error is returned. It reads the reply from stdin, so call it once
+// at startup, before the program starts reading input.
+//
+// Example:
+//
+//	_ = style.DetectBackground(100 * time.Millisecond) // keep the default on error
+//	p := tea.New(model{})
+func DetectBackground(timeout time.Duration) error {
+	r, g, b, err := terminal.QueryBackgroundColor(timeout)
+	if err != nil {
+		return err
+	}
+	SetBackgroundIsDark(RGB(r, g, b).IsDark())
+	return nil
+}
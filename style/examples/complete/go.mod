@@ -6,10 +6,14 @@ replace github.com/phoenix-tui/phoenix/style => ../..
 
 replace github.com/phoenix-tui/phoenix/core => ../../../core
 
+replace github.com/phoenix-tui/phoenix/terminal => ../../../terminal
+
 require github.com/phoenix-tui/phoenix/style v0.2.4
 
 require (
 	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
-	github.com/rivo/uniseg v0.4.7 // indirect
+	github.com/phoenix-tui/phoenix/terminal v0.2.4 // indirect
 	github.com/unilibs/uniwidth v0.2.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/term v0.39.0 // indirect
 )
@@ -5,6 +5,7 @@
 // - Create custom themes
 // - Switch themes at runtime
 // - Use ThemeManager for thread-safe theme management
+// - Style elements by role and restyle them when the theme changes
 package main
 
 import (
@@ -118,6 +119,24 @@ func main() {
 	fmt.Printf("   Spacing MD: %d\n", spacing.MD)
 	fmt.Printf("   Default Border: %s\n", borders.Default)
 
+	// 8. Styling by role, restyled on theme change
+	fmt.Println("\n8. Styling by Role:")
+	manager := style.NewThemeManager(nil)
+	var panel, errText style.Style
+	restyle := func(theme *style.Theme) {
+		panel = style.New().PaddingHorizontal(1).FromTheme(theme, style.RoleBorder)
+		errText = style.New().FromTheme(theme, style.RoleError)
+	}
+	restyle(manager.Current())
+	unsubscribe := manager.Subscribe(restyle)
+	defer unsubscribe()
+
+	fmt.Println(style.Render(panel, "Disk usage"))
+	fmt.Println(style.Render(errText, "Disk full"))
+	manager.SetPreset("HighContrast")
+	fmt.Println(style.Render(panel, "Disk usage"))
+	fmt.Println(style.Render(errText, "Disk full"))
+
 	fmt.Println("\n=== Demo Complete ===")
 }
 
@@ -0,0 +1,24 @@
+package style
+
+// Gradient renders text with its foreground color fading from one color to
+// another, left to right. Each grapheme cluster gets a single color, so
+// emoji and CJK characters are never split across colors. Multi-line text
+// is colored per column, so all lines share the same colors at the same
+// column.
+//
+// Gradient renders for TrueColor terminals. To adapt to other color depths,
+// use a Style with ForegroundGradient and WithTerminalCapability: each step
+// then uses the nearest palette color, and NoColor renders the text as-is.
+//
+// Example:
+//
+//	title := style.Gradient("Phoenix TUI", style.RGB(255, 95, 0), style.RGB(255, 215, 0))
+//
+//	s := style.WithTerminalCapability(
+//	    style.New().ForegroundGradient(style.RGB(255, 95, 0), style.RGB(255, 215, 0)),
+//	    style.ANSI256,
+//	)
+//	banner := style.Render(s, "Phoenix\nTUI")
+func Gradient(text string, from, to Color) string {
+	return Render(New().ForegroundGradient(from, to), text)
+}
@@ -0,0 +1,75 @@
+package style
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+	"github.com/phoenix-tui/phoenix/terminal"
+)
+
+// HyperlinkOption configures Hyperlink.
+type HyperlinkOption func(*hyperlinkOptions)
+
+// hyperlinkOptions holds the settings applied by HyperlinkOption.
+type hyperlinkOptions struct {
+	id string
+}
+
+// WithHyperlinkID sets the link id. Links with the same id and URL are
+// treated as one link by the terminal, so a URL wrapped over several lines
+// (or split across table cells) highlights as a whole on hover.
+func WithHyperlinkID(id string) HyperlinkOption {
+	return func(o *hyperlinkOptions) {
+		o.id = id
+	}
+}
+
+var (
+	// hyperlinksDetect detects hyperlink support on first use, unless
+	// SetHyperlinks was called before.
+	hyperlinksDetect sync.Once
+
+	// hyperlinksEnabled stores whether Hyperlink emits OSC 8 sequences.
+	hyperlinksEnabled atomic.Bool
+)
+
+// SetHyperlinks sets whether Hyperlink emits clickable OSC 8 links or plain
+// text, overriding the detection from the environment. Safe for concurrent
+// use.
+func SetHyperlinks(enabled bool) {
+	hyperlinksDetect.Do(func() {})
+	hyperlinksEnabled.Store(enabled)
+}
+
+// HyperlinksEnabled reports whether Hyperlink emits clickable links. Unless
+// set with SetHyperlinks, this is detected once from the environment, see
+// terminal.HyperlinksSupported.
+func HyperlinksEnabled() bool {
+	hyperlinksDetect.Do(func() {
+		hyperlinksEnabled.Store(terminal.HyperlinksSupported())
+	})
+	return hyperlinksEnabled.Load()
+}
+
+// Hyperlink returns text as a clickable link to url (OSC 8), or text
+// unchanged if the terminal does not support hyperlinks (see
+// HyperlinksEnabled). The link sequences have no width, so the result
+// measures the same as text with Width and can be styled, padded and
+// wrapped like any other string.
+//
+// Example:
+//
+//	link := style.Hyperlink("https://github.com/phoenix-tui/phoenix", "Phoenix")
+//	s := style.Render(style.New().Underline(true), link)
+func Hyperlink(url, text string, opts ...HyperlinkOption) string {
+	if !HyperlinksEnabled() {
+		return text
+	}
+
+	var o hyperlinkOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return ansi.Hyperlink(url, text, o.id)
+}
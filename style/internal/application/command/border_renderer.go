@@ -4,7 +4,6 @@ package command
 import (
 	"strings"
 
-	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style/internal/domain/model"
 	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
 	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
@@ -58,8 +57,9 @@ func (br *BorderRenderer) Render(content string, style model.Style) string {
 
 	// Top border.
 	if hasTop {
-		topLine := br.buildTopBorder(border, maxWidth, hasLeft, hasRight)
-		topLine = br.applyBorderColor(topLine, style)
+		topLine := br.buildTopBorder(border, maxWidth, hasLeft, hasRight, style.GetBorderTitle(), style.GetBorderTitleAlign())
+		topColor, hasTopColor := style.GetBorderTopColor()
+		topLine = br.applyBorderColor(topLine, style, topColor, hasTopColor)
 		result = append(result, topLine)
 	}
 
@@ -73,7 +73,8 @@ func (br *BorderRenderer) Render(content string, style model.Style) string {
 	// Bottom border.
 	if hasBottom {
 		bottomLine := br.buildBottomBorder(border, maxWidth, hasLeft, hasRight)
-		bottomLine = br.applyBorderColor(bottomLine, style)
+		bottomColor, hasBottomColor := style.GetBorderBottomColor()
+		bottomLine = br.applyBorderColor(bottomLine, style, bottomColor, hasBottomColor)
 		result = append(result, bottomLine)
 	}
 
@@ -84,7 +85,7 @@ func (br *BorderRenderer) Render(content string, style model.Style) string {
 func (br *BorderRenderer) calculateMaxWidth(lines []string) int {
 	maxWidth := 0
 	for _, line := range lines {
-		width := core.StringWidth(line)
+		width := ansi.Width(line)
 		if width > maxWidth {
 			maxWidth = width
 		}
@@ -92,8 +93,8 @@ func (br *BorderRenderer) calculateMaxWidth(lines []string) int {
 	return maxWidth
 }
 
-// buildTopBorder builds the top border line.
-func (br *BorderRenderer) buildTopBorder(border value2.Border, width int, hasLeft, hasRight bool) string {
+// buildTopBorder builds the top border line, with title (if any) inline.
+func (br *BorderRenderer) buildTopBorder(border value2.Border, width int, hasLeft, hasRight bool, title string, align value2.HorizontalAlignment) string {
 	var parts []string
 
 	// Left corner.
@@ -102,7 +103,7 @@ func (br *BorderRenderer) buildTopBorder(border value2.Border, width int, hasLef
 	}
 
 	// Top edge.
-	parts = append(parts, strings.Repeat(border.Top, width))
+	parts = append(parts, buildTitledEdge(border.Top, width, title, align))
 
 	// Right corner.
 	if hasRight {
@@ -112,6 +113,34 @@ func (br *BorderRenderer) buildTopBorder(border value2.Border, width int, hasLef
 	return strings.Join(parts, "")
 }
 
+// buildTitledEdge builds a border edge of width cells with title embedded
+// as " title ", keeping at least one edge character on each side. Titles
+// that do not fit are truncated with "…"; if not even one character fits,
+// the plain edge is returned.
+func buildTitledEdge(edge string, width int, title string, align value2.HorizontalAlignment) string {
+	const minEdge = 1 // Edge characters kept on each side of the title
+
+	room := width - 2*minEdge - 2 // Spaces around the title
+	if title == "" || room < 1 {
+		return strings.Repeat(edge, width)
+	}
+
+	title = " " + ansi.Truncate(title, room, "…") + " "
+	fill := width - 2*minEdge - ansi.Width(title)
+
+	var left int
+	switch align {
+	case value2.AlignCenter:
+		left = (fill + 1) / 2 // Left gets extra if odd, like AlignHorizontal
+	case value2.AlignRight:
+		left = fill
+	default:
+		left = 0
+	}
+
+	return strings.Repeat(edge, minEdge+left) + title + strings.Repeat(edge, minEdge+fill-left)
+}
+
 // buildBottomBorder builds the bottom border line.
 func (br *BorderRenderer) buildBottomBorder(border value2.Border, width int, hasLeft, hasRight bool) string {
 	var parts []string
@@ -135,7 +164,7 @@ func (br *BorderRenderer) buildBottomBorder(border value2.Border, width int, has
 // buildContentLine builds a content line with left/right borders.
 func (br *BorderRenderer) buildContentLine(line string, border value2.Border, targetWidth int, hasLeft, hasRight bool) string {
 	// Calculate padding needed to reach target width.
-	currentWidth := core.StringWidth(line)
+	currentWidth := ansi.Width(line)
 	paddingWidth := targetWidth - currentWidth
 	if paddingWidth < 0 {
 		paddingWidth = 0
@@ -162,64 +191,53 @@ func (br *BorderRenderer) buildContentLine(line string, border value2.Border, ta
 	return strings.Join(parts, "")
 }
 
-// applyBorderColor applies border color to entire line.
-func (br *BorderRenderer) applyBorderColor(line string, style model.Style) string {
-	borderColor, hasBorderColor := style.GetBorderColor()
-	if !hasBorderColor {
+// applyBorderColor applies a border color to an entire line (top or bottom
+// border, corners included).
+func (br *BorderRenderer) applyBorderColor(line string, style model.Style, color value2.Color, hasColor bool) string {
+	if !hasColor {
 		return line
 	}
 
 	// Adapt color to terminal capability.
 	termCap := style.GetTerminalCapability()
-	ansiCode := br.colorToANSI(borderColor, termCap, true)
+	ansiCode := br.colorToANSI(color, termCap, true)
 
 	return ansiCode + line + br.ansiGenerator.Reset()
 }
 
-// applyBorderColorToSides applies border color only to left/right border characters.
-// This is more complex because we need to color only the border chars, not the content.
-func (br *BorderRenderer) applyBorderColorToSides(line string, _ value2.Border, style model.Style, hasLeft, hasRight bool) string {
-	borderColor, hasBorderColor := style.GetBorderColor()
-	if !hasBorderColor {
-		return line
-	}
+// applyBorderColorToSides applies the left and right border colors only to
+// the border characters, not the content.
+func (br *BorderRenderer) applyBorderColorToSides(line string, border value2.Border, style model.Style, hasLeft, hasRight bool) string {
+	leftColor, hasLeftColor := style.GetBorderLeftColor()
+	rightColor, hasRightColor := style.GetBorderRightColor()
+	hasLeftColor = hasLeftColor && hasLeft
+	hasRightColor = hasRightColor && hasRight
 
-	// If neither side has border, return as-is.
-	if !hasLeft && !hasRight {
+	// If no drawn side has a color, return as-is.
+	if !hasLeftColor && !hasRightColor {
 		return line
 	}
 
 	termCap := style.GetTerminalCapability()
-	ansiCode := br.colorToANSI(borderColor, termCap, true)
 	reset := br.ansiGenerator.Reset()
 
-	// Split line to color only border characters.
-	runes := []rune(line)
-	var result strings.Builder
-
-	// Left border (first character).
-	if hasLeft && len(runes) > 0 {
-		result.WriteString(ansiCode)
-		result.WriteRune(runes[0])
-		result.WriteString(reset)
-		runes = runes[1:]
+	// Split line into left border, content, and right border.
+	left, right := "", ""
+	if hasLeft {
+		left, line = line[:len(border.Left)], line[len(border.Left):]
+	}
+	if hasRight {
+		line, right = line[:len(line)-len(border.Right)], line[len(line)-len(border.Right):]
 	}
 
-	// Middle content (no color).
-	if hasRight && len(runes) > 0 {
-		// All but last character.
-		result.WriteString(string(runes[:len(runes)-1]))
-
-		// Right border (last character).
-		result.WriteString(ansiCode)
-		result.WriteRune(runes[len(runes)-1])
-		result.WriteString(reset)
-	} else {
-		// No right border, write remaining content.
-		result.WriteString(string(runes))
+	if hasLeftColor {
+		left = br.colorToANSI(leftColor, termCap, true) + left + reset
+	}
+	if hasRightColor {
+		right = br.colorToANSI(rightColor, termCap, true) + right + reset
 	}
 
-	return result.String()
+	return left + line + right
 }
 
 // colorToANSI converts a Color to ANSI code based on terminal capability.
@@ -0,0 +1,174 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+// TestBuildTitledEdge tests embedding a title in a border edge
+func TestBuildTitledEdge(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		title string
+		align value2.HorizontalAlignment
+		want  string
+	}{
+		{"No title", 6, "", value2.AlignLeft, "──────"},
+		{"Left", 12, "Title", value2.AlignLeft, "─ Title ────"},
+		{"Right", 12, "Title", value2.AlignRight, "──── Title ─"},
+		{"Center even", 11, "Title", value2.AlignCenter, "── Title ──"},
+		{"Center odd", 12, "Title", value2.AlignCenter, "─── Title ──"},
+		{"Exact fit", 9, "Title", value2.AlignLeft, "─ Title ─"},
+		{"Truncated", 8, "Title", value2.AlignLeft, "─ Tit… ─"},
+		{"Wide runes truncated", 8, "中文字", value2.AlignLeft, "─ 中… ──"},
+		{"Too narrow", 4, "Title", value2.AlignLeft, "────"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildTitledEdge("─", tt.width, tt.title, tt.align)
+			if got != tt.want {
+				t.Errorf("buildTitledEdge(%d, %q) = %q, want %q", tt.width, tt.title, got, tt.want)
+			}
+			if w := ansi.Width(got); w != tt.width {
+				t.Errorf("width = %d, want %d", w, tt.width)
+			}
+		})
+	}
+}
+
+// TestRenderCommand_Execute_BorderTitle tests rendering a titled border
+func TestRenderCommand_Execute_BorderTitle(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().
+		Border(value2.RoundedBorder).
+		BorderTitle("Info").
+		BorderTitleAlign(value2.AlignCenter)
+
+	output, err := cmd.Execute(style, "Hello, World")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(output, "\n")
+	if lines[0] != "╭─── Info ───╮" {
+		t.Errorf("top border = %q, want %q", lines[0], "╭─── Info ───╮")
+	}
+	if lines[1] != "│Hello, World│" {
+		t.Errorf("content line = %q", lines[1])
+	}
+	if lines[2] != "╰────────────╯" {
+		t.Errorf("bottom border = %q", lines[2])
+	}
+}
+
+// TestRenderCommand_Execute_BorderTitle_NoTop tests that the title is
+// omitted without a top border
+func TestRenderCommand_Execute_BorderTitle_NoTop(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().
+		Border(value2.NormalBorder).
+		BorderTop(false).
+		BorderTitle("Info")
+
+	output, err := cmd.Execute(style, "Hi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "Info") {
+		t.Errorf("title rendered without top border: %q", output)
+	}
+}
+
+// TestRenderCommand_Execute_BorderForegroundPerSide tests per-side border colors
+func TestRenderCommand_Execute_BorderForegroundPerSide(t *testing.T) {
+	cmd := newRenderCommand()
+	red, green, blue, white := value2.RGB(255, 0, 0), value2.RGB(0, 255, 0), value2.RGB(0, 0, 255), value2.RGB(255, 255, 255)
+	style := model.NewStyle().
+		Border(value2.NormalBorder).
+		BorderForegroundPerSide(red, green, blue, white)
+
+	output, err := cmd.Execute(style, "Hi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"\x1b[38;2;255;0;0m┌──┐\x1b[0m",
+		"\x1b[38;2;255;255;255m│\x1b[0mHi\x1b[38;2;0;255;0m│\x1b[0m",
+		"\x1b[38;2;0;0;255m└──┘\x1b[0m",
+	}, "\n")
+	if output != want {
+		t.Errorf("expected %q, got %q", want, output)
+	}
+}
+
+// TestRenderCommand_Execute_BorderColorReplacesPerSide tests that BorderColor
+// overrides earlier per-side colors
+func TestRenderCommand_Execute_BorderColorReplacesPerSide(t *testing.T) {
+	cmd := newRenderCommand()
+	red := value2.RGB(255, 0, 0)
+	style := model.NewStyle().
+		Border(value2.NormalBorder).
+		BorderForegroundPerSide(red, red, red, red).
+		BorderColor(value2.RGB(0, 0, 255))
+
+	output, err := cmd.Execute(style, "Hi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "255;0;0") {
+		t.Errorf("per-side color should be replaced, got %q", output)
+	}
+}
+
+// TestRenderCommand_Execute_SelectiveSidesColored tests that only drawn
+// sides are colored
+func TestRenderCommand_Execute_SelectiveSidesColored(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().
+		Border(value2.NormalBorder).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(true).
+		BorderRight(false).
+		BorderColor(value2.RGB(255, 0, 0))
+
+	output, err := cmd.Execute(style, "Tab")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "\x1b[38;2;255;0;0m│\x1b[0mTab"
+	if output != want {
+		t.Errorf("expected %q, got %q", want, output)
+	}
+}
+
+// TestRenderCommand_Execute_SizeCountsDrawnSides tests that size
+// constraints only count the border sides actually drawn
+func TestRenderCommand_Execute_SizeCountsDrawnSides(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().
+		Border(value2.NormalBorder).
+		BorderTop(true).
+		BorderBottom(false).
+		BorderLeft(true).
+		BorderRight(false).
+		MaxWidth(4).
+		MaxHeight(2)
+
+	// "abc" + left border = 4 wide, 1 line + top border = 2 high.
+	if _, err := cmd.Execute(style, "abc"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := cmd.Execute(style.BorderRight(true), "abc"); err == nil {
+		t.Error("expected max width error with both sides drawn")
+	}
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core"
+	"github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+// applyGradient colors each grapheme cluster of content with the gradient
+// color at its column, spread across the width of the widest line, so all
+// lines of a block share the same colors per column. Colors are adapted to
+// the terminal capability; consecutive clusters with the same code share one
+// escape sequence. Returns whether any code was written.
+func (rc *RenderCommand) applyGradient(content string, gradient value.Gradient, termCap value.TerminalCapability) (string, bool) {
+	lines := strings.Split(content, "\n")
+	span := float64(max(1, maxWidth(lines)-1))
+	colored := false
+
+	for i, line := range lines {
+		var b strings.Builder
+		col, last := 0, ""
+
+		for j := 0; j < len(line); {
+			if n := ansi.EscapeLen(line[j:]); n > 0 {
+				// The sequence may reset the color, so set it again after.
+				b.WriteString(line[j : j+n])
+				last = ""
+				j += n
+				continue
+			}
+
+			n := core.ClusterLen(line[j:])
+			cluster := line[j : j+n]
+			if cluster != " " {
+				code := rc.colorAdapter.ToANSIForeground(gradient.At(float64(col)/span), termCap)
+				if code != last {
+					b.WriteString(code)
+					last = code
+					colored = colored || code != ""
+				}
+			}
+			b.WriteString(cluster)
+			col += core.StringWidth(cluster)
+			j += n
+		}
+
+		lines[i] = b.String()
+	}
+
+	return strings.Join(lines, "\n"), colored
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style/internal/domain/model"
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+// TestRenderCommand_Execute_ForegroundGradient tests per-cluster gradient colors
+func TestRenderCommand_Execute_ForegroundGradient(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().ForegroundGradient(value2.RGB(0, 0, 0), value2.RGB(200, 0, 0))
+
+	output, err := cmd.Execute(style, "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "\x1b[38;2;0;0;0ma\x1b[38;2;100;0;0mb\x1b[38;2;200;0;0mc\x1b[0m"
+	if output != want {
+		t.Errorf("expected %q, got %q", want, output)
+	}
+}
+
+// TestRenderCommand_Execute_ForegroundGradient_WideClusters tests that
+// emoji and CJK get a single color at their starting column
+func TestRenderCommand_Execute_ForegroundGradient_WideClusters(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().ForegroundGradient(value2.RGB(0, 0, 0), value2.RGB(200, 0, 0))
+
+	output, err := cmd.Execute(style, "中👨‍👩‍👧x")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Columns 0, 2 and 4 of a 5 cell wide line.
+	want := "\x1b[38;2;0;0;0m中\x1b[38;2;100;0;0m👨‍👩‍👧\x1b[38;2;200;0;0mx\x1b[0m"
+	if output != want {
+		t.Errorf("expected %q, got %q", want, output)
+	}
+}
+
+// TestRenderCommand_Execute_ForegroundGradient_MultiLine tests that lines
+// share colors per column
+func TestRenderCommand_Execute_ForegroundGradient_MultiLine(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().ForegroundGradient(value2.RGB(0, 0, 0), value2.RGB(200, 0, 0))
+
+	output, err := cmd.Execute(style, "abc\nx")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(output, "\n")
+	if !strings.HasPrefix(lines[1], "\x1b[38;2;0;0;0mx") {
+		t.Errorf("second line should start with the first column color, got %q", lines[1])
+	}
+	if ansi.Strip(output) != "abc\nx" {
+		t.Errorf("visible text changed: %q", ansi.Strip(output))
+	}
+}
+
+// TestRenderCommand_Execute_ForegroundGradient_Capabilities tests color adaptation
+func TestRenderCommand_Execute_ForegroundGradient_Capabilities(t *testing.T) {
+	cmd := newRenderCommand()
+	gradient := model.NewStyle().ForegroundGradient(value2.RGB(255, 0, 0), value2.RGB(250, 0, 0))
+
+	tests := []struct {
+		name string
+		cap  value2.TerminalCapability
+		want string
+	}{
+		// Nearby colors collapse into one palette color and one escape code.
+		{"ANSI256", value2.ANSI256, "\x1b[38;5;196mabc\x1b[0m"},
+		{"ANSI16", value2.ANSI16, "\x1b[91mabc\x1b[0m"},
+		{"NoColor", value2.NoColor, "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := cmd.Execute(gradient.TerminalCapability(tt.cap), "abc")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, output)
+			}
+		})
+	}
+}
+
+// TestRenderCommand_Execute_ForegroundGradient_Background tests combining
+// a gradient with a background color
+func TestRenderCommand_Execute_ForegroundGradient_Background(t *testing.T) {
+	cmd := newRenderCommand()
+	style := model.NewStyle().
+		ForegroundGradient(value2.RGB(0, 0, 0), value2.RGB(0, 0, 0)).
+		Background(value2.RGB(0, 0, 255))
+
+	output, err := cmd.Execute(style, "a b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "\x1b[48;2;0;0;255m\x1b[38;2;0;0;0ma b\x1b[0m"
+	if output != want {
+		t.Errorf("expected %q, got %q", want, output)
+	}
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"strings"
+
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+// JoinCommand combines multi-line blocks of (possibly styled) text.
+// Widths are measured in visible cells, ignoring ANSI escape sequences.
+type JoinCommand struct{}
+
+// NewJoinCommand creates a new JoinCommand.
+func NewJoinCommand() *JoinCommand {
+	return &JoinCommand{}
+}
+
+// Horizontal places blocks side by side. Each block is padded to its own
+// width, and blocks shorter than the tallest one are padded with blank lines
+// according to alignment (middle puts the extra line on top, like
+// AlignVertical).
+func (jc *JoinCommand) Horizontal(alignment value2.VerticalAlignment, blocks ...string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	split := make([][]string, len(blocks))
+	widths := make([]int, len(blocks))
+	height := 0
+	for i, block := range blocks {
+		split[i] = strings.Split(block, "\n")
+		widths[i] = maxWidth(split[i])
+		height = max(height, len(split[i]))
+	}
+
+	rows := make([]strings.Builder, height)
+	for i, lines := range split {
+		offset := 0
+		switch alignment {
+		case value2.AlignMiddle:
+			offset = (height - len(lines) + 1) / 2
+		case value2.AlignBottom:
+			offset = height - len(lines)
+		}
+
+		for row := range rows {
+			line := ""
+			if j := row - offset; j >= 0 && j < len(lines) {
+				line = lines[j]
+			}
+			rows[row].WriteString(line)
+			rows[row].WriteString(strings.Repeat(" ", widths[i]-ansi.Width(line)))
+		}
+	}
+
+	result := make([]string, height)
+	for i := range rows {
+		result[i] = rows[i].String()
+	}
+	return strings.Join(result, "\n")
+}
+
+// Vertical stacks blocks on top of each other. Every line is padded to the
+// width of the widest line according to alignment (center puts the extra
+// space on the left, like AlignHorizontal).
+func (jc *JoinCommand) Vertical(alignment value2.HorizontalAlignment, blocks ...string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	var lines []string
+	for _, block := range blocks {
+		lines = append(lines, strings.Split(block, "\n")...)
+	}
+	width := maxWidth(lines)
+
+	for i, line := range lines {
+		gap := width - ansi.Width(line)
+		switch alignment {
+		case value2.AlignCenter:
+			left := (gap + 1) / 2
+			lines[i] = strings.Repeat(" ", left) + line + strings.Repeat(" ", gap-left)
+		case value2.AlignRight:
+			lines[i] = strings.Repeat(" ", gap) + line
+		default:
+			lines[i] = line + strings.Repeat(" ", gap)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// maxWidth returns the visible width of the widest line.
+func maxWidth(lines []string) int {
+	width := 0
+	for _, line := range lines {
+		width = max(width, ansi.Width(line))
+	}
+	return width
+}
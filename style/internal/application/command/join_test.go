@@ -0,0 +1,76 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+func TestJoinCommand_Horizontal(t *testing.T) {
+	tests := []struct {
+		name      string
+		alignment value2.VerticalAlignment
+		blocks    []string
+		want      string
+	}{
+		{"No blocks", value2.AlignTop, nil, ""},
+		{"Single block", value2.AlignTop, []string{"ab\nc"}, "ab\nc "},
+		{"Top", value2.AlignTop, []string{"a\nb\nc", "xy"}, "axy\nb  \nc  "},
+		{"Middle", value2.AlignMiddle, []string{"a\nb\nc", "xy"}, "a  \nbxy\nc  "},
+		{"Middle odd gap goes on top", value2.AlignMiddle, []string{"a\nb\nc\nd", "x"}, "a \nb \ncx\nd "},
+		{"Bottom", value2.AlignBottom, []string{"a\nb\nc", "xy"}, "a  \nb  \ncxy"},
+		{"Pads ragged block", value2.AlignTop, []string{"abc\nd", "|\n|"}, "abc|\nd  |"},
+		{"Wide runes", value2.AlignTop, []string{"中\nab\nx", "|\n|\n|"}, "中|\nab|\nx |"},
+	}
+
+	jc := NewJoinCommand()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jc.Horizontal(tt.alignment, tt.blocks...); got != tt.want {
+				t.Errorf("Horizontal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinCommand_Horizontal_IgnoresANSI(t *testing.T) {
+	left := "\x1b[31mab\x1b[0m\nc"
+	got := NewJoinCommand().Horizontal(value2.AlignTop, left, "|\n|")
+
+	lines := strings.Split(got, "\n")
+	for i, line := range lines {
+		if w := ansi.Width(line); w != 3 {
+			t.Errorf("line %d %q has width %d, want 3", i, line, w)
+		}
+	}
+	if lines[0] != "\x1b[31mab\x1b[0m|" {
+		t.Errorf("styled line = %q, want escape codes kept unpadded", lines[0])
+	}
+}
+
+func TestJoinCommand_Vertical(t *testing.T) {
+	tests := []struct {
+		name      string
+		alignment value2.HorizontalAlignment
+		blocks    []string
+		want      string
+	}{
+		{"No blocks", value2.AlignLeft, nil, ""},
+		{"Left", value2.AlignLeft, []string{"abcd", "ab"}, "abcd\nab  "},
+		{"Center", value2.AlignCenter, []string{"abcd", "ab"}, "abcd\n ab "},
+		{"Center odd gap goes left", value2.AlignCenter, []string{"abcd", "a"}, "abcd\n  a "},
+		{"Right", value2.AlignRight, []string{"abcd", "ab\nc"}, "abcd\n  ab\n   c"},
+		{"Styled", value2.AlignRight, []string{"abcd", "\x1b[1mab\x1b[0m"}, "abcd\n  \x1b[1mab\x1b[0m"},
+	}
+
+	jc := NewJoinCommand()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jc.Vertical(tt.alignment, tt.blocks...); got != tt.want {
+				t.Errorf("Vertical() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style/internal/domain/model"
 	service2 "github.com/phoenix-tui/phoenix/style/internal/domain/service"
 	"github.com/phoenix-tui/phoenix/style/internal/domain/value"
@@ -36,6 +35,7 @@ type RenderCommand struct {
 	textAligner       service2.TextAligner
 	ansiGenerator     *ansi.ANSICodeGenerator
 	borderRenderer    *BorderRenderer
+	darkBackground    bool // Resolves adaptive colors (default: dark)
 }
 
 // NewRenderCommand creates a new RenderCommand.
@@ -51,9 +51,17 @@ func NewRenderCommand(
 		textAligner:       textAligner,
 		ansiGenerator:     ansiGenerator,
 		borderRenderer:    NewBorderRenderer(ansiGenerator),
+		darkBackground:    true,
 	}
 }
 
+// WithDarkBackground sets whether adaptive colors are resolved for a dark
+// (true) or light (false) terminal background.
+func (rc *RenderCommand) WithDarkBackground(dark bool) *RenderCommand {
+	rc.darkBackground = dark
+	return rc
+}
+
 // Execute applies the style to content and returns ANSI-styled string.
 //
 //nolint:gocognit,gocyclo,cyclop // Complexity justified: comprehensive style application with multiple optional properties
@@ -78,13 +86,9 @@ func (rc *RenderCommand) Execute(style model.Style, content string) (string, err
 			contentHeight += padding.Top() + padding.Bottom()
 		}
 
-		// Add border dimensions.
-		if style.GetBorderLeft() || style.GetBorderRight() {
-			contentWidth += 2 // Left + right border
-		}
-		if style.GetBorderTop() || style.GetBorderBottom() {
-			contentHeight += 2 // Top + bottom border
-		}
+		// Add border dimensions (one cell per side actually drawn).
+		contentWidth += countTrue(style.GetBorderLeft(), style.GetBorderRight())
+		contentHeight += countTrue(style.GetBorderTop(), style.GetBorderBottom())
 
 		// Add margin dimensions.
 		if margin, hasMargin := style.GetMargin(); hasMargin {
@@ -142,6 +146,17 @@ func (rc *RenderCommand) Execute(style model.Style, content string) (string, err
 	return content, nil
 }
 
+// countTrue returns the number of true values.
+func countTrue(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
+
 // calculateContentDimensions calculates the width and height of content.
 func (rc *RenderCommand) calculateContentDimensions(content string) (int, int) {
 	lines := strings.Split(content, "\n")
@@ -149,7 +164,7 @@ func (rc *RenderCommand) calculateContentDimensions(content string) (int, int) {
 
 	maxWidth := 0
 	for _, line := range lines {
-		width := core.StringWidth(line)
+		width := ansi.Width(line)
 		if width > maxWidth {
 			maxWidth = width
 		}
@@ -197,9 +212,15 @@ func (rc *RenderCommand) applyColors(content string, style model.Style) string {
 	termCap := style.GetTerminalCapability()
 	var codes []string
 
+	// Foreground gradient (colored per column).
+	colored := false
+	if gradient, hasGradient := style.GetForegroundGradient(); hasGradient {
+		content, colored = rc.applyGradient(content, gradient, termCap)
+	}
+
 	// Foreground color.
 	if fg, hasFg := style.GetForeground(); hasFg {
-		ansiCode := rc.colorAdapter.ToANSIForeground(fg, termCap)
+		ansiCode := rc.colorAdapter.ToANSIForeground(fg.Resolve(rc.darkBackground), termCap)
 		if ansiCode != "" {
 			codes = append(codes, ansiCode)
 		}
@@ -207,7 +228,7 @@ func (rc *RenderCommand) applyColors(content string, style model.Style) string {
 
 	// Background color.
 	if bg, hasBg := style.GetBackground(); hasBg {
-		ansiCode := rc.colorAdapter.ToANSIBackground(bg, termCap)
+		ansiCode := rc.colorAdapter.ToANSIBackground(bg.Resolve(rc.darkBackground), termCap)
 		if ansiCode != "" {
 			codes = append(codes, ansiCode)
 		}
@@ -215,6 +236,9 @@ func (rc *RenderCommand) applyColors(content string, style model.Style) string {
 
 	// No colors to apply.
 	if len(codes) == 0 {
+		if colored {
+			return content + rc.ansiGenerator.Reset()
+		}
 		return content
 	}
 
@@ -897,3 +897,45 @@ func TestRenderCommand_Execute_ZeroDimensions(t *testing.T) {
 		})
 	}
 }
+
+// TestRenderCommand_Execute_AdaptiveColor tests resolving adaptive colors
+// against the terminal background
+func TestRenderCommand_Execute_AdaptiveColor(t *testing.T) {
+	adaptive := value2.AdaptiveColor{Light: value2.RGB(0, 0, 0), Dark: value2.RGB(255, 255, 255)}
+	style := model.NewStyle().Foreground(adaptive).Background(adaptive)
+
+	tests := []struct {
+		name string
+		dark bool
+		want string
+	}{
+		{"Dark", true, "\x1b[38;2;255;255;255m\x1b[48;2;255;255;255mText\x1b[0m"},
+		{"Light", false, "\x1b[38;2;0;0;0m\x1b[48;2;0;0;0mText\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := newRenderCommand().WithDarkBackground(tt.dark).Execute(style, "Text")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, output)
+			}
+		})
+	}
+}
+
+// TestRenderCommand_Execute_AdaptiveColor_DefaultDark tests that adaptive
+// colors resolve for a dark background by default
+func TestRenderCommand_Execute_AdaptiveColor_DefaultDark(t *testing.T) {
+	adaptive := value2.AdaptiveColor{Light: value2.RGB(0, 0, 0), Dark: value2.RGB(255, 0, 0)}
+
+	output, err := newRenderCommand().Execute(model.NewStyle().Foreground(adaptive), "x")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "\x1b[38;2;255;0;0mx\x1b[0m" {
+		t.Errorf("expected dark variant, got %q", output)
+	}
+}
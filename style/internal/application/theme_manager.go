@@ -12,9 +12,18 @@ import (
 //
 // ThemeManager is safe for concurrent use from multiple goroutines.
 // Theme changes are atomic and immediately visible to all readers.
+// Components that cache styles can Subscribe to be told when the theme changes.
 type ThemeManager struct {
-	mu      sync.RWMutex
-	current *model.Theme
+	mu          sync.RWMutex
+	current     *model.Theme
+	subscribers []themeSubscriber
+	nextID      int
+}
+
+// themeSubscriber is a registered theme change callback.
+type themeSubscriber struct {
+	id int
+	fn func(*model.Theme)
 }
 
 // NewThemeManager creates a new ThemeManager with the given initial theme.
@@ -46,10 +55,12 @@ func (tm *ThemeManager) SetTheme(theme *model.Theme) *model.Theme {
 	}
 
 	tm.mu.Lock()
-	defer tm.mu.Unlock()
-
 	previous := tm.current
 	tm.current = theme
+	subscribers := tm.subscribers
+	tm.mu.Unlock()
+
+	notify(subscribers, theme)
 	return previous
 }
 
@@ -70,10 +81,51 @@ func (tm *ThemeManager) SetPreset(name string) bool {
 // The given theme takes precedence for non-zero values.
 // This enables runtime theme customization without replacing the entire theme.
 func (tm *ThemeManager) MergeTheme(override *model.Theme) {
+	tm.mu.Lock()
+	tm.current = tm.current.Merge(override)
+	current := tm.current
+	subscribers := tm.subscribers
+	tm.mu.Unlock()
+
+	notify(subscribers, current)
+}
+
+// Subscribe registers fn to be called with the new theme whenever it changes
+// (SetTheme, SetPreset, MergeTheme, Reset). Callbacks run synchronously on
+// the goroutine that changed the theme, in registration order, after the
+// change is visible through Current. They must not block; a TEA component
+// would typically send a message to its program and restyle in Update.
+//
+// The returned function unsubscribes fn; calling it more than once is safe.
+func (tm *ThemeManager) Subscribe(fn func(*model.Theme)) (unsubscribe func()) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
-	tm.current = tm.current.Merge(override)
+	id := tm.nextID
+	tm.nextID++
+	tm.subscribers = append(tm.subscribers, themeSubscriber{id: id, fn: fn})
+
+	return func() {
+		tm.mu.Lock()
+		defer tm.mu.Unlock()
+
+		for i, sub := range tm.subscribers {
+			if sub.id == id {
+				// Copy so that a notify in progress keeps its own snapshot.
+				subscribers := make([]themeSubscriber, 0, len(tm.subscribers)-1)
+				subscribers = append(subscribers, tm.subscribers[:i]...)
+				tm.subscribers = append(subscribers, tm.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify calls every subscriber with theme.
+func notify(subscribers []themeSubscriber, theme *model.Theme) {
+	for _, sub := range subscribers {
+		sub.fn(theme)
+	}
 }
 
 // Reset resets the theme to the default theme.
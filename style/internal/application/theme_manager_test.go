@@ -311,3 +311,58 @@ func TestThemeManager_ConcurrentMerge(t *testing.T) {
 	assert.GreaterOrEqual(t, r, uint8(0))
 	assert.Less(t, r, uint8(50)) // Should be less than numGoroutines
 }
+
+func TestThemeManager_Subscribe(t *testing.T) {
+	tm := NewThemeManager(model.DefaultTheme())
+
+	var got []string
+	tm.Subscribe(func(theme *model.Theme) {
+		// The new theme is already current when subscribers run.
+		assert.Equal(t, theme, tm.Current())
+		got = append(got, theme.Name())
+	})
+
+	tm.SetTheme(model.DarkTheme())
+	tm.SetPreset("Light")
+	tm.SetPreset("missing")
+	tm.SetTheme(nil)
+	tm.MergeTheme(model.DarkTheme().WithName("Merged"))
+	tm.Reset()
+
+	assert.Equal(t, []string{"Dark", "Light", "Merged", "Default"}, got)
+}
+
+func TestThemeManager_Unsubscribe(t *testing.T) {
+	tm := NewThemeManager(nil)
+
+	var first, second int
+	unsubscribe := tm.Subscribe(func(*model.Theme) { first++ })
+	tm.Subscribe(func(*model.Theme) { second++ })
+
+	tm.SetTheme(model.DarkTheme())
+	unsubscribe()
+	unsubscribe() // Second call is a no-op
+	tm.SetTheme(model.LightTheme())
+
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+}
+
+func TestThemeManager_Subscribe_Concurrent(t *testing.T) {
+	tm := NewThemeManager(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unsubscribe := tm.Subscribe(func(*model.Theme) {})
+			unsubscribe()
+		}()
+		go func() {
+			defer wg.Done()
+			tm.SetTheme(model.DarkTheme())
+		}()
+	}
+	wg.Wait()
+}
@@ -11,7 +11,9 @@ import (
 // It follows DDD principles: data + behavior, immutability, and fluent API design.
 //
 // Style composes multiple value objects:.
-//   - Color (foreground, background, border color).
+//   - Color (foreground, background, border color); foreground and background
+//     may be adaptive (resolved against the terminal background when rendered).
+//   - Gradient (foreground gradient).
 //   - Border (border type and sides).
 //   - Padding (inner spacing).
 //   - Margin (outer spacing).
@@ -33,16 +35,20 @@ import (
 //	    BorderColor(value.RGB(128, 128, 128)).
 type Style struct {
 	// Color properties.
-	foreground *value2.Color
-	background *value2.Color
+	foreground         value2.TerminalColor
+	foregroundGradient *value2.Gradient
+	background         value2.TerminalColor
 
 	// Border properties.
-	border       *value2.Border
-	borderColor  *value2.Color
-	borderTop    bool
-	borderBottom bool
-	borderLeft   bool
-	borderRight  bool
+	border           *value2.Border
+	borderColor      *value2.Color
+	borderSideColors *[4]value2.Color // Top, right, bottom, left (overrides borderColor)
+	borderTop        bool
+	borderBottom     bool
+	borderLeft       bool
+	borderRight      bool
+	borderTitle      string
+	borderTitleAlign value2.HorizontalAlignment
 
 	// Spacing properties.
 	padding *value2.Padding
@@ -76,13 +82,17 @@ type Style struct {
 func NewStyle() Style {
 	return Style{
 		foreground:         nil,
+		foregroundGradient: nil,
 		background:         nil,
 		border:             nil,
 		borderColor:        nil,
+		borderSideColors:   nil,
 		borderTop:          false,
 		borderBottom:       false,
 		borderLeft:         false,
 		borderRight:        false,
+		borderTitle:        "",
+		borderTitleAlign:   value2.AlignLeft,
 		padding:            nil,
 		margin:             nil,
 		size:               nil,
@@ -97,17 +107,30 @@ func NewStyle() Style {
 
 // Color methods (fluent).
 
-// Foreground sets the foreground (text) color.
+// Foreground sets the foreground (text) color, replacing any foreground gradient.
+// c may be a Color or an AdaptiveColor.
 // Returns a new Style instance (immutability).
-func (s Style) Foreground(c value2.Color) Style {
-	s.foreground = &c
+func (s Style) Foreground(c value2.TerminalColor) Style {
+	s.foreground = c
+	s.foregroundGradient = nil
+	return s
+}
+
+// ForegroundGradient sets a foreground gradient from left to right across
+// the rendered block, replacing any foreground color.
+// Returns a new Style instance (immutability).
+func (s Style) ForegroundGradient(from, to value2.Color) Style {
+	g := value2.NewGradient(from, to)
+	s.foregroundGradient = &g
+	s.foreground = nil
 	return s
 }
 
 // Background sets the background color.
+// c may be a Color or an AdaptiveColor.
 // Returns a new Style instance (immutability).
-func (s Style) Background(c value2.Color) Style {
-	s.background = &c
+func (s Style) Background(c value2.TerminalColor) Style {
+	s.background = c
 	return s
 }
 
@@ -128,10 +151,20 @@ func (s Style) Border(b value2.Border) Style {
 	return s
 }
 
-// BorderColor sets the border color.
+// BorderColor sets the border color for all sides, replacing any per-side colors.
 // Returns a new Style instance (immutability).
 func (s Style) BorderColor(c value2.Color) Style {
 	s.borderColor = &c
+	s.borderSideColors = nil
+	return s
+}
+
+// BorderForegroundPerSide sets a different border color for each side,
+// in CSS order: top, right, bottom, left. Corners take the color of the
+// top or bottom side.
+// Returns a new Style instance (immutability).
+func (s Style) BorderForegroundPerSide(top, right, bottom, left value2.Color) Style {
+	s.borderSideColors = &[4]value2.Color{top, right, bottom, left}
 	return s
 }
 
@@ -163,6 +196,21 @@ func (s Style) BorderRight(enabled bool) Style {
 	return s
 }
 
+// BorderTitle sets a title shown inline in the top border (╭─ Title ─╮).
+// Titles wider than the box are truncated with "…". Empty removes the title.
+// Returns a new Style instance (immutability).
+func (s Style) BorderTitle(title string) Style {
+	s.borderTitle = title
+	return s
+}
+
+// BorderTitleAlign sets the position of the border title (default: left).
+// Returns a new Style instance (immutability).
+func (s Style) BorderTitleAlign(a value2.HorizontalAlignment) Style {
+	s.borderTitleAlign = a
+	return s
+}
+
 // Spacing methods (fluent).
 
 // Padding sets the padding (inner spacing).
@@ -478,21 +526,30 @@ func (s Style) TerminalCapability(tc value2.TerminalCapability) Style {
 // Getters (for rendering).
 
 // GetForeground returns the foreground color if set.
-// Returns (color, true) if set, (zero value, false) otherwise.
-func (s Style) GetForeground() (value2.Color, bool) {
+// Returns (color, true) if set, (nil, false) otherwise.
+func (s Style) GetForeground() (value2.TerminalColor, bool) {
 	if s.foreground == nil {
-		return value2.Color{}, false
+		return nil, false
+	}
+	return s.foreground, true
+}
+
+// GetForegroundGradient returns the foreground gradient if set.
+// Returns (gradient, true) if set, (zero value, false) otherwise.
+func (s Style) GetForegroundGradient() (value2.Gradient, bool) {
+	if s.foregroundGradient == nil {
+		return value2.Gradient{}, false
 	}
-	return *s.foreground, true
+	return *s.foregroundGradient, true
 }
 
 // GetBackground returns the background color if set.
-// Returns (color, true) if set, (zero value, false) otherwise.
-func (s Style) GetBackground() (value2.Color, bool) {
+// Returns (color, true) if set, (nil, false) otherwise.
+func (s Style) GetBackground() (value2.TerminalColor, bool) {
 	if s.background == nil {
-		return value2.Color{}, false
+		return nil, false
 	}
-	return *s.background, true
+	return s.background, true
 }
 
 // GetBorder returns the border if set.
@@ -513,6 +570,36 @@ func (s Style) GetBorderColor() (value2.Color, bool) {
 	return *s.borderColor, true
 }
 
+// GetBorderTopColor returns the color of the top border (and its corners):
+// the per-side color if set, otherwise the border color.
+func (s Style) GetBorderTopColor() (value2.Color, bool) {
+	return s.borderSideColor(0)
+}
+
+// GetBorderRightColor returns the color of the right border.
+func (s Style) GetBorderRightColor() (value2.Color, bool) {
+	return s.borderSideColor(1)
+}
+
+// GetBorderBottomColor returns the color of the bottom border (and its corners).
+func (s Style) GetBorderBottomColor() (value2.Color, bool) {
+	return s.borderSideColor(2)
+}
+
+// GetBorderLeftColor returns the color of the left border.
+func (s Style) GetBorderLeftColor() (value2.Color, bool) {
+	return s.borderSideColor(3)
+}
+
+// borderSideColor returns the color of side i (CSS order), falling back to
+// the border color.
+func (s Style) borderSideColor(i int) (value2.Color, bool) {
+	if s.borderSideColors != nil {
+		return s.borderSideColors[i], true
+	}
+	return s.GetBorderColor()
+}
+
 // GetBorderTop returns whether the top border is enabled.
 func (s Style) GetBorderTop() bool {
 	return s.borderTop && s.border != nil
@@ -533,6 +620,16 @@ func (s Style) GetBorderRight() bool {
 	return s.borderRight && s.border != nil
 }
 
+// GetBorderTitle returns the border title (empty if none).
+func (s Style) GetBorderTitle() string {
+	return s.borderTitle
+}
+
+// GetBorderTitleAlign returns the position of the border title.
+func (s Style) GetBorderTitleAlign() value2.HorizontalAlignment {
+	return s.borderTitleAlign
+}
+
 // GetPadding returns the padding if set.
 // Returns (padding, true) if set, (zero value, false) otherwise.
 func (s Style) GetPadding() (value2.Padding, bool) {
@@ -51,6 +51,23 @@ func TestStyle_Foreground(t *testing.T) {
 	assert.Equal(t, color, fg, "foreground color should match")
 }
 
+// TestStyle_ForegroundGradient tests setting a foreground gradient.
+func TestStyle_ForegroundGradient(t *testing.T) {
+	from, to := value2.RGB(255, 0, 0), value2.RGB(0, 0, 255)
+	s := NewStyle().Foreground(from).ForegroundGradient(from, to)
+
+	g, hasGradient := s.GetForegroundGradient()
+	assert.True(t, hasGradient, "gradient should be set")
+	assert.True(t, g.Equal(value2.NewGradient(from, to)), "gradient should match")
+
+	_, hasFg := s.GetForeground()
+	assert.False(t, hasFg, "gradient should replace foreground color")
+
+	s = s.Foreground(from)
+	_, hasGradient = s.GetForegroundGradient()
+	assert.False(t, hasGradient, "foreground color should replace gradient")
+}
+
 // TestStyle_Background tests setting background color.
 func TestStyle_Background(t *testing.T) {
 	color := value2.RGB(0, 0, 255)
@@ -360,7 +377,7 @@ func TestStyle_Immutability(t *testing.T) {
 	// Modified should have the color
 	fg, hasFg := modified.GetForeground()
 	assert.True(t, hasFg, "modified style should have foreground color")
-	r, g, b := fg.RGB()
+	r, g, b := fg.Resolve(true).RGB()
 	assert.Equal(t, uint8(255), r, "red should be 255")
 	assert.Equal(t, uint8(0), g, "green should be 0")
 	assert.Equal(t, uint8(0), b, "blue should be 0")
@@ -380,14 +397,14 @@ func TestStyle_FluentAPI(t *testing.T) {
 	// Verify all properties are set
 	fg, hasFg := s.GetForeground()
 	assert.True(t, hasFg, "foreground should be set")
-	r, g, b := fg.RGB()
+	r, g, b := fg.Resolve(true).RGB()
 	assert.Equal(t, uint8(255), r)
 	assert.Equal(t, uint8(255), g)
 	assert.Equal(t, uint8(255), b)
 
 	bg, hasBg := s.GetBackground()
 	assert.True(t, hasBg, "background should be set")
-	r, g, b = bg.RGB()
+	r, g, b = bg.Resolve(true).RGB()
 	assert.Equal(t, uint8(0), r)
 	assert.Equal(t, uint8(0), g)
 	assert.Equal(t, uint8(255), b)
@@ -448,3 +465,42 @@ func TestStyle_Validate(t *testing.T) {
 		})
 	}
 }
+
+// TestStyle_BorderTitle tests setting a border title and its alignment.
+func TestStyle_BorderTitle(t *testing.T) {
+	s := NewStyle()
+	assert.Equal(t, "", s.GetBorderTitle(), "default style should have no border title")
+	assert.Equal(t, value2.AlignLeft, s.GetBorderTitleAlign(), "default title alignment should be left")
+
+	s = s.BorderTitle("Info").BorderTitleAlign(value2.AlignRight)
+	assert.Equal(t, "Info", s.GetBorderTitle(), "border title should match")
+	assert.Equal(t, value2.AlignRight, s.GetBorderTitleAlign(), "title alignment should match")
+}
+
+// TestStyle_BorderForegroundPerSide tests per-side border colors and the
+// fallback to the border color.
+func TestStyle_BorderForegroundPerSide(t *testing.T) {
+	red, green := value2.RGB(255, 0, 0), value2.RGB(0, 255, 0)
+	blue, white := value2.RGB(0, 0, 255), value2.RGB(255, 255, 255)
+
+	_, hasTop := NewStyle().GetBorderTopColor()
+	assert.False(t, hasTop, "default style should have no border side colors")
+
+	s := NewStyle().BorderColor(white)
+	top, hasTop := s.GetBorderTopColor()
+	assert.True(t, hasTop, "side colors should fall back to the border color")
+	assert.Equal(t, white, top)
+
+	s = s.BorderForegroundPerSide(red, green, blue, white)
+	for name, get := range map[string]func() (value2.Color, bool){
+		"top": s.GetBorderTopColor, "right": s.GetBorderRightColor,
+		"bottom": s.GetBorderBottomColor, "left": s.GetBorderLeftColor,
+	} {
+		_, ok := get()
+		assert.True(t, ok, "%s color should be set", name)
+	}
+	c, _ := s.GetBorderRightColor()
+	assert.Equal(t, green, c, "right color should match")
+	c, _ = s.GetBorderBottomColor()
+	assert.Equal(t, blue, c, "bottom color should match")
+}
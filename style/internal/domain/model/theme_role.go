@@ -0,0 +1,147 @@
+package model
+
+// ThemeRole identifies the semantic purpose of a styled element, such as
+// primary text, an error message, or a border. Style.FromTheme maps a role
+// to the matching colors and borders of a Theme.
+type ThemeRole int
+
+const (
+	// RoleText is regular body text.
+	RoleText ThemeRole = iota
+
+	// RoleMuted is secondary, de-emphasized text.
+	RoleMuted
+
+	// RolePrimary is the main accent (focused items, active selections).
+	RolePrimary
+
+	// RoleSecondary is the secondary accent.
+	RoleSecondary
+
+	// RoleSurface is an elevated surface (cards, modals): text on the surface background.
+	RoleSurface
+
+	// RoleError is an error message.
+	RoleError
+
+	// RoleWarning is a warning message.
+	RoleWarning
+
+	// RoleSuccess is a success message.
+	RoleSuccess
+
+	// RoleInfo is an informational message.
+	RoleInfo
+
+	// RoleBorder is the default border of a container.
+	RoleBorder
+
+	// RoleFocus is the border of a focused container.
+	RoleFocus
+
+	// RoleDisabled is a disabled element.
+	RoleDisabled
+
+	// RolePlaceholder is placeholder text in an empty input.
+	RolePlaceholder
+
+	// RoleHeading is a heading or title.
+	RoleHeading
+
+	// RoleLink is a link.
+	RoleLink
+
+	// RoleCode is code or monospace text.
+	RoleCode
+)
+
+// String returns the role name.
+func (r ThemeRole) String() string {
+	switch r {
+	case RoleText:
+		return "Text"
+	case RoleMuted:
+		return "Muted"
+	case RolePrimary:
+		return "Primary"
+	case RoleSecondary:
+		return "Secondary"
+	case RoleSurface:
+		return "Surface"
+	case RoleError:
+		return "Error"
+	case RoleWarning:
+		return "Warning"
+	case RoleSuccess:
+		return "Success"
+	case RoleInfo:
+		return "Info"
+	case RoleBorder:
+		return "Border"
+	case RoleFocus:
+		return "Focus"
+	case RoleDisabled:
+		return "Disabled"
+	case RolePlaceholder:
+		return "Placeholder"
+	case RoleHeading:
+		return "Heading"
+	case RoleLink:
+		return "Link"
+	case RoleCode:
+		return "Code"
+	default:
+		return "Unknown"
+	}
+}
+
+// FromTheme returns a copy of the style configured for role from theme's
+// palette. Text roles set the foreground (headings are also bold, links
+// underlined), RoleSurface sets both foreground and background, and
+// RoleBorder and RoleFocus set the theme's default border and its color.
+// Other settings of s are kept, so FromTheme can be combined with padding,
+// width, etc. If theme is nil, DefaultTheme is used.
+func (s Style) FromTheme(theme *Theme, role ThemeRole) Style {
+	if theme == nil {
+		theme = DefaultTheme()
+	}
+	colors := theme.Colors()
+	typography := theme.Typography()
+
+	switch role {
+	case RoleText:
+		return s.Foreground(colors.Text)
+	case RoleMuted:
+		return s.Foreground(colors.TextMuted)
+	case RolePrimary:
+		return s.Foreground(colors.Primary)
+	case RoleSecondary:
+		return s.Foreground(colors.Secondary)
+	case RoleSurface:
+		return s.Foreground(colors.Text).Background(colors.Surface)
+	case RoleError:
+		return s.Foreground(colors.Error)
+	case RoleWarning:
+		return s.Foreground(colors.Warning)
+	case RoleSuccess:
+		return s.Foreground(colors.Success)
+	case RoleInfo:
+		return s.Foreground(colors.Info)
+	case RoleBorder:
+		return s.Border(theme.Borders().Default).BorderColor(colors.Border)
+	case RoleFocus:
+		return s.Border(theme.Borders().Default).BorderColor(colors.Focus)
+	case RoleDisabled:
+		return s.Foreground(colors.Disabled)
+	case RolePlaceholder:
+		return s.Foreground(typography.PlaceholderColor)
+	case RoleHeading:
+		return s.Foreground(typography.HeadingColor).Bold(true)
+	case RoleLink:
+		return s.Foreground(typography.LinkColor).Underline(true)
+	case RoleCode:
+		return s.Foreground(typography.CodeColor)
+	default:
+		return s
+	}
+}
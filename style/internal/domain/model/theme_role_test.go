@@ -0,0 +1,97 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_FromTheme_Foreground(t *testing.T) {
+	theme := DarkTheme()
+	colors := theme.Colors()
+
+	tests := []struct {
+		role ThemeRole
+		want value.Color
+	}{
+		{RoleText, colors.Text},
+		{RoleMuted, colors.TextMuted},
+		{RolePrimary, colors.Primary},
+		{RoleSecondary, colors.Secondary},
+		{RoleError, colors.Error},
+		{RoleWarning, colors.Warning},
+		{RoleSuccess, colors.Success},
+		{RoleInfo, colors.Info},
+		{RoleDisabled, colors.Disabled},
+		{RolePlaceholder, theme.Typography().PlaceholderColor},
+		{RoleCode, theme.Typography().CodeColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role.String(), func(t *testing.T) {
+			fg, ok := NewStyle().FromTheme(theme, tt.role).GetForeground()
+			require.True(t, ok)
+			assert.Equal(t, tt.want, fg)
+		})
+	}
+}
+
+func TestStyle_FromTheme_Surface(t *testing.T) {
+	theme := LightTheme()
+	s := NewStyle().FromTheme(theme, RoleSurface)
+
+	fg, _ := s.GetForeground()
+	bg, ok := s.GetBackground()
+	require.True(t, ok)
+	assert.Equal(t, theme.Colors().Text, fg)
+	assert.Equal(t, theme.Colors().Surface, bg)
+}
+
+func TestStyle_FromTheme_Border(t *testing.T) {
+	theme := HighContrastTheme()
+
+	for role, want := range map[ThemeRole]value.Color{
+		RoleBorder: theme.Colors().Border,
+		RoleFocus:  theme.Colors().Focus,
+	} {
+		s := NewStyle().FromTheme(theme, role)
+		border, ok := s.GetBorder()
+		require.True(t, ok, role.String())
+		assert.Equal(t, theme.Borders().Default, border)
+		assert.True(t, s.GetBorderTop() && s.GetBorderRight() && s.GetBorderBottom() && s.GetBorderLeft())
+
+		c, ok := s.GetBorderColor()
+		require.True(t, ok)
+		assert.Equal(t, want, c)
+	}
+}
+
+func TestStyle_FromTheme_TextDecoration(t *testing.T) {
+	theme := DefaultTheme()
+
+	heading := NewStyle().FromTheme(theme, RoleHeading)
+	assert.True(t, heading.GetBold())
+
+	link := NewStyle().FromTheme(theme, RoleLink)
+	assert.True(t, link.GetUnderline())
+	fg, _ := link.GetForeground()
+	assert.Equal(t, theme.Typography().LinkColor, fg)
+}
+
+func TestStyle_FromTheme_KeepsOtherSettings(t *testing.T) {
+	s := NewStyle().Padding(value.NewPadding(0, 1, 0, 1)).Italic(true).FromTheme(nil, RoleError)
+
+	padding, _ := s.GetPadding()
+	assert.Equal(t, 1, padding.Left())
+	assert.True(t, s.GetItalic())
+
+	fg, _ := s.GetForeground()
+	assert.Equal(t, DefaultTheme().Colors().Error, fg, "nil theme should use DefaultTheme")
+}
+
+func TestThemeRole_String(t *testing.T) {
+	assert.Equal(t, "Primary", RolePrimary.String())
+	assert.Equal(t, "Unknown", ThemeRole(-1).String())
+}
@@ -3,13 +3,14 @@ package service
 import (
 	"strings"
 
-	"github.com/phoenix-tui/phoenix/core"
 	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
 )
 
 // SpacingCalculator is a domain service that handles spacing calculations for content.
 // This includes padding and margin application, as well as total dimension calculations.
-// This is pure business logic with no infrastructure dependencies.
+// Widths are measured with ansi.Width, so already styled content (nested
+// Render output, OSC 8 hyperlinks) is measured by its visible cells.
 type SpacingCalculator interface {
 	// CalculateTotalWidth calculates total width including content, padding, and margin.
 	CalculateTotalWidth(contentWidth int, padding value2.Padding, margin value2.Margin) int
@@ -80,7 +81,7 @@ func (sc *DefaultSpacingCalculator) ApplyPadding(content string, padding value2.
 	// Use the first line's width if available.
 	emptyLineWidth := padding.Left() + padding.Right()
 	if len(paddedLines) > 0 {
-		emptyLineWidth = core.StringWidth(paddedLines[0])
+		emptyLineWidth = ansi.Width(paddedLines[0])
 	}
 	emptyLine := strings.Repeat(" ", emptyLineWidth)
 
@@ -128,7 +129,7 @@ func (sc *DefaultSpacingCalculator) ApplyMargin(content string, margin value2.Ma
 	// Calculate width of margined lines for empty margin lines.
 	emptyLineWidth := margin.Left() + margin.Right()
 	if len(marginedLines) > 0 {
-		emptyLineWidth = core.StringWidth(marginedLines[0])
+		emptyLineWidth = ansi.Width(marginedLines[0])
 	}
 	emptyLine := strings.Repeat(" ", emptyLineWidth)
 
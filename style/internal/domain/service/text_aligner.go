@@ -3,13 +3,14 @@ package service
 import (
 	"strings"
 
-	"github.com/phoenix-tui/phoenix/core"
 	"github.com/phoenix-tui/phoenix/style/internal/domain/value"
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
 )
 
 // TextAligner is a domain service that handles text alignment within given dimensions.
 // This service correctly handles Unicode width calculation for proper alignment.
-// This is pure business logic with no infrastructure dependencies.
+// Widths are measured with ansi.Width, so already styled content (nested
+// Render output, OSC 8 hyperlinks) is measured by its visible cells.
 type TextAligner interface {
 	// AlignHorizontal aligns text within given width.
 	// Returns the aligned text (single line).
@@ -37,7 +38,7 @@ func NewTextAligner() TextAligner {
 // Handles Unicode correctly by using visual width, not string length.
 func (ta *DefaultTextAligner) AlignHorizontal(text string, width int, alignment value.HorizontalAlignment) string {
 	// Calculate actual visual width of text.
-	textWidth := core.StringWidth(text)
+	textWidth := ansi.Width(text)
 
 	// If text is wider than target width, truncate (future: could add ellipsis).
 	if textWidth > width {
@@ -97,7 +98,7 @@ func (ta *DefaultTextAligner) AlignVertical(text string, height int, alignment v
 	// Determine width for empty lines (use max line width).
 	emptyLineWidth := 0
 	for _, line := range lines {
-		lineWidth := core.StringWidth(line)
+		lineWidth := ansi.Width(line)
 		if lineWidth > emptyLineWidth {
 			emptyLineWidth = lineWidth
 		}
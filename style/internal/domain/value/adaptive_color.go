@@ -0,0 +1,35 @@
+package value
+
+// TerminalColor is a color that may depend on the terminal background.
+// Color resolves to itself; AdaptiveColor picks a variant.
+type TerminalColor interface {
+	// Resolve returns the color to use on a dark or light background.
+	Resolve(darkBackground bool) Color
+}
+
+// AdaptiveColor is a pair of colors for light and dark terminal backgrounds.
+// This is a value object in DDD terms - immutable and defined by its values.
+type AdaptiveColor struct {
+	Light Color // Used on light backgrounds
+	Dark  Color // Used on dark backgrounds
+}
+
+// Resolve returns Dark on a dark background and Light otherwise.
+func (a AdaptiveColor) Resolve(darkBackground bool) Color {
+	if darkBackground {
+		return a.Dark
+	}
+	return a.Light
+}
+
+// Resolve returns the color itself: plain colors do not adapt.
+func (c Color) Resolve(_ bool) Color {
+	return c
+}
+
+// IsDark reports whether the color is dark, i.e. its relative luminance
+// (ITU-R BT.709) is below one half. Used to classify terminal backgrounds.
+func (c Color) IsDark() bool {
+	luminance := 0.2126*float64(c.r) + 0.7152*float64(c.g) + 0.0722*float64(c.b)
+	return luminance < 128
+}
@@ -0,0 +1,47 @@
+package value
+
+import "testing"
+
+func TestAdaptiveColor_Resolve(t *testing.T) {
+	light, dark := RGB(0, 0, 0), RGB(255, 255, 255)
+	a := AdaptiveColor{Light: light, Dark: dark}
+
+	if got := a.Resolve(true); !got.Equal(dark) {
+		t.Errorf("Resolve(true) = %v, want %v", got, dark)
+	}
+	if got := a.Resolve(false); !got.Equal(light) {
+		t.Errorf("Resolve(false) = %v, want %v", got, light)
+	}
+}
+
+func TestColor_Resolve(t *testing.T) {
+	c := RGB(10, 20, 30)
+	for _, dark := range []bool{true, false} {
+		if got := c.Resolve(dark); !got.Equal(c) {
+			t.Errorf("Resolve(%v) = %v, want %v", dark, got, c)
+		}
+	}
+}
+
+func TestColor_IsDark(t *testing.T) {
+	tests := []struct {
+		name  string
+		color Color
+		want  bool
+	}{
+		{"Black", RGB(0, 0, 0), true},
+		{"White", RGB(255, 255, 255), false},
+		{"Dark theme", RGB(40, 44, 52), true},
+		{"Solarized light", RGB(253, 246, 227), false},
+		{"Pure blue", RGB(0, 0, 255), true},
+		{"Pure green", RGB(0, 255, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.color.IsDark(); got != tt.want {
+				t.Errorf("IsDark() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
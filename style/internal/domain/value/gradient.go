@@ -0,0 +1,46 @@
+package value
+
+import "math"
+
+// Gradient represents an immutable linear color gradient between two colors.
+// This is a value object in DDD terms - immutable and defined by its values.
+type Gradient struct {
+	from, to Color
+}
+
+// NewGradient creates a new Gradient from one color to another.
+func NewGradient(from, to Color) Gradient {
+	return Gradient{from: from, to: to}
+}
+
+// From returns the start color of the gradient.
+func (g Gradient) From() Color {
+	return g.from
+}
+
+// To returns the end color of the gradient.
+func (g Gradient) To() Color {
+	return g.to
+}
+
+// At returns the color at position t, where 0 is the start color and 1 the
+// end color. Channels are interpolated linearly in RGB; t is clamped to
+// [0, 1].
+func (g Gradient) At(t float64) Color {
+	t = math.Max(0, math.Min(1, t))
+	return Color{
+		r: lerp(g.from.r, g.to.r, t),
+		g: lerp(g.from.g, g.to.g, t),
+		b: lerp(g.from.b, g.to.b, t),
+	}
+}
+
+// Equal returns true if this gradient equals another gradient.
+func (g Gradient) Equal(other Gradient) bool {
+	return g.from.Equal(other.from) && g.to.Equal(other.to)
+}
+
+// lerp interpolates between two channel values.
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+}
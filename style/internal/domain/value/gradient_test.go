@@ -0,0 +1,49 @@
+package value
+
+import "testing"
+
+func TestGradient_At(t *testing.T) {
+	g := NewGradient(RGB(0, 0, 0), RGB(255, 100, 10))
+
+	tests := []struct {
+		name string
+		t    float64
+		want Color
+	}{
+		{"Start", 0, RGB(0, 0, 0)},
+		{"End", 1, RGB(255, 100, 10)},
+		{"Middle", 0.5, RGB(128, 50, 5)},
+		{"Clamped below", -1, RGB(0, 0, 0)},
+		{"Clamped above", 2, RGB(255, 100, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.At(tt.t); !got.Equal(tt.want) {
+				t.Errorf("At(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGradient_Descending(t *testing.T) {
+	g := NewGradient(RGB(200, 0, 0), RGB(0, 0, 200))
+	if got := g.At(0.25); !got.Equal(RGB(150, 0, 50)) {
+		t.Errorf("At(0.25) = %v, want %v", got, RGB(150, 0, 50))
+	}
+}
+
+func TestGradient_Accessors(t *testing.T) {
+	from, to := RGB(1, 2, 3), RGB(4, 5, 6)
+	g := NewGradient(from, to)
+
+	if !g.From().Equal(from) || !g.To().Equal(to) {
+		t.Errorf("From()/To() = %v/%v, want %v/%v", g.From(), g.To(), from, to)
+	}
+	if !g.Equal(NewGradient(from, to)) {
+		t.Error("Equal() = false for identical gradients")
+	}
+	if g.Equal(NewGradient(to, from)) {
+		t.Error("Equal() = true for reversed gradient")
+	}
+}
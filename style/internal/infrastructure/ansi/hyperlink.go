@@ -0,0 +1,30 @@
+package ansi
+
+import "strings"
+
+// Hyperlink wraps text in OSC 8 hyperlink sequences pointing to url. The id
+// parameter, which groups links split over several lines, is omitted if id
+// is empty. text is returned unchanged if url is empty or url or id contain
+// control characters, which would end the sequence early.
+func Hyperlink(url, text, id string) string {
+	if url == "" || hasControl(url) || hasControl(id) {
+		return text
+	}
+
+	params := ""
+	if id != "" {
+		// ':' and ';' separate OSC 8 parameters and cannot appear in an id.
+		params = "id=" + strings.NewReplacer(":", "", ";", "").Replace(id)
+	}
+	return "\x1b]8;" + params + ";" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// hasControl reports whether s contains an ASCII control character.
+func hasControl(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] == 0x7f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package ansi
+
+import "testing"
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		name          string
+		url, text, id string
+		want          string
+	}{
+		{"Plain", "https://example.com", "site", "", "\x1b]8;;https://example.com\x1b\\site\x1b]8;;\x1b\\"},
+		{"With id", "https://example.com", "site", "a:b", "\x1b]8;id=ab;https://example.com\x1b\\site\x1b]8;;\x1b\\"},
+		{"Empty url", "", "site", "", "site"},
+		{"Control chars", "https://example.com\a", "site", "", "site"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Hyperlink(tt.url, tt.text, tt.id)
+			if got != tt.want {
+				t.Errorf("Hyperlink(%q, %q, %q) = %q, want %q", tt.url, tt.text, tt.id, got, tt.want)
+			}
+			if w := Width(got); w != Width(tt.text) {
+				t.Errorf("Width = %d, want %d (link sequences must not count)", w, Width(tt.text))
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package ansi
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+// Strip removes all ANSI escape sequences from s, leaving the visible text.
+func Strip(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for {
+		// Copy the plain text up to the next sequence in one go
+		esc := strings.IndexByte(s, '\x1b')
+		if esc < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		b.WriteString(s[:esc])
+		s = s[esc:]
+
+		n := EscapeLen(s)
+		if n == 0 {
+			b.WriteString(s) // A lone trailing ESC is kept as text
+			return b.String()
+		}
+		s = s[n:]
+	}
+}
+
+// Width returns the visible width of s in terminal cells, ignoring ANSI
+// escape sequences.
+func Width(s string) int {
+	return core.StringWidth(Strip(s))
+}
+
+// Truncate cuts s to at most width visible cells and appends tail, which
+// counts towards the width. Grapheme clusters are never split, so a wide
+// character that does not fit is dropped entirely. Escape sequences before
+// the cut are kept, and if an SGR style is still active at the cut, a reset
+// is appended after the tail so the style does not leak into what follows.
+// s is returned unchanged if it already fits.
+func Truncate(s string, width int, tail string) string {
+	if width <= 0 {
+		return ""
+	}
+	if Width(s) <= width {
+		return s
+	}
+
+	tailWidth := Width(tail)
+	if tailWidth > width {
+		return Truncate(tail, width, "")
+	}
+	budget := width - tailWidth
+
+	var b strings.Builder
+	used := 0
+	styled := false
+
+	for i := 0; i < len(s); {
+		if n := EscapeLen(s[i:]); n > 0 {
+			seq := s[i : i+n]
+			if isSGR(seq) {
+				styled = !isSGRReset(seq)
+			}
+			b.WriteString(seq)
+			i += n
+			continue
+		}
+
+		n := core.ClusterLen(s[i:])
+		w := core.StringWidth(s[i : i+n])
+		if used+w > budget {
+			break
+		}
+		b.WriteString(s[i : i+n])
+		used += w
+		i += n
+	}
+
+	b.WriteString(tail)
+	if styled {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// Cut returns the visible cells [from, to) of s. Escape sequences before
+// the cut are kept so that the styles (and links) active at from still
+// apply; sequences after to are dropped. A wide character straddling either
+// edge is replaced by spaces for the cells that fall inside, so the result
+// never contains half a glyph. If an SGR style is still active at the end,
+// a reset is appended. The result is shorter than to-from only if s is.
+func Cut(s string, from, to int) string {
+	if from < 0 {
+		from = 0
+	}
+	if to <= from {
+		return ""
+	}
+
+	var b strings.Builder
+	col := 0
+	styled := false
+
+	for i := 0; i < len(s); {
+		if n := EscapeLen(s[i:]); n > 0 {
+			seq := s[i : i+n]
+			if col < to {
+				if isSGR(seq) {
+					styled = !isSGRReset(seq)
+				}
+				b.WriteString(seq)
+			}
+			i += n
+			continue
+		}
+		if col >= to {
+			break
+		}
+
+		n := core.ClusterLen(s[i:])
+		w := core.StringWidth(s[i : i+n])
+		switch {
+		case col >= from && col+w <= to:
+			b.WriteString(s[i : i+n])
+		case col+w > from:
+			// Wide character straddling an edge: keep only its footprint.
+			b.WriteString(strings.Repeat(" ", min(col+w, to)-max(col, from)))
+		}
+		col += w
+		i += n
+	}
+
+	if styled {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// EscapeLen returns the length in bytes of the ANSI escape sequence at the
+// start of s, or 0 if s does not start with one.
+func EscapeLen(s string) int {
+	if len(s) < 2 || s[0] != '\x1b' {
+		return 0
+	}
+
+	switch s[1] {
+	case '[': // CSI: parameters, then a final byte in '@'..'~'
+		for i := 2; i < len(s); i++ {
+			if s[i] >= '@' && s[i] <= '~' {
+				return i + 1
+			}
+		}
+	case ']': // OSC: terminated by BEL or ST (ESC \)
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default: // Two-byte sequence
+		return 2
+	}
+
+	return len(s) // Unterminated: the rest is part of the sequence
+}
+
+// isSGR reports whether seq is a Select Graphic Rendition sequence (ESC[...m).
+func isSGR(seq string) bool {
+	return strings.HasPrefix(seq, "\x1b[") && strings.HasSuffix(seq, "m")
+}
+
+// isSGRReset reports whether the SGR sequence seq resets all attributes.
+func isSGRReset(seq string) bool {
+	params := seq[2 : len(seq)-1]
+	return params == "" || strings.Trim(params, "0") == ""
+}
@@ -0,0 +1,139 @@
+package ansi
+
+import "testing"
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"Plain", "hello", "hello"},
+		{"SGR", "\x1b[1;31mhello\x1b[0m", "hello"},
+		{"OSC hyperlink", "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"OSC with BEL", "\x1b]0;title\atext", "text"},
+		{"Unterminated", "text\x1b[31", "text"},
+		{"Cursor movement", "\x1b[2J\x1b[1;1Hab\x1b[3Dc\x1b[K", "abc"},
+		{"Two-byte", "\x1b7saved\x1b8", "saved"},
+		{"Unicode between sequences", "\x1b[1m日本\x1b[0m🔥", "日本🔥"},
+		{"Lone trailing ESC", "text\x1b", "text\x1b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Strip(tt.in); got != tt.want {
+				t.Errorf("Strip(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"Empty", "", 0},
+		{"ASCII", "hello", 5},
+		{"Styled", "\x1b[38;2;255;0;0mhello\x1b[0m", 5},
+		{"CJK", "\x1b[1m中文\x1b[0m", 4},
+		{"Emoji", "hi 🔥", 5},
+		{"ZWJ sequence", "👨‍👩‍👧", 2},
+		{"Combining", "é", 1},
+		{"Hyperlink", "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\", 4},
+		{"Trailing ESC", "ab\x1b", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Width(tt.in); got != tt.want {
+				t.Errorf("Width(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		tail  string
+		want  string
+	}{
+		{"Fits", "hello", 5, "…", "hello"},
+		{"Plain", "hello world", 6, "…", "hello…"},
+		{"No tail", "hello world", 5, "", "hello"},
+		{"Zero width", "hello", 0, "…", ""},
+		{"Tail wider than width", "hello world", 2, "...", ".."},
+		{"Styled closes open SGR", "\x1b[31mhello world\x1b[0m", 6, "…", "\x1b[31mhello…\x1b[0m"},
+		{"Reset before cut", "\x1b[31mred\x1b[0m plain text", 6, "", "\x1b[31mred\x1b[0m pl"},
+		{"Wide rune not split", "中文字", 3, "", "中"},
+		{"Wide rune with tail", "中文字", 5, "…", "中文…"},
+		{"ZWJ kept whole", "a👨‍👩‍👧b", 2, "", "a"},
+		{"Combining kept", "ééé", 2, "", "éé"},
+		{"Flag kept whole", "🇩🇪🇫🇷", 3, "", "🇩🇪"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.in, tt.width, tt.tail)
+			if got != tt.want {
+				t.Errorf("Truncate(%q, %d, %q) = %q, want %q", tt.in, tt.width, tt.tail, got, tt.want)
+			}
+			if tt.width > 0 && Width(got) > tt.width {
+				t.Errorf("Width(%q) = %d, exceeds %d", got, Width(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestCut(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		from, to int
+		want     string
+	}{
+		{"Plain middle", "hello world", 2, 7, "llo w"},
+		{"Past end", "hi", 1, 10, "i"},
+		{"Empty range", "hello", 3, 3, ""},
+		{"Negative from", "hello", -2, 2, "he"},
+		{"Style before cut kept", "\x1b[31mhello\x1b[0m", 1, 3, "\x1b[31mel\x1b[0m"},
+		{"Reset before cut", "\x1b[31mab\x1b[0mcd", 2, 4, "\x1b[31m\x1b[0mcd"},
+		{"Style after cut dropped", "ab\x1b[31mcd", 0, 2, "ab"},
+		{"Wide straddles start", "中文字", 1, 6, " 文字"},
+		{"Wide straddles end", "中文字", 0, 3, "中 "},
+		{"Wide inside one cell", "中", 1, 2, " "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cut(tt.in, tt.from, tt.to); got != tt.want {
+				t.Errorf("Cut(%q, %d, %d) = %q, want %q", tt.in, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeLen(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"abc", 0},
+		{"\x1b[0m", 4},
+		{"\x1b[38;5;240mx", 11},
+		{"\x1b]0;t\a", 6},
+		{"\x1b7", 2},
+		{"\x1b]8;;https://example.com\x1b\\link", 26},
+		{"\x1b[31", 4}, // Unterminated
+	}
+
+	for _, tt := range tests {
+		if got := EscapeLen(tt.in); got != tt.want {
+			t.Errorf("EscapeLen(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package ansi
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+// wrapToken is a word or a run of spaces in a line being wrapped.
+type wrapToken struct {
+	text  string
+	width int
+	space bool
+}
+
+// Wrap soft-wraps s at spaces so that no line is wider than width visible
+// cells. Existing newlines are kept as hard breaks, and the spaces at a wrap
+// point are dropped. Words wider than width are broken between grapheme
+// clusters if hardBreak is set, and left to overflow otherwise. Escape
+// sequences are kept in place and do not count towards the width.
+// s is returned unchanged if width <= 0.
+func Wrap(s string, width int, hardBreak bool) string {
+	if width <= 0 {
+		return s
+	}
+
+	paragraphs := strings.Split(s, "\n")
+	for i, p := range paragraphs {
+		if Width(p) > width {
+			paragraphs[i] = strings.Join(wrapParagraph(p, width, hardBreak), "\n")
+		}
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// wrapParagraph wraps a single line of text into lines of at most width cells.
+func wrapParagraph(s string, width int, hardBreak bool) []string {
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+	pending := wrapToken{space: true} // Spaces not yet written to line
+
+	flush := func() {
+		lines = append(lines, line.String())
+		line.Reset()
+		lineWidth = 0
+	}
+
+	for _, tok := range tokenize(s) {
+		if tok.space {
+			pending.text += tok.text
+			pending.width += tok.width
+			continue
+		}
+
+		if tok.width == 0 {
+			// Escape sequences only: keep them on the current line.
+			line.WriteString(tok.text)
+			continue
+		}
+
+		if lineWidth+pending.width+tok.width <= width {
+			line.WriteString(pending.text)
+			line.WriteString(tok.text)
+			lineWidth += pending.width + tok.width
+			pending = wrapToken{space: true}
+			continue
+		}
+
+		// The word does not fit: drop the spaces before it and start a new line.
+		pending = wrapToken{space: true}
+		if lineWidth > 0 {
+			flush()
+		}
+		if tok.width > width && hardBreak {
+			chunks := breakWord(tok.text, width)
+			for _, chunk := range chunks[:len(chunks)-1] {
+				lines = append(lines, chunk)
+			}
+			tok.text = chunks[len(chunks)-1]
+			tok.width = Width(tok.text)
+		}
+		line.WriteString(tok.text)
+		lineWidth += tok.width
+	}
+
+	if lineWidth+pending.width <= width {
+		line.WriteString(pending.text)
+	}
+	lines = append(lines, line.String())
+	return lines
+}
+
+// tokenize splits s into words and runs of spaces. Escape sequences always
+// belong to a word, so dropping spaces at a wrap point never drops styling.
+func tokenize(s string) []wrapToken {
+	var tokens []wrapToken
+	var cur wrapToken
+
+	for i := 0; i < len(s); {
+		if n := EscapeLen(s[i:]); n > 0 {
+			if cur.space {
+				tokens = append(tokens, cur)
+				cur = wrapToken{}
+			}
+			cur.text += s[i : i+n]
+			i += n
+			continue
+		}
+
+		n := core.ClusterLen(s[i:])
+		cluster := s[i : i+n]
+		space := cluster == " "
+		if cur.text != "" && cur.space != space {
+			tokens = append(tokens, cur)
+			cur = wrapToken{}
+		}
+		cur.text += cluster
+		cur.width += core.StringWidth(cluster)
+		cur.space = space
+		i += n
+	}
+
+	if cur.text != "" {
+		tokens = append(tokens, cur)
+	}
+	return tokens
+}
+
+// breakWord splits word into chunks of at most width cells without splitting
+// grapheme clusters. A single cluster wider than width gets a chunk of its own.
+func breakWord(word string, width int) []string {
+	var chunks []string
+	var chunk strings.Builder
+	chunkWidth := 0
+
+	for i := 0; i < len(word); {
+		if n := EscapeLen(word[i:]); n > 0 {
+			chunk.WriteString(word[i : i+n])
+			i += n
+			continue
+		}
+
+		n := core.ClusterLen(word[i:])
+		w := core.StringWidth(word[i : i+n])
+		if chunkWidth+w > width && chunkWidth > 0 {
+			chunks = append(chunks, chunk.String())
+			chunk.Reset()
+			chunkWidth = 0
+		}
+		chunk.WriteString(word[i : i+n])
+		chunkWidth += w
+		i += n
+	}
+
+	return append(chunks, chunk.String())
+}
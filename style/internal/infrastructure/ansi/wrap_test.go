@@ -0,0 +1,42 @@
+package ansi
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		width     int
+		hardBreak bool
+		want      string
+	}{
+		{"Fits", "hello world", 11, false, "hello world"},
+		{"Word boundary", "the quick brown fox", 10, false, "the quick\nbrown fox"},
+		{"Spaces at wrap point dropped", "aaa    bbb", 5, false, "aaa\nbbb"},
+		{"Inner spaces kept", "a  b  c", 5, false, "a  b\nc"},
+		{"Leading indent kept", "  indented text", 11, false, "  indented\ntext"},
+		{"Newlines are hard breaks", "one two\nthree", 4, false, "one\ntwo\nthree"},
+		{"Empty lines kept", "a\n\nb", 3, false, "a\n\nb"},
+		{"Long word overflows", "see supercalifragilistic", 10, false, "see\nsupercalifragilistic"},
+		{"Long word hard break", "see supercalifragilistic", 10, true, "see\nsupercalif\nragilistic"},
+		{"Wide chars", "中文 字符 测试", 5, false, "中文\n字符\n测试"},
+		{"Wide hard break", "中文字符测试", 5, true, "中文\n字符\n测试"},
+		{"Grapheme cluster kept", "e\u0301e\u0301e\u0301", 2, true, "e\u0301e\u0301\ne\u0301"},
+		{"Zero width", "hello world", 0, false, "hello world"},
+		{
+			"Escapes kept and not counted",
+			"\x1b[31mred\x1b[0m \x1b[32mgreen\x1b[0m",
+			5, false,
+			"\x1b[31mred\x1b[0m\n\x1b[32mgreen\x1b[0m",
+		},
+		{"Trailing reset stays on line", "foo \x1b[0m", 3, false, "foo\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.in, tt.width, tt.hardBreak); got != tt.want {
+				t.Errorf("Wrap(%q, %d, %v) = %q, want %q", tt.in, tt.width, tt.hardBreak, got, tt.want)
+			}
+		})
+	}
+}
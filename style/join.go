@@ -0,0 +1,31 @@
+package style
+
+import (
+	"github.com/phoenix-tui/phoenix/style/internal/application/command"
+	value2 "github.com/phoenix-tui/phoenix/style/internal/domain/value"
+)
+
+// JoinHorizontal places multi-line blocks side by side, for example panels
+// rendered with Render. Each block keeps its own width (the visible width of
+// its widest line, ignoring ANSI codes), and shorter blocks are padded with
+// blank lines: AlignTop, AlignMiddle or AlignBottom decides where.
+//
+// Example:
+//
+//	left := style.Render(box, "Files\nmain.go\ngo.mod")
+//	right := style.Render(box, "Preview")
+//	view := style.JoinHorizontal(style.AlignTop, left, " ", right)
+func JoinHorizontal(align VerticalAlignment, blocks ...string) string {
+	return command.NewJoinCommand().Horizontal(value2.VerticalAlignment(align), blocks...)
+}
+
+// JoinVertical stacks multi-line blocks on top of each other. Every line is
+// padded to the visible width of the widest line (ignoring ANSI codes), and
+// AlignLeft, AlignCenter or AlignRight decides on which side.
+//
+// Example:
+//
+//	view := style.JoinVertical(style.AlignCenter, header, body, footer)
+func JoinVertical(align HorizontalAlignment, blocks ...string) string {
+	return command.NewJoinCommand().Vertical(value2.HorizontalAlignment(align), blocks...)
+}
@@ -231,6 +231,28 @@ func New() Style {
 	return model.NewStyle()
 }
 
+// WithTerminalCapability returns s with its colors adapted to tc when
+// rendered, e.g. RGB colors become 256-color codes for ANSI256. Styles
+// default to TrueColor.
+//
+// Example:
+//
+//	s := style.WithTerminalCapability(style.New().Foreground(style.RGB(255, 0, 0)), style.ANSI256)
+func WithTerminalCapability(s Style, tc TerminalCapability) Style {
+	return s.TerminalCapability(value2.TerminalCapability(tc))
+}
+
+// WithBorderTitleAlign returns s with its border title (set with
+// Style.BorderTitle) placed at the left, center, or right of the top border.
+//
+// Example:
+//
+//	s := style.New().Border(style.RoundedBorder).BorderTitle("Files")
+//	s = style.WithBorderTitleAlign(s, style.AlignCenter) // ╭─── Files ───╮
+func WithBorderTitleAlign(s Style, align HorizontalAlignment) Style {
+	return s.BorderTitleAlign(value2.HorizontalAlignment(align))
+}
+
 // Render applies a Style to content and returns ANSI-styled output.
 // This is the main function for styling content.
 //
@@ -269,8 +291,8 @@ func Render(s Style, content string) string {
 		ansiGenerator,
 	)
 
-	// Execute rendering.
-	output, err := renderCmd.Execute(s, content)
+	// Execute rendering, resolving adaptive colors for the terminal background.
+	output, err := renderCmd.WithDarkBackground(BackgroundIsDark()).Execute(s, content)
 	if err != nil {
 		// For user-facing API, we return content as-is on error.
 		// In production, you might want to log the error.
@@ -216,6 +216,20 @@ func TestAPI_FluentChaining(t *testing.T) {
 	}
 }
 
+func TestAPI_WithTerminalCapability(t *testing.T) {
+	red := style.New().Foreground(style.RGB(255, 0, 0))
+
+	if got := style.Render(red, "x"); !strings.Contains(got, "38;2;255;0;0") {
+		t.Errorf("default should render TrueColor, got %q", got)
+	}
+	if got := style.Render(style.WithTerminalCapability(red, style.ANSI256), "x"); !strings.Contains(got, "38;5;") {
+		t.Errorf("ANSI256 should render a 256-color code, got %q", got)
+	}
+	if got := style.Render(style.WithTerminalCapability(red, style.NoColor), "x"); got != "x" {
+		t.Errorf("NoColor should render plain text, got %q", got)
+	}
+}
+
 func TestAPI_Render(t *testing.T) {
 	t.Run("PlainText", func(t *testing.T) {
 		output := style.Render(style.New(), "Hello")
@@ -429,3 +443,241 @@ func TestAPI_Documentation_Examples(t *testing.T) {
 		}
 	})
 }
+
+func TestAPI_Width(t *testing.T) {
+	s := style.Render(style.New().Bold(true).Foreground(style.RGB(255, 0, 0)), "Hello 🔥")
+	if got := style.Width(s); got != 8 {
+		t.Errorf("Width(%q) = %d, want 8", s, got)
+	}
+}
+
+func TestAPI_Truncate(t *testing.T) {
+	s := style.Render(style.New().Foreground(style.RGB(255, 0, 0)), "Hello, World!")
+
+	got := style.Truncate(s, 8, "…")
+	if style.Width(got) != 8 {
+		t.Errorf("Width(Truncate()) = %d, want 8", style.Width(got))
+	}
+	if !strings.HasPrefix(got, "\x1b[38;2;255;0;0mHello, …") {
+		t.Errorf("Truncate() = %q, want styled prefix kept", got)
+	}
+	if !strings.HasSuffix(got, "\x1b[0m") {
+		t.Errorf("Truncate() = %q, want style reset at the end", got)
+	}
+
+	if got := style.Truncate(s, 20, "…"); got != s {
+		t.Errorf("Truncate() of fitting string = %q, want unchanged", got)
+	}
+}
+
+func TestAPI_Cut(t *testing.T) {
+	s := style.Render(style.New().Bold(true), "Hello, World!")
+
+	got := style.Cut(s, 7, 12)
+	if style.Width(got) != 5 || !strings.Contains(got, "World") {
+		t.Errorf("Cut() = %q, want the 5 cells of \"World\"", got)
+	}
+	if !strings.HasPrefix(got, "\x1b[1m") || !strings.HasSuffix(got, "\x1b[0m") {
+		t.Errorf("Cut() = %q, want bold kept and reset at the end", got)
+	}
+
+	if got := style.Cut("中文", 1, 4); got != " 文" {
+		t.Errorf("Cut() across a wide character = %q, want %q", got, " 文")
+	}
+}
+
+func TestAPI_EscapeLen(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"\x1b[1mbold", 4},
+		{"\x1b]8;;https://example.com\x1b\\docs", 26},
+		{"plain", 0},
+	}
+	for _, tt := range tests {
+		if got := style.EscapeLen(tt.in); got != tt.want {
+			t.Errorf("EscapeLen(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAPI_Wrap(t *testing.T) {
+	if got := style.Wrap("The quick brown fox", 10); got != "The quick\nbrown fox" {
+		t.Errorf("Wrap() = %q", got)
+	}
+
+	url := "see https://example.com/very/long/path"
+	if got := style.Wrap(url, 12); got != "see\nhttps://example.com/very/long/path" {
+		t.Errorf("Wrap() without hard break = %q", got)
+	}
+	if got := style.Wrap(url, 12, style.WithHardBreak()); got != "see\nhttps://exam\nple.com/very\n/long/path" {
+		t.Errorf("Wrap() with hard break = %q", got)
+	}
+}
+
+func TestAPI_Hyperlink(t *testing.T) {
+	prev := style.HyperlinksEnabled()
+	t.Cleanup(func() { style.SetHyperlinks(prev) })
+
+	style.SetHyperlinks(true)
+	got := style.Hyperlink("https://example.com", "docs", style.WithHyperlinkID("d1"))
+	if want := "\x1b]8;id=d1;https://example.com\x1b\\docs\x1b]8;;\x1b\\"; got != want {
+		t.Errorf("Hyperlink() = %q, want %q", got, want)
+	}
+	if style.Width(got) != 4 {
+		t.Errorf("Width(Hyperlink()) = %d, want 4", style.Width(got))
+	}
+
+	style.SetHyperlinks(false)
+	if got := style.Hyperlink("https://example.com", "docs"); got != "docs" {
+		t.Errorf("Hyperlink() with hyperlinks disabled = %q, want %q", got, "docs")
+	}
+}
+
+func TestAPI_Render_StyledContentWidth(t *testing.T) {
+	bold := style.Render(style.New().Bold(true), "docs")
+	link := "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\"
+	box := style.New().Border(style.RoundedBorder).Padding(style.NewPadding(1, 2, 1, 2))
+
+	for _, content := range []string{bold, link} {
+		lines := strings.Split(style.Render(box, content+"\nabcdef"), "\n")
+		for i, line := range lines {
+			if style.Width(line) != 12 {
+				t.Errorf("line %d width = %d, want 12 (escape codes must not count): %q", i, style.Width(line), line)
+			}
+		}
+	}
+}
+
+func TestAPI_JoinHorizontal(t *testing.T) {
+	box := style.New().Border(style.RoundedBorder)
+	left := style.Render(box, "Files\nmain.go")
+	right := style.Render(box, "Preview")
+
+	got := style.JoinHorizontal(style.AlignTop, left, right)
+	lines := strings.Split(got, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("JoinHorizontal() has %d lines, want 4", len(lines))
+	}
+	want := style.Width(left[:strings.Index(left, "\n")]) + style.Width(right[:strings.Index(right, "\n")])
+	for i, line := range lines {
+		if style.Width(line) != want {
+			t.Errorf("line %d width = %d, want %d", i, style.Width(line), want)
+		}
+	}
+}
+
+func TestAPI_JoinVertical(t *testing.T) {
+	got := style.JoinVertical(style.AlignCenter, "Title", "a longer body")
+	if got != "    Title    \na longer body" {
+		t.Errorf("JoinVertical() = %q", got)
+	}
+}
+
+func TestAPI_Gradient(t *testing.T) {
+	got := style.Gradient("ab", style.RGB(255, 0, 0), style.RGB(0, 0, 255))
+	want := "\x1b[38;2;255;0;0ma\x1b[38;2;0;0;255mb\x1b[0m"
+	if got != want {
+		t.Errorf("Gradient() = %q, want %q", got, want)
+	}
+	if style.Width(got) != 2 {
+		t.Errorf("Width(Gradient()) = %d, want 2", style.Width(got))
+	}
+}
+
+func TestAPI_AdaptiveColor(t *testing.T) {
+	defer style.SetBackgroundIsDark(style.BackgroundIsDark())
+
+	s := style.New().Foreground(style.AdaptiveColor{
+		Light: style.RGB(0, 0, 0),
+		Dark:  style.RGB(255, 255, 255),
+	})
+
+	style.SetBackgroundIsDark(true)
+	if !style.BackgroundIsDark() {
+		t.Fatal("BackgroundIsDark() = false after SetBackgroundIsDark(true)")
+	}
+	if got := style.Render(s, "x"); got != "\x1b[38;2;255;255;255mx\x1b[0m" {
+		t.Errorf("dark background: Render() = %q", got)
+	}
+
+	style.SetBackgroundIsDark(false)
+	if got := style.Render(s, "x"); got != "\x1b[38;2;0;0;0mx\x1b[0m" {
+		t.Errorf("light background: Render() = %q", got)
+	}
+}
+
+func TestAPI_BorderTitle(t *testing.T) {
+	s := style.New().Border(style.RoundedBorder).BorderTitle("Files")
+	s = style.WithBorderTitleAlign(s, style.AlignRight)
+
+	got := style.Render(s, "main.go  go.mod")
+	top := strings.Split(got, "\n")[0]
+	if top != "╭─────── Files ─╮" {
+		t.Errorf("top border = %q", top)
+	}
+}
+
+func TestAPI_Strip(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"plain", "Hello", "Hello"},
+		{"styled", style.Render(style.New().Bold(true).Foreground(style.Red), "Error"), "Error"},
+		{"cursor movement", "\x1b[H\x1b[2Jtop\x1b[5;10Hmiddle", "topmiddle"},
+		{"hyperlink", style.Hyperlink("https://example.com", "docs"), "docs"},
+		{"window title", "\x1b]0;Phoenix\aready", "ready"},
+		{"multi-line", style.Render(style.New().Border(style.RoundedBorder).Foreground(style.Red), "Hi"), "╭──╮\n│Hi│\n╰──╯"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := style.Strip(tt.s); got != tt.want {
+				t.Errorf("Strip(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkStrip strips a full-screen frame of styled text (80×24, every
+// word colored).
+func BenchmarkStrip(b *testing.B) {
+	word := style.Render(style.New().Bold(true).Foreground(style.RGB(255, 128, 0)), "phoenix") + " "
+	line := strings.Repeat(word, 10)
+	frame := strings.Repeat(line+"\n", 24)
+
+	b.SetBytes(int64(len(frame)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = style.Strip(frame)
+	}
+}
+
+func TestAPI_Measure(t *testing.T) {
+	box := style.Render(style.New().Border(style.RoundedBorder).PaddingAll(1), "Hello")
+	if w, h := style.Measure(box); w != 9 || h != 5 {
+		t.Errorf("Measure(box) = %d×%d, want 9×5", w, h)
+	}
+
+	tests := []struct {
+		name          string
+		s             string
+		width, height int
+	}{
+		{"empty", "", 0, 0},
+		{"single line", "Hello", 5, 1},
+		{"widest line wins", "ab\nabcd\nabc", 4, 3},
+		{"styled", style.Render(style.New().Bold(true), "Hi") + "\n" + "🔥🔥🔥", 6, 2},
+		{"trailing newline", "abc\n", 3, 2},
+		{"blank lines", "\n\n", 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w, h := style.Measure(tt.s); w != tt.width || h != tt.height {
+				t.Errorf("Measure(%q) = %d×%d, want %d×%d", tt.s, w, h, tt.width, tt.height)
+			}
+		})
+	}
+}
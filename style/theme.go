@@ -41,6 +41,33 @@ type Typography = model.Typography
 // Thread safety: ThemeManager is safe for concurrent use.
 type ThemeManager = application.ThemeManager
 
+// ThemeRole identifies the semantic purpose of a styled element.
+// Pass it to Style.FromTheme to style the element from a theme's palette:
+//
+//	errStyle := style.New().FromTheme(theme, style.RoleError)
+//	panel := style.New().Padding(style.NewPadding(0, 1, 0, 1)).FromTheme(theme, style.RoleBorder)
+type ThemeRole = model.ThemeRole
+
+// Theme roles.
+const (
+	RoleText        = model.RoleText
+	RoleMuted       = model.RoleMuted
+	RolePrimary     = model.RolePrimary
+	RoleSecondary   = model.RoleSecondary
+	RoleSurface     = model.RoleSurface
+	RoleError       = model.RoleError
+	RoleWarning     = model.RoleWarning
+	RoleSuccess     = model.RoleSuccess
+	RoleInfo        = model.RoleInfo
+	RoleBorder      = model.RoleBorder
+	RoleFocus       = model.RoleFocus
+	RoleDisabled    = model.RoleDisabled
+	RolePlaceholder = model.RolePlaceholder
+	RoleHeading     = model.RoleHeading
+	RoleLink        = model.RoleLink
+	RoleCode        = model.RoleCode
+)
+
 // NewTheme creates a new custom Theme.
 // For common themes, use preset functions (DefaultTheme, DarkTheme, etc.).
 //
@@ -208,3 +208,23 @@ func TestNewTheme_PublicAPI(t *testing.T) {
 	assert.Equal(t, spacing, theme.Spacing())
 	assert.Equal(t, typography, theme.Typography())
 }
+
+func TestStyle_FromTheme_PublicAPI(t *testing.T) {
+	theme := DarkTheme()
+	s := New().FromTheme(theme, RoleError)
+
+	fg, ok := s.GetForeground()
+	assert.True(t, ok)
+	assert.Equal(t, theme.Colors().Error, fg)
+}
+
+func TestThemeManager_Subscribe_PublicAPI(t *testing.T) {
+	tm := NewThemeManager(nil)
+
+	var current *Theme
+	unsubscribe := tm.Subscribe(func(theme *Theme) { current = theme })
+	defer unsubscribe()
+
+	tm.SetTheme(LightTheme())
+	assert.Equal(t, "Light", current.Name())
+}
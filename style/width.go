@@ -0,0 +1,95 @@
+package style
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+)
+
+// Width returns the visible width of s in terminal cells. ANSI escape
+// sequences (colors, attributes, hyperlinks) are ignored, and Unicode is
+// measured by grapheme cluster, so emoji and CJK characters count as two
+// cells. Use it instead of len() when laying out styled text.
+//
+// Example:
+//
+//	s := style.Render(style.New().Bold(true), "Hello 🔥")
+//	len(s)          // 18 (bytes, including escape codes)
+//	style.Width(s)  // 8
+func Width(s string) int {
+	return ansi.Width(s)
+}
+
+// Strip removes all ANSI escape sequences from s (colors and other SGR
+// attributes, cursor movement, OSC hyperlinks and titles) and returns the
+// plain text, e.g. to write rendered output to a log file or copy it to the
+// clipboard. s is returned as-is if it contains no escape sequences.
+//
+// Example:
+//
+//	s := style.Render(style.New().Bold(true).Foreground(style.Red), "Error")
+//	style.Strip(s) // "Error"
+func Strip(s string) string {
+	return ansi.Strip(s)
+}
+
+// EscapeLen returns the length in bytes of the ANSI escape sequence at the
+// start of s (CSI, OSC terminated by BEL or ST, or a two-byte sequence), or
+// 0 if s does not start with one. An unterminated sequence runs to the end
+// of s. Use it to walk styled text without splitting its escape codes.
+//
+// Example:
+//
+//	style.EscapeLen("\x1b[1mbold") // 4
+//	style.EscapeLen("plain")       // 0
+func EscapeLen(s string) int {
+	return ansi.EscapeLen(s)
+}
+
+// Measure returns the size of a rendered block: the visible width of its
+// widest line (measured like Width) and its number of lines. An empty string
+// measures 0×0; a trailing newline starts an empty last line. Use it to
+// position rendered components instead of hardcoding their size.
+//
+// Example:
+//
+//	box := style.Render(style.New().Border(style.RoundedBorder).PaddingAll(1), "Hello")
+//	w, h := style.Measure(box) // 9, 5
+//	left, top := (termWidth-w)/2, (termHeight-h)/2
+func Measure(s string) (width, height int) {
+	if s == "" {
+		return 0, 0
+	}
+	for line := range strings.SplitSeq(s, "\n") {
+		width = max(width, Width(line))
+		height++
+	}
+	return width, height
+}
+
+// Truncate cuts s to at most w visible cells, ending with tail (for example
+// "…"), which counts towards w. Escape sequences are kept and never count
+// towards the width, grapheme clusters are never split, and a style still
+// open at the cut is reset after the tail so colors do not leak into the
+// following text. s is returned unchanged if it already fits.
+//
+// Example:
+//
+//	s := style.Render(style.New().Foreground(style.RGB(255, 0, 0)), "Hello, World!")
+//	style.Truncate(s, 8, "…") // "\x1b[38;2;255;0;0mHello, …\x1b[0m"
+func Truncate(s string, w int, tail string) string {
+	return ansi.Truncate(s, w, tail)
+}
+
+// Cut returns the visible cells [from, to) of s, the styled counterpart of
+// slicing a plain string by column. Escape sequences before from are kept so
+// the text keeps its colors, wide characters straddling either edge become
+// spaces, and a style still open at the end is reset.
+//
+// Example:
+//
+//	s := style.Render(style.New().Bold(true), "Hello, World!")
+//	style.Cut(s, 7, 12) // "\x1b[1mWorld\x1b[0m"
+func Cut(s string, from, to int) string {
+	return ansi.Cut(s, from, to)
+}
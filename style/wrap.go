@@ -0,0 +1,39 @@
+package style
+
+import "github.com/phoenix-tui/phoenix/style/internal/infrastructure/ansi"
+
+// WrapOption configures Wrap.
+type WrapOption func(*wrapOptions)
+
+// wrapOptions holds the settings applied by WrapOption.
+type wrapOptions struct {
+	hardBreak bool
+}
+
+// WithHardBreak makes Wrap break words wider than the wrap width between
+// grapheme clusters. Without it, such words are kept whole on a line of
+// their own and overflow the width.
+func WithHardBreak() WrapOption {
+	return func(o *wrapOptions) {
+		o.hardBreak = true
+	}
+}
+
+// Wrap soft-wraps s at word boundaries so that no line is wider than width
+// visible cells, as measured by Width. Existing newlines are kept as hard
+// breaks, and the spaces at a wrap point are dropped rather than left at
+// the end or start of a line. Escape sequences are kept in place, so styled
+// text can be wrapped. s is returned unchanged if width <= 0.
+//
+// Example:
+//
+//	style.Wrap("The quick brown fox", 10)                     // "The quick\nbrown fox"
+//	style.Wrap("see https://example.com/very/long/path", 12,
+//	    style.WithHardBreak())                                // "see\nhttps://exam\nple.com/very\n/long/path"
+func Wrap(s string, width int, opts ...WrapOption) string {
+	var o wrapOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return ansi.Wrap(s, width, o.hardBreak)
+}
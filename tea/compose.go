@@ -0,0 +1,178 @@
+package tea
+
+// UpdateSub forwards msg to a child model and returns the updated child and
+// its command.
+//
+// It removes the type juggling of composing models by hand:
+//
+//	func (m Parent) Update(msg tea.Msg) (Parent, tea.Cmd) {
+//		var listCmd, inputCmd tea.Cmd
+//		m.list, listCmd = tea.UpdateSub(m.list, msg)
+//		m.input, inputCmd = tea.UpdateSub(m.input, msg)
+//		return m, tea.Batch(listCmd, inputCmd)
+//	}
+func UpdateSub[C modelConstraint[C]](child C, msg Msg) (C, Cmd) {
+	return child.Update(msg)
+}
+
+// focusable is implemented by children that render differently when focused
+// (e.g. input.Input). FocusGroup keeps their focus state in sync.
+type focusable[C any] interface {
+	Focused(focused bool) C
+}
+
+// FocusGroup manages a set of child models of which exactly one is focused.
+//
+// Update routes input messages (KeyMsg, PasteMsg, MouseMsg) only to the
+// focused child and broadcasts every other message (WindowSizeMsg, ticks, async results)
+// to all children. Focus moves with Next, Prev and Focus; children that have
+// a Focused(bool) method (like input.Input) are told when they gain or lose
+// focus.
+//
+// FocusGroup is a value type: methods return a new group.
+//
+// Example - three-field form:
+//
+//	type formModel struct {
+//		fields tea.FocusGroup[input.Input]
+//	}
+//
+//	func newForm() formModel {
+//		return formModel{fields: tea.NewFocusGroup(
+//			input.New(30).Placeholder("Name"),
+//			input.New(30).Placeholder("Email"),
+//			input.New(60).Placeholder("Message"),
+//		)}
+//	}
+//
+//	func (m formModel) Update(msg tea.Msg) (formModel, tea.Cmd) {
+//		if key, ok := msg.(tea.KeyMsg); ok {
+//			switch key.String() {
+//			case "tab":
+//				m.fields = m.fields.Next()
+//				return m, nil
+//			case "shift+tab":
+//				m.fields = m.fields.Prev()
+//				return m, nil
+//			}
+//		}
+//		var cmd tea.Cmd
+//		m.fields, cmd = m.fields.Update(msg)
+//		return m, cmd
+//	}
+type FocusGroup[C modelConstraint[C]] struct {
+	children []C
+	focused  int
+}
+
+// NewFocusGroup creates a focus group with the first child focused.
+func NewFocusGroup[C modelConstraint[C]](children ...C) FocusGroup[C] {
+	g := FocusGroup[C]{children: append([]C(nil), children...)}
+	for i := range g.children {
+		g.children[i] = setFocused(g.children[i], i == 0)
+	}
+	return g
+}
+
+// Len returns the number of children.
+func (g FocusGroup[C]) Len() int {
+	return len(g.children)
+}
+
+// Focused returns the index of the focused child (0 for an empty group).
+func (g FocusGroup[C]) Focused() int {
+	return g.focused
+}
+
+// Child returns the child at index i.
+// Panics if i is out of range, like a slice index.
+func (g FocusGroup[C]) Child(i int) C {
+	return g.children[i]
+}
+
+// FocusedChild returns the focused child.
+// Returns the zero value of C for an empty group.
+func (g FocusGroup[C]) FocusedChild() C {
+	if len(g.children) == 0 {
+		var zero C
+		return zero
+	}
+	return g.children[g.focused]
+}
+
+// SetChild returns a group with the child at index i replaced.
+// Out-of-range indices are ignored. The focus state of the new child is
+// synced with the group.
+func (g FocusGroup[C]) SetChild(i int, child C) FocusGroup[C] {
+	if i < 0 || i >= len(g.children) {
+		return g
+	}
+	g.children = append([]C(nil), g.children...)
+	g.children[i] = setFocused(child, i == g.focused)
+	return g
+}
+
+// Focus returns a group with the child at index i focused.
+// Out-of-range indices are ignored.
+func (g FocusGroup[C]) Focus(i int) FocusGroup[C] {
+	if i < 0 || i >= len(g.children) || i == g.focused {
+		return g
+	}
+	g.children = append([]C(nil), g.children...)
+	g.children[g.focused] = setFocused(g.children[g.focused], false)
+	g.children[i] = setFocused(g.children[i], true)
+	g.focused = i
+	return g
+}
+
+// Next returns a group with focus moved to the next child (wraps around).
+func (g FocusGroup[C]) Next() FocusGroup[C] {
+	if len(g.children) == 0 {
+		return g
+	}
+	return g.Focus((g.focused + 1) % len(g.children))
+}
+
+// Prev returns a group with focus moved to the previous child (wraps around).
+func (g FocusGroup[C]) Prev() FocusGroup[C] {
+	if len(g.children) == 0 {
+		return g
+	}
+	return g.Focus((g.focused - 1 + len(g.children)) % len(g.children))
+}
+
+// Update routes msg to the children.
+//
+// KeyMsg, PasteMsg and MouseMsg go to the focused child only, so a click or
+// wheel event doesn't act on every child at once; all other messages are
+// broadcast to every child and their commands are batched.
+func (g FocusGroup[C]) Update(msg Msg) (FocusGroup[C], Cmd) {
+	if len(g.children) == 0 {
+		return g, nil
+	}
+
+	g.children = append([]C(nil), g.children...)
+
+	switch msg.(type) {
+	case KeyMsg, PasteMsg, MouseMsg:
+		var cmd Cmd
+		g.children[g.focused], cmd = g.children[g.focused].Update(msg)
+		return g, cmd
+	}
+
+	cmds := make([]Cmd, 0, len(g.children))
+	for i := range g.children {
+		var cmd Cmd
+		g.children[i], cmd = g.children[i].Update(msg)
+		cmds = append(cmds, cmd)
+	}
+	return g, Batch(cmds...)
+}
+
+// setFocused tells child whether it is focused, if it supports that.
+func setFocused[C any](child C, focused bool) C {
+	if f, ok := any(child).(focusable[C]); ok {
+		return f.Focused(focused)
+	}
+	return child
+}
@@ -0,0 +1,142 @@
+package tea_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+// childModel records what it received and whether it is focused.
+type childModel struct {
+	keys    int
+	sizes   int
+	others  int
+	focused bool
+}
+
+func (c childModel) Init() tea.Cmd { return nil }
+
+func (c childModel) Update(msg tea.Msg) (childModel, tea.Cmd) {
+	switch msg.(type) {
+	case tea.KeyMsg:
+		c.keys++
+	case tea.WindowSizeMsg:
+		c.sizes++
+		return c, func() tea.Msg { return "resized" }
+	default:
+		c.others++
+	}
+	return c, nil
+}
+
+func (c childModel) View() string { return "" }
+
+func (c childModel) Focused(focused bool) childModel {
+	c.focused = focused
+	return c
+}
+
+func TestUpdateSub(t *testing.T) {
+	child, cmd := tea.UpdateSub(childModel{}, tea.KeyMsg{Type: tea.KeyEnter})
+	if child.keys != 1 {
+		t.Errorf("keys = %d, want 1", child.keys)
+	}
+	if cmd != nil {
+		t.Error("expected nil cmd")
+	}
+}
+
+func TestFocusGroup_RoutesKeysToFocused(t *testing.T) {
+	g := tea.NewFocusGroup(childModel{}, childModel{}, childModel{})
+	g = g.Next()
+
+	g, _ = g.Update(tea.KeyMsg{Type: tea.KeyRune, Rune: 'a'})
+
+	for i, want := range []int{0, 1, 0} {
+		if got := g.Child(i).keys; got != want {
+			t.Errorf("child %d keys = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestFocusGroup_RoutesMouseToFocused(t *testing.T) {
+	g := tea.NewFocusGroup(childModel{}, childModel{})
+	g = g.Next()
+
+	g, _ = g.Update(tea.MouseMsg{X: 3, Y: 1, Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress})
+
+	for i, want := range []int{0, 1} {
+		if got := g.Child(i).others; got != want {
+			t.Errorf("child %d received %d mouse messages, want %d", i, got, want)
+		}
+	}
+}
+
+func TestFocusGroup_BroadcastsOtherMessages(t *testing.T) {
+	g := tea.NewFocusGroup(childModel{}, childModel{})
+
+	g, cmd := g.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	if cmd == nil {
+		t.Fatal("expected batched cmd from children")
+	}
+	g, _ = g.Update("custom")
+
+	for i := 0; i < g.Len(); i++ {
+		if g.Child(i).sizes != 1 || g.Child(i).others != 1 {
+			t.Errorf("child %d = %+v, want one size and one other message", i, g.Child(i))
+		}
+	}
+}
+
+func TestFocusGroup_FocusNavigation(t *testing.T) {
+	g := tea.NewFocusGroup(childModel{}, childModel{}, childModel{})
+	if g.Focused() != 0 || !g.Child(0).focused {
+		t.Fatal("first child should be focused initially")
+	}
+
+	g = g.Prev()
+	if g.Focused() != 2 {
+		t.Errorf("Prev from 0 = %d, want 2 (wrap)", g.Focused())
+	}
+	g = g.Next()
+	if g.Focused() != 0 {
+		t.Errorf("Next from 2 = %d, want 0 (wrap)", g.Focused())
+	}
+
+	g = g.Focus(1)
+	for i, want := range []bool{false, true, false} {
+		if g.Child(i).focused != want {
+			t.Errorf("child %d focused = %v, want %v", i, g.Child(i).focused, want)
+		}
+	}
+	if !g.FocusedChild().focused {
+		t.Error("FocusedChild should report focus")
+	}
+
+	if g.Focus(10).Focused() != 1 {
+		t.Error("out-of-range Focus should be ignored")
+	}
+}
+
+func TestFocusGroup_Immutable(t *testing.T) {
+	g := tea.NewFocusGroup(childModel{}, childModel{})
+	g2 := g.SetChild(1, childModel{keys: 5})
+	_ = g.Next()
+
+	if g.Child(1).keys != 0 || g.Focused() != 0 {
+		t.Error("original group should be unchanged")
+	}
+	if g2.Child(1).keys != 5 || g2.Child(1).focused {
+		t.Error("SetChild should replace the child and sync focus")
+	}
+}
+
+func TestFocusGroup_Empty(t *testing.T) {
+	var g tea.FocusGroup[childModel]
+	g = g.Next().Prev()
+	g, cmd := g.Update(tea.KeyMsg{})
+	if cmd != nil || g.Len() != 0 {
+		t.Error("empty group should be a no-op")
+	}
+	_ = g.FocusedChild()
+}
@@ -1,7 +1,7 @@
 // Package main demonstrates a countdown timer application using phoenix/tea.
 //
 // This example shows:
-//   - Asynchronous commands (Tick)
+//   - Clock-aligned repeating commands (EveryWithID / CancelEvery)
 //   - Time-based updates
 //   - State machine (stopped/running/paused)
 //   - Command chaining
@@ -31,6 +31,19 @@ const (
 	StatePaused
 )
 
+// timerID identifies the countdown ticker so it can be stopped on pause.
+const timerID tea.TickerID = "countdown"
+
+// countdownTickMsg is delivered once per second while the timer runs.
+type countdownTickMsg struct{}
+
+// startTicker starts the self-sustaining countdown ticker.
+func startTicker() tea.Cmd {
+	return tea.EveryWithID(timerID, time.Second, func(time.Time) tea.Msg {
+		return countdownTickMsg{}
+	})
+}
+
 // TimerModel represents the application state.
 type TimerModel struct {
 	state     TimerState
@@ -57,13 +70,13 @@ func (m TimerModel) Update(msg tea.Msg) (TimerModel, tea.Cmd) {
 			// Start/Pause toggle
 			if m.state == StateRunning {
 				m.state = StatePaused
-				return m, nil
+				return m, tea.CancelEvery(timerID)
 			}
 
 			if m.remaining > 0 {
 				m.state = StateRunning
-				// Start ticking
-				return m, tea.Tick(1 * time.Second)
+				// Start ticking (keeps firing until cancelled)
+				return m, startTicker()
 			}
 			return m, nil
 
@@ -71,7 +84,7 @@ func (m TimerModel) Update(msg tea.Msg) (TimerModel, tea.Cmd) {
 			// Reset timer
 			m.state = StateStopped
 			m.remaining = m.initial
-			return m, nil
+			return m, tea.CancelEvery(timerID)
 
 		case "+", "=":
 			// Add 10 seconds
@@ -92,7 +105,7 @@ func (m TimerModel) Update(msg tea.Msg) (TimerModel, tea.Cmd) {
 			return m, nil
 		}
 
-	case tea.TickMsg:
+	case countdownTickMsg:
 		// Timer tick
 		if m.state == StateRunning {
 			m.remaining -= 1 * time.Second
@@ -101,11 +114,8 @@ func (m TimerModel) Update(msg tea.Msg) (TimerModel, tea.Cmd) {
 				// Timer finished!
 				m.remaining = 0
 				m.state = StateStopped
-				return m, nil
+				return m, tea.CancelEvery(timerID)
 			}
-
-			// Continue ticking
-			return m, tea.Tick(1 * time.Second)
 		}
 	}
 
@@ -5,6 +5,7 @@
 //   - Multiple input modes (viewing vs editing)
 //   - List navigation
 //   - Item addition/removal
+//   - Key bindings and footer help from a single tea.KeyMap
 //
 // Controls:
 //   - 'a' : Add new todo
@@ -29,6 +30,24 @@ type TodoItem struct {
 	completed bool
 }
 
+// listKeys are the bindings of the list view; the footer help is generated
+// from them, so it can't fall out of sync with Update.
+var listKeys = tea.NewKeyMap(
+	tea.KeyBinding{Keys: []string{"a"}, Help: "add", Action: "add"},
+	tea.KeyBinding{Keys: []string{"d"}, Help: "delete", Action: "delete"},
+	tea.KeyBinding{Keys: []string{"space"}, Help: "toggle", Action: "toggle"},
+	tea.KeyBinding{Keys: []string{"j", "down"}, Help: "down", Action: "down"},
+	tea.KeyBinding{Keys: []string{"k", "up"}, Help: "up", Action: "up"},
+	tea.KeyBinding{Keys: []string{"q", "ctrl+c"}, Help: "quit", Action: "quit"},
+)
+
+// addKeys are the bindings while typing a new todo.
+var addKeys = tea.NewKeyMap(
+	tea.KeyBinding{Keys: []string{"enter"}, Help: "save", Action: "save"},
+	tea.KeyBinding{Keys: []string{"esc", "ctrl+c"}, Help: "cancel", Action: "cancel"},
+	tea.KeyBinding{Keys: []string{"backspace"}, Action: "backspace"},
+)
+
 // TodoModel represents the application state.
 type TodoModel struct {
 	items   []TodoItem
@@ -56,17 +75,17 @@ func (m TodoModel) Update(msg tea.Msg) (TodoModel, tea.Cmd) {
 		}
 
 		// Normal mode - list navigation
-		switch msg.String() {
-		case "q", "ctrl+c":
+		switch listKeys.Match(msg) {
+		case "quit":
 			return m, tea.Quit()
 
-		case "a":
+		case "add":
 			// Enter add mode
 			m.addMode = true
 			m.newText = ""
 			return m, nil
 
-		case "d":
+		case "delete":
 			// Delete current item
 			if len(m.items) > 0 {
 				m.items = append(m.items[:m.cursor], m.items[m.cursor+1:]...)
@@ -76,21 +95,21 @@ func (m TodoModel) Update(msg tea.Msg) (TodoModel, tea.Cmd) {
 			}
 			return m, nil
 
-		case "j", "down":
+		case "down":
 			// Move down
 			if m.cursor < len(m.items)-1 {
 				m.cursor++
 			}
 			return m, nil
 
-		case "k", "up":
+		case "up":
 			// Move up
 			if m.cursor > 0 {
 				m.cursor--
 			}
 			return m, nil
 
-		case " ":
+		case "toggle":
 			// Toggle completion
 			if len(m.items) > 0 {
 				m.items[m.cursor].completed = !m.items[m.cursor].completed
@@ -104,8 +123,8 @@ func (m TodoModel) Update(msg tea.Msg) (TodoModel, tea.Cmd) {
 
 // handleAddMode handles keyboard input while adding a new todo.
 func (m TodoModel) handleAddMode(msg tea.KeyMsg) (TodoModel, tea.Cmd) {
-	switch msg.String() {
-	case "enter":
+	switch addKeys.Match(msg) {
+	case "save":
 		// Add the todo
 		if m.newText != "" {
 			m.items = append(m.items, TodoItem{text: m.newText, completed: false})
@@ -115,7 +134,7 @@ func (m TodoModel) handleAddMode(msg tea.KeyMsg) (TodoModel, tea.Cmd) {
 		m.newText = ""
 		return m, nil
 
-	case "esc", "ctrl+c":
+	case "cancel":
 		// Cancel add
 		m.addMode = false
 		m.newText = ""
@@ -149,8 +168,6 @@ func (m TodoModel) View() string {
 		// Add mode view
 		b.WriteString("║ Adding new todo:                      ║\n")
 		b.WriteString(fmt.Sprintf("║ > %-35s ║\n", m.newText+"_"))
-		b.WriteString("║                                       ║\n")
-		b.WriteString("║ Enter: Save  |  Esc: Cancel          ║\n")
 	} else {
 		// Normal mode view
 		if len(m.items) == 0 {
@@ -196,11 +213,17 @@ func (m TodoModel) View() string {
 		}
 
 		b.WriteString("╠═══════════════════════════════════════╣\n")
-		b.WriteString("║ a:Add  d:Delete  Space:Toggle  q:Quit ║\n")
-		b.WriteString(fmt.Sprintf("║ j/k or ↑/↓ to navigate  (%d items)    ║\n", len(m.items)))
+		b.WriteString(fmt.Sprintf("║ %-37s ║\n", fmt.Sprintf("%d items", len(m.items))))
 	}
 
-	b.WriteString("╚═══════════════════════════════════════╝")
+	b.WriteString("╚═══════════════════════════════════════╝\n")
+
+	// Footer help, generated from the active key map
+	if m.addMode {
+		b.WriteString(addKeys.ShortHelp())
+	} else {
+		b.WriteString(listKeys.ShortHelp())
+	}
 
 	return b.String()
 }
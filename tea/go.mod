@@ -3,6 +3,7 @@ module github.com/phoenix-tui/phoenix/tea
 go 1.25.1
 
 require (
+	github.com/phoenix-tui/phoenix/core v0.2.4
 	github.com/phoenix-tui/phoenix/terminal v0.2.4
 	github.com/phoenix-tui/phoenix/testing v0.2.4
 	github.com/stretchr/testify v1.11.1
@@ -13,6 +14,7 @@ require (
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/unilibs/uniwidth v0.2.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
@@ -21,3 +23,6 @@ replace github.com/phoenix-tui/phoenix/terminal => ../terminal
 
 // Local development
 replace github.com/phoenix-tui/phoenix/testing => ../testing
+
+// Local development
+replace github.com/phoenix-tui/phoenix/core => ../core
@@ -0,0 +1,131 @@
+package program
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+	"github.com/phoenix-tui/phoenix/terminal"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+func newInlineRecordingProgram(mockTerm *phoenixtesting.MockTerminal, out *bytes.Buffer, msgs *[]model2.Msg) *Program[recordingModel] {
+	return New(
+		recordingModel{msgs: msgs},
+		WithTerminal[recordingModel](mockTerm),
+		WithInput[recordingModel](strings.NewReader("")),
+		WithOutput[recordingModel](out),
+	)
+}
+
+// TestProgram_EnterAltScreenMsg verifies the program switches to the alt
+// screen and draws the whole view there, without calling Update.
+func TestProgram_EnterAltScreenMsg(t *testing.T) {
+	var out bytes.Buffer
+	var msgs []model2.Msg
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := newInlineRecordingProgram(mockTerm, &out, &msgs)
+	p.renderView() // Inline frame
+
+	out.Reset()
+	quit := p.handleMsg(service.EnterAltScreenMsg{})
+
+	assert.False(t, quit)
+	assert.True(t, mockTerm.IsInAltScreen(), "should be in alt screen")
+	assert.Equal(t, 1, mockTerm.CallCount("Clear"), "alt screen should be cleared for the first frame")
+	assert.Equal(t, "view", out.String(), "view should be drawn in full")
+	assert.Empty(t, msgs, "EnterAltScreenMsg should not reach Update")
+
+	// Entering again does nothing
+	p.handleMsg(service.EnterAltScreenMsg{})
+	assert.Equal(t, 1, mockTerm.CallCount("EnterAltScreen"))
+}
+
+// TestProgram_ExitAltScreenMsg verifies the program returns to inline
+// rendering and repaints the whole view.
+func TestProgram_ExitAltScreenMsg(t *testing.T) {
+	var out bytes.Buffer
+	var msgs []model2.Msg
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := newInlineRecordingProgram(mockTerm, &out, &msgs)
+	p.renderView() // Inline frame, tracked by the inline renderer
+
+	p.handleMsg(service.EnterAltScreenMsg{})
+	out.Reset()
+	p.handleMsg(service.ExitAltScreenMsg{})
+
+	assert.False(t, mockTerm.IsInAltScreen(), "should be back on the normal screen")
+	assert.Contains(t, out.String(), "view", "unchanged view should still be repainted")
+	assert.Empty(t, msgs, "ExitAltScreenMsg should not reach Update")
+
+	// Exiting again does nothing
+	p.handleMsg(service.ExitAltScreenMsg{})
+	assert.Equal(t, 1, mockTerm.CallCount("ExitAltScreen"))
+}
+
+// TestProgram_ExitAltScreenMsg_StartedInAltScreen verifies a program created
+// WithAltScreen can leave it.
+func TestProgram_ExitAltScreenMsg_StartedInAltScreen(t *testing.T) {
+	var msgs []model2.Msg
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := newRecordingProgram(mockTerm, &msgs)
+	require.NoError(t, mockTerm.EnterAltScreen())
+
+	p.handleMsg(service.ExitAltScreenMsg{})
+
+	assert.False(t, mockTerm.IsInAltScreen())
+	assert.False(t, p.altScreen, "views should be rendered inline")
+}
+
+// TestProgram_RestoreTerminal_ExitsToggledAltScreen verifies cleanup leaves
+// an alt screen entered at runtime.
+func TestProgram_RestoreTerminal_ExitsToggledAltScreen(t *testing.T) {
+	var out bytes.Buffer
+	var msgs []model2.Msg
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := newInlineRecordingProgram(mockTerm, &out, &msgs)
+
+	p.handleMsg(service.EnterAltScreenMsg{})
+	p.restoreTerminal()
+
+	assert.False(t, mockTerm.IsInAltScreen(), "cleanup should exit the alt screen")
+}
+
+// TestProgram_RestoreTerminal_Cursor verifies cleanup restores only a cursor
+// that was changed, and only when the program draws on the terminal.
+func TestProgram_RestoreTerminal_Cursor(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   io.Writer
+		changed  bool
+		restores bool
+	}{
+		{"changed on the terminal", os.Stdout, true, true},
+		{"unchanged", os.Stdout, false, false},
+		{"output to a buffer", &bytes.Buffer{}, true, false},
+	}
+	for _, tt := range tests {
+		mockTerm := phoenixtesting.NewMockTerminal()
+		if tt.changed {
+			_ = mockTerm.HideCursor()
+			_ = mockTerm.SetCursorStyle(terminal.CursorBar, false)
+		}
+		p := New(TestModel{}, WithTerminal[TestModel](mockTerm), WithOutput[TestModel](tt.output))
+
+		p.restoreTerminal()
+
+		restored := mockTerm.CallCount("ShowCursor") + mockTerm.CallCount("ResetCursorStyle")
+		if tt.restores {
+			assert.Equal(t, 2, restored, "%s: cursor and style should be restored", tt.name)
+		} else {
+			assert.Zero(t, restored, "%s: cursor should be left alone", tt.name)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package program
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phoenix-tui/phoenix/terminal"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// TestProgram_Run_ContextCanceled verifies Run returns ctx.Err() and restores the terminal.
+func TestProgram_Run_ContextCanceled(t *testing.T) {
+	mockTerm := phoenixtesting.NewMockTerminal()
+	_ = mockTerm.HideCursor() // Changed by the application
+	_ = mockTerm.SetCursorStyle(terminal.CursorBar, false)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := New(
+		TestModel{},
+		WithContext[TestModel](ctx),
+		WithTerminal[TestModel](mockTerm),
+		WithInput[TestModel](strings.NewReader("")),
+		WithAltScreen[TestModel](), // Draws on stdout
+	)
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run() }()
+
+	time.Sleep(50 * time.Millisecond)
+	require.True(t, p.IsRunning(), "program should be running")
+	require.True(t, mockTerm.IsInAltScreen(), "program should be in alt screen")
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.True(t, errors.Is(err, context.Canceled), "Run should return ctx.Err(), got %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("Run() did not finish after context cancellation")
+	}
+
+	assert.False(t, p.IsRunning(), "program should not be running")
+	assert.False(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	assert.False(t, mockTerm.IsInAltScreen(), "alt screen should be exited")
+	assert.False(t, mockTerm.IsCursorHidden(), "cursor should be shown")
+	assert.False(t, mockTerm.IsCursorStyled(), "cursor style should be reset")
+	assert.Contains(t, mockTerm.Calls, "SetBuffered(false)", "cleanup output should be flushed")
+}
+
+// TestProgram_Run_ContextAlreadyCanceled verifies an already-canceled context stops Run immediately.
+func TestProgram_Run_ContextAlreadyCanceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	time.Sleep(5 * time.Millisecond)
+
+	p := New(
+		TestModel{},
+		WithContext[TestModel](ctx),
+		WithTerminal[TestModel](phoenixtesting.NewMockTerminal()),
+		WithInput[TestModel](strings.NewReader("")),
+		WithOutput[TestModel](&bytes.Buffer{}),
+	)
+
+	err := p.Run()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "Run should return ctx.Err(), got %v", err)
+}
+
+// TestProgram_Start_ContextCanceled verifies Start's loop also stops on cancellation.
+func TestProgram_Start_ContextCanceled(t *testing.T) {
+	mockTerm := phoenixtesting.NewMockTerminal()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := New(
+		TestModel{},
+		WithContext[TestModel](ctx),
+		WithTerminal[TestModel](mockTerm),
+		WithInput[TestModel](strings.NewReader("")),
+		WithOutput[TestModel](&bytes.Buffer{}),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+
+	assert.Eventually(t, func() bool { return !p.IsRunning() }, time.Second, 10*time.Millisecond,
+		"program should stop after context cancellation")
+	assert.False(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	assert.ErrorIs(t, p.Wait(), context.Canceled, "Wait should report the cancellation")
+	assert.ErrorIs(t, p.Err(), context.Canceled)
+}
@@ -0,0 +1,61 @@
+package program
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// TestProgram_RequestCursorPositionMsg verifies the program sends the cursor
+// position query after the latest frame, without calling Update.
+func TestProgram_RequestCursorPositionMsg(t *testing.T) {
+	var out bytes.Buffer
+	var msgs []model2.Msg
+	p := newInlineRecordingProgram(phoenixtesting.NewMockTerminal(), &out, &msgs)
+
+	quit := p.handleMsg(service.RequestCursorPositionMsg{})
+
+	assert.False(t, quit)
+	assert.Empty(t, msgs, "RequestCursorPositionMsg should not reach Update")
+	assert.Equal(t, int32(1), p.cursorRequests.Load(), "the request should be outstanding")
+	assert.True(t, bytes.HasSuffix(out.Bytes(), []byte(cursorPositionQuery)),
+		"output should end with the query: %q", out.String())
+}
+
+// TestProgram_InputReaderDrainsPendingInput verifies keys the terminal read
+// while waiting for a reply reach the program before stdin input.
+func TestProgram_InputReaderDrainsPendingInput(t *testing.T) {
+	mockTerm := phoenixtesting.NewMockTerminal()
+	mockTerm.SetPendingInput([]byte("a\x1b[3;7R"))
+	p := New(
+		recordingModel{msgs: new([]model2.Msg)},
+		WithTerminal[recordingModel](mockTerm),
+		WithInput[recordingModel](bytes.NewReader([]byte("b"))),
+		WithOutput[recordingModel](&bytes.Buffer{}),
+	)
+	p.cursorRequests.Add(1) // The pending reply answers this request
+
+	p.startInputReader()
+	defer p.stopInputReader()
+
+	want := []model2.Msg{
+		model2.KeyMsg{Type: model2.KeyRune, Rune: 'a'},
+		model2.CursorPositionMsg{X: 6, Y: 2},
+		model2.KeyMsg{Type: model2.KeyRune, Rune: 'b'},
+	}
+	for _, w := range want {
+		select {
+		case msg := <-p.msgCh:
+			require.Equal(t, w, msg)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %v", w)
+		}
+	}
+}
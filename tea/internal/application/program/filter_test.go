@@ -0,0 +1,97 @@
+package program
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// TestWithFilter verifies filter option is stored.
+func TestWithFilter(t *testing.T) {
+	filter := func(_ model2.Model[TestModel], msg model2.Msg) model2.Msg { return msg }
+	p := New(TestModel{}, WithFilter[TestModel](filter))
+	assert.NotNil(t, p.filter, "WithFilter should set filter")
+}
+
+// TestProgram_Filter_Drop verifies returning nil drops the message before Update.
+func TestProgram_Filter_Drop(t *testing.T) {
+	var buf bytes.Buffer
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+		WithFilter[TestModel](func(_ model2.Model[TestModel], msg model2.Msg) model2.Msg {
+			if key, ok := msg.(model2.KeyMsg); ok && key.Rune == '+' {
+				return nil // Drop increments
+			}
+			return msg
+		}),
+	)
+
+	require.NoError(t, p.Start())
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: '+'}))
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: 'x'}))
+	time.Sleep(50 * time.Millisecond)
+	p.Stop()
+
+	output := buf.String()
+	assert.Contains(t, output, "Last: x", "unfiltered message should reach Update")
+	assert.NotContains(t, output, "Value: 1", "dropped message should not reach Update")
+}
+
+// TestProgram_Filter_TransformToQuit verifies a filter can implement a global quit shortcut.
+func TestProgram_Filter_TransformToQuit(t *testing.T) {
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&bytes.Buffer{}),
+		WithInput[TestModel](strings.NewReader("")),
+		WithFilter[TestModel](func(_ model2.Model[TestModel], msg model2.Msg) model2.Msg {
+			if key, ok := msg.(model2.KeyMsg); ok && key.Type == model2.KeyCtrlC {
+				return model2.QuitMsg{}
+			}
+			return msg
+		}),
+	)
+
+	require.NoError(t, p.Start())
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyCtrlC}))
+
+	assert.Eventually(t, func() bool { return !p.IsRunning() }, time.Second, 10*time.Millisecond,
+		"filter-produced QuitMsg should stop the program")
+}
+
+// TestProgram_Filter_SeesCurrentModel verifies the filter receives the up-to-date model.
+func TestProgram_Filter_SeesCurrentModel(t *testing.T) {
+	seen := make(chan int, 10)
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&bytes.Buffer{}),
+		WithInput[TestModel](strings.NewReader("")),
+		WithFilter[TestModel](func(m model2.Model[TestModel], msg model2.Msg) model2.Msg {
+			if key, ok := msg.(model2.KeyMsg); ok && key.Rune == '?' {
+				seen <- m.(TestModel).value
+			}
+			return msg
+		}),
+	)
+
+	require.NoError(t, p.Start())
+	defer p.Stop()
+
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: '+'}))
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: '+'}))
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: '?'}))
+
+	select {
+	case value := <-seen:
+		assert.Equal(t, 2, value, "filter should see model after previous updates")
+	case <-time.After(time.Second):
+		t.Fatal("filter was not called")
+	}
+}
@@ -0,0 +1,87 @@
+package program
+
+import (
+	"bytes"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// viewCountModel counts Update and View calls via shared counters.
+type viewCountModel struct {
+	updates *atomic.Int64
+	views   *atomic.Int64
+}
+
+func newViewCountModel() viewCountModel {
+	return viewCountModel{updates: &atomic.Int64{}, views: &atomic.Int64{}}
+}
+
+func (m viewCountModel) Init() model2.Cmd { return nil }
+
+func (m viewCountModel) Update(_ model2.Msg) (model2.Model[viewCountModel], model2.Cmd) {
+	m.updates.Add(1)
+	return m, nil
+}
+
+func (m viewCountModel) View() string {
+	m.views.Add(1)
+	return "frame\n"
+}
+
+// TestWithMaxFPS verifies the option value (negative disables the cap).
+func TestWithMaxFPS(t *testing.T) {
+	p := New(TestModel{}, WithMaxFPS[TestModel](30))
+	assert.Equal(t, 30, p.maxFPS)
+
+	p = New(TestModel{}, WithMaxFPS[TestModel](-5))
+	assert.Equal(t, 0, p.maxFPS, "negative FPS should mean unlimited")
+}
+
+// TestProgram_MaxFPS_CoalescesRenders verifies many Updates produce few Views.
+func TestProgram_MaxFPS_CoalescesRenders(t *testing.T) {
+	m := newViewCountModel()
+	p := New[viewCountModel](
+		m,
+		WithOutput[viewCountModel](&bytes.Buffer{}),
+		WithInput[viewCountModel](strings.NewReader("")),
+		WithMaxFPS[viewCountModel](10),
+	)
+
+	require.NoError(t, p.Start())
+	for i := 0; i < 50; i++ {
+		require.NoError(t, p.Send(model2.MouseMsg{X: i, Action: model2.MouseActionMotion}))
+	}
+	time.Sleep(250 * time.Millisecond)
+	p.Stop()
+
+	assert.Equal(t, int64(50), m.updates.Load(), "every message must reach Update")
+	assert.Less(t, m.views.Load(), int64(10), "renders should be throttled to ~10 FPS")
+	assert.Greater(t, m.views.Load(), int64(1), "pending updates should be rendered on a frame tick")
+}
+
+// TestProgram_MaxFPS_Unlimited verifies default behavior renders after every Update.
+func TestProgram_MaxFPS_Unlimited(t *testing.T) {
+	m := newViewCountModel()
+	p := New[viewCountModel](
+		m,
+		WithOutput[viewCountModel](&bytes.Buffer{}),
+		WithInput[viewCountModel](strings.NewReader("")),
+	)
+
+	require.NoError(t, p.Start())
+	for i := 0; i < 5; i++ {
+		require.NoError(t, p.Send(model2.MouseMsg{X: i, Action: model2.MouseActionMotion}))
+	}
+	time.Sleep(50 * time.Millisecond)
+	p.Stop()
+
+	// Initial render + one per Update
+	assert.Equal(t, int64(6), m.views.Load())
+}
@@ -0,0 +1,71 @@
+package program
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// msgLogTimeFormat is the timestamp layout of WithMessageLog lines.
+const msgLogTimeFormat = "15:04:05.000"
+
+// msgLogMaxValue caps the logged representation of a message so a large
+// payload (file contents, API responses) doesn't flood the log.
+const msgLogMaxValue = 120
+
+// logMsg writes msg to the message log (WithMessageLog), if enabled.
+func (p *Program[T]) logMsg(msg model2.Msg) {
+	if p.msgLog == nil {
+		return
+	}
+	p.writeLog("msg %T: %s", msg, describeMsg(msg))
+}
+
+// cmdSource is implemented by models that wrap another model and convert
+// the commands it returns (like the public API's model wrapper), so the log
+// names the command the wrapped Update returned instead of the conversion.
+type cmdSource interface {
+	// SourceCmd returns the unconverted command of the last Update, or nil.
+	SourceCmd() any
+}
+
+// logCmd writes the command returned by Update to the message log, if any.
+// Commands are closures, so they are identified by their function name.
+func (p *Program[T]) logCmd(cmd model2.Cmd) {
+	if p.msgLog == nil || cmd == nil {
+		return
+	}
+	var fn any = cmd
+	if source, ok := p.model.(cmdSource); ok && source.SourceCmd() != nil {
+		fn = source.SourceCmd()
+	}
+	name := "unknown"
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		name = f.Name()
+	}
+	p.writeLog("cmd %s", name)
+}
+
+func (p *Program[T]) writeLog(format string, args ...any) {
+	line := time.Now().Format(msgLogTimeFormat) + " " + fmt.Sprintf(format, args...) + "\n"
+	_, _ = p.msgLog.Write([]byte(line)) // Best effort
+}
+
+// describeMsg returns a compact single-line representation of msg.
+func describeMsg(msg model2.Msg) string {
+	var s string
+	if stringer, ok := msg.(fmt.Stringer); ok {
+		s = stringer.String()
+	} else {
+		s = fmt.Sprintf("%+v", msg)
+	}
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	if runes := []rune(s); len(runes) > msgLogMaxValue {
+		s = string(runes[:msgLogMaxValue]) + "…"
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+package program
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// TestProgram_MessageLog verifies messages and returned commands are logged with timestamps.
+func TestProgram_MessageLog(t *testing.T) {
+	var log bytes.Buffer
+	p := New(
+		TestModel{},
+		WithInput[TestModel](strings.NewReader("")),
+		WithOutput[TestModel](&bytes.Buffer{}),
+		WithMessageLog[TestModel](&log),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(30 * time.Millisecond)
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: 'q'}))
+	time.Sleep(30 * time.Millisecond)
+	p.Stop()
+
+	output := log.String()
+	assert.Contains(t, output, "msg program.testInitMsg: init")
+	assert.Contains(t, output, "msg model.KeyMsg: q")
+	assert.NotContains(t, output, "cmd <nil>", "Updates without a command are not logged")
+	assert.Regexp(t, regexp.MustCompile(`cmd \S+TestModel\.Update\.func1`), output, "quit cmd returned for q")
+	assert.Contains(t, output, "msg model.QuitMsg: quit")
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		assert.Regexp(t, `^\d{2}:\d{2}:\d{2}\.\d{3} (msg|cmd) `, line)
+	}
+}
+
+// TestDescribeMsg_Truncates verifies large payloads are shortened to one line.
+func TestDescribeMsg_Truncates(t *testing.T) {
+	type bigMsg struct{ Data string }
+
+	s := describeMsg(bigMsg{Data: strings.Repeat("é", 500) + "\nmore"})
+	assert.Equal(t, msgLogMaxValue+1, len([]rune(s)), "truncated to limit plus ellipsis")
+	assert.NotContains(t, s, "\n")
+}
@@ -1,8 +1,10 @@
 package program
 
 import (
+	"context"
 	"io"
 
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/terminal"
 )
 
@@ -72,3 +74,122 @@ func WithTerminal[T any](term terminal.Terminal) Option[T] {
 		p.terminal = term
 	}
 }
+
+// WithContext ties the program lifetime to ctx (default: context.Background()).
+//
+// When ctx is canceled the event loop stops, the terminal is restored
+// (raw mode, alternate screen, cursor) and Run returns ctx.Err().
+// Useful when embedding a TUI in a service that must shut down on SIGTERM.
+//
+// Example:
+//
+//	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+//	defer stop()
+//	p := program.New(model, program.WithContext[MyModel](ctx))
+//	err := p.Run() // context.Canceled after SIGTERM
+func WithContext[T any](ctx context.Context) Option[T] {
+	return func(p *Program[T]) {
+		if ctx != nil {
+			p.ctx = ctx
+		}
+	}
+}
+
+// WithFilter installs a message filter that runs before Update.
+//
+// The filter receives the current model and each incoming message (after
+// BatchMsg/SequenceMsg expansion, before QuitMsg handling) and returns the
+// message to deliver. Returning a different message transforms it; returning
+// nil drops it. The filter runs on the event loop goroutine, so it never races
+// with Update.
+//
+// Example - Global quit shortcut:
+//
+//	p := program.New(model, program.WithFilter[MyModel](
+//		func(_ model.Model[MyModel], msg model.Msg) model.Msg {
+//			if key, ok := msg.(model.KeyMsg); ok && key.Type == model.KeyCtrlC {
+//				return model.QuitMsg{}
+//			}
+//			return msg
+//		},
+//	))
+func WithFilter[T any](filter func(model2.Model[T], model2.Msg) model2.Msg) Option[T] {
+	return func(p *Program[T]) {
+		p.filter = filter
+	}
+}
+
+// WithMaxFPS caps how often View is rendered (default: 0 = unlimited).
+//
+// Every message still reaches Update immediately; only rendering is
+// throttled. Updates arriving within one frame are coalesced into a single
+// View call, which keeps bursts of events (e.g. mouse motion) from burning
+// CPU on frames nobody sees. Values <= 0 disable the cap.
+//
+// Example:
+//
+//	p := program.New(model, program.WithMaxFPS[MyModel](60))
+func WithMaxFPS[T any](fps int) Option[T] {
+	return func(p *Program[T]) {
+		if fps < 0 {
+			fps = 0
+		}
+		p.maxFPS = fps
+	}
+}
+
+// WithBracketedPaste enables bracketed paste mode while the program runs.
+//
+// Pasted text is then delivered as a single model.PasteMsg instead of one
+// KeyMsg per character, so a pasted newline is never mistaken for Enter.
+// Terminals without bracketed paste support keep the per-key behavior.
+//
+// Example:
+//
+//	p := program.New(model, program.WithBracketedPaste[MyModel]())
+func WithBracketedPaste[T any]() Option[T] {
+	return func(p *Program[T]) {
+		p.bracketedPaste = true
+	}
+}
+
+// WithRecover recovers panics from Init, Update, View and commands.
+//
+// Without it, a panic in a command goroutine crashes the process before the
+// terminal is restored, leaving the user's shell in raw mode / alt screen.
+// With it, the terminal is restored and Run returns a *PanicError carrying
+// the panic value and stack trace.
+//
+// Example:
+//
+//	p := program.New(model, program.WithRecover[MyModel]())
+//	if err := p.Run(); err != nil {
+//		var perr *program.PanicError
+//		if errors.As(err, &perr) { ... }
+//	}
+func WithRecover[T any]() Option[T] {
+	return func(p *Program[T]) {
+		p.recoverPanics = true
+	}
+}
+
+// WithMessageLog writes every message flowing through the event loop to w,
+// one timestamped line per message, followed by the command Update returned.
+//
+// This is println-debugging for TUIs, where stdout belongs to the UI:
+//
+//	f, _ := os.Create("debug.log")
+//	defer f.Close()
+//	p := program.New(model, program.WithMessageLog[MyModel](f))
+//
+// Then `tail -f debug.log` in another terminal. Output looks like:
+//
+//	15:04:05.123 msg model.KeyMsg: a
+//	15:04:05.123 cmd main.fetchUser.func1
+//
+// Write errors are ignored. A nil writer disables logging.
+func WithMessageLog[T any](w io.Writer) Option[T] {
+	return func(p *Program[T]) {
+		p.msgLog = w
+	}
+}
@@ -2,6 +2,7 @@ package program
 
 import (
 	"bytes"
+	"context"
 	"strings"
 	"testing"
 )
@@ -158,3 +159,20 @@ func TestOptions_Overwrite(t *testing.T) {
 		t.Error("later WithInput should overwrite earlier one")
 	}
 }
+
+// TestWithContext verifies context option is stored (nil is ignored).
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := New(TestModel{}, WithContext[TestModel](ctx))
+	if p.ctx != ctx {
+		t.Error("WithContext should set context")
+	}
+
+	//nolint:staticcheck // Verifying nil context falls back to Background
+	p = New(TestModel{}, WithContext[TestModel](nil))
+	if p.ctx == nil {
+		t.Error("WithContext(nil) should keep default context")
+	}
+}
@@ -0,0 +1,57 @@
+package program
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// PanicError is returned by Run when a panic was recovered (see WithRecover).
+//
+// It carries the original panic value and the stack trace of the panicking
+// goroutine, so callers can distinguish crashes in user code from regular
+// errors:
+//
+//	var perr *program.PanicError
+//	if errors.As(err, &perr) {
+//		log.Printf("TUI crashed: %v\n%s", perr.Value, perr.Stack)
+//	}
+type PanicError struct {
+	Value any    // Value passed to panic()
+	Stack []byte // Stack trace captured at recovery
+}
+
+// newPanicError captures the current stack. Must be called from the
+// deferred function that recovered, so the stack includes the panic site.
+// A model.CommandPanic re-raised by a command wrapper keeps the stack of
+// the goroutine that originally panicked.
+func newPanicError(value any) *PanicError {
+	if cp, ok := value.(model2.CommandPanic); ok {
+		return &PanicError{Value: cp.Value, Stack: cp.Stack}
+	}
+	return &PanicError{Value: value, Stack: debug.Stack()}
+}
+
+// reportPanic hands a panic recovered outside the event loop to it.
+// Only the first one is kept.
+func (p *Program[T]) reportPanic(err *PanicError) {
+	select {
+	case p.panicCh <- err:
+	default:
+		// Another panic is already pending
+	}
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("program panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error (e.g. panic(err)).
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package program
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// panicModel panics in Update on 'p', or returns a panicking command on 'c',
+// one wrapped in WithTimeout on 't', and one that panics after its timeout
+// on 'l'.
+type panicModel struct{}
+
+func (m panicModel) Init() model2.Cmd { return nil }
+
+func (m panicModel) Update(msg model2.Msg) (model2.Model[panicModel], model2.Cmd) {
+	if key, ok := msg.(model2.KeyMsg); ok {
+		switch key.Rune {
+		case 'p':
+			panic("update exploded")
+		case 'c':
+			return m, func() model2.Msg { panic(errors.New("command exploded")) }
+		case 't':
+			return m, service.WithTimeout(time.Second, timeoutPanicCmd, nil)
+		case 'l':
+			return m, service.WithTimeout(time.Millisecond, func() model2.Msg {
+				time.Sleep(20 * time.Millisecond)
+				return timeoutPanicCmd()
+			}, nil)
+		}
+	}
+	return m, nil
+}
+
+func (m panicModel) View() string { return "ok\n" }
+
+func timeoutPanicCmd() model2.Msg { panic("timeout command exploded") }
+
+// runPanicModel sends key to a recovering panicModel and returns its Run
+// error. Each of before runs once the program is running, before key is sent.
+func runPanicModel(t *testing.T, key rune, before ...func()) (*phoenixtesting.MockTerminal, error) {
+	t.Helper()
+
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := New[panicModel](
+		panicModel{},
+		WithRecover[panicModel](),
+		WithTerminal[panicModel](mockTerm),
+		WithAltScreen[panicModel](),
+		WithInput[panicModel](strings.NewReader("")),
+		WithOutput[panicModel](&bytes.Buffer{}),
+	)
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run() }()
+
+	time.Sleep(30 * time.Millisecond)
+	for _, fn := range before {
+		fn()
+	}
+	require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: key}))
+
+	select {
+	case err := <-done:
+		assert.False(t, p.IsRunning(), "program should have stopped")
+		return mockTerm, err
+	case <-time.After(time.Second):
+		t.Fatal("Run() did not return after panic")
+		return nil, nil
+	}
+}
+
+// TestProgram_Recover_UpdatePanic verifies a panic in Update is returned as PanicError.
+func TestProgram_Recover_UpdatePanic(t *testing.T) {
+	mockTerm, err := runPanicModel(t, 'p')
+
+	var perr *PanicError
+	require.True(t, errors.As(err, &perr), "expected *PanicError, got %T", err)
+	assert.Equal(t, "update exploded", perr.Value)
+	assert.Contains(t, string(perr.Stack), "panicModel", "stack should include panic site")
+	assert.False(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	assert.False(t, mockTerm.IsInAltScreen(), "alt screen should be exited")
+}
+
+// TestProgram_Recover_CommandPanic verifies a panic in a command goroutine stops Run.
+func TestProgram_Recover_CommandPanic(t *testing.T) {
+	mockTerm, err := runPanicModel(t, 'c')
+
+	var perr *PanicError
+	require.True(t, errors.As(err, &perr), "expected *PanicError, got %T", err)
+	assert.EqualError(t, errors.Unwrap(err), "command exploded", "error panic values should unwrap")
+	assert.False(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+}
+
+// TestProgram_Recover_TimeoutCommandPanic verifies a panic inside WithTimeout
+// is reported with the stack of the command that panicked, also when it
+// happens after the timeout fired.
+func TestProgram_Recover_TimeoutCommandPanic(t *testing.T) {
+	for _, key := range []rune{'t', 'l'} {
+		_, err := runPanicModel(t, key)
+
+		var perr *PanicError
+		require.True(t, errors.As(err, &perr), "%c: expected *PanicError, got %T", key, err)
+		assert.Equal(t, "timeout command exploded", perr.Value)
+		assert.Contains(t, string(perr.Stack), "timeoutPanicCmd", "stack should point at the command")
+	}
+}
+
+// TestProgram_Recover_LatePanicStaysInProgram verifies a panic after a
+// timeout is reported by the program that ran the command, not by another
+// program started later.
+func TestProgram_Recover_LatePanicStaysInProgram(t *testing.T) {
+	other := New[panicModel](
+		panicModel{},
+		WithRecover[panicModel](),
+		WithTerminal[panicModel](phoenixtesting.NewMockTerminal()),
+		WithInput[panicModel](strings.NewReader("")),
+		WithOutput[panicModel](&bytes.Buffer{}),
+	)
+
+	_, err := runPanicModel(t, 'l', func() {
+		require.NoError(t, other.Start())
+	})
+
+	var perr *PanicError
+	require.True(t, errors.As(err, &perr), "expected *PanicError, got %T", err)
+	assert.True(t, other.IsRunning(), "the other program should keep running")
+	assert.NoError(t, other.Err())
+	other.Stop()
+}
+
+// TestProgram_Recover_Start verifies a program started with Start reports
+// recovered panics through Wait and Err, like Run does.
+func TestProgram_Recover_Start(t *testing.T) {
+	for _, key := range []rune{'p', 'c'} {
+		mockTerm := phoenixtesting.NewMockTerminal()
+		p := New[panicModel](
+			panicModel{},
+			WithRecover[panicModel](),
+			WithTerminal[panicModel](mockTerm),
+			WithInput[panicModel](strings.NewReader("")),
+			WithOutput[panicModel](&bytes.Buffer{}),
+		)
+
+		assert.NoError(t, p.Wait(), "Wait before Start should return at once")
+		require.NoError(t, p.Start())
+		time.Sleep(30 * time.Millisecond)
+		assert.NoError(t, p.Err(), "no error while running")
+		require.NoError(t, p.Send(model2.KeyMsg{Type: model2.KeyRune, Rune: key}))
+
+		err := p.Wait()
+		var perr *PanicError
+		require.True(t, errors.As(err, &perr), "%c: expected *PanicError, got %T", key, err)
+		assert.Equal(t, err, p.Err())
+		assert.False(t, p.IsRunning(), "program should have stopped")
+		assert.False(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	}
+}
+
+// TestPanicError_Error verifies the message contains value and stack.
+func TestPanicError_Error(t *testing.T) {
+	perr := &PanicError{Value: "boom", Stack: []byte("goroutine 1 [running]:")}
+	assert.Contains(t, perr.Error(), "boom")
+	assert.Contains(t, perr.Error(), "goroutine 1")
+	assert.Nil(t, perr.Unwrap(), "non-error values don't unwrap")
+}
@@ -0,0 +1,79 @@
+package program
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// TestWithBracketedPaste verifies the option sets the flag.
+func TestWithBracketedPaste(t *testing.T) {
+	p := New(TestModel{}, WithBracketedPaste[TestModel]())
+	assert.True(t, p.bracketedPaste)
+}
+
+// TestProgram_BracketedPaste_EnableDisable verifies mode is enabled on start and disabled on exit.
+func TestProgram_BracketedPaste_EnableDisable(t *testing.T) {
+	var buf bytes.Buffer
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+		WithTerminal[TestModel](phoenixtesting.NewMockTerminal()),
+		WithBracketedPaste[TestModel](),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(50 * time.Millisecond)
+	p.Stop()
+
+	output := buf.String()
+	enable := strings.Index(output, enableBracketedPaste)
+	disable := strings.LastIndex(output, disableBracketedPaste)
+	assert.GreaterOrEqual(t, enable, 0, "bracketed paste should be enabled")
+	assert.Greater(t, disable, enable, "bracketed paste should be disabled on exit")
+}
+
+// TestProgram_BracketedPaste_DisabledByDefault verifies no sequences without the option.
+func TestProgram_BracketedPaste_DisabledByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+		WithTerminal[TestModel](phoenixtesting.NewMockTerminal()),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(50 * time.Millisecond)
+	p.Stop()
+
+	assert.NotContains(t, buf.String(), enableBracketedPaste)
+}
+
+// TestProgram_BracketedPaste_SuspendResume verifies mode is toggled around Suspend/Resume.
+func TestProgram_BracketedPaste_SuspendResume(t *testing.T) {
+	var buf bytes.Buffer
+	mockTerm := phoenixtesting.NewMockTerminal()
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+		WithTerminal[TestModel](mockTerm),
+		WithBracketedPaste[TestModel](),
+	)
+	require.NoError(t, mockTerm.EnterRawMode())
+
+	require.NoError(t, p.Suspend())
+	assert.Contains(t, buf.String(), disableBracketedPaste, "Suspend should disable bracketed paste")
+
+	buf.Reset()
+	require.NoError(t, p.Resume())
+	assert.Contains(t, buf.String(), enableBracketedPaste, "Resume should re-enable bracketed paste")
+}
@@ -0,0 +1,49 @@
+package program
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+)
+
+// TestProgram_Println_Inline verifies PrintlnMsg is printed above the view and not sent to Update.
+func TestProgram_Println_Inline(t *testing.T) {
+	var buf bytes.Buffer
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(30 * time.Millisecond)
+	require.NoError(t, p.Send(service.PrintlnMsg{Message: "progress: 50%"}))
+	time.Sleep(30 * time.Millisecond)
+	p.Stop()
+
+	output := buf.String()
+	logAt := strings.Index(output, "progress: 50%")
+	require.GreaterOrEqual(t, logAt, 0, "message should be printed")
+	assert.Contains(t, output[logAt:], "Value:", "view should be re-rendered below the printed line")
+	assert.NotContains(t, output, "Last: println", "PrintlnMsg should not reach Update")
+}
+
+// TestProgram_Println_AltScreen verifies PrintlnMsg is discarded in alt-screen mode.
+func TestProgram_Println_AltScreen(t *testing.T) {
+	var buf bytes.Buffer
+	p := New(
+		TestModel{},
+		WithOutput[TestModel](&buf),
+		WithInput[TestModel](strings.NewReader("")),
+		WithAltScreen[TestModel](),
+	)
+
+	p.printAbove("should not appear")
+	assert.NotContains(t, buf.String(), "should not appear")
+}
@@ -8,10 +8,15 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"golang.org/x/term"
+
 	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
 	"github.com/phoenix-tui/phoenix/tea/internal/infrastructure/input"
 	"github.com/phoenix-tui/phoenix/tea/internal/infrastructure/renderer"
 	"github.com/phoenix-tui/phoenix/terminal"
@@ -53,14 +58,40 @@ type Program[T any] struct {
 	inputReaderRunning    bool               // True if inputReader goroutine is active
 	inputReaderGeneration uint64             // Generation counter to prevent race conditions
 
+	// Cursor position requests not yet answered. While none is outstanding,
+	// the input reader parses "ESC [ n ; m R" as a key (e.g. Shift+F3).
+	cursorRequests atomic.Int32
+
 	// Configuration flags
 	altScreen      bool // Use alternate screen buffer
 	mouseAllMotion bool // Enable mouse motion events
 
+	// External cancellation (WithContext). Never nil after New().
+	ctx context.Context
+
+	// Optional message filter (WithFilter), runs before Update on the event loop.
+	filter func(model2.Model[T], model2.Msg) model2.Msg
+
+	// Optional debug log of every message and returned command (WithMessageLog).
+	msgLog io.Writer
+
+	// Bracketed paste mode (WithBracketedPaste): pasted text arrives as one PasteMsg.
+	bracketedPaste bool
+
+	// Frame rate cap (WithMaxFPS). 0 = unlimited (render after every Update).
+	// renderPending is only touched from the event loop goroutine.
+	maxFPS        int
+	renderPending bool
+
 	// Lifecycle management
 	running bool
 	mu      sync.Mutex
 
+	// Background event loop (Start): done is closed once it has stopped and
+	// startErr is why (nil after a normal quit).
+	done     chan struct{}
+	startErr error
+
 	// Event loop channels
 	msgCh  chan model2.Msg // Incoming messages
 	cmdCh  chan model2.Cmd // Commands to execute
@@ -69,12 +100,25 @@ type Program[T any] struct {
 	// Quit channel
 	quitCh chan struct{}
 
+	// Panic recovery (WithRecover). Panics recovered in command goroutines
+	// are forwarded to the event loop through panicCh.
+	recoverPanics bool
+	panicCh       chan error
+
+	// Runtime-managed commands (Debounce, EveryWithID), scoped to this program.
+	debounces *service.DebounceRegistry
+	tickers   *service.TickerRegistry
+
 	// Inline renderer for non-alt-screen mode.
 	// Initialized lazily in renderView() on the first render call.
 	// Tracks linesRendered so subsequent renders overwrite the previous frame
 	// instead of appending below it.
 	inlineRenderer *renderer.InlineRenderer
 
+	// Wrap each frame in synchronized output sequences (DEC mode 2026).
+	// Set in Run()/Start() when the terminal supports it and output goes to it.
+	syncOutput bool
+
 	// Suspend/Resume state (for ExecProcess and public API)
 	suspended    bool            // True if TUI is suspended
 	suspendState *suspendedState // Saved state when suspended
@@ -100,13 +144,18 @@ type suspendedState struct {
 //	)
 func New[T any](m model2.Model[T], opts ...Option[T]) *Program[T] {
 	p := &Program[T]{
-		model:  m,
-		input:  os.Stdin,  // Default
-		output: os.Stdout, // Default
-		quitCh: make(chan struct{}),
-		msgCh:  make(chan model2.Msg, 100), // Buffered for performance
-		cmdCh:  make(chan model2.Cmd, 10),
-		viewCh: make(chan string, 10),
+		model:   m,
+		input:   os.Stdin,  // Default
+		output:  os.Stdout, // Default
+		ctx:     context.Background(),
+		quitCh:  make(chan struct{}),
+		panicCh: make(chan error, 1),
+		msgCh:   make(chan model2.Msg, 100), // Buffered for performance
+		cmdCh:   make(chan model2.Cmd, 10),
+		viewCh:  make(chan string, 10),
+
+		debounces: service.NewDebounceRegistry(),
+		tickers:   service.NewTickerRegistry(),
 	}
 
 	// Apply options
@@ -139,9 +188,13 @@ func New[T any](m model2.Model[T], opts ...Option[T]) *Program[T] {
 //	}
 //
 // Returns error if program is already running or initialization fails.
+// If the program was configured WithContext and the context is canceled,
+// Run restores the terminal and returns ctx.Err().
+// If the program was configured WithRecover, a panic in Init/Update/View or
+// in a command restores the terminal and is returned as *PanicError.
 //
 //nolint:gocognit // Event loop orchestration requires sequential logic
-func (p *Program[T]) Run() error {
+func (p *Program[T]) Run() (err error) {
 	p.mu.Lock()
 	if p.running {
 		p.mu.Unlock()
@@ -153,25 +206,21 @@ func (p *Program[T]) Run() error {
 	if p.terminal == nil {
 		p.terminal = terminal.New()
 	}
+	p.syncOutput = p.output == io.Writer(os.Stdout) && p.terminal.SupportsSynchronizedOutput()
 	p.mu.Unlock()
 
 	// Cleanup on exit
-	defer func() {
-		p.mu.Lock()
+	defer p.restoreTerminal()
 
-		// Exit raw mode if we're in it
-		if p.terminal != nil && p.terminal.IsInRawMode() {
-			_ = p.terminal.ExitRawMode() // Best effort cleanup
-		}
-
-		// Exit alt screen if we're in it
-		if p.terminal != nil && p.altScreen && p.terminal.IsInAltScreen() {
-			_ = p.terminal.ExitAltScreen() // Best effort cleanup
-		}
-
-		p.running = false
-		p.mu.Unlock()
-	}()
+	// Recover panics from Init/Update/View (runs before restoreTerminal)
+	if p.recoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				p.stopInputReader()
+				err = newPanicError(r)
+			}
+		}()
+	}
 
 	// Enter raw mode for TUI (best effort - may fail in test environments)
 	p.mu.Lock()
@@ -188,6 +237,7 @@ func (p *Program[T]) Run() error {
 			return fmt.Errorf("failed to enter alt screen: %w", err)
 		}
 	}
+	p.setBracketedPaste(true)
 	p.mu.Unlock()
 
 	// STEP 1: Call Init() to get initial command
@@ -198,61 +248,122 @@ func (p *Program[T]) Run() error {
 	// Start input reader
 	p.startInputReader()
 
-	// STEP 2: Render initial view
-	p.renderView()
+	// STEP 2: Deliver the terminal size, then render initial view
+	width, height, rendered, quit := p.deliverInitialSize()
+	if quit {
+		return nil
+	}
+	if !rendered {
+		p.renderView()
+	}
+
+	// Deliver WindowSizeMsg on every terminal resize
+	stopResize := p.watchResize(width, height)
+	defer stopResize()
+
+	// Frame ticker for throttled rendering (nil channel when unlimited)
+	frames, stopFrames := p.frameTicker()
+	defer stopFrames()
 
 	// STEP 3: EVENT LOOP - THE HEART OF ELM ARCHITECTURE
 	for {
 		select {
 		case msg := <-p.msgCh:
-			// Check for quit
-			if _, isQuit := msg.(model2.QuitMsg); isQuit {
+			if quit := p.handleMsg(msg); quit {
 				return nil // Exit loop
 			}
 
-			// Handle BatchMsg - expand to individual messages
-			if batchMsg, ok := msg.(model2.BatchMsg); ok {
-				for _, m := range batchMsg.Messages {
-					p.msgCh <- m
-				}
-				continue
-			}
+		case <-frames:
+			p.flushRender()
 
-			// Handle SequenceMsg - expand to individual messages (in order)
-			if seqMsg, ok := msg.(model2.SequenceMsg); ok {
-				for _, m := range seqMsg.Messages {
-					p.msgCh <- m
-				}
-				continue
-			}
+		case <-p.quitCh:
+			return nil // External quit signal
 
-			// Intercept WindowSizeMsg to keep inline renderer dimensions current.
-			if sizeMsg, ok := msg.(model2.WindowSizeMsg); ok && !p.altScreen {
-				if p.inlineRenderer != nil {
-					p.inlineRenderer.Resize(sizeMsg.Width, sizeMsg.Height)
-				}
-			}
+		case <-p.ctx.Done():
+			// Embedding application canceled us - release stdin as well,
+			// since the process keeps running after Run returns.
+			p.stopInputReader()
+			return p.ctx.Err()
 
-			// Update model
-			newModel, cmd := p.model.Update(msg)
-			p.model = newModel
+		case panicErr := <-p.panicCh:
+			// A command goroutine panicked (WithRecover)
+			p.stopInputReader()
+			return panicErr
+		}
+	}
+}
 
-			// Execute command (if any)
-			if cmd != nil {
-				p.executeCommand(cmd)
-			}
+// restoreTerminal undoes all terminal changes made by Run/Start and marks
+// the program as stopped. Used as the deferred cleanup of both event loops,
+// so it runs on every exit path (QuitMsg, Stop, context cancellation).
+func (p *Program[T]) restoreTerminal() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-			// Render view
-			p.renderView()
+	p.setBracketedPaste(false)
 
-		case <-p.quitCh:
-			return nil // External quit signal
+	if p.terminal != nil {
+		// Send the cleanup sequences in one write, after any output the
+		// application left buffered
+		_ = p.terminal.SetBuffered(true)
+
+		// Exit raw mode if we're in it
+		if p.terminal.IsInRawMode() {
+			_ = p.terminal.ExitRawMode() // Best effort cleanup
 		}
+
+		// Exit alt screen if we're in it, whether it was entered at start
+		// or with EnterAltScreen
+		if p.terminal.IsInAltScreen() {
+			_ = p.terminal.ExitAltScreen() // Best effort cleanup
+		}
+
+		// Leave the cursor as the user's shell expects it, if it was changed
+		// on the terminal the program draws on
+		if p.output == io.Writer(os.Stdout) {
+			if p.terminal.IsCursorHidden() {
+				_ = p.terminal.ShowCursor() // Best effort cleanup
+			}
+			if p.terminal.IsCursorStyled() {
+				_ = p.terminal.ResetCursorStyle() // Best effort cleanup
+			}
+		}
+
+		_ = p.terminal.SetBuffered(false) // Flushes
 	}
+
+	p.running = false
+}
+
+// Bracketed paste mode toggles (DEC private mode 2004).
+const (
+	enableBracketedPaste  = "\x1b[?2004h"
+	disableBracketedPaste = "\x1b[?2004l"
+)
+
+// cursorPositionQuery asks the terminal for a Cursor Position Report
+// (Device Status Report 6).
+const cursorPositionQuery = "\x1b[6n"
+
+// setBracketedPaste turns bracketed paste mode on or off when the program
+// was configured WithBracketedPaste. Terminals that don't support the mode
+// ignore the sequence and keep sending pasted text as individual keys.
+//
+// Caller must hold p.mu.
+func (p *Program[T]) setBracketedPaste(on bool) {
+	if !p.bracketedPaste || p.output == nil {
+		return
+	}
+	seq := disableBracketedPaste
+	if on {
+		seq = enableBracketedPaste
+	}
+	_, _ = p.output.Write([]byte(seq)) // Best effort
 }
 
 // Start starts the program in a goroutine and returns immediately.
-// Use Stop() to stop the program later.
+// Use Stop() to stop the program later, and Wait() or Err() to learn why it
+// stopped (e.g. a *PanicError with WithRecover).
 //
 // Runs the same event loop as Run(), but in a background goroutine.
 //
@@ -280,25 +391,31 @@ func (p *Program[T]) Start() error {
 	if p.terminal == nil {
 		p.terminal = terminal.New()
 	}
+	p.syncOutput = p.output == io.Writer(os.Stdout) && p.terminal.SupportsSynchronizedOutput()
+	done := make(chan struct{})
+	p.done = done
+	p.startErr = nil
 	p.mu.Unlock()
 
 	go func() {
+		var err error
 		defer func() {
 			p.mu.Lock()
-
-			// Exit raw mode if we're in it
-			if p.terminal != nil && p.terminal.IsInRawMode() {
-				_ = p.terminal.ExitRawMode() // Best effort cleanup
-			}
-
-			// Exit alt screen if we're in it
-			if p.terminal != nil && p.altScreen && p.terminal.IsInAltScreen() {
-				_ = p.terminal.ExitAltScreen() // Best effort cleanup
-			}
-
-			p.running = false
+			p.startErr = err
 			p.mu.Unlock()
+			close(done)
 		}()
+		defer p.restoreTerminal()
+
+		// Recover panics from Init/Update/View (runs before restoreTerminal)
+		if p.recoverPanics {
+			defer func() {
+				if r := recover(); r != nil {
+					p.stopInputReader()
+					err = newPanicError(r)
+				}
+			}()
+		}
 
 		// Enter raw mode for TUI
 		p.mu.Lock()
@@ -312,10 +429,12 @@ func (p *Program[T]) Start() error {
 				if altScreenErr := p.terminal.EnterAltScreen(); altScreenErr != nil {
 					_ = p.terminal.ExitRawMode() // Cleanup raw mode
 					p.mu.Unlock()
+					err = fmt.Errorf("failed to enter alt screen: %w", altScreenErr)
 					return
 				}
 			}
 		}
+		p.setBracketedPaste(true)
 		p.mu.Unlock()
 
 		// Same event loop as Run(), but in goroutine
@@ -326,49 +445,42 @@ func (p *Program[T]) Start() error {
 		// Start input reader
 		p.startInputReader()
 
-		p.renderView()
+		width, height, rendered, quit := p.deliverInitialSize()
+		if quit {
+			return
+		}
+		if !rendered {
+			p.renderView()
+		}
+
+		stopResize := p.watchResize(width, height)
+		defer stopResize()
+
+		frames, stopFrames := p.frameTicker()
+		defer stopFrames()
 
 		for {
 			select {
 			case msg := <-p.msgCh:
-				if _, isQuit := msg.(model2.QuitMsg); isQuit {
+				if quit := p.handleMsg(msg); quit {
 					return
 				}
 
-				// Handle BatchMsg
-				if batchMsg, ok := msg.(model2.BatchMsg); ok {
-					for _, m := range batchMsg.Messages {
-						p.msgCh <- m
-					}
-					continue
-				}
-
-				// Handle SequenceMsg
-				if seqMsg, ok := msg.(model2.SequenceMsg); ok {
-					for _, m := range seqMsg.Messages {
-						p.msgCh <- m
-					}
-					continue
-				}
+			case <-frames:
+				p.flushRender()
 
-				// Intercept WindowSizeMsg to keep inline renderer dimensions current.
-				if sizeMsg, ok := msg.(model2.WindowSizeMsg); ok && !p.altScreen {
-					if p.inlineRenderer != nil {
-						p.inlineRenderer.Resize(sizeMsg.Width, sizeMsg.Height)
-					}
-				}
-
-				// Update
-				newModel, cmd := p.model.Update(msg)
-				p.model = newModel
-
-				if cmd != nil {
-					p.executeCommand(cmd)
-				}
+			case <-p.quitCh:
+				return
 
-				p.renderView()
+			case <-p.ctx.Done():
+				p.stopInputReader()
+				err = p.ctx.Err()
+				return
 
-			case <-p.quitCh:
+			case panicErr := <-p.panicCh:
+				// A command goroutine panicked (WithRecover)
+				p.stopInputReader()
+				err = panicErr
 				return
 			}
 		}
@@ -377,6 +489,30 @@ func (p *Program[T]) Start() error {
 	return nil
 }
 
+// Wait blocks until a program started with Start has stopped and returns
+// why, like Run does: a *PanicError if a panic was recovered (WithRecover),
+// ctx.Err() if its context was canceled (WithContext), or nil after a normal
+// quit or Stop. Returns nil at once if Start was never called.
+func (p *Program[T]) Wait() error {
+	p.mu.Lock()
+	done := p.done
+	p.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+	<-done
+	return p.Err()
+}
+
+// Err returns why a program started with Start stopped (see Wait), or nil
+// while it is still running.
+func (p *Program[T]) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.startErr
+}
+
 // Stop stops a running program gracefully.
 // Blocks until the program has fully stopped.
 //
@@ -444,6 +580,15 @@ func (p *Program[T]) Quit() {
 	}
 }
 
+// Model returns the program's current model.
+//
+// The model is owned by the event loop, so this is only safe to call when
+// the program is not running - typically right after Run returns, to obtain
+// the final model.
+func (p *Program[T]) Model() model2.Model[T] {
+	return p.model
+}
+
 // IsRunning returns true if the program is currently running.
 func (p *Program[T]) IsRunning() bool {
 	p.mu.Lock()
@@ -480,10 +625,253 @@ func (p *Program[T]) Send(msg model2.Msg) error {
 	}
 }
 
+// handleMsg processes a single message received by the event loop.
+// Shared by Run() and Start() so both loops behave identically.
+//
+// Returns true if the program should quit.
+func (p *Program[T]) handleMsg(msg model2.Msg) bool {
+	p.logMsg(msg)
+
+	// Handle BatchMsg - expand to individual messages
+	if batchMsg, ok := msg.(model2.BatchMsg); ok {
+		for _, m := range batchMsg.Messages {
+			p.msgCh <- m
+		}
+		return false
+	}
+
+	// Handle SequenceMsg - expand to individual messages (in order)
+	if seqMsg, ok := msg.(model2.SequenceMsg); ok {
+		for _, m := range seqMsg.Messages {
+			p.msgCh <- m
+		}
+		return false
+	}
+
+	// Handle RepeatMsg - schedule the next occurrence, then deliver the payload
+	if repeatMsg, ok := msg.(model2.RepeatMsg); ok {
+		if repeatMsg.Next != nil {
+			p.executeCommand(repeatMsg.Next)
+		}
+		if repeatMsg.Msg == nil {
+			return false
+		}
+		return p.handleMsg(repeatMsg.Msg)
+	}
+
+	// Apply filter (may transform or drop the message)
+	if p.filter != nil {
+		msg = p.filter(p.model, msg)
+		if msg == nil {
+			return false
+		}
+	}
+
+	// Check for quit
+	if _, isQuit := msg.(model2.QuitMsg); isQuit {
+		p.flushRender() // Don't lose the last throttled frame
+		return true
+	}
+
+	// Handle PrintlnMsg - print above the view instead of calling Update
+	if printMsg, ok := msg.(service.PrintlnMsg); ok {
+		p.printAbove(printMsg.Message)
+		return false
+	}
+
+	// Handle SuspendMsg - stop the process until the shell continues it
+	if _, ok := msg.(service.SuspendMsg); ok {
+		p.suspendProcess()
+		return p.handleMsg(model2.ResumeMsg{})
+	}
+
+	// Handle EnterAltScreenMsg/ExitAltScreenMsg - switch screen buffers
+	if _, ok := msg.(service.EnterAltScreenMsg); ok {
+		p.setAltScreen(true)
+		return false
+	}
+	if _, ok := msg.(service.ExitAltScreenMsg); ok {
+		p.setAltScreen(false)
+		return false
+	}
+
+	// Handle ReadStdinMsg - read piped stdin, which the input reader leaves alone
+	if readMsg, ok := msg.(service.ReadStdinMsg); ok {
+		p.executeCommand(service.ReadFile(p.stdin(), readMsg.Fn))
+		return false
+	}
+
+	// Handle DebounceMsg - claim the key and run the command after the delay
+	if debounceMsg, ok := msg.(service.DebounceMsg); ok {
+		if cmd := p.debounces.Schedule(debounceMsg); cmd != nil {
+			p.executeCommand(cmd)
+		}
+		return false
+	}
+
+	// Handle StartTickerMsg/CancelTickerMsg - start or stop a named ticker
+	if startMsg, ok := msg.(service.StartTickerMsg); ok {
+		if cmd := p.tickers.Start(startMsg); cmd != nil {
+			p.executeCommand(cmd)
+		}
+		return false
+	}
+	if cancelMsg, ok := msg.(service.CancelTickerMsg); ok {
+		p.tickers.Cancel(cancelMsg)
+		return false
+	}
+
+	// Handle RequestCursorPositionMsg - the input reader delivers the reply
+	if _, ok := msg.(service.RequestCursorPositionMsg); ok {
+		p.requestCursorPosition()
+		return false
+	}
+
+	// Handle ExecMsg - run the process with the terminal released
+	if execMsg, ok := msg.(service.ExecMsg); ok {
+		err := p.ExecProcess(execMsg.Cmd)
+		if execMsg.Callback == nil {
+			return false
+		}
+		if result := execMsg.Callback(err); result != nil {
+			return p.handleMsg(result)
+		}
+		return false
+	}
+
+	// Intercept WindowSizeMsg to keep inline renderer dimensions current,
+	// also in alt-screen mode, which the program may leave at runtime.
+	if sizeMsg, ok := msg.(model2.WindowSizeMsg); ok {
+		if p.inlineRenderer != nil {
+			p.inlineRenderer.Resize(sizeMsg.Width, sizeMsg.Height)
+		}
+	}
+
+	// Update model
+	newModel, cmd := p.model.Update(msg)
+	p.model = newModel
+	p.logCmd(cmd)
+
+	// Execute command (if any)
+	if cmd != nil {
+		p.executeCommand(cmd)
+	}
+
+	// Render view (immediately, or on the next frame when throttled)
+	p.scheduleRender()
+
+	return false
+}
+
+// setAltScreen switches between the alternate screen buffer and inline
+// rendering at runtime (see service.EnterAltScreen), then draws the view in
+// full: the previous frame belongs to the other buffer.
+//
+// Leaving the alt screen brings back the normal buffer as it was, including
+// any inline frame drawn before entering, so the inline renderer repaints
+// over that frame.
+func (p *Program[T]) setAltScreen(on bool) {
+	if p.altScreen == on {
+		return
+	}
+	p.flushRender() // Finish the pending frame in the buffer it belongs to
+
+	p.mu.Lock()
+	if p.terminal == nil {
+		p.terminal = terminal.New()
+	}
+	if on {
+		if err := p.terminal.EnterAltScreen(); err != nil {
+			p.mu.Unlock()
+			return // Stay inline rather than draw into the wrong buffer
+		}
+		_ = p.terminal.Clear() // Start the first frame at the top-left corner
+	} else if p.terminal.IsInAltScreen() {
+		if err := p.terminal.ExitAltScreen(); err != nil {
+			p.mu.Unlock()
+			return
+		}
+	}
+	p.altScreen = on
+	p.mu.Unlock()
+
+	if !on && p.inlineRenderer != nil {
+		p.inlineRenderer.Repaint()
+	}
+	p.renderView()
+}
+
+// printAbove writes text above the inline view (see service.Println).
+//
+// In alt-screen mode there is no scrollback to print into, so the text is
+// discarded. After printing the view is re-rendered immediately, because
+// PrintAbove erased the previous frame.
+func (p *Program[T]) printAbove(text string) {
+	if p.altScreen {
+		return
+	}
+	p.flushRender() // Bring the frame up to date before it moves down
+	_ = p.inline().PrintAbove(text)
+	p.renderView()
+}
+
+// suspendProcess releases the terminal, stops the process until the shell
+// continues it, and restores the terminal state saved by Suspend.
+func (p *Program[T]) suspendProcess() {
+	p.flushRender() // Leave the latest frame on screen
+	if err := p.Suspend(); err != nil {
+		return // Terminal untouched - don't stop with a broken screen
+	}
+	stopProcess()
+	_ = p.Resume() // Best effort
+}
+
+// frameTicker returns the channel that drives throttled rendering and a
+// function to stop it. With no FPS cap the channel is nil, so its select
+// case never fires and every Update renders immediately.
+func (p *Program[T]) frameTicker() (<-chan time.Time, func()) {
+	if p.maxFPS <= 0 {
+		return nil, func() {}
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(p.maxFPS))
+	return ticker.C, ticker.Stop
+}
+
+// scheduleRender renders now when unthrottled, otherwise marks the view
+// dirty so the next frame tick renders it once for all pending Updates.
+func (p *Program[T]) scheduleRender() {
+	if p.maxFPS <= 0 {
+		p.renderView()
+		return
+	}
+	p.renderPending = true
+}
+
+// flushRender renders the view if an Update happened since the last frame.
+func (p *Program[T]) flushRender() {
+	if !p.renderPending {
+		return
+	}
+	p.renderPending = false
+	p.renderView()
+}
+
 // executeCommand runs a command in a goroutine and sends result to msgCh.
+// Commands that produce a nil message (e.g. a cancelled ticker) send nothing.
 func (p *Program[T]) executeCommand(cmd model2.Cmd) {
 	go func() {
+		if p.recoverPanics {
+			defer func() {
+				if r := recover(); r != nil {
+					p.reportPanic(newPanicError(r))
+				}
+			}()
+		}
+
 		msg := cmd() // Execute command (may block)
+		if msg == nil {
+			return
+		}
 
 		// Send result back to event loop
 		select {
@@ -502,25 +890,36 @@ func (p *Program[T]) executeCommand(cmd model2.Cmd) {
 //
 // In alt-screen mode a plain write is used; the alt-screen renderer will be
 // integrated in a future release.
+//
+// If the terminal supports synchronized output, each frame is wrapped in
+// DEC mode 2026 sequences so it is drawn at once, without tearing.
 func (p *Program[T]) renderView() {
 	view := p.model.View()
 
 	if !p.altScreen {
-		// Lazily initialize the inline renderer on the first call.
-		// Width/height start at 0 (disables truncation/clipping) until
-		// a WindowSizeMsg updates the dimensions.
-		if p.inlineRenderer == nil {
-			p.inlineRenderer = renderer.NewInlineRenderer(p.output, 0, 0)
-		}
 		// Render errors are non-fatal (e.g. write to closed pipe during tests).
-		_ = p.inlineRenderer.Render(view)
+		_ = p.inline().Render(view)
 		return
 	}
 
 	// Alt-screen mode: plain write (cursor is at absolute position after \x1b[H).
+	if p.syncOutput {
+		view = renderer.Synchronized(view)
+	}
 	_, _ = p.output.Write([]byte(view))
 }
 
+// inline returns the inline renderer, creating it on first use.
+// Width/height start at 0 (disables truncation/clipping) until
+// a WindowSizeMsg updates the dimensions.
+func (p *Program[T]) inline() *renderer.InlineRenderer {
+	if p.inlineRenderer == nil {
+		p.inlineRenderer = renderer.NewInlineRenderer(p.output, 0, 0)
+		p.inlineRenderer.SetSynchronized(p.syncOutput)
+	}
+	return p.inlineRenderer
+}
+
 // startInputReader starts reading input in a goroutine.
 // Creates a new goroutine with cancellation support for ExecProcess.
 //
@@ -528,8 +927,19 @@ func (p *Program[T]) renderView() {
 // cannot be reused after Cancel() - it permanently returns EOF.
 func (p *Program[T]) startInputReader() {
 	// Always create a new Reader (CancelableReader cannot be reused after Cancel)
-	// This ensures fresh state after ExecProcess
-	p.inputReader = input.NewReader(p.input)
+	// This ensures fresh state after ExecProcess. Input the terminal read
+	// while waiting for a reply (e.g. GetCursorPosition) is parsed first.
+	var pending []byte
+	if p.terminal != nil {
+		pending = p.terminal.PendingInput()
+	}
+	// Piped input carries data for ReadStdin, not keystrokes: leave it unread.
+	src := p.input
+	if p.pipedInput() != nil {
+		src = strings.NewReader("")
+	}
+	p.inputReader = input.NewReaderWithPending(src, pending)
+	p.inputReader.TrackCursorRequests(&p.cursorRequests)
 
 	// Create cancellation context for this inputReader goroutine
 	ctx, cancel := context.WithCancel(context.Background())
@@ -592,6 +1002,33 @@ func (p *Program[T]) startInputReader() {
 	}()
 }
 
+// pipedInput returns the program input if it is a file that is not a
+// terminal (`cat file | mytool`), or nil.
+func (p *Program[T]) pipedInput() *os.File {
+	if f, ok := p.input.(*os.File); ok && !term.IsTerminal(int(f.Fd())) {
+		return f
+	}
+	return nil
+}
+
+// stdin returns the file ReadStdin reads: the piped program input, or
+// os.Stdin when input comes from elsewhere (e.g. WithInput(tty)).
+func (p *Program[T]) stdin() *os.File {
+	if f := p.pipedInput(); f != nil {
+		return f
+	}
+	return os.Stdin
+}
+
+// requestCursorPosition asks the terminal for the cursor position (DSR 6).
+// The reply is read by the input reader and arrives as CursorPositionMsg,
+// so it is never raced for by a second reader of stdin.
+func (p *Program[T]) requestCursorPosition() {
+	p.flushRender() // Report the position after the latest frame
+	p.cursorRequests.Add(1)
+	_, _ = p.output.Write([]byte(cursorPositionQuery))
+}
+
 // stopInputReader gracefully stops the inputReader goroutine.
 // Blocks until the goroutine has fully exited.
 // Safe to call even if inputReader is not running.
@@ -726,7 +1163,8 @@ func (p *Program[T]) Suspend() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	// STEP 2: Save current terminal state
+	// STEP 2: Save current terminal state (after pushing out pending output)
+	_ = p.terminal.Flush()
 	state := &suspendedState{
 		wasInRawMode:   p.terminal.IsInRawMode(),
 		wasInAltScreen: p.terminal.IsInAltScreen(),
@@ -763,6 +1201,10 @@ func (p *Program[T]) Suspend() error {
 		// Non-fatal - continue anyway
 		// Some terminals may not support cursor control
 	}
+	_ = p.terminal.ResetCursorStyle() // External commands expect the default shape
+
+	// External commands expect plain pastes
+	p.setBracketedPaste(false)
 
 	// Mark as suspended and save state
 	p.suspended = true
@@ -835,6 +1277,7 @@ func (p *Program[T]) Resume() error {
 			return fmt.Errorf("resume: failed to restore raw mode: %w", err)
 		}
 	}
+	p.setBracketedPaste(true)
 
 	// Clear suspended state
 	p.suspended = false
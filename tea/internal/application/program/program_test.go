@@ -689,3 +689,37 @@ func TestProgram_EventLoop_Run(t *testing.T) {
 		t.Errorf("view should have been rendered, got: %s", output)
 	}
 }
+
+// TestProgram_RenderView_Synchronized verifies frames are wrapped in
+// synchronized output sequences when the terminal supports them.
+func TestProgram_RenderView_Synchronized(t *testing.T) {
+	for _, altScreen := range []bool{false, true} {
+		var out bytes.Buffer
+		p := New(TestModel{}, WithOutput[TestModel](&out))
+		p.altScreen = altScreen
+		p.syncOutput = true
+
+		p.renderView()
+
+		got := out.String()
+		if !strings.HasPrefix(got, "\x1b[?2026h") || !strings.HasSuffix(got, "\x1b[?2026l") {
+			t.Errorf("altScreen=%v: frame not synchronized: %q", altScreen, got)
+		}
+		if !strings.Contains(got, "Value: 0") {
+			t.Errorf("altScreen=%v: frame missing view: %q", altScreen, got)
+		}
+	}
+}
+
+// TestProgram_RenderView_NotSynchronized verifies frames are written as-is
+// by default.
+func TestProgram_RenderView_NotSynchronized(t *testing.T) {
+	var out bytes.Buffer
+	p := New(TestModel{}, WithOutput[TestModel](&out), WithAltScreen[TestModel]())
+
+	p.renderView()
+
+	if strings.Contains(out.String(), "2026") {
+		t.Errorf("frame should not be synchronized: %q", out.String())
+	}
+}
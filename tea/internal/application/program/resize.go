@@ -0,0 +1,68 @@
+package program
+
+import (
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// resizeDebounce is how long the terminal must stay quiet after a resize
+// notification before the size is re-queried. Dragging a window edge fires
+// dozens of notifications per second; only the final size matters.
+const resizeDebounce = 50 * time.Millisecond
+
+// deliverInitialSize queries the terminal size and passes it to Update as a
+// WindowSizeMsg before the first View, so components are never rendered at a
+// bogus default size.
+//
+// Returns the delivered size, whether the view was rendered as part of
+// handling it (0, 0, false if the size is unavailable, e.g. not a TTY), and
+// whether the model quit in response, in which case the caller must stop.
+func (p *Program[T]) deliverInitialSize() (width, height int, rendered, quit bool) {
+	width, height, err := p.terminal.Size()
+	if err != nil || width <= 0 || height <= 0 {
+		return 0, 0, false, false
+	}
+	quit = p.handleMsg(model2.WindowSizeMsg{Width: width, Height: height})
+	p.flushRender() // Don't wait for the first frame tick when throttled
+	return width, height, true, quit
+}
+
+// watchResize delivers a fresh WindowSizeMsg whenever the terminal is resized,
+// starting from the last known size width x height.
+//
+// Notifications come from SIGWINCH on Unix and from polling the console on
+// Windows (see resizeEvents). Bursts are debounced and a message is sent only
+// if the size actually changed. Returns a function that stops watching.
+func (p *Program[T]) watchResize(width, height int) (stop func()) {
+	done := make(chan struct{})
+	events := resizeEvents(done)
+
+	go func() {
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-done:
+				return
+
+			case <-events:
+				debounce = time.After(resizeDebounce) // Restart the quiet period
+
+			case <-debounce:
+				debounce = nil
+				w, h, err := p.terminal.Size()
+				if err != nil || w <= 0 || h <= 0 || (w == width && h == height) {
+					continue
+				}
+				width, height = w, h
+				select {
+				case p.msgCh <- model2.WindowSizeMsg{Width: w, Height: h}:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
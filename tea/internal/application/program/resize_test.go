@@ -0,0 +1,133 @@
+//go:build !windows
+
+package program
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// resizableTerminal is a MockTerminal whose size can be changed by the test.
+type resizableTerminal struct {
+	*phoenixtesting.MockTerminal
+	mu            sync.Mutex
+	width, height int
+}
+
+func (r *resizableTerminal) Size() (width, height int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.width, r.height, nil
+}
+
+func (r *resizableTerminal) resize(width, height int) {
+	r.mu.Lock()
+	r.width, r.height = width, height
+	r.mu.Unlock()
+}
+
+func sizeMsgs(msgs []model2.Msg) []model2.WindowSizeMsg {
+	var sizes []model2.WindowSizeMsg
+	for _, msg := range msgs {
+		if size, ok := msg.(model2.WindowSizeMsg); ok {
+			sizes = append(sizes, size)
+		}
+	}
+	return sizes
+}
+
+// TestProgram_InitialSize verifies the terminal size reaches Update before the first View.
+func TestProgram_InitialSize(t *testing.T) {
+	term := &resizableTerminal{MockTerminal: phoenixtesting.NewMockTerminal(), width: 120, height: 40}
+
+	var msgs []model2.Msg
+	p := New(
+		recordingModel{msgs: &msgs},
+		WithTerminal[recordingModel](term),
+		WithInput[recordingModel](strings.NewReader("")),
+		WithOutput[recordingModel](&bytes.Buffer{}),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(30 * time.Millisecond)
+	p.Stop()
+
+	require.NotEmpty(t, msgs)
+	assert.Equal(t, model2.WindowSizeMsg{Width: 120, Height: 40}, msgs[0], "size should be the first message")
+}
+
+// TestProgram_InitialSize_Quit verifies the program stops when the initial
+// WindowSizeMsg makes it quit.
+func TestProgram_InitialSize_Quit(t *testing.T) {
+	term := &resizableTerminal{MockTerminal: phoenixtesting.NewMockTerminal(), width: 120, height: 40}
+
+	var msgs []model2.Msg
+	p := New(
+		recordingModel{msgs: &msgs},
+		WithTerminal[recordingModel](term),
+		WithInput[recordingModel](strings.NewReader("")),
+		WithOutput[recordingModel](&bytes.Buffer{}),
+		WithFilter[recordingModel](func(_ model2.Model[recordingModel], msg model2.Msg) model2.Msg {
+			if _, ok := msg.(model2.WindowSizeMsg); ok {
+				return model2.QuitMsg{}
+			}
+			return msg
+		}),
+	)
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run() }()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		p.Stop()
+		t.Fatal("Run() kept running after quitting on the initial size")
+	}
+	assert.Empty(t, msgs, "Update should not be called")
+}
+
+// TestProgram_ResizeSignal verifies SIGWINCH delivers one debounced WindowSizeMsg.
+func TestProgram_ResizeSignal(t *testing.T) {
+	term := &resizableTerminal{MockTerminal: phoenixtesting.NewMockTerminal(), width: 80, height: 24}
+
+	var msgs []model2.Msg
+	p := New(
+		recordingModel{msgs: &msgs},
+		WithTerminal[recordingModel](term),
+		WithInput[recordingModel](strings.NewReader("")),
+		WithOutput[recordingModel](&bytes.Buffer{}),
+	)
+
+	require.NoError(t, p.Start())
+	time.Sleep(30 * time.Millisecond)
+
+	// A burst of resize signals (window edge being dragged)
+	term.resize(100, 30)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGWINCH))
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	// Signal without an actual size change
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGWINCH))
+	time.Sleep(150 * time.Millisecond)
+	p.Stop()
+
+	assert.Equal(t, []model2.WindowSizeMsg{
+		{Width: 80, Height: 24},
+		{Width: 100, Height: 30},
+	}, sizeMsgs(msgs))
+}
@@ -0,0 +1,33 @@
+//go:build !windows
+
+package program
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// resizeEvents returns a channel that receives a value on every SIGWINCH,
+// until done is closed.
+func resizeEvents(done <-chan struct{}) <-chan struct{} {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGWINCH)
+
+	events := make(chan struct{}, 1)
+	go func() {
+		defer signal.Stop(sig)
+		for {
+			select {
+			case <-done:
+				return
+			case <-sig:
+				select {
+				case events <- struct{}{}:
+				default: // A notification is already pending
+				}
+			}
+		}
+	}()
+	return events
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package program
+
+import "time"
+
+// resizePollInterval is how often the console size is re-checked on Windows.
+const resizePollInterval = 250 * time.Millisecond
+
+// resizeEvents returns a channel that receives a value periodically, until
+// done is closed.
+//
+// Windows has no SIGWINCH, and WINDOW_BUFFER_SIZE_EVENT records arrive through
+// the same console input queue the input reader consumes, so the size is
+// polled instead. watchResize only sends a message when it actually changed.
+func resizeEvents(done <-chan struct{}) <-chan struct{} {
+	events := make(chan struct{}, 1)
+	go func() {
+		ticker := time.NewTicker(resizePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				select {
+				case events <- struct{}{}:
+				default: // A notification is already pending
+				}
+			}
+		}
+	}()
+	return events
+}
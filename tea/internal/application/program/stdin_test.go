@@ -0,0 +1,89 @@
+package program
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// pipedModel reads stdin some time after start, giving a key reader on the
+// pipe every chance to consume the data first, and quits once it arrives.
+type pipedModel struct {
+	msgs *[]model2.Msg
+}
+
+type startReadMsg struct{}
+
+type pipedTextMsg struct {
+	text string
+}
+
+func (m pipedModel) Init() model2.Cmd {
+	return func() model2.Msg {
+		time.Sleep(50 * time.Millisecond)
+		return startReadMsg{}
+	}
+}
+
+func (m pipedModel) Update(msg model2.Msg) (model2.Model[pipedModel], model2.Cmd) {
+	*m.msgs = append(*m.msgs, msg)
+	switch msg.(type) {
+	case startReadMsg:
+		return m, service.ReadStdin(func(s string) model2.Msg { return pipedTextMsg{text: s} })
+	case pipedTextMsg:
+		return m, func() model2.Msg { return model2.QuitMsg{} }
+	}
+	return m, nil
+}
+
+func (m pipedModel) View() string {
+	return ""
+}
+
+// TestProgram_ReadStdin_Pipe verifies a program whose stdin is a pipe hands
+// all piped bytes to ReadStdin instead of parsing them as keys.
+func TestProgram_ReadStdin_Pipe(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+
+	var msgs []model2.Msg
+	p := New(
+		pipedModel{msgs: &msgs},
+		WithTerminal[pipedModel](phoenixtesting.NewMockTerminal()),
+		WithInput[pipedModel](r),
+		WithOutput[pipedModel](&bytes.Buffer{}),
+	)
+
+	_, _ = w.WriteString("q\x1b[A line 1\nline 2\n")
+	require.NoError(t, w.Close())
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run() }()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		p.Quit()
+		t.Fatal("program did not receive piped input")
+	}
+
+	var texts []string
+	for _, msg := range msgs {
+		_, isKey := msg.(model2.KeyMsg)
+		assert.False(t, isKey, "piped bytes must not arrive as keys: %v", msg)
+		if piped, ok := msg.(pipedTextMsg); ok {
+			texts = append(texts, piped.text)
+		}
+	}
+	assert.Equal(t, []string{"q\x1b[A line 1\nline 2\n"}, texts)
+}
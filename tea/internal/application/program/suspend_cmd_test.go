@@ -0,0 +1,117 @@
+package program
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+	"github.com/phoenix-tui/phoenix/tea/internal/domain/service"
+	phoenixtesting "github.com/phoenix-tui/phoenix/testing"
+)
+
+// recordingModel records every message delivered to Update.
+type recordingModel struct {
+	msgs *[]model2.Msg
+}
+
+func (m recordingModel) Init() model2.Cmd { return nil }
+
+func (m recordingModel) Update(msg model2.Msg) (model2.Model[recordingModel], model2.Cmd) {
+	*m.msgs = append(*m.msgs, msg)
+	return m, nil
+}
+
+func (m recordingModel) View() string { return "view" }
+
+func newRecordingProgram(mockTerm *phoenixtesting.MockTerminal, msgs *[]model2.Msg) *Program[recordingModel] {
+	return New(
+		recordingModel{msgs: msgs},
+		WithTerminal[recordingModel](mockTerm),
+		WithAltScreen[recordingModel](),
+		WithInput[recordingModel](strings.NewReader("")),
+		WithOutput[recordingModel](&bytes.Buffer{}),
+	)
+}
+
+// TestProgram_SuspendMsg verifies the terminal is released while stopped,
+// restored afterwards, and ResumeMsg is delivered to Update.
+func TestProgram_SuspendMsg(t *testing.T) {
+	var stoppedInRawMode, stoppedInAltScreen bool
+	mockTerm := phoenixtesting.NewMockTerminal()
+
+	original := stopProcess
+	stopProcess = func() {
+		stoppedInRawMode = mockTerm.IsInRawMode()
+		stoppedInAltScreen = mockTerm.IsInAltScreen()
+	}
+	defer func() { stopProcess = original }()
+
+	var msgs []model2.Msg
+	p := newRecordingProgram(mockTerm, &msgs)
+	require.NoError(t, mockTerm.EnterRawMode())
+	require.NoError(t, mockTerm.EnterAltScreen())
+
+	quit := p.handleMsg(service.SuspendMsg{})
+
+	assert.False(t, quit)
+	assert.False(t, stoppedInRawMode, "raw mode should be off while stopped")
+	assert.False(t, stoppedInAltScreen, "alt screen should be off while stopped")
+	assert.True(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	assert.True(t, mockTerm.IsInAltScreen(), "alt screen should be restored")
+	assert.False(t, p.IsSuspended())
+	require.Len(t, msgs, 1, "only ResumeMsg should reach Update")
+	assert.IsType(t, model2.ResumeMsg{}, msgs[0])
+}
+
+// TestProgram_ExecMsg verifies the process runs and the callback result reaches Update.
+func TestProgram_ExecMsg(t *testing.T) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", "exit", "1")
+	} else {
+		cmd = exec.Command("sh", "-c", "exit 1")
+	}
+
+	type doneMsg struct{ err error }
+
+	mockTerm := phoenixtesting.NewMockTerminal()
+	var msgs []model2.Msg
+	p := newRecordingProgram(mockTerm, &msgs)
+	require.NoError(t, mockTerm.EnterRawMode())
+
+	quit := p.handleMsg(service.ExecMsg{
+		Cmd:      cmd,
+		Callback: func(err error) model2.Msg { return doneMsg{err: err} },
+	})
+
+	assert.False(t, quit)
+	assert.True(t, mockTerm.IsInRawMode(), "raw mode should be restored")
+	require.Len(t, msgs, 1)
+	done, ok := msgs[0].(doneMsg)
+	require.True(t, ok, "callback result should reach Update, got %T", msgs[0])
+	var exitErr *exec.ExitError
+	assert.True(t, errors.As(done.err, &exitErr), "callback should receive the exit error")
+}
+
+// TestProgram_ExecMsg_NilCallback verifies Exec without a callback delivers nothing.
+func TestProgram_ExecMsg_NilCallback(t *testing.T) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", "echo", "test")
+	} else {
+		cmd = exec.Command("true")
+	}
+
+	var msgs []model2.Msg
+	p := newRecordingProgram(phoenixtesting.NewMockTerminal(), &msgs)
+
+	assert.False(t, p.handleMsg(service.ExecMsg{Cmd: cmd}))
+	assert.Empty(t, msgs)
+}
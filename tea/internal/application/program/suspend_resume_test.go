@@ -33,6 +33,7 @@ func TestProgram_Suspend_Basic(t *testing.T) {
 	// Verify terminal restored to cooked mode.
 	assert.False(t, mockTerm.IsInRawMode(), "Should exit raw mode")
 	assert.Greater(t, mockTerm.CallCount("ShowCursor"), 0, "ShowCursor should be called during Suspend")
+	assert.Greater(t, mockTerm.CallCount("ResetCursorStyle"), 0, "ResetCursorStyle should be called during Suspend")
 }
 
 // TestProgram_Suspend_Idempotent verifies Suspend is idempotent.
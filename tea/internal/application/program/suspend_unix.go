@@ -0,0 +1,24 @@
+//go:build !windows
+
+package program
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// stopProcess stops the process group with SIGTSTP (like Ctrl+Z in cooked
+// mode) and blocks until the shell continues it with SIGCONT.
+//
+// Package variable so tests can replace it without stopping the test binary.
+var stopProcess = func() {
+	cont := make(chan os.Signal, 1)
+	signal.Notify(cont, syscall.SIGCONT)
+	defer signal.Stop(cont)
+
+	if err := syscall.Kill(0, syscall.SIGTSTP); err != nil {
+		return // Can't stop - resume immediately
+	}
+	<-cont
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package program
+
+// stopProcess is a no-op on Windows, which has no job control.
+// The terminal is released and immediately restored.
+//
+// Package variable so tests can replace it.
+var stopProcess = func() {}
@@ -1,6 +1,9 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"runtime/debug"
+)
 
 // Cmd is a function that produces a message asynchronously.
 //
@@ -41,6 +44,8 @@ type Cmd func() Msg
 //	) // All three run concurrently
 //
 // The order of messages in BatchMsg is undefined since commands run in parallel.
+// A panic in one of the commands is raised again by the returned command as
+// a CommandPanic carrying the stack of the command that panicked.
 func Batch(cmds ...Cmd) Cmd {
 	// Filter out nil commands
 	filtered := make([]Cmd, 0, len(cmds))
@@ -62,19 +67,36 @@ func Batch(cmds ...Cmd) Cmd {
 
 	// Multiple commands: run in parallel
 	return func() Msg {
-		results := make(chan Msg, len(filtered))
+		type result struct {
+			msg      Msg
+			panicked *CommandPanic
+		}
+		// Buffered so commands still running never block on a send.
+		results := make(chan result, len(filtered))
 
 		// Launch all commands in parallel
 		for _, cmd := range filtered {
 			go func(c Cmd) {
-				results <- c()
+				defer func() {
+					if r := recover(); r != nil {
+						p := RecoveredPanic(r, debug.Stack())
+						results <- result{panicked: &p}
+					}
+				}()
+				results <- result{msg: c()}
 			}(cmd)
 		}
 
 		// Collect all results
 		msgs := make([]Msg, 0, len(filtered))
 		for i := 0; i < len(filtered); i++ {
-			msgs = append(msgs, <-results)
+			res := <-results
+			if res.panicked != nil {
+				// Surface in the goroutine running the batch (see
+				// WithRecover), with the stack of the command.
+				panic(*res.panicked)
+			}
+			msgs = append(msgs, res.msg)
 		}
 
 		return BatchMsg{Messages: msgs}
@@ -187,3 +209,20 @@ type SequenceMsg struct {
 func (s SequenceMsg) String() string {
 	return fmt.Sprintf("sequence (%d messages)", len(s.Messages))
 }
+
+// RepeatMsg wraps a message produced by a self-sustaining command together
+// with the command that produces the next occurrence.
+//
+// The event loop delivers Msg to Update and then schedules Next, so repeating
+// commands (e.g. service.Every) keep firing without the model re-issuing them.
+// service.WithTimeout uses Next for a follow-up command that doesn't repeat.
+// Update never sees RepeatMsg itself - only the wrapped Msg.
+type RepeatMsg struct {
+	Msg  Msg
+	Next Cmd
+}
+
+// String returns a human-readable representation.
+func (r RepeatMsg) String() string {
+	return fmt.Sprintf("repeat (%T)", r.Msg)
+}
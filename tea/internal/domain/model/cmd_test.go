@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -211,6 +212,23 @@ func TestBatch_Parallelism(t *testing.T) {
 	}
 }
 
+func batchPanicCmd() Msg { panic("boom") }
+
+// TestBatch_Panic verifies a panic in a batched command is raised again in
+// the goroutine running the batch, with the stack of the command.
+func TestBatch_Panic(t *testing.T) {
+	cmd := Batch(func() Msg { return "ok" }, batchPanicCmd)
+
+	defer func() {
+		p, ok := recover().(CommandPanic)
+		if !ok || p.Value != "boom" || !strings.Contains(string(p.Stack), "batchPanicCmd") {
+			t.Errorf("recovered %v with stack:\n%s", p.Value, p.Stack)
+		}
+	}()
+	cmd()
+	t.Error("batched panic was swallowed")
+}
+
 // TestBatchMsg_String verifies BatchMsg.String() format.
 func TestBatchMsg_String(t *testing.T) {
 	tests := []struct {
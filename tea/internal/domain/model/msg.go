@@ -176,6 +176,8 @@ const (
 	MouseButtonRight
 	MouseButtonWheelUp
 	MouseButtonWheelDown
+	MouseButtonWheelLeft  // Horizontal wheel / trackpad scroll left
+	MouseButtonWheelRight // Horizontal wheel / trackpad scroll right
 )
 
 // MouseAction represents what the mouse did.
@@ -214,6 +216,10 @@ func mouseButtonName(mb MouseButton) string {
 		return "wheel up"
 	case MouseButtonWheelDown:
 		return "wheel down"
+	case MouseButtonWheelLeft:
+		return "wheel left"
+	case MouseButtonWheelRight:
+		return "wheel right"
 	default:
 		return unknownKeyName
 	}
@@ -250,7 +256,7 @@ func (m MouseMsg) String() string {
 	}
 
 	// For wheel events, the action is implicit
-	if m.Button == MouseButtonWheelUp || m.Button == MouseButtonWheelDown {
+	if m.Button >= MouseButtonWheelUp && m.Button <= MouseButtonWheelRight {
 		return fmt.Sprintf("%s at (%d, %d)", buttonName, m.X, m.Y)
 	}
 
@@ -285,3 +291,47 @@ type QuitMsg struct{}
 func (q QuitMsg) String() string {
 	return "quit"
 }
+
+// ResumeMsg is delivered to Update when the program regains control of the
+// terminal after being suspended (see service.Suspend).
+//
+// The terminal has already been restored when it arrives; models can use it
+// to refresh state that may have changed while the program was stopped.
+type ResumeMsg struct{}
+
+// String returns a human-readable representation.
+func (r ResumeMsg) String() string {
+	return "resume"
+}
+
+// PasteMsg carries text pasted by the user while bracketed paste mode is enabled.
+//
+// Instead of a flood of KeyMsgs (where a newline would look like Enter),
+// the whole pasted chunk arrives at once so components can insert it
+// atomically. Line endings are normalized to "\n".
+type PasteMsg struct {
+	Text string
+}
+
+// String returns a human-readable representation.
+//
+// Example:
+//   - PasteMsg{Text: "hello"} → "paste: 5 bytes"
+func (p PasteMsg) String() string {
+	return fmt.Sprintf("paste: %d bytes", len(p.Text))
+}
+
+// CursorPositionMsg carries the cursor position reported by the terminal in
+// reply to a cursor position request. X and Y are 0-based.
+type CursorPositionMsg struct {
+	X int // Column
+	Y int // Row
+}
+
+// String returns a human-readable representation.
+//
+// Example:
+//   - CursorPositionMsg{X: 4, Y: 2} → "cursor position: (4, 2)"
+func (c CursorPositionMsg) String() string {
+	return fmt.Sprintf("cursor position: (%d, %d)", c.X, c.Y)
+}
@@ -373,6 +373,16 @@ func TestMouseMsg_String(t *testing.T) {
 			mouse: MouseMsg{X: 10, Y: 10, Button: MouseButtonWheelDown, Action: MouseActionPress},
 			want:  "wheel down at (10, 10)",
 		},
+		{
+			name:  "wheel left",
+			mouse: MouseMsg{X: 3, Y: 4, Button: MouseButtonWheelLeft, Action: MouseActionPress},
+			want:  "wheel left at (3, 4)",
+		},
+		{
+			name:  "wheel right",
+			mouse: MouseMsg{X: 3, Y: 4, Button: MouseButtonWheelRight, Action: MouseActionPress, Shift: true},
+			want:  "wheel right at (3, 4)",
+		},
 		{
 			name:  "wheel up at position",
 			mouse: MouseMsg{X: 25, Y: 12, Button: MouseButtonWheelUp, Action: MouseActionPress},
@@ -754,6 +764,8 @@ func TestMouseButtonName_AllButtons(t *testing.T) {
 		{"Right", MouseButtonRight, "right"},
 		{"WheelUp", MouseButtonWheelUp, "wheel up"},
 		{"WheelDown", MouseButtonWheelDown, "wheel down"},
+		{"WheelLeft", MouseButtonWheelLeft, "wheel left"},
+		{"WheelRight", MouseButtonWheelRight, "wheel right"},
 		{"Unknown", MouseButton(999), "unknown"},
 	}
 
@@ -789,3 +801,36 @@ func TestMouseActionName_AllActions(t *testing.T) {
 		})
 	}
 }
+
+// TestPasteMsg_String tests PasteMsg string representation
+func TestPasteMsg_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"Empty", "", "paste: 0 bytes"},
+		{"ASCII", "hello", "paste: 5 bytes"},
+		{"Multiline", "a\nb", "paste: 3 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PasteMsg{Text: tt.text}.String()
+			if got != tt.expected {
+				t.Errorf("PasteMsg.String() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestResumeMsg_String tests the String method for ResumeMsg
+func TestResumeMsg_String(t *testing.T) {
+	msg := ResumeMsg{}
+	want := "resume"
+
+	got := msg.String()
+	if got != want {
+		t.Errorf("ResumeMsg.String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,28 @@
+package model
+
+import "fmt"
+
+// CommandPanic is the panic value a command wrapper raises for a panic it
+// recovered in a goroutine of its own (see Batch and service.WithTimeout). It keeps the
+// original panic value and the stack of the goroutine that panicked, so the
+// report points at the code that panicked rather than at the wrapper.
+type CommandPanic struct {
+	Value any    // Value passed to panic()
+	Stack []byte // Stack trace of the panicking goroutine
+}
+
+// String returns the panic value and the original stack. The runtime prints
+// it when the panic is not recovered.
+func (p CommandPanic) String() string {
+	return fmt.Sprintf("%v\n\noriginal stack:\n%s", p.Value, p.Stack)
+}
+
+// RecoveredPanic wraps a recovered panic value with the current stack.
+// Must be called from the deferred function that recovered. A value that
+// already is a CommandPanic (from a nested wrapper) keeps its stack.
+func RecoveredPanic(r any, stack []byte) CommandPanic {
+	if p, ok := r.(CommandPanic); ok {
+		return p
+	}
+	return CommandPanic{Value: r, Stack: stack}
+}
@@ -33,8 +33,9 @@ func Quit() model2.Cmd {
 
 // PrintlnMsg is sent by the Println command.
 //
-// This is useful for debugging - you can print messages without breaking
-// the TUI rendering. The event loop can handle these specially (e.g., log to file).
+// The event loop handles it instead of Update: in inline mode the message is
+// printed above the live view (scrolling into terminal history) without
+// corrupting the rendered frame. In alt-screen mode it is discarded.
 type PrintlnMsg struct {
 	Message string
 }
@@ -46,8 +47,8 @@ func (p PrintlnMsg) String() string {
 
 // Println returns a command that sends a PrintlnMsg with the given message.
 //
-// This is primarily for debugging during development. You can use it to
-// log events without disrupting the TUI display.
+// Use it to log progress lines above the live view (e.g. completed steps
+// while a spinner is running). Multi-line messages are printed as-is.
 //
 // Example:
 //
@@ -59,7 +60,7 @@ func (p PrintlnMsg) String() string {
 //		return m, nil
 //	}
 //
-// Note: In production, consider using proper logging instead.
+// Note: Alt-screen programs have no scrollback, so the message is discarded.
 func Println(message string) model2.Cmd {
 	return func() model2.Msg {
 		return PrintlnMsg{Message: message}
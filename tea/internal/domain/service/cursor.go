@@ -0,0 +1,29 @@
+package service
+
+import (
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// RequestCursorPositionMsg is sent by the RequestCursorPosition command.
+//
+// The event loop handles it instead of Update: it asks the terminal for the
+// cursor position, and the input reader delivers the reply as a
+// model.CursorPositionMsg.
+type RequestCursorPositionMsg struct{}
+
+// String returns a human-readable representation.
+func (r RequestCursorPositionMsg) String() string {
+	return "request cursor position"
+}
+
+// RequestCursorPosition returns a command that asks the terminal for the
+// cursor position. The reply arrives as a model.CursorPositionMsg; nothing
+// arrives if the terminal does not answer.
+//
+// The query goes through the program's input reader, so the reply is not
+// raced for and keys typed meanwhile are delivered as usual.
+func RequestCursorPosition() model2.Cmd {
+	return func() model2.Msg {
+		return RequestCursorPositionMsg{}
+	}
+}
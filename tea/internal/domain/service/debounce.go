@@ -0,0 +1,118 @@
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// creationOrder numbers runtime-managed commands (Debounce, EveryWithID,
+// CancelEvery) as they are created, so the program applies them in the
+// order Update returned them even when they run concurrently, as in a Batch.
+var creationOrder atomic.Uint64
+
+// DebounceMsg is sent by a Debounce command.
+//
+// The event loop handles it instead of Update: it claims the key in the
+// program's DebounceRegistry and runs the debounced command from there.
+type DebounceMsg struct {
+	key   string
+	order uint64
+	delay time.Duration
+	cmd   model2.Cmd
+}
+
+// String returns a human-readable representation.
+func (d DebounceMsg) String() string {
+	return "debounce " + d.key
+}
+
+// Debounce returns a command that runs cmd after d, unless another Debounce
+// with the same key is run in the meantime, in which case this one is
+// dropped. Typing "phoenix" with a 300ms debounce therefore runs one search
+// for "phoenix" instead of seven.
+//
+// The timers are managed by the program (see DebounceRegistry), so keys are
+// scoped to it, and a key is claimed when the command runs, not when it is
+// created: a Debounce that Update creates but doesn't return has no effect.
+// Commands still take effect in the order they were created, so in a Batch
+// the later Debounce wins whichever runs first. A result that cmd returns
+// after a newer Debounce with the same key ran is discarded too, so a slow
+// earlier search can't overwrite a newer one.
+//
+// Example:
+//
+//	case KeyMsg:
+//		m.query += string(msg.Rune)
+//		return m, Debounce("search", 300*time.Millisecond, search(m.query))
+//
+// Returns nil if cmd is nil, and cmd unchanged if d is not positive.
+func Debounce(key string, d time.Duration, cmd model2.Cmd) model2.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	if d <= 0 {
+		return cmd
+	}
+
+	order := creationOrder.Add(1)
+	return func() model2.Msg {
+		return DebounceMsg{key: key, order: order, delay: d, cmd: cmd}
+	}
+}
+
+// debounceClaim is the latest Debounce run for a key.
+type debounceClaim struct {
+	order      uint64
+	superseded chan struct{} // Closed when a newer Debounce claims the key
+}
+
+// DebounceRegistry tracks the latest Debounce of every key for one program.
+type DebounceRegistry struct {
+	mu     sync.Mutex
+	latest map[string]debounceClaim
+}
+
+// NewDebounceRegistry creates an empty registry.
+func NewDebounceRegistry() *DebounceRegistry {
+	return &DebounceRegistry{latest: make(map[string]debounceClaim)}
+}
+
+// Schedule claims msg's key, superseding the Debounce that held it, and
+// returns the command that waits for the delay and runs the debounced
+// command. It returns nil if a Debounce created after msg already ran.
+func (r *DebounceRegistry) Schedule(msg DebounceMsg) model2.Cmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.latest[msg.key]
+	if ok && previous.order > msg.order {
+		return nil // A newer Debounce got here first
+	}
+	if ok {
+		close(previous.superseded)
+	}
+	superseded := make(chan struct{})
+	r.latest[msg.key] = debounceClaim{order: msg.order, superseded: superseded}
+
+	return func() model2.Msg {
+		timer := time.NewTimer(msg.delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-superseded:
+			return nil
+		}
+
+		result := msg.cmd()
+		select {
+		case <-superseded:
+			return nil // A newer command owns the key now
+		default:
+			return result
+		}
+	}
+}
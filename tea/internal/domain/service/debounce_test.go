@@ -0,0 +1,147 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+type debounceTestMsg struct{ query string }
+
+func searchCmd(query string, d time.Duration) model2.Cmd {
+	return func() model2.Msg {
+		time.Sleep(d)
+		return debounceTestMsg{query: query}
+	}
+}
+
+// schedule runs a Debounce command and hands its message to r, like the
+// program does.
+func schedule(r *DebounceRegistry, cmd model2.Cmd) model2.Cmd {
+	return r.Schedule(cmd().(DebounceMsg))
+}
+
+// runAll runs cmds concurrently, like the program does, and returns the
+// non-nil messages.
+func runAll(cmds ...model2.Cmd) []model2.Msg {
+	var (
+		mu   sync.Mutex
+		msgs []model2.Msg
+		wg   sync.WaitGroup
+	)
+	for _, cmd := range cmds {
+		if cmd == nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if msg := cmd(); msg != nil {
+				mu.Lock()
+				msgs = append(msgs, msg)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return msgs
+}
+
+// TestDebounce_LastWins verifies only the last command of a burst runs.
+func TestDebounce_LastWins(t *testing.T) {
+	r := NewDebounceRegistry()
+	var cmds []model2.Cmd
+	for _, query := range []string{"p", "ph", "pho"} {
+		cmds = append(cmds, schedule(r, Debounce("search", 30*time.Millisecond, searchCmd(query, 0))))
+	}
+
+	msgs := runAll(cmds...)
+	if len(msgs) != 1 || msgs[0].(debounceTestMsg).query != "pho" {
+		t.Errorf("messages = %v, want only pho", msgs)
+	}
+}
+
+// TestDebounce_CreationOrder verifies an older command that runs after a
+// newer one with the same key (as can happen in a Batch) is dropped.
+func TestDebounce_CreationOrder(t *testing.T) {
+	r := NewDebounceRegistry()
+	older := Debounce("search", 10*time.Millisecond, searchCmd("ph", 0))
+	newer := Debounce("search", 10*time.Millisecond, searchCmd("pho", 0))
+
+	scheduled := schedule(r, newer)
+	if schedule(r, older) != nil {
+		t.Error("older command should be dropped")
+	}
+	if msg := scheduled(); msg == nil || msg.(debounceTestMsg).query != "pho" {
+		t.Errorf("newer result = %v, want pho", msg)
+	}
+}
+
+// TestDebounce_ClaimedWhenRun verifies a command that is created but never
+// run doesn't supersede the pending one.
+func TestDebounce_ClaimedWhenRun(t *testing.T) {
+	r := NewDebounceRegistry()
+	pending := schedule(r, Debounce("search", 10*time.Millisecond, searchCmd("ph", 0)))
+	_ = Debounce("search", 10*time.Millisecond, searchCmd("pho", 0)) // Thrown away
+
+	if msg := pending(); msg == nil || msg.(debounceTestMsg).query != "ph" {
+		t.Errorf("pending result = %v, want ph", msg)
+	}
+}
+
+// TestDebounce_KeysAreIndependent verifies different keys don't cancel each other.
+func TestDebounce_KeysAreIndependent(t *testing.T) {
+	r := NewDebounceRegistry()
+	msgs := runAll(
+		schedule(r, Debounce("users", 10*time.Millisecond, searchCmd("alice", 0))),
+		schedule(r, Debounce("repos", 10*time.Millisecond, searchCmd("phoenix", 0))),
+	)
+	if len(msgs) != 2 {
+		t.Errorf("messages = %v, want both", msgs)
+	}
+}
+
+// TestDebounce_RegistriesAreIndependent verifies two programs using the same
+// key don't cancel each other.
+func TestDebounce_RegistriesAreIndependent(t *testing.T) {
+	msgs := runAll(
+		schedule(NewDebounceRegistry(), Debounce("search", 10*time.Millisecond, searchCmd("a", 0))),
+		schedule(NewDebounceRegistry(), Debounce("search", 10*time.Millisecond, searchCmd("b", 0))),
+	)
+	if len(msgs) != 2 {
+		t.Errorf("messages = %v, want both", msgs)
+	}
+}
+
+// TestDebounce_DropsStaleResult verifies a result that arrives after a newer
+// command ran is discarded.
+func TestDebounce_DropsStaleResult(t *testing.T) {
+	r := NewDebounceRegistry()
+	slow := schedule(r, Debounce("stale", time.Millisecond, searchCmd("old", 50*time.Millisecond)))
+	done := make(chan model2.Msg, 1)
+	go func() { done <- slow() }()
+
+	time.Sleep(20 * time.Millisecond) // slow is now running its search
+	fresh := schedule(r, Debounce("stale", time.Millisecond, searchCmd("new", 0)))
+
+	if msg := <-done; msg != nil {
+		t.Errorf("stale result %v was delivered", msg)
+	}
+	if msg := fresh(); msg == nil || msg.(debounceTestMsg).query != "new" {
+		t.Errorf("fresh result = %v, want new", msg)
+	}
+}
+
+// TestDebounce_Edges verifies nil commands and non-positive durations.
+func TestDebounce_Edges(t *testing.T) {
+	if Debounce("edges", time.Second, nil) != nil {
+		t.Error("nil cmd should return nil")
+	}
+
+	msg := Debounce("edges", 0, searchCmd("direct", 0))()
+	if got := msg.(debounceTestMsg).query; got != "direct" {
+		t.Errorf("d=0 should run cmd unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// TickerID identifies a repeating ticker started with EveryWithID.
+//
+// IDs are chosen by the application (e.g. "clock", "countdown") so a model
+// can stop its ticker later via CancelEvery without storing any handle.
+type TickerID string
+
+// StartTickerMsg is sent by an EveryWithID command.
+//
+// The event loop handles it instead of Update: it registers the ticker in
+// the program's TickerRegistry and starts it.
+type StartTickerMsg struct {
+	id    TickerID
+	order uint64
+	d     time.Duration
+	fn    func(time.Time) model2.Msg
+}
+
+// String returns a human-readable representation.
+func (s StartTickerMsg) String() string {
+	return "start ticker " + string(s.id)
+}
+
+// CancelTickerMsg is sent by a CancelEvery command.
+//
+// The event loop handles it instead of Update: it stops the ticker in the
+// program's TickerRegistry.
+type CancelTickerMsg struct {
+	id    TickerID
+	order uint64
+}
+
+// String returns a human-readable representation.
+func (c CancelTickerMsg) String() string {
+	return "cancel ticker " + string(c.id)
+}
+
+// tickerClaim is the latest EveryWithID or CancelEvery run for an id.
+type tickerClaim struct {
+	order uint64
+	stop  chan struct{} // Stops the running ticker; nil after CancelEvery
+}
+
+// TickerRegistry tracks the named tickers of one program.
+type TickerRegistry struct {
+	mu      sync.Mutex
+	tickers map[TickerID]tickerClaim
+}
+
+// NewTickerRegistry creates an empty registry.
+func NewTickerRegistry() *TickerRegistry {
+	return &TickerRegistry{tickers: make(map[TickerID]tickerClaim)}
+}
+
+// Start registers the ticker of msg, stopping the one running under the same
+// id, and returns the command that produces its first tick. It returns nil
+// if an EveryWithID or CancelEvery created after msg already ran for the id.
+func (r *TickerRegistry) Start(msg StartTickerMsg) model2.Cmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.claim(msg.id, msg.order) {
+		return nil
+	}
+	stop := make(chan struct{})
+	r.tickers[msg.id] = tickerClaim{order: msg.order, stop: stop}
+	return everyCmd(msg.d, msg.fn, stop)
+}
+
+// Cancel stops the ticker running under msg's id, unless it was started by
+// an EveryWithID created after msg.
+func (r *TickerRegistry) Cancel(msg CancelTickerMsg) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.claim(msg.id, msg.order) {
+		// Remember the cancellation, so an older EveryWithID that runs
+		// later doesn't start.
+		r.tickers[msg.id] = tickerClaim{order: msg.order}
+	}
+}
+
+// claim stops the ticker registered under id and reports true, or reports
+// false if the id was claimed by a command created after order.
+// Must be called with r.mu held.
+func (r *TickerRegistry) claim(id TickerID, order uint64) bool {
+	previous, ok := r.tickers[id]
+	if ok && previous.order > order {
+		return false
+	}
+	if previous.stop != nil {
+		close(previous.stop)
+	}
+	return true
+}
+
+// Every returns a self-sustaining command that fires on a fixed interval
+// aligned to the wall clock.
+//
+// Unlike chaining Tick (which sleeps for the full duration after each Update
+// and therefore drifts), Every waits until the next multiple of d on the clock
+// (e.g. the start of the next second for d = time.Second) and keeps firing
+// until the program exits. Each tick calls fn with the tick time and delivers
+// the result to Update.
+//
+// A ticker started with Every cannot be stopped; use EveryWithID when the
+// model needs to stop it on a state transition.
+//
+// Example - Wall clock:
+//
+//	func (m ClockModel) Init() Cmd {
+//		return Every(time.Second, func(t time.Time) Msg {
+//			return ClockTickMsg{Time: t}
+//		})
+//	}
+//
+// Returns nil if d is not positive.
+func Every(d time.Duration, fn func(time.Time) model2.Msg) model2.Cmd {
+	if d <= 0 || fn == nil {
+		return nil
+	}
+	return everyCmd(d, fn, nil)
+}
+
+// EveryWithID is like Every, but registers the ticker under id so it can be
+// stopped with CancelEvery.
+//
+// Starting a ticker with an id that is already running replaces the old
+// ticker, so re-entering a state never produces duplicate ticks. Tickers are
+// managed by the program (see TickerRegistry), so ids are scoped to it, and
+// an id is claimed when the command runs, not when it is created: an
+// EveryWithID that Update creates but doesn't return has no effect.
+// EveryWithID and CancelEvery still take effect in the order Update created
+// them, however their commands are scheduled (e.g. batched together).
+//
+// Example - Pausable countdown:
+//
+//	case KeyMsg:
+//		if m.running {
+//			m.running = false
+//			return m, CancelEvery("countdown")
+//		}
+//		m.running = true
+//		return m, EveryWithID("countdown", time.Second, func(t time.Time) Msg {
+//			return CountdownTickMsg{}
+//		})
+//
+// Returns nil if d is not positive.
+func EveryWithID(id TickerID, d time.Duration, fn func(time.Time) model2.Msg) model2.Cmd {
+	if d <= 0 || fn == nil {
+		return nil
+	}
+	order := creationOrder.Add(1)
+	return func() model2.Msg {
+		return StartTickerMsg{id: id, order: order, d: d, fn: fn}
+	}
+}
+
+// CancelEvery returns a command that stops the ticker registered under id.
+//
+// A tick that has already been delivered to the event loop is not recalled,
+// so Update may observe at most one more tick after requesting cancellation.
+// Only a ticker started by an EveryWithID created before CancelEvery is
+// stopped; one created afterwards is unaffected. Cancelling an unknown id is
+// a no-op.
+func CancelEvery(id TickerID) model2.Cmd {
+	order := creationOrder.Add(1)
+	return func() model2.Msg {
+		return CancelTickerMsg{id: id, order: order}
+	}
+}
+
+// everyCmd builds the repeating command. A nil stop channel never fires.
+func everyCmd(d time.Duration, fn func(time.Time) model2.Msg, stop <-chan struct{}) model2.Cmd {
+	var cmd model2.Cmd
+	cmd = func() model2.Msg {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(d).Add(d).Sub(now))
+		defer timer.Stop()
+
+		select {
+		case t := <-timer.C:
+			return model2.RepeatMsg{Msg: fn(t), Next: cmd}
+		case <-stop:
+			return nil
+		}
+	}
+	return cmd
+}
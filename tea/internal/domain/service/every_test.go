@@ -0,0 +1,190 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+type everyTestMsg struct {
+	t time.Time
+}
+
+func everyTestFn(t time.Time) model2.Msg {
+	return everyTestMsg{t: t}
+}
+
+// TestEvery_InvalidArgs verifies Every returns nil for unusable input.
+func TestEvery_InvalidArgs(t *testing.T) {
+	if Every(0, everyTestFn) != nil {
+		t.Error("Every(0) should return nil")
+	}
+	if Every(-time.Second, everyTestFn) != nil {
+		t.Error("Every(negative) should return nil")
+	}
+	if Every(time.Second, nil) != nil {
+		t.Error("Every(nil fn) should return nil")
+	}
+	if EveryWithID("x", 0, everyTestFn) != nil {
+		t.Error("EveryWithID(0) should return nil")
+	}
+}
+
+// TestEvery_RepeatMsg verifies Every wraps the payload in RepeatMsg with a Next command.
+func TestEvery_RepeatMsg(t *testing.T) {
+	cmd := Every(20*time.Millisecond, everyTestFn)
+	if cmd == nil {
+		t.Fatal("Every() returned nil")
+	}
+
+	msg := cmd()
+	repeat, ok := msg.(model2.RepeatMsg)
+	if !ok {
+		t.Fatalf("Every command sent %T, expected model.RepeatMsg", msg)
+	}
+	if _, ok := repeat.Msg.(everyTestMsg); !ok {
+		t.Errorf("RepeatMsg.Msg = %T, expected everyTestMsg", repeat.Msg)
+	}
+	if repeat.Next == nil {
+		t.Fatal("RepeatMsg.Next should not be nil")
+	}
+
+	// Next produces another occurrence
+	if _, ok := repeat.Next().(model2.RepeatMsg); !ok {
+		t.Error("Next command should produce another RepeatMsg")
+	}
+}
+
+// TestEvery_ClockAligned verifies ticks land on interval boundaries.
+func TestEvery_ClockAligned(t *testing.T) {
+	d := 50 * time.Millisecond
+	cmd := Every(d, everyTestFn)
+
+	for i := 0; i < 3; i++ {
+		repeat := cmd().(model2.RepeatMsg)
+		tick := repeat.Msg.(everyTestMsg).t
+
+		// Tick must not fire before the boundary, and should fire close to it.
+		offset := tick.Sub(tick.Truncate(d))
+		if offset > 20*time.Millisecond {
+			t.Errorf("tick %d fired %v after boundary, expected alignment", i, offset)
+		}
+		cmd = repeat.Next
+	}
+}
+
+// start runs an EveryWithID command and hands its message to r, like the
+// program does.
+func start(r *TickerRegistry, cmd model2.Cmd) model2.Cmd {
+	return r.Start(cmd().(StartTickerMsg))
+}
+
+// cancel runs a CancelEvery command and hands its message to r.
+func cancel(r *TickerRegistry, cmd model2.Cmd) {
+	r.Cancel(cmd().(CancelTickerMsg))
+}
+
+// runTicker runs cmd in the background and returns its message channel.
+func runTicker(cmd model2.Cmd) <-chan model2.Msg {
+	done := make(chan model2.Msg, 1)
+	go func() { done <- cmd() }()
+	return done
+}
+
+// expectStopped verifies the ticker behind done stopped without a tick.
+func expectStopped(t *testing.T, done <-chan model2.Msg) {
+	t.Helper()
+
+	select {
+	case msg := <-done:
+		if msg != nil {
+			t.Errorf("stopped ticker sent %T, expected nil", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not stop")
+	}
+}
+
+// TestEveryWithID_Cancel verifies CancelEvery stops a pending tick.
+func TestEveryWithID_Cancel(t *testing.T) {
+	r := NewTickerRegistry()
+	done := runTicker(start(r, EveryWithID("cancel", time.Hour, everyTestFn)))
+
+	if msg := CancelEvery("cancel")(); msg == nil {
+		t.Error("CancelEvery command should send CancelTickerMsg")
+	} else {
+		r.Cancel(msg.(CancelTickerMsg))
+	}
+	expectStopped(t, done)
+}
+
+// TestEveryWithID_Replace verifies restarting an id stops the previous ticker.
+func TestEveryWithID_Replace(t *testing.T) {
+	r := NewTickerRegistry()
+	done := runTicker(start(r, EveryWithID("replace", time.Hour, everyTestFn)))
+
+	second := start(r, EveryWithID("replace", 20*time.Millisecond, everyTestFn))
+	if _, ok := second().(model2.RepeatMsg); !ok {
+		t.Error("replacement ticker should fire")
+	}
+	expectStopped(t, done)
+}
+
+// TestEveryWithID_CancelThenRestart verifies a CancelEvery created before a
+// restart with the same id doesn't stop the new ticker, even when the
+// commands run in the opposite order (as they may in a Batch).
+func TestEveryWithID_CancelThenRestart(t *testing.T) {
+	r := NewTickerRegistry()
+	first := runTicker(start(r, EveryWithID("restart", time.Hour, everyTestFn)))
+	cancelCmd := CancelEvery("restart")
+	second := start(r, EveryWithID("restart", 20*time.Millisecond, everyTestFn))
+
+	expectStopped(t, first)
+	cancel(r, cancelCmd)
+	if _, ok := second().(model2.RepeatMsg); !ok {
+		t.Error("restarted ticker should fire")
+	}
+}
+
+// TestEveryWithID_CreationOrder verifies an EveryWithID created before a
+// CancelEvery doesn't start when it runs after it.
+func TestEveryWithID_CreationOrder(t *testing.T) {
+	r := NewTickerRegistry()
+	startCmd := EveryWithID("order", time.Hour, everyTestFn)
+	cancel(r, CancelEvery("order"))
+
+	if start(r, startCmd) != nil {
+		t.Error("ticker created before the cancellation should not start")
+	}
+}
+
+// TestEveryWithID_ClaimedWhenRun verifies an EveryWithID that is created
+// but never run doesn't stop the running ticker.
+func TestEveryWithID_ClaimedWhenRun(t *testing.T) {
+	r := NewTickerRegistry()
+	ticker := start(r, EveryWithID("claim", 20*time.Millisecond, everyTestFn))
+	_ = EveryWithID("claim", time.Hour, everyTestFn) // Thrown away
+
+	if _, ok := ticker().(model2.RepeatMsg); !ok {
+		t.Error("running ticker should keep firing")
+	}
+}
+
+// TestEveryWithID_RegistriesAreIndependent verifies two programs using the
+// same id don't stop each other's tickers.
+func TestEveryWithID_RegistriesAreIndependent(t *testing.T) {
+	first := start(NewTickerRegistry(), EveryWithID("shared", 20*time.Millisecond, everyTestFn))
+	other := NewTickerRegistry()
+	start(other, EveryWithID("shared", time.Hour, everyTestFn))
+	cancel(other, CancelEvery("shared"))
+
+	if _, ok := first().(model2.RepeatMsg); !ok {
+		t.Error("ticker of another registry should keep firing")
+	}
+}
+
+// TestCancelEvery_UnknownID verifies cancelling an unknown id is a no-op.
+func TestCancelEvery_UnknownID(_ *testing.T) {
+	cancel(NewTickerRegistry(), CancelEvery("never-started"))
+}
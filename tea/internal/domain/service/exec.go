@@ -0,0 +1,92 @@
+package service
+
+import (
+	"fmt"
+	"os/exec"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// SuspendMsg is sent by the Suspend command.
+//
+// The event loop handles it instead of Update: the terminal is restored to
+// its normal state and the process is stopped as if the user pressed Ctrl+Z.
+// When the shell resumes the process (fg), the terminal state is re-applied
+// and Update receives a model.ResumeMsg.
+type SuspendMsg struct{}
+
+// String returns a human-readable representation.
+func (s SuspendMsg) String() string {
+	return "suspend"
+}
+
+// Suspend returns a command that suspends the program (job control).
+//
+// Typically bound to ctrl+z, since raw mode disables the terminal's own
+// handling of that key.
+//
+// Example:
+//
+//	func (m AppModel) Update(msg Msg) (Model[AppModel], Cmd) {
+//		switch msg := msg.(type) {
+//		case KeyMsg:
+//			if msg.String() == "ctrl+z" {
+//				return m, Suspend()
+//			}
+//		case ResumeMsg:
+//			return m, RefreshData()
+//		}
+//		return m, nil
+//	}
+//
+// On platforms without job control (Windows) the terminal is released and
+// immediately restored, and ResumeMsg is still delivered.
+func Suspend() model2.Cmd {
+	return func() model2.Msg {
+		return SuspendMsg{}
+	}
+}
+
+// ExecMsg is sent by the Exec command.
+//
+// The event loop handles it instead of Update: the TUI is suspended, Cmd runs
+// with full control of the terminal, the TUI is restored, and the message
+// returned by Callback (if any) is delivered to Update.
+type ExecMsg struct {
+	Cmd      *exec.Cmd
+	Callback func(error) model2.Msg
+}
+
+// String returns a human-readable representation.
+func (e ExecMsg) String() string {
+	if e.Cmd == nil {
+		return "exec: <nil>"
+	}
+	return fmt.Sprintf("exec: %s", e.Cmd.Path)
+}
+
+// Exec returns a command that runs an external process in the foreground,
+// e.g. $EDITOR for a commit message.
+//
+// While the process runs the program leaves raw mode and the alternate screen
+// and shows the cursor; afterwards the previous terminal state is restored and
+// the view is redrawn. fn receives the process error (nil on success) and its
+// result is delivered to Update. fn may be nil.
+//
+// Example:
+//
+//	case EditMsg:
+//		c := exec.Command(os.Getenv("EDITOR"), m.file)
+//		return m, Exec(c, func(err error) Msg {
+//			return EditorClosedMsg{Err: err}
+//		})
+//
+// Returns nil if cmd is nil.
+func Exec(cmd *exec.Cmd, fn func(error) model2.Msg) model2.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() model2.Msg {
+		return ExecMsg{Cmd: cmd, Callback: fn}
+	}
+}
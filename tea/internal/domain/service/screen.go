@@ -0,0 +1,53 @@
+package service
+
+import (
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// EnterAltScreenMsg is sent by the EnterAltScreen command.
+//
+// The event loop handles it instead of Update: the terminal switches to the
+// alternate screen buffer and the view is drawn there in full.
+type EnterAltScreenMsg struct{}
+
+// String returns a human-readable representation.
+func (e EnterAltScreenMsg) String() string {
+	return "enter alt screen"
+}
+
+// ExitAltScreenMsg is sent by the ExitAltScreen command.
+//
+// The event loop handles it instead of Update: the terminal switches back to
+// the normal screen buffer and the view is drawn inline again, in full.
+type ExitAltScreenMsg struct{}
+
+// String returns a human-readable representation.
+func (e ExitAltScreenMsg) String() string {
+	return "exit alt screen"
+}
+
+// EnterAltScreen returns a command that switches a running program to the
+// alternate screen buffer (full-screen mode), as if it had been created
+// with WithAltScreen. Does nothing if the program already uses it.
+//
+// Example:
+//
+//	case KeyMsg:
+//		if msg.String() == "enter" {
+//			m.detail = true
+//			return m, EnterAltScreen()
+//		}
+func EnterAltScreen() model2.Cmd {
+	return func() model2.Msg {
+		return EnterAltScreenMsg{}
+	}
+}
+
+// ExitAltScreen returns a command that switches a running program back from
+// the alternate screen buffer to inline rendering. Does nothing if the
+// program is not using the alternate screen.
+func ExitAltScreen() model2.Cmd {
+	return func() model2.Msg {
+		return ExitAltScreenMsg{}
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/term"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// StdinIsTerminalMsg is sent by ReadStdin instead of calling its callback
+// when stdin is an interactive terminal, i.e. nothing was piped in.
+type StdinIsTerminalMsg struct{}
+
+// String returns a human-readable representation.
+func (s StdinIsTerminalMsg) String() string {
+	return "stdin is a terminal"
+}
+
+// ReadStdinMsg is sent by the ReadStdin command.
+//
+// The event loop handles it instead of Update: it reads the program's piped
+// stdin (on which it never starts the key reader) and delivers Fn(content).
+type ReadStdinMsg struct {
+	Fn func(string) model2.Msg
+}
+
+// String returns a human-readable representation.
+func (r ReadStdinMsg) String() string {
+	return "read stdin"
+}
+
+// ReadStdin returns a command that reads all of stdin when it is piped or
+// redirected (`cat file | mytool`) and delivers fn(content).
+//
+// If stdin is a terminal there is nothing to read, so the command sends
+// StdinIsTerminalMsg immediately instead of blocking on the keyboard.
+// A read error delivers whatever was read before it.
+//
+// The read is done by the program, which doesn't parse keys from a piped
+// stdin, so no piped bytes are lost to the input reader.
+//
+// Example:
+//
+//	func (m Model) Init() Cmd {
+//		return ReadStdin(func(s string) Msg { return PipedInputMsg{Text: s} })
+//	}
+func ReadStdin(fn func(string) model2.Msg) model2.Cmd {
+	return func() model2.Msg {
+		return ReadStdinMsg{Fn: fn}
+	}
+}
+
+// ReadFile returns a command that reads f to EOF and delivers fn(content), or
+// StdinIsTerminalMsg if f is a terminal. Used by the program for ReadStdinMsg.
+func ReadFile(f *os.File, fn func(string) model2.Msg) model2.Cmd {
+	return func() model2.Msg {
+		if term.IsTerminal(int(f.Fd())) {
+			return StdinIsTerminalMsg{}
+		}
+		data, _ := io.ReadAll(f) // Partial content on error is still useful
+		if fn == nil {
+			return nil
+		}
+		return fn(string(data))
+	}
+}
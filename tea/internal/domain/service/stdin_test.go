@@ -0,0 +1,70 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+type pipedMsg struct {
+	text string
+}
+
+// TestReadFile_Pipe verifies piped content is read to EOF and delivered via fn.
+func TestReadFile_Pipe(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	go func() {
+		_, _ = w.WriteString("line 1\nline 2\n")
+		_ = w.Close()
+	}()
+
+	msg := ReadFile(r, func(s string) model2.Msg { return pipedMsg{text: s} })()
+
+	got, ok := msg.(pipedMsg)
+	if !ok {
+		t.Fatalf("ReadFile sent %T, expected pipedMsg", msg)
+	}
+	if got.text != "line 1\nline 2\n" {
+		t.Errorf("text = %q", got.text)
+	}
+}
+
+// TestReadFile_NilFn verifies a nil callback drains the file and sends nothing.
+func TestReadFile_NilFn(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	_ = w.Close()
+
+	if msg := ReadFile(r, nil)(); msg != nil {
+		t.Errorf("ReadFile(nil) sent %T, expected nil", msg)
+	}
+}
+
+// TestReadStdin verifies ReadStdin leaves the read to the event loop.
+func TestReadStdin(t *testing.T) {
+	fn := func(s string) model2.Msg { return pipedMsg{text: s} }
+
+	msg, ok := ReadStdin(fn)().(ReadStdinMsg)
+	if !ok {
+		t.Fatalf("ReadStdin sent %T, expected ReadStdinMsg", msg)
+	}
+	if got := msg.Fn("x"); got != (pipedMsg{text: "x"}) {
+		t.Errorf("Fn(\"x\") = %v", got)
+	}
+}
+
+// TestStdinIsTerminalMsg_String tests the String method.
+func TestStdinIsTerminalMsg_String(t *testing.T) {
+	if got := (StdinIsTerminalMsg{}).String(); got != "stdin is a terminal" {
+		t.Errorf("String() = %q", got)
+	}
+}
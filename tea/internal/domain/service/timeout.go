@@ -0,0 +1,112 @@
+package service
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+// WithTimeout returns a command that races cmd against a timer.
+//
+// Whichever finishes first wins: either cmd's message, or onTimeout() if d
+// elapses first. The loser is discarded - a command that completes after the
+// timeout never reaches Update, so there is no late duplicate update.
+// The slow command itself is not interrupted (Go can't kill a goroutine);
+// pass it a context if it must stop doing work.
+//
+// Example:
+//
+//	return m, WithTimeout(5*time.Second, fetchUser(id), func() Msg {
+//		return FetchFailedMsg{Err: errors.New("request timed out")}
+//	})
+//
+// A panic in cmd is raised again by the returned command as a
+// model.CommandPanic carrying the original stack. After a timeout the
+// message comes with a follow-up command (see model.RepeatMsg) that waits
+// for cmd and raises a panic that happens after the timeout fired, so the
+// program running the command reports it like any other command panic.
+//
+// A nil onTimeout sends no message on timeout. Returns nil if cmd is nil,
+// and cmd unchanged if d is not positive.
+func WithTimeout(d time.Duration, cmd model2.Cmd, onTimeout func() model2.Msg) model2.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	if d <= 0 {
+		return cmd
+	}
+	return func() model2.Msg {
+		// Whoever moves state from running first decides the outcome.
+		const (
+			running int32 = iota
+			finished
+			timedOut
+		)
+		var state atomic.Int32
+
+		type result struct {
+			msg      model2.Msg
+			panicked *model2.CommandPanic
+		}
+		// Buffered so the command goroutine never blocks on a send.
+		done := make(chan result, 1)
+		late := make(chan model2.CommandPanic, 1) // Closed when cmd returns
+		go func() {
+			defer close(late)
+			defer func() {
+				if r := recover(); r != nil {
+					p := model2.RecoveredPanic(r, debug.Stack())
+					if state.CompareAndSwap(running, finished) {
+						done <- result{panicked: &p}
+						return
+					}
+					late <- p // Nobody waits any more; don't swallow it
+				}
+			}()
+			msg := cmd()
+			if state.CompareAndSwap(running, finished) {
+				done <- result{msg: msg}
+			}
+		}()
+
+		deliver := func(res result) model2.Msg {
+			if res.panicked != nil {
+				// Surface in the command goroutine (see WithRecover), with
+				// the stack of the goroutine that panicked.
+				panic(*res.panicked)
+			}
+			return res.msg
+		}
+
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case res := <-done:
+			return deliver(res)
+		case <-timer.C:
+			if !state.CompareAndSwap(running, timedOut) {
+				return deliver(<-done) // The command finished just in time
+			}
+			var msg model2.Msg
+			if onTimeout != nil {
+				msg = onTimeout()
+			}
+			return model2.RepeatMsg{Msg: msg, Next: raiseLatePanic(late)}
+		}
+	}
+}
+
+// raiseLatePanic returns a command that waits for an abandoned command and
+// raises its panic, if it panics, in the command goroutine (see
+// WithRecover). It sends no message.
+func raiseLatePanic(late <-chan model2.CommandPanic) model2.Cmd {
+	return func() model2.Msg {
+		if p, ok := <-late; ok {
+			panic(p)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	model2 "github.com/phoenix-tui/phoenix/tea/internal/domain/model"
+)
+
+type timeoutTestMsg struct{ name string }
+
+func sleepCmd(d time.Duration, name string) model2.Cmd {
+	return func() model2.Msg {
+		time.Sleep(d)
+		return timeoutTestMsg{name: name}
+	}
+}
+
+func onTimeoutMsg() model2.Msg { return timeoutTestMsg{name: "timeout"} }
+
+// TestWithTimeout_CommandWins verifies a fast command's message is delivered.
+func TestWithTimeout_CommandWins(t *testing.T) {
+	msg := WithTimeout(time.Second, sleepCmd(0, "done"), onTimeoutMsg)()
+
+	if got := msg.(timeoutTestMsg).name; got != "done" {
+		t.Errorf("got %q, want done", got)
+	}
+}
+
+// TestWithTimeout_TimeoutWins verifies a slow command is abandoned.
+func TestWithTimeout_TimeoutWins(t *testing.T) {
+	start := time.Now()
+	msg := WithTimeout(20*time.Millisecond, sleepCmd(time.Second, "late"), onTimeoutMsg)()
+
+	repeat, ok := msg.(model2.RepeatMsg)
+	if !ok {
+		t.Fatalf("got %T, want model.RepeatMsg", msg)
+	}
+	if got := repeat.Msg.(timeoutTestMsg).name; got != "timeout" {
+		t.Errorf("got %q, want timeout", got)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("WithTimeout waited %v for the slow command", elapsed)
+	}
+}
+
+// TestWithTimeout_NilOnTimeout verifies a timeout without callback sends nothing.
+func TestWithTimeout_NilOnTimeout(t *testing.T) {
+	msg := WithTimeout(10*time.Millisecond, sleepCmd(time.Second, "late"), nil)()
+	if repeat, ok := msg.(model2.RepeatMsg); !ok || repeat.Msg != nil {
+		t.Errorf("got %v, want a RepeatMsg without message", msg)
+	}
+}
+
+// TestWithTimeout_AbandonedCommandFinishes verifies the follow-up command of
+// a timeout sends nothing when the abandoned command returns normally.
+func TestWithTimeout_AbandonedCommandFinishes(t *testing.T) {
+	msg := WithTimeout(5*time.Millisecond, sleepCmd(20*time.Millisecond, "late"), onTimeoutMsg)()
+
+	if next := msg.(model2.RepeatMsg).Next(); next != nil {
+		t.Errorf("follow-up command sent %v, want nil", next)
+	}
+}
+
+// TestWithTimeout_Edges verifies nil command and non-positive durations.
+func TestWithTimeout_Edges(t *testing.T) {
+	if WithTimeout(time.Second, nil, onTimeoutMsg) != nil {
+		t.Error("nil cmd should return nil")
+	}
+
+	msg := WithTimeout(0, sleepCmd(0, "direct"), onTimeoutMsg)()
+	if got := msg.(timeoutTestMsg).name; got != "direct" {
+		t.Errorf("d=0 should run cmd unchanged, got %q", got)
+	}
+}
+
+// TestWithTimeout_PanicPropagates verifies a panic in cmd surfaces in the
+// caller with the stack of the goroutine that panicked.
+func TestWithTimeout_PanicPropagates(t *testing.T) {
+	defer func() {
+		p, ok := recover().(model2.CommandPanic)
+		if !ok || p.Value != "boom" {
+			t.Fatalf("recovered %v, want CommandPanic boom", p)
+		}
+		if !strings.Contains(string(p.Stack), "panickingCmd") {
+			t.Errorf("stack should point at the panicking command:\n%s", p.Stack)
+		}
+	}()
+
+	WithTimeout(time.Second, panickingCmd, onTimeoutMsg)()
+	t.Error("expected panic")
+}
+
+// TestWithTimeout_LatePanic verifies a panic after the timeout is raised by
+// the follow-up command instead of being swallowed.
+func TestWithTimeout_LatePanic(t *testing.T) {
+	slow := func() model2.Msg {
+		time.Sleep(30 * time.Millisecond)
+		return panickingCmd()
+	}
+	msg := WithTimeout(5*time.Millisecond, slow, onTimeoutMsg)()
+	repeat := msg.(model2.RepeatMsg)
+	if got := repeat.Msg.(timeoutTestMsg).name; got != "timeout" {
+		t.Errorf("got %q, want timeout", got)
+	}
+
+	defer func() {
+		p, ok := recover().(model2.CommandPanic)
+		if !ok || p.Value != "boom" || !strings.Contains(string(p.Stack), "panickingCmd") {
+			t.Errorf("late panic = %v with stack:\n%s", p.Value, p.Stack)
+		}
+	}()
+	repeat.Next()
+	t.Error("late panic was swallowed")
+}
+
+func panickingCmd() model2.Msg {
+	panic("boom")
+}
@@ -24,6 +24,8 @@ func NewParser() *Parser {
 // - Arrow keys (ESC [ A/B/C/D).
 // - Function keys F1-F12 (basic sequences).
 // - Ctrl combinations (Ctrl+A through Ctrl+Z).
+// - Modified cursor keys (ESC [ 1 ; <mod> A/B/C/D/H/F), e.g. Ctrl+Left.
+// - Alt combinations sent as ESC prefix (ESC b, ESC DEL).
 //
 //nolint:gocognit,gocyclo,cyclop,funlen,nestif // ANSI parsing requires sequential checks for all key types
 func (p *Parser) ParseKey(data []byte) (model.KeyMsg, bool) {
@@ -74,6 +76,26 @@ func (p *Parser) ParseKey(data []byte) (model.KeyMsg, bool) {
 
 	// Multi-byte - ANSI escape sequences
 	if data[0] == 0x1B { // ESC
+		// Alt combinations: ESC <printable> / ESC DEL
+		// ('[' and 'O' introduce CSI/SS3 sequences and are left unparsed)
+		if len(data) == 2 && data[1] != '[' && data[1] != 'O' {
+			switch b := data[1]; {
+			case b == 0x7F:
+				return model.KeyMsg{Type: model.KeyBackspace, Alt: true}, true
+			case b == 0x20:
+				return model.KeyMsg{Type: model.KeySpace, Alt: true}, true
+			case b > 0x20 && b <= 126:
+				return model.KeyMsg{Type: model.KeyRune, Rune: rune(b), Alt: true}, true
+			}
+		}
+
+		// Modified cursor and F1-F4 keys: ESC [ 1 ; <mod> A/B/C/D/H/F/P/Q/R/S
+		if len(data) == 6 && data[1] == '[' && data[2] == '1' && data[3] == ';' {
+			if key, ok := p.parseModifiedCursorKey(data[4], data[5]); ok {
+				return key, true
+			}
+		}
+
 		// Arrow keys: ESC [ A/B/C/D
 		if len(data) == 3 && data[1] == '[' {
 			switch data[2] {
@@ -141,15 +163,60 @@ func (p *Parser) ParseKey(data []byte) (model.KeyMsg, bool) {
 		}
 
 		// Alternative Home/End sequences: ESC [ H / ESC [ F
+		// Back-tab (Shift+Tab): ESC [ Z
 		if len(data) == 3 && data[1] == '[' {
 			switch data[2] {
 			case 'H':
 				return model.KeyMsg{Type: model.KeyHome}, true
 			case 'F':
 				return model.KeyMsg{Type: model.KeyEnd}, true
+			case 'Z':
+				return model.KeyMsg{Type: model.KeyTab, Shift: true}, true
 			}
 		}
 	}
 
 	return model.KeyMsg{}, false
 }
+
+// parseModifiedCursorKey parses the modifier and final byte of an
+// xterm-style modified cursor or F1-F4 key (ESC [ 1 ; mod X).
+//
+// The modifier parameter is 1 + a bitmask: 1 Shift, 2 Alt, 4 Ctrl.
+func (p *Parser) parseModifiedCursorKey(mod, final byte) (model.KeyMsg, bool) {
+	if mod < '2' || mod > '8' {
+		return model.KeyMsg{}, false
+	}
+
+	var key model.KeyMsg
+	switch final {
+	case 'A':
+		key.Type = model.KeyUp
+	case 'B':
+		key.Type = model.KeyDown
+	case 'C':
+		key.Type = model.KeyRight
+	case 'D':
+		key.Type = model.KeyLeft
+	case 'H':
+		key.Type = model.KeyHome
+	case 'F':
+		key.Type = model.KeyEnd
+	case 'P':
+		key.Type = model.KeyF1
+	case 'Q':
+		key.Type = model.KeyF2
+	case 'R':
+		key.Type = model.KeyF3
+	case 'S':
+		key.Type = model.KeyF4
+	default:
+		return model.KeyMsg{}, false
+	}
+
+	bits := mod - '1'
+	key.Shift = bits&1 != 0
+	key.Alt = bits&2 != 0
+	key.Ctrl = bits&4 != 0
+	return key, true
+}
@@ -158,6 +158,83 @@ func TestParser_ParseKey_ArrowKeys(t *testing.T) {
 	}
 }
 
+func TestParser_ParseKey_ModifiedCursorKeys(t *testing.T) {
+	p := ansi.NewParser()
+
+	tests := []struct {
+		name  string
+		input string
+		want  model.KeyMsg
+	}{
+		{"ctrl+left", "\x1b[1;5D", model.KeyMsg{Type: model.KeyLeft, Ctrl: true}},
+		{"ctrl+right", "\x1b[1;5C", model.KeyMsg{Type: model.KeyRight, Ctrl: true}},
+		{"alt+left", "\x1b[1;3D", model.KeyMsg{Type: model.KeyLeft, Alt: true}},
+		{"shift+up", "\x1b[1;2A", model.KeyMsg{Type: model.KeyUp, Shift: true}},
+		{"ctrl+shift+end", "\x1b[1;6F", model.KeyMsg{Type: model.KeyEnd, Ctrl: true, Shift: true}},
+		{"shift+f3", "\x1b[1;2R", model.KeyMsg{Type: model.KeyF3, Shift: true}},
+		{"ctrl+f1", "\x1b[1;5P", model.KeyMsg{Type: model.KeyF1, Ctrl: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := p.ParseKey([]byte(tt.input))
+
+			if !ok {
+				t.Fatal("should parse modified cursor key")
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseKey() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_ParseKey_ShiftTab(t *testing.T) {
+	p := ansi.NewParser()
+
+	got, ok := p.ParseKey([]byte("\x1b[Z"))
+	if !ok {
+		t.Fatal("should parse back-tab")
+	}
+
+	want := model.KeyMsg{Type: model.KeyTab, Shift: true}
+	if got != want {
+		t.Errorf("ParseKey() = %+v, want %+v", got, want)
+	}
+	if got.String() != "shift+tab" {
+		t.Errorf("String() = %q, want %q", got.String(), "shift+tab")
+	}
+}
+
+func TestParser_ParseKey_AltKeys(t *testing.T) {
+	p := ansi.NewParser()
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  model.KeyMsg
+	}{
+		{"alt+b", []byte{0x1B, 'b'}, model.KeyMsg{Type: model.KeyRune, Rune: 'b', Alt: true}},
+		{"alt+.", []byte{0x1B, '.'}, model.KeyMsg{Type: model.KeyRune, Rune: '.', Alt: true}},
+		{"alt+backspace", []byte{0x1B, 0x7F}, model.KeyMsg{Type: model.KeyBackspace, Alt: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := p.ParseKey(tt.input)
+
+			if !ok {
+				t.Fatal("should parse alt key")
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseKey() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParser_ParseKey_CtrlKeys(t *testing.T) {
 	p := ansi.NewParser()
 
@@ -276,7 +353,8 @@ func TestParser_ParseKey_InvalidSequences(t *testing.T) {
 		name  string
 		input []byte
 	}{
-		{"invalid ESC sequence", []byte{0x1B, 'X'}},
+		{"invalid ESC sequence", []byte{0x1B, 0x01}},
+		{"unknown modifier", []byte{0x1B, '[', '1', ';', '9', 'D'}},
 		{"incomplete arrow", []byte{0x1B, '['}},
 		{"unknown function key", []byte{0x1B, '[', '9', '9', '~'}},
 	}
@@ -3,12 +3,23 @@ package input
 
 import (
 	"bufio"
+	"bytes"
 	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
 
 	"github.com/phoenix-tui/phoenix/tea/internal/domain/model"
 	"github.com/phoenix-tui/phoenix/tea/internal/infrastructure/ansi"
 )
 
+// Bracketed paste markers (DEC mode 2004).
+// When enabled, terminals wrap pasted text in these sequences.
+var (
+	pasteStart = []byte("\x1b[200~")
+	pasteEnd   = []byte("\x1b[201~")
+)
+
 // Reader reads input from stdin and parses it into messages.
 // Supports cancellation for ExecProcess stdin release.
 //
@@ -17,6 +28,7 @@ type Reader struct {
 	reader           *bufio.Reader
 	parser           *ansi.Parser
 	cancelableReader *CancelableReader // For cancellation support
+	cursorRequests   *atomic.Int32     // Unanswered cursor position requests
 }
 
 // NewReader creates a new input reader with cancellation support.
@@ -25,16 +37,56 @@ type Reader struct {
 // allowing Cancel() to immediately unblock any pending Read() calls.
 // This is essential for ExecProcess to cleanly release stdin.
 func NewReader(r io.Reader) *Reader {
+	return NewReaderWithPending(r, nil)
+}
+
+// NewReaderWithPending creates an input reader that parses pending before
+// reading from r. Use it for input that was already read from r elsewhere,
+// such as keys typed while the terminal was queried for the cursor position.
+func NewReaderWithPending(r io.Reader, pending []byte) *Reader {
 	// Wrap with CancelableReader for cancellation support
 	cancelableReader := NewCancelableReader(r)
 
+	var src io.Reader = cancelableReader
+	if len(pending) > 0 {
+		src = io.MultiReader(bytes.NewReader(pending), cancelableReader)
+	}
+
 	return &Reader{
-		reader:           bufio.NewReader(cancelableReader),
+		reader:           bufio.NewReader(src),
 		parser:           ansi.NewParser(),
 		cancelableReader: cancelableReader,
 	}
 }
 
+// TrackCursorRequests makes the reader parse cursor position reports only
+// while requests counts unanswered requests, and count each report it
+// delivers as an answer. Other "ESC [ n ; m R" sequences, like Shift+F3
+// (ESC [ 1 ; 2 R), are parsed as keys. The counter is shared so requests
+// outlive a reader that is replaced (e.g. after ExecProcess).
+//
+// Without a counter every such sequence is a cursor position report.
+func (ir *Reader) TrackCursorRequests(requests *atomic.Int32) {
+	ir.cursorRequests = requests
+}
+
+// answerCursorRequest reports whether a cursor position report is expected,
+// counting it as answered if so.
+func (ir *Reader) answerCursorRequest() bool {
+	if ir.cursorRequests == nil {
+		return true
+	}
+	for {
+		n := ir.cursorRequests.Load()
+		if n <= 0 {
+			return false
+		}
+		if ir.cursorRequests.CompareAndSwap(n, n-1) {
+			return true
+		}
+	}
+}
+
 // Cancel cancels any pending Read operations.
 // After Cancel(), Read() will return io.EOF.
 //
@@ -70,6 +122,7 @@ func (ir *Reader) IsCanceled() bool {
 //
 // Returns:
 //   - KeyMsg if keyboard input
+//   - PasteMsg if bracketed paste content (ESC [ 200 ~ ... ESC [ 201 ~)
 //   - nil, io.EOF if canceled or stream ended
 //   - nil, error if read fails
 //
@@ -101,6 +154,11 @@ func (ir *Reader) Read() (model.Msg, error) {
 				}
 				seq = append(seq, nextByte)
 
+				// SS3 sequences (ESC O P for F1) continue past the 'O'
+				if i == 0 && nextByte == 'O' && ir.reader.Buffered() > 0 {
+					continue
+				}
+
 				// Stop if we hit a letter or tilde (end of most sequences)
 				if (nextByte >= 'A' && nextByte <= 'Z') ||
 					(nextByte >= 'a' && nextByte <= 'z') ||
@@ -113,6 +171,17 @@ func (ir *Reader) Read() (model.Msg, error) {
 		}
 	}
 
+	// Bracketed paste start - collect everything up to the end marker
+	if bytes.Equal(seq, pasteStart) {
+		return ir.readPaste()
+	}
+
+	// Cursor position report - the reply to a cursor position request.
+	// Unrequested, the same bytes are a modified F3 key.
+	if msg, ok := parseCursorPosition(seq); ok && ir.answerCursorRequest() {
+		return msg, nil
+	}
+
 	// Parse sequence (handles special keys, ANSI sequences, ASCII)
 	keyMsg, ok := ir.parser.ParseKey(seq)
 	if ok {
@@ -147,3 +216,54 @@ func (ir *Reader) Read() (model.Msg, error) {
 	//nolint:nilnil // Intentional: nil msg + nil error = skip this byte, continue reading
 	return nil, nil
 }
+
+// readPaste reads bracketed paste content after the start marker until the
+// end marker and returns it as a single PasteMsg.
+//
+// Terminals send line breaks in pasted text as CR; they are normalized to LF.
+// If the stream ends before the end marker, the content read so far is
+// returned; the error surfaces on the next Read.
+func (ir *Reader) readPaste() (model.Msg, error) {
+	var buf []byte
+	for {
+		b, err := ir.reader.ReadByte()
+		if err != nil {
+			if len(buf) == 0 {
+				return nil, err
+			}
+			return model.PasteMsg{Text: normalizePaste(buf)}, nil
+		}
+		buf = append(buf, b)
+
+		if bytes.HasSuffix(buf, pasteEnd) {
+			return model.PasteMsg{Text: normalizePaste(buf[:len(buf)-len(pasteEnd)])}, nil
+		}
+	}
+}
+
+// parseCursorPosition parses a Cursor Position Report ("ESC [ row ; col R",
+// both 1-based) into a CursorPositionMsg.
+func parseCursorPosition(seq []byte) (model.Msg, bool) {
+	if len(seq) < 6 || seq[0] != 0x1B || seq[1] != '[' || seq[len(seq)-1] != 'R' {
+		return nil, false
+	}
+	rowStr, colStr, ok := strings.Cut(string(seq[2:len(seq)-1]), ";")
+	if !ok {
+		return nil, false
+	}
+	row, err := strconv.Atoi(rowStr)
+	if err != nil || row < 1 || strings.HasPrefix(rowStr, "+") {
+		return nil, false
+	}
+	col, err := strconv.Atoi(colStr)
+	if err != nil || col < 1 || strings.HasPrefix(colStr, "+") {
+		return nil, false
+	}
+	return model.CursorPositionMsg{X: col - 1, Y: row - 1}, true
+}
+
+// normalizePaste converts CRLF and lone CR line endings to LF.
+func normalizePaste(b []byte) string {
+	s := strings.ReplaceAll(string(b), "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
@@ -2,6 +2,7 @@ package input_test
 
 import (
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/phoenix-tui/phoenix/tea/internal/domain/model"
@@ -159,6 +160,28 @@ func TestInputReader_Read_FunctionKey(t *testing.T) {
 	}
 }
 
+func TestInputReader_Read_ModifiedKeys(t *testing.T) {
+	stdin := strings.NewReader("\x1b[1;5D\x1bf")
+
+	reader := input.NewReader(stdin)
+
+	msg, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if key, ok := msg.(model.KeyMsg); !ok || key.Type != model.KeyLeft || !key.Ctrl {
+		t.Errorf("first Read() = %#v, want ctrl+left", msg)
+	}
+
+	msg, err = reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if key, ok := msg.(model.KeyMsg); !ok || key.Rune != 'f' || !key.Alt {
+		t.Errorf("second Read() = %#v, want alt+f", msg)
+	}
+}
+
 func TestInputReader_Read_EOFError(t *testing.T) {
 	stdin := strings.NewReader("")
 
@@ -312,3 +335,99 @@ func TestInputReader_Read_UTF8_Mixed(t *testing.T) {
 		}
 	}
 }
+
+func TestInputReader_Read_BracketedPaste(t *testing.T) {
+	stdin := strings.NewReader("\x1b[200~line one\r\nline two\rend\x1b[201~x")
+
+	reader := input.NewReader(stdin)
+
+	msg, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+
+	pasteMsg, ok := msg.(model.PasteMsg)
+	if !ok {
+		t.Fatalf("expected PasteMsg, got %T", msg)
+	}
+	if want := "line one\nline two\nend"; pasteMsg.Text != want {
+		t.Errorf("Text = %q, want %q", pasteMsg.Text, want)
+	}
+
+	// Input after the paste is parsed normally
+	msg, err = reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if keyMsg, ok := msg.(model.KeyMsg); !ok || keyMsg.Rune != 'x' {
+		t.Errorf("expected KeyMsg 'x' after paste, got %v", msg)
+	}
+}
+
+func TestInputReader_Read_BracketedPaste_Unterminated(t *testing.T) {
+	stdin := strings.NewReader("\x1b[200~partial")
+
+	reader := input.NewReader(stdin)
+
+	msg, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if pasteMsg, ok := msg.(model.PasteMsg); !ok || pasteMsg.Text != "partial" {
+		t.Errorf("expected partial PasteMsg, got %v", msg)
+	}
+}
+
+func TestInputReader_Read_CursorPosition(t *testing.T) {
+	reader := input.NewReader(strings.NewReader("\x1b[12;40R"))
+
+	msg, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if want := (model.CursorPositionMsg{X: 39, Y: 11}); msg != want {
+		t.Errorf("Read() = %#v, want %#v", msg, want)
+	}
+}
+
+func TestInputReader_Read_CursorPositionOnlyWhenRequested(t *testing.T) {
+	var requests atomic.Int32
+	reader := input.NewReader(strings.NewReader("\x1b[1;2R\x1b[1;2R"))
+	reader.TrackCursorRequests(&requests)
+
+	// Nothing requested: Shift+F3
+	msg, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if want := (model.KeyMsg{Type: model.KeyF3, Shift: true}); msg != want {
+		t.Errorf("unrequested: Read() = %#v, want %#v", msg, want)
+	}
+
+	// Requested: the reply, which answers the request
+	requests.Add(1)
+	msg, err = reader.Read()
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if want := (model.CursorPositionMsg{X: 1, Y: 0}); msg != want {
+		t.Errorf("requested: Read() = %#v, want %#v", msg, want)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("requests = %d after the reply, want 0", n)
+	}
+}
+
+func TestInputReader_Read_Pending(t *testing.T) {
+	reader := input.NewReaderWithPending(strings.NewReader("b"), []byte("a"))
+
+	for _, want := range []rune{'a', 'b'} {
+		msg, err := reader.Read()
+		if err != nil {
+			t.Fatalf("Read failed: %v", err)
+		}
+		if key, ok := msg.(model.KeyMsg); !ok || key.Rune != want {
+			t.Errorf("Read() = %#v, want %q", msg, want)
+		}
+	}
+}
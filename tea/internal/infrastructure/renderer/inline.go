@@ -25,8 +25,19 @@ const (
 
 	// carriageReturn moves the cursor to column 0 of the current line.
 	carriageReturn = "\r"
+
+	// syncBegin and syncEnd bracket a frame in synchronized output mode
+	// (DEC private mode 2026): the terminal draws the whole frame at once.
+	syncBegin = "\x1b[?2026h"
+	syncEnd   = "\x1b[?2026l"
 )
 
+// Synchronized wraps frame in synchronized output begin/end sequences so
+// that supporting terminals draw it atomically, without tearing.
+func Synchronized(frame string) string {
+	return syncBegin + frame + syncEnd
+}
+
 // cursorUp returns the ANSI sequence to move the cursor up n lines.
 // Returns empty string if n <= 0.
 func cursorUp(n int) string {
@@ -50,6 +61,7 @@ type InlineRenderer struct {
 	lastView      string   // raw string of the previous render (for identical-frame check)
 	lastLines     []string // previous render split by "\n" (for per-line diffing)
 	linesRendered int      // number of lines written in the previous render
+	synchronized  bool     // wrap each write in synchronized output sequences
 	mu            sync.Mutex
 }
 
@@ -84,6 +96,9 @@ func (r *InlineRenderer) Render(view string) error { //nolint:gocognit,gocyclo,c
 	}
 
 	buf := &bytes.Buffer{}
+	if r.synchronized {
+		buf.WriteString(syncBegin)
+	}
 
 	// Move cursor back to the top of the previously rendered region.
 	// When linesRendered == 0 this is the first render; no cursor-up needed.
@@ -136,6 +151,9 @@ func (r *InlineRenderer) Render(view string) error { //nolint:gocognit,gocyclo,c
 
 	// Leave cursor at column 0 of the last rendered line for consistent positioning.
 	buf.WriteString(carriageReturn)
+	if r.synchronized {
+		buf.WriteString(syncEnd)
+	}
 
 	_, err := r.out.Write(buf.Bytes())
 	if err != nil {
@@ -150,6 +168,52 @@ func (r *InlineRenderer) Render(view string) error { //nolint:gocognit,gocyclo,c
 	return nil
 }
 
+// PrintAbove writes text above the rendered region, so it scrolls into the
+// terminal history while the live view stays at the bottom.
+//
+// The current frame is erased, text is written line by line (each line ends
+// with CRLF), and the frame tracking is reset so the next Render draws the
+// full view below the printed text. Printed lines are not truncated - they
+// become regular scrollback content. Callers should Render right after.
+func (r *InlineRenderer) PrintAbove(text string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf := &bytes.Buffer{}
+	if r.synchronized {
+		buf.WriteString(syncBegin)
+	}
+
+	// Return to the top of the current frame and erase it.
+	if r.linesRendered > 1 {
+		buf.WriteString(cursorUp(r.linesRendered - 1))
+	}
+	buf.WriteString(carriageReturn)
+	if r.linesRendered > 0 {
+		buf.WriteString(eraseScreenBelow)
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString(line)
+		buf.WriteString(eraseLineRight)
+		buf.WriteString("\r\n")
+	}
+	if r.synchronized {
+		buf.WriteString(syncEnd)
+	}
+
+	if _, err := r.out.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	// The frame is gone; the next Render starts fresh below the printed text.
+	r.linesRendered = 0
+	r.lastView = ""
+	r.lastLines = nil
+
+	return nil
+}
+
 // Repaint clears the per-line diff cache so the next Render call performs a
 // full repaint of all lines. Call this after Resume() to restore the TUI view
 // after an external process has written to the terminal.
@@ -175,6 +239,15 @@ func (r *InlineRenderer) Resize(width, height int) {
 	r.lastLines = nil
 }
 
+// SetSynchronized enables or disables wrapping each write in synchronized
+// output sequences (DEC mode 2026). Enable it only for terminals that
+// support the mode; others would ignore it anyway, at a few bytes per frame.
+func (r *InlineRenderer) SetSynchronized(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.synchronized = enabled
+}
+
 // SetOutput changes the destination writer. Useful for redirecting output
 // without recreating the renderer (e.g., after a terminal handoff).
 func (r *InlineRenderer) SetOutput(w io.Writer) {
@@ -306,6 +306,106 @@ func TestInlineRenderer_EmptyView_AfterContent(t *testing.T) {
 	}
 }
 
+// ─── PrintAbove ──────────────────────────────────────────────────────────────
+
+// TestInlineRenderer_PrintAbove verifies the frame is erased, the text is
+// written with CRLF line endings, and frame tracking is reset.
+func TestInlineRenderer_PrintAbove(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewInlineRenderer(&buf, 80, 24)
+
+	if err := r.Render("frame 1\nframe 2"); err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	buf.Reset()
+
+	if err := r.PrintAbove("log A\nlog B"); err != nil {
+		t.Fatalf("PrintAbove error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, cursorUp(1)+carriageReturn+eraseScreenBelow) {
+		t.Errorf("PrintAbove should move to frame top and erase it, got: %q", out)
+	}
+	if !strings.Contains(out, "log A"+eraseLineRight+"\r\n"+"log B"+eraseLineRight+"\r\n") {
+		t.Errorf("PrintAbove should write each line with CRLF, got: %q", out)
+	}
+	if r.linesRendered != 0 || r.lastView != "" || r.lastLines != nil {
+		t.Error("PrintAbove should reset frame tracking")
+	}
+
+	// Next render starts fresh (no cursor-up) below the printed text.
+	buf.Reset()
+	if err := r.Render("frame 1\nframe 2"); err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if strings.HasPrefix(buf.String(), "\x1b[") {
+		t.Errorf("render after PrintAbove should not move cursor up, got: %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "frame 1") {
+		t.Errorf("render after PrintAbove should repaint all lines, got: %q", buf.String())
+	}
+}
+
+// TestInlineRenderer_Synchronized verifies each write is wrapped in
+// synchronized output sequences when enabled.
+func TestInlineRenderer_Synchronized(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewInlineRenderer(&buf, 80, 24)
+	r.SetSynchronized(true)
+
+	if err := r.Render("line 1\nline 2"); err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	want := syncBegin + carriageReturn + "line 1" + eraseLineRight + "\r\n" +
+		"line 2" + eraseLineRight + carriageReturn + syncEnd
+	if buf.String() != want {
+		t.Errorf("synchronized render:\nwant %q\ngot  %q", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := r.PrintAbove("log"); err != nil {
+		t.Fatalf("PrintAbove error: %v", err)
+	}
+	if out := buf.String(); !strings.HasPrefix(out, syncBegin) || !strings.HasSuffix(out, syncEnd) {
+		t.Errorf("synchronized PrintAbove should be wrapped, got: %q", out)
+	}
+
+	// Disabled again: no sequences.
+	r.SetSynchronized(false)
+	buf.Reset()
+	if err := r.Render("changed"); err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if strings.Contains(buf.String(), "2026") {
+		t.Errorf("unsynchronized render should not contain mode 2026, got: %q", buf.String())
+	}
+}
+
+func TestSynchronized(t *testing.T) {
+	if got := Synchronized("frame"); got != "\x1b[?2026hframe\x1b[?2026l" {
+		t.Errorf("Synchronized() = %q", got)
+	}
+}
+
+// TestInlineRenderer_PrintAbove_BeforeFirstRender verifies printing with no frame.
+func TestInlineRenderer_PrintAbove_BeforeFirstRender(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewInlineRenderer(&buf, 80, 24)
+
+	if err := r.PrintAbove("hello"); err != nil {
+		t.Fatalf("PrintAbove error: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, eraseScreenBelow) {
+		t.Errorf("nothing to erase before the first render, got: %q", out)
+	}
+	if out != carriageReturn+"hello"+eraseLineRight+"\r\n" {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
 // ─── Resize ──────────────────────────────────────────────────────────────────
 
 // TestInlineRenderer_Resize verifies that Resize updates dimensions and forces
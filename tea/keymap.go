@@ -0,0 +1,171 @@
+package tea
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+// KeyBinding associates keys with an action and the help text describing it.
+type KeyBinding struct {
+	// Keys are key strings as returned by KeyMsg.String ("q", "ctrl+c",
+	// "enter", "space"). Arrow keys may be written as "up", "down", "left"
+	// and "right" as well as "↑", "↓", "←" and "→".
+	Keys []string
+	// Help describes the action in help text. Bindings without Help still
+	// match but are left out of the help.
+	Help string
+	// Action identifies the binding; KeyMap.Match returns it.
+	Action string
+}
+
+// KeyMap is a set of key bindings that both dispatches keys and renders the
+// help for them, so the two can't drift apart.
+//
+// KeyMap is a value type: methods return a new key map.
+//
+// Example:
+//
+//	var keys = tea.NewKeyMap(
+//		tea.KeyBinding{Keys: []string{"k", "up"}, Help: "up", Action: "up"},
+//		tea.KeyBinding{Keys: []string{"j", "down"}, Help: "down", Action: "down"},
+//		tea.KeyBinding{Keys: []string{"q", "ctrl+c"}, Help: "quit", Action: "quit"},
+//	)
+//
+//	func (m model) Update(msg tea.Msg) (model, tea.Cmd) {
+//		switch keys.Match(msg) {
+//		case "up":
+//			m.cursor--
+//		case "down":
+//			m.cursor++
+//		case "quit":
+//			return m, tea.Quit()
+//		}
+//		return m, nil
+//	}
+//
+//	func (m model) View() string {
+//		return m.list() + "\n" + keys.ShortHelp() // "k/up up • j/down down • q/ctrl+c quit"
+//	}
+type KeyMap struct {
+	bindings []KeyBinding
+}
+
+// keyAliases maps alternative key names to the names KeyMsg.String uses.
+var keyAliases = map[string]string{
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+	" ":     "space",
+}
+
+// NewKeyMap creates a key map with the given bindings.
+func NewKeyMap(bindings ...KeyBinding) KeyMap {
+	return KeyMap{}.Add(bindings...)
+}
+
+// Add returns a new key map with bindings registered after the existing
+// ones. When several bindings share a key, the first registered wins.
+func (k KeyMap) Add(bindings ...KeyBinding) KeyMap {
+	added := make([]KeyBinding, 0, len(k.bindings)+len(bindings))
+	added = append(added, k.bindings...)
+	for _, b := range bindings {
+		b.Keys = append([]string(nil), b.Keys...)
+		added = append(added, b)
+	}
+	return KeyMap{bindings: added}
+}
+
+// Bindings returns a copy of the registered bindings in registration order.
+func (k KeyMap) Bindings() []KeyBinding {
+	bindings := make([]KeyBinding, len(k.bindings))
+	for i, b := range k.bindings {
+		b.Keys = append([]string(nil), b.Keys...)
+		bindings[i] = b
+	}
+	return bindings
+}
+
+// Match returns the action of the binding for msg, or "" if msg is not a
+// KeyMsg or no binding has its key.
+func (k KeyMap) Match(msg Msg) string {
+	key, ok := msg.(KeyMsg)
+	if !ok {
+		return ""
+	}
+
+	pressed := normalizeKey(key.String())
+	for _, b := range k.bindings {
+		for _, name := range b.Keys {
+			if normalizeKey(name) == pressed {
+				return b.Action
+			}
+		}
+	}
+	return ""
+}
+
+// ShortHelp returns the help on a single line, suitable for a footer:
+//
+//	k/up up • j/down down • q quit
+func (k KeyMap) ShortHelp() string {
+	var parts []string
+	for _, b := range k.bindings {
+		if b.Help == "" || len(b.Keys) == 0 {
+			continue
+		}
+		parts = append(parts, helpKeys(b)+" "+b.Help)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// FullHelp returns the help with one binding per line and the keys in an
+// aligned column:
+//
+//	k/up    up
+//	j/down  down
+//	q       quit
+func (k KeyMap) FullHelp() string {
+	width := 0
+	for _, b := range k.bindings {
+		if b.Help != "" && len(b.Keys) > 0 {
+			width = max(width, core.StringWidth(helpKeys(b)))
+		}
+	}
+
+	var lines []string
+	for _, b := range k.bindings {
+		if b.Help == "" || len(b.Keys) == 0 {
+			continue
+		}
+		lines = append(lines, core.PadRight(helpKeys(b), width+2)+b.Help)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// helpKeys returns the keys of b as shown in help, separated by '/'.
+func helpKeys(b KeyBinding) string {
+	keys := make([]string, len(b.Keys))
+	for i, key := range b.Keys {
+		if key == " " {
+			key = "space" // A blank would be invisible
+		}
+		keys[i] = key
+	}
+	return strings.Join(keys, "/")
+}
+
+// normalizeKey rewrites the key name of a key string (the part after any
+// modifiers) to the name KeyMsg.String uses, so "ctrl+up" matches "ctrl+↑".
+func normalizeKey(key string) string {
+	prefix, name := "", key
+	// The last character is never a separator: "ctrl++" is ctrl and '+'.
+	if i := strings.LastIndex(key[:max(len(key)-1, 0)], "+"); i >= 0 {
+		prefix, name = key[:i+1], key[i+1:]
+	}
+	if alias, ok := keyAliases[name]; ok {
+		name = alias
+	}
+	return prefix + name
+}
@@ -0,0 +1,112 @@
+package tea_test
+
+import (
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/core"
+	"github.com/phoenix-tui/phoenix/tea"
+)
+
+func newTestKeyMap() tea.KeyMap {
+	return tea.NewKeyMap(
+		tea.KeyBinding{Keys: []string{"k", "up"}, Help: "up", Action: "up"},
+		tea.KeyBinding{Keys: []string{"j", "down"}, Help: "down", Action: "down"},
+		tea.KeyBinding{Keys: []string{" "}, Help: "toggle", Action: "toggle"},
+		tea.KeyBinding{Keys: []string{"ctrl+left"}, Action: "word-left"},
+		tea.KeyBinding{Keys: []string{"q", "ctrl+c"}, Help: "quit", Action: "quit"},
+	)
+}
+
+func TestKeyMap_Match(t *testing.T) {
+	keys := newTestKeyMap()
+
+	tests := []struct {
+		name string
+		msg  tea.Msg
+		want string
+	}{
+		{"rune", tea.KeyMsg{Type: tea.KeyRune, Rune: 'k'}, "up"},
+		{"arrow alias", tea.KeyMsg{Type: tea.KeyDown}, "down"},
+		{"space alias", tea.KeyMsg{Type: tea.KeySpace}, "toggle"},
+		{"modified arrow", tea.KeyMsg{Type: tea.KeyLeft, Ctrl: true}, "word-left"},
+		{"dedicated ctrl+c", tea.KeyMsg{Type: tea.KeyCtrlC}, "quit"},
+		{"ctrl+c rune", tea.KeyMsg{Type: tea.KeyRune, Rune: 'c', Ctrl: true}, "quit"},
+		{"modifier must match", tea.KeyMsg{Type: tea.KeyRune, Rune: 'q', Alt: true}, ""},
+		{"unbound", tea.KeyMsg{Type: tea.KeyRune, Rune: 'x'}, ""},
+		{"not a key", tea.WindowSizeMsg{Width: 80, Height: 24}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keys.Match(tt.msg); got != tt.want {
+				t.Errorf("Match(%v) = %q, want %q", tt.msg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyMap_FirstBindingWins(t *testing.T) {
+	keys := tea.NewKeyMap(tea.KeyBinding{Keys: []string{"q"}, Action: "quit"}).
+		Add(tea.KeyBinding{Keys: []string{"q"}, Action: "query"})
+
+	if got := keys.Match(tea.KeyMsg{Type: tea.KeyRune, Rune: 'q'}); got != "quit" {
+		t.Errorf("Match = %q, want quit", got)
+	}
+}
+
+func TestKeyMap_Immutable(t *testing.T) {
+	bindingKeys := []string{"a"}
+	keys := tea.NewKeyMap(tea.KeyBinding{Keys: bindingKeys, Action: "add"})
+	bindingKeys[0] = "b"
+
+	extended := keys.Add(tea.KeyBinding{Keys: []string{"d"}, Action: "delete"})
+	keys.Bindings()[0].Keys[0] = "x"
+
+	if len(keys.Bindings()) != 1 || len(extended.Bindings()) != 2 {
+		t.Errorf("Add modified the original key map")
+	}
+	if got := keys.Match(tea.KeyMsg{Type: tea.KeyRune, Rune: 'a'}); got != "add" {
+		t.Errorf("Match = %q, want add; bindings were changed from outside", got)
+	}
+}
+
+func TestKeyMap_ShortHelp(t *testing.T) {
+	want := "k/up up • j/down down • space toggle • q/ctrl+c quit"
+	if got := newTestKeyMap().ShortHelp(); got != want {
+		t.Errorf("ShortHelp() = %q, want %q", got, want)
+	}
+	if got := tea.NewKeyMap().ShortHelp(); got != "" {
+		t.Errorf("empty ShortHelp() = %q", got)
+	}
+}
+
+func TestKeyMap_FullHelp(t *testing.T) {
+	keys := tea.NewKeyMap(
+		tea.KeyBinding{Keys: []string{"k", "↑"}, Help: "up", Action: "up"},
+		tea.KeyBinding{Keys: []string{"enter"}, Help: "select", Action: "select"},
+		tea.KeyBinding{Keys: []string{"g"}, Action: "hidden"},
+	)
+
+	want := "k/↑    up\nenter  select"
+	if got := keys.FullHelp(); got != want {
+		t.Errorf("FullHelp() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestKeyMap_FullHelp_WideKeys verifies columns align by display width, also
+// when arrows are rendered wide (East Asian Ambiguous width).
+func TestKeyMap_FullHelp_WideKeys(t *testing.T) {
+	defer core.SetAmbiguousWidth(core.AmbiguousWidth())
+	core.SetAmbiguousWidth(2)
+
+	keys := tea.NewKeyMap(
+		tea.KeyBinding{Keys: []string{"↑", "↓"}, Help: "move", Action: "move"},
+		tea.KeyBinding{Keys: []string{"检索"}, Help: "search", Action: "search"},
+		tea.KeyBinding{Keys: []string{"enter"}, Help: "select", Action: "select"},
+	)
+
+	want := "↑/↓  move\n检索   search\nenter  select"
+	if got := keys.FullHelp(); got != want {
+		t.Errorf("FullHelp() =\n%s\nwant\n%s", got, want)
+	}
+}
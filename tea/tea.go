@@ -112,6 +112,7 @@
 //   - internal/domain/service  - Event processing, input handling
 //   - internal/application     - Program orchestration, lifecycle
 //   - tea.go (this file)       - Public API (wrapper types)
+//   - compose.go               - Sub-model helpers (UpdateSub, FocusGroup)
 //
 // # Performance
 //
@@ -123,9 +124,12 @@
 package tea
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os/exec"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	program2 "github.com/phoenix-tui/phoenix/tea/internal/application/program"
@@ -258,6 +262,8 @@ const (
 	MouseButtonRight
 	MouseButtonWheelUp
 	MouseButtonWheelDown
+	MouseButtonWheelLeft  // Horizontal wheel / trackpad scroll left
+	MouseButtonWheelRight // Horizontal wheel / trackpad scroll right
 )
 
 // MouseAction represents what the mouse did.
@@ -310,6 +316,10 @@ func (m MouseMsg) String() string {
 
 // WindowSizeMsg represents a terminal resize event.
 //
+// The program sends one with the current size before the first View, and
+// another whenever the terminal is resized (SIGWINCH on Unix, console polling
+// on Windows). Rapid resizes are debounced into a single message.
+//
 // Zero value: WindowSizeMsg with Width=0, Height=0 is valid but invalid as a window size.
 // Use IsValid() to check if dimensions are positive.
 //
@@ -335,6 +345,44 @@ func (w WindowSizeMsg) IsValid() bool {
 	return internal.IsValid()
 }
 
+// PasteMsg carries text pasted by the user when bracketed paste is enabled
+// (see WithBracketedPaste).
+//
+// The whole paste arrives as one message, so input components can insert it
+// atomically and a pasted newline is never treated as Enter/submit.
+// Line endings are normalized to "\n".
+type PasteMsg struct {
+	Text string
+}
+
+// String returns a human-readable representation.
+func (p PasteMsg) String() string {
+	internal := model2.PasteMsg{Text: p.Text}
+	return internal.String()
+}
+
+// CursorPositionMsg is delivered to Update with the terminal's reply to
+// RequestCursorPosition. X and Y are 0-based.
+type CursorPositionMsg struct {
+	X int // Column
+	Y int // Row
+}
+
+// String returns a human-readable representation.
+func (c CursorPositionMsg) String() string {
+	internal := model2.CursorPositionMsg{X: c.X, Y: c.Y}
+	return internal.String()
+}
+
+// ResumeMsg is delivered to Update when the program resumes after Suspend.
+// The terminal has already been restored and the view redrawn.
+type ResumeMsg struct{}
+
+// String returns a human-readable representation.
+func (r ResumeMsg) String() string {
+	return "resume"
+}
+
 // QuitMsg signals the program to quit.
 // This is a message, not a command. The application can choose to ignore it
 // or perform cleanup before actually quitting.
@@ -399,7 +447,10 @@ func (s SequenceMsg) String() string {
 	return fmt.Sprintf("sequence (%d messages)", len(s.Messages))
 }
 
-// PrintlnMsg is sent by Println command for debugging output.
+// PrintlnMsg is sent by the Println and Printf commands.
+//
+// The program consumes it (Update never sees it): in inline mode the message
+// is printed above the live view, in alt-screen mode it is discarded.
 type PrintlnMsg struct {
 	Message string
 }
@@ -416,10 +467,36 @@ func Quit() Cmd {
 	}
 }
 
-// Println returns a command that prints a message (for debugging).
-func Println(msg string) Cmd {
+// Println returns a command that prints a line above the program's view.
+//
+// Arguments are formatted like fmt.Println (spaces between operands, no
+// trailing newline needed). In inline mode the line scrolls into the terminal
+// history above the live UI without corrupting it - handy for logging progress
+// while a spinner runs. In alt-screen mode there is no scrollback and the
+// output is discarded.
+//
+// Example:
+//
+//	case StepDoneMsg:
+//		return m, tea.Println("✓", msg.Name, "finished in", msg.Elapsed)
+func Println(args ...any) Cmd {
+	text := strings.TrimSuffix(fmt.Sprintln(args...), "\n")
 	return func() Msg {
-		return PrintlnMsg{Message: msg}
+		return PrintlnMsg{Message: text}
+	}
+}
+
+// Printf returns a command that prints a formatted line above the program's
+// view. It behaves like Println with fmt.Sprintf formatting; a trailing
+// newline in the result is not needed and is trimmed.
+//
+// Example:
+//
+//	return m, tea.Printf("downloaded %d/%d files", m.done, m.total)
+func Printf(format string, args ...any) Cmd {
+	text := strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+	return func() Msg {
+		return PrintlnMsg{Message: text}
 	}
 }
 
@@ -431,6 +508,308 @@ func Tick(d time.Duration) Cmd {
 	}
 }
 
+// TickerID identifies a repeating ticker started with EveryWithID.
+type TickerID = service.TickerID
+
+// Every returns a self-sustaining command that fires on a fixed interval
+// aligned to the wall clock, until the program exits.
+//
+// Unlike re-issuing Tick from Update (which drifts by the time spent between
+// ticks), Every fires on multiples of d (e.g. the start of every second) and
+// re-schedules itself, so Update doesn't have to return it again.
+//
+// Example:
+//
+//	func (m Model) Init() tea.Cmd {
+//		return tea.Every(time.Second, func(t time.Time) tea.Msg {
+//			return ClockMsg{Time: t}
+//		})
+//	}
+//
+// Use EveryWithID if the ticker must be stopped later.
+// Returns nil if d is not positive.
+func Every(d time.Duration, fn func(time.Time) Msg) Cmd {
+	if fn == nil {
+		return nil
+	}
+	return wrapInternalCmd(service.Every(d, func(t time.Time) model2.Msg {
+		return convertMsgToInternal(fn(t))
+	}))
+}
+
+// EveryWithID is like Every, but registers the ticker under id so it can be
+// stopped with CancelEvery. Starting a ticker with an id that is already
+// running replaces the previous ticker. Ids are scoped to the program and
+// claimed when the command runs, so an EveryWithID built but not returned
+// stops nothing. Commands still take effect in the order they were created:
+// batching CancelEvery(id) with a new EveryWithID(id, ...) never stops the
+// new ticker.
+//
+// Example:
+//
+//	case tea.KeyMsg:
+//		if m.running {
+//			m.running = false
+//			return m, tea.CancelEvery("countdown")
+//		}
+//		m.running = true
+//		return m, tea.EveryWithID("countdown", time.Second, func(t time.Time) tea.Msg {
+//			return CountdownMsg{}
+//		})
+//
+// Returns nil if d is not positive.
+func EveryWithID(id TickerID, d time.Duration, fn func(time.Time) Msg) Cmd {
+	if fn == nil {
+		return nil
+	}
+	return wrapInternalCmd(service.EveryWithID(id, d, func(t time.Time) model2.Msg {
+		return convertMsgToInternal(fn(t))
+	}))
+}
+
+// CancelEvery returns a command that stops the ticker registered under id.
+// Only a ticker started by an EveryWithID created before CancelEvery is
+// affected. At most one already-queued tick may still reach Update after
+// cancellation.
+func CancelEvery(id TickerID) Cmd {
+	return wrapInternalCmd(service.CancelEvery(id))
+}
+
+// StdinIsTerminalMsg is sent by ReadStdin when stdin is an interactive
+// terminal, meaning nothing was piped into the program.
+type StdinIsTerminalMsg struct{}
+
+// String returns a human-readable representation.
+func (s StdinIsTerminalMsg) String() string {
+	return "stdin is a terminal"
+}
+
+// ReadStdin returns a command that reads piped or redirected stdin to EOF and
+// delivers fn(content), for the `cat file | mytool` pattern where piped data
+// seeds the TUI's initial state.
+//
+// If stdin is a terminal the command does not block: it sends
+// StdinIsTerminalMsg instead of calling fn.
+//
+// Example:
+//
+//	func (m Model) Init() tea.Cmd {
+//		return tea.ReadStdin(func(s string) tea.Msg { return PipedMsg{Text: s} })
+//	}
+//
+//	func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+//		switch msg := msg.(type) {
+//		case PipedMsg:
+//			m.lines = strings.Split(msg.Text, "\n")
+//		case tea.StdinIsTerminalMsg:
+//			// Interactive start - nothing piped in
+//		}
+//		return m, nil
+//	}
+//
+// The program never parses keys from piped input, so all piped bytes reach
+// fn. For keystrokes as well, pass the controlling terminal explicitly, e.g.
+// WithInput(tty) with tty opened from /dev/tty (CONIN$ on Windows); ReadStdin
+// then still reads os.Stdin.
+func ReadStdin(fn func(string) Msg) Cmd {
+	var callback func(string) model2.Msg
+	if fn != nil {
+		callback = func(s string) model2.Msg {
+			return convertMsgToInternal(fn(s))
+		}
+	}
+	return wrapInternalCmd(service.ReadStdin(callback))
+}
+
+// EnterAltScreen returns a command that switches a running program to the
+// alternate screen buffer (full-screen mode), as if it had been created
+// with WithAltScreen. The view is redrawn in full on the new screen.
+//
+// Together with ExitAltScreen this lets a program start inline and go
+// full-screen only when needed:
+//
+//	case KeyMsg:
+//		switch msg.String() {
+//		case "enter":
+//			m.detail = true
+//			return m, tea.EnterAltScreen()
+//		case "esc":
+//			m.detail = false
+//			return m, tea.ExitAltScreen()
+//		}
+//
+// The program consumes the message; Update never sees it. When the program
+// exits, the alternate screen is left whichever way it was entered.
+func EnterAltScreen() Cmd {
+	return wrapInternalCmd(service.EnterAltScreen())
+}
+
+// ExitAltScreen returns a command that switches a running program from the
+// alternate screen buffer back to inline rendering. The normal screen comes
+// back as it was and the view is redrawn in full in place of the last
+// inline frame. Does nothing if the program is not in alt-screen mode.
+func ExitAltScreen() Cmd {
+	return wrapInternalCmd(service.ExitAltScreen())
+}
+
+// RequestCursorPosition returns a command that asks the terminal where the
+// cursor is. The reply is delivered to Update as a CursorPositionMsg, after
+// the latest frame has been drawn; nothing arrives if the terminal does not
+// answer.
+//
+// Use it instead of the terminal's GetCursorPosition while a program runs:
+// the reply is read by the program's input reader, so it is not raced for
+// and keys typed meanwhile are delivered as usual.
+//
+//	func (m Model) Init() tea.Cmd {
+//		return tea.RequestCursorPosition()
+//	}
+//
+//	case tea.CursorPositionMsg:
+//		m.originY = msg.Y
+func RequestCursorPosition() Cmd {
+	return wrapInternalCmd(service.RequestCursorPosition())
+}
+
+// Suspend returns a command that suspends the program like Ctrl+Z does in a
+// regular shell command.
+//
+// The terminal is restored to its normal state (alternate screen left, cursor
+// shown, cooked mode) and the process is stopped. When the user brings it back
+// with fg, the previous terminal state is re-applied, the view is redrawn and
+// Update receives a ResumeMsg. Raw mode delivers ctrl+z as a key, so bind it
+// explicitly:
+//
+//	case KeyMsg:
+//		if msg.String() == "ctrl+z" {
+//			return m, tea.Suspend()
+//		}
+//
+// On Windows (no job control) the program resumes immediately.
+func Suspend() Cmd {
+	return wrapInternalCmd(service.Suspend())
+}
+
+// Exec returns a command that runs an external process (e.g. $EDITOR) with
+// full control of the terminal.
+//
+// The TUI is suspended while cmd runs and restored afterwards, exactly as it
+// was (alternate screen, raw mode, hidden cursor). fn receives the process
+// error (nil on success) and the message it returns is delivered to Update;
+// fn may be nil. Unlike Program.ExecProcess, no reference to the Program is
+// needed.
+//
+// Example:
+//
+//	case EditMsg:
+//		c := exec.Command(os.Getenv("EDITOR"), m.msgFile)
+//		return m, tea.Exec(c, func(err error) tea.Msg {
+//			return EditorClosedMsg{Err: err}
+//		})
+//
+// Returns nil if cmd is nil.
+func Exec(cmd *exec.Cmd, fn func(error) Msg) Cmd {
+	var callback func(error) model2.Msg
+	if fn != nil {
+		callback = func(err error) model2.Msg {
+			return convertMsgToInternal(fn(err))
+		}
+	}
+	return wrapInternalCmd(service.Exec(cmd, callback))
+}
+
+// WithTimeout returns a command that races cmd against a timer and delivers
+// whichever finishes first: cmd's message, or onTimeout() after d.
+//
+// The slower result is discarded, so a command that completes after its
+// timeout never causes a late, duplicate Update. The command's goroutine is
+// not interrupted; give it a context if it must stop working.
+//
+// Example:
+//
+//	return m, tea.WithTimeout(5*time.Second, fetchUser(id), func() tea.Msg {
+//		return FetchFailedMsg{Err: errors.New("request timed out")}
+//	})
+//
+// A panic in cmd is not swallowed, even after the timeout fired: WithRecover
+// reports it as a *PanicError with the stack of cmd; without WithRecover it
+// crashes the program.
+//
+// After a timeout the command returns a TimeoutMsg carrying onTimeout's
+// message, which the program unwraps, so Update receives onTimeout's message
+// itself. A nil onTimeout sends no message on timeout. Returns nil if cmd is
+// nil, and cmd unchanged if d is not positive.
+func WithTimeout(d time.Duration, cmd Cmd, onTimeout func() Msg) Cmd {
+	if cmd == nil {
+		return nil
+	}
+	if d <= 0 {
+		return cmd
+	}
+	var timeoutMsg func() model2.Msg
+	if onTimeout != nil {
+		timeoutMsg = func() model2.Msg { return onTimeout() }
+	}
+	timed := service.WithTimeout(d, convertCmdToInternal(cmd), timeoutMsg)
+	return func() Msg {
+		msg := timed()
+		if repeat, ok := msg.(model2.RepeatMsg); ok {
+			return TimeoutMsg{Msg: repeat.Msg, late: repeat.Next}
+		}
+		return msg
+	}
+}
+
+// TimeoutMsg is returned by a WithTimeout command whose command did not
+// finish in time. Msg is the message of onTimeout, or nil.
+//
+// Programs deliver Msg to Update in its place, so Update never sees
+// TimeoutMsg; it shows up when the command is called directly, e.g. in a
+// unit test:
+//
+//	msg := tea.WithTimeout(time.Millisecond, slowFetch, onTimeout)()
+//	if timeout, ok := msg.(tea.TimeoutMsg); ok {
+//		// timeout.Msg is onTimeout's message
+//	}
+type TimeoutMsg struct {
+	Msg Msg
+
+	late model2.Cmd // Raises a panic of the abandoned command (see WithTimeout)
+}
+
+// String returns a human-readable representation.
+func (t TimeoutMsg) String() string {
+	return fmt.Sprintf("timeout (%T)", t.Msg)
+}
+
+// Debounce returns a command that runs cmd after d, unless another Debounce
+// with the same key is returned from Update in the meantime, which replaces
+// it. Use it for search-as-you-type: only the last keystroke within d
+// triggers a request, and its result arrives as cmd's normal message.
+//
+// The timers are managed by the program, so each program has its own keys,
+// and a key is only claimed when the command runs: a Debounce built but not
+// returned cancels nothing. A result that arrives after a newer Debounce with
+// the same key ran is discarded, so a slow earlier search never overwrites a
+// newer one.
+//
+// Example:
+//
+//	case tea.KeyMsg:
+//		m.input, _ = m.input.Update(msg)
+//		return m, tea.Debounce("search", 300*time.Millisecond, searchAPI(m.input.Value()))
+//
+//	case SearchResultMsg: // Only for the query typed last
+//		m.results = msg.Results
+//
+// Returns nil if cmd is nil, and cmd unchanged if d is not positive.
+func Debounce(key string, d time.Duration, cmd Cmd) Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return wrapInternalCmd(service.Debounce(key, d, convertCmdToInternal(cmd)))
+}
+
 // Batch executes multiple commands concurrently.
 //
 // Commands run in parallel via goroutines, and messages are collected into
@@ -451,6 +830,9 @@ func Tick(d time.Duration) Cmd {
 //	) // All three run concurrently
 //
 // The order of messages in BatchMsg is undefined since commands run in parallel.
+// A panic in one of the commands is not lost in its goroutine: WithRecover
+// reports it as a *PanicError with the stack of that command; without
+// WithRecover it crashes the program.
 func Batch(cmds ...Cmd) Cmd {
 	// Filter out nil commands
 	filtered := make([]Cmd, 0, len(cmds))
@@ -472,19 +854,36 @@ func Batch(cmds ...Cmd) Cmd {
 
 	// Multiple commands: run in parallel
 	return func() Msg {
-		results := make(chan Msg, len(filtered))
+		type result struct {
+			msg      Msg
+			panicked *model2.CommandPanic
+		}
+		// Buffered so commands still running never block on a send.
+		results := make(chan result, len(filtered))
 
 		// Launch all commands in parallel
 		for _, cmd := range filtered {
 			go func(c Cmd) {
-				results <- c()
+				defer func() {
+					if r := recover(); r != nil {
+						p := model2.RecoveredPanic(r, debug.Stack())
+						results <- result{panicked: &p}
+					}
+				}()
+				results <- result{msg: c()}
 			}(cmd)
 		}
 
 		// Collect all results
 		msgs := make([]Msg, 0, len(filtered))
 		for i := 0; i < len(filtered); i++ {
-			msgs = append(msgs, <-results)
+			res := <-results
+			if res.panicked != nil {
+				// Surface in the goroutine running the batch (see
+				// WithRecover), with the stack of the command.
+				panic(*res.panicked)
+			}
+			msgs = append(msgs, res.msg)
 		}
 
 		return BatchMsg{Messages: msgs}
@@ -566,6 +965,10 @@ func Sequence(cmds ...Cmd) Cmd {
 //	p.Run()  // Event loop in main goroutine
 type Program[T any] struct {
 	p *program2.Program[T]
+
+	// finalModel unwraps the user model from the internal program
+	// (captured in New, where T is known to be a model).
+	finalModel func() T
 }
 
 // New creates a new program with the given model.
@@ -589,14 +992,22 @@ func New[T modelConstraint[T]](m T, opts ...Option[T]) *Program[T] {
 		internalOpts = append(internalOpts, program2.Option[T](opt))
 	}
 
+	internal := program2.New(wrapped, internalOpts...)
 	return &Program[T]{
-		p: program2.New(wrapped, internalOpts...),
+		p: internal,
+		finalModel: func() T {
+			if w, ok := internal.Model().(modelWrapper[T]); ok {
+				return w.model
+			}
+			return m
+		},
 	}
 }
 
 // modelWrapper wraps a user model to satisfy the internal model.Model[T] interface.
 type modelWrapper[T modelConstraint[T]] struct {
 	model T
+	cmd   Cmd // Command the last Update returned, before conversion
 }
 
 func (w modelWrapper[T]) Init() model2.Cmd {
@@ -608,7 +1019,16 @@ func (w modelWrapper[T]) Update(msg model2.Msg) (model2.Model[T], model2.Cmd) {
 	publicMsg := convertMsgToPublic(msg)
 	updated, cmd := w.model.Update(publicMsg)
 	internalCmd := convertCmdToInternal(cmd)
-	return modelWrapper[T]{model: updated}, internalCmd
+	return modelWrapper[T]{model: updated, cmd: cmd}, internalCmd
+}
+
+// SourceCmd returns the command the last Update returned, so the message log
+// (WithMessageLog) shows its name rather than the conversion wrapper's.
+func (w modelWrapper[T]) SourceCmd() any {
+	if w.cmd == nil {
+		return nil
+	}
+	return w.cmd
 }
 
 func (w modelWrapper[T]) View() string {
@@ -641,6 +1061,12 @@ func convertMsgToPublic(msg model2.Msg) Msg {
 			Width:  m.Width,
 			Height: m.Height,
 		}
+	case model2.PasteMsg:
+		return PasteMsg{Text: m.Text}
+	case model2.CursorPositionMsg:
+		return CursorPositionMsg{X: m.X, Y: m.Y}
+	case model2.ResumeMsg:
+		return ResumeMsg{}
 	case model2.QuitMsg:
 		return QuitMsg{}
 	case model2.BatchMsg:
@@ -659,6 +1085,8 @@ func convertMsgToPublic(msg model2.Msg) Msg {
 		return PrintlnMsg{Message: m.Message}
 	case service.TickMsg:
 		return TickMsg{Time: m.Time}
+	case service.StdinIsTerminalMsg:
+		return StdinIsTerminalMsg{}
 	default:
 		// Pass through unknown messages as-is
 		return m
@@ -691,6 +1119,12 @@ func convertMsgToInternal(msg Msg) model2.Msg {
 			Width:  m.Width,
 			Height: m.Height,
 		}
+	case PasteMsg:
+		return model2.PasteMsg{Text: m.Text}
+	case CursorPositionMsg:
+		return model2.CursorPositionMsg{X: m.X, Y: m.Y}
+	case ResumeMsg:
+		return model2.ResumeMsg{}
 	case QuitMsg:
 		return model2.QuitMsg{}
 	case BatchMsg:
@@ -709,6 +1143,10 @@ func convertMsgToInternal(msg Msg) model2.Msg {
 		return service.PrintlnMsg{Message: m.Message}
 	case TickMsg:
 		return service.TickMsg{Time: m.Time}
+	case StdinIsTerminalMsg:
+		return service.StdinIsTerminalMsg{}
+	case TimeoutMsg:
+		return model2.RepeatMsg{Msg: convertMsgToInternal(m.Msg), Next: m.late}
 	default:
 		// Pass through unknown messages as-is
 		return m
@@ -726,11 +1164,40 @@ func convertCmdToInternal(cmd Cmd) model2.Cmd {
 	}
 }
 
+// wrapInternalCmd exposes an internal command as a public Cmd.
+// The internal message is passed through as-is; the event loop understands it.
+func wrapInternalCmd(cmd model2.Cmd) Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() Msg {
+		return cmd()
+	}
+}
+
 // Run starts the program and blocks until it quits.
 func (p *Program[T]) Run() error {
 	return p.p.Run()
 }
 
+// RunReturning runs the program like Run and returns the final model with
+// its concrete type, so no type assertion is needed to read results:
+//
+//	final, err := tea.New(formModel{}).RunReturning()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if final.submitted {
+//		fmt.Println("Hello,", final.name)
+//	}
+//
+// The model is returned even when err is non-nil (e.g. context canceled),
+// reflecting the last completed Update.
+func (p *Program[T]) RunReturning() (T, error) {
+	err := p.p.Run()
+	return p.finalModel(), err
+}
+
 // Start starts the program in a goroutine.
 func (p *Program[T]) Start() error {
 	return p.p.Start()
@@ -741,6 +1208,19 @@ func (p *Program[T]) Stop() {
 	p.p.Stop()
 }
 
+// Wait blocks until a program started with Start has stopped and returns
+// why, as Run would: a *PanicError if a panic was recovered (WithRecover),
+// the context's error if it was canceled (WithContext), or nil.
+func (p *Program[T]) Wait() error {
+	return p.p.Wait()
+}
+
+// Err returns why a program started with Start stopped (see Wait), or nil
+// while it is still running.
+func (p *Program[T]) Err() error {
+	return p.p.Err()
+}
+
 // Send sends a message to the event loop.
 func (p *Program[T]) Send(msg Msg) error {
 	internalMsg := convertMsgToInternal(msg)
@@ -775,6 +1255,128 @@ func WithMouseAllMotion[T any]() Option[T] {
 	return Option[T](program2.WithMouseAllMotion[T]())
 }
 
+// WithContext stops the program when ctx is canceled.
+//
+// On cancellation the event loop exits, the terminal is restored
+// (raw mode, alternate screen, cursor) and Run returns ctx.Err().
+//
+// Example:
+//
+//	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+//	defer stop()
+//	p := tea.New(model, tea.WithContext[Model](ctx))
+//	if err := p.Run(); err != nil && !errors.Is(err, context.Canceled) {
+//		log.Fatal(err)
+//	}
+func WithContext[T any](ctx context.Context) Option[T] {
+	return Option[T](program2.WithContext[T](ctx))
+}
+
+// WithFilter installs a message filter that runs before Update.
+//
+// The filter sees the current model and every message, and returns the
+// message to deliver: the same one, a replacement, or nil to drop it.
+// It runs on the event loop goroutine, so reading the model is race-free.
+// This is the single place for global shortcuts, input logging, or
+// rate limiting without threading them through every sub-model.
+//
+// Example - ctrl+c quits from any screen:
+//
+//	p := tea.New(model, tea.WithFilter(func(_ Model, msg tea.Msg) tea.Msg {
+//		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "ctrl+c" {
+//			return tea.QuitMsg{}
+//		}
+//		return msg
+//	}))
+func WithFilter[T modelConstraint[T]](filter func(T, Msg) Msg) Option[T] {
+	if filter == nil {
+		return Option[T](program2.WithFilter[T](nil))
+	}
+	return Option[T](program2.WithFilter[T](func(m model2.Model[T], msg model2.Msg) model2.Msg {
+		var current T
+		if w, ok := m.(modelWrapper[T]); ok {
+			current = w.model
+		}
+		filtered := filter(current, convertMsgToPublic(msg))
+		if filtered == nil {
+			return nil
+		}
+		return convertMsgToInternal(filtered)
+	}))
+}
+
+// WithMaxFPS caps rendering at fps frames per second (default: unlimited).
+//
+// Messages still reach Update immediately; Updates within one frame are
+// coalesced into a single View call. Use this when bursts of events
+// (mouse motion, streaming data) would otherwise re-render on every message.
+//
+// Example:
+//
+//	p := tea.New(model, tea.WithMaxFPS[Model](60))
+func WithMaxFPS[T any](fps int) Option[T] {
+	return Option[T](program2.WithMaxFPS[T](fps))
+}
+
+// WithBracketedPaste enables the terminal's bracketed paste mode.
+//
+// Pasted text is delivered as a single PasteMsg instead of a flood of
+// KeyMsgs. Terminals that don't support the mode fall back to the regular
+// per-key behavior, so Update should keep handling KeyMsg as well.
+//
+// Example:
+//
+//	p := tea.New(model, tea.WithBracketedPaste[Model]())
+//
+//	case tea.PasteMsg:
+//		m.text += msg.Text // Insert whole paste at once
+func WithBracketedPaste[T any]() Option[T] {
+	return Option[T](program2.WithBracketedPaste[T]())
+}
+
+// PanicError is returned by Run when a panic was recovered (see WithRecover).
+// Value holds the original panic value and Stack the stack trace.
+type PanicError = program2.PanicError
+
+// WithRecover recovers panics in Init, Update, View and commands.
+//
+// The terminal is restored (raw mode, alternate screen, cursor) and Run
+// returns a *PanicError instead of crashing with a garbled shell.
+//
+// Example:
+//
+//	p := tea.New(model, tea.WithRecover[Model]())
+//	if err := p.Run(); err != nil {
+//		var perr *tea.PanicError
+//		if errors.As(err, &perr) {
+//			fmt.Fprintf(os.Stderr, "crash: %v\n%s", perr.Value, perr.Stack)
+//		}
+//		os.Exit(1)
+//	}
+func WithRecover[T any]() Option[T] {
+	return Option[T](program2.WithRecover[T]())
+}
+
+// WithMessageLog writes every message flowing through the event loop to w,
+// with a timestamp, followed by the command Update returned for it (if any).
+//
+// The TUI owns stdout, so log to a file and follow it from another terminal:
+//
+//	f, _ := os.Create("debug.log")
+//	defer f.Close()
+//	p := tea.New(model, tea.WithMessageLog[Model](f))
+//
+//	// $ tail -f debug.log
+//	// 15:04:05.123 msg model.KeyMsg: enter
+//	// 15:04:05.123 cmd main.Model.submit.func1
+//
+// Messages are logged as the event loop sees them (built-in types appear
+// under their internal package name). Cheap enough to leave on during
+// development; a nil writer disables logging.
+func WithMessageLog[T any](w io.Writer) Option[T] {
+	return Option[T](program2.WithMessageLog[T](w))
+}
+
 // WithTerminal sets a custom terminal instance (for testing).
 func WithTerminal[T any](term terminal.Terminal) Option[T] {
 	return Option[T](program2.WithTerminal[T](term))
@@ -2,6 +2,12 @@ package tea_test
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -71,6 +77,9 @@ func TestAPI_Program_Lifecycle(t *testing.T) {
 		p.Stop()
 		t.Error("should have stopped after quit message")
 	}
+	if err := p.Wait(); err != nil || p.Err() != nil {
+		t.Errorf("Wait() = %v, Err() = %v after a normal quit, want nil", err, p.Err())
+	}
 }
 
 func TestAPI_Send(t *testing.T) {
@@ -124,6 +133,20 @@ func TestAPI_Quit(t *testing.T) {
 	}
 }
 
+func TestAPI_RunReturning(t *testing.T) {
+	var buf bytes.Buffer
+
+	p := tea.New(TestModel{}, tea.WithOutput[TestModel](&buf), tea.WithInput[TestModel](strings.NewReader("++q")))
+
+	final, err := p.RunReturning()
+	if err != nil {
+		t.Fatalf("RunReturning failed: %v", err)
+	}
+	if final.value != 2 {
+		t.Errorf("final value = %d, want 2", final.value)
+	}
+}
+
 func TestAPI_QuitCommand(t *testing.T) {
 	var buf bytes.Buffer
 
@@ -168,6 +191,31 @@ func TestAPI_Sequence(t *testing.T) {
 	}
 }
 
+func TestAPI_PrintlnPrintf(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  tea.Cmd
+		want string
+	}{
+		{"println single", tea.Println("hello"), "hello"},
+		{"println operands", tea.Println("done", 3, "of", 5), "done 3 of 5"},
+		{"printf", tea.Printf("%d/%d files", 2, 7), "2/7 files"},
+		{"printf trailing newline trimmed", tea.Printf("line\n"), "line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ok := tt.cmd().(tea.PrintlnMsg)
+			if !ok {
+				t.Fatalf("expected PrintlnMsg")
+			}
+			if msg.Message != tt.want {
+				t.Errorf("Message = %q, want %q", msg.Message, tt.want)
+			}
+		})
+	}
+}
+
 func TestAPI_Tick(t *testing.T) {
 	cmd := tea.Tick(10 * time.Millisecond)
 
@@ -176,6 +224,240 @@ func TestAPI_Tick(t *testing.T) {
 	}
 }
 
+type everyTickMsg struct{}
+
+// everyModel counts ticks delivered by tea.Every.
+type everyModel struct {
+	ticks int
+}
+
+func (m everyModel) Init() tea.Cmd {
+	return tea.Every(20*time.Millisecond, func(time.Time) tea.Msg {
+		return everyTickMsg{}
+	})
+}
+
+func (m everyModel) Update(msg tea.Msg) (everyModel, tea.Cmd) {
+	if _, ok := msg.(everyTickMsg); ok {
+		m.ticks++
+	}
+	return m, nil
+}
+
+func (m everyModel) View() string {
+	return fmt.Sprintf("ticks=%d\n", m.ticks)
+}
+
+func TestAPI_Every(t *testing.T) {
+	var buf bytes.Buffer
+
+	p := tea.New(everyModel{}, tea.WithOutput[everyModel](&buf))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Update never re-issues the command - Every keeps itself alive.
+	time.Sleep(150 * time.Millisecond)
+	p.Stop()
+
+	if !strings.Contains(buf.String(), "ticks=3") {
+		t.Errorf("expected at least 3 ticks without re-issuing, got: %q", buf.String())
+	}
+}
+
+func TestAPI_EveryWithID(t *testing.T) {
+	if tea.Every(0, func(time.Time) tea.Msg { return nil }) != nil {
+		t.Error("Every with zero duration should return nil")
+	}
+	if tea.EveryWithID("api-test", time.Second, nil) != nil {
+		t.Error("EveryWithID with nil fn should return nil")
+	}
+	if tea.CancelEvery("api-test") == nil {
+		t.Error("CancelEvery should return command")
+	}
+}
+
+type namedTickMsg struct{}
+
+// namedTickerModel stops its named ticker after stopAfter ticks (0 = never).
+type namedTickerModel struct {
+	ticks     chan<- struct{}
+	stopAfter int
+	count     int
+}
+
+func (m namedTickerModel) Init() tea.Cmd {
+	return tea.EveryWithID("api-ticker", 10*time.Millisecond, func(time.Time) tea.Msg {
+		return namedTickMsg{}
+	})
+}
+
+func (m namedTickerModel) Update(msg tea.Msg) (namedTickerModel, tea.Cmd) {
+	if _, ok := msg.(namedTickMsg); !ok {
+		return m, nil
+	}
+	m.ticks <- struct{}{}
+	if m.count++; m.count == m.stopAfter {
+		return m, tea.CancelEvery("api-ticker")
+	}
+	return m, nil
+}
+
+func (m namedTickerModel) View() string { return "" }
+
+func TestAPI_EveryWithID_PerProgram(t *testing.T) {
+	// Both programs use the same id; cancelling one ticker leaves the other.
+	stopped, running := make(chan struct{}, 100), make(chan struct{}, 100)
+	_ = tea.CancelEvery("api-ticker") // Built but never run: cancels nothing
+
+	for _, m := range []namedTickerModel{{ticks: stopped, stopAfter: 2}, {ticks: running}} {
+		p := tea.New(m, tea.WithInput[namedTickerModel](strings.NewReader("")), tea.WithOutput[namedTickerModel](&bytes.Buffer{}))
+		if err := p.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer p.Stop()
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	if n := len(stopped); n < 2 || n > 3 {
+		t.Errorf("cancelled ticker ticked %d times, want 2 (plus at most one queued)", n)
+	}
+	if n := len(running); n < 5 {
+		t.Errorf("other program's ticker ticked %d times, want it to keep running", n)
+	}
+}
+
+func TestAPI_WithFilter(t *testing.T) {
+	var buf bytes.Buffer
+
+	// Filter swallows '+' and turns 'x' into a global quit.
+	filter := func(_ TestModel, msg tea.Msg) tea.Msg {
+		if key, ok := msg.(tea.KeyMsg); ok {
+			switch key.String() {
+			case "+":
+				return nil
+			case "x":
+				return tea.QuitMsg{}
+			}
+		}
+		return msg
+	}
+
+	p := tea.New(TestModel{}, tea.WithOutput[TestModel](&buf), tea.WithFilter(filter))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	_ = p.Send(tea.KeyMsg{Type: tea.KeyRune, Rune: '+'})
+	_ = p.Send(tea.KeyMsg{Type: tea.KeyRune, Rune: 'x'})
+	time.Sleep(100 * time.Millisecond)
+
+	if p.IsRunning() {
+		p.Stop()
+		t.Error("filter should have turned 'x' into QuitMsg")
+	}
+	if strings.Contains(buf.String(), "Value: 1") {
+		t.Error("filter should have dropped '+'")
+	}
+}
+
+type pasteModel struct {
+	text string
+}
+
+func (m pasteModel) Init() tea.Cmd { return nil }
+
+func (m pasteModel) Update(msg tea.Msg) (pasteModel, tea.Cmd) {
+	if paste, ok := msg.(tea.PasteMsg); ok {
+		m.text = paste.Text
+	}
+	return m, nil
+}
+
+func (m pasteModel) View() string { return "pasted=" + m.text + "\n" }
+
+func TestAPI_PasteMsg(t *testing.T) {
+	var buf bytes.Buffer
+
+	p := tea.New(pasteModel{}, tea.WithOutput[pasteModel](&buf), tea.WithBracketedPaste[pasteModel]())
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Send(tea.PasteMsg{Text: "hello world"}); err != nil {
+		t.Errorf("Send failed: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	p.Stop()
+
+	if !strings.Contains(buf.String(), "pasted=hello world") {
+		t.Errorf("expected PasteMsg to reach Update, got: %q", buf.String())
+	}
+	if got := (tea.PasteMsg{Text: "abc"}).String(); got != "paste: 3 bytes" {
+		t.Errorf("PasteMsg.String() = %q", got)
+	}
+}
+
+type execDoneMsg struct{ err error }
+
+type execModel struct {
+	cmd  *exec.Cmd
+	done string
+}
+
+func (m execModel) Init() tea.Cmd {
+	return tea.Exec(m.cmd, func(err error) tea.Msg { return execDoneMsg{err: err} })
+}
+
+func (m execModel) Update(msg tea.Msg) (execModel, tea.Cmd) {
+	if done, ok := msg.(execDoneMsg); ok {
+		m.done = fmt.Sprintf("err=%v", done.err)
+	}
+	return m, nil
+}
+
+func (m execModel) View() string { return "exec " + m.done + "\n" }
+
+func TestAPI_Exec(t *testing.T) {
+	if tea.Exec(nil, nil) != nil {
+		t.Error("Exec(nil) should return nil")
+	}
+	if tea.Suspend() == nil {
+		t.Error("Suspend() should return a command")
+	}
+	if tea.EnterAltScreen() == nil || tea.ExitAltScreen() == nil {
+		t.Error("EnterAltScreen() and ExitAltScreen() should return commands")
+	}
+	if tea.RequestCursorPosition() == nil {
+		t.Error("RequestCursorPosition() should return a command")
+	}
+	if got := (tea.CursorPositionMsg{X: 4, Y: 2}).String(); got != "cursor position: (4, 2)" {
+		t.Errorf("CursorPositionMsg.String() = %q", got)
+	}
+	if got := (tea.ResumeMsg{}).String(); got != "resume" {
+		t.Errorf("ResumeMsg.String() = %q", got)
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", "exit", "0")
+	} else {
+		cmd = exec.Command("sh", "-c", "exit 0")
+	}
+
+	var buf bytes.Buffer
+	p := tea.New(execModel{cmd: cmd}, tea.WithOutput[execModel](&buf), tea.WithInput[execModel](strings.NewReader("")))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	p.Stop()
+
+	if !strings.Contains(buf.String(), "exec err=<nil>") {
+		t.Errorf("expected Exec callback to reach Update, got: %q", buf.String())
+	}
+}
+
 func TestAPI_KeyTypes(_ *testing.T) {
 	// Just verify constants are accessible
 	_ = tea.KeyEnter
@@ -338,3 +620,259 @@ func BenchmarkAPI_Send(b *testing.B) {
 		_ = p.Send(msg)
 	}
 }
+
+type pipedMsg string
+
+// stdinModel reads piped stdin in Init and quits with the content.
+type stdinModel struct {
+	text string
+}
+
+func (m stdinModel) Init() tea.Cmd {
+	return tea.ReadStdin(func(s string) tea.Msg { return pipedMsg(s) })
+}
+
+func (m stdinModel) Update(msg tea.Msg) (stdinModel, tea.Cmd) {
+	if s, ok := msg.(pipedMsg); ok {
+		m.text = string(s)
+		return m, tea.Quit()
+	}
+	return m, nil
+}
+
+func (m stdinModel) View() string {
+	return ""
+}
+
+func TestAPI_ReadStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	go func() {
+		_, _ = w.WriteString("line 1\nline 2\n")
+		_ = w.Close()
+	}()
+
+	final, err := tea.New(stdinModel{},
+		tea.WithInput[stdinModel](r),
+		tea.WithOutput[stdinModel](&bytes.Buffer{}),
+	).RunReturning()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if final.text != "line 1\nline 2\n" {
+		t.Errorf("piped text = %q", final.text)
+	}
+}
+
+// submitModel submits once it starts and quits when the submission is done.
+type submitModel struct{}
+
+func (m submitModel) Init() tea.Cmd { return func() tea.Msg { return "start" } }
+
+func (m submitModel) Update(msg tea.Msg) (submitModel, tea.Cmd) {
+	switch msg {
+	case "start":
+		return m, m.submit()
+	case "submitted":
+		return m, tea.Quit()
+	}
+	return m, nil
+}
+
+func (m submitModel) View() string { return "" }
+
+func (m submitModel) submit() tea.Cmd {
+	return func() tea.Msg { return "submitted" }
+}
+
+// TestAPI_WithMessageLog verifies the log names the commands the model
+// returned, not the wrappers the public API converts them with.
+func TestAPI_WithMessageLog(t *testing.T) {
+	var log bytes.Buffer
+	p := tea.New(submitModel{},
+		tea.WithInput[submitModel](strings.NewReader("")),
+		tea.WithOutput[submitModel](&bytes.Buffer{}),
+		tea.WithMessageLog[submitModel](&log),
+	)
+	if err := p.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	output := log.String()
+	if !strings.Contains(output, "cmd github.com/phoenix-tui/phoenix/tea_test.submitModel.submit.func1") {
+		t.Errorf("log does not name the submit command:\n%s", output)
+	}
+	if strings.Contains(output, "convertCmdToInternal") {
+		t.Errorf("log names the conversion wrapper:\n%s", output)
+	}
+}
+
+func TestAPI_WithTimeout(t *testing.T) {
+	slow := func() tea.Msg {
+		time.Sleep(time.Second)
+		return "slow"
+	}
+	fast := func() tea.Msg { return "fast" }
+	onTimeout := func() tea.Msg { return "timeout" }
+
+	// Called directly, a timed out command returns a TimeoutMsg.
+	msg := tea.WithTimeout(20*time.Millisecond, slow, onTimeout)()
+	if timeout, ok := msg.(tea.TimeoutMsg); !ok || timeout.Msg != "timeout" {
+		t.Errorf("slow cmd: got %#v, want TimeoutMsg with timeout", msg)
+	}
+	msg = tea.WithTimeout(time.Millisecond, slow, nil)()
+	if timeout, ok := msg.(tea.TimeoutMsg); !ok || timeout.Msg != nil {
+		t.Errorf("slow cmd without onTimeout: got %#v, want empty TimeoutMsg", msg)
+	}
+
+	// Under a program, Update receives onTimeout's message itself.
+	got := make(chan string, 1)
+	m := debounceModel{cmds: []tea.Cmd{tea.WithTimeout(20*time.Millisecond, slow, onTimeout)}, got: got}
+	p := tea.New(m, tea.WithInput[debounceModel](strings.NewReader("")), tea.WithOutput[debounceModel](&bytes.Buffer{}))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Stop()
+	select {
+	case msg := <-got:
+		if msg != "timeout" {
+			t.Errorf("slow cmd: got %v, want timeout", msg)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Error("slow cmd: no message delivered")
+	}
+
+	if msg := tea.WithTimeout(time.Second, fast, onTimeout)(); msg != "fast" {
+		t.Errorf("fast cmd: got %v, want fast", msg)
+	}
+	if tea.WithTimeout(time.Second, nil, onTimeout) != nil {
+		t.Error("nil cmd should return nil")
+	}
+}
+
+// TestAPI_WithTimeout_LatePanic verifies a panic after the timeout fired is
+// still reported by WithRecover.
+func TestAPI_WithTimeout_LatePanic(t *testing.T) {
+	slow := func() tea.Msg {
+		time.Sleep(20 * time.Millisecond)
+		panic("late explosion")
+	}
+	m := debounceModel{cmds: []tea.Cmd{tea.WithTimeout(time.Millisecond, slow, nil)}, got: make(chan string, 1)}
+	p := tea.New(m,
+		tea.WithRecover[debounceModel](),
+		tea.WithInput[debounceModel](strings.NewReader("")),
+		tea.WithOutput[debounceModel](&bytes.Buffer{}),
+	)
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- p.Wait() }()
+	select {
+	case err := <-done:
+		if perr, ok := err.(*tea.PanicError); !ok || perr.Value != "late explosion" {
+			t.Errorf("Wait() = %v, want *tea.PanicError with the late panic", err)
+		}
+	case <-time.After(time.Second):
+		p.Stop()
+		t.Fatal("program did not stop after the late panic")
+	}
+}
+
+// debounceModel starts its commands and forwards the strings they deliver.
+type debounceModel struct {
+	cmds []tea.Cmd
+	got  chan<- string
+}
+
+func (m debounceModel) Init() tea.Cmd { return tea.Batch(m.cmds...) }
+
+func (m debounceModel) Update(msg tea.Msg) (debounceModel, tea.Cmd) {
+	if s, ok := msg.(string); ok {
+		m.got <- s
+	}
+	return m, nil
+}
+
+func (m debounceModel) View() string { return "" }
+
+func batchPanicCmd() tea.Msg { panic("batched command exploded") }
+
+// TestAPI_Batch_PanicRecovered verifies a panic in a batched command, which
+// runs in a goroutine of its own, is reported by WithRecover, also from a
+// nested Sequence.
+func TestAPI_Batch_PanicRecovered(t *testing.T) {
+	ok := func() tea.Msg { return "ok" }
+	m := debounceModel{cmds: []tea.Cmd{tea.Batch(ok, tea.Sequence(ok, batchPanicCmd))}, got: make(chan string, 1)}
+	p := tea.New(m,
+		tea.WithRecover[debounceModel](),
+		tea.WithInput[debounceModel](strings.NewReader("")),
+		tea.WithOutput[debounceModel](&bytes.Buffer{}),
+	)
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- p.Wait() }()
+	select {
+	case err := <-done:
+		perr, isPanic := err.(*tea.PanicError)
+		if !isPanic {
+			t.Fatalf("Wait() = %v, want *tea.PanicError", err)
+		}
+		if perr.Value != "batched command exploded" {
+			t.Errorf("panic value = %v", perr.Value)
+		}
+		if !strings.Contains(string(perr.Stack), "batchPanicCmd") {
+			t.Errorf("stack should point at the command:\n%s", perr.Stack)
+		}
+	case <-time.After(time.Second):
+		p.Stop()
+		t.Fatal("program did not stop after the batched panic")
+	}
+}
+
+func TestAPI_Debounce(t *testing.T) {
+	search := func(query string) tea.Cmd {
+		return tea.Debounce("api-search", 20*time.Millisecond, func() tea.Msg { return query })
+	}
+
+	got := make(chan string, 10)
+	first := debounceModel{cmds: []tea.Cmd{search("ph"), search("pho")}, got: got}
+	_ = search("phoe") // Built but never run: must not supersede "pho"
+	// Another program using the same key is independent.
+	second := debounceModel{cmds: []tea.Cmd{search("other")}, got: got}
+
+	for _, m := range []debounceModel{first, second} {
+		p := tea.New(m, tea.WithInput[debounceModel](strings.NewReader("")), tea.WithOutput[debounceModel](&bytes.Buffer{}))
+		if err := p.Start(); err != nil {
+			t.Fatal(err)
+		}
+		defer p.Stop()
+	}
+
+	var results []string
+	timeout := time.After(200 * time.Millisecond)
+collect:
+	for {
+		select {
+		case s := <-got:
+			results = append(results, s)
+		case <-timeout:
+			break collect
+		}
+	}
+	sort.Strings(results)
+	if !reflect.DeepEqual(results, []string{"other", "pho"}) {
+		t.Errorf("delivered %v, want [other pho]", results)
+	}
+
+	if tea.Debounce("api-search", time.Second, nil) != nil {
+		t.Error("nil cmd should return nil")
+	}
+}
@@ -0,0 +1,68 @@
+package terminal
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrAmbiguousWidthUnknown is returned by ProbeAmbiguousWidth when the
+// terminal cannot report the cursor position.
+var ErrAmbiguousWidthUnknown = errors.New("terminal: cannot probe ambiguous character width")
+
+// ambiguousProbe is an East Asian Ambiguous character (U+00B1 PLUS-MINUS
+// SIGN). Terminals render it one or two cells wide depending on their
+// ambiguous-width setting.
+const ambiguousProbe = "±"
+
+// ProbeAmbiguousWidth asks the terminal how wide it renders East Asian
+// Ambiguous characters by writing one at the cursor and reading back how
+// far the cursor moved. It returns 1 or 2, suitable for
+// core.SetAmbiguousWidth. The probe cell is overwritten with spaces and
+// the cursor is put back where it was.
+//
+// Call it before drawing (for example right after entering the alternate
+// screen) and before starting an input reader, since the cursor position
+// reply is read from stdin. Returns ErrAmbiguousWidthUnknown if
+// SupportsCursorQuery is false.
+//
+// Example:
+//
+//	if w, err := terminal.ProbeAmbiguousWidth(term); err == nil {
+//	    core.SetAmbiguousWidth(w)
+//	}
+func ProbeAmbiguousWidth(t Terminal) (int, error) {
+	if !t.SupportsCursorQuery() {
+		return 0, ErrAmbiguousWidthUnknown
+	}
+
+	x0, y0, err := t.GetCursorPosition()
+	if err != nil {
+		return 0, err
+	}
+	if err := t.Write(ambiguousProbe); err != nil {
+		return 0, err
+	}
+	x1, _, err := t.GetCursorPosition()
+	if err != nil {
+		return 0, err
+	}
+
+	// Erase the probe and restore the cursor.
+	advanced := x1 - x0
+	if advanced > 0 {
+		if err := t.SetCursorPosition(x0, y0); err != nil {
+			return 0, err
+		}
+		if err := t.Write(strings.Repeat(" ", advanced)); err != nil {
+			return 0, err
+		}
+	}
+	if err := t.SetCursorPosition(x0, y0); err != nil {
+		return 0, err
+	}
+
+	if advanced >= 2 {
+		return 2, nil
+	}
+	return 1, nil
+}
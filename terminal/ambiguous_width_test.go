@@ -0,0 +1,66 @@
+package terminal
+
+import (
+	"errors"
+	"testing"
+)
+
+// probeTerminal simulates cursor movement for ProbeAmbiguousWidth.
+// Methods not overridden panic via the nil embedded interface.
+type probeTerminal struct {
+	Terminal
+	ambiguousWidth int
+	queryable      bool
+	x, y           int
+	writes         []string
+}
+
+func (p *probeTerminal) SupportsCursorQuery() bool { return p.queryable }
+
+func (p *probeTerminal) GetCursorPosition() (int, int, error) { return p.x, p.y, nil }
+
+func (p *probeTerminal) SetCursorPosition(x, y int) error {
+	p.x, p.y = x, y
+	return nil
+}
+
+func (p *probeTerminal) Write(s string) error {
+	p.writes = append(p.writes, s)
+	if s == ambiguousProbe {
+		p.x += p.ambiguousWidth
+	} else {
+		p.x += len(s)
+	}
+	return nil
+}
+
+func TestProbeAmbiguousWidth(t *testing.T) {
+	for _, width := range []int{1, 2} {
+		p := &probeTerminal{ambiguousWidth: width, queryable: true, x: 4, y: 3}
+
+		got, err := ProbeAmbiguousWidth(p)
+		if err != nil {
+			t.Fatalf("ProbeAmbiguousWidth() error = %v", err)
+		}
+		if got != width {
+			t.Errorf("ProbeAmbiguousWidth() = %d, want %d", got, width)
+		}
+		if p.x != 4 || p.y != 3 {
+			t.Errorf("cursor = (%d, %d), want restored to (4, 3)", p.x, p.y)
+		}
+		if last := p.writes[len(p.writes)-1]; len(last) != width {
+			t.Errorf("probe erased with %q, want %d spaces", last, width)
+		}
+	}
+}
+
+func TestProbeAmbiguousWidth_Unsupported(t *testing.T) {
+	p := &probeTerminal{queryable: false}
+
+	if _, err := ProbeAmbiguousWidth(p); !errors.Is(err, ErrAmbiguousWidthUnknown) {
+		t.Errorf("error = %v, want ErrAmbiguousWidthUnknown", err)
+	}
+	if len(p.writes) != 0 {
+		t.Errorf("wrote %q to a terminal without cursor query", p.writes)
+	}
+}
@@ -0,0 +1,193 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/terminal/internal/infrastructure/unix"
+	"golang.org/x/term"
+)
+
+// ErrNoBackgroundColor is returned by QueryBackgroundColor and
+// Terminal.BackgroundColor when the terminal does not report its
+// background color.
+var ErrNoBackgroundColor = errors.New("terminal: background color not reported")
+
+const (
+	// osc11Query asks for the background color.
+	osc11Query = "\x1b]11;?\x1b\\"
+
+	// da1Query asks for the primary device attributes. Virtually every
+	// terminal answers it, so its reply marks the end of the responses even
+	// when OSC 11 is not supported.
+	da1Query = "\x1b[c"
+
+	// backgroundQueryTimeout is how long Terminal.BackgroundColor waits for
+	// a reply.
+	backgroundQueryTimeout = 100 * time.Millisecond
+)
+
+// QueryBackgroundColor asks the terminal for its background color (OSC 11)
+// and returns it as 8-bit RGB. This is best-effort: it returns
+// ErrNoBackgroundColor if stdin/stdout are not terminals, the terminal does
+// not support the query, or no reply arrives within timeout.
+//
+// The terminal is briefly switched to raw mode to read the reply from stdin,
+// so call this before starting an event loop that reads input.
+//
+// Example:
+//
+//	r, g, b, err := terminal.QueryBackgroundColor(100 * time.Millisecond)
+//	if err == nil {
+//	    fmt.Printf("background: #%02x%02x%02x\n", r, g, b)
+//	}
+func QueryBackgroundColor(timeout time.Duration) (r, g, b uint8, err error) {
+	return queryBackgroundColor(os.Stdin, os.Stdout, timeout)
+}
+
+// queryBackgroundColor sends the OSC 11 query to out and parses the reply
+// read from in.
+func queryBackgroundColor(in, out *os.File, timeout time.Duration) (r, g, b uint8, err error) {
+	fd := int(in.Fd())
+	if !term.IsTerminal(fd) || !term.IsTerminal(int(out.Fd())) {
+		return 0, 0, 0, ErrNoBackgroundColor
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("terminal: enter raw mode: %w", err)
+	}
+	defer term.Restore(fd, state) //nolint:errcheck // Best-effort restore
+
+	if _, err := out.WriteString(osc11Query + da1Query); err != nil {
+		return 0, 0, 0, fmt.Errorf("terminal: write background query: %w", err)
+	}
+
+	r, g, b, ok := parseOSC11(readReply(in, timeout))
+	if !ok {
+		return 0, 0, 0, ErrNoBackgroundColor
+	}
+	return r, g, b, nil
+}
+
+// readReply reads from in until the DA1 reply arrives or timeout expires,
+// and returns everything read.
+//
+// in is polled before every read and read one byte at a time, so nothing
+// after the DA1 reply is consumed and no reader is left on in when
+// readReply returns: input typed after the query stays available to the
+// application. Without polling support nothing is read.
+func readReply(in *os.File, timeout time.Duration) string {
+	if !unix.CanPoll {
+		return ""
+	}
+
+	fd := int(in.Fd())
+	deadline := time.Now().Add(timeout)
+	var reply strings.Builder
+	buf := make([]byte, 1)
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return reply.String()
+		}
+		ready, err := unix.WaitReadable(fd, remaining)
+		if err != nil || !ready {
+			return reply.String()
+		}
+
+		n, err := in.Read(buf)
+		reply.Write(buf[:n])
+		if hasDA1Reply(reply.String()) || err != nil {
+			return reply.String()
+		}
+	}
+}
+
+// hasDA1Reply reports whether s contains a DA1 reply (ESC [ ? ... c).
+func hasDA1Reply(s string) bool {
+	i := strings.Index(s, "\x1b[?")
+	if i < 0 {
+		return false
+	}
+	for _, c := range s[i+3:] {
+		switch {
+		case c == 'c':
+			return true
+		case c == ';' || (c >= '0' && c <= '9'):
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// parseOSC11 extracts the color from an OSC 11 reply such as
+// "ESC ] 11 ; rgb:RRRR/GGGG/BBBB ESC \" or "ESC ] 11 ; #RRGGBB BEL". Each
+// component may have 1-4 hex digits and is scaled to 8 bits.
+func parseOSC11(s string) (r, g, b uint8, ok bool) {
+	i := strings.Index(s, "\x1b]11;")
+	if i < 0 {
+		return 0, 0, 0, false
+	}
+	s = s[i+len("\x1b]11;"):]
+
+	end := strings.IndexAny(s, "\a\x1b")
+	if end < 0 {
+		return 0, 0, 0, false
+	}
+	s = s[:end]
+
+	var parts []string
+	switch {
+	case strings.HasPrefix(s, "rgb:"):
+		parts = strings.Split(s[len("rgb:"):], "/")
+	case strings.HasPrefix(s, "rgba:"):
+		parts = strings.Split(s[len("rgba:"):], "/")
+	case strings.HasPrefix(s, "#"):
+		parts = splitHexColor(s[1:])
+	}
+	if len(parts) < 3 {
+		return 0, 0, 0, false
+	}
+
+	var rgb [3]uint8
+	for j := range rgb {
+		c, valid := parseColorComponent(parts[j])
+		if !valid {
+			return 0, 0, 0, false
+		}
+		rgb[j] = c
+	}
+	return rgb[0], rgb[1], rgb[2], true
+}
+
+// splitHexColor splits the digits of a "#RGB", "#RRGGBB", "#RRRGGGBBB" or
+// "#RRRRGGGGBBBB" color into its three components. It returns nil if the
+// number of digits is not one of those.
+func splitHexColor(s string) []string {
+	if len(s) == 0 || len(s)%3 != 0 || len(s) > 12 {
+		return nil
+	}
+	n := len(s) / 3
+	return []string{s[:n], s[n : 2*n], s[2*n:]}
+}
+
+// parseColorComponent parses a 1-4 digit hex color component and scales it
+// to 8 bits.
+func parseColorComponent(s string) (uint8, bool) {
+	if s == "" || len(s) > 4 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s, 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	maxValue := uint64(1)<<(4*len(s)) - 1
+	return uint8((v*255 + maxValue/2) / maxValue), true
+}
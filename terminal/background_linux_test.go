@@ -0,0 +1,80 @@
+package terminal
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"testing"
+
+	sysunix "golang.org/x/sys/unix"
+)
+
+// openPTY opens a pseudo-terminal pair, or skips the test if none is
+// available.
+func openPTY(t *testing.T) (master, slave *os.File) {
+	t.Helper()
+
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("no pseudo-terminal available: %v", err)
+	}
+	fd := int(master.Fd())
+	if err := sysunix.IoctlSetPointerInt(fd, sysunix.TIOCSPTLCK, 0); err != nil {
+		master.Close()
+		t.Skipf("unlock pseudo-terminal: %v", err)
+	}
+	n, err := sysunix.IoctlGetInt(fd, sysunix.TIOCGPTN)
+	if err != nil {
+		master.Close()
+		t.Skipf("get pseudo-terminal number: %v", err)
+	}
+	slave, err = os.OpenFile("/dev/pts/"+strconv.Itoa(n), os.O_RDWR|sysunix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		t.Skipf("open pseudo-terminal: %v", err)
+	}
+	t.Cleanup(func() {
+		slave.Close()
+		master.Close()
+	})
+	return master, slave
+}
+
+func TestTerminal_BackgroundColor_KeepsLaterInput(t *testing.T) {
+	master, slave := openPTY(t)
+
+	stdin, stdout := os.Stdin, os.Stdout
+	os.Stdin, os.Stdout = slave, slave
+	defer func() { os.Stdin, os.Stdout = stdin, stdout }()
+
+	// Play the terminal: answer the query once it arrives.
+	go func() {
+		var got []byte
+		buf := make([]byte, 256)
+		for !bytes.Contains(got, []byte(da1Query)) {
+			n, err := master.Read(buf)
+			if err != nil {
+				return
+			}
+			got = append(got, buf[:n]...)
+		}
+		master.WriteString("\x1b]11;rgb:2828/2c2c/3434\x1b\\\x1b[?62;22c")
+	}()
+
+	r, g, b, err := NewANSI().BackgroundColor()
+	if err != nil {
+		t.Fatalf("BackgroundColor() error = %v", err)
+	}
+	if r != 40 || g != 44 || b != 52 {
+		t.Errorf("BackgroundColor() = (%d, %d, %d), want (40, 44, 52)", r, g, b)
+	}
+
+	// The user types after the query; the application must still get it.
+	master.WriteString("k\n")
+	assertNextInput(t, slave, "k\n")
+
+	// A query that times out must not leave a reader behind either.
+	NewANSI().BackgroundColor() //nolint:errcheck // Expected to time out
+	master.WriteString("j\n")
+	assertNextInput(t, slave, "j\n")
+}
@@ -0,0 +1,142 @@
+package terminal
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/phoenix-tui/phoenix/terminal/internal/infrastructure/unix"
+	"golang.org/x/term"
+)
+
+func TestParseOSC11(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		r, g, b uint8
+		ok      bool
+	}{
+		{"16-bit ST", "\x1b]11;rgb:ffff/ffff/ffff\x1b\\", 255, 255, 255, true},
+		{"16-bit BEL", "\x1b]11;rgb:1e1e/1e1e/2e2e\a", 30, 30, 46, true},
+		{"8-bit", "\x1b]11;rgb:28/2c/34\x1b\\", 40, 44, 52, true},
+		{"4-bit", "\x1b]11;rgb:f/0/8\a", 255, 0, 136, true},
+		{"rgba", "\x1b]11;rgba:0000/0000/0000/ffff\a", 0, 0, 0, true},
+		{"Followed by DA1", "\x1b]11;rgb:0000/8080/ffff\x1b\\\x1b[?62;22c", 0, 128, 255, true},
+		{"Only DA1", "\x1b[?62;22c", 0, 0, 0, false},
+		{"Unterminated", "\x1b]11;rgb:ffff/ffff/ff", 0, 0, 0, false},
+		{"Bad hex", "\x1b]11;rgb:zz/00/00\a", 0, 0, 0, false},
+		{"Too many digits", "\x1b]11;rgb:fffff/0/0\a", 0, 0, 0, false},
+		{"Missing component", "\x1b]11;rgb:ff/ff\a", 0, 0, 0, false},
+		{"Hex RRGGBB", "\x1b]11;#282c34\a", 40, 44, 52, true},
+		{"Hex RGB", "\x1b]11;#f08\x1b\\", 255, 0, 136, true},
+		{"Hex 16-bit", "\x1b]11;#ffff00000000\a", 255, 0, 0, true},
+		{"Hex bad length", "\x1b]11;#ffff\a", 0, 0, 0, false},
+		{"Unknown format", "\x1b]11;white\a", 0, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, ok := parseOSC11(tt.reply)
+			if ok != tt.ok || r != tt.r || g != tt.g || b != tt.b {
+				t.Errorf("parseOSC11(%q) = (%d, %d, %d, %v), want (%d, %d, %d, %v)",
+					tt.reply, r, g, b, ok, tt.r, tt.g, tt.b, tt.ok)
+			}
+		})
+	}
+}
+
+func TestHasDA1Reply(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"\x1b[?62;22c", true},
+		{"\x1b]11;rgb:0/0/0\a\x1b[?1;2c", true},
+		{"\x1b[?62;22", false},
+		{"\x1b[62c", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := hasDA1Reply(tt.s); got != tt.want {
+			t.Errorf("hasDA1Reply(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestQueryBackgroundColor_NotTerminal(t *testing.T) {
+	in, out, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+	defer out.Close()
+
+	_, _, _, err = queryBackgroundColor(in, out, 10*time.Millisecond)
+	if !errors.Is(err, ErrNoBackgroundColor) {
+		t.Errorf("queryBackgroundColor() error = %v, want ErrNoBackgroundColor", err)
+	}
+}
+
+func TestTerminal_BackgroundColor_NotATerminal(t *testing.T) {
+	// Under go test stdin/stdout are not a terminal, so the query must fail
+	// fast with the sentinel error instead of waiting for a reply.
+	if term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd())) {
+		t.Skip("stdin/stdout are a terminal")
+	}
+
+	_, _, _, err := NewANSI().BackgroundColor()
+	if !errors.Is(err, ErrNoBackgroundColor) {
+		t.Errorf("BackgroundColor() error = %v, want ErrNoBackgroundColor", err)
+	}
+}
+
+func TestReadReply_LeavesLaterInput(t *testing.T) {
+	if !unix.CanPoll {
+		t.Skip("polling stdin not supported on this platform")
+	}
+
+	in, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+	defer w.Close()
+
+	// The reply is followed by a keypress in the same write.
+	w.WriteString("\x1b]11;rgb:0/0/0\a\x1b[?62c" + "a")
+	if reply := readReply(in, time.Second); reply != "\x1b]11;rgb:0/0/0\a\x1b[?62c" {
+		t.Errorf("readReply() = %q, want the reply only", reply)
+	}
+	assertNextInput(t, in, "a")
+
+	// After a timeout, input typed later must not be taken by a leftover
+	// reader.
+	if reply := readReply(in, 10*time.Millisecond); reply != "" {
+		t.Errorf("readReply() = %q, want empty on timeout", reply)
+	}
+	w.WriteString("b")
+	assertNextInput(t, in, "b")
+}
+
+// assertNextInput reads from in and checks the input is want.
+func assertNextInput(t *testing.T, in *os.File, want string) {
+	t.Helper()
+
+	got := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 16)
+		n, _ := in.Read(buf)
+		got <- string(buf[:n])
+	}()
+
+	select {
+	case s := <-got:
+		if s != want {
+			t.Errorf("next input = %q, want %q", s, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("input %q was lost", want)
+	}
+}
@@ -0,0 +1,70 @@
+package terminal
+
+import (
+	"strings"
+
+	"github.com/phoenix-tui/phoenix/core"
+)
+
+// PhysicalRows returns how many terminal rows lines occupy when printed on a
+// terminal termWidth columns wide. A line wider than the terminal wraps and
+// takes several rows; an empty line still takes one. Escape sequences take
+// no cells, so rendered (styled) output can be measured directly.
+//
+// If termWidth <= 0, every line counts as one row.
+//
+// Example:
+//
+//	terminal.PhysicalRows([]string{"short", strings.Repeat("x", 100)}, 80) // 3
+func PhysicalRows(lines []string, termWidth int) int {
+	if termWidth <= 0 {
+		return len(lines)
+	}
+
+	rows := 0
+	for _, line := range lines {
+		width := core.StringWidth(stripEscapes(line))
+		if width <= termWidth {
+			rows++ // Includes an exactly full row: the cursor stays on it
+			continue
+		}
+		rows += (width + termWidth - 1) / termWidth
+	}
+	return rows
+}
+
+// stripEscapes removes ANSI escape sequences (CSI, OSC and two-byte
+// sequences) from s.
+func stripEscapes(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] != '\x1b' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+
+		j := i + 2
+		switch s[i+1] {
+		case '[': // CSI: ends with a byte in '@'..'~'
+			for j < len(s) && (s[j] < '@' || s[j] > '~') {
+				j++
+			}
+			j++
+		case ']': // OSC: ends with BEL or ST (ESC \)
+			for j < len(s) && s[j] != '\a' && (s[j] != '\x1b' || j+1 >= len(s) || s[j+1] != '\\') {
+				j++
+			}
+			if j < len(s) && s[j] == '\x1b' {
+				j++
+			}
+			j++
+		}
+		i = min(j, len(s))
+	}
+	return b.String()
+}
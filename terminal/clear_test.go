@@ -0,0 +1,74 @@
+package terminal
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/phoenix-tui/phoenix/terminal/internal/infrastructure/unix"
+)
+
+func TestPhysicalRows(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		width int
+		want  int
+	}{
+		{"Short lines", []string{"a", "b", "c"}, 80, 3},
+		{"Empty line", []string{""}, 80, 1},
+		{"Exactly full", []string{strings.Repeat("x", 80)}, 80, 1},
+		{"Wraps once", []string{strings.Repeat("x", 81)}, 80, 2},
+		{"Wraps twice", []string{strings.Repeat("x", 200)}, 80, 3},
+		{"Wide chars", []string{strings.Repeat("中", 41)}, 80, 2},
+		{"Escapes ignored", []string{"\x1b[1m" + strings.Repeat("x", 80) + "\x1b[0m"}, 80, 1},
+		{"Hyperlink ignored", []string{"\x1b]8;;https://example.com\x1b\\" + strings.Repeat("x", 10) + "\x1b]8;;\x1b\\"}, 10, 1},
+		{"No width", []string{strings.Repeat("x", 200), "b"}, 0, 2},
+		{"No lines", nil, 80, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PhysicalRows(tt.lines, tt.width); got != tt.want {
+				t.Errorf("PhysicalRows() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripEscapes(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"\x1b[38;2;1;2;3mred\x1b[0m", "red"},
+		{"\x1b]8;;url\x07link\x1b]8;;\x07", "link"},
+		{"a\x1b7b", "ab"},
+		{"unterminated\x1b[12", "unterminated"},
+		{"trailing\x1b", "trailing\x1b"},
+	}
+
+	for _, tt := range tests {
+		if got := stripEscapes(tt.in); got != tt.want {
+			t.Errorf("stripEscapes(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestClearRenderedLines(t *testing.T) {
+	r, w, _ := os.Pipe()
+	term := &terminalAdapter{internal: unix.NewANSIWithOutput(w)}
+
+	// One short line and one that wraps onto a second row: 3 rows in total.
+	if err := term.ClearRenderedLines([]string{"a", strings.Repeat("x", 100)}, 80); err != nil {
+		t.Fatalf("ClearRenderedLines() error = %v", err)
+	}
+	w.Close()
+
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if want := "\033[2A\r\033[J"; string(got) != want {
+		t.Errorf("ClearRenderedLines() wrote %q, want %q", got, want)
+	}
+}
@@ -2,7 +2,10 @@ module github.com/phoenix-tui/phoenix/terminal
 
 go 1.25.1
 
-require golang.org/x/term v0.39.0
+require (
+	github.com/phoenix-tui/phoenix/core v0.2.4
+	golang.org/x/term v0.39.0
+)
 
 require (
 	github.com/stretchr/testify v1.11.1
@@ -12,5 +15,8 @@ require (
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/unilibs/uniwidth v0.2.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/phoenix-tui/phoenix/core => ../core
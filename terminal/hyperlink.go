@@ -0,0 +1,11 @@
+package terminal
+
+import "github.com/phoenix-tui/phoenix/terminal/internal/infrastructure/unix"
+
+// HyperlinksSupported reports whether the terminal is known to support OSC 8
+// hyperlinks, detected from the environment. It is what
+// Terminal.SupportsHyperlinks returns on ANSI terminals, for code that
+// renders links into strings rather than writing them through a Terminal.
+func HyperlinksSupported() bool {
+	return unix.HyperlinksSupported()
+}
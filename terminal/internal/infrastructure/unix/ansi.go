@@ -11,9 +11,12 @@
 package unix
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"sync"
 
 	"golang.org/x/term"
@@ -39,6 +42,19 @@ type ANSITerminal struct {
 	// Raw mode state.
 	inRawMode     bool        // True if currently in raw mode
 	originalState *term.State // Saved cooked mode state (for restoration)
+
+	// Cursor state (protected by mu).
+	cursorHidden bool // True after HideCursor until ShowCursor
+	cursorStyled bool // True after SetCursorStyle until ResetCursorStyle
+
+	// Output buffering state.
+	buffered bool         // True if output is held until Flush
+	buf      bytes.Buffer // Pending output in buffered mode
+	outMu    sync.Mutex   // Protects buffered and buf
+
+	// Input read while waiting for a cursor position report.
+	pending   []byte
+	pendingMu sync.Mutex // Protects pending
 }
 
 // NewANSI creates new ANSI terminal implementation.
@@ -67,35 +83,17 @@ func NewANSIWithOutput(output *os.File) *ANSITerminal {
 // ANSI: "\033[{row};{col}H" (1-based indexing!).
 func (a *ANSITerminal) SetCursorPosition(x, y int) error {
 	// ANSI uses 1-based indexing, API uses 0-based.
-	_, err := fmt.Fprintf(a.output, "\033[%d;%dH", y+1, x+1)
+	err := a.write(fmt.Sprintf("\033[%d;%dH", y+1, x+1))
 	return err
 }
 
-// GetCursorPosition returns error - ANSI doesn't support reliable readback.
-//
-// Technical note: ANSI has CPR (Cursor Position Report) protocol:.
-//
-//	Write: "\033[6n".
-//	Read: "\033[{row};{col}R".
-//
-// But this requires:.
-//   - Raw terminal mode.
-//   - Stdin read with timeout.
-//   - Complex parsing.
-//   - Race conditions with other input.
-//
-// For simplicity, we don't support this. Use Windows Console API for readback.
-func (a *ANSITerminal) GetCursorPosition() (x, y int, err error) {
-	return 0, 0, fmt.Errorf("ANSI terminals don't support reliable cursor readback")
-}
-
 // MoveCursorUp moves cursor up n lines.
 // ANSI: "\033[{n}A".
 func (a *ANSITerminal) MoveCursorUp(n int) error {
 	if n <= 0 {
 		return nil // No-op for non-positive values
 	}
-	_, err := fmt.Fprintf(a.output, "\033[%dA", n)
+	err := a.write(fmt.Sprintf("\033[%dA", n))
 	return err
 }
 
@@ -105,7 +103,7 @@ func (a *ANSITerminal) MoveCursorDown(n int) error {
 	if n <= 0 {
 		return nil
 	}
-	_, err := fmt.Fprintf(a.output, "\033[%dB", n)
+	err := a.write(fmt.Sprintf("\033[%dB", n))
 	return err
 }
 
@@ -115,7 +113,7 @@ func (a *ANSITerminal) MoveCursorLeft(n int) error {
 	if n <= 0 {
 		return nil
 	}
-	_, err := fmt.Fprintf(a.output, "\033[%dD", n)
+	err := a.write(fmt.Sprintf("\033[%dD", n))
 	return err
 }
 
@@ -125,21 +123,21 @@ func (a *ANSITerminal) MoveCursorRight(n int) error {
 	if n <= 0 {
 		return nil
 	}
-	_, err := fmt.Fprintf(a.output, "\033[%dC", n)
+	err := a.write(fmt.Sprintf("\033[%dC", n))
 	return err
 }
 
 // SaveCursorPosition saves cursor position to stack.
 // ANSI: "\033[s" or "\0337" (DEC mode).
 func (a *ANSITerminal) SaveCursorPosition() error {
-	_, err := fmt.Fprint(a.output, "\033[s")
+	err := a.write("\033[s")
 	return err
 }
 
 // RestoreCursorPosition restores saved cursor position.
 // ANSI: "\033[u" or "\0338" (DEC mode).
 func (a *ANSITerminal) RestoreCursorPosition() error {
-	_, err := fmt.Fprint(a.output, "\033[u")
+	err := a.write("\033[u")
 	return err
 }
 
@@ -150,14 +148,68 @@ func (a *ANSITerminal) RestoreCursorPosition() error {
 // HideCursor makes cursor invisible.
 // ANSI: "\033[?25l" (DECTCEM - DEC Text Cursor Enable Mode).
 func (a *ANSITerminal) HideCursor() error {
-	_, err := fmt.Fprint(a.output, "\033[?25l")
+	err := a.write("\033[?25l")
+	if err == nil {
+		a.setCursorState(&a.cursorHidden, true)
+	}
 	return err
 }
 
 // ShowCursor makes cursor visible.
 // ANSI: "\033[?25h".
 func (a *ANSITerminal) ShowCursor() error {
-	_, err := fmt.Fprint(a.output, "\033[?25h")
+	err := a.write("\033[?25h")
+	if err == nil {
+		a.setCursorState(&a.cursorHidden, false)
+	}
+	return err
+}
+
+// IsCursorHidden returns true if HideCursor was called and ShowCursor was
+// not called since.
+// Thread-safe via mutex.
+func (a *ANSITerminal) IsCursorHidden() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.cursorHidden
+}
+
+// IsCursorStyled returns true if SetCursorStyle was called and
+// ResetCursorStyle was not called since.
+// Thread-safe via mutex.
+func (a *ANSITerminal) IsCursorStyled() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.cursorStyled
+}
+
+// setCursorState sets one of the cursor state flags under the mutex.
+func (a *ANSITerminal) setCursorState(flag *bool, value bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	*flag = value
+}
+
+// BeginSynchronizedUpdate starts a synchronized update: the terminal holds
+// back drawing until EndSynchronizedUpdate, then shows the whole frame at once.
+// ANSI: "\033[?2026h" (DEC private mode 2026).
+// No-op if SupportsSynchronizedOutput is false.
+func (a *ANSITerminal) BeginSynchronizedUpdate() error {
+	if !a.SupportsSynchronizedOutput() {
+		return nil
+	}
+	err := a.write("\033[?2026h")
+	return err
+}
+
+// EndSynchronizedUpdate ends a synchronized update and draws the frame.
+// ANSI: "\033[?2026l".
+// No-op if SupportsSynchronizedOutput is false.
+func (a *ANSITerminal) EndSynchronizedUpdate() error {
+	if !a.SupportsSynchronizedOutput() {
+		return nil
+	}
+	err := a.write("\033[?2026l")
 	return err
 }
 
@@ -173,8 +225,8 @@ func (a *ANSITerminal) ShowCursor() error {
 //	5: Blinking bar.
 //	6: Steady bar.
 //
-// We use steady variants for consistency.
-func (a *ANSITerminal) SetCursorStyle(style types.CursorStyle) error {
+// Blinking selects the odd (blinking) code, otherwise the steady one.
+func (a *ANSITerminal) SetCursorStyle(style types.CursorStyle, blinking bool) error {
 	var code int
 	switch style {
 	case types.CursorBlock:
@@ -186,8 +238,25 @@ func (a *ANSITerminal) SetCursorStyle(style types.CursorStyle) error {
 	default:
 		return fmt.Errorf("unknown cursor style: %v", style)
 	}
+	if blinking {
+		code-- // Blinking variant
+	}
 
-	_, err := fmt.Fprintf(a.output, "\033[%d q", code)
+	err := a.write(fmt.Sprintf("\033[%d q", code))
+	if err == nil {
+		a.setCursorState(&a.cursorStyled, true)
+	}
+	return err
+}
+
+// ResetCursorStyle restores the terminal's default cursor style
+// (as configured by the user).
+// ANSI: "\033[0 q".
+func (a *ANSITerminal) ResetCursorStyle() error {
+	err := a.write("\033[0 q")
+	if err == nil {
+		a.setCursorState(&a.cursorStyled, false)
+	}
 	return err
 }
 
@@ -198,7 +267,7 @@ func (a *ANSITerminal) SetCursorStyle(style types.CursorStyle) error {
 // Clear clears entire screen and moves cursor to top-left.
 // ANSI: "\033[2J" + "\033[H".
 func (a *ANSITerminal) Clear() error {
-	_, err := fmt.Fprint(a.output, "\033[2J\033[H")
+	err := a.write("\033[2J\033[H")
 	return err
 }
 
@@ -207,14 +276,14 @@ func (a *ANSITerminal) Clear() error {
 func (a *ANSITerminal) ClearLine() error {
 	// CRITICAL: Must include \r (carriage return) to move cursor to start of line!
 	// Without \r, clearing happens from current cursor position.
-	_, err := fmt.Fprint(a.output, "\r\033[2K")
+	err := a.write("\r\033[2K")
 	return err
 }
 
 // ClearFromCursor clears from cursor to end of screen.
 // ANSI: "\033[J" or "\033[0J".
 func (a *ANSITerminal) ClearFromCursor() error {
-	_, err := fmt.Fprint(a.output, "\033[J")
+	err := a.write("\033[J")
 	return err
 }
 
@@ -238,12 +307,12 @@ func (a *ANSITerminal) ClearLines(count int) error {
 
 	if count == 1 {
 		// Single line: just CR + clear to end.
-		_, err := fmt.Fprint(a.output, "\r\033[J")
+		err := a.write("\r\033[J")
 		return err
 	}
 
 	// Multiple lines: move up to first line, then clear to end.
-	_, err := fmt.Fprintf(a.output, "\033[%dA\r\033[J", count-1)
+	err := a.write(fmt.Sprintf("\033[%dA\r\033[J", count-1))
 	return err
 }
 
@@ -253,7 +322,7 @@ func (a *ANSITerminal) ClearLines(count int) error {
 
 // Write writes string to terminal at current cursor position.
 func (a *ANSITerminal) Write(s string) error {
-	_, err := fmt.Fprint(a.output, s)
+	err := a.write(s)
 	return err
 }
 
@@ -266,6 +335,61 @@ func (a *ANSITerminal) WriteAt(x, y int, s string) error {
 	return a.Write(s)
 }
 
+// write sends s to the output, or appends it to the pending output in
+// buffered mode. All escape sequences go through write.
+func (a *ANSITerminal) write(s string) error {
+	a.outMu.Lock()
+	defer a.outMu.Unlock()
+
+	if a.buffered {
+		a.buf.WriteString(s)
+		return nil
+	}
+	_, err := io.WriteString(a.output, s)
+	return err
+}
+
+// ┌─────────────────────────────────────────────────────────────────┐.
+// │ Output Buffering                                                │.
+// └─────────────────────────────────────────────────────────────────┘.
+
+// SetBuffered enables or disables buffered output.
+//
+// In buffered mode, cursor movement, clearing and writes are accumulated in
+// memory instead of being written immediately, and Flush sends them all in
+// a single write syscall. Disabling buffered mode flushes pending output.
+func (a *ANSITerminal) SetBuffered(enabled bool) error {
+	a.outMu.Lock()
+	a.buffered = enabled
+	a.outMu.Unlock()
+
+	if !enabled {
+		return a.Flush()
+	}
+	return nil
+}
+
+// IsBuffered returns true if output is held until Flush.
+func (a *ANSITerminal) IsBuffered() bool {
+	a.outMu.Lock()
+	defer a.outMu.Unlock()
+	return a.buffered
+}
+
+// Flush writes all pending buffered output in one write.
+// No-op if nothing is pending.
+func (a *ANSITerminal) Flush() error {
+	a.outMu.Lock()
+	defer a.outMu.Unlock()
+
+	if a.buf.Len() == 0 {
+		return nil
+	}
+	_, err := a.output.Write(a.buf.Bytes())
+	a.buf.Reset()
+	return err
+}
+
 // ┌─────────────────────────────────────────────────────────────────┐.
 // │ Screen Buffer (Not Supported on ANSI)                          │.
 // └─────────────────────────────────────────────────────────────────┘.
@@ -330,8 +454,9 @@ func (a *ANSITerminal) SupportsDirectPositioning() bool {
 	return false
 }
 
-// SupportsReadback returns false - ANSI can't read cursor/buffer.
+// SupportsReadback returns false - ANSI can't read the screen buffer.
 // Windows Console API supports readback via GetConsoleScreenBufferInfo.
+// The cursor position can still be queried, see SupportsCursorQuery.
 func (a *ANSITerminal) SupportsReadback() bool {
 	return false
 }
@@ -341,6 +466,32 @@ func (a *ANSITerminal) SupportsTrueColor() bool {
 	return a.ColorDepth() == 16777216
 }
 
+// SupportsSynchronizedOutput reports whether the terminal is known to
+// support synchronized output (DEC private mode 2026).
+//
+// Detection heuristics (environment, like ColorDepth):.
+//   - TERM: xterm-kitty, xterm-ghostty, foot*, alacritty, contour.
+//   - TERM_PROGRAM: WezTerm, ghostty, iTerm.app, contour.
+//   - WT_SESSION set (Windows Terminal).
+//
+// Terminals ignore private modes they do not know, so a false positive only
+// costs a few bytes per frame.
+func (a *ANSITerminal) SupportsSynchronizedOutput() bool {
+	termEnv := os.Getenv("TERM")
+	switch {
+	case termEnv == "xterm-kitty", termEnv == "xterm-ghostty", termEnv == "alacritty",
+		termEnv == "contour", strings.HasPrefix(termEnv, "foot"):
+		return true
+	}
+
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty", "iTerm.app", "contour":
+		return true
+	}
+
+	return os.Getenv("WT_SESSION") != ""
+}
+
 // Platform returns Unix platform type.
 func (a *ANSITerminal) Platform() types.Platform {
 	return types.PlatformUnix
@@ -378,7 +529,7 @@ func (a *ANSITerminal) EnterAltScreen() error {
 
 	// Switch to alternate screen buffer.
 	// CSI ? 1049 h = Save cursor + clear + switch to alt screen.
-	_, err := fmt.Fprint(a.output, "\033[?1049h")
+	err := a.write("\033[?1049h")
 	if err != nil {
 		return fmt.Errorf("failed to enter alternate screen buffer: %w", err)
 	}
@@ -414,7 +565,7 @@ func (a *ANSITerminal) ExitAltScreen() error {
 
 	// Return to normal screen buffer.
 	// CSI ? 1049 l = Restore cursor + switch to normal screen.
-	_, err := fmt.Fprint(a.output, "\033[?1049l")
+	err := a.write("\033[?1049l")
 	if err != nil {
 		return fmt.Errorf("failed to exit alternate screen buffer: %w", err)
 	}
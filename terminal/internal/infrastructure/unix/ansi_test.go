@@ -2,6 +2,7 @@ package unix
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"os"
 	"strings"
@@ -58,11 +59,19 @@ func TestANSI_SetCursorPosition(t *testing.T) {
 }
 
 func TestANSI_GetCursorPosition_NotSupported(t *testing.T) {
-	term := NewANSI()
+	r, w, _ := os.Pipe()
+	defer r.Close()
+	defer w.Close()
+
+	// Pipes are not terminals, so the DSR query is unavailable.
+	term := &ANSITerminal{output: w, input: r}
+	if term.SupportsCursorQuery() {
+		t.Fatal("SupportsCursorQuery() = true for pipes")
+	}
 	x, y, err := term.GetCursorPosition()
 
-	if err == nil {
-		t.Error("GetCursorPosition should return error on ANSI")
+	if !errors.Is(err, ErrCursorQueryUnsupported) {
+		t.Errorf("GetCursorPosition error = %v, want ErrCursorQueryUnsupported", err)
 	}
 
 	if x != 0 || y != 0 {
@@ -217,32 +226,68 @@ func TestANSI_ShowCursor(t *testing.T) {
 
 func TestANSI_SetCursorStyle(t *testing.T) {
 	tests := []struct {
-		name  string
-		style types.CursorStyle
-		want  string
+		name     string
+		style    types.CursorStyle
+		blinking bool
+		want     string
 	}{
-		{"block", types.CursorBlock, "\033[2 q"},
-		{"underline", types.CursorUnderline, "\033[4 q"},
-		{"bar", types.CursorBar, "\033[6 q"},
+		{"block", types.CursorBlock, false, "\033[2 q"},
+		{"underline", types.CursorUnderline, false, "\033[4 q"},
+		{"bar", types.CursorBar, false, "\033[6 q"},
+		{"blinking block", types.CursorBlock, true, "\033[1 q"},
+		{"blinking underline", types.CursorUnderline, true, "\033[3 q"},
+		{"blinking bar", types.CursorBar, true, "\033[5 q"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := captureANSI(func(term *ANSITerminal) {
-				term.SetCursorStyle(tt.style)
+				term.SetCursorStyle(tt.style, tt.blinking)
 			})
 
 			if got != tt.want {
-				t.Errorf("SetCursorStyle(%v) = %q, want %q", tt.style, got, tt.want)
+				t.Errorf("SetCursorStyle(%v, %v) = %q, want %q", tt.style, tt.blinking, got, tt.want)
 			}
 		})
 	}
 }
 
+func TestANSI_ResetCursorStyle(t *testing.T) {
+	got := captureANSI(func(term *ANSITerminal) {
+		term.ResetCursorStyle()
+	})
+
+	want := "\033[0 q"
+	if got != want {
+		t.Errorf("ResetCursorStyle = %q, want %q", got, want)
+	}
+}
+
+func TestANSI_CursorState(t *testing.T) {
+	captureANSI(func(term *ANSITerminal) {
+		if term.IsCursorHidden() || term.IsCursorStyled() {
+			t.Fatal("new terminal should report a visible cursor in the default style")
+		}
+
+		term.HideCursor()
+		term.SetCursorStyle(types.CursorBar, false)
+		if !term.IsCursorHidden() || !term.IsCursorStyled() {
+			t.Error("HideCursor and SetCursorStyle should be tracked")
+		}
+
+		term.SetCursorStyle(types.CursorStyle(99), false) // Invalid: no change
+		term.ShowCursor()
+		term.ResetCursorStyle()
+		if term.IsCursorHidden() || term.IsCursorStyled() {
+			t.Error("ShowCursor and ResetCursorStyle should clear the state")
+		}
+	})
+}
+
 func TestANSI_SetCursorStyle_Invalid(t *testing.T) {
 	term := NewANSI()
 
-	err := term.SetCursorStyle(types.CursorStyle(99))
+	err := term.SetCursorStyle(types.CursorStyle(99), false)
 	if err == nil {
 		t.Error("SetCursorStyle with invalid style should return error")
 	}
@@ -435,6 +480,125 @@ func TestANSI_SupportsTrueColor(_ *testing.T) {
 	_ = term.SupportsTrueColor()
 }
 
+// clearSyncEnv unsets the variables SupportsSynchronizedOutput looks at.
+func clearSyncEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{"TERM", "TERM_PROGRAM", "WT_SESSION"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestANSI_SupportsSynchronizedOutput(t *testing.T) {
+	tests := []struct {
+		key, value string
+		want       bool
+	}{
+		{"TERM", "xterm-kitty", true},
+		{"TERM", "foot-extra", true},
+		{"TERM", "alacritty", true},
+		{"TERM", "xterm-256color", false},
+		{"TERM_PROGRAM", "WezTerm", true},
+		{"TERM_PROGRAM", "Apple_Terminal", false},
+		{"WT_SESSION", "0c5f7a1e", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			clearSyncEnv(t)
+			t.Setenv(tt.key, tt.value)
+
+			if got := NewANSI().SupportsSynchronizedOutput(); got != tt.want {
+				t.Errorf("SupportsSynchronizedOutput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestANSI_SynchronizedUpdate(t *testing.T) {
+	clearSyncEnv(t)
+	t.Setenv("TERM", "xterm-kitty")
+
+	got := captureANSI(func(term *ANSITerminal) {
+		term.BeginSynchronizedUpdate()
+		term.Write("frame")
+		term.EndSynchronizedUpdate()
+	})
+
+	want := "\033[?2026hframe\033[?2026l"
+	if got != want {
+		t.Errorf("synchronized update = %q, want %q", got, want)
+	}
+}
+
+func TestANSI_SynchronizedUpdate_Unsupported(t *testing.T) {
+	clearSyncEnv(t)
+	t.Setenv("TERM", "xterm-256color")
+
+	got := captureANSI(func(term *ANSITerminal) {
+		term.BeginSynchronizedUpdate()
+		term.Write("frame")
+		term.EndSynchronizedUpdate()
+	})
+
+	if got != "frame" {
+		t.Errorf("unsupported synchronized update = %q, want %q", got, "frame")
+	}
+}
+
+func TestANSI_Buffered(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	term := NewANSIWithOutput(f)
+	if err := term.SetBuffered(true); err != nil {
+		t.Fatalf("SetBuffered(true) error = %v", err)
+	}
+	if !term.IsBuffered() {
+		t.Error("IsBuffered() = false after SetBuffered(true)")
+	}
+
+	term.SetCursorPosition(0, 0)
+	term.Write("frame")
+	term.ClearFromCursor()
+
+	if info, _ := f.Stat(); info.Size() != 0 {
+		t.Errorf("%d bytes written before Flush, want 0", info.Size())
+	}
+
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	got, _ := os.ReadFile(f.Name())
+	if want := "\033[1;1Hframe\033[J"; string(got) != want {
+		t.Errorf("flushed output = %q, want %q", got, want)
+	}
+
+	// Flushing again writes nothing more.
+	if err := term.Flush(); err != nil {
+		t.Fatalf("second Flush() error = %v", err)
+	}
+	if info, _ := f.Stat(); info.Size() != int64(len(got)) {
+		t.Errorf("second Flush wrote %d extra bytes", info.Size()-int64(len(got)))
+	}
+}
+
+func TestANSI_SetBuffered_FalseFlushes(t *testing.T) {
+	got := captureANSI(func(term *ANSITerminal) {
+		term.SetBuffered(true)
+		term.HideCursor()
+		term.Write("x")
+		term.SetBuffered(false)
+		term.Write("y")
+	})
+
+	if want := "\033[?25lxy"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
 func TestANSI_Platform(t *testing.T) {
 	term := NewANSI()
 	platform := term.Platform()
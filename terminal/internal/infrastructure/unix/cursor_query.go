@@ -0,0 +1,167 @@
+package unix
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Cursor position query errors.
+var (
+	ErrCursorQueryUnsupported = errors.New("terminal: cursor readback not available (stdin/stdout not a terminal)")
+	ErrCursorQueryTimeout     = errors.New("terminal: no cursor position report received")
+)
+
+const (
+	// dsrQuery asks for the cursor position (Device Status Report 6).
+	// The terminal answers with a Cursor Position Report: "\033[{row};{col}R".
+	dsrQuery = "\033[6n"
+
+	// cursorQueryTimeout is how long GetCursorPosition waits for the report.
+	cursorQueryTimeout = 100 * time.Millisecond
+)
+
+// ┌─────────────────────────────────────────────────────────────────┐.
+// │ Cursor Position Query (DSR/CPR)                                 │.
+// └─────────────────────────────────────────────────────────────────┘.
+
+// GetCursorPosition returns current cursor position (x, y), 0-based.
+//
+// Uses the DSR/CPR protocol:.
+//
+//	Write: "\033[6n".
+//	Read:  "\033[{row};{col}R".
+//
+// Pending buffered output is flushed first so the position is up to date.
+// The terminal is switched to raw mode for the read if it is not already.
+// Keystrokes that arrive before the report are not lost: PendingInput
+// returns them. Nothing else may read stdin during the query, or the report
+// may be consumed by the other reader.
+//
+// Returns ErrCursorQueryUnsupported if SupportsCursorQuery is false, or
+// ErrCursorQueryTimeout if no report arrives in time.
+func (a *ANSITerminal) GetCursorPosition() (x, y int, err error) {
+	if !a.SupportsCursorQuery() {
+		return 0, 0, ErrCursorQueryUnsupported
+	}
+	if err := a.Flush(); err != nil {
+		return 0, 0, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.inRawMode {
+		fd := int(a.input.Fd())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return 0, 0, fmt.Errorf("terminal: enter raw mode: %w", err)
+		}
+		defer term.Restore(fd, state) //nolint:errcheck // Best-effort restore
+	}
+
+	x, y, extra, err := queryCursorPosition(a.input, a.output, cursorQueryTimeout)
+	a.pendingMu.Lock()
+	a.pending = append(a.pending, extra...)
+	a.pendingMu.Unlock()
+	return x, y, err
+}
+
+// SupportsCursorQuery returns true if GetCursorPosition can query the
+// cursor position: stdin and stdout must both be terminals, on a platform
+// where stdin can be polled with a timeout.
+func (a *ANSITerminal) SupportsCursorQuery() bool {
+	return CanPoll &&
+		a.input != nil && term.IsTerminal(int(a.input.Fd())) &&
+		term.IsTerminal(int(a.output.Fd()))
+}
+
+// PendingInput returns and clears the input read while waiting for a cursor
+// position report, such as keys typed during GetCursorPosition. Input
+// readers should consume it before reading stdin.
+func (a *ANSITerminal) PendingInput() []byte {
+	a.pendingMu.Lock()
+	defer a.pendingMu.Unlock()
+
+	pending := a.pending
+	a.pending = nil
+	return pending
+}
+
+// queryCursorPosition sends the DSR query to out and reads the report from
+// in, waiting at most timeout. Input read around the report is returned as
+// extra.
+func queryCursorPosition(in, out *os.File, timeout time.Duration) (x, y int, extra []byte, err error) {
+	if _, err := out.WriteString(dsrQuery); err != nil {
+		return 0, 0, nil, fmt.Errorf("terminal: write cursor position query: %w", err)
+	}
+
+	fd := int(in.Fd())
+	deadline := time.Now().Add(timeout)
+	var got []byte
+	buf := make([]byte, 256)
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return 0, 0, got, ErrCursorQueryTimeout
+		}
+		ready, err := WaitReadable(fd, remaining)
+		if err != nil {
+			return 0, 0, got, fmt.Errorf("terminal: wait for cursor position report: %w", err)
+		}
+		if !ready {
+			return 0, 0, got, ErrCursorQueryTimeout
+		}
+
+		n, err := in.Read(buf)
+		got = append(got, buf[:n]...)
+		if row, col, start, end, ok := findCPR(got); ok {
+			extra = append(got[:start:start], got[end:]...)
+			return col - 1, row - 1, extra, nil
+		}
+		if err != nil {
+			return 0, 0, got, fmt.Errorf("terminal: read cursor position report: %w", err)
+		}
+	}
+}
+
+// findCPR finds the first Cursor Position Report ("ESC [ row ; col R") in b
+// and returns its 1-based row and column and its start and end offsets.
+func findCPR(b []byte) (row, col, start, end int, ok bool) {
+	for start = 0; start+1 < len(b); start++ {
+		if b[start] != '\x1b' || b[start+1] != '[' {
+			continue
+		}
+
+		i := start + 2
+		row, i = parseCPRNumber(b, i)
+		if row <= 0 || i >= len(b) || b[i] != ';' {
+			continue
+		}
+		col, i = parseCPRNumber(b, i+1)
+		if col <= 0 || i >= len(b) || b[i] != 'R' {
+			continue
+		}
+		return row, col, start, i + 1, true
+	}
+	return 0, 0, 0, 0, false
+}
+
+// parseCPRNumber parses the decimal number at b[i:] and returns it with the
+// offset just past it. It returns 0 if there is no number.
+func parseCPRNumber(b []byte, i int) (int, int) {
+	j := i
+	for j < len(b) && b[j] >= '0' && b[j] <= '9' {
+		j++
+	}
+	n, err := strconv.Atoi(string(b[i:j]))
+	if err != nil {
+		return 0, j
+	}
+	return n, j
+}
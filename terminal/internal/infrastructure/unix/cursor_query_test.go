@@ -0,0 +1,101 @@
+package unix
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestFindCPR(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         string
+		row, col   int
+		start, end int
+		ok         bool
+	}{
+		{"Report only", "\x1b[5;10R", 5, 10, 0, 7, true},
+		{"Keys around", "ab\x1b[12;3Rc", 12, 3, 2, 9, true},
+		{"Incomplete", "\x1b[5;1", 0, 0, 0, 0, false},
+		{"Arrow key first", "\x1b[A\x1b[1;1R", 1, 1, 3, 9, true},
+		{"Not a report", "\x1b[5;10H", 0, 0, 0, 0, false},
+		{"Empty", "", 0, 0, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, start, end, ok := findCPR([]byte(tt.in))
+			if ok != tt.ok || row != tt.row || col != tt.col || start != tt.start || end != tt.end {
+				t.Errorf("findCPR(%q) = %d, %d, %d, %d, %v; want %d, %d, %d, %d, %v",
+					tt.in, row, col, start, end, ok, tt.row, tt.col, tt.start, tt.end, tt.ok)
+			}
+		})
+	}
+}
+
+func TestQueryCursorPosition(t *testing.T) {
+	if !CanPoll {
+		t.Skip("polling stdin not supported on this platform")
+	}
+
+	inR, inW, _ := os.Pipe()
+	outR, outW, _ := os.Pipe()
+	defer inR.Close()
+	defer inW.Close()
+	defer outR.Close()
+	defer outW.Close()
+
+	// The user typed "ab" just before the report arrived, and "c" after it.
+	inW.WriteString("ab\x1b[7;21Rc")
+
+	x, y, extra, err := queryCursorPosition(inR, outW, time.Second)
+	if err != nil {
+		t.Fatalf("queryCursorPosition() error = %v", err)
+	}
+	if x != 20 || y != 6 {
+		t.Errorf("position = (%d, %d), want (20, 6)", x, y)
+	}
+	if string(extra) != "abc" {
+		t.Errorf("extra input = %q, want %q", extra, "abc")
+	}
+
+	buf := make([]byte, 16)
+	n, _ := outR.Read(buf)
+	if got := string(buf[:n]); got != dsrQuery {
+		t.Errorf("query written = %q, want %q", got, dsrQuery)
+	}
+}
+
+func TestQueryCursorPosition_Timeout(t *testing.T) {
+	if !CanPoll {
+		t.Skip("polling stdin not supported on this platform")
+	}
+
+	inR, inW, _ := os.Pipe()
+	_, outW, _ := os.Pipe()
+	defer inR.Close()
+	defer inW.Close()
+	defer outW.Close()
+
+	inW.WriteString("x")
+
+	_, _, extra, err := queryCursorPosition(inR, outW, 20*time.Millisecond)
+	if !errors.Is(err, ErrCursorQueryTimeout) {
+		t.Errorf("error = %v, want ErrCursorQueryTimeout", err)
+	}
+	if string(extra) != "x" {
+		t.Errorf("extra input = %q, want %q", extra, "x")
+	}
+}
+
+func TestANSI_PendingInput(t *testing.T) {
+	term := &ANSITerminal{pending: []byte("abc")}
+
+	if got := string(term.PendingInput()); got != "abc" {
+		t.Errorf("PendingInput() = %q, want %q", got, "abc")
+	}
+	if got := term.PendingInput(); got != nil {
+		t.Errorf("second PendingInput() = %q, want nil", got)
+	}
+}
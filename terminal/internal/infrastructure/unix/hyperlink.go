@@ -0,0 +1,96 @@
+package unix
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ┌─────────────────────────────────────────────────────────────────┐.
+// │ Hyperlinks (OSC 8)                                              │.
+// └─────────────────────────────────────────────────────────────────┘.
+
+// WriteHyperlink writes text as a clickable link to url.
+// ANSI: "\033]8;;{url}\033\\{text}\033]8;;\033\\".
+//
+// Writes plain text where SupportsHyperlinks is false.
+func (a *ANSITerminal) WriteHyperlink(url, text string) error {
+	return a.WriteHyperlinkWithID(url, text, "")
+}
+
+// WriteHyperlinkWithID writes text as a clickable link to url, with an id.
+// Links with the same id and url are treated as one link by the terminal,
+// for example when a long URL is wrapped over several lines.
+// ANSI: "\033]8;id={id};{url}\033\\{text}\033]8;;\033\\".
+//
+// Writes plain text where SupportsHyperlinks is false.
+func (a *ANSITerminal) WriteHyperlinkWithID(url, text, id string) error {
+	if !a.SupportsHyperlinks() {
+		return a.Write(text)
+	}
+	return a.write(Hyperlink(url, text, id))
+}
+
+// SupportsHyperlinks returns true if the terminal is known to support
+// OSC 8 hyperlinks, see HyperlinksSupported.
+func (a *ANSITerminal) SupportsHyperlinks() bool {
+	return HyperlinksSupported()
+}
+
+// Hyperlink returns text wrapped in OSC 8 hyperlink sequences. The id
+// parameter is omitted if id is empty. text is returned unchanged if url
+// is empty or url or id contain control characters, which would end the
+// sequence early.
+func Hyperlink(url, text, id string) string {
+	if url == "" || hasControl(url) || hasControl(id) {
+		return text
+	}
+
+	params := ""
+	if id != "" {
+		params = "id=" + strings.NewReplacer(":", "", ";", "").Replace(id)
+	}
+	return "\033]8;" + params + ";" + url + "\033\\" + text + "\033]8;;\033\\"
+}
+
+// HyperlinksSupported reports whether the terminal is known to support
+// OSC 8 hyperlinks, detected from the environment: kitty, WezTerm, foot,
+// Alacritty, Ghostty, iTerm2, contour, VS Code, Windows Terminal, Konsole
+// and VTE-based terminals (GNOME Terminal, Tilix, ...).
+//
+// Unlike unknown private modes, an unsupported OSC 8 sequence may be shown
+// as garbage, so unknown terminals get plain text.
+func HyperlinksSupported() bool {
+	termEnv := os.Getenv("TERM")
+	switch {
+	case termEnv == "xterm-kitty", termEnv == "xterm-ghostty", termEnv == "alacritty",
+		termEnv == "contour", termEnv == "wezterm", strings.HasPrefix(termEnv, "foot"):
+		return true
+	}
+
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty", "iTerm.app", "contour", "vscode":
+		return true
+	}
+
+	if os.Getenv("WT_SESSION") != "" {
+		return true
+	}
+	if v, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		return true // VTE 0.50
+	}
+	if v, err := strconv.Atoi(os.Getenv("KONSOLE_VERSION")); err == nil && v >= 201200 {
+		return true // Konsole 20.12
+	}
+	return false
+}
+
+// hasControl reports whether s contains an ASCII control character.
+func hasControl(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] == 0x7f {
+			return true
+		}
+	}
+	return false
+}
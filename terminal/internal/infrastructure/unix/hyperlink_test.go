@@ -0,0 +1,103 @@
+package unix
+
+import "testing"
+
+func clearHyperlinkEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{"TERM", "TERM_PROGRAM", "WT_SESSION", "VTE_VERSION", "KONSOLE_VERSION"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		name          string
+		url, text, id string
+		want          string
+	}{
+		{"Plain", "https://example.com", "site", "", "\033]8;;https://example.com\033\\site\033]8;;\033\\"},
+		{"With id", "https://example.com", "site", "ref1", "\033]8;id=ref1;https://example.com\033\\site\033]8;;\033\\"},
+		{"Id separators removed", "https://example.com", "site", "a:b;c", "\033]8;id=abc;https://example.com\033\\site\033]8;;\033\\"},
+		{"Empty url", "", "site", "", "site"},
+		{"Control chars in url", "https://example.com\033]0;x\a", "site", "", "site"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hyperlink(tt.url, tt.text, tt.id); got != tt.want {
+				t.Errorf("Hyperlink(%q, %q, %q) = %q, want %q", tt.url, tt.text, tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHyperlinksSupported(t *testing.T) {
+	tests := []struct {
+		key, value string
+		want       bool
+	}{
+		{"TERM", "xterm-kitty", true},
+		{"TERM", "foot-extra", true},
+		{"TERM", "xterm-256color", false},
+		{"TERM_PROGRAM", "iTerm.app", true},
+		{"TERM_PROGRAM", "vscode", true},
+		{"TERM_PROGRAM", "Apple_Terminal", false},
+		{"WT_SESSION", "1234", true},
+		{"VTE_VERSION", "6003", true},
+		{"VTE_VERSION", "4205", false},
+		{"KONSOLE_VERSION", "220401", true},
+		{"KONSOLE_VERSION", "200401", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			clearHyperlinkEnv(t)
+			t.Setenv(tt.key, tt.value)
+
+			if got := HyperlinksSupported(); got != tt.want {
+				t.Errorf("HyperlinksSupported() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestANSI_WriteHyperlink(t *testing.T) {
+	clearHyperlinkEnv(t)
+	t.Setenv("TERM", "xterm-kitty")
+
+	got := captureANSI(func(term *ANSITerminal) {
+		term.WriteHyperlink("https://example.com", "site")
+	})
+
+	want := "\033]8;;https://example.com\033\\site\033]8;;\033\\"
+	if got != want {
+		t.Errorf("WriteHyperlink() = %q, want %q", got, want)
+	}
+}
+
+func TestANSI_WriteHyperlinkWithID(t *testing.T) {
+	clearHyperlinkEnv(t)
+	t.Setenv("WT_SESSION", "1")
+
+	got := captureANSI(func(term *ANSITerminal) {
+		term.WriteHyperlinkWithID("https://example.com", "site", "x")
+	})
+
+	want := "\033]8;id=x;https://example.com\033\\site\033]8;;\033\\"
+	if got != want {
+		t.Errorf("WriteHyperlinkWithID() = %q, want %q", got, want)
+	}
+}
+
+func TestANSI_WriteHyperlink_Unsupported(t *testing.T) {
+	clearHyperlinkEnv(t)
+	t.Setenv("TERM", "xterm-256color")
+
+	got := captureANSI(func(term *ANSITerminal) {
+		term.WriteHyperlink("https://example.com", "site")
+	})
+
+	if got != "site" {
+		t.Errorf("unsupported WriteHyperlink() = %q, want %q", got, "site")
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !unix
+
+package unix
+
+import (
+	"errors"
+	"time"
+)
+
+// CanPoll reports whether WaitReadable is implemented on this platform.
+// Console handles on Windows cannot be polled, so cursor position queries
+// are not supported by the ANSI fallback there.
+const CanPoll = false
+
+// WaitReadable is not supported on this platform.
+func WaitReadable(_ int, _ time.Duration) (bool, error) {
+	return false, errors.New("terminal: polling stdin not supported on this platform")
+}
@@ -0,0 +1,26 @@
+//go:build unix
+
+package unix
+
+import (
+	"errors"
+	"time"
+
+	sysunix "golang.org/x/sys/unix"
+)
+
+// CanPoll reports whether WaitReadable is implemented on this platform.
+const CanPoll = true
+
+// WaitReadable waits until fd has input to read or timeout expires, and
+// reports whether input is available.
+func WaitReadable(fd int, timeout time.Duration) (bool, error) {
+	fds := []sysunix.PollFd{{Fd: int32(fd), Events: sysunix.POLLIN}}
+	for {
+		n, err := sysunix.Poll(fds, int(timeout.Milliseconds()))
+		if errors.Is(err, sysunix.EINTR) {
+			continue
+		}
+		return n > 0, err
+	}
+}
@@ -77,6 +77,13 @@ type Console struct {
 	// Raw mode state.
 	inRawMode         bool   // True if currently in raw mode
 	originalInputMode uint32 // Saved input console mode (for restoration)
+
+	// Cursor size before the first SetCursorStyle (for ResetCursorStyle).
+	originalCursorSize uint32
+
+	// Cursor state (protected by mu).
+	cursorHidden bool // True after HideCursor until ShowCursor
+	cursorStyled bool // True after SetCursorStyle until ResetCursorStyle
 }
 
 // NewConsole creates Windows Console API terminal.
@@ -99,10 +106,15 @@ func NewConsole() (*Console, error) {
 		return nil, fmt.Errorf("not a Windows Console (use ANSI fallback): %w", err)
 	}
 
+	// Remember the user's cursor size for ResetCursorStyle (25% is the console default).
+	cursorInfo := ConsoleCursorInfo{Size: 25}
+	_ = GetConsoleCursorInfo(stdout, &cursorInfo) // Best effort
+
 	return &Console{
-		stdout: stdout,
-		stdin:  stdin,
-		info:   info,
+		stdout:             stdout,
+		stdin:              stdin,
+		info:               info,
+		originalCursorSize: cursorInfo.Size,
 	}, nil
 }
 
@@ -265,7 +277,21 @@ func (c *Console) HideCursor() error {
 	}
 
 	cursorInfo.Visible = 0 // FALSE
-	return SetConsoleCursorInfo(c.stdout, &cursorInfo)
+	if err := SetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
+		return err
+	}
+	c.setCursorState(&c.cursorHidden, true)
+	return nil
+}
+
+// BeginSynchronizedUpdate does nothing - see SupportsSynchronizedOutput.
+func (c *Console) BeginSynchronizedUpdate() error {
+	return nil
+}
+
+// EndSynchronizedUpdate does nothing - see SupportsSynchronizedOutput.
+func (c *Console) EndSynchronizedUpdate() error {
+	return nil
 }
 
 // ShowCursor makes the cursor visible.
@@ -276,7 +302,36 @@ func (c *Console) ShowCursor() error {
 	}
 
 	cursorInfo.Visible = 1 // TRUE
-	return SetConsoleCursorInfo(c.stdout, &cursorInfo)
+	if err := SetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
+		return err
+	}
+	c.setCursorState(&c.cursorHidden, false)
+	return nil
+}
+
+// IsCursorHidden returns true if HideCursor was called and ShowCursor was
+// not called since.
+// Thread-safe via mutex.
+func (c *Console) IsCursorHidden() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cursorHidden
+}
+
+// IsCursorStyled returns true if SetCursorStyle was called and
+// ResetCursorStyle was not called since.
+// Thread-safe via mutex.
+func (c *Console) IsCursorStyled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cursorStyled
+}
+
+// setCursorState sets one of the cursor state flags under the mutex.
+func (c *Console) setCursorState(flag *bool, value bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*flag = value
 }
 
 // SetCursorStyle changes cursor appearance.
@@ -288,7 +343,9 @@ func (c *Console) ShowCursor() error {
 //   - CursorBar: Size = 10 (thin bar - closest to vertical bar).
 //
 // Note: Windows Console cursor is always a horizontal underline, size controls height.
-func (c *Console) SetCursorStyle(style types.CursorStyle) error {
+//
+// The console cursor cannot be made steady or blinking, so blinking is ignored.
+func (c *Console) SetCursorStyle(style types.CursorStyle, _ bool) error {
 	var cursorInfo ConsoleCursorInfo
 	if err := GetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
 		return err
@@ -305,7 +362,27 @@ func (c *Console) SetCursorStyle(style types.CursorStyle) error {
 		return fmt.Errorf("unknown cursor style: %v", style)
 	}
 
-	return SetConsoleCursorInfo(c.stdout, &cursorInfo)
+	if err := SetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
+		return err
+	}
+	c.setCursorState(&c.cursorStyled, true)
+	return nil
+}
+
+// ResetCursorStyle restores the cursor size the console had when the
+// Console was created.
+func (c *Console) ResetCursorStyle() error {
+	var cursorInfo ConsoleCursorInfo
+	if err := GetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
+		return err
+	}
+
+	cursorInfo.Size = c.originalCursorSize
+	if err := SetConsoleCursorInfo(c.stdout, &cursorInfo); err != nil {
+		return err
+	}
+	c.setCursorState(&c.cursorStyled, false)
+	return nil
 }
 
 // ┌─────────────────────────────────────────────────────────────────┐.
@@ -504,6 +581,36 @@ func (c *Console) WriteAt(x, y int, s string) error {
 	return c.Write(s)
 }
 
+// WriteHyperlink writes text without a link - see SupportsHyperlinks.
+func (c *Console) WriteHyperlink(_, text string) error {
+	return c.Write(text)
+}
+
+// WriteHyperlinkWithID writes text without a link - see SupportsHyperlinks.
+func (c *Console) WriteHyperlinkWithID(_, text, _ string) error {
+	return c.Write(text)
+}
+
+// ┌─────────────────────────────────────────────────────────────────┐.
+// │ Output Buffering                                                │.
+// └─────────────────────────────────────────────────────────────────┘.
+
+// SetBuffered does nothing - Console API calls such as cursor movement take.
+// effect immediately, so holding back only the text would reorder output.
+func (c *Console) SetBuffered(_ bool) error {
+	return nil
+}
+
+// IsBuffered returns false - Console output is never buffered.
+func (c *Console) IsBuffered() bool {
+	return false
+}
+
+// Flush does nothing - Console output is never buffered.
+func (c *Console) Flush() error {
+	return nil
+}
+
 // ┌─────────────────────────────────────────────────────────────────┐.
 // │ Screen Buffer (Windows Console API only)                        │.
 // └─────────────────────────────────────────────────────────────────┘.
@@ -612,11 +719,29 @@ func (c *Console) SupportsReadback() bool {
 	return true
 }
 
+// SupportsCursorQuery returns true - GetCursorPosition uses
+// GetConsoleScreenBufferInfo, which needs no terminal reply.
+func (c *Console) SupportsCursorQuery() bool {
+	return true
+}
+
+// SupportsHyperlinks returns false - the Console API path does not enable
+// virtual terminal processing, so OSC 8 sequences would be printed as text.
+func (c *Console) SupportsHyperlinks() bool {
+	return false
+}
+
 // SupportsTrueColor returns true - Windows 10+ supports 24-bit RGB colors.
 func (c *Console) SupportsTrueColor() bool {
 	return true
 }
 
+// SupportsSynchronizedOutput returns false - the Console API draws through
+// Win32 calls, which have no synchronized update mode.
+func (c *Console) SupportsSynchronizedOutput() bool {
+	return false
+}
+
 // Platform returns Windows Console platform type.
 func (c *Console) Platform() types.Platform {
 	return types.PlatformWindowsConsole
@@ -835,3 +960,8 @@ func (c *Console) ExitRawMode() error {
 	c.originalInputMode = 0
 	return nil
 }
+
+// PendingInput returns nil - the Console API never reads replies from stdin.
+func (c *Console) PendingInput() []byte {
+	return nil
+}
@@ -249,16 +249,16 @@ func TestConsole_SetCursorStyle(t *testing.T) {
 	}
 
 	for _, style := range styles {
-		err = console.SetCursorStyle(style)
+		err = console.SetCursorStyle(style, false)
 		if err != nil {
 			t.Errorf("SetCursorStyle(%v) error: %v", style, err)
 		}
 	}
 
-	// Restore to block (default).
-	err = console.SetCursorStyle(types.CursorBlock)
+	// Restore the original style.
+	err = console.ResetCursorStyle()
 	if err != nil {
-		t.Fatalf("SetCursorStyle(Block) error: %v", err)
+		t.Fatalf("ResetCursorStyle() error: %v", err)
 	}
 }
 
@@ -455,6 +455,10 @@ func TestConsole_Capabilities(t *testing.T) {
 		t.Error("SupportsReadback() = false, want true")
 	}
 
+	if !console.SupportsCursorQuery() {
+		t.Error("SupportsCursorQuery() = false, want true")
+	}
+
 	if !console.SupportsTrueColor() {
 		t.Error("SupportsTrueColor() = false, want true")
 	}
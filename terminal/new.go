@@ -20,19 +20,32 @@ type internalTerminal interface {
 	RestoreCursorPosition() error
 	HideCursor() error
 	ShowCursor() error
-	SetCursorStyle(style types.CursorStyle) error // Uses types
+	BeginSynchronizedUpdate() error
+	EndSynchronizedUpdate() error
+	SetCursorStyle(style types.CursorStyle, blinking bool) error // Uses types
+	ResetCursorStyle() error
+	IsCursorHidden() bool
+	IsCursorStyled() bool
 	Clear() error
 	ClearLine() error
 	ClearFromCursor() error
 	ClearLines(count int) error
 	Write(s string) error
 	WriteAt(x, y int, s string) error
+	WriteHyperlink(url, text string) error
+	WriteHyperlinkWithID(url, text, id string) error
+	SetBuffered(enabled bool) error
+	IsBuffered() bool
+	Flush() error
 	ReadScreenBuffer() ([][]rune, error)
 	Size() (width, height int, err error)
 	ColorDepth() int
 	SupportsDirectPositioning() bool
 	SupportsReadback() bool
+	SupportsCursorQuery() bool
 	SupportsTrueColor() bool
+	SupportsHyperlinks() bool
+	SupportsSynchronizedOutput() bool
 	Platform() types.Platform // Uses types
 	EnterAltScreen() error
 	ExitAltScreen() error
@@ -40,6 +53,7 @@ type internalTerminal interface {
 	IsInRawMode() bool
 	EnterRawMode() error
 	ExitRawMode() error
+	PendingInput() []byte
 }
 
 // terminalAdapter wraps internal terminal and converts types.
@@ -61,23 +75,57 @@ func (t *terminalAdapter) SaveCursorPosition() error    { return t.internal.Save
 func (t *terminalAdapter) RestoreCursorPosition() error { return t.internal.RestoreCursorPosition() }
 func (t *terminalAdapter) HideCursor() error            { return t.internal.HideCursor() }
 func (t *terminalAdapter) ShowCursor() error            { return t.internal.ShowCursor() }
-func (t *terminalAdapter) SetCursorStyle(style CursorStyle) error {
-	return t.internal.SetCursorStyle(types.CursorStyle(style)) // Convert
+func (t *terminalAdapter) SetCursorStyle(style CursorStyle, blinking bool) error {
+	return t.internal.SetCursorStyle(types.CursorStyle(style), blinking) // Convert
+}
+func (t *terminalAdapter) ResetCursorStyle() error    { return t.internal.ResetCursorStyle() }
+func (t *terminalAdapter) IsCursorHidden() bool       { return t.internal.IsCursorHidden() }
+func (t *terminalAdapter) IsCursorStyled() bool       { return t.internal.IsCursorStyled() }
+func (t *terminalAdapter) Clear() error               { return t.internal.Clear() }
+func (t *terminalAdapter) ClearLine() error           { return t.internal.ClearLine() }
+func (t *terminalAdapter) ClearFromCursor() error     { return t.internal.ClearFromCursor() }
+func (t *terminalAdapter) ClearLines(count int) error { return t.internal.ClearLines(count) }
+func (t *terminalAdapter) ClearRenderedLines(lines []string, termWidth int) error {
+	return t.internal.ClearLines(PhysicalRows(lines, termWidth))
 }
-func (t *terminalAdapter) Clear() error                        { return t.internal.Clear() }
-func (t *terminalAdapter) ClearLine() error                    { return t.internal.ClearLine() }
-func (t *terminalAdapter) ClearFromCursor() error              { return t.internal.ClearFromCursor() }
-func (t *terminalAdapter) ClearLines(count int) error          { return t.internal.ClearLines(count) }
 func (t *terminalAdapter) Write(s string) error                { return t.internal.Write(s) }
 func (t *terminalAdapter) WriteAt(x, y int, s string) error    { return t.internal.WriteAt(x, y, s) }
+func (t *terminalAdapter) SetBuffered(enabled bool) error      { return t.internal.SetBuffered(enabled) }
+func (t *terminalAdapter) IsBuffered() bool                    { return t.internal.IsBuffered() }
+func (t *terminalAdapter) Flush() error                        { return t.internal.Flush() }
 func (t *terminalAdapter) ReadScreenBuffer() ([][]rune, error) { return t.internal.ReadScreenBuffer() }
 func (t *terminalAdapter) Size() (int, int, error)             { return t.internal.Size() }
 func (t *terminalAdapter) ColorDepth() int                     { return t.internal.ColorDepth() }
+func (t *terminalAdapter) BackgroundColor() (uint8, uint8, uint8, error) {
+	if t.internal.Platform() == types.PlatformWindowsConsole {
+		return 0, 0, 0, ErrNoBackgroundColor // Console API never answers OSC 11
+	}
+	return QueryBackgroundColor(backgroundQueryTimeout)
+}
 func (t *terminalAdapter) SupportsDirectPositioning() bool {
 	return t.internal.SupportsDirectPositioning()
 }
 func (t *terminalAdapter) SupportsReadback() bool  { return t.internal.SupportsReadback() }
 func (t *terminalAdapter) SupportsTrueColor() bool { return t.internal.SupportsTrueColor() }
+func (t *terminalAdapter) SupportsHyperlinks() bool {
+	return t.internal.SupportsHyperlinks()
+}
+func (t *terminalAdapter) WriteHyperlink(url, text string) error {
+	return t.internal.WriteHyperlink(url, text)
+}
+func (t *terminalAdapter) WriteHyperlinkWithID(url, text, id string) error {
+	return t.internal.WriteHyperlinkWithID(url, text, id)
+}
+func (t *terminalAdapter) SupportsCursorQuery() bool {
+	return t.internal.SupportsCursorQuery()
+}
+func (t *terminalAdapter) SupportsSynchronizedOutput() bool {
+	return t.internal.SupportsSynchronizedOutput()
+}
+func (t *terminalAdapter) BeginSynchronizedUpdate() error {
+	return t.internal.BeginSynchronizedUpdate()
+}
+func (t *terminalAdapter) EndSynchronizedUpdate() error { return t.internal.EndSynchronizedUpdate() }
 func (t *terminalAdapter) Platform() Platform {
 	return Platform(t.internal.Platform()) // Convert
 }
@@ -87,6 +135,10 @@ func (t *terminalAdapter) IsInAltScreen() bool   { return t.internal.IsInAltScre
 func (t *terminalAdapter) IsInRawMode() bool     { return t.internal.IsInRawMode() }
 func (t *terminalAdapter) EnterRawMode() error   { return t.internal.EnterRawMode() }
 func (t *terminalAdapter) ExitRawMode() error    { return t.internal.ExitRawMode() }
+func (t *terminalAdapter) PendingInput() []byte  { return t.internal.PendingInput() }
+func (t *terminalAdapter) MakeRaw() (func() error, error) {
+	return makeRaw(t.internal.EnterRawMode, t.internal.ExitRawMode)
+}
 
 // New creates platform-optimized terminal with auto-detection.
 //
@@ -0,0 +1,21 @@
+package terminal
+
+import "sync"
+
+// makeRaw enters raw mode with enter and returns a closure that leaves it
+// with exit. The closure only restores once; later calls return the result
+// of the first, so it is safe to both defer it and call it early.
+func makeRaw(enter, exit func() error) (restore func() error, err error) {
+	if err := enter(); err != nil {
+		return nil, err
+	}
+
+	var (
+		once       sync.Once
+		restoreErr error
+	)
+	return func() error {
+		once.Do(func() { restoreErr = exit() })
+		return restoreErr
+	}, nil
+}
@@ -0,0 +1,62 @@
+package terminal
+
+import (
+	"errors"
+	"testing"
+)
+
+// rawModeTerminal records raw mode transitions; other methods are unused.
+type rawModeTerminal struct {
+	internalTerminal
+	enterErr      error
+	enters, exits int
+}
+
+func (r *rawModeTerminal) EnterRawMode() error {
+	r.enters++
+	return r.enterErr
+}
+
+func (r *rawModeTerminal) ExitRawMode() error {
+	r.exits++
+	return nil
+}
+
+func TestMakeRaw_RestoresOnce(t *testing.T) {
+	internal := &rawModeTerminal{}
+	term := &terminalAdapter{internal: internal}
+
+	restore, err := term.MakeRaw()
+	if err != nil {
+		t.Fatalf("MakeRaw() error = %v", err)
+	}
+	if internal.enters != 1 {
+		t.Errorf("EnterRawMode calls = %d, want 1", internal.enters)
+	}
+
+	for range 3 {
+		if err := restore(); err != nil {
+			t.Errorf("restore() = %v, want nil", err)
+		}
+	}
+	if internal.exits != 1 {
+		t.Errorf("ExitRawMode calls = %d, want 1", internal.exits)
+	}
+}
+
+func TestMakeRaw_EnterError(t *testing.T) {
+	enterErr := errors.New("not a terminal")
+	internal := &rawModeTerminal{enterErr: enterErr}
+	term := &terminalAdapter{internal: internal}
+
+	restore, err := term.MakeRaw()
+	if !errors.Is(err, enterErr) {
+		t.Errorf("MakeRaw() error = %v, want %v", err, enterErr)
+	}
+	if restore != nil {
+		t.Error("restore should be nil on error")
+	}
+	if internal.exits != 0 {
+		t.Errorf("ExitRawMode calls = %d, want 0", internal.exits)
+	}
+}
@@ -46,10 +46,11 @@
 //	term := terminal.New()
 //
 //	// Enter raw mode
-//	if err := term.SetRawMode(true); err != nil {
+//	restore, err := term.MakeRaw()
+//	if err != nil {
 //		log.Fatal(err)
 //	}
-//	defer term.SetRawMode(false) // Restore cooked mode
+//	defer restore() // Restore cooked mode
 //
 //	// Read single characters
 //	buf := make([]byte, 1)
@@ -121,7 +122,7 @@
 //
 //	Implementation: ANSI escape sequences
 //	  - SetCursorPosition: ESC[row;colH (~100μs)
-//	  - GetCursorPosition: Unsupported (returns error)
+//	  - GetCursorPosition: DSR query ESC[6n (terminal round trip)
 //	  - HideCursor: ESC[?25l
 //	  - Clear: ESC[2J
 //	Performance: Standard ANSI performance
@@ -175,7 +176,7 @@
 //   - Windows Console SetCursorPosition: ~10μs (Win32)
 //   - ANSI SetCursorPosition: ~100μs (escape sequence)
 //   - Windows Console GetCursorPosition: <1μs (instant)
-//   - ANSI GetCursorPosition: Unsupported (no CPR protocol)
+//   - ANSI GetCursorPosition: One terminal round trip (DSR/CPR)
 //   - HideCursor/ShowCursor: <10μs (both platforms)
 //   - Clear screen: <100μs (both platforms)
 package terminal
@@ -224,9 +225,12 @@ type Terminal interface {
 	// Coordinates are 0-based (top-left is 0,0).
 	//
 	// Windows Console API: Instant readback via GetConsoleScreenBufferInfo.
-	// ANSI: Returns error (requires CPR protocol, unreliable).
+	// ANSI: DSR query "\033[6n", reply "\033[{row};{col}R" read from stdin.
+	// with a short timeout. Keystrokes that arrive before the reply are kept.
+	// for PendingInput(). Another reader of stdin (such as a running tea.
+	// program) races for the reply; tea programs use RequestCursorPosition.
 	//
-	// Use SupportsReadback() to check if this is available.
+	// Use SupportsCursorQuery() to check if this is available.
 	GetCursorPosition() (x, y int, err error)
 
 	// MoveCursorUp moves cursor up n lines (relative movement).
@@ -260,9 +264,34 @@ type Terminal interface {
 	// ShowCursor makes the cursor visible.
 	ShowCursor() error
 
-	// SetCursorStyle changes cursor appearance.
+	// SetCursorStyle changes cursor appearance, e.g. a bar in insert mode
+	// and a block in normal mode. blinking selects a blinking cursor.
 	// Not all terminals support all styles - check terminal documentation.
-	SetCursorStyle(style CursorStyle) error
+	//
+	// ANSI: "\033[{n} q" (DECSCUSR).
+	// Windows Console API: cursor size via SetConsoleCursorInfo (blinking ignored).
+	SetCursorStyle(style CursorStyle, blinking bool) error
+
+	// ResetCursorStyle restores the default cursor style.
+	// Call it before exit after SetCursorStyle (tea programs do this automatically).
+	//
+	// ANSI: "\033[0 q" (the user's configured style).
+	// Windows Console API: the cursor size at startup.
+	ResetCursorStyle() error
+
+	// IsCursorHidden returns true if HideCursor was called and ShowCursor
+	// was not called since.
+	//
+	// Always returns accurate state (tracked internally, no syscalls), for
+	// cursor changes made through this Terminal.
+	IsCursorHidden() bool
+
+	// IsCursorStyled returns true if SetCursorStyle was called and
+	// ResetCursorStyle was not called since.
+	//
+	// Always returns accurate state (tracked internally, no syscalls), for
+	// cursor changes made through this Terminal.
+	IsCursorStyled() bool
 
 	// ┌─────────────────────────────────────────────────────────────┐.
 	// │ Screen Operations                                           │.
@@ -286,10 +315,26 @@ type Terminal interface {
 	//   - Efficiently clears multiple lines of previous content.
 	//   - Positions cursor at start of cleared region.
 	//
+	// count is in terminal rows. A line wider than the terminal wraps and.
+	// takes several rows, so use ClearRenderedLines to clear printed content.
+	//
 	// Windows Console API: FillConsoleOutputCharacter (~50μs for 10 lines).
 	// ANSI: Move up + clear to end (~500μs for 10 lines).
 	ClearLines(count int) error
 
+	// ClearRenderedLines clears the rows occupied by lines, as printed on a.
+	// terminal termWidth columns wide, ending at the current cursor row.
+	//
+	// Unlike ClearLines, it counts the rows each wrapped line takes up.
+	// (see PhysicalRows), so long lines do not leave leftovers behind.
+	// Escape sequences in lines are ignored when measuring.
+	//
+	// Example:.
+	//   term.Write(strings.Join(lines, "\n")).
+	//   width, _, _ := term.Size().
+	//   term.ClearRenderedLines(lines, width) // Clears all wrapped rows.
+	ClearRenderedLines(lines []string, termWidth int) error
+
 	// ┌─────────────────────────────────────────────────────────────┐.
 	// │ Output                                                      │.
 	// └─────────────────────────────────────────────────────────────┘.
@@ -307,6 +352,55 @@ type Terminal interface {
 	// But optimized on platforms that support direct positioning.
 	WriteAt(x, y int, s string) error
 
+	// WriteHyperlink writes text as a clickable link to url (OSC 8).
+	//
+	// ANSI: "\033]8;;{url}\033\\{text}\033]8;;\033\\".
+	// Writes plain text where SupportsHyperlinks() is false.
+	WriteHyperlink(url, text string) error
+
+	// WriteHyperlinkWithID is WriteHyperlink with an id parameter. Links.
+	// with the same id and url are treated as one link, for example a URL.
+	// wrapped over several lines highlights as a whole on hover.
+	WriteHyperlinkWithID(url, text, id string) error
+
+	// ┌─────────────────────────────────────────────────────────────┐.
+	// │ Output Buffering                                            │.
+	// └─────────────────────────────────────────────────────────────┘.
+
+	// SetBuffered enables or disables buffered output.
+	//
+	// In buffered mode, all output (cursor movement, clearing, writes) is.
+	// accumulated in memory and sent in a single write on Flush, instead of.
+	// one write syscall per call. Disabling buffered mode flushes pending output.
+	// Output is unbuffered by default.
+	//
+	// Windows Console: no-op (Console API calls take effect immediately).
+	SetBuffered(enabled bool) error
+
+	// IsBuffered returns true if output is held until Flush.
+	IsBuffered() bool
+
+	// Flush writes all pending buffered output in one write.
+	// No-op if nothing is pending or output is unbuffered.
+	Flush() error
+
+	// ┌─────────────────────────────────────────────────────────────┐.
+	// │ Synchronized Output                                         │.
+	// └─────────────────────────────────────────────────────────────┘.
+
+	// BeginSynchronizedUpdate tells the terminal to hold back drawing until.
+	// EndSynchronizedUpdate, so a frame appears at once instead of tearing.
+	//
+	// ANSI: "\033[?2026h" (DEC mode 2026).
+	// No-op where SupportsSynchronizedOutput() is false.
+	BeginSynchronizedUpdate() error
+
+	// EndSynchronizedUpdate ends a synchronized update and draws the frame.
+	//
+	// ANSI: "\033[?2026l".
+	// No-op where SupportsSynchronizedOutput() is false.
+	EndSynchronizedUpdate() error
+
 	// ┌─────────────────────────────────────────────────────────────┐.
 	// │ Screen Buffer (Windows Console API only)                    │.
 	// └─────────────────────────────────────────────────────────────┘.
@@ -338,6 +432,18 @@ type Terminal interface {
 	//   - 16777216: True color (24-bit RGB).
 	ColorDepth() int
 
+	// BackgroundColor queries the terminal's background color (OSC 11) and
+	// returns it as 8-bit RGB, waiting up to 100ms for the reply.
+	//
+	// Returns ErrNoBackgroundColor if the terminal does not report it:
+	// stdin/stdout are not terminals, the query is unsupported, or no reply
+	// arrives in time. The Windows Console API does not answer OSC 11, so
+	// PlatformWindowsConsole returns ErrNoBackgroundColor without querying.
+	//
+	// The terminal is briefly switched to raw mode to read the reply from
+	// stdin, so call this before starting an event loop that reads input.
+	BackgroundColor() (r, g, b uint8, err error)
+
 	// ┌─────────────────────────────────────────────────────────────┐.
 	// │ Capabilities Discovery                                      │.
 	// └─────────────────────────────────────────────────────────────┘.
@@ -351,12 +457,34 @@ type Terminal interface {
 	// SupportsReadback returns true if terminal supports reading.
 	// cursor position and screen buffer (Windows Console API).
 	//
-	// If false, GetCursorPosition() and ReadScreenBuffer() will fail.
+	// If false, ReadScreenBuffer() will fail. GetCursorPosition() may still.
+	// work on ANSI terminals, see SupportsCursorQuery().
 	SupportsReadback() bool
 
+	// SupportsCursorQuery returns true if GetCursorPosition() can read the.
+	// cursor position.
+	//
+	// Windows Console API: Always true.
+	// ANSI: True on Unix when stdin and stdout are terminals (DSR query).
+	SupportsCursorQuery() bool
+
 	// SupportsTrueColor returns true if terminal supports 24-bit RGB colors.
 	SupportsTrueColor() bool
 
+	// SupportsHyperlinks returns true if terminal is known to support OSC 8.
+	// hyperlinks, detected from the environment (kitty, WezTerm, iTerm2,.
+	// VS Code, Windows Terminal, GNOME Terminal, Konsole, ...).
+	//
+	// If false, WriteHyperlink writes plain text.
+	SupportsHyperlinks() bool
+
+	// SupportsSynchronizedOutput returns true if terminal is known to.
+	// support synchronized output (DEC mode 2026), detected from the.
+	// environment (kitty, WezTerm, foot, Alacritty, iTerm2, Windows Terminal, ...).
+	//
+	// If false, BeginSynchronizedUpdate and EndSynchronizedUpdate are no-ops.
+	SupportsSynchronizedOutput() bool
+
 	// Platform returns the detected terminal platform type.
 	Platform() Platform
 
@@ -446,6 +574,32 @@ type Terminal interface {
 	//
 	// Returns error if not in raw mode or syscall fails.
 	ExitRawMode() error
+
+	// MakeRaw puts terminal into raw mode and returns a function that.
+	// restores the previous mode.
+	//
+	// This is the raw mode the tea Program manages internally, exposed for.
+	// code that runs its own event loop.
+	//
+	// Example:.
+	//   restore, err := term.MakeRaw().
+	//   if err != nil {.
+	//       return err.
+	//   }.
+	//   defer restore() // Back to cooked mode.
+	//
+	// restore only acts once; calling it again returns the first result, so.
+	// it can be deferred and also called early (e.g. before exec'ing vim).
+	//
+	// Returns error if already in raw mode or syscall fails.
+	MakeRaw() (restore func() error, err error)
+
+	// PendingInput returns and clears input that was read from stdin while.
+	// waiting for a terminal reply, such as keys typed during.
+	// GetCursorPosition(). Input readers should consume it before stdin.
+	//
+	// Windows Console API: Always nil (no replies are read from stdin).
+	PendingInput() []byte
 }
 
 // CursorStyle represents the visual appearance of the terminal cursor.
@@ -5,8 +5,12 @@ go 1.25.1
 require github.com/phoenix-tui/phoenix/terminal v0.2.4
 
 require (
+	github.com/phoenix-tui/phoenix/core v0.2.4 // indirect
+	github.com/unilibs/uniwidth v0.2.0 // indirect
 	golang.org/x/sys v0.40.0 // indirect
 	golang.org/x/term v0.39.0 // indirect
 )
 
 replace github.com/phoenix-tui/phoenix/terminal => ../terminal
+
+replace github.com/phoenix-tui/phoenix/core => ../core
@@ -37,10 +37,14 @@ import (
 //	assert.Equal(t, 1, mock.CallCount("ClearLine"))
 //	assert.Equal(t, "SetCursorPosition(10, 5)", mock.Calls[0])
 type MockTerminal struct {
-	inAltScreen bool // Tracks alternate screen state
-	inRawMode   bool // Tracks raw mode state
-	mu          sync.Mutex
-	Calls       []string // All recorded method calls with arguments
+	inAltScreen  bool   // Tracks alternate screen state
+	inRawMode    bool   // Tracks raw mode state
+	cursorHidden bool   // Tracks cursor visibility
+	cursorStyled bool   // Tracks cursor style changes
+	buffered     bool   // Tracks buffered output state
+	pending      []byte // Returned by the next PendingInput
+	mu           sync.Mutex
+	Calls        []string // All recorded method calls with arguments
 }
 
 // NewMockTerminal creates a new mock terminal.
@@ -142,22 +146,62 @@ func (m *MockTerminal) RestoreCursorPosition() error {
 
 // HideCursor hides the cursor (mock implementation).
 func (m *MockTerminal) HideCursor() error {
-	m.record("HideCursor")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "HideCursor")
+	m.cursorHidden = true
 	return nil
 }
 
 // ShowCursor shows the cursor (mock implementation).
 func (m *MockTerminal) ShowCursor() error {
-	m.record("ShowCursor")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "ShowCursor")
+	m.cursorHidden = false
 	return nil
 }
 
 // SetCursorStyle sets the cursor style (mock implementation).
-func (m *MockTerminal) SetCursorStyle(style terminal.CursorStyle) error {
-	m.record(fmt.Sprintf("SetCursorStyle(%s)", style))
+func (m *MockTerminal) SetCursorStyle(style terminal.CursorStyle, blinking bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, fmt.Sprintf("SetCursorStyle(%s, %t)", style, blinking))
+	m.cursorStyled = true
+	return nil
+}
+
+// ResetCursorStyle resets the cursor style (mock implementation).
+func (m *MockTerminal) ResetCursorStyle() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "ResetCursorStyle")
+	m.cursorStyled = false
 	return nil
 }
 
+// IsCursorHidden returns whether the cursor is hidden (mock implementation).
+func (m *MockTerminal) IsCursorHidden() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "IsCursorHidden")
+	return m.cursorHidden
+}
+
+// IsCursorStyled returns whether the cursor style was changed (mock implementation).
+func (m *MockTerminal) IsCursorStyled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "IsCursorStyled")
+	return m.cursorStyled
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Screen Operations                                           │
 // └─────────────────────────────────────────────────────────────┘
@@ -186,6 +230,12 @@ func (m *MockTerminal) ClearLines(count int) error {
 	return nil
 }
 
+// ClearRenderedLines clears the rows of rendered lines (mock implementation).
+func (m *MockTerminal) ClearRenderedLines(lines []string, termWidth int) error {
+	m.record(fmt.Sprintf("ClearRenderedLines(%q, %d)", lines, termWidth))
+	return nil
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Output                                                      │
 // └─────────────────────────────────────────────────────────────┘
@@ -195,12 +245,61 @@ func (m *MockTerminal) Write(s string) error {
 	return nil
 }
 
+// BeginSynchronizedUpdate starts a synchronized update (mock implementation).
+func (m *MockTerminal) BeginSynchronizedUpdate() error {
+	m.record("BeginSynchronizedUpdate")
+	return nil
+}
+
+// EndSynchronizedUpdate ends a synchronized update (mock implementation).
+func (m *MockTerminal) EndSynchronizedUpdate() error {
+	m.record("EndSynchronizedUpdate")
+	return nil
+}
+
 // WriteAt writes text at specified position (mock implementation).
 func (m *MockTerminal) WriteAt(x, y int, s string) error {
 	m.record(fmt.Sprintf("WriteAt(%d, %d, %q)", x, y, s))
 	return nil
 }
 
+// WriteHyperlink writes a hyperlink (mock implementation).
+func (m *MockTerminal) WriteHyperlink(url, text string) error {
+	m.record(fmt.Sprintf("WriteHyperlink(%q, %q)", url, text))
+	return nil
+}
+
+// WriteHyperlinkWithID writes a hyperlink with an id (mock implementation).
+func (m *MockTerminal) WriteHyperlinkWithID(url, text, id string) error {
+	m.record(fmt.Sprintf("WriteHyperlinkWithID(%q, %q, %q)", url, text, id))
+	return nil
+}
+
+// SetBuffered enables or disables buffered output (mock implementation).
+func (m *MockTerminal) SetBuffered(enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, fmt.Sprintf("SetBuffered(%t)", enabled))
+	m.buffered = enabled
+	return nil
+}
+
+// IsBuffered returns whether output is buffered (mock implementation).
+func (m *MockTerminal) IsBuffered() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Calls = append(m.Calls, "IsBuffered")
+	return m.buffered
+}
+
+// Flush flushes buffered output (mock implementation).
+func (m *MockTerminal) Flush() error {
+	m.record("Flush")
+	return nil
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Screen Buffer (Windows Console API only)                    │
 // └─────────────────────────────────────────────────────────────┘
@@ -227,6 +326,12 @@ func (m *MockTerminal) ColorDepth() int {
 	return 256
 }
 
+// BackgroundColor returns a black background (mock implementation).
+func (m *MockTerminal) BackgroundColor() (r, g, b uint8, err error) {
+	m.record("BackgroundColor")
+	return 0, 0, 0, nil
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Capabilities Discovery                                      │
 // └─────────────────────────────────────────────────────────────┘
@@ -243,12 +348,30 @@ func (m *MockTerminal) SupportsReadback() bool {
 	return false
 }
 
+// SupportsCursorQuery returns whether cursor position queries are supported (mock implementation).
+func (m *MockTerminal) SupportsCursorQuery() bool {
+	m.record("SupportsCursorQuery")
+	return true
+}
+
 // SupportsTrueColor returns whether true color is supported (mock implementation).
 func (m *MockTerminal) SupportsTrueColor() bool {
 	m.record("SupportsTrueColor")
 	return true
 }
 
+// SupportsHyperlinks returns whether OSC 8 hyperlinks are supported (mock implementation).
+func (m *MockTerminal) SupportsHyperlinks() bool {
+	m.record("SupportsHyperlinks")
+	return true
+}
+
+// SupportsSynchronizedOutput returns whether synchronized output is supported (mock implementation).
+func (m *MockTerminal) SupportsSynchronizedOutput() bool {
+	m.record("SupportsSynchronizedOutput")
+	return false
+}
+
 // Platform returns the platform type (mock implementation).
 func (m *MockTerminal) Platform() terminal.Platform {
 	m.record("Platform")
@@ -340,3 +463,40 @@ func (m *MockTerminal) ExitRawMode() error {
 	m.inRawMode = false
 	return nil
 }
+
+// MakeRaw enters raw mode and returns a closure that exits it once (mock implementation).
+func (m *MockTerminal) MakeRaw() (func() error, error) {
+	m.record("MakeRaw")
+	if err := m.EnterRawMode(); err != nil {
+		return nil, err
+	}
+
+	var (
+		once sync.Once
+		err  error
+	)
+	return func() error {
+		once.Do(func() { err = m.ExitRawMode() })
+		return err
+	}, nil
+}
+
+// PendingInput returns input read while waiting for a terminal reply (mock implementation).
+// Returns and clears the input set with SetPendingInput.
+func (m *MockTerminal) PendingInput() []byte {
+	m.record("PendingInput")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending := m.pending
+	m.pending = nil
+	return pending
+}
+
+// SetPendingInput sets the input returned by the next PendingInput call,
+// e.g. to simulate keys typed while the cursor position was queried.
+func (m *MockTerminal) SetPendingInput(b []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending = b
+}
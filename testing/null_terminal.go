@@ -94,10 +94,25 @@ func (n *NullTerminal) ShowCursor() error {
 }
 
 // SetCursorStyle does nothing (null implementation).
-func (n *NullTerminal) SetCursorStyle(_ terminal.CursorStyle) error {
+func (n *NullTerminal) SetCursorStyle(_ terminal.CursorStyle, _ bool) error {
 	return nil
 }
 
+// ResetCursorStyle does nothing (null implementation).
+func (n *NullTerminal) ResetCursorStyle() error {
+	return nil
+}
+
+// IsCursorHidden returns false (null implementation).
+func (n *NullTerminal) IsCursorHidden() bool {
+	return false
+}
+
+// IsCursorStyled returns false (null implementation).
+func (n *NullTerminal) IsCursorStyled() bool {
+	return false
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Screen Operations                                           │
 // └─────────────────────────────────────────────────────────────┘
@@ -122,6 +137,11 @@ func (n *NullTerminal) ClearLines(_ int) error {
 	return nil
 }
 
+// ClearRenderedLines does nothing (null implementation).
+func (n *NullTerminal) ClearRenderedLines(_ []string, _ int) error {
+	return nil
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Output                                                      │
 // └─────────────────────────────────────────────────────────────┘
@@ -130,11 +150,46 @@ func (n *NullTerminal) Write(_ string) error {
 	return nil
 }
 
+// BeginSynchronizedUpdate does nothing (null implementation).
+func (n *NullTerminal) BeginSynchronizedUpdate() error {
+	return nil
+}
+
+// EndSynchronizedUpdate does nothing (null implementation).
+func (n *NullTerminal) EndSynchronizedUpdate() error {
+	return nil
+}
+
 // WriteAt does nothing (null implementation).
 func (n *NullTerminal) WriteAt(_, _ int, _ string) error {
 	return nil
 }
 
+// WriteHyperlink does nothing (null implementation).
+func (n *NullTerminal) WriteHyperlink(_, _ string) error {
+	return nil
+}
+
+// WriteHyperlinkWithID does nothing (null implementation).
+func (n *NullTerminal) WriteHyperlinkWithID(_, _, _ string) error {
+	return nil
+}
+
+// SetBuffered does nothing (null implementation).
+func (n *NullTerminal) SetBuffered(_ bool) error {
+	return nil
+}
+
+// IsBuffered returns false (null implementation).
+func (n *NullTerminal) IsBuffered() bool {
+	return false
+}
+
+// Flush does nothing (null implementation).
+func (n *NullTerminal) Flush() error {
+	return nil
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Screen Buffer (Windows Console API only)                    │
 // └─────────────────────────────────────────────────────────────┘
@@ -158,6 +213,11 @@ func (n *NullTerminal) ColorDepth() int {
 	return 256 // Assume 256 colors
 }
 
+// BackgroundColor returns ErrNoBackgroundColor (null implementation).
+func (n *NullTerminal) BackgroundColor() (r, g, b uint8, err error) {
+	return 0, 0, 0, terminal.ErrNoBackgroundColor // Nothing to query
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Capabilities Discovery                                      │
 // └─────────────────────────────────────────────────────────────┘
@@ -172,11 +232,26 @@ func (n *NullTerminal) SupportsReadback() bool {
 	return false // Conservative default
 }
 
+// SupportsCursorQuery returns true (null implementation).
+func (n *NullTerminal) SupportsCursorQuery() bool {
+	return true // GetCursorPosition always succeeds
+}
+
 // SupportsTrueColor returns false (null implementation).
 func (n *NullTerminal) SupportsTrueColor() bool {
 	return true // Optimistic default
 }
 
+// SupportsHyperlinks returns false (null implementation).
+func (n *NullTerminal) SupportsHyperlinks() bool {
+	return false // Conservative default
+}
+
+// SupportsSynchronizedOutput returns false (null implementation).
+func (n *NullTerminal) SupportsSynchronizedOutput() bool {
+	return false // Nothing to synchronize
+}
+
 // Platform returns null platform (null implementation).
 func (n *NullTerminal) Platform() terminal.Platform {
 	return terminal.PlatformUnknown
@@ -219,3 +294,13 @@ func (n *NullTerminal) EnterRawMode() error {
 func (n *NullTerminal) ExitRawMode() error {
 	return nil
 }
+
+// MakeRaw returns a no-op restore function (null implementation).
+func (n *NullTerminal) MakeRaw() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+// PendingInput returns nil (null implementation).
+func (n *NullTerminal) PendingInput() []byte {
+	return nil
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"errors"
 	"sync"
 	"testing"
 
@@ -51,7 +52,7 @@ func TestNullTerminal_AllMethodsReturnNil(t *testing.T) {
 	if err := term.ShowCursor(); err != nil {
 		t.Errorf("ShowCursor() = %v, want nil", err)
 	}
-	if err := term.SetCursorStyle(terminal.CursorBlock); err != nil {
+	if err := term.SetCursorStyle(terminal.CursorBlock, false); err != nil {
 		t.Errorf("SetCursorStyle() = %v, want nil", err)
 	}
 
@@ -68,6 +69,9 @@ func TestNullTerminal_AllMethodsReturnNil(t *testing.T) {
 	if err := term.ClearLines(5); err != nil {
 		t.Errorf("ClearLines() = %v, want nil", err)
 	}
+	if err := term.ClearRenderedLines([]string{"a"}, 80); err != nil {
+		t.Errorf("ClearRenderedLines() = %v, want nil", err)
+	}
 
 	// Output
 	if err := term.Write("test"); err != nil {
@@ -76,6 +80,20 @@ func TestNullTerminal_AllMethodsReturnNil(t *testing.T) {
 	if err := term.WriteAt(0, 0, "test"); err != nil {
 		t.Errorf("WriteAt() = %v, want nil", err)
 	}
+	if err := term.WriteHyperlink("https://example.com", "test"); err != nil {
+		t.Errorf("WriteHyperlink() = %v, want nil", err)
+	}
+
+	// Output buffering
+	if err := term.SetBuffered(true); err != nil {
+		t.Errorf("SetBuffered() = %v, want nil", err)
+	}
+	if term.IsBuffered() {
+		t.Error("IsBuffered() = true, want false")
+	}
+	if err := term.Flush(); err != nil {
+		t.Errorf("Flush() = %v, want nil", err)
+	}
 
 	// Screen buffer
 	if _, err := term.ReadScreenBuffer(); err != nil {
@@ -108,6 +126,9 @@ func TestNullTerminal_AllMethodsReturnNil(t *testing.T) {
 	if term.IsInRawMode() {
 		t.Error("IsInRawMode() = true, want false")
 	}
+	if restore, err := term.MakeRaw(); err != nil || restore() != nil {
+		t.Errorf("MakeRaw() = %v, want nil and a no-op restore", err)
+	}
 }
 
 func TestNullTerminal_ReasonableDefaults(t *testing.T) {
@@ -128,6 +149,11 @@ func TestNullTerminal_ReasonableDefaults(t *testing.T) {
 		t.Errorf("ColorDepth() = %d, want 256", depth)
 	}
 
+	// BackgroundColor has nothing to query
+	if _, _, _, err := term.BackgroundColor(); !errors.Is(err, terminal.ErrNoBackgroundColor) {
+		t.Errorf("BackgroundColor() error = %v, want ErrNoBackgroundColor", err)
+	}
+
 	// Capabilities should be conservative
 	if term.SupportsDirectPositioning() {
 		t.Error("SupportsDirectPositioning() = true, want false (conservative)")
@@ -295,19 +321,33 @@ func TestMockTerminal_AllMethodsRecord(t *testing.T) {
 		{"RestoreCursorPosition", func() { _ = mock.RestoreCursorPosition() }, "RestoreCursorPosition"},
 		{"HideCursor", func() { _ = mock.HideCursor() }, "HideCursor"},
 		{"ShowCursor", func() { _ = mock.ShowCursor() }, "ShowCursor"},
-		{"SetCursorStyle", func() { _ = mock.SetCursorStyle(terminal.CursorBlock) }, "SetCursorStyle(Block)"},
+		{"SetCursorStyle", func() { _ = mock.SetCursorStyle(terminal.CursorBar, true) }, "SetCursorStyle(Bar, true)"},
+		{"ResetCursorStyle", func() { _ = mock.ResetCursorStyle() }, "ResetCursorStyle"},
 		{"Clear", func() { _ = mock.Clear() }, "Clear"},
 		{"ClearLine", func() { _ = mock.ClearLine() }, "ClearLine"},
 		{"ClearFromCursor", func() { _ = mock.ClearFromCursor() }, "ClearFromCursor"},
 		{"ClearLines", func() { _ = mock.ClearLines(7) }, "ClearLines(7)"},
+		{"ClearRenderedLines", func() { _ = mock.ClearRenderedLines([]string{"a", "b"}, 80) }, `ClearRenderedLines(["a" "b"], 80)`},
 		{"Write", func() { _ = mock.Write("test") }, `Write("test")`},
 		{"WriteAt", func() { _ = mock.WriteAt(8, 9, "hello") }, `WriteAt(8, 9, "hello")`},
+		{"WriteHyperlink", func() { _ = mock.WriteHyperlink("https://x.dev", "x") }, `WriteHyperlink("https://x.dev", "x")`},
+		{"WriteHyperlinkWithID", func() { _ = mock.WriteHyperlinkWithID("https://x.dev", "x", "1") }, `WriteHyperlinkWithID("https://x.dev", "x", "1")`},
+		{"SetBuffered", func() { _ = mock.SetBuffered(true) }, "SetBuffered(true)"},
+		{"IsBuffered", func() { _ = mock.IsBuffered() }, "IsBuffered"},
+		{"Flush", func() { _ = mock.Flush() }, "Flush"},
 		{"ReadScreenBuffer", func() { _, _ = mock.ReadScreenBuffer() }, "ReadScreenBuffer"},
 		{"Size", func() { _, _, _ = mock.Size() }, "Size"},
 		{"ColorDepth", func() { _ = mock.ColorDepth() }, "ColorDepth"},
+		{"BackgroundColor", func() { _, _, _, _ = mock.BackgroundColor() }, "BackgroundColor"},
 		{"SupportsDirectPositioning", func() { _ = mock.SupportsDirectPositioning() }, "SupportsDirectPositioning"},
 		{"SupportsReadback", func() { _ = mock.SupportsReadback() }, "SupportsReadback"},
+		{"SupportsCursorQuery", func() { _ = mock.SupportsCursorQuery() }, "SupportsCursorQuery"},
+		{"PendingInput", func() { _ = mock.PendingInput() }, "PendingInput"},
 		{"SupportsTrueColor", func() { _ = mock.SupportsTrueColor() }, "SupportsTrueColor"},
+		{"SupportsHyperlinks", func() { _ = mock.SupportsHyperlinks() }, "SupportsHyperlinks"},
+		{"SupportsSynchronizedOutput", func() { _ = mock.SupportsSynchronizedOutput() }, "SupportsSynchronizedOutput"},
+		{"BeginSynchronizedUpdate", func() { _ = mock.BeginSynchronizedUpdate() }, "BeginSynchronizedUpdate"},
+		{"EndSynchronizedUpdate", func() { _ = mock.EndSynchronizedUpdate() }, "EndSynchronizedUpdate"},
 		{"Platform", func() { _ = mock.Platform() }, "Platform"},
 	}
 
@@ -327,6 +367,61 @@ func TestMockTerminal_AllMethodsRecord(t *testing.T) {
 	}
 }
 
+func TestMockTerminal_CursorState(t *testing.T) {
+	mock := NewMockTerminal()
+	if mock.IsCursorHidden() || mock.IsCursorStyled() {
+		t.Fatal("new mock should have a visible cursor in the default style")
+	}
+
+	_ = mock.HideCursor()
+	_ = mock.SetCursorStyle(terminal.CursorBar, true)
+	if !mock.IsCursorHidden() || !mock.IsCursorStyled() {
+		t.Error("HideCursor and SetCursorStyle should be tracked")
+	}
+
+	_ = mock.ShowCursor()
+	_ = mock.ResetCursorStyle()
+	if mock.IsCursorHidden() || mock.IsCursorStyled() {
+		t.Error("ShowCursor and ResetCursorStyle should clear the state")
+	}
+}
+
+func TestMockTerminal_MakeRaw(t *testing.T) {
+	mock := NewMockTerminal()
+
+	restore, err := mock.MakeRaw()
+	if err != nil {
+		t.Fatalf("MakeRaw() = %v, want nil", err)
+	}
+	if !mock.IsInRawMode() {
+		t.Error("IsInRawMode() = false after MakeRaw")
+	}
+	if _, err := mock.MakeRaw(); err == nil {
+		t.Error("MakeRaw() while raw should fail")
+	}
+
+	_ = restore()
+	_ = restore()
+	if mock.IsInRawMode() {
+		t.Error("IsInRawMode() = true after restore")
+	}
+	if got := mock.CallCount("ExitRawMode"); got != 1 {
+		t.Errorf("ExitRawMode calls = %d, want 1", got)
+	}
+}
+
+func TestMockTerminal_PendingInput(t *testing.T) {
+	mock := NewMockTerminal()
+	mock.SetPendingInput([]byte("q"))
+
+	if got := string(mock.PendingInput()); got != "q" {
+		t.Errorf("PendingInput() = %q, want %q", got, "q")
+	}
+	if got := mock.PendingInput(); got != nil {
+		t.Errorf("second PendingInput() = %q, want nil", got)
+	}
+}
+
 // ┌─────────────────────────────────────────────────────────────┐
 // │ Integration Tests (Realistic Usage)                        │
 // └─────────────────────────────────────────────────────────────┘